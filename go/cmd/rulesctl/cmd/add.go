@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -22,6 +23,12 @@ var (
 	addOptQueryRE           string
 	addOptLeadingCommentRE  string
 	addOptTrailingCommentRE string
+	addOptDelay             time.Duration
+	addOptMaxQPS            float64
+	addOptBurst             int
+	addOptMaxConcurrency    int
+	addOptMaxExecutionTime  time.Duration
+	addOptLimit             int64
 	// TODO: other stuff, bind vars etc
 )
 
@@ -30,6 +37,13 @@ func runAdd(cmd *cobra.Command, args []string) {
 	ruleAction := mkAction()
 
 	rule := vtrules.NewQueryRule(addOptDescription, addOptName, ruleAction)
+	rule.SetDelay(addOptDelay)
+	rule.SetThrottle(addOptMaxQPS, addOptBurst)
+	rule.SetMaxConcurrency(addOptMaxConcurrency)
+	rule.SetRewrite(addOptMaxExecutionTime, addOptLimit)
+	if err := rule.ValidateAction(); err != nil {
+		log.Fatalf("Action '%v' invalid: %v", addOptAction, err)
+	}
 	for _, pt := range rulePlans {
 		rule.AddPlanCond(pt)
 	}
@@ -101,8 +115,16 @@ func mkAction() vtrules.Action {
 	switch strings.ToLower(addOptAction) {
 	case "fail":
 		return vtrules.QRFail
-	case "fail_retry":
+	case "fail_retry", "fail-retry":
 		return vtrules.QRFailRetry
+	case "delay":
+		return vtrules.QRDelay
+	case "throttle":
+		return vtrules.QRThrottle
+	case "concurrency_limit", "concurrency-limit":
+		return vtrules.QRConcurrencyLimit
+	case "rewrite":
+		return vtrules.QRRewrite
 	case "continue":
 		return vtrules.QRContinue
 	default:
@@ -139,7 +161,7 @@ func Add() *cobra.Command {
 		&addOptAction,
 		"action", "a",
 		"",
-		"What action should be taken when this rule is matched {continue, fail, fail-retry} (required)")
+		"What action should be taken when this rule is matched {continue, fail, fail-retry, delay, throttle, concurrency-limit, rewrite}; see \"explain actions\" for details (required)")
 	addCmd.Flags().StringSliceVarP(
 		&addOptPlans,
 		"plan", "p",
@@ -166,6 +188,37 @@ func Add() *cobra.Command {
 		"",
 		"A regexp that will be applied to comments after a SQL statement")
 
+	addCmd.Flags().DurationVar(
+		&addOptDelay,
+		"delay",
+		0,
+		"How long the delay action holds matching queries back")
+	addCmd.Flags().Float64Var(
+		&addOptMaxQPS,
+		"max-qps",
+		0,
+		"How many matching queries per second the throttle action lets through")
+	addCmd.Flags().IntVar(
+		&addOptBurst,
+		"burst",
+		0,
+		"How many matching queries the throttle action lets through at once; defaults to one second worth of queries")
+	addCmd.Flags().IntVar(
+		&addOptMaxConcurrency,
+		"max-concurrency",
+		0,
+		"How many matching queries the concurrency-limit action lets execute at once")
+	addCmd.Flags().DurationVar(
+		&addOptMaxExecutionTime,
+		"max-execution-time",
+		0,
+		"The MAX_EXECUTION_TIME hint the rewrite action adds to matching selects")
+	addCmd.Flags().Int64Var(
+		&addOptLimit,
+		"limit",
+		0,
+		"The LIMIT the rewrite action adds to matching selects, if they have none or a larger one")
+
 	for _, f := range []string{"name", "action"} {
 		addCmd.MarkFlagRequired(f)
 	}
@@ -11,7 +11,7 @@ import (
 func Explain() *cobra.Command {
 	explain := &cobra.Command{
 		Use:   "explain [concept]",
-		Short: "Explains a concept, valid options are: query-plans",
+		Short: "Explains a concept, valid options are: query-plans, actions",
 		Args:  cobra.ExactArgs(1),
 		Run:   runExplain,
 	}
@@ -21,6 +21,7 @@ func Explain() *cobra.Command {
 func runExplain(cmd *cobra.Command, args []string) {
 	lookup := map[string]func(){
 		"query-plans": helpQueryPlans,
+		"actions":     helpActions,
 	}
 
 	if fn, ok := lookup[args[0]]; ok {
@@ -44,3 +45,23 @@ The list of valid plan types that can be used follows:
 		fmt.Printf("  - %v\n", planbuilder.PlanType(i).String())
 	}
 }
+
+func helpActions() {
+	fmt.Printf(`Actions!
+
+The action is what the Tablet does with the queries a rule matches. Only the
+action of the first rule that matches a query is taken.
+
+The list of valid actions follows:
+  - continue: nothing, the rule is ignored
+  - fail: fail the query
+  - fail-retry: fail the query with an error that lets the client retry it
+  - delay: hold the query back for --delay before executing it
+  - throttle: fail the query if more than --max-qps queries per second match
+    the rule, letting through bursts of up to --burst queries
+  - concurrency-limit: fail the query if --max-concurrency queries matching the
+    rule are already executing
+  - rewrite: add a MAX_EXECUTION_TIME hint of --max-execution-time, and a LIMIT
+    of --limit, to the selects the rule matches
+`)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// DistributedTransaction is the parent command of the commands managing
+	// the distributed transactions committed with TWOPC.
+	DistributedTransaction = &cobra.Command{
+		Use:   "DistributedTransaction",
+		Short: "Lists, inspects, concludes and rolls back distributed transactions.",
+		Long: strings.TrimSpace(`
+DistributedTransaction manages the distributed transactions committed by vtgates
+in TWOPC transaction mode. The transactions are read from, and resolved on, the
+primaries of the shards storing their metadata.`),
+		Args: cobra.NoArgs,
+	}
+	// DistributedTransactionList lists the unresolved distributed transactions of a keyspace.
+	DistributedTransactionList = &cobra.Command{
+		Use:                   "List [--abandon-age=<duration>] [--json] <keyspace>",
+		Short:                 "Lists the unresolved distributed transactions whose metadata is stored in the keyspace.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandDistributedTransactionList,
+	}
+	// DistributedTransactionRead shows a distributed transaction.
+	DistributedTransactionRead = &cobra.Command{
+		Use:                   "Read [--json] <dtid>",
+		Short:                 "Shows the state and the participants of a distributed transaction.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandDistributedTransactionRead,
+	}
+	// DistributedTransactionConclude concludes a distributed transaction.
+	DistributedTransactionConclude = &cobra.Command{
+		Use:                   "Conclude <dtid>",
+		Short:                 "Commits a distributed transaction on all its participants if the decision to commit it was made, and rolls it back otherwise.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandDistributedTransactionConclude,
+	}
+	// DistributedTransactionRollback rolls back a distributed transaction.
+	DistributedTransactionRollback = &cobra.Command{
+		Use:                   "Rollback <dtid>",
+		Short:                 "Rolls back a distributed transaction on all its participants, unless the decision to commit it was already made.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandDistributedTransactionRollback,
+	}
+	// DistributedTransactionClearFailures resets the failure stats of the resolver.
+	DistributedTransactionClearFailures = &cobra.Command{
+		Use:                   "ClearFailures",
+		Short:                 "Resets the DistributedTransactionResolveFailures stats of the resolver run by the vtctld.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		RunE:                  commandDistributedTransactionClearFailures,
+	}
+)
+
+var distributedTransactionListOptions = struct {
+	AbandonAge time.Duration
+	JSON       bool
+}{}
+
+func commandDistributedTransactionList(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.DistributedTransactionList(commandCtx, &vtctldatapb.DistributedTransactionListRequest{
+		Keyspace:   cmd.Flags().Arg(0),
+		AbandonAge: protoutil.DurationToProto(distributedTransactionListOptions.AbandonAge),
+	})
+	if err != nil {
+		return err
+	}
+
+	if distributedTransactionListOptions.JSON {
+		data, err := cli.MarshalJSON(resp.Transactions)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s\n", data)
+		return nil
+	}
+
+	for _, transaction := range resp.Transactions {
+		printDistributedTransaction(transaction)
+	}
+
+	return nil
+}
+
+var distributedTransactionReadOptions = struct {
+	JSON bool
+}{}
+
+func commandDistributedTransactionRead(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.DistributedTransactionRead(commandCtx, &vtctldatapb.DistributedTransactionReadRequest{
+		Dtid: cmd.Flags().Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	if distributedTransactionReadOptions.JSON {
+		data, err := cli.MarshalJSON(resp.Transaction)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s\n", data)
+		return nil
+	}
+
+	printDistributedTransaction(resp.Transaction)
+	return nil
+}
+
+func commandDistributedTransactionConclude(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	_, err := client.DistributedTransactionConclude(commandCtx, &vtctldatapb.DistributedTransactionConcludeRequest{
+		Dtid: cmd.Flags().Arg(0),
+	})
+	return err
+}
+
+func commandDistributedTransactionRollback(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	_, err := client.DistributedTransactionRollback(commandCtx, &vtctldatapb.DistributedTransactionRollbackRequest{
+		Dtid: cmd.Flags().Arg(0),
+	})
+	return err
+}
+
+func commandDistributedTransactionClearFailures(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	_, err := client.DistributedTransactionClearFailures(commandCtx, &vtctldatapb.DistributedTransactionClearFailuresRequest{})
+	return err
+}
+
+// printDistributedTransaction prints a transaction in the format of the legacy
+// DistributedTransactionList and DistributedTransactionRead vtctl commands.
+func printDistributedTransaction(transaction *querypb.TransactionMetadata) {
+	participants := make([]string, 0, len(transaction.Participants))
+	for _, target := range transaction.Participants {
+		participants = append(participants, target.Keyspace+"/"+target.Shard)
+	}
+	fmt.Printf("%s %v created %v participants %s\n", transaction.Dtid, transaction.State,
+		time.Unix(0, transaction.TimeCreated).UTC().Format(time.RFC3339), strings.Join(participants, ","))
+}
+
+func init() {
+	DistributedTransactionList.Flags().DurationVar(&distributedTransactionListOptions.AbandonAge, "abandon-age", 0, "Only list the transactions created more than this duration ago.")
+	DistributedTransactionList.Flags().BoolVar(&distributedTransactionListOptions.JSON, "json", false, "Output the transactions in JSON.")
+	DistributedTransaction.AddCommand(DistributedTransactionList)
+
+	DistributedTransactionRead.Flags().BoolVar(&distributedTransactionReadOptions.JSON, "json", false, "Output the transaction in JSON.")
+	DistributedTransaction.AddCommand(DistributedTransactionRead)
+
+	DistributedTransaction.AddCommand(DistributedTransactionConclude)
+	DistributedTransaction.AddCommand(DistributedTransactionRollback)
+	DistributedTransaction.AddCommand(DistributedTransactionClearFailures)
+
+	Root.AddCommand(DistributedTransaction)
+}
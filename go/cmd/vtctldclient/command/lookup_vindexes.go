@@ -0,0 +1,264 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// LookupVindex is the parent command of the commands managing the
+	// workflow backfilling a lookup vindex.
+	LookupVindex = &cobra.Command{
+		Use:   "LookupVindex",
+		Short: "Creates, shows, verifies and externalizes the workflow backfilling a lookup vindex.",
+		Long: strings.TrimSpace(`
+LookupVindex manages the workflow backfilling a lookup vindex, from its creation
+to its externalization.`),
+		Args: cobra.NoArgs,
+	}
+	// LookupVindexCreate creates a lookup vindex and starts its backfill.
+	LookupVindexCreate = &cobra.Command{
+		Use:                   "Create [--cells=c1,c2,...] [--tablet-types=<tablet types>] [--continue-after-copy-with-owner] <keyspace> <json spec>",
+		Short:                 "Creates a write_only lookup vindex and starts the workflow backfilling its lookup table.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandLookupVindexCreate,
+	}
+	// LookupVindexShow shows the progress of the backfill of a lookup vindex.
+	LookupVindexShow = &cobra.Command{
+		Use:                   "Show <keyspace>.<vindex>",
+		Short:                 "Shows the rows copied and the lag of the workflow backfilling a lookup vindex.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandLookupVindexShow,
+	}
+	// LookupVindexVerify compares the lookup table of a lookup vindex with its owner table.
+	LookupVindexVerify = &cobra.Command{
+		Use:                   "Verify [--source-cell=<cell>] [--target-cell=<cell>] [--tablet-types=<tablet types>] [--filtered-replication-wait-time=30s] [--limit=<max rows>] [--debug-query] [--only-pks] <keyspace>.<vindex>",
+		Short:                 "Compares the lookup table of a lookup vindex with its owner table, like VDiff.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandLookupVindexVerify,
+	}
+	// LookupVindexExternalize verifies and externalizes a lookup vindex.
+	LookupVindexExternalize = &cobra.Command{
+		Use:                   "Externalize [--source-cell=<cell>] [--target-cell=<cell>] [--tablet-types=<tablet types>] [--filtered-replication-wait-time=30s] <keyspace>.<vindex>",
+		Short:                 "Verifies the lookup table of a lookup vindex, and takes the vindex out of write_only mode if it matches its owner table.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandLookupVindexExternalize,
+	}
+)
+
+var lookupVindexCreateOptions = struct {
+	Cells                      []string
+	TabletTypes                []string
+	ContinueAfterCopyWithOwner bool
+}{}
+
+func commandLookupVindexCreate(cmd *cobra.Command, args []string) error {
+	tabletTypes, err := parseTabletTypes(lookupVindexCreateOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	vindex := &vschemapb.Keyspace{}
+	if err := json2.Unmarshal([]byte(cmd.Flags().Arg(1)), vindex); err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	_, err = client.LookupVindexCreate(commandCtx, &vtctldatapb.LookupVindexCreateRequest{
+		Keyspace:                   cmd.Flags().Arg(0),
+		Vindex:                     vindex,
+		Cells:                      lookupVindexCreateOptions.Cells,
+		TabletTypes:                tabletTypes,
+		ContinueAfterCopyWithOwner: lookupVindexCreateOptions.ContinueAfterCopyWithOwner,
+	})
+	return err
+}
+
+func commandLookupVindexShow(cmd *cobra.Command, args []string) error {
+	keyspace, name, err := parseLookupVindexName(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.LookupVindexShow(commandCtx, &vtctldatapb.LookupVindexShowRequest{
+		Keyspace: keyspace,
+		Name:     name,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var lookupVindexVerifyOptions = struct {
+	SourceCell                  string
+	TargetCell                  string
+	TabletTypes                 []string
+	FilteredReplicationWaitTime time.Duration
+	Limit                       int64
+	DebugQuery                  bool
+	OnlyPKs                     bool
+}{}
+
+func commandLookupVindexVerify(cmd *cobra.Command, args []string) error {
+	keyspace, name, err := parseLookupVindexName(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := parseTabletTypes(lookupVindexVerifyOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	if lookupVindexVerifyOptions.Limit <= 0 {
+		return fmt.Errorf("--limit must be greater than 0")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.LookupVindexVerify(commandCtx, &vtctldatapb.LookupVindexVerifyRequest{
+		Keyspace:                    keyspace,
+		Name:                        name,
+		SourceCell:                  lookupVindexVerifyOptions.SourceCell,
+		TargetCell:                  lookupVindexVerifyOptions.TargetCell,
+		TabletTypes:                 tabletTypes,
+		FilteredReplicationWaitTime: protoutil.DurationToProto(lookupVindexVerifyOptions.FilteredReplicationWaitTime),
+		Limit:                       lookupVindexVerifyOptions.Limit,
+		DebugQuery:                  lookupVindexVerifyOptions.DebugQuery,
+		OnlyPks:                     lookupVindexVerifyOptions.OnlyPKs,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func commandLookupVindexExternalize(cmd *cobra.Command, args []string) error {
+	keyspace, name, err := parseLookupVindexName(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := parseTabletTypes(lookupVindexVerifyOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	_, err = client.LookupVindexExternalize(commandCtx, &vtctldatapb.LookupVindexExternalizeRequest{
+		Keyspace:                    keyspace,
+		Name:                        name,
+		SourceCell:                  lookupVindexVerifyOptions.SourceCell,
+		TargetCell:                  lookupVindexVerifyOptions.TargetCell,
+		TabletTypes:                 tabletTypes,
+		FilteredReplicationWaitTime: protoutil.DurationToProto(lookupVindexVerifyOptions.FilteredReplicationWaitTime),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Externalized vindex %s.%s\n", keyspace, name)
+
+	return nil
+}
+
+// parseLookupVindexName parses a vindex name of the form <keyspace>.<vindex>.
+func parseLookupVindexName(qualifiedName string) (keyspace string, name string, err error) {
+	parts := strings.Split(qualifiedName, ".")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("vindex name should be of the form <keyspace>.<vindex>: %s", qualifiedName)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func parseTabletTypes(strs []string) ([]topodatapb.TabletType, error) {
+	tabletTypes := make([]topodatapb.TabletType, 0, len(strs))
+	for _, str := range strs {
+		tabletType, err := topoproto.ParseTabletType(str)
+		if err != nil {
+			return nil, err
+		}
+
+		tabletTypes = append(tabletTypes, tabletType)
+	}
+
+	return tabletTypes, nil
+}
+
+func init() {
+	LookupVindexCreate.Flags().StringSliceVarP(&lookupVindexCreateOptions.Cells, "cells", "c", nil, "Source cells to replicate from.")
+	LookupVindexCreate.Flags().StringSliceVar(&lookupVindexCreateOptions.TabletTypes, "tablet-types", nil, "Source tablet types to replicate from.")
+	LookupVindexCreate.Flags().BoolVar(&lookupVindexCreateOptions.ContinueAfterCopyWithOwner, "continue-after-copy-with-owner", false, "Keep the workflow running after the copy phase when the vindex has an owner.")
+	LookupVindex.AddCommand(LookupVindexCreate)
+
+	LookupVindex.AddCommand(LookupVindexShow)
+
+	for _, cmd := range []*cobra.Command{LookupVindexVerify, LookupVindexExternalize} {
+		cmd.Flags().StringVar(&lookupVindexVerifyOptions.SourceCell, "source-cell", "", "The cell to read the owner table from; default is any available cell.")
+		cmd.Flags().StringVar(&lookupVindexVerifyOptions.TargetCell, "target-cell", "", "The cell to read the lookup table from; default is any available cell.")
+		cmd.Flags().StringSliceVar(&lookupVindexVerifyOptions.TabletTypes, "tablet-types", []string{"primary", "replica", "rdonly"}, "Tablet types to read the tables from.")
+		cmd.Flags().DurationVar(&lookupVindexVerifyOptions.FilteredReplicationWaitTime, "filtered-replication-wait-time", 30*time.Second, "Maximum time to wait for the workflow to catch up before the comparison.")
+	}
+	LookupVindexVerify.Flags().Int64Var(&lookupVindexVerifyOptions.Limit, "limit", math.MaxInt64, "Stop the comparison after this number of rows.")
+	LookupVindexVerify.Flags().BoolVar(&lookupVindexVerifyOptions.DebugQuery, "debug-query", false, "Add a mysql query to the report that can be used for further debugging.")
+	LookupVindexVerify.Flags().BoolVar(&lookupVindexVerifyOptions.OnlyPKs, "only-pks", false, "Only show the primary keys of the mismatched rows in the report.")
+	LookupVindex.AddCommand(LookupVindexVerify)
+	LookupVindex.AddCommand(LookupVindexExternalize)
+
+	Root.AddCommand(LookupVindex)
+}
@@ -30,6 +30,19 @@ import (
 )
 
 var (
+	// AddVindexRange makes an AddVindexRange gRPC call to a vtctld.
+	AddVindexRange = &cobra.Command{
+		Use:   "AddVindexRange [--keyspace-id=<keyspace id>] [--cells=c1,c2,...] [--skip-rebuild] [--dry-run] <keyspace> <vindex> <start>",
+		Short: "Adds a range starting at <start> to a numeric_range or time_range vindex.",
+		Long: `Adds a range starting at <start> to a numeric_range or time_range vindex.
+
+The range containing <start> is split in two ranges that keep its keyspace id, so no
+row changes shard. --keyspace-id is only accepted when <start> is below the first range,
+whose values don't have a keyspace id yet and so can't have any row.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(3),
+		RunE:                  commandAddVindexRange,
+	}
 	// GetVSchema makes a GetVSchema gRPC call to a vtctld.
 	GetVSchema = &cobra.Command{
 		Use:  "GetVSchema keyspace",
@@ -56,6 +69,36 @@ var applyVSchemaOptions = struct {
 	Cells       []string
 }{}
 
+var addVindexRangeOptions = struct {
+	KeyspaceID  string
+	DryRun      bool
+	SkipRebuild bool
+	Cells       []string
+}{}
+
+func commandAddVindexRange(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.AddVindexRange(commandCtx, &vtctldatapb.AddVindexRangeRequest{
+		Keyspace:    cmd.Flags().Arg(0),
+		Vindex:      cmd.Flags().Arg(1),
+		Start:       cmd.Flags().Arg(2),
+		KeyspaceId:  addVindexRangeOptions.KeyspaceID,
+		SkipRebuild: addVindexRangeOptions.SkipRebuild,
+		Cells:       addVindexRangeOptions.Cells,
+		DryRun:      addVindexRangeOptions.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+	data, err := cli.MarshalJSON(resp.Vindex)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("New vindex %s:\n%s\n", cmd.Flags().Arg(1), data)
+	return nil
+}
+
 func commandApplyVSchema(cmd *cobra.Command, args []string) error {
 	sqlMode := (applyVSchemaOptions.SQL != "") != (applyVSchemaOptions.SQLFile != "")
 	jsonMode := (applyVSchemaOptions.VSchema != "") != (applyVSchemaOptions.VSchemaFile != "")
@@ -142,6 +185,12 @@ func commandGetVSchema(cmd *cobra.Command, args []string) error {
 }
 
 func init() {
+	AddVindexRange.Flags().StringVar(&addVindexRangeOptions.KeyspaceID, "keyspace-id", "", "Keyspace id prefix, in hex, of a new range below the first one. Other ranges keep the keyspace id of the range they are split from.")
+	AddVindexRange.Flags().BoolVar(&addVindexRangeOptions.DryRun, "dry-run", false, "If set, do not save the altered vschema, simply echo to console.")
+	AddVindexRange.Flags().BoolVar(&addVindexRangeOptions.SkipRebuild, "skip-rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	AddVindexRange.Flags().StringSliceVar(&addVindexRangeOptions.Cells, "cells", nil, "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
+	Root.AddCommand(AddVindexRange)
+
 	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.VSchema, "vschema", "", "VSchema")
 	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.VSchemaFile, "vschema-file", "", "VSchema File")
 	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.SQL, "sql", "", "A VSchema DDL SQL statement, e.g. `alter table t add vindex hash(id)`")
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// collationBinary is the binary collation of VARBINARY and BLOB columns:
+// values are compared byte by byte and trailing spaces are significant.
+type collationBinary struct{}
+
+func (c *collationBinary) ID() ID {
+	return Binary
+}
+
+func (c *collationBinary) Name() string {
+	return "binary"
+}
+
+func (c *collationBinary) Collate(left, right []byte) int {
+	return bytes.Compare(left, right)
+}
+
+func (c *collationBinary) WeightString(dst, src []byte) []byte {
+	return append(dst, src...)
+}
+
+// binPad is the weight of the space character in utf8mb4_bin
+var binPad = []byte{0, 0, ' '}
+
+// collationUtf8mb4Bin compares the code points of the characters, and ignores
+// trailing spaces (PAD SPACE).
+type collationUtf8mb4Bin struct{}
+
+func (c *collationUtf8mb4Bin) ID() ID {
+	return Utf8mb4Bin
+}
+
+func (c *collationUtf8mb4Bin) Name() string {
+	return "utf8mb4_bin"
+}
+
+func (c *collationUtf8mb4Bin) Collate(left, right []byte) int {
+	return comparePadded(c.weights(nil, left), c.weights(nil, right), binPad)
+}
+
+func (c *collationUtf8mb4Bin) WeightString(dst, src []byte) []byte {
+	return padWeightString(dst, c.weights(nil, src), binPad)
+}
+
+// weights appends the code point of every character of src on 3 bytes, like MySQL does
+func (c *collationUtf8mb4Bin) weights(dst, src []byte) []byte {
+	for len(src) > 0 {
+		r, size := utf8.DecodeRune(src)
+		src = src[size:]
+		dst = append(dst, byte(r>>16), byte(r>>8), byte(r))
+	}
+	return dst
+}
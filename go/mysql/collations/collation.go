@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package collations implements the most common MySQL collations, so that text
+// values coming from different shards can be compared and sorted in vtgate
+// without asking MySQL for their WEIGHT_STRING().
+//
+// utf8mb4_0900_ai_ci, the default collation of MySQL 8.0, is only approximated:
+// its weights don't come from the UCA 9.0.0 tables that MySQL uses. The planner
+// keeps asking MySQL for the WEIGHT_STRING() of its values, and only compares the
+// values of the collations for which IsExact returns true in vtgate.
+package collations
+
+import (
+	"strings"
+)
+
+// ID is the numerical identifier of a collation, as used by the MySQL protocol
+// and reported by SHOW COLLATION.
+type ID uint16
+
+// Unknown is the ID of a collation that is not known, or not implemented.
+// Values with an unknown collation cannot be compared in vtgate.
+const Unknown ID = 0
+
+// IDs of the collations implemented in this package.
+const (
+	Latin1SwedishCi  ID = 8
+	Utf8mb4GeneralCi ID = 45
+	Utf8mb4Bin       ID = 46
+	Binary           ID = 63
+	Utf8mb4_0900AiCi ID = 255
+)
+
+// Collation implements the comparison rules of a MySQL collation.
+type Collation interface {
+	// ID returns the MySQL identifier of this collation.
+	ID() ID
+
+	// Name returns the MySQL name of this collation, e.g. utf8mb4_general_ci.
+	Name() string
+
+	// Collate compares left and right and returns -1, 0 or 1 when left sorts
+	// before, equal to, or after right.
+	Collate(left, right []byte) int
+
+	// WeightString appends the weight string of src to dst and returns the result.
+	// Two weight strings compare with bytes.Compare like their source values compare
+	// with Collate, which makes them suitable for hashing and sorting.
+	// The weight strings are not guaranteed to be the same as the ones returned
+	// by MySQL's WEIGHT_STRING().
+	WeightString(dst, src []byte) []byte
+}
+
+var (
+	collationsByID   = map[ID]Collation{}
+	collationsByName = map[string]Collation{}
+)
+
+func register(c Collation) {
+	collationsByID[c.ID()] = c
+	collationsByName[c.Name()] = c
+}
+
+func init() {
+	register(&collationBinary{})
+	register(&collationUtf8mb4Bin{})
+	register(&collationUtf8mb4GeneralCi{})
+	register(&collationLatin1SwedishCi{})
+	register(newCollationUca0900AiCi())
+}
+
+// LookupByID returns the collation with the given ID, or nil if the
+// collation is not implemented.
+func LookupByID(id ID) Collation {
+	return collationsByID[id]
+}
+
+// LookupByName returns the collation with the given name, or nil if the
+// collation is not implemented. The name is case-insensitive.
+func LookupByName(name string) Collation {
+	return collationsByName[strings.ToLower(name)]
+}
+
+// LookupID returns the ID of the collation with the given name, or Unknown
+// if the collation is not implemented.
+func LookupID(name string) ID {
+	if c := LookupByName(name); c != nil {
+		return c.ID()
+	}
+	return Unknown
+}
+
+// Name returns the name of the collation with this ID, or an empty string
+// if the collation is not implemented.
+func (id ID) Name() string {
+	if c := LookupByID(id); c != nil {
+		return c.Name()
+	}
+	return ""
+}
+
+// Valid returns true if the collation with this ID is implemented.
+func (id ID) Valid() bool {
+	return LookupByID(id) != nil
+}
+
+// approximated lists the collations that are not implemented exactly like in MySQL.
+var approximated = map[ID]bool{
+	// x/text implements a different version of the UCA, and doesn't handle
+	// the ignorable characters and contractions like MySQL does
+	Utf8mb4_0900AiCi: true,
+}
+
+// IsExact returns true if the collation with this ID is implemented, and compares
+// values exactly like MySQL. The values of the collations that are only approximated
+// must still be ordered and grouped by their WEIGHT_STRING() in MySQL.
+func (id ID) IsExact() bool {
+	return id.Valid() && !approximated[id]
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"binary", "utf8mb4_bin", "utf8mb4_general_ci", "latin1_swedish_ci", "utf8mb4_0900_ai_ci"} {
+		coll := LookupByName(name)
+		require.NotNil(t, coll, name)
+		assert.Equal(t, name, coll.Name())
+		assert.Equal(t, coll, LookupByID(coll.ID()))
+		assert.Equal(t, coll.ID(), LookupID(name))
+		assert.Equal(t, name, coll.ID().Name())
+	}
+	assert.Equal(t, Utf8mb4GeneralCi, LookupID("UTF8MB4_General_CI"))
+	assert.Nil(t, LookupByName("utf8mb4_ja_0900_as_cs"))
+	assert.Equal(t, Unknown, LookupID("utf8mb4_ja_0900_as_cs"))
+	assert.False(t, Unknown.Valid())
+	assert.False(t, Unknown.IsExact())
+	assert.True(t, Utf8mb4GeneralCi.IsExact())
+	assert.False(t, Utf8mb4_0900AiCi.IsExact())
+	assert.Equal(t, "", Unknown.Name())
+}
+
+func TestCollate(t *testing.T) {
+	tcases := []struct {
+		collation   ID
+		left, right string
+		want        int
+	}{
+		{Binary, "a", "A", 1},
+		{Binary, "a", "a ", -1},
+		{Binary, "abc", "abd", -1},
+
+		{Utf8mb4Bin, "a", "A", 1},
+		{Utf8mb4Bin, "a", "a   ", 0},
+		{Utf8mb4Bin, "a\t", "a", -1},
+		{Utf8mb4Bin, "é", "f", 1},
+		{Utf8mb4Bin, "😀", "é", 1},
+
+		{Utf8mb4GeneralCi, "a", "A", 0},
+		{Utf8mb4GeneralCi, "abc", "ABD", -1},
+		{Utf8mb4GeneralCi, "Résumé", "resume", 0},
+		{Utf8mb4GeneralCi, "a", "a  ", 0},
+		{Utf8mb4GeneralCi, "a\t", "a", -1},
+		{Utf8mb4GeneralCi, "ß", "s", 0},
+		{Utf8mb4GeneralCi, "é", "f", -1},
+		{Utf8mb4GeneralCi, "😀", "😺", 0},
+		{Utf8mb4GeneralCi, "", " ", 0},
+
+		{Latin1SwedishCi, "a", "A", 0},
+		{Latin1SwedishCi, "é", "E", 0},
+		{Latin1SwedishCi, "z", "å", -1},
+		{Latin1SwedishCi, "å", "ä", -1},
+		{Latin1SwedishCi, "ä", "ö", -1},
+		{Latin1SwedishCi, "ü", "y", 0},
+		{Latin1SwedishCi, "abc", "abc ", 0},
+		{Latin1SwedishCi, "\xe9", "E", 0},
+
+		{Utf8mb4_0900AiCi, "a", "A", 0},
+		{Utf8mb4_0900AiCi, "Résumé", "resume", 0},
+		{Utf8mb4_0900AiCi, "é", "f", -1},
+		{Utf8mb4_0900AiCi, "a", "a ", -1},
+		{Utf8mb4_0900AiCi, "apple", "Banana", -1},
+	}
+	for _, tc := range tcases {
+		coll := LookupByID(tc.collation)
+		t.Run(fmt.Sprintf("%s/%s/%s", coll.Name(), tc.left, tc.right), func(t *testing.T) {
+			assert.Equal(t, tc.want, coll.Collate([]byte(tc.left), []byte(tc.right)))
+			assert.Equal(t, -tc.want, coll.Collate([]byte(tc.right), []byte(tc.left)))
+
+			// equal values must have the same weight strings
+			left := coll.WeightString(nil, []byte(tc.left))
+			right := coll.WeightString(nil, []byte(tc.right))
+			assert.Equal(t, tc.want == 0, bytes.Equal(left, right))
+		})
+	}
+}
+
+func TestWeightStringAppends(t *testing.T) {
+	for _, id := range []ID{Binary, Utf8mb4Bin, Utf8mb4GeneralCi, Latin1SwedishCi, Utf8mb4_0900AiCi} {
+		coll := LookupByID(id)
+		ws := coll.WeightString(nil, []byte("abc"))
+		prefix := []byte("prefix")
+		got := coll.WeightString(append([]byte{}, prefix...), []byte("abc"))
+		assert.Equal(t, append(prefix, ws...), got, coll.Name())
+	}
+}
+
+func TestComparePadded(t *testing.T) {
+	pad := []byte{0, ' '}
+	assert.Equal(t, 0, comparePadded([]byte{0, 'a'}, []byte{0, 'a', 0, ' ', 0, ' '}, pad))
+	assert.Equal(t, 1, comparePadded([]byte{0, 'a', 0, 'b'}, []byte{0, 'a'}, pad))
+	assert.Equal(t, -1, comparePadded([]byte{0, 'a', 0, '\t'}, []byte{0, 'a'}, pad))
+}
+
+func TestPadWeightString(t *testing.T) {
+	values := []string{"", " ", "\t", "a", "a ", "a  ", "a\t", "a \t", "a  \t", "a b", "a  b", "ab", "a\tb", "b", "A"}
+	for _, id := range []ID{Utf8mb4Bin, Utf8mb4GeneralCi, Latin1SwedishCi} {
+		coll := LookupByID(id)
+		for _, left := range values {
+			for _, right := range values {
+				want := coll.Collate([]byte(left), []byte(right))
+				got := bytes.Compare(coll.WeightString(nil, []byte(left)), coll.WeightString(nil, []byte(right)))
+				assert.Equal(t, want, got, "%s: %q %q", coll.Name(), left, right)
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"unicode/utf8"
+)
+
+// sortOrderLatin1SwedishCi is the sort_order_latin1 table of MySQL (strings/ctype-extra.cc):
+// the weight of every latin1 character in latin1_swedish_ci.
+var sortOrderLatin1SwedishCi = [256]byte{
+	0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F,
+	0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1A, 0x1B, 0x1C, 0x1D, 0x1E, 0x1F,
+	0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
+	0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
+	0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
+	0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
+	0x60, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
+	0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5A, 0x7B, 0x7C, 0x7D, 0x7E, 0x7F,
+	0x80, 0x81, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88, 0x89, 0x8A, 0x8B, 0x8C, 0x8D, 0x8E, 0x8F,
+	0x90, 0x91, 0x92, 0x93, 0x94, 0x95, 0x96, 0x97, 0x98, 0x99, 0x9A, 0x9B, 0x9C, 0x9D, 0x9E, 0x9F,
+	0xA0, 0xA1, 0xA2, 0xA3, 0xA4, 0xA5, 0xA6, 0xA7, 0xA8, 0xA9, 0xAA, 0xAB, 0xAC, 0xAD, 0xAE, 0xAF,
+	0xB0, 0xB1, 0xB2, 0xB3, 0xB4, 0xB5, 0xB6, 0xB7, 0xB8, 0xB9, 0xBA, 0xBB, 0xBC, 0xBD, 0xBE, 0xBF,
+	0x41, 0x41, 0x41, 0x41, 0x5C, 0x5B, 0x5C, 0x43, 0x45, 0x45, 0x45, 0x45, 0x49, 0x49, 0x49, 0x49,
+	0x44, 0x4E, 0x4F, 0x4F, 0x4F, 0x4F, 0x5D, 0xD7, 0xD8, 0x55, 0x55, 0x55, 0x59, 0x59, 0xDE, 0xDF,
+	0x41, 0x41, 0x41, 0x41, 0x5C, 0x5B, 0x5C, 0x43, 0x45, 0x45, 0x45, 0x45, 0x49, 0x49, 0x49, 0x49,
+	0x44, 0x4E, 0x4F, 0x4F, 0x4F, 0x4F, 0x5D, 0xF7, 0xD8, 0x55, 0x55, 0x55, 0x59, 0x59, 0xDE, 0xFF,
+}
+
+// latin1Pad is the weight of the space character in latin1_swedish_ci
+var latin1Pad = []byte{' '}
+
+// collationLatin1SwedishCi is the default collation of latin1. It is case insensitive and
+// ignores trailing spaces (PAD SPACE).
+//
+// The values returned by the tablets are converted to the character set of the connection,
+// which is utf8: valid UTF-8 values are decoded first, and the characters that do not exist
+// in latin1 weigh like '?'. Values that are not valid UTF-8 are considered as latin1 bytes.
+type collationLatin1SwedishCi struct{}
+
+func (c *collationLatin1SwedishCi) ID() ID {
+	return Latin1SwedishCi
+}
+
+func (c *collationLatin1SwedishCi) Name() string {
+	return "latin1_swedish_ci"
+}
+
+func (c *collationLatin1SwedishCi) Collate(left, right []byte) int {
+	return comparePadded(c.weights(nil, left), c.weights(nil, right), latin1Pad)
+}
+
+func (c *collationLatin1SwedishCi) WeightString(dst, src []byte) []byte {
+	return padWeightString(dst, c.weights(nil, src), latin1Pad)
+}
+
+func (c *collationLatin1SwedishCi) weights(dst, src []byte) []byte {
+	if !utf8.Valid(src) {
+		for _, b := range src {
+			dst = append(dst, sortOrderLatin1SwedishCi[b])
+		}
+		return dst
+	}
+	for _, r := range string(src) {
+		if r > 0xFF {
+			r = '?'
+		}
+		dst = append(dst, sortOrderLatin1SwedishCi[r])
+	}
+	return dst
+}
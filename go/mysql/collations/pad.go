@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"bytes"
+)
+
+// comparePadded compares two weight strings of a PAD SPACE collation: the shorter
+// weight string is compared as if it was padded with the weight of the space character.
+// This means that 'a' and 'a   ' are equal, but that 'a\t' sorts before 'a'.
+func comparePadded(left, right, pad []byte) int {
+	n := len(left)
+	if len(right) < n {
+		n = len(right)
+	}
+	if cmp := bytes.Compare(left[:n], right[:n]); cmp != 0 {
+		return cmp
+	}
+	switch {
+	case len(left) > n:
+		return compareWithPadding(left[n:], pad)
+	case len(right) > n:
+		return -compareWithPadding(right[n:], pad)
+	}
+	return 0
+}
+
+// compareWithPadding compares the rest of a weight string with the padding weight
+func compareWithPadding(rest, pad []byte) int {
+	for len(rest) >= len(pad) {
+		if cmp := bytes.Compare(rest[:len(pad)], pad); cmp != 0 {
+			return cmp
+		}
+		rest = rest[len(pad):]
+	}
+	if len(rest) > 0 {
+		return bytes.Compare(rest, pad)
+	}
+	return 0
+}
+
+// The weight strings of PAD SPACE collations are made of the weights that are not
+// padding weights, each prefixed by the number of padding weights before it, and
+// end with padEnd. The end of a string compares like an infinite run of padding
+// weights: the weights lower than the padding weight sort before padEnd, and the
+// higher ones after it.
+const (
+	padBelow = 0
+	padEnd   = 1
+	padAbove = 2
+)
+
+// padWeightString appends to dst the weight string of a PAD SPACE collation
+// computed from the weights of a string. Two weight strings compare with
+// bytes.Compare like comparePadded compares the weights, so 'a' and 'a   '
+// have the same weight string, and 'a\t' sorts before 'a'.
+func padWeightString(dst, weights, pad []byte) []byte {
+	var pads uint32
+	for ; len(weights) >= len(pad); weights = weights[len(pad):] {
+		w := weights[:len(pad)]
+		cmp := bytes.Compare(w, pad)
+		if cmp == 0 {
+			pads++
+			continue
+		}
+		// the more padding weights there are before a weight, the further it is from padEnd
+		if cmp < 0 {
+			dst = append(dst, padBelow, byte(pads>>24), byte(pads>>16), byte(pads>>8), byte(pads))
+		} else {
+			dst = append(dst, padAbove, ^byte(pads>>24), ^byte(pads>>16), ^byte(pads>>8), ^byte(pads))
+		}
+		dst = append(dst, w...)
+		pads = 0
+	}
+	return append(dst, padEnd)
+}
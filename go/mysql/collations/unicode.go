@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// generalCiPad is the weight of the space character in utf8mb4_general_ci
+var generalCiPad = []byte{0, ' '}
+
+var (
+	generalCiOnce    sync.Once
+	generalCiWeights []uint16
+)
+
+// generalCiWeight returns the weight of a character in utf8mb4_general_ci. Like MySQL,
+// the weight of a character of the Basic Multilingual Plane is the upper case version
+// of the character without its accents, and all the supplementary characters are equal.
+func generalCiWeight(r rune) uint16 {
+	if r > 0xFFFF {
+		return 0xFFFD
+	}
+	generalCiOnce.Do(buildGeneralCiWeights)
+	return generalCiWeights[r]
+}
+
+func buildGeneralCiWeights() {
+	generalCiWeights = make([]uint16, 0x10000)
+	for r := rune(0); r <= 0xFFFF; r++ {
+		base := r
+		if utf8.ValidRune(r) {
+			// the first character of the canonical decomposition is the character without its accents
+			base, _ = utf8.DecodeRuneInString(norm.NFD.String(string(r)))
+		}
+		upper := unicode.ToUpper(base)
+		switch {
+		case r == 'ß':
+			upper = 'S'
+		case upper > 0xFFFF:
+			upper = 0xFFFD
+		}
+		generalCiWeights[r] = uint16(upper)
+	}
+}
+
+// collationUtf8mb4GeneralCi is the default collation of utf8mb4 in MySQL 5.7.
+// It is case and accent insensitive, and ignores trailing spaces (PAD SPACE).
+type collationUtf8mb4GeneralCi struct{}
+
+func (c *collationUtf8mb4GeneralCi) ID() ID {
+	return Utf8mb4GeneralCi
+}
+
+func (c *collationUtf8mb4GeneralCi) Name() string {
+	return "utf8mb4_general_ci"
+}
+
+func (c *collationUtf8mb4GeneralCi) Collate(left, right []byte) int {
+	return comparePadded(c.weights(nil, left), c.weights(nil, right), generalCiPad)
+}
+
+func (c *collationUtf8mb4GeneralCi) WeightString(dst, src []byte) []byte {
+	return padWeightString(dst, c.weights(nil, src), generalCiPad)
+}
+
+func (c *collationUtf8mb4GeneralCi) weights(dst, src []byte) []byte {
+	for len(src) > 0 {
+		r, size := utf8.DecodeRune(src)
+		src = src[size:]
+		w := generalCiWeight(r)
+		dst = append(dst, byte(w>>8), byte(w))
+	}
+	return dst
+}
+
+// collationUca0900AiCi is the default collation of utf8mb4 in MySQL 8.0. It implements
+// the Unicode Collation Algorithm at the primary level, which makes it case and accent
+// insensitive. Unlike the older collations, trailing spaces are significant (NO PAD).
+type collationUca0900AiCi struct {
+	pool sync.Pool
+}
+
+// pooledCollator pairs a Collator and a Buffer, which cannot be used concurrently.
+type pooledCollator struct {
+	col *collate.Collator
+	buf *collate.Buffer
+}
+
+func newCollationUca0900AiCi() *collationUca0900AiCi {
+	return &collationUca0900AiCi{
+		pool: sync.Pool{New: func() interface{} {
+			return &pooledCollator{
+				// the root locale implements the default Unicode order of the DUCET;
+				// collate.Loose ignores case, accents and width
+				col: collate.New(language.Und, collate.Loose),
+				buf: new(collate.Buffer),
+			}
+		}},
+	}
+}
+
+func (c *collationUca0900AiCi) ID() ID {
+	return Utf8mb4_0900AiCi
+}
+
+func (c *collationUca0900AiCi) Name() string {
+	return "utf8mb4_0900_ai_ci"
+}
+
+func (c *collationUca0900AiCi) Collate(left, right []byte) int {
+	collator := c.pool.Get().(*pooledCollator)
+	defer c.pool.Put(collator)
+	return collator.col.Compare(left, right)
+}
+
+func (c *collationUca0900AiCi) WeightString(dst, src []byte) []byte {
+	collator := c.pool.Get().(*pooledCollator)
+	defer c.pool.Put(collator)
+	// the key points into the buffer of the collator, so it must be copied before
+	// the collator goes back to the pool
+	dst = append(dst, collator.col.Key(collator.buf, src)...)
+	collator.buf.Reset()
+	return dst
+}
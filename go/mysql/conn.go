@@ -201,6 +201,9 @@ type PrepareData struct {
 	BindVars    map[string]*querypb.BindVariable
 	StatementID uint32
 	ParamsCount uint16
+	// HandlerData is kept by the Handler across the executions
+	// of the statement, until the statement is closed.
+	HandlerData interface{}
 }
 
 // execResult is an enum signifying the result of executing a query
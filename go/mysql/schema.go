@@ -30,8 +30,8 @@ import (
 // data.
 
 const (
-	// BaseShowPrimary is the base query for fetching primary key info.
-	BaseShowPrimary = "SELECT table_name, column_name FROM information_schema.key_column_usage WHERE table_schema=database() AND constraint_name='PRIMARY' ORDER BY table_name, ordinal_position"
+	// BaseShowPrimary is the base query for fetching primary key and unique key info.
+	BaseShowPrimary = "SELECT k.table_name, k.column_name, k.constraint_name FROM information_schema.key_column_usage k JOIN information_schema.table_constraints c ON c.table_schema = k.table_schema AND c.table_name = k.table_name AND c.constraint_name = k.constraint_name WHERE k.table_schema=database() AND c.constraint_type IN ('PRIMARY KEY', 'UNIQUE') ORDER BY k.table_name, k.constraint_name, k.ordinal_position"
 	// BaseShowTableUniqueKey returns names of colunms covered by a given unique constraint on a given table, in key order
 	BaseShowTableUniqueKey = "SELECT column_name as column_name FROM information_schema.key_column_usage WHERE table_schema=database() AND table_name=%a AND constraint_name=%a ORDER BY ordinal_position"
 	// ShowRowsRead is the query used to find the number of rows read.
@@ -194,12 +194,21 @@ var ShowPrimaryFields = []*querypb.Field{{
 }, {
 	Name: "column_name",
 	Type: sqltypes.VarChar,
+}, {
+	Name: "constraint_name",
+	Type: sqltypes.VarChar,
 }}
 
 // ShowPrimaryRow returns a row for a primary key column.
 func ShowPrimaryRow(tableName, colName string) []sqltypes.Value {
+	return ShowUniqueKeyRow(tableName, "PRIMARY", colName)
+}
+
+// ShowUniqueKeyRow returns a row for a column of a unique key.
+func ShowUniqueKeyRow(tableName, keyName, colName string) []sqltypes.Value {
 	return []sqltypes.Value{
 		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(tableName)),
 		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(colName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(keyName)),
 	}
 }
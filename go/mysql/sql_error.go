@@ -158,6 +158,7 @@ var stateToMysqlCode = map[vterrors.State]struct {
 }{
 	vterrors.Undefined:                    {num: ERUnknownError, state: SSUnknownSQLState},
 	vterrors.AccessDeniedError:            {num: ERAccessDeniedError, state: SSAccessDeniedError},
+	vterrors.KillDeniedError:              {num: ERKillDenied, state: SSUnknownSQLState},
 	vterrors.BadDb:                        {num: ERBadDb, state: SSClientError},
 	vterrors.BadFieldError:                {num: ERBadFieldError, state: SSBadFieldError},
 	vterrors.BadTableError:                {num: ERBadTable, state: SSUnknownTable},
@@ -194,6 +195,7 @@ var stateToMysqlCode = map[vterrors.State]struct {
 	vterrors.CantDoThisInTransaction:      {num: ERCantDoThisDuringAnTransaction, state: SSCantDoThisDuringAnTransaction},
 	vterrors.RequiresPrimaryKey:           {num: ERRequiresPrimaryKey, state: SSClientError},
 	vterrors.NoSuchSession:                {num: ERUnknownComError, state: SSNetError},
+	vterrors.NoSuchThread:                 {num: ERNoSuchThread, state: SSUnknownSQLState},
 }
 
 func init() {
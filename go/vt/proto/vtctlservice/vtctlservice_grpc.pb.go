@@ -142,6 +142,9 @@ type VtctldClient interface {
 	// cells within the group (alias). Only primary traffic can be routed across
 	// cells not in the same group (alias).
 	AddCellsAlias(ctx context.Context, in *vtctldata.AddCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.AddCellsAliasResponse, error)
+	// AddVindexRange adds a range to a numeric_range or time_range vindex,
+	// while holding the keyspace lock.
+	AddVindexRange(ctx context.Context, in *vtctldata.AddVindexRangeRequest, opts ...grpc.CallOption) (*vtctldata.AddVindexRangeResponse, error)
 	// ApplyRoutingRules applies the VSchema routing rules.
 	ApplyRoutingRules(ctx context.Context, in *vtctldata.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyRoutingRulesResponse, error)
 	// ApplyVSchema applies a vschema to a keyspace.
@@ -177,6 +180,22 @@ type VtctldClient interface {
 	DeleteSrvVSchema(ctx context.Context, in *vtctldata.DeleteSrvVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.DeleteSrvVSchemaResponse, error)
 	// DeleteTablets deletes one or more tablets from the topology.
 	DeleteTablets(ctx context.Context, in *vtctldata.DeleteTabletsRequest, opts ...grpc.CallOption) (*vtctldata.DeleteTabletsResponse, error)
+	// DistributedTransactionClearFailures resets the failure stats of the
+	// resolver of abandoned distributed transactions run by the vtctld.
+	DistributedTransactionClearFailures(ctx context.Context, in *vtctldata.DistributedTransactionClearFailuresRequest, opts ...grpc.CallOption) (*vtctldata.DistributedTransactionClearFailuresResponse, error)
+	// DistributedTransactionConclude commits a distributed transaction on all
+	// its participants if the decision to commit it was made, and rolls it back
+	// otherwise.
+	DistributedTransactionConclude(ctx context.Context, in *vtctldata.DistributedTransactionConcludeRequest, opts ...grpc.CallOption) (*vtctldata.DistributedTransactionConcludeResponse, error)
+	// DistributedTransactionList lists the unresolved distributed transactions
+	// whose metadata is stored in a keyspace.
+	DistributedTransactionList(ctx context.Context, in *vtctldata.DistributedTransactionListRequest, opts ...grpc.CallOption) (*vtctldata.DistributedTransactionListResponse, error)
+	// DistributedTransactionRead returns the state and the participants of a
+	// distributed transaction.
+	DistributedTransactionRead(ctx context.Context, in *vtctldata.DistributedTransactionReadRequest, opts ...grpc.CallOption) (*vtctldata.DistributedTransactionReadResponse, error)
+	// DistributedTransactionRollback rolls back a distributed transaction on all
+	// its participants, unless the decision to commit it was already made.
+	DistributedTransactionRollback(ctx context.Context, in *vtctldata.DistributedTransactionRollbackRequest, opts ...grpc.CallOption) (*vtctldata.DistributedTransactionRollbackResponse, error)
 	// EmergencyReparentShard reparents the shard to the new primary. It assumes
 	// the old primary is dead or otherwise not responding.
 	EmergencyReparentShard(ctx context.Context, in *vtctldata.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.EmergencyReparentShardResponse, error)
@@ -230,6 +249,18 @@ type VtctldClient interface {
 	// PlannedReparentShard or EmergencyReparentShard should be used in those
 	// cases instead.
 	InitShardPrimary(ctx context.Context, in *vtctldata.InitShardPrimaryRequest, opts ...grpc.CallOption) (*vtctldata.InitShardPrimaryResponse, error)
+	// LookupVindexCreate creates a write_only lookup vindex and starts the
+	// workflow backfilling its lookup table.
+	LookupVindexCreate(ctx context.Context, in *vtctldata.LookupVindexCreateRequest, opts ...grpc.CallOption) (*vtctldata.LookupVindexCreateResponse, error)
+	// LookupVindexExternalize verifies the lookup table of a lookup vindex, and
+	// takes the vindex out of write_only mode if it matches its owner table.
+	LookupVindexExternalize(ctx context.Context, in *vtctldata.LookupVindexExternalizeRequest, opts ...grpc.CallOption) (*vtctldata.LookupVindexExternalizeResponse, error)
+	// LookupVindexShow returns the progress of the workflow backfilling the
+	// lookup table of a lookup vindex.
+	LookupVindexShow(ctx context.Context, in *vtctldata.LookupVindexShowRequest, opts ...grpc.CallOption) (*vtctldata.LookupVindexShowResponse, error)
+	// LookupVindexVerify compares the lookup table of a lookup vindex with its
+	// owner table, like VDiff.
+	LookupVindexVerify(ctx context.Context, in *vtctldata.LookupVindexVerifyRequest, opts ...grpc.CallOption) (*vtctldata.LookupVindexVerifyResponse, error)
 	// PingTablet checks that the specified tablet is awake and responding to RPCs.
 	// This command can be blocked by other in-flight operations.
 	PingTablet(ctx context.Context, in *vtctldata.PingTabletRequest, opts ...grpc.CallOption) (*vtctldata.PingTabletResponse, error)
@@ -354,6 +385,15 @@ func (c *vtctldClient) AddCellsAlias(ctx context.Context, in *vtctldata.AddCells
 	return out, nil
 }
 
+func (c *vtctldClient) AddVindexRange(ctx context.Context, in *vtctldata.AddVindexRangeRequest, opts ...grpc.CallOption) (*vtctldata.AddVindexRangeResponse, error) {
+	out := new(vtctldata.AddVindexRangeResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/AddVindexRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldata.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyRoutingRulesResponse, error) {
 	out := new(vtctldata.ApplyRoutingRulesResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyRoutingRules", in, out, opts...)
@@ -453,6 +493,51 @@ func (c *vtctldClient) DeleteTablets(ctx context.Context, in *vtctldata.DeleteTa
 	return out, nil
 }
 
+func (c *vtctldClient) DistributedTransactionClearFailures(ctx context.Context, in *vtctldata.DistributedTransactionClearFailuresRequest, opts ...grpc.CallOption) (*vtctldata.DistributedTransactionClearFailuresResponse, error) {
+	out := new(vtctldata.DistributedTransactionClearFailuresResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DistributedTransactionClearFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) DistributedTransactionConclude(ctx context.Context, in *vtctldata.DistributedTransactionConcludeRequest, opts ...grpc.CallOption) (*vtctldata.DistributedTransactionConcludeResponse, error) {
+	out := new(vtctldata.DistributedTransactionConcludeResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DistributedTransactionConclude", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) DistributedTransactionList(ctx context.Context, in *vtctldata.DistributedTransactionListRequest, opts ...grpc.CallOption) (*vtctldata.DistributedTransactionListResponse, error) {
+	out := new(vtctldata.DistributedTransactionListResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DistributedTransactionList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) DistributedTransactionRead(ctx context.Context, in *vtctldata.DistributedTransactionReadRequest, opts ...grpc.CallOption) (*vtctldata.DistributedTransactionReadResponse, error) {
+	out := new(vtctldata.DistributedTransactionReadResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DistributedTransactionRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) DistributedTransactionRollback(ctx context.Context, in *vtctldata.DistributedTransactionRollbackRequest, opts ...grpc.CallOption) (*vtctldata.DistributedTransactionRollbackResponse, error) {
+	out := new(vtctldata.DistributedTransactionRollbackResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DistributedTransactionRollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldata.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.EmergencyReparentShardResponse, error) {
 	out := new(vtctldata.EmergencyReparentShardResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/EmergencyReparentShard", in, out, opts...)
@@ -633,6 +718,42 @@ func (c *vtctldClient) InitShardPrimary(ctx context.Context, in *vtctldata.InitS
 	return out, nil
 }
 
+func (c *vtctldClient) LookupVindexCreate(ctx context.Context, in *vtctldata.LookupVindexCreateRequest, opts ...grpc.CallOption) (*vtctldata.LookupVindexCreateResponse, error) {
+	out := new(vtctldata.LookupVindexCreateResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/LookupVindexCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) LookupVindexExternalize(ctx context.Context, in *vtctldata.LookupVindexExternalizeRequest, opts ...grpc.CallOption) (*vtctldata.LookupVindexExternalizeResponse, error) {
+	out := new(vtctldata.LookupVindexExternalizeResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/LookupVindexExternalize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) LookupVindexShow(ctx context.Context, in *vtctldata.LookupVindexShowRequest, opts ...grpc.CallOption) (*vtctldata.LookupVindexShowResponse, error) {
+	out := new(vtctldata.LookupVindexShowResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/LookupVindexShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) LookupVindexVerify(ctx context.Context, in *vtctldata.LookupVindexVerifyRequest, opts ...grpc.CallOption) (*vtctldata.LookupVindexVerifyResponse, error) {
+	out := new(vtctldata.LookupVindexVerifyResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/LookupVindexVerify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) PingTablet(ctx context.Context, in *vtctldata.PingTabletRequest, opts ...grpc.CallOption) (*vtctldata.PingTabletResponse, error) {
 	out := new(vtctldata.PingTabletResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/PingTablet", in, out, opts...)
@@ -872,6 +993,9 @@ type VtctldServer interface {
 	// cells within the group (alias). Only primary traffic can be routed across
 	// cells not in the same group (alias).
 	AddCellsAlias(context.Context, *vtctldata.AddCellsAliasRequest) (*vtctldata.AddCellsAliasResponse, error)
+	// AddVindexRange adds a range to a numeric_range or time_range vindex,
+	// while holding the keyspace lock.
+	AddVindexRange(context.Context, *vtctldata.AddVindexRangeRequest) (*vtctldata.AddVindexRangeResponse, error)
 	// ApplyRoutingRules applies the VSchema routing rules.
 	ApplyRoutingRules(context.Context, *vtctldata.ApplyRoutingRulesRequest) (*vtctldata.ApplyRoutingRulesResponse, error)
 	// ApplyVSchema applies a vschema to a keyspace.
@@ -907,6 +1031,22 @@ type VtctldServer interface {
 	DeleteSrvVSchema(context.Context, *vtctldata.DeleteSrvVSchemaRequest) (*vtctldata.DeleteSrvVSchemaResponse, error)
 	// DeleteTablets deletes one or more tablets from the topology.
 	DeleteTablets(context.Context, *vtctldata.DeleteTabletsRequest) (*vtctldata.DeleteTabletsResponse, error)
+	// DistributedTransactionClearFailures resets the failure stats of the
+	// resolver of abandoned distributed transactions run by the vtctld.
+	DistributedTransactionClearFailures(context.Context, *vtctldata.DistributedTransactionClearFailuresRequest) (*vtctldata.DistributedTransactionClearFailuresResponse, error)
+	// DistributedTransactionConclude commits a distributed transaction on all
+	// its participants if the decision to commit it was made, and rolls it back
+	// otherwise.
+	DistributedTransactionConclude(context.Context, *vtctldata.DistributedTransactionConcludeRequest) (*vtctldata.DistributedTransactionConcludeResponse, error)
+	// DistributedTransactionList lists the unresolved distributed transactions
+	// whose metadata is stored in a keyspace.
+	DistributedTransactionList(context.Context, *vtctldata.DistributedTransactionListRequest) (*vtctldata.DistributedTransactionListResponse, error)
+	// DistributedTransactionRead returns the state and the participants of a
+	// distributed transaction.
+	DistributedTransactionRead(context.Context, *vtctldata.DistributedTransactionReadRequest) (*vtctldata.DistributedTransactionReadResponse, error)
+	// DistributedTransactionRollback rolls back a distributed transaction on all
+	// its participants, unless the decision to commit it was already made.
+	DistributedTransactionRollback(context.Context, *vtctldata.DistributedTransactionRollbackRequest) (*vtctldata.DistributedTransactionRollbackResponse, error)
 	// EmergencyReparentShard reparents the shard to the new primary. It assumes
 	// the old primary is dead or otherwise not responding.
 	EmergencyReparentShard(context.Context, *vtctldata.EmergencyReparentShardRequest) (*vtctldata.EmergencyReparentShardResponse, error)
@@ -960,6 +1100,18 @@ type VtctldServer interface {
 	// PlannedReparentShard or EmergencyReparentShard should be used in those
 	// cases instead.
 	InitShardPrimary(context.Context, *vtctldata.InitShardPrimaryRequest) (*vtctldata.InitShardPrimaryResponse, error)
+	// LookupVindexCreate creates a write_only lookup vindex and starts the
+	// workflow backfilling its lookup table.
+	LookupVindexCreate(context.Context, *vtctldata.LookupVindexCreateRequest) (*vtctldata.LookupVindexCreateResponse, error)
+	// LookupVindexExternalize verifies the lookup table of a lookup vindex, and
+	// takes the vindex out of write_only mode if it matches its owner table.
+	LookupVindexExternalize(context.Context, *vtctldata.LookupVindexExternalizeRequest) (*vtctldata.LookupVindexExternalizeResponse, error)
+	// LookupVindexShow returns the progress of the workflow backfilling the
+	// lookup table of a lookup vindex.
+	LookupVindexShow(context.Context, *vtctldata.LookupVindexShowRequest) (*vtctldata.LookupVindexShowResponse, error)
+	// LookupVindexVerify compares the lookup table of a lookup vindex with its
+	// owner table, like VDiff.
+	LookupVindexVerify(context.Context, *vtctldata.LookupVindexVerifyRequest) (*vtctldata.LookupVindexVerifyResponse, error)
 	// PingTablet checks that the specified tablet is awake and responding to RPCs.
 	// This command can be blocked by other in-flight operations.
 	PingTablet(context.Context, *vtctldata.PingTabletRequest) (*vtctldata.PingTabletResponse, error)
@@ -1069,6 +1221,9 @@ func (UnimplementedVtctldServer) AddCellInfo(context.Context, *vtctldata.AddCell
 func (UnimplementedVtctldServer) AddCellsAlias(context.Context, *vtctldata.AddCellsAliasRequest) (*vtctldata.AddCellsAliasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCellsAlias not implemented")
 }
+func (UnimplementedVtctldServer) AddVindexRange(context.Context, *vtctldata.AddVindexRangeRequest) (*vtctldata.AddVindexRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVindexRange not implemented")
+}
 func (UnimplementedVtctldServer) ApplyRoutingRules(context.Context, *vtctldata.ApplyRoutingRulesRequest) (*vtctldata.ApplyRoutingRulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyRoutingRules not implemented")
 }
@@ -1102,6 +1257,21 @@ func (UnimplementedVtctldServer) DeleteSrvVSchema(context.Context, *vtctldata.De
 func (UnimplementedVtctldServer) DeleteTablets(context.Context, *vtctldata.DeleteTabletsRequest) (*vtctldata.DeleteTabletsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTablets not implemented")
 }
+func (UnimplementedVtctldServer) DistributedTransactionClearFailures(context.Context, *vtctldata.DistributedTransactionClearFailuresRequest) (*vtctldata.DistributedTransactionClearFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributedTransactionClearFailures not implemented")
+}
+func (UnimplementedVtctldServer) DistributedTransactionConclude(context.Context, *vtctldata.DistributedTransactionConcludeRequest) (*vtctldata.DistributedTransactionConcludeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributedTransactionConclude not implemented")
+}
+func (UnimplementedVtctldServer) DistributedTransactionList(context.Context, *vtctldata.DistributedTransactionListRequest) (*vtctldata.DistributedTransactionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributedTransactionList not implemented")
+}
+func (UnimplementedVtctldServer) DistributedTransactionRead(context.Context, *vtctldata.DistributedTransactionReadRequest) (*vtctldata.DistributedTransactionReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributedTransactionRead not implemented")
+}
+func (UnimplementedVtctldServer) DistributedTransactionRollback(context.Context, *vtctldata.DistributedTransactionRollbackRequest) (*vtctldata.DistributedTransactionRollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributedTransactionRollback not implemented")
+}
 func (UnimplementedVtctldServer) EmergencyReparentShard(context.Context, *vtctldata.EmergencyReparentShardRequest) (*vtctldata.EmergencyReparentShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EmergencyReparentShard not implemented")
 }
@@ -1162,6 +1332,18 @@ func (UnimplementedVtctldServer) GetWorkflows(context.Context, *vtctldata.GetWor
 func (UnimplementedVtctldServer) InitShardPrimary(context.Context, *vtctldata.InitShardPrimaryRequest) (*vtctldata.InitShardPrimaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitShardPrimary not implemented")
 }
+func (UnimplementedVtctldServer) LookupVindexCreate(context.Context, *vtctldata.LookupVindexCreateRequest) (*vtctldata.LookupVindexCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupVindexCreate not implemented")
+}
+func (UnimplementedVtctldServer) LookupVindexExternalize(context.Context, *vtctldata.LookupVindexExternalizeRequest) (*vtctldata.LookupVindexExternalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupVindexExternalize not implemented")
+}
+func (UnimplementedVtctldServer) LookupVindexShow(context.Context, *vtctldata.LookupVindexShowRequest) (*vtctldata.LookupVindexShowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupVindexShow not implemented")
+}
+func (UnimplementedVtctldServer) LookupVindexVerify(context.Context, *vtctldata.LookupVindexVerifyRequest) (*vtctldata.LookupVindexVerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupVindexVerify not implemented")
+}
 func (UnimplementedVtctldServer) PingTablet(context.Context, *vtctldata.PingTabletRequest) (*vtctldata.PingTabletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PingTablet not implemented")
 }
@@ -1286,6 +1468,24 @@ func _Vtctld_AddCellsAlias_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_AddVindexRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.AddVindexRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).AddVindexRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/AddVindexRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).AddVindexRange(ctx, req.(*vtctldata.AddVindexRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_ApplyRoutingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.ApplyRoutingRulesRequest)
 	if err := dec(in); err != nil {
@@ -1484,6 +1684,96 @@ func _Vtctld_DeleteTablets_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_DistributedTransactionClearFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DistributedTransactionClearFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DistributedTransactionClearFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DistributedTransactionClearFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DistributedTransactionClearFailures(ctx, req.(*vtctldata.DistributedTransactionClearFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DistributedTransactionConclude_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DistributedTransactionConcludeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DistributedTransactionConclude(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DistributedTransactionConclude",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DistributedTransactionConclude(ctx, req.(*vtctldata.DistributedTransactionConcludeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DistributedTransactionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DistributedTransactionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DistributedTransactionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DistributedTransactionList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DistributedTransactionList(ctx, req.(*vtctldata.DistributedTransactionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DistributedTransactionRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DistributedTransactionReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DistributedTransactionRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DistributedTransactionRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DistributedTransactionRead(ctx, req.(*vtctldata.DistributedTransactionReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DistributedTransactionRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DistributedTransactionRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DistributedTransactionRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DistributedTransactionRollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DistributedTransactionRollback(ctx, req.(*vtctldata.DistributedTransactionRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_EmergencyReparentShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.EmergencyReparentShardRequest)
 	if err := dec(in); err != nil {
@@ -1844,6 +2134,78 @@ func _Vtctld_InitShardPrimary_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_LookupVindexCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.LookupVindexCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).LookupVindexCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/LookupVindexCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).LookupVindexCreate(ctx, req.(*vtctldata.LookupVindexCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_LookupVindexExternalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.LookupVindexExternalizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).LookupVindexExternalize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/LookupVindexExternalize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).LookupVindexExternalize(ctx, req.(*vtctldata.LookupVindexExternalizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_LookupVindexShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.LookupVindexShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).LookupVindexShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/LookupVindexShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).LookupVindexShow(ctx, req.(*vtctldata.LookupVindexShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_LookupVindexVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.LookupVindexVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).LookupVindexVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/LookupVindexVerify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).LookupVindexVerify(ctx, req.(*vtctldata.LookupVindexVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_PingTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.PingTabletRequest)
 	if err := dec(in); err != nil {
@@ -2309,6 +2671,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddCellsAlias",
 			Handler:    _Vtctld_AddCellsAlias_Handler,
 		},
+		{
+			MethodName: "AddVindexRange",
+			Handler:    _Vtctld_AddVindexRange_Handler,
+		},
 		{
 			MethodName: "ApplyRoutingRules",
 			Handler:    _Vtctld_ApplyRoutingRules_Handler,
@@ -2353,6 +2719,26 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTablets",
 			Handler:    _Vtctld_DeleteTablets_Handler,
 		},
+		{
+			MethodName: "DistributedTransactionClearFailures",
+			Handler:    _Vtctld_DistributedTransactionClearFailures_Handler,
+		},
+		{
+			MethodName: "DistributedTransactionConclude",
+			Handler:    _Vtctld_DistributedTransactionConclude_Handler,
+		},
+		{
+			MethodName: "DistributedTransactionList",
+			Handler:    _Vtctld_DistributedTransactionList_Handler,
+		},
+		{
+			MethodName: "DistributedTransactionRead",
+			Handler:    _Vtctld_DistributedTransactionRead_Handler,
+		},
+		{
+			MethodName: "DistributedTransactionRollback",
+			Handler:    _Vtctld_DistributedTransactionRollback_Handler,
+		},
 		{
 			MethodName: "EmergencyReparentShard",
 			Handler:    _Vtctld_EmergencyReparentShard_Handler,
@@ -2433,6 +2819,22 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InitShardPrimary",
 			Handler:    _Vtctld_InitShardPrimary_Handler,
 		},
+		{
+			MethodName: "LookupVindexCreate",
+			Handler:    _Vtctld_LookupVindexCreate_Handler,
+		},
+		{
+			MethodName: "LookupVindexExternalize",
+			Handler:    _Vtctld_LookupVindexExternalize_Handler,
+		},
+		{
+			MethodName: "LookupVindexShow",
+			Handler:    _Vtctld_LookupVindexShow_Handler,
+		},
+		{
+			MethodName: "LookupVindexVerify",
+			Handler:    _Vtctld_LookupVindexVerify_Handler,
+		},
 		{
 			MethodName: "PingTablet",
 			Handler:    _Vtctld_PingTablet_Handler,
@@ -63,6 +63,7 @@ const (
 	StmtCallProc
 	StmtRevert
 	StmtShowMigrationLogs
+	StmtKill
 )
 
 //ASTToStatementType returns a StatementType from an AST stmt
@@ -116,6 +117,8 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtStream
 	case *VStream:
 		return StmtVStream
+	case *Kill:
+		return StmtKill
 	default:
 		return StmtUnknown
 	}
@@ -227,6 +230,8 @@ func Preview(sql string) StatementType {
 		return StmtDDL
 	case "flush":
 		return StmtFlush
+	case "kill":
+		return StmtKill
 	case "set":
 		return StmtSet
 	case "show":
@@ -299,6 +304,8 @@ func (s StatementType) String() string {
 		return "FLUSH"
 	case StmtCallProc:
 		return "CALL_PROC"
+	case StmtKill:
+		return "KILL"
 	default:
 		return "UNKNOWN"
 	}
@@ -78,6 +78,7 @@ func TestPreview(t *testing.T) {
 		{"revoke", StmtPriv},
 		{"truncate", StmtDDL},
 		{"flush", StmtFlush},
+		{"kill 1", StmtKill},
 		{"unknown", StmtUnknown},
 
 		{"/* leading comment */ select ...", StmtSelect},
@@ -51,6 +51,8 @@ type (
 		GetColumnCount() int
 		SetComments(comments Comments)
 		GetComments() Comments
+		SetWith(with *With)
+		GetWith() *With
 	}
 
 	// DDLStatement represents any DDL Statement
@@ -213,10 +215,26 @@ type (
 		Where       *Where
 		GroupBy     GroupBy
 		Having      *Where
+		Windows     WindowDefinitions
 		OrderBy     OrderBy
 		Limit       *Limit
 		Lock        Lock
 		Into        *SelectInto
+		With        *With
+	}
+
+	// With represents the WITH clause of a query, holding the list of
+	// common table expressions and whether they may reference themselves.
+	With struct {
+		CTEs      []*CommonTableExpr
+		Recursive bool
+	}
+
+	// CommonTableExpr represents a single named subquery in a WITH clause.
+	CommonTableExpr struct {
+		TableID  TableIdent
+		Columns  Columns
+		Subquery *Subquery
 	}
 
 	// SelectInto is a struct that represent the INTO part of a select query
@@ -245,6 +263,7 @@ type (
 		Limit    *Limit
 		Lock     Lock
 		Into     *SelectInto
+		With     *With
 	}
 
 	// VStream represents a VSTREAM statement.
@@ -385,6 +404,15 @@ type (
 		ForExport    bool
 	}
 
+	// KillType is an enum for Kill.Type
+	KillType int8
+
+	// Kill represents a KILL statement.
+	Kill struct {
+		Type          KillType
+		ProcesslistID uint64
+	}
+
 	// RenameTablePair represents the name of the original table and what it is going to be set in a RENAME TABLE statement.
 	RenameTablePair struct {
 		FromTable TableName
@@ -600,6 +628,7 @@ func (*Set) iStatement()               {}
 func (*SetTransaction) iStatement()    {}
 func (*DropDatabase) iStatement()      {}
 func (*Flush) iStatement()             {}
+func (*Kill) iStatement()              {}
 func (*Show) iStatement()              {}
 func (*Use) iStatement()               {}
 func (*Begin) iStatement()             {}
@@ -1899,6 +1928,14 @@ type (
 		Limit     *Limit
 	}
 
+	// WindowFuncExpr represents a call to a window function, or to an aggregate
+	// function used as a window function, e.g. ROW_NUMBER() OVER (PARTITION BY a ORDER BY b)
+	WindowFuncExpr struct {
+		Name  ColIdent
+		Exprs SelectExprs
+		Over  *OverClause
+	}
+
 	// ValuesFuncExpr represents a function call.
 	ValuesFuncExpr struct {
 		Name *ColName
@@ -2008,6 +2045,7 @@ func (*SubstrExpr) iExpr()        {}
 func (*ConvertUsingExpr) iExpr()  {}
 func (*MatchExpr) iExpr()         {}
 func (*GroupConcatExpr) iExpr()   {}
+func (*WindowFuncExpr) iExpr()    {}
 func (*Default) iExpr()           {}
 func (*ExtractedSubquery) iExpr() {}
 
@@ -2046,6 +2084,50 @@ type Order struct {
 // OrderDirection is an enum for the direction in which to order - asc or desc.
 type OrderDirection int8
 
+// OverClause represents the OVER clause of a window function call. It either
+// names a window defined in the WINDOW clause or specifies the window inline.
+type OverClause struct {
+	WindowName ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// WindowSpecification represents the specification of a window:
+// [existing_window_name] [PARTITION BY ...] [ORDER BY ...] [frame_clause]
+type WindowSpecification struct {
+	Name            ColIdent
+	PartitionClause Exprs
+	OrderClause     OrderBy
+	FrameClause     *FrameClause
+}
+
+// FrameClause represents the frame of a window: {ROWS | RANGE} frame_extent
+type FrameClause struct {
+	Unit  FrameUnitType
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FrameUnitType is an enum for FrameClause.Unit - rows or range.
+type FrameUnitType int8
+
+// FramePoint represents one of the boundaries of a window frame.
+type FramePoint struct {
+	Type FramePointType
+	Expr Expr
+}
+
+// FramePointType is an enum for FramePoint.Type
+type FramePointType int8
+
+// WindowDefinition represents a named window in the WINDOW clause.
+type WindowDefinition struct {
+	Name       ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// WindowDefinitions represents the WINDOW clause of a SELECT.
+type WindowDefinitions []*WindowDefinition
+
 // Limit represents a LIMIT clause.
 type Limit struct {
 	Offset, Rowcount Expr
@@ -87,6 +87,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneComments(in)
 	case *Commit:
 		return CloneRefOfCommit(in)
+	case *CommonTableExpr:
+		return CloneRefOfCommonTableExpr(in)
 	case *ComparisonExpr:
 		return CloneRefOfComparisonExpr(in)
 	case *ConstraintDefinition:
@@ -137,6 +139,10 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfForce(in)
 	case *ForeignKeyDefinition:
 		return CloneRefOfForeignKeyDefinition(in)
+	case *FrameClause:
+		return CloneRefOfFrameClause(in)
+	case *FramePoint:
+		return CloneRefOfFramePoint(in)
 	case *FuncExpr:
 		return CloneRefOfFuncExpr(in)
 	case GroupBy:
@@ -163,6 +169,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfJoinTableExpr(in)
 	case *KeyState:
 		return CloneRefOfKeyState(in)
+	case *Kill:
+		return CloneRefOfKill(in)
 	case *Limit:
 		return CloneRefOfLimit(in)
 	case ListArg:
@@ -201,6 +209,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfOtherAdmin(in)
 	case *OtherRead:
 		return CloneRefOfOtherRead(in)
+	case *OverClause:
+		return CloneRefOfOverClause(in)
 	case *ParenTableExpr:
 		return CloneRefOfParenTableExpr(in)
 	case *PartitionDefinition:
@@ -317,6 +327,16 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfWhen(in)
 	case *Where:
 		return CloneRefOfWhere(in)
+	case *WindowDefinition:
+		return CloneRefOfWindowDefinition(in)
+	case WindowDefinitions:
+		return CloneWindowDefinitions(in)
+	case *WindowFuncExpr:
+		return CloneRefOfWindowFuncExpr(in)
+	case *WindowSpecification:
+		return CloneRefOfWindowSpecification(in)
+	case *With:
+		return CloneRefOfWith(in)
 	case *XorExpr:
 		return CloneRefOfXorExpr(in)
 	default:
@@ -623,6 +643,18 @@ func CloneRefOfCommit(n *Commit) *Commit {
 	return &out
 }
 
+// CloneRefOfCommonTableExpr creates a deep clone of the input.
+func CloneRefOfCommonTableExpr(n *CommonTableExpr) *CommonTableExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.TableID = CloneTableIdent(n.TableID)
+	out.Columns = CloneColumns(n.Columns)
+	out.Subquery = CloneRefOfSubquery(n.Subquery)
+	return &out
+}
+
 // CloneRefOfComparisonExpr creates a deep clone of the input.
 func CloneRefOfComparisonExpr(n *ComparisonExpr) *ComparisonExpr {
 	if n == nil {
@@ -899,6 +931,27 @@ func CloneRefOfForeignKeyDefinition(n *ForeignKeyDefinition) *ForeignKeyDefiniti
 	return &out
 }
 
+// CloneRefOfFrameClause creates a deep clone of the input.
+func CloneRefOfFrameClause(n *FrameClause) *FrameClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Start = CloneRefOfFramePoint(n.Start)
+	out.End = CloneRefOfFramePoint(n.End)
+	return &out
+}
+
+// CloneRefOfFramePoint creates a deep clone of the input.
+func CloneRefOfFramePoint(n *FramePoint) *FramePoint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
 // CloneRefOfFuncExpr creates a deep clone of the input.
 func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
 	if n == nil {
@@ -1035,6 +1088,15 @@ func CloneRefOfKeyState(n *KeyState) *KeyState {
 	return &out
 }
 
+// CloneRefOfKill creates a deep clone of the input.
+func CloneRefOfKill(n *Kill) *Kill {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfLimit creates a deep clone of the input.
 func CloneRefOfLimit(n *Limit) *Limit {
 	if n == nil {
@@ -1217,6 +1279,17 @@ func CloneRefOfOtherRead(n *OtherRead) *OtherRead {
 	return &out
 }
 
+// CloneRefOfOverClause creates a deep clone of the input.
+func CloneRefOfOverClause(n *OverClause) *OverClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.WindowName = CloneColIdent(n.WindowName)
+	out.WindowSpec = CloneRefOfWindowSpecification(n.WindowSpec)
+	return &out
+}
+
 // CloneRefOfParenTableExpr creates a deep clone of the input.
 func CloneRefOfParenTableExpr(n *ParenTableExpr) *ParenTableExpr {
 	if n == nil {
@@ -1384,9 +1457,11 @@ func CloneRefOfSelect(n *Select) *Select {
 	out.Where = CloneRefOfWhere(n.Where)
 	out.GroupBy = CloneGroupBy(n.GroupBy)
 	out.Having = CloneRefOfWhere(n.Having)
+	out.Windows = CloneWindowDefinitions(n.Windows)
 	out.OrderBy = CloneOrderBy(n.OrderBy)
 	out.Limit = CloneRefOfLimit(n.Limit)
 	out.Into = CloneRefOfSelectInto(n.Into)
+	out.With = CloneRefOfWith(n.With)
 	return &out
 }
 
@@ -1677,6 +1752,7 @@ func CloneRefOfUnion(n *Union) *Union {
 	out.OrderBy = CloneOrderBy(n.OrderBy)
 	out.Limit = CloneRefOfLimit(n.Limit)
 	out.Into = CloneRefOfSelectInto(n.Into)
+	out.With = CloneRefOfWith(n.With)
 	return &out
 }
 
@@ -1832,6 +1908,64 @@ func CloneRefOfWhere(n *Where) *Where {
 	return &out
 }
 
+// CloneRefOfWindowDefinition creates a deep clone of the input.
+func CloneRefOfWindowDefinition(n *WindowDefinition) *WindowDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.WindowSpec = CloneRefOfWindowSpecification(n.WindowSpec)
+	return &out
+}
+
+// CloneWindowDefinitions creates a deep clone of the input.
+func CloneWindowDefinitions(n WindowDefinitions) WindowDefinitions {
+	if n == nil {
+		return nil
+	}
+	res := make(WindowDefinitions, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfWindowDefinition(x))
+	}
+	return res
+}
+
+// CloneRefOfWindowFuncExpr creates a deep clone of the input.
+func CloneRefOfWindowFuncExpr(n *WindowFuncExpr) *WindowFuncExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Exprs = CloneSelectExprs(n.Exprs)
+	out.Over = CloneRefOfOverClause(n.Over)
+	return &out
+}
+
+// CloneRefOfWindowSpecification creates a deep clone of the input.
+func CloneRefOfWindowSpecification(n *WindowSpecification) *WindowSpecification {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.PartitionClause = CloneExprs(n.PartitionClause)
+	out.OrderClause = CloneOrderBy(n.OrderClause)
+	out.FrameClause = CloneRefOfFrameClause(n.FrameClause)
+	return &out
+}
+
+// CloneRefOfWith creates a deep clone of the input.
+func CloneRefOfWith(n *With) *With {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.CTEs = CloneSliceOfRefOfCommonTableExpr(n.CTEs)
+	return &out
+}
+
 // CloneRefOfXorExpr creates a deep clone of the input.
 func CloneRefOfXorExpr(n *XorExpr) *XorExpr {
 	if n == nil {
@@ -2073,6 +2207,8 @@ func CloneExpr(in Expr) Expr {
 		return CloneValTuple(in)
 	case *ValuesFuncExpr:
 		return CloneRefOfValuesFuncExpr(in)
+	case *WindowFuncExpr:
+		return CloneRefOfWindowFuncExpr(in)
 	case *XorExpr:
 		return CloneRefOfXorExpr(in)
 	default:
@@ -2211,6 +2347,8 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfFlush(in)
 	case *Insert:
 		return CloneRefOfInsert(in)
+	case *Kill:
+		return CloneRefOfKill(in)
 	case *Load:
 		return CloneRefOfLoad(in)
 	case *LockTables:
@@ -2568,6 +2706,18 @@ func CloneSliceOfVindexParam(n []VindexParam) []VindexParam {
 	return res
 }
 
+// CloneSliceOfRefOfCommonTableExpr creates a deep clone of the input.
+func CloneSliceOfRefOfCommonTableExpr(n []*CommonTableExpr) []*CommonTableExpr {
+	if n == nil {
+		return nil
+	}
+	res := make([]*CommonTableExpr, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfCommonTableExpr(x))
+	}
+	return res
+}
+
 // CloneCollateAndCharset creates a deep clone of the input.
 func CloneCollateAndCharset(n CollateAndCharset) CollateAndCharset {
 	return *CloneRefOfCollateAndCharset(&n)
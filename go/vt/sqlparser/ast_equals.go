@@ -218,6 +218,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfCommit(a, b)
+	case *CommonTableExpr:
+		b, ok := inB.(*CommonTableExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCommonTableExpr(a, b)
 	case *ComparisonExpr:
 		b, ok := inB.(*ComparisonExpr)
 		if !ok {
@@ -368,6 +374,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfForeignKeyDefinition(a, b)
+	case *FrameClause:
+		b, ok := inB.(*FrameClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFrameClause(a, b)
+	case *FramePoint:
+		b, ok := inB.(*FramePoint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFramePoint(a, b)
 	case *FuncExpr:
 		b, ok := inB.(*FuncExpr)
 		if !ok {
@@ -446,6 +464,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfKeyState(a, b)
+	case *Kill:
+		b, ok := inB.(*Kill)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfKill(a, b)
 	case *Limit:
 		b, ok := inB.(*Limit)
 		if !ok {
@@ -560,6 +584,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfOtherRead(a, b)
+	case *OverClause:
+		b, ok := inB.(*OverClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfOverClause(a, b)
 	case *ParenTableExpr:
 		b, ok := inB.(*ParenTableExpr)
 		if !ok {
@@ -908,6 +938,36 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfWhere(a, b)
+	case *WindowDefinition:
+		b, ok := inB.(*WindowDefinition)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowDefinition(a, b)
+	case WindowDefinitions:
+		b, ok := inB.(WindowDefinitions)
+		if !ok {
+			return false
+		}
+		return EqualsWindowDefinitions(a, b)
+	case *WindowFuncExpr:
+		b, ok := inB.(*WindowFuncExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowFuncExpr(a, b)
+	case *WindowSpecification:
+		b, ok := inB.(*WindowSpecification)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowSpecification(a, b)
+	case *With:
+		b, ok := inB.(*With)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWith(a, b)
 	case *XorExpr:
 		b, ok := inB.(*XorExpr)
 		if !ok {
@@ -1278,6 +1338,19 @@ func EqualsRefOfCommit(a, b *Commit) bool {
 	return true
 }
 
+// EqualsRefOfCommonTableExpr does deep equals between the two objects.
+func EqualsRefOfCommonTableExpr(a, b *CommonTableExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsTableIdent(a.TableID, b.TableID) &&
+		EqualsColumns(a.Columns, b.Columns) &&
+		EqualsRefOfSubquery(a.Subquery, b.Subquery)
+}
+
 // EqualsRefOfComparisonExpr does deep equals between the two objects.
 func EqualsRefOfComparisonExpr(a, b *ComparisonExpr) bool {
 	if a == b {
@@ -1611,6 +1684,31 @@ func EqualsRefOfForeignKeyDefinition(a, b *ForeignKeyDefinition) bool {
 		EqualsRefOfReferenceDefinition(a.ReferenceDefinition, b.ReferenceDefinition)
 }
 
+// EqualsRefOfFrameClause does deep equals between the two objects.
+func EqualsRefOfFrameClause(a, b *FrameClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Unit == b.Unit &&
+		EqualsRefOfFramePoint(a.Start, b.Start) &&
+		EqualsRefOfFramePoint(a.End, b.End)
+}
+
+// EqualsRefOfFramePoint does deep equals between the two objects.
+func EqualsRefOfFramePoint(a, b *FramePoint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsExpr(a.Expr, b.Expr)
+}
+
 // EqualsRefOfFuncExpr does deep equals between the two objects.
 func EqualsRefOfFuncExpr(a, b *FuncExpr) bool {
 	if a == b {
@@ -1774,6 +1872,18 @@ func EqualsRefOfKeyState(a, b *KeyState) bool {
 	return a.Enable == b.Enable
 }
 
+// EqualsRefOfKill does deep equals between the two objects.
+func EqualsRefOfKill(a, b *Kill) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.ProcesslistID == b.ProcesslistID &&
+		a.Type == b.Type
+}
+
 // EqualsRefOfLimit does deep equals between the two objects.
 func EqualsRefOfLimit(a, b *Limit) bool {
 	if a == b {
@@ -1984,6 +2094,18 @@ func EqualsRefOfOtherRead(a, b *OtherRead) bool {
 	return true
 }
 
+// EqualsRefOfOverClause does deep equals between the two objects.
+func EqualsRefOfOverClause(a, b *OverClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.WindowName, b.WindowName) &&
+		EqualsRefOfWindowSpecification(a.WindowSpec, b.WindowSpec)
+}
+
 // EqualsRefOfParenTableExpr does deep equals between the two objects.
 func EqualsRefOfParenTableExpr(a, b *ParenTableExpr) bool {
 	if a == b {
@@ -2179,10 +2301,12 @@ func EqualsRefOfSelect(a, b *Select) bool {
 		EqualsRefOfWhere(a.Where, b.Where) &&
 		EqualsGroupBy(a.GroupBy, b.GroupBy) &&
 		EqualsRefOfWhere(a.Having, b.Having) &&
+		EqualsWindowDefinitions(a.Windows, b.Windows) &&
 		EqualsOrderBy(a.OrderBy, b.OrderBy) &&
 		EqualsRefOfLimit(a.Limit, b.Limit) &&
 		a.Lock == b.Lock &&
-		EqualsRefOfSelectInto(a.Into, b.Into)
+		EqualsRefOfSelectInto(a.Into, b.Into) &&
+		EqualsRefOfWith(a.With, b.With)
 }
 
 // EqualsSelectExprs does deep equals between the two objects.
@@ -2521,7 +2645,8 @@ func EqualsRefOfUnion(a, b *Union) bool {
 		EqualsOrderBy(a.OrderBy, b.OrderBy) &&
 		EqualsRefOfLimit(a.Limit, b.Limit) &&
 		a.Lock == b.Lock &&
-		EqualsRefOfSelectInto(a.Into, b.Into)
+		EqualsRefOfSelectInto(a.Into, b.Into) &&
+		EqualsRefOfWith(a.With, b.With)
 }
 
 // EqualsRefOfUnlockTables does deep equals between the two objects.
@@ -2694,6 +2819,70 @@ func EqualsRefOfWhere(a, b *Where) bool {
 		EqualsExpr(a.Expr, b.Expr)
 }
 
+// EqualsRefOfWindowDefinition does deep equals between the two objects.
+func EqualsRefOfWindowDefinition(a, b *WindowDefinition) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsRefOfWindowSpecification(a.WindowSpec, b.WindowSpec)
+}
+
+// EqualsWindowDefinitions does deep equals between the two objects.
+func EqualsWindowDefinitions(a, b WindowDefinitions) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfWindowDefinition(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsRefOfWindowFuncExpr does deep equals between the two objects.
+func EqualsRefOfWindowFuncExpr(a, b *WindowFuncExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsSelectExprs(a.Exprs, b.Exprs) &&
+		EqualsRefOfOverClause(a.Over, b.Over)
+}
+
+// EqualsRefOfWindowSpecification does deep equals between the two objects.
+func EqualsRefOfWindowSpecification(a, b *WindowSpecification) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsExprs(a.PartitionClause, b.PartitionClause) &&
+		EqualsOrderBy(a.OrderClause, b.OrderClause) &&
+		EqualsRefOfFrameClause(a.FrameClause, b.FrameClause)
+}
+
+// EqualsRefOfWith does deep equals between the two objects.
+func EqualsRefOfWith(a, b *With) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Recursive == b.Recursive &&
+		EqualsSliceOfRefOfCommonTableExpr(a.CTEs, b.CTEs)
+}
+
 // EqualsRefOfXorExpr does deep equals between the two objects.
 func EqualsRefOfXorExpr(a, b *XorExpr) bool {
 	if a == b {
@@ -3240,6 +3429,12 @@ func EqualsExpr(inA, inB Expr) bool {
 			return false
 		}
 		return EqualsRefOfValuesFuncExpr(a, b)
+	case *WindowFuncExpr:
+		b, ok := inB.(*WindowFuncExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowFuncExpr(a, b)
 	case *XorExpr:
 		b, ok := inB.(*XorExpr)
 		if !ok {
@@ -3528,6 +3723,12 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfInsert(a, b)
+	case *Kill:
+		b, ok := inB.(*Kill)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfKill(a, b)
 	case *Load:
 		b, ok := inB.(*Load)
 		if !ok {
@@ -4036,6 +4237,19 @@ func EqualsSliceOfVindexParam(a, b []VindexParam) bool {
 	return true
 }
 
+// EqualsSliceOfRefOfCommonTableExpr does deep equals between the two objects.
+func EqualsSliceOfRefOfCommonTableExpr(a, b []*CommonTableExpr) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfCommonTableExpr(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsCollateAndCharset does deep equals between the two objects.
 func EqualsCollateAndCharset(a, b CollateAndCharset) bool {
 	return a.IsDefault == b.IsDefault &&
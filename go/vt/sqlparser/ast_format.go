@@ -17,6 +17,7 @@ limitations under the License.
 package sqlparser
 
 import (
+	"strconv"
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -24,7 +25,7 @@ import (
 
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "select %v", node.Comments)
+	buf.astPrintf(node, "%vselect %v", node.With, node.Comments)
 
 	if node.Distinct {
 		buf.WriteString(DistinctStr)
@@ -51,14 +52,15 @@ func (node *Select) Format(buf *TrackedBuffer) {
 		prefix = ", "
 	}
 
-	buf.astPrintf(node, "%v%v%v%v%v%s%v",
+	buf.astPrintf(node, "%v%v%v%v%v%v%s%v",
 		node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock.ToString(), node.Into)
 }
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v", node.With)
 	if requiresParen(node.Left) {
 		buf.astPrintf(node, "(%v)", node.Left)
 	} else {
@@ -82,6 +84,28 @@ func (node *Union) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "%v%v%s", node.OrderBy, node.Limit, node.Lock.ToString())
 }
 
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString("with ")
+	if node.Recursive {
+		buf.WriteString("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.CTEs {
+		buf.astPrintf(node, "%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.WriteString(" ")
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v%v as %v", node.TableID, node.Columns, node.Subquery)
+}
+
 // Format formats the node.
 func (node *VStream) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "vstream %v%v from %v",
@@ -165,6 +189,12 @@ func (node *DropDatabase) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "%s %vdatabase %s%v", DropStr, node.Comments, exists, node.DBName)
 }
 
+// Format formats the node.
+func (node *Kill) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s %s ", KillStr, node.Type.ToString())
+	buf.WriteString(strconv.FormatUint(node.ProcesslistID, 10))
+}
+
 // Format formats the node.
 func (node *Flush) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "%s", FlushStr)
@@ -666,7 +696,7 @@ func (node *ShowLegacy) Format(buf *TrackedBuffer) {
 	nodeType := strings.ToLower(node.Type)
 	if (nodeType == "tables" || nodeType == "columns" || nodeType == "fields" || nodeType == "index" || nodeType == "keys" || nodeType == "indexes" ||
 		nodeType == "databases" || nodeType == "schemas" || nodeType == "keyspaces" || nodeType == "vitess_keyspaces" || nodeType == "vitess_replication_status" ||
-		nodeType == "vitess_shards" || nodeType == "vitess_tablets") && node.ShowTablesOpt != nil {
+		nodeType == "vitess_shards" || nodeType == "vitess_tablets" || nodeType == "vitess_plans" || nodeType == "vitess_query_stats") && node.ShowTablesOpt != nil {
 		opt := node.ShowTablesOpt
 		if node.Extended != "" {
 			buf.astPrintf(node, "show %s%s", node.Extended, nodeType)
@@ -1125,6 +1155,85 @@ func (node *GroupConcatExpr) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node.
+func (node *WindowFuncExpr) Format(buf *TrackedBuffer) {
+	funcName := node.Name.String()
+	if containEscapableChars(funcName, NoAt) {
+		writeEscapedString(buf, funcName)
+	} else {
+		buf.WriteString(funcName)
+	}
+	buf.astPrintf(node, "(%v) %v", node.Exprs, node.Over)
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node.WindowSpec == nil {
+		buf.astPrintf(node, "over %v", node.WindowName)
+		return
+	}
+	buf.astPrintf(node, "over (%v)", node.WindowSpec)
+}
+
+// Format formats the node.
+func (node *WindowSpecification) Format(buf *TrackedBuffer) {
+	prefix := ""
+	if !node.Name.IsEmpty() {
+		buf.astPrintf(node, "%v", node.Name)
+		prefix = " "
+	}
+	if len(node.PartitionClause) > 0 {
+		buf.astPrintf(node, "%spartition by %v", prefix, node.PartitionClause)
+		prefix = " "
+	}
+	if len(node.OrderClause) > 0 {
+		buf.astPrintf(node, "%sorder by ", prefix)
+		for i, order := range node.OrderClause {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.astPrintf(node, "%v", order)
+		}
+		prefix = " "
+	}
+	if node.FrameClause != nil {
+		buf.astPrintf(node, "%s%v", prefix, node.FrameClause)
+	}
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.astPrintf(node, "%s %v", node.Unit.ToString(), node.Start)
+		return
+	}
+	buf.astPrintf(node, "%s between %v and %v", node.Unit.ToString(), node.Start, node.End)
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	switch node.Type {
+	case ExprPrecedingType, ExprFollowingType:
+		buf.astPrintf(node, "%v %s", node.Expr, node.Type.ToString())
+	default:
+		buf.WriteString(node.Type.ToString())
+	}
+}
+
+// Format formats the node.
+func (node *WindowDefinition) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v as (%v)", node.Name, node.WindowSpec)
+}
+
+// Format formats the node.
+func (node WindowDefinitions) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
 // Format formats the node.
 func (node *ValuesFuncExpr) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "values(%v)", node.Name)
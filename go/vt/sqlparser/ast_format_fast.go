@@ -18,6 +18,7 @@ limitations under the License.
 package sqlparser
 
 import (
+	"strconv"
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -25,6 +26,7 @@ import (
 
 // formatFast formats the node.
 func (node *Select) formatFast(buf *TrackedBuffer) {
+	node.With.formatFast(buf)
 	buf.WriteString("select ")
 	node.Comments.formatFast(buf)
 
@@ -61,6 +63,8 @@ func (node *Select) formatFast(buf *TrackedBuffer) {
 
 	node.Having.formatFast(buf)
 
+	node.Windows.formatFast(buf)
+
 	node.OrderBy.formatFast(buf)
 
 	node.Limit.formatFast(buf)
@@ -71,6 +75,7 @@ func (node *Select) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *Union) formatFast(buf *TrackedBuffer) {
+	node.With.formatFast(buf)
 	if requiresParen(node.Left) {
 		buf.WriteByte('(')
 		node.Left.formatFast(buf)
@@ -100,6 +105,32 @@ func (node *Union) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(node.Lock.ToString())
 }
 
+// formatFast formats the node.
+func (node *With) formatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString("with ")
+	if node.Recursive {
+		buf.WriteString("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.CTEs {
+		buf.WriteString(prefix)
+		cte.formatFast(buf)
+		prefix = ", "
+	}
+	buf.WriteString(" ")
+}
+
+// formatFast formats the node.
+func (node *CommonTableExpr) formatFast(buf *TrackedBuffer) {
+	node.TableID.formatFast(buf)
+	node.Columns.formatFast(buf)
+	buf.WriteString(" as ")
+	node.Subquery.formatFast(buf)
+}
+
 // formatFast formats the node.
 func (node *VStream) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("vstream ")
@@ -263,6 +294,15 @@ func (node *DropDatabase) formatFast(buf *TrackedBuffer) {
 	node.DBName.formatFast(buf)
 }
 
+// formatFast formats the node.
+func (node *Kill) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(KillStr)
+	buf.WriteByte(' ')
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte(' ')
+	buf.WriteString(strconv.FormatUint(node.ProcesslistID, 10))
+}
+
 // formatFast formats the node.
 func (node *Flush) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(FlushStr)
@@ -929,7 +969,7 @@ func (node *ShowLegacy) formatFast(buf *TrackedBuffer) {
 	nodeType := strings.ToLower(node.Type)
 	if (nodeType == "tables" || nodeType == "columns" || nodeType == "fields" || nodeType == "index" || nodeType == "keys" || nodeType == "indexes" ||
 		nodeType == "databases" || nodeType == "schemas" || nodeType == "keyspaces" || nodeType == "vitess_keyspaces" || nodeType == "vitess_replication_status" ||
-		nodeType == "vitess_shards" || nodeType == "vitess_tablets") && node.ShowTablesOpt != nil {
+		nodeType == "vitess_shards" || nodeType == "vitess_tablets" || nodeType == "vitess_plans" || nodeType == "vitess_query_stats") && node.ShowTablesOpt != nil {
 		opt := node.ShowTablesOpt
 		if node.Extended != "" {
 			buf.WriteString("show ")
@@ -1513,6 +1553,107 @@ func (node *GroupConcatExpr) formatFast(buf *TrackedBuffer) {
 	}
 }
 
+// formatFast formats the node.
+func (node *WindowFuncExpr) formatFast(buf *TrackedBuffer) {
+	funcName := node.Name.String()
+	if containEscapableChars(funcName, NoAt) {
+		writeEscapedString(buf, funcName)
+	} else {
+		buf.WriteString(funcName)
+	}
+	buf.WriteByte('(')
+	node.Exprs.formatFast(buf)
+	buf.WriteString(") ")
+	node.Over.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *OverClause) formatFast(buf *TrackedBuffer) {
+	if node.WindowSpec == nil {
+		buf.WriteString("over ")
+		node.WindowName.formatFast(buf)
+		return
+	}
+	buf.WriteString("over (")
+	node.WindowSpec.formatFast(buf)
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node.
+func (node *WindowSpecification) formatFast(buf *TrackedBuffer) {
+	prefix := ""
+	if !node.Name.IsEmpty() {
+		node.Name.formatFast(buf)
+		prefix = " "
+	}
+	if len(node.PartitionClause) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("partition by ")
+		node.PartitionClause.formatFast(buf)
+		prefix = " "
+	}
+	if len(node.OrderClause) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("order by ")
+		for i, order := range node.OrderClause {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			order.formatFast(buf)
+		}
+		prefix = " "
+	}
+	if node.FrameClause != nil {
+		buf.WriteString(prefix)
+		node.FrameClause.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *FrameClause) formatFast(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.WriteString(node.Unit.ToString())
+		buf.WriteByte(' ')
+		node.Start.formatFast(buf)
+		return
+	}
+	buf.WriteString(node.Unit.ToString())
+	buf.WriteString(" between ")
+	node.Start.formatFast(buf)
+	buf.WriteString(" and ")
+	node.End.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *FramePoint) formatFast(buf *TrackedBuffer) {
+	switch node.Type {
+	case ExprPrecedingType, ExprFollowingType:
+		node.Expr.formatFast(buf)
+		buf.WriteByte(' ')
+		buf.WriteString(node.Type.ToString())
+	default:
+		buf.WriteString(node.Type.ToString())
+	}
+}
+
+// formatFast formats the node.
+func (node *WindowDefinition) formatFast(buf *TrackedBuffer) {
+	node.Name.formatFast(buf)
+	buf.WriteString(" as (")
+	node.WindowSpec.formatFast(buf)
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node.
+func (node WindowDefinitions) formatFast(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = ", "
+	}
+}
+
 // formatFast formats the node.
 func (node *ValuesFuncExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("values(")
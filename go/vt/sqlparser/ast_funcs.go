@@ -19,6 +19,7 @@ package sqlparser
 import (
 	"encoding/hex"
 	"encoding/json"
+	"strconv"
 	"strings"
 
 	"vitess.io/vitess/go/hack"
@@ -514,6 +515,13 @@ func (node *FuncExpr) IsAggregate() bool {
 	return Aggregates[node.Name.Lowered()]
 }
 
+// IsWindowAggregate returns true if the function is an aggregate that can be
+// used as a window function. GROUP_CONCAT is the only one that cannot.
+func IsWindowAggregate(name ColIdent) bool {
+	lowered := name.Lowered()
+	return Aggregates[lowered] && lowered != "group_concat"
+}
+
 // NewColIdent makes a new ColIdent.
 func NewColIdent(str string) ColIdent {
 	return ColIdent{
@@ -785,6 +793,16 @@ func (node *Select) GetComments() Comments {
 	return node.Comments
 }
 
+// SetWith implements the SelectStatement interface
+func (node *Select) SetWith(with *With) {
+	node.With = with
+}
+
+// GetWith implements the SelectStatement interface
+func (node *Select) GetWith() *With {
+	return node.With
+}
+
 // AddWhere adds the boolean expression to the
 // WHERE clause as an AND condition.
 func (node *Select) AddWhere(expr Expr) {
@@ -878,12 +896,22 @@ func (node *Union) GetComments() Comments {
 	return node.Left.GetComments()
 }
 
+// SetWith implements the SelectStatement interface
+func (node *Union) SetWith(with *With) {
+	node.With = with
+}
+
+// GetWith implements the SelectStatement interface
+func (node *Union) GetWith() *With {
+	return node.With
+}
+
 func requiresParen(stmt SelectStatement) bool {
 	switch node := stmt.(type) {
 	case *Union:
-		return len(node.OrderBy) != 0 || node.Lock != 0 || node.Into != nil || node.Limit != nil
+		return len(node.OrderBy) != 0 || node.Lock != 0 || node.Into != nil || node.Limit != nil || node.With != nil
 	case *Select:
-		return len(node.OrderBy) != 0 || node.Lock != 0 || node.Into != nil || node.Limit != nil
+		return len(node.OrderBy) != 0 || node.Lock != 0 || node.Into != nil || node.Limit != nil || node.With != nil
 	}
 
 	return false
@@ -1159,6 +1187,36 @@ func (dir OrderDirection) ToString() string {
 	}
 }
 
+// ToString returns the unit as a string
+func (unit FrameUnitType) ToString() string {
+	switch unit {
+	case RowsUnit:
+		return RowsStr
+	case RangeUnit:
+		return RangeStr
+	default:
+		return "Unknown FrameUnitType"
+	}
+}
+
+// ToString returns the type as a string
+func (typ FramePointType) ToString() string {
+	switch typ {
+	case CurrentRowType:
+		return CurrentRowStr
+	case UnboundedPrecedingType:
+		return UnboundedPrecedingStr
+	case UnboundedFollowingType:
+		return UnboundedFollowingStr
+	case ExprPrecedingType:
+		return ExprPrecedingStr
+	case ExprFollowingType:
+		return ExprFollowingStr
+	default:
+		return "Unknown FramePointType"
+	}
+}
+
 // ToString returns the operator as a string
 func (op ConvertTypeOperator) ToString() string {
 	switch op {
@@ -1205,6 +1263,24 @@ func (ty ExplainType) ToString() string {
 	}
 }
 
+// ToString returns the type as a string
+func (ty KillType) ToString() string {
+	switch ty {
+	case ConnectionType:
+		return ConnectionStr
+	case QueryType:
+		return QueryStr
+	default:
+		return "Unknown KillType"
+	}
+}
+
+// parseProcesslistID parses the processlist id of a KILL statement.
+func parseProcesslistID(id string) (uint64, bool) {
+	val, err := strconv.ParseUint(id, 10, 64)
+	return val, err == nil
+}
+
 // ToString returns the type as a string
 func (sel SelectIntoType) ToString() string {
 	switch sel {
@@ -1385,7 +1461,7 @@ func handleUnaryMinus(expr Expr) Expr {
 			num.Val = num.Val[1:]
 			return num
 		}
-		return NewIntLiteral("-" + num.Val)
+		return &Literal{Type: num.Type, Val: "-" + num.Val}
 	}
 	if unaryExpr, ok := expr.(*UnaryExpr); ok && unaryExpr.Operator == UMinusOp {
 		return unaryExpr.Expr
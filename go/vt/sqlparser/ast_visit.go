@@ -86,6 +86,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitComments(in, f)
 	case *Commit:
 		return VisitRefOfCommit(in, f)
+	case *CommonTableExpr:
+		return VisitRefOfCommonTableExpr(in, f)
 	case *ComparisonExpr:
 		return VisitRefOfComparisonExpr(in, f)
 	case *ConstraintDefinition:
@@ -136,6 +138,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfForce(in, f)
 	case *ForeignKeyDefinition:
 		return VisitRefOfForeignKeyDefinition(in, f)
+	case *FrameClause:
+		return VisitRefOfFrameClause(in, f)
+	case *FramePoint:
+		return VisitRefOfFramePoint(in, f)
 	case *FuncExpr:
 		return VisitRefOfFuncExpr(in, f)
 	case GroupBy:
@@ -162,6 +168,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfJoinTableExpr(in, f)
 	case *KeyState:
 		return VisitRefOfKeyState(in, f)
+	case *Kill:
+		return VisitRefOfKill(in, f)
 	case *Limit:
 		return VisitRefOfLimit(in, f)
 	case ListArg:
@@ -200,6 +208,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfOtherAdmin(in, f)
 	case *OtherRead:
 		return VisitRefOfOtherRead(in, f)
+	case *OverClause:
+		return VisitRefOfOverClause(in, f)
 	case *ParenTableExpr:
 		return VisitRefOfParenTableExpr(in, f)
 	case *PartitionDefinition:
@@ -316,6 +326,16 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfWhen(in, f)
 	case *Where:
 		return VisitRefOfWhere(in, f)
+	case *WindowDefinition:
+		return VisitRefOfWindowDefinition(in, f)
+	case WindowDefinitions:
+		return VisitWindowDefinitions(in, f)
+	case *WindowFuncExpr:
+		return VisitRefOfWindowFuncExpr(in, f)
+	case *WindowSpecification:
+		return VisitRefOfWindowSpecification(in, f)
+	case *With:
+		return VisitRefOfWith(in, f)
 	case *XorExpr:
 		return VisitRefOfXorExpr(in, f)
 	default:
@@ -718,6 +738,24 @@ func VisitRefOfCommit(in *Commit, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfCommonTableExpr(in *CommonTableExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableIdent(in.TableID, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.Columns, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfSubquery(in.Subquery, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfComparisonExpr(in *ComparisonExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -1086,6 +1124,33 @@ func VisitRefOfForeignKeyDefinition(in *ForeignKeyDefinition, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfFrameClause(in *FrameClause, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfFramePoint(in.Start, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfFramePoint(in.End, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfFramePoint(in *FramePoint, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfFuncExpr(in *FuncExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -1270,6 +1335,15 @@ func VisitRefOfKeyState(in *KeyState, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfKill(in *Kill, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
 func VisitRefOfLimit(in *Limit, f Visit) error {
 	if in == nil {
 		return nil
@@ -1481,6 +1555,21 @@ func VisitRefOfOtherRead(in *OtherRead, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfOverClause(in *OverClause, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.WindowName, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWindowSpecification(in.WindowSpec, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfParenTableExpr(in *ParenTableExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -1713,6 +1802,9 @@ func VisitRefOfSelect(in *Select, f Visit) error {
 	if err := VisitRefOfWhere(in.Having, f); err != nil {
 		return err
 	}
+	if err := VisitWindowDefinitions(in.Windows, f); err != nil {
+		return err
+	}
 	if err := VisitOrderBy(in.OrderBy, f); err != nil {
 		return err
 	}
@@ -1722,6 +1814,9 @@ func VisitRefOfSelect(in *Select, f Visit) error {
 	if err := VisitRefOfSelectInto(in.Into, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfWith(in.With, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitSelectExprs(in SelectExprs, f Visit) error {
@@ -2105,6 +2200,9 @@ func VisitRefOfUnion(in *Union, f Visit) error {
 	if err := VisitRefOfSelectInto(in.Into, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfWith(in.With, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfUnlockTables(in *UnlockTables, f Visit) error {
@@ -2313,6 +2411,88 @@ func VisitRefOfWhere(in *Where, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfWindowDefinition(in *WindowDefinition, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWindowSpecification(in.WindowSpec, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitWindowDefinitions(in WindowDefinitions, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitRefOfWindowDefinition(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfWindowFuncExpr(in *WindowFuncExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitSelectExprs(in.Exprs, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfOverClause(in.Over, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfWindowSpecification(in *WindowSpecification, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitExprs(in.PartitionClause, f); err != nil {
+		return err
+	}
+	if err := VisitOrderBy(in.OrderClause, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfFrameClause(in.FrameClause, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfWith(in *With, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.CTEs {
+		if err := VisitRefOfCommonTableExpr(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfXorExpr(in *XorExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -2543,6 +2723,8 @@ func VisitExpr(in Expr, f Visit) error {
 		return VisitValTuple(in, f)
 	case *ValuesFuncExpr:
 		return VisitRefOfValuesFuncExpr(in, f)
+	case *WindowFuncExpr:
+		return VisitRefOfWindowFuncExpr(in, f)
 	case *XorExpr:
 		return VisitRefOfXorExpr(in, f)
 	default:
@@ -2669,6 +2851,8 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfFlush(in, f)
 	case *Insert:
 		return VisitRefOfInsert(in, f)
+	case *Kill:
+		return VisitRefOfKill(in, f)
 	case *Load:
 		return VisitRefOfLoad(in, f)
 	case *LockTables:
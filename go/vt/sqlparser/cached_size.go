@@ -543,6 +543,27 @@ func (cached *ColumnTypeOptions) CachedSize(alloc bool) int64 {
 	size += cached.Reference.CachedSize(true)
 	return size
 }
+func (cached *CommonTableExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field TableID vitess.io/vitess/go/vt/sqlparser.TableIdent
+	size += cached.TableID.CachedSize(false)
+	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Subquery *vitess.io/vitess/go/vt/sqlparser.Subquery
+	size += cached.Subquery.CachedSize(true)
+	return size
+}
 func (cached *ComparisonExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -997,6 +1018,34 @@ func (cached *ForeignKeyDefinition) CachedSize(alloc bool) int64 {
 	size += cached.ReferenceDefinition.CachedSize(true)
 	return size
 }
+func (cached *FrameClause) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Start *vitess.io/vitess/go/vt/sqlparser.FramePoint
+	size += cached.Start.CachedSize(true)
+	// field End *vitess.io/vitess/go/vt/sqlparser.FramePoint
+	size += cached.End.CachedSize(true)
+	return size
+}
+func (cached *FramePoint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *FuncExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1264,6 +1313,16 @@ func (cached *KeyState) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Kill) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	return size
+}
 func (cached *Limit) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1447,6 +1506,20 @@ func (cached *OrderByOption) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *OverClause) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field WindowName vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.WindowName.CachedSize(false)
+	// field WindowSpec *vitess.io/vitess/go/vt/sqlparser.WindowSpecification
+	size += cached.WindowSpec.CachedSize(true)
+	return size
+}
 func (cached *ParenTableExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1699,7 +1772,7 @@ func (cached *Select) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(176)
+		size += int64(208)
 	}
 	// field Cache *bool
 	size += hack.RuntimeAllocSize(int64(1))
@@ -1741,6 +1814,13 @@ func (cached *Select) CachedSize(alloc bool) int64 {
 	}
 	// field Having *vitess.io/vitess/go/vt/sqlparser.Where
 	size += cached.Having.CachedSize(true)
+	// field Windows vitess.io/vitess/go/vt/sqlparser.WindowDefinitions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Windows)) * int64(8))
+		for _, elem := range cached.Windows {
+			size += elem.CachedSize(true)
+		}
+	}
 	// field OrderBy vitess.io/vitess/go/vt/sqlparser.OrderBy
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(8))
@@ -1752,6 +1832,8 @@ func (cached *Select) CachedSize(alloc bool) int64 {
 	size += cached.Limit.CachedSize(true)
 	// field Into *vitess.io/vitess/go/vt/sqlparser.SelectInto
 	size += cached.Into.CachedSize(true)
+	// field With *vitess.io/vitess/go/vt/sqlparser.With
+	size += cached.With.CachedSize(true)
 	return size
 }
 func (cached *SelectInto) CachedSize(alloc bool) int64 {
@@ -2220,6 +2302,8 @@ func (cached *Union) CachedSize(alloc bool) int64 {
 	size += cached.Limit.CachedSize(true)
 	// field Into *vitess.io/vitess/go/vt/sqlparser.SelectInto
 	size += cached.Into.CachedSize(true)
+	// field With *vitess.io/vitess/go/vt/sqlparser.With
+	size += cached.With.CachedSize(true)
 	return size
 }
 func (cached *Update) CachedSize(alloc bool) int64 {
@@ -2410,6 +2494,90 @@ func (cached *Where) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *WindowDefinition) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field WindowSpec *vitess.io/vitess/go/vt/sqlparser.WindowSpecification
+	size += cached.WindowSpec.CachedSize(true)
+	return size
+}
+func (cached *WindowFuncExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Exprs vitess.io/vitess/go/vt/sqlparser.SelectExprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Exprs)) * int64(16))
+		for _, elem := range cached.Exprs {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field Over *vitess.io/vitess/go/vt/sqlparser.OverClause
+	size += cached.Over.CachedSize(true)
+	return size
+}
+func (cached *WindowSpecification) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field PartitionClause vitess.io/vitess/go/vt/sqlparser.Exprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.PartitionClause)) * int64(16))
+		for _, elem := range cached.PartitionClause {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field OrderClause vitess.io/vitess/go/vt/sqlparser.OrderBy
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.OrderClause)) * int64(8))
+		for _, elem := range cached.OrderClause {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field FrameClause *vitess.io/vitess/go/vt/sqlparser.FrameClause
+	size += cached.FrameClause.CachedSize(true)
+	return size
+}
+func (cached *With) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field CTEs []*vitess.io/vitess/go/vt/sqlparser.CommonTableExpr
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.CTEs)) * int64(8))
+		for _, elem := range cached.CTEs {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *XorExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
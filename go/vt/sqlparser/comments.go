@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -39,6 +40,10 @@ const (
 	DirectiveIgnoreMaxMemoryRows = "IGNORE_MAX_MEMORY_ROWS"
 	// DirectiveAllowScatter lets scatter plans pass through even when they are turned off by `no-scatter`.
 	DirectiveAllowScatter = "ALLOW_SCATTER"
+	// DirectiveCacheTTL caches the results of a SELECT in vtgate for the given duration.
+	DirectiveCacheTTL = "CACHE_TTL"
+	// DirectivePriorityClass assigns a query to a priority class of vttablet.
+	DirectivePriorityClass = "PRIORITY_CLASS"
 )
 
 func isNonSpace(r rune) bool {
@@ -405,3 +410,44 @@ func AllowScatterDirective(stmt Statement) bool {
 	}
 	return directives.IsSet(DirectiveAllowScatter)
 }
+
+// PriorityClassDirective returns the priority class the query is assigned to
+// by the priority class directive, or an empty string if it is not assigned.
+func PriorityClassDirective(stmt Statement) string {
+	var directives CommentDirectives
+	switch stmt := stmt.(type) {
+	case *Select:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Insert:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Update:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Delete:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	default:
+		return ""
+	}
+	return directives.GetString(DirectivePriorityClass, "")
+}
+
+// CacheTTLDirective returns the duration for which the results of a SELECT
+// can be cached, or 0 if they cannot. The value is a duration like 5s, or
+// a number of seconds.
+func CacheTTLDirective(stmt Statement) time.Duration {
+	sel, ok := stmt.(*Select)
+	if !ok {
+		return 0
+	}
+	directives := ExtractCommentDirectives(sel.Comments)
+	switch val := directives[DirectiveCacheTTL].(type) {
+	case int:
+		if val > 0 {
+			return time.Duration(val) * time.Second
+		}
+	case string:
+		if ttl, err := time.ParseDuration(val); err == nil && ttl > 0 {
+			return ttl
+		}
+	}
+	return 0
+}
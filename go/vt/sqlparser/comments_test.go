@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -418,6 +419,27 @@ func TestSkipQueryPlanCacheDirective(t *testing.T) {
 	}
 }
 
+func TestPriorityClassDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{"select /*vt+ PRIORITY_CLASS=batch */ * from users", "batch"},
+		{"select * from users", ""},
+		{"insert /*vt+ PRIORITY_CLASS=batch */ into user(id) values (1), (2)", "batch"},
+		{"update /*vt+ PRIORITY_CLASS=oltp */ users set name=1", "oltp"},
+		{"delete /*vt+ PRIORITY_CLASS=batch */ from users", "batch"},
+		{"show /*vt+ PRIORITY_CLASS=batch */ create table users", ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, _ := Parse(test.query)
+			assert.Equal(t, test.expected, PriorityClassDirective(stmt))
+		})
+	}
+}
+
 func TestIgnoreMaxPayloadSizeDirective(t *testing.T) {
 	testCases := []struct {
 		query    string
@@ -469,3 +491,26 @@ func TestIgnoreMaxMaxMemoryRowsDirective(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheTTLDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected time.Duration
+	}{
+		{"select /*vt+ CACHE_TTL=5s */ * from users", 5 * time.Second},
+		{"select /*vt+ CACHE_TTL=1m30s */ * from users", 90 * time.Second},
+		{"select /*vt+ CACHE_TTL=10 */ * from users", 10 * time.Second},
+		{"select /*vt+ CACHE_TTL=abc */ * from users", 0},
+		{"select /*vt+ CACHE_TTL=-5s */ * from users", 0},
+		{"select * from users", 0},
+		{"update /*vt+ CACHE_TTL=5s */ users set name=1", 0},
+		{"delete /*vt+ CACHE_TTL=5s */ from users", 0},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, _ := Parse(test.query)
+			assert.Equal(t, test.expected, CacheTTLDirective(stmt))
+		})
+	}
+}
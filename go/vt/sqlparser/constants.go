@@ -57,6 +57,7 @@ const (
 	RenameStr           = "rename"
 	TruncateStr         = "truncate"
 	FlushStr            = "flush"
+	KillStr             = "kill"
 	CreateVindexStr     = "create vindex"
 	DropVindexStr       = "drop vindex"
 	AddVschemaTableStr  = "add vschema table"
@@ -183,6 +184,17 @@ const (
 	AscScr  = "asc"
 	DescScr = "desc"
 
+	// FrameClause.Unit
+	RowsStr  = "rows"
+	RangeStr = "range"
+
+	// FramePoint.Type
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	ExprPrecedingStr      = "preceding"
+	ExprFollowingStr      = "following"
+
 	// SetExpr.Expr, for SET TRANSACTION ... or START TRANSACTION
 	// TransactionStr is the Name for a SET TRANSACTION statement
 	TransactionStr = "transaction"
@@ -204,6 +216,10 @@ const (
 	TraditionalStr = "traditional"
 	AnalyzeStr     = "analyze"
 
+	// Kill types
+	ConnectionStr = "connection"
+	QueryStr      = "query"
+
 	// Lock Types
 	ReadStr             = "read"
 	ReadLocalStr        = "read local"
@@ -404,6 +420,21 @@ const (
 	DescOrder
 )
 
+// Constant for Enum Type - FrameUnitType
+const (
+	RowsUnit FrameUnitType = iota
+	RangeUnit
+)
+
+// Constant for Enum Type - FramePointType
+const (
+	CurrentRowType FramePointType = iota
+	UnboundedPrecedingType
+	UnboundedFollowingType
+	ExprPrecedingType
+	ExprFollowingType
+)
+
 // Constant for Enum Type - ConvertTypeOperator
 const (
 	NoOperator ConvertTypeOperator = iota
@@ -436,6 +467,12 @@ const (
 	UpgradeAction
 )
 
+// Constant for Enum Type - KillType
+const (
+	ConnectionType KillType = iota
+	QueryType
+)
+
 // Constant for Enum Type - ExplainType
 const (
 	EmptyType ExplainType = iota
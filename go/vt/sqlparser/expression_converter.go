@@ -18,7 +18,11 @@ package sqlparser
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
@@ -27,6 +31,26 @@ var ErrExprNotSupported = fmt.Errorf("Expr Not Supported")
 
 //Convert converts between AST expressions and executable expressions
 func Convert(e Expr) (evalengine.Expr, error) {
+	return (&converter{}).convert(e)
+}
+
+// ConvertWithColumns is like Convert, but the sub-expressions for which columns returns
+// an offset are converted to the value of the column at that offset in the evaluated row.
+// The text values of the column are compared with the collation that columns returns.
+func ConvertWithColumns(e Expr, columns func(Expr) (int, collations.ID, bool)) (evalengine.Expr, error) {
+	return (&converter{columns: columns}).convert(e)
+}
+
+type converter struct {
+	columns func(Expr) (int, collations.ID, bool)
+}
+
+func (c *converter) convert(e Expr) (evalengine.Expr, error) {
+	if c.columns != nil {
+		if offset, collation, ok := c.columns(e); ok {
+			return evalengine.NewColumnWithCollation(offset, collation), nil
+		}
+	}
 	switch node := e.(type) {
 	case Argument:
 		return evalengine.NewBindVar(string(node)), nil
@@ -44,25 +68,40 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			return evalengine.NewLiteralIntFromBytes([]byte("1"))
 		}
 		return evalengine.NewLiteralIntFromBytes([]byte("0"))
+	case *NullVal:
+		return evalengine.NewLiteralNull(), nil
 	case *BinaryExpr:
 		var op evalengine.BinaryExpr
 		switch node.Operator {
 		case PlusOp:
+			if interval, ok := node.Right.(*IntervalExpr); ok {
+				return c.convertDateAdd(node.Left, interval, false)
+			}
+			if interval, ok := node.Left.(*IntervalExpr); ok {
+				return c.convertDateAdd(node.Right, interval, false)
+			}
 			op = &evalengine.Addition{}
 		case MinusOp:
+			if interval, ok := node.Right.(*IntervalExpr); ok {
+				return c.convertDateAdd(node.Left, interval, true)
+			}
 			op = &evalengine.Subtraction{}
 		case MultOp:
 			op = &evalengine.Multiplication{}
 		case DivOp:
 			op = &evalengine.Division{}
+		case IntDivOp:
+			op = &evalengine.IntegerDivision{}
+		case ModOp:
+			op = &evalengine.Modulo{}
 		default:
 			return nil, ErrExprNotSupported
 		}
-		left, err := Convert(node.Left)
+		left, err := c.convert(node.Left)
 		if err != nil {
 			return nil, err
 		}
-		right, err := Convert(node.Right)
+		right, err := c.convert(node.Right)
 		if err != nil {
 			return nil, err
 		}
@@ -71,7 +110,342 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			Left:  left,
 			Right: right,
 		}, nil
-
+	case *UnaryExpr:
+		if node.Operator != UMinusOp {
+			return nil, ErrExprNotSupported
+		}
+		inner, err := c.convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.NegateExpr{Inner: inner}, nil
+	case *ComparisonExpr:
+		return c.convertComparison(node)
+	case *RangeCond:
+		if comparesTextWithoutCollation(node.Left, node.From, node.To) {
+			return nil, ErrExprNotSupported
+		}
+		left, from, to, err := c.convertAll3(node.Left, node.From, node.To)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.BetweenExpr{Left: left, From: from, To: to, Negate: node.Operator == NotBetweenOp}, nil
+	case *AndExpr:
+		return c.convertLogical(evalengine.AndOp, node.Left, node.Right)
+	case *OrExpr:
+		return c.convertLogical(evalengine.OrOp, node.Left, node.Right)
+	case *XorExpr:
+		return c.convertLogical(evalengine.XorOp, node.Left, node.Right)
+	case *NotExpr:
+		inner, err := c.convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.NotExpr{Inner: inner}, nil
+	case *IsExpr:
+		return c.convertIs(node)
+	case *CaseExpr:
+		return c.convertCase(node)
+	case *ConvertExpr:
+		return c.convertCast(node)
+	case *FuncExpr:
+		return c.convertFunc(node)
+	case *SubstrExpr:
+		if node.StrVal == nil {
+			return nil, ErrExprNotSupported
+		}
+		args := []Expr{node.StrVal, node.From}
+		if node.To != nil {
+			args = append(args, node.To)
+		}
+		return c.convertCall("substr", args)
 	}
 	return nil, ErrExprNotSupported
 }
+
+func (c *converter) convertAll3(e1, e2, e3 Expr) (evalengine.Expr, evalengine.Expr, evalengine.Expr, error) {
+	c1, err := c.convert(e1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c2, err := c.convert(e2)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c3, err := c.convert(e3)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return c1, c2, c3, nil
+}
+
+// comparesTextWithoutCollation returns true if all the compared expressions are strings or bind
+// variables. They are compared as text, and vtgate doesn't know the collation of the connection
+// that MySQL would use to compare them.
+func comparesTextWithoutCollation(exprs ...Expr) bool {
+	for _, expr := range exprs {
+		switch expr := expr.(type) {
+		case Argument:
+		case *Literal:
+			if expr.Type != StrVal {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (c *converter) convertComparison(node *ComparisonExpr) (evalengine.Expr, error) {
+	compared := []Expr{node.Left, node.Right}
+	if tuple, ok := node.Right.(ValTuple); ok {
+		compared = append([]Expr{node.Left}, tuple...)
+	}
+	if comparesTextWithoutCollation(compared...) {
+		return nil, ErrExprNotSupported
+	}
+	left, err := c.convert(node.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	switch node.Operator {
+	case InOp, NotInOp:
+		tuple, ok := node.Right.(ValTuple)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		var values []evalengine.Expr
+		for _, expr := range tuple {
+			value, err := c.convert(expr)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return &evalengine.InExpr{Left: left, Right: values, Negate: node.Operator == NotInOp}, nil
+	case LikeOp, NotLikeOp:
+		right, err := c.convert(node.Right)
+		if err != nil {
+			return nil, err
+		}
+		like := &evalengine.LikeExpr{Left: left, Right: right, Negate: node.Operator == NotLikeOp}
+		if node.Escape != nil {
+			escape, ok := node.Escape.(*Literal)
+			if !ok || escape.Type != StrVal || len(escape.Val) != 1 {
+				return nil, ErrExprNotSupported
+			}
+			like.Escape = escape.Val[0]
+		}
+		return like, nil
+	}
+
+	var op evalengine.ComparisonOp
+	switch node.Operator {
+	case EqualOp:
+		op = evalengine.EqualOp
+	case NotEqualOp:
+		op = evalengine.NotEqualOp
+	case LessThanOp:
+		op = evalengine.LessThanOp
+	case LessEqualOp:
+		op = evalengine.LessEqualOp
+	case GreaterThanOp:
+		op = evalengine.GreaterThanOp
+	case GreaterEqualOp:
+		op = evalengine.GreaterEqualOp
+	case NullSafeEqualOp:
+		op = evalengine.NullSafeEqualOp
+	default:
+		return nil, ErrExprNotSupported
+	}
+	right, err := c.convert(node.Right)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.ComparisonExpr{Op: op, Left: left, Right: right}, nil
+}
+
+func (c *converter) convertLogical(op evalengine.LogicalOp, l, r Expr) (evalengine.Expr, error) {
+	left, err := c.convert(l)
+	if err != nil {
+		return nil, err
+	}
+	right, err := c.convert(r)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.LogicalExpr{Op: op, Left: left, Right: right}, nil
+}
+
+func (c *converter) convertIs(node *IsExpr) (evalengine.Expr, error) {
+	var op evalengine.IsOp
+	switch node.Right {
+	case IsNullOp:
+		op = evalengine.IsNullOp
+	case IsNotNullOp:
+		op = evalengine.IsNotNullOp
+	case IsTrueOp:
+		op = evalengine.IsTrueOp
+	case IsNotTrueOp:
+		op = evalengine.IsNotTrueOp
+	case IsFalseOp:
+		op = evalengine.IsFalseOp
+	case IsNotFalseOp:
+		op = evalengine.IsNotFalseOp
+	default:
+		return nil, ErrExprNotSupported
+	}
+	inner, err := c.convert(node.Left)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.IsExpr{Inner: inner, Op: op}, nil
+}
+
+func (c *converter) convertCase(node *CaseExpr) (evalengine.Expr, error) {
+	result := &evalengine.CaseExpr{}
+	var err error
+	if node.Expr != nil {
+		for _, when := range node.Whens {
+			if comparesTextWithoutCollation(node.Expr, when.Cond) {
+				return nil, ErrExprNotSupported
+			}
+		}
+		if result.Base, err = c.convert(node.Expr); err != nil {
+			return nil, err
+		}
+	}
+	for _, when := range node.Whens {
+		cond, err := c.convert(when.Cond)
+		if err != nil {
+			return nil, err
+		}
+		val, err := c.convert(when.Val)
+		if err != nil {
+			return nil, err
+		}
+		result.Whens = append(result.Whens, evalengine.WhenThen{When: cond, Then: val})
+	}
+	if node.Else != nil {
+		if result.Else, err = c.convert(node.Else); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (c *converter) convertCast(node *ConvertExpr) (evalengine.Expr, error) {
+	cast := &evalengine.CastExpr{}
+	typ := node.Type
+	switch strings.ToLower(typ.Type) {
+	case "signed":
+		cast.Target = sqltypes.Int64
+	case "unsigned":
+		cast.Target = sqltypes.Uint64
+	case "decimal":
+		cast.Target = sqltypes.Decimal
+		if typ.Scale != nil {
+			scale, err := strconv.Atoi(typ.Scale.Val)
+			if err != nil {
+				return nil, ErrExprNotSupported
+			}
+			cast.Scale = scale
+		}
+	case "date":
+		cast.Target = sqltypes.Date
+	case "datetime":
+		cast.Target = sqltypes.Datetime
+	case "time":
+		cast.Target = sqltypes.Time
+	case "binary", "char", "nchar":
+		// the length and the character set change the result, which we cannot do yet
+		if typ.Length != nil || typ.Charset != "" {
+			return nil, ErrExprNotSupported
+		}
+		cast.Target = sqltypes.VarBinary
+	default:
+		return nil, ErrExprNotSupported
+	}
+	if (cast.Target == sqltypes.Datetime || cast.Target == sqltypes.Time) && typ.Length != nil {
+		return nil, ErrExprNotSupported
+	}
+	inner, err := c.convert(node.Expr)
+	if err != nil {
+		return nil, err
+	}
+	cast.Inner = inner
+	return cast, nil
+}
+
+func (c *converter) convertFunc(node *FuncExpr) (evalengine.Expr, error) {
+	if !node.Qualifier.IsEmpty() || node.Distinct {
+		return nil, ErrExprNotSupported
+	}
+	var args []Expr
+	for _, expr := range node.Exprs {
+		aliased, ok := expr.(*AliasedExpr)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		args = append(args, aliased.Expr)
+	}
+
+	name := node.Name.Lowered()
+	switch name {
+	case "date_add", "adddate", "date_sub", "subdate":
+		if len(args) != 2 {
+			return nil, ErrExprNotSupported
+		}
+		interval, ok := args[1].(*IntervalExpr)
+		if !ok {
+			// ADDDATE(date, days) is not supported yet
+			return nil, ErrExprNotSupported
+		}
+		return c.convertDateAdd(args[0], interval, name == "date_sub" || name == "subdate")
+	}
+	return c.convertCall(name, args)
+}
+
+func (c *converter) convertCall(name string, args []Expr) (evalengine.Expr, error) {
+	if !evalengine.SupportsFunction(name) {
+		return nil, ErrExprNotSupported
+	}
+	switch name {
+	case "nullif", "strcmp", "greatest", "least", "instr", "locate":
+		compared := args
+		if name == "locate" && len(args) == 3 {
+			// the position is not compared
+			compared = args[:2]
+		}
+		if comparesTextWithoutCollation(compared...) {
+			return nil, ErrExprNotSupported
+		}
+	}
+	var exprs []evalengine.Expr
+	for _, arg := range args {
+		expr, err := c.convert(arg)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	return evalengine.NewCallExpr(name, exprs)
+}
+
+func (c *converter) convertDateAdd(date Expr, interval *IntervalExpr, sub bool) (evalengine.Expr, error) {
+	unit, ok := evalengine.ParseIntervalUnit(interval.Unit)
+	if !ok {
+		return nil, ErrExprNotSupported
+	}
+	d, err := c.convert(date)
+	if err != nil {
+		return nil, err
+	}
+	i, err := c.convert(interval.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.DateAddExpr{Date: d, Interval: i, Unit: unit, Sub: sub}, nil
+}
@@ -21,6 +21,7 @@ import (
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	"github.com/stretchr/testify/assert"
@@ -70,6 +71,207 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: ":float_bind_variable",
 		expected:   sqltypes.NewFloat64(2.2),
+	}, {
+		expression: "7 div 2",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "7 % 3",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "-7 % 3",
+		expected:   sqltypes.NewInt64(-1),
+	}, {
+		expression: "1/0",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "-(40+2)",
+		expected:   sqltypes.NewInt64(-42),
+	}, {
+		expression: "1 + null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "1 = 1",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 < 1.5",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'10' = 10",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null = null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "null <=> null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "2 in (1, 2, 3)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "4 not in (1, 2, 3)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "4 in (1, null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "5 between 1 and 10",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "5 not between 1 and 4",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 and null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "0 and null",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "1 or null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 xor 1",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "not 0",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null is null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "0 is not true",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "case 2 when 1 then 'one' when 2 then 'two' end",
+		expected:   sqltypes.NewVarBinary("two"),
+	}, {
+		expression: "case when 1 > 2 then 1 else 2 end",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "case when 1 > 2 then 1 end",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "cast('42abc' as signed)",
+		expected:   sqltypes.NewInt64(42),
+	}, {
+		expression: "cast(-1 as unsigned)",
+		expected:   sqltypes.NewUint64(18446744073709551615),
+	}, {
+		expression: "cast(1.005 as decimal(10, 1))",
+		expected:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.0")),
+	}, {
+		expression: "cast('2021-03-04 10:11:12' as date)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-03-04")),
+	}, {
+		expression: "cast('2021-03-04' as datetime)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-03-04 00:00:00")),
+	}, {
+		expression: "cast('not a date' as date)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "convert(42, char)",
+		expected:   sqltypes.NewVarBinary("42"),
+	}, {
+		expression: "concat('vi', 'te', 'ss')",
+		expected:   sqltypes.NewVarBinary("vitess"),
+	}, {
+		expression: "concat('vitess', null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "concat_ws(',', 'a', null, 'b')",
+		expected:   sqltypes.NewVarBinary("a,b"),
+	}, {
+		expression: "lower('ViTeSs')",
+		expected:   sqltypes.NewVarBinary("vitess"),
+	}, {
+		expression: "upper('vitess')",
+		expected:   sqltypes.NewVarBinary("VITESS"),
+	}, {
+		expression: "substring('vitess', 3)",
+		expected:   sqltypes.NewVarBinary("tess"),
+	}, {
+		expression: "substr('vitess', -4, 2)",
+		expected:   sqltypes.NewVarBinary("te"),
+	}, {
+		expression: "substr('vitess' from 2 for 3)",
+		expected:   sqltypes.NewVarBinary("ite"),
+	}, {
+		expression: "length('vitess')",
+		expected:   sqltypes.NewInt64(6),
+	}, {
+		expression: "char_length('ñandú')",
+		expected:   sqltypes.NewInt64(5),
+	}, {
+		expression: "trim('  vitess  ')",
+		expected:   sqltypes.NewVarBinary("vitess"),
+	}, {
+		expression: "replace('vitess', 's', 'z')",
+		expected:   sqltypes.NewVarBinary("vitezz"),
+	}, {
+		expression: "lpad('42', 5, '0')",
+		expected:   sqltypes.NewVarBinary("00042"),
+	}, {
+		expression: "coalesce(null, null, 42)",
+		expected:   sqltypes.NewInt64(42),
+	}, {
+		expression: "ifnull(null, 'default')",
+		expected:   sqltypes.NewVarBinary("default"),
+	}, {
+		expression: "nullif(1, 1)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "if(1 > 2, 'yes', 'no')",
+		expected:   sqltypes.NewVarBinary("no"),
+	}, {
+		expression: "abs(-42)",
+		expected:   sqltypes.NewInt64(42),
+	}, {
+		expression: "round(2.5)",
+		expected:   sqltypes.NewFloat64(3),
+	}, {
+		expression: "round(1234, -2)",
+		expected:   sqltypes.NewInt64(1200),
+	}, {
+		expression: "truncate(1.999, 1)",
+		expected:   sqltypes.NewFloat64(1.9),
+	}, {
+		expression: "floor(-1.5)",
+		expected:   sqltypes.NewFloat64(-2),
+	}, {
+		expression: "mod(10, 4)",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "greatest(1, 42, 3)",
+		expected:   sqltypes.NewInt64(42),
+	}, {
+		expression: "sqrt(-1)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "date_add('2021-01-31', interval 1 month)",
+		expected:   sqltypes.NewVarBinary("2021-02-28"),
+	}, {
+		expression: "date_sub('2021-03-01 10:00:00', interval 1 hour)",
+		expected:   sqltypes.NewVarBinary("2021-03-01 09:00:00"),
+	}, {
+		expression: "'2020-02-29' + interval 1 year",
+		expected:   sqltypes.NewVarBinary("2021-02-28"),
+	}, {
+		expression: "date_add('not a date', interval 1 day)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "year('2021-03-04')",
+		expected:   sqltypes.NewInt64(2021),
+	}, {
+		expression: "dayofweek('2021-03-07')",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "datediff('2021-03-04 23:59:59', '2021-03-01')",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "last_day('2020-02-10')",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2020-02-29")),
+	}, {
+		expression: "date_format('2021-03-04 15:06:07', '%Y/%m/%d %h:%i %p %W %D')",
+		expected:   sqltypes.NewVarBinary("2021/03/04 03:06 PM Thursday 4th"),
 	}}
 
 	for _, test := range tests {
@@ -100,3 +302,123 @@ func TestEvaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertNotSupported(t *testing.T) {
+	tests := []string{
+		"col",
+		"now()",
+		"database()",
+		"unknown_function(1)",
+		"1 in (select 1)",
+		"date_add('2021-01-01', interval '1:1' day_hour)",
+		"cast(1 as json)",
+		"cast('abc' as char(2))",
+		"1 << 2",
+		"'abc' > 'abd'",
+		"'vitess' like 'vi%s'",
+		":string_bind_variable in ('foo', 'bar')",
+		"'b' between 'a' and 'c'",
+		"least('b', 'a')",
+		"locate('s', 'vitess', 6)",
+		"case 'a' when 'A' then 1 end",
+	}
+	for _, expression := range tests {
+		t.Run(expression, func(t *testing.T) {
+			stmt, err := Parse("select " + expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+			_, err = Convert(astExpr)
+			require.Equal(t, ErrExprNotSupported, err)
+		})
+	}
+}
+
+func TestConvertWrongArgumentCount(t *testing.T) {
+	stmt, err := Parse("select concat_ws(',')")
+	require.NoError(t, err)
+	_, err = Convert(stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr)
+	require.EqualError(t, err, "Incorrect parameter count in the call to native function 'concat_ws'")
+}
+
+func TestEvaluateCollated(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   sqltypes.Value
+	}{{
+		expression: "a = 'vitess'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "a > 'VITESZ'",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "a in ('foo', 'VITESS')",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "a between 'v' and 'W'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "a like 'vi%S'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "a not like 'V_TEZ%'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "pct like '10|%' escape '|'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "locate('S', a, 6)",
+		expected:   sqltypes.NewInt64(6),
+	}, {
+		expression: "instr(a, 'TE')",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "strcmp(a, 'VITESS')",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "nullif(a, 'VITESS')",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "least(a, 'W')",
+		expected:   sqltypes.NewVarBinary("Vitess"),
+	}, {
+		expression: "case a when 'VITESS' then 1 else 0 end",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "lower(a) = 'VITESS'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "b = 'vitess'",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "b = 'Vitess'",
+		expected:   sqltypes.NewInt64(1),
+	}}
+
+	// a is a text column of a case insensitive collation, b a binary column
+	columns := map[string]int{"a": 0, "b": 1, "pct": 2}
+	columnCollations := []collations.ID{collations.Utf8mb4GeneralCi, collations.Binary, collations.Utf8mb4GeneralCi}
+	row := []sqltypes.Value{sqltypes.NewVarChar("Vitess"), sqltypes.NewVarBinary("Vitess"), sqltypes.NewVarChar("10%")}
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			stmt, err := Parse("select " + test.expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+			expr, err := ConvertWithColumns(astExpr, func(e Expr) (int, collations.ID, bool) {
+				col, ok := e.(*ColName)
+				if !ok {
+					return 0, collations.Unknown, false
+				}
+				offset, ok := columns[col.Name.String()]
+				if !ok {
+					return 0, collations.Unknown, false
+				}
+				return offset, columnCollations[offset], true
+			})
+			require.NoError(t, err)
+
+			r, err := expr.Evaluate(evalengine.ExpressionEnv{Row: row})
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, r.Value())
+		})
+	}
+}
@@ -27,7 +27,7 @@ package sqlparser
 func FormatImpossibleQuery(buf *TrackedBuffer, node SQLNode) {
 	switch node := node.(type) {
 	case *Select:
-		buf.Myprintf("select %v from ", node.SelectExprs)
+		buf.Myprintf("%vselect %v from ", node.With, node.SelectExprs)
 		var prefix string
 		for _, n := range node.From {
 			buf.Myprintf("%s%v", prefix, n)
@@ -37,7 +37,11 @@ func FormatImpossibleQuery(buf *TrackedBuffer, node SQLNode) {
 		if node.GroupBy != nil {
 			node.GroupBy.Format(buf)
 		}
+		if node.Windows != nil {
+			node.Windows.Format(buf)
+		}
 	case *Union:
+		buf.Myprintf("%v", node.With)
 		if requiresParen(node.Left) {
 			buf.astPrintf(node, "(%v)", node.Left)
 		} else {
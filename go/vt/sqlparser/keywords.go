@@ -136,12 +136,13 @@ var keywords = []keyword{
 	{"continue", UNUSED},
 	{"convert", CONVERT},
 	{"copy", COPY},
-	{"cume_dist", UNUSED},
+	{"cume_dist", CUME_DIST},
 	{"substr", SUBSTR},
 	{"substring", SUBSTRING},
 	{"create", CREATE},
 	{"cross", CROSS},
 	{"csv", CSV},
+	{"current", CURRENT},
 	{"current_date", CURRENT_DATE},
 	{"current_time", CURRENT_TIME},
 	{"current_timestamp", CURRENT_TIMESTAMP},
@@ -164,7 +165,7 @@ var keywords = []keyword{
 	{"delay_key_write", DELAY_KEY_WRITE},
 	{"delayed", UNUSED},
 	{"delete", DELETE},
-	{"dense_rank", UNUSED},
+	{"dense_rank", DENSE_RANK},
 	{"desc", DESC},
 	{"describe", DESCRIBE},
 	{"deterministic", UNUSED},
@@ -209,12 +210,13 @@ var keywords = []keyword{
 	{"fetch", UNUSED},
 	{"fields", FIELDS},
 	{"first", FIRST},
-	{"first_value", UNUSED},
+	{"first_value", FIRST_VALUE},
 	{"fixed", FIXED},
 	{"float", FLOAT_TYPE},
 	{"float4", UNUSED},
 	{"float8", UNUSED},
 	{"flush", FLUSH},
+	{"following", FOLLOWING},
 	{"for", FOR},
 	{"force", FORCE},
 	{"foreign", FOREIGN},
@@ -276,14 +278,14 @@ var keywords = []keyword{
 	{"keys", KEYS},
 	{"keyspaces", KEYSPACES},
 	{"key_block_size", KEY_BLOCK_SIZE},
-	{"kill", UNUSED},
-	{"lag", UNUSED},
+	{"kill", KILL},
+	{"lag", LAG},
 	{"language", LANGUAGE},
 	{"last", LAST},
-	{"last_value", UNUSED},
+	{"last_value", LAST_VALUE},
 	{"last_insert_id", LAST_INSERT_ID},
 	{"lateral", UNUSED},
-	{"lead", UNUSED},
+	{"lead", LEAD},
 	{"leading", UNUSED},
 	{"leave", UNUSED},
 	{"left", LEFT},
@@ -335,8 +337,8 @@ var keywords = []keyword{
 	{"none", NONE},
 	{"not", NOT},
 	{"no_write_to_binlog", NO_WRITE_TO_BINLOG},
-	{"nth_value", UNUSED},
-	{"ntile", UNUSED},
+	{"nth_value", NTH_VALUE},
+	{"ntile", NTILE},
 	{"null", NULL},
 	{"numeric", NUMERIC},
 	{"of", UNUSED},
@@ -354,31 +356,32 @@ var keywords = []keyword{
 	{"out", UNUSED},
 	{"outer", OUTER},
 	{"outfile", OUTFILE},
-	{"over", UNUSED},
+	{"over", OVER},
 	{"overwrite", OVERWRITE},
 	{"pack_keys", PACK_KEYS},
 	{"parser", PARSER},
 	{"partition", PARTITION},
 	{"partitioning", PARTITIONING},
 	{"password", PASSWORD},
-	{"percent_rank", UNUSED},
+	{"percent_rank", PERCENT_RANK},
 	{"plugins", PLUGINS},
 	{"point", POINT},
 	{"polygon", POLYGON},
+	{"preceding", PRECEDING},
 	{"precision", UNUSED},
 	{"primary", PRIMARY},
 	{"privileges", PRIVILEGES},
 	{"processlist", PROCESSLIST},
 	{"procedure", PROCEDURE},
 	{"query", QUERY},
-	{"range", UNUSED},
-	{"rank", UNUSED},
+	{"range", RANGE},
+	{"rank", RANK},
 	{"read", READ},
 	{"reads", UNUSED},
 	{"read_write", UNUSED},
 	{"real", REAL},
 	{"rebuild", REBUILD},
-	{"recursive", UNUSED},
+	{"recursive", RECURSIVE},
 	{"redundant", REDUNDANT},
 	{"references", REFERENCES},
 	{"regexp", REGEXP},
@@ -401,10 +404,10 @@ var keywords = []keyword{
 	{"right", RIGHT},
 	{"rlike", REGEXP},
 	{"rollback", ROLLBACK},
-	{"row", UNUSED},
+	{"row", ROW},
 	{"row_format", ROW_FORMAT},
-	{"row_number", UNUSED},
-	{"rows", UNUSED},
+	{"row_number", ROW_NUMBER},
+	{"rows", ROWS},
 	{"s3", S3},
 	{"savepoint", SAVEPOINT},
 	{"schema", SCHEMA},
@@ -474,6 +477,7 @@ var keywords = []keyword{
 	{"triggers", TRIGGERS},
 	{"true", TRUE},
 	{"truncate", TRUNCATE},
+	{"unbounded", UNBOUNDED},
 	{"uncommitted", UNCOMMITTED},
 	{"undefined", UNDEFINED},
 	{"undo", UNUSED},
@@ -508,6 +512,8 @@ var keywords = []keyword{
 	{"vitess_metadata", VITESS_METADATA},
 	{"vitess_migration", VITESS_MIGRATION},
 	{"vitess_migrations", VITESS_MIGRATIONS},
+	{"vitess_plans", VITESS_PLANS},
+	{"vitess_query_stats", VITESS_QUERY_STATS},
 	{"vitess_replication_status", VITESS_REPLICATION_STATUS},
 	{"vitess_shards", VITESS_SHARDS},
 	{"vitess_tablets", VITESS_TABLETS},
@@ -516,7 +522,7 @@ var keywords = []keyword{
 	{"when", WHEN},
 	{"where", WHERE},
 	{"while", UNUSED},
-	{"window", UNUSED},
+	{"window", WINDOW},
 	{"with", WITH},
 	{"without", WITHOUT},
 	{"work", WORK},
@@ -45,6 +45,46 @@ var (
 		output: "select 1 from dual",
 	}, {
 		input: "select 1 from t",
+	}, {
+		input: "with x as (select * from t) select * from x",
+	}, {
+		input:  "WITH x (a, b) AS (SELECT a, b FROM t), y AS (SELECT * FROM x) SELECT * FROM y",
+		output: "with x(a, b) as (select a, b from t), y as (select * from x) select * from y",
+	}, {
+		input: "with recursive cte(n) as (select 1 from dual union all select n + 1 from cte where n < 5) select n from cte",
+	}, {
+		input: "with x as (select id from t) select id from x order by id asc limit 10",
+	}, {
+		input: "with x as (select id from t) select id from x union select id from y",
+	}, {
+		input: "select * from (with x as (select 1 from dual) select * from x) as t",
+	}, {
+		input: "select * from t where a in (with x as (select a from b) select a from x)",
+	}, {
+		input:  "(with x as (select 1 from dual) select * from x) union (select 2 from dual)",
+		output: "(with x as (select 1 from dual) select * from x) union select 2 from dual",
+	}, {
+		input: "select id, row_number() over (partition by col order by id asc) from t",
+	}, {
+		input:  "SELECT RANK() OVER (ORDER BY a DESC), DENSE_RANK() OVER () FROM t",
+		output: "select RANK() over (order by a desc), DENSE_RANK() over () from t",
+	}, {
+		input: "select lag(a, 1, 0) over (partition by b, c order by d asc), lead(a) over (order by d asc) from t",
+	}, {
+		input: "select ntile(4) over w, cume_dist() over w, percent_rank() over w from t window w as (partition by a order by b asc)",
+	}, {
+		input: "select first_value(a) over w1, last_value(a) over (w1 rows unbounded preceding), nth_value(a, 2) over w2 from t window w1 as (order by b asc), w2 as (w1 partition by c)",
+	}, {
+		input: "select sum(a) over (partition by b order by c asc rows between 1 preceding and current row), count(*) over (partition by b) from t",
+	}, {
+		input: "select avg(a) over (order by b asc range between interval 1 day preceding and unbounded following) from t",
+	}, {
+		input: "select max(a) over (order by b asc rows between current row and :n following) from t",
+	}, {
+		input: "select a from t group by a having count(*) > 1 window w as (partition by a) order by a asc limit 1",
+	}, {
+		input:  "select following, preceding, unbounded, current from t",
+		output: "select `following`, `preceding`, `unbounded`, `current` from t",
 	}, {
 		input:  "select * from (select 1) as x(user)",
 		output: "select * from (select 1 from dual) as x(`user`)",
@@ -1417,6 +1457,14 @@ var (
 		output: "otherread",
 	}, {
 		input: "flush tables",
+	}, {
+		input:  "kill 5",
+		output: "kill connection 5",
+	}, {
+		input: "kill connection 18446744073709551615",
+	}, {
+		input:  "KILL QUERY 42",
+		output: "kill query 42",
 	}, {
 		input: "flush tables with read lock",
 	}, {
@@ -1652,6 +1700,14 @@ var (
 		input: "show vitess_tablets like '%'",
 	}, {
 		input: "show vitess_tablets where hostname = 'some-tablet'",
+	}, {
+		input: "show vitess_plans",
+	}, {
+		input: "show vitess_plans like '%user%'",
+	}, {
+		input: "show vitess_query_stats",
+	}, {
+		input: "show vitess_query_stats where ExecCount > 10",
 	}, {
 		input: "show vschema tables",
 	}, {
@@ -2053,7 +2109,7 @@ var (
 	}, {
 		input: "call proc(@param)",
 	}, {
-		input:  "create table unused_reserved_keywords (dense_rank bigint, lead VARCHAR(255), percent_rank decimal(3, 0), row TINYINT, rows CHAR(10), constraint PK_project PRIMARY KEY (dense_rank))",
+		input:  "create table unused_reserved_keywords (`dense_rank` bigint, `lead` VARCHAR(255), `percent_rank` decimal(3, 0), row TINYINT, rows CHAR(10), constraint PK_project PRIMARY KEY (`dense_rank`))",
 		output: "create table unused_reserved_keywords (\n\t`dense_rank` bigint,\n\t`lead` VARCHAR(255),\n\t`percent_rank` decimal(3,0),\n\t`row` TINYINT,\n\t`rows` CHAR(10),\n\tconstraint PK_project PRIMARY KEY (`dense_rank`)\n)",
 	}}
 )
@@ -2128,6 +2184,9 @@ func TestInvalid(t *testing.T) {
 	}{{
 		input: "select a, b from (select * from tbl) sort by a",
 		err:   "syntax error",
+	}, {
+		input: "kill query 18446744073709551616",
+		err:   "invalid processlist id",
 	}, {
 		input: "/*!*/",
 		err:   "query was empty",
@@ -3243,6 +3302,30 @@ var (
 		output       string
 		excludeMulti bool // Don't use in the ParseNext multi-statement parsing tests.
 	}{{
+		input:  "with x as select 1 from dual",
+		output: "syntax error at position 17 near 'select'",
+	}, {
+		input:  "select row_number() over from t",
+		output: "syntax error at position 30 near 'from'",
+	}, {
+		input:  "select rank() over (rows 1 + 1 preceding) from t",
+		output: "syntax error at position 29",
+	}, {
+		input:  "select concat(a) over () from t",
+		output: "syntax error at position 25",
+	}, {
+		input:  "select group_concat(a) over () from t",
+		output: "syntax error at position 28 near 'over'",
+	}, {
+		input:  "select row_number(a) over () from t",
+		output: "syntax error at position 20 near 'a'",
+	}, {
+		input:  "select rank from t",
+		output: "syntax error at position 17 near 'from'",
+	}, {
+		input:  "select lag() over () from t",
+		output: "syntax error at position 13",
+	}, {
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
 	}, {
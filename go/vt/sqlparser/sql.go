@@ -195,319 +195,326 @@ const ADD = 57492
 const FLUSH = 57493
 const CHANGE = 57494
 const MODIFY = 57495
-const REVERT = 57496
-const SCHEMA = 57497
-const TABLE = 57498
-const INDEX = 57499
-const VIEW = 57500
-const TO = 57501
-const IGNORE = 57502
-const IF = 57503
-const PRIMARY = 57504
-const COLUMN = 57505
-const SPATIAL = 57506
-const FULLTEXT = 57507
-const KEY_BLOCK_SIZE = 57508
-const CHECK = 57509
-const INDEXES = 57510
-const ACTION = 57511
-const CASCADE = 57512
-const CONSTRAINT = 57513
-const FOREIGN = 57514
-const NO = 57515
-const REFERENCES = 57516
-const RESTRICT = 57517
-const SHOW = 57518
-const DESCRIBE = 57519
-const EXPLAIN = 57520
-const DATE = 57521
-const ESCAPE = 57522
-const REPAIR = 57523
-const OPTIMIZE = 57524
-const TRUNCATE = 57525
-const COALESCE = 57526
-const EXCHANGE = 57527
-const REBUILD = 57528
-const PARTITIONING = 57529
-const REMOVE = 57530
-const MAXVALUE = 57531
-const PARTITION = 57532
-const REORGANIZE = 57533
-const LESS = 57534
-const THAN = 57535
-const PROCEDURE = 57536
-const TRIGGER = 57537
-const VINDEX = 57538
-const VINDEXES = 57539
-const DIRECTORY = 57540
-const NAME = 57541
-const UPGRADE = 57542
-const STATUS = 57543
-const VARIABLES = 57544
-const WARNINGS = 57545
-const CASCADED = 57546
-const DEFINER = 57547
-const OPTION = 57548
-const SQL = 57549
-const UNDEFINED = 57550
-const SEQUENCE = 57551
-const MERGE = 57552
-const TEMPORARY = 57553
-const TEMPTABLE = 57554
-const INVOKER = 57555
-const SECURITY = 57556
-const FIRST = 57557
-const AFTER = 57558
-const LAST = 57559
-const VITESS_MIGRATION = 57560
-const CANCEL = 57561
-const RETRY = 57562
-const COMPLETE = 57563
-const BEGIN = 57564
-const START = 57565
-const TRANSACTION = 57566
-const COMMIT = 57567
-const ROLLBACK = 57568
-const SAVEPOINT = 57569
-const RELEASE = 57570
-const WORK = 57571
-const BIT = 57572
-const TINYINT = 57573
-const SMALLINT = 57574
-const MEDIUMINT = 57575
-const INT = 57576
-const INTEGER = 57577
-const BIGINT = 57578
-const INTNUM = 57579
-const REAL = 57580
-const DOUBLE = 57581
-const FLOAT_TYPE = 57582
-const DECIMAL = 57583
-const NUMERIC = 57584
-const TIME = 57585
-const TIMESTAMP = 57586
-const DATETIME = 57587
-const YEAR = 57588
-const CHAR = 57589
-const VARCHAR = 57590
-const BOOL = 57591
-const CHARACTER = 57592
-const VARBINARY = 57593
-const NCHAR = 57594
-const TEXT = 57595
-const TINYTEXT = 57596
-const MEDIUMTEXT = 57597
-const LONGTEXT = 57598
-const BLOB = 57599
-const TINYBLOB = 57600
-const MEDIUMBLOB = 57601
-const LONGBLOB = 57602
-const JSON = 57603
-const ENUM = 57604
-const GEOMETRY = 57605
-const POINT = 57606
-const LINESTRING = 57607
-const POLYGON = 57608
-const GEOMETRYCOLLECTION = 57609
-const MULTIPOINT = 57610
-const MULTILINESTRING = 57611
-const MULTIPOLYGON = 57612
-const NULLX = 57613
-const AUTO_INCREMENT = 57614
-const APPROXNUM = 57615
-const SIGNED = 57616
-const UNSIGNED = 57617
-const ZEROFILL = 57618
-const CODE = 57619
-const COLLATION = 57620
-const COLUMNS = 57621
-const DATABASES = 57622
-const ENGINES = 57623
-const EVENT = 57624
-const EXTENDED = 57625
-const FIELDS = 57626
-const FULL = 57627
-const FUNCTION = 57628
-const GTID_EXECUTED = 57629
-const KEYSPACES = 57630
-const OPEN = 57631
-const PLUGINS = 57632
-const PRIVILEGES = 57633
-const PROCESSLIST = 57634
-const SCHEMAS = 57635
-const TABLES = 57636
-const TRIGGERS = 57637
-const USER = 57638
-const VGTID_EXECUTED = 57639
-const VITESS_KEYSPACES = 57640
-const VITESS_METADATA = 57641
-const VITESS_MIGRATIONS = 57642
-const VITESS_REPLICATION_STATUS = 57643
-const VITESS_SHARDS = 57644
-const VITESS_TABLETS = 57645
-const VSCHEMA = 57646
-const NAMES = 57647
-const GLOBAL = 57648
-const SESSION = 57649
-const ISOLATION = 57650
-const LEVEL = 57651
-const READ = 57652
-const WRITE = 57653
-const ONLY = 57654
-const REPEATABLE = 57655
-const COMMITTED = 57656
-const UNCOMMITTED = 57657
-const SERIALIZABLE = 57658
-const CURRENT_TIMESTAMP = 57659
-const DATABASE = 57660
-const CURRENT_DATE = 57661
-const CURRENT_TIME = 57662
-const LOCALTIME = 57663
-const LOCALTIMESTAMP = 57664
-const CURRENT_USER = 57665
-const UTC_DATE = 57666
-const UTC_TIME = 57667
-const UTC_TIMESTAMP = 57668
-const REPLACE = 57669
-const CONVERT = 57670
-const CAST = 57671
-const SUBSTR = 57672
-const SUBSTRING = 57673
-const GROUP_CONCAT = 57674
-const SEPARATOR = 57675
-const TIMESTAMPADD = 57676
-const TIMESTAMPDIFF = 57677
-const MATCH = 57678
-const AGAINST = 57679
-const BOOLEAN = 57680
-const LANGUAGE = 57681
-const WITH = 57682
-const QUERY = 57683
-const EXPANSION = 57684
-const WITHOUT = 57685
-const VALIDATION = 57686
-const UNUSED = 57687
-const ARRAY = 57688
-const CUME_DIST = 57689
-const DESCRIPTION = 57690
-const DENSE_RANK = 57691
-const EMPTY = 57692
-const EXCEPT = 57693
-const FIRST_VALUE = 57694
-const GROUPING = 57695
-const GROUPS = 57696
-const JSON_TABLE = 57697
-const LAG = 57698
-const LAST_VALUE = 57699
-const LATERAL = 57700
-const LEAD = 57701
-const MEMBER = 57702
-const NTH_VALUE = 57703
-const NTILE = 57704
-const OF = 57705
-const OVER = 57706
-const PERCENT_RANK = 57707
-const RANK = 57708
-const RECURSIVE = 57709
-const ROW_NUMBER = 57710
-const SYSTEM = 57711
-const WINDOW = 57712
-const ACTIVE = 57713
-const ADMIN = 57714
-const BUCKETS = 57715
-const CLONE = 57716
-const COMPONENT = 57717
-const DEFINITION = 57718
-const ENFORCED = 57719
-const EXCLUDE = 57720
-const FOLLOWING = 57721
-const GEOMCOLLECTION = 57722
-const GET_MASTER_PUBLIC_KEY = 57723
-const HISTOGRAM = 57724
-const HISTORY = 57725
-const INACTIVE = 57726
-const INVISIBLE = 57727
-const LOCKED = 57728
-const MASTER_COMPRESSION_ALGORITHMS = 57729
-const MASTER_PUBLIC_KEY_PATH = 57730
-const MASTER_TLS_CIPHERSUITES = 57731
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57732
-const NESTED = 57733
-const NETWORK_NAMESPACE = 57734
-const NOWAIT = 57735
-const NULLS = 57736
-const OJ = 57737
-const OLD = 57738
-const OPTIONAL = 57739
-const ORDINALITY = 57740
-const ORGANIZATION = 57741
-const OTHERS = 57742
-const PATH = 57743
-const PERSIST = 57744
-const PERSIST_ONLY = 57745
-const PRECEDING = 57746
-const PRIVILEGE_CHECKS_USER = 57747
-const PROCESS = 57748
-const RANDOM = 57749
-const REFERENCE = 57750
-const REQUIRE_ROW_FORMAT = 57751
-const RESOURCE = 57752
-const RESPECT = 57753
-const RESTART = 57754
-const RETAIN = 57755
-const REUSE = 57756
-const ROLE = 57757
-const SECONDARY = 57758
-const SECONDARY_ENGINE = 57759
-const SECONDARY_LOAD = 57760
-const SECONDARY_UNLOAD = 57761
-const SKIP = 57762
-const SRID = 57763
-const THREAD_PRIORITY = 57764
-const TIES = 57765
-const UNBOUNDED = 57766
-const VCPU = 57767
-const VISIBLE = 57768
-const FORMAT = 57769
-const TREE = 57770
-const VITESS = 57771
-const TRADITIONAL = 57772
-const LOCAL = 57773
-const LOW_PRIORITY = 57774
-const NO_WRITE_TO_BINLOG = 57775
-const LOGS = 57776
-const ERROR = 57777
-const GENERAL = 57778
-const HOSTS = 57779
-const OPTIMIZER_COSTS = 57780
-const USER_RESOURCES = 57781
-const SLOW = 57782
-const CHANNEL = 57783
-const RELAY = 57784
-const EXPORT = 57785
-const AVG_ROW_LENGTH = 57786
-const CONNECTION = 57787
-const CHECKSUM = 57788
-const DELAY_KEY_WRITE = 57789
-const ENCRYPTION = 57790
-const ENGINE = 57791
-const INSERT_METHOD = 57792
-const MAX_ROWS = 57793
-const MIN_ROWS = 57794
-const PACK_KEYS = 57795
-const PASSWORD = 57796
-const FIXED = 57797
-const DYNAMIC = 57798
-const COMPRESSED = 57799
-const REDUNDANT = 57800
-const COMPACT = 57801
-const ROW_FORMAT = 57802
-const STATS_AUTO_RECALC = 57803
-const STATS_PERSISTENT = 57804
-const STATS_SAMPLE_PAGES = 57805
-const STORAGE = 57806
-const MEMORY = 57807
-const DISK = 57808
+const KILL = 57496
+const REVERT = 57497
+const SCHEMA = 57498
+const TABLE = 57499
+const INDEX = 57500
+const VIEW = 57501
+const TO = 57502
+const IGNORE = 57503
+const IF = 57504
+const PRIMARY = 57505
+const COLUMN = 57506
+const SPATIAL = 57507
+const FULLTEXT = 57508
+const KEY_BLOCK_SIZE = 57509
+const CHECK = 57510
+const INDEXES = 57511
+const ACTION = 57512
+const CASCADE = 57513
+const CONSTRAINT = 57514
+const FOREIGN = 57515
+const NO = 57516
+const REFERENCES = 57517
+const RESTRICT = 57518
+const SHOW = 57519
+const DESCRIBE = 57520
+const EXPLAIN = 57521
+const DATE = 57522
+const ESCAPE = 57523
+const REPAIR = 57524
+const OPTIMIZE = 57525
+const TRUNCATE = 57526
+const COALESCE = 57527
+const EXCHANGE = 57528
+const REBUILD = 57529
+const PARTITIONING = 57530
+const REMOVE = 57531
+const MAXVALUE = 57532
+const PARTITION = 57533
+const REORGANIZE = 57534
+const LESS = 57535
+const THAN = 57536
+const PROCEDURE = 57537
+const TRIGGER = 57538
+const VINDEX = 57539
+const VINDEXES = 57540
+const DIRECTORY = 57541
+const NAME = 57542
+const UPGRADE = 57543
+const STATUS = 57544
+const VARIABLES = 57545
+const WARNINGS = 57546
+const CASCADED = 57547
+const DEFINER = 57548
+const OPTION = 57549
+const SQL = 57550
+const UNDEFINED = 57551
+const SEQUENCE = 57552
+const MERGE = 57553
+const TEMPORARY = 57554
+const TEMPTABLE = 57555
+const INVOKER = 57556
+const SECURITY = 57557
+const FIRST = 57558
+const AFTER = 57559
+const LAST = 57560
+const VITESS_MIGRATION = 57561
+const CANCEL = 57562
+const RETRY = 57563
+const COMPLETE = 57564
+const BEGIN = 57565
+const START = 57566
+const TRANSACTION = 57567
+const COMMIT = 57568
+const ROLLBACK = 57569
+const SAVEPOINT = 57570
+const RELEASE = 57571
+const WORK = 57572
+const BIT = 57573
+const TINYINT = 57574
+const SMALLINT = 57575
+const MEDIUMINT = 57576
+const INT = 57577
+const INTEGER = 57578
+const BIGINT = 57579
+const INTNUM = 57580
+const REAL = 57581
+const DOUBLE = 57582
+const FLOAT_TYPE = 57583
+const DECIMAL = 57584
+const NUMERIC = 57585
+const TIME = 57586
+const TIMESTAMP = 57587
+const DATETIME = 57588
+const YEAR = 57589
+const CHAR = 57590
+const VARCHAR = 57591
+const BOOL = 57592
+const CHARACTER = 57593
+const VARBINARY = 57594
+const NCHAR = 57595
+const TEXT = 57596
+const TINYTEXT = 57597
+const MEDIUMTEXT = 57598
+const LONGTEXT = 57599
+const BLOB = 57600
+const TINYBLOB = 57601
+const MEDIUMBLOB = 57602
+const LONGBLOB = 57603
+const JSON = 57604
+const ENUM = 57605
+const GEOMETRY = 57606
+const POINT = 57607
+const LINESTRING = 57608
+const POLYGON = 57609
+const GEOMETRYCOLLECTION = 57610
+const MULTIPOINT = 57611
+const MULTILINESTRING = 57612
+const MULTIPOLYGON = 57613
+const NULLX = 57614
+const AUTO_INCREMENT = 57615
+const APPROXNUM = 57616
+const SIGNED = 57617
+const UNSIGNED = 57618
+const ZEROFILL = 57619
+const CODE = 57620
+const COLLATION = 57621
+const COLUMNS = 57622
+const DATABASES = 57623
+const ENGINES = 57624
+const EVENT = 57625
+const EXTENDED = 57626
+const FIELDS = 57627
+const FULL = 57628
+const FUNCTION = 57629
+const GTID_EXECUTED = 57630
+const KEYSPACES = 57631
+const OPEN = 57632
+const PLUGINS = 57633
+const PRIVILEGES = 57634
+const PROCESSLIST = 57635
+const SCHEMAS = 57636
+const TABLES = 57637
+const TRIGGERS = 57638
+const USER = 57639
+const VGTID_EXECUTED = 57640
+const VITESS_KEYSPACES = 57641
+const VITESS_METADATA = 57642
+const VITESS_MIGRATIONS = 57643
+const VITESS_PLANS = 57644
+const VITESS_QUERY_STATS = 57645
+const VITESS_REPLICATION_STATUS = 57646
+const VITESS_SHARDS = 57647
+const VITESS_TABLETS = 57648
+const VSCHEMA = 57649
+const NAMES = 57650
+const GLOBAL = 57651
+const SESSION = 57652
+const ISOLATION = 57653
+const LEVEL = 57654
+const READ = 57655
+const WRITE = 57656
+const ONLY = 57657
+const REPEATABLE = 57658
+const COMMITTED = 57659
+const UNCOMMITTED = 57660
+const SERIALIZABLE = 57661
+const CURRENT_TIMESTAMP = 57662
+const DATABASE = 57663
+const CURRENT_DATE = 57664
+const CURRENT_TIME = 57665
+const LOCALTIME = 57666
+const LOCALTIMESTAMP = 57667
+const CURRENT_USER = 57668
+const UTC_DATE = 57669
+const UTC_TIME = 57670
+const UTC_TIMESTAMP = 57671
+const REPLACE = 57672
+const CONVERT = 57673
+const CAST = 57674
+const SUBSTR = 57675
+const SUBSTRING = 57676
+const GROUP_CONCAT = 57677
+const SEPARATOR = 57678
+const TIMESTAMPADD = 57679
+const TIMESTAMPDIFF = 57680
+const MATCH = 57681
+const AGAINST = 57682
+const BOOLEAN = 57683
+const LANGUAGE = 57684
+const WITH = 57685
+const QUERY = 57686
+const EXPANSION = 57687
+const WITHOUT = 57688
+const VALIDATION = 57689
+const UNUSED = 57690
+const ARRAY = 57691
+const CUME_DIST = 57692
+const DESCRIPTION = 57693
+const DENSE_RANK = 57694
+const EMPTY = 57695
+const EXCEPT = 57696
+const FIRST_VALUE = 57697
+const GROUPING = 57698
+const GROUPS = 57699
+const JSON_TABLE = 57700
+const LAG = 57701
+const LAST_VALUE = 57702
+const LATERAL = 57703
+const LEAD = 57704
+const MEMBER = 57705
+const NTH_VALUE = 57706
+const NTILE = 57707
+const OF = 57708
+const OVER = 57709
+const PERCENT_RANK = 57710
+const RANK = 57711
+const RECURSIVE = 57712
+const ROW_NUMBER = 57713
+const SYSTEM = 57714
+const WINDOW = 57715
+const ACTIVE = 57716
+const ADMIN = 57717
+const BUCKETS = 57718
+const CLONE = 57719
+const COMPONENT = 57720
+const DEFINITION = 57721
+const ENFORCED = 57722
+const EXCLUDE = 57723
+const FOLLOWING = 57724
+const GEOMCOLLECTION = 57725
+const GET_MASTER_PUBLIC_KEY = 57726
+const HISTOGRAM = 57727
+const HISTORY = 57728
+const INACTIVE = 57729
+const INVISIBLE = 57730
+const LOCKED = 57731
+const MASTER_COMPRESSION_ALGORITHMS = 57732
+const MASTER_PUBLIC_KEY_PATH = 57733
+const MASTER_TLS_CIPHERSUITES = 57734
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57735
+const NESTED = 57736
+const NETWORK_NAMESPACE = 57737
+const NOWAIT = 57738
+const NULLS = 57739
+const OJ = 57740
+const OLD = 57741
+const OPTIONAL = 57742
+const ORDINALITY = 57743
+const ORGANIZATION = 57744
+const OTHERS = 57745
+const PATH = 57746
+const PERSIST = 57747
+const PERSIST_ONLY = 57748
+const PRECEDING = 57749
+const PRIVILEGE_CHECKS_USER = 57750
+const PROCESS = 57751
+const RANDOM = 57752
+const REFERENCE = 57753
+const REQUIRE_ROW_FORMAT = 57754
+const RESOURCE = 57755
+const RESPECT = 57756
+const RESTART = 57757
+const RETAIN = 57758
+const REUSE = 57759
+const ROLE = 57760
+const SECONDARY = 57761
+const SECONDARY_ENGINE = 57762
+const SECONDARY_LOAD = 57763
+const SECONDARY_UNLOAD = 57764
+const SKIP = 57765
+const SRID = 57766
+const THREAD_PRIORITY = 57767
+const TIES = 57768
+const UNBOUNDED = 57769
+const VCPU = 57770
+const VISIBLE = 57771
+const CURRENT = 57772
+const RANGE = 57773
+const ROW = 57774
+const ROWS = 57775
+const FORMAT = 57776
+const TREE = 57777
+const VITESS = 57778
+const TRADITIONAL = 57779
+const LOCAL = 57780
+const LOW_PRIORITY = 57781
+const NO_WRITE_TO_BINLOG = 57782
+const LOGS = 57783
+const ERROR = 57784
+const GENERAL = 57785
+const HOSTS = 57786
+const OPTIMIZER_COSTS = 57787
+const USER_RESOURCES = 57788
+const SLOW = 57789
+const CHANNEL = 57790
+const RELAY = 57791
+const EXPORT = 57792
+const AVG_ROW_LENGTH = 57793
+const CONNECTION = 57794
+const CHECKSUM = 57795
+const DELAY_KEY_WRITE = 57796
+const ENCRYPTION = 57797
+const ENGINE = 57798
+const INSERT_METHOD = 57799
+const MAX_ROWS = 57800
+const MIN_ROWS = 57801
+const PACK_KEYS = 57802
+const PASSWORD = 57803
+const FIXED = 57804
+const DYNAMIC = 57805
+const COMPRESSED = 57806
+const REDUNDANT = 57807
+const COMPACT = 57808
+const ROW_FORMAT = 57809
+const STATS_AUTO_RECALC = 57810
+const STATS_PERSISTENT = 57811
+const STATS_SAMPLE_PAGES = 57812
+const STORAGE = 57813
+const MEMORY = 57814
+const DISK = 57815
 
 var yyToknames = [...]string{
 	"$end",
@@ -680,6 +687,7 @@ var yyToknames = [...]string{
 	"FLUSH",
 	"CHANGE",
 	"MODIFY",
+	"KILL",
 	"REVERT",
 	"SCHEMA",
 	"TABLE",
@@ -827,6 +835,8 @@ var yyToknames = [...]string{
 	"VITESS_KEYSPACES",
 	"VITESS_METADATA",
 	"VITESS_MIGRATIONS",
+	"VITESS_PLANS",
+	"VITESS_QUERY_STATS",
 	"VITESS_REPLICATION_STATUS",
 	"VITESS_SHARDS",
 	"VITESS_TABLETS",
@@ -953,6 +963,10 @@ var yyToknames = [...]string{
 	"UNBOUNDED",
 	"VCPU",
 	"VISIBLE",
+	"CURRENT",
+	"RANGE",
+	"ROW",
+	"ROWS",
 	"FORMAT",
 	"TREE",
 	"VITESS",
@@ -1007,3121 +1021,3264 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 45,
-	1, 126,
-	484, 126,
-	-2, 132,
 	-1, 46,
-	113, 132,
-	152, 132,
-	267, 132,
-	-2, 355,
-	-1, 53,
-	33, 505,
-	174, 505,
-	185, 505,
-	218, 519,
-	219, 519,
-	-2, 507,
-	-1, 58,
-	176, 529,
-	-2, 527,
-	-1, 107,
-	173, 972,
+	1, 133,
+	491, 133,
+	-2, 139,
+	-1, 47,
+	113, 139,
+	152, 139,
+	268, 139,
+	-2, 362,
+	-1, 54,
+	33, 515,
+	175, 515,
+	186, 515,
+	219, 529,
+	220, 529,
+	-2, 517,
+	-1, 59,
+	177, 539,
+	-2, 537,
+	-1, 111,
+	174, 1026,
+	-2, 112,
+	-1, 113,
+	1, 134,
+	491, 134,
+	-2, 139,
+	-1, 123,
+	114, 265,
+	180, 265,
+	-2, 356,
+	-1, 142,
+	113, 139,
+	152, 139,
+	268, 139,
+	-2, 371,
+	-1, 607,
+	159, 1047,
+	-2, 1043,
+	-1, 608,
+	159, 1048,
+	-2, 1044,
+	-1, 625,
+	57, 611,
+	-2, 619,
+	-1, 661,
+	127, 1402,
 	-2, 105,
-	-1, 109,
-	1, 127,
-	484, 127,
-	-2, 132,
-	-1, 119,
-	114, 258,
-	179, 258,
-	-2, 349,
-	-1, 138,
-	113, 132,
-	152, 132,
-	267, 132,
-	-2, 364,
-	-1, 582,
-	159, 993,
-	-2, 989,
-	-1, 583,
-	159, 994,
-	-2, 990,
-	-1, 593,
-	57, 597,
-	-2, 605,
-	-1, 627,
-	127, 1344,
-	-2, 98,
-	-1, 628,
-	127, 1225,
-	-2, 99,
-	-1, 634,
-	127, 1276,
-	-2, 966,
-	-1, 774,
-	127, 1159,
-	-2, 963,
-	-1, 810,
-	184, 38,
-	189, 38,
-	-2, 269,
-	-1, 887,
-	1, 402,
-	484, 402,
-	-2, 132,
-	-1, 1080,
-	57, 598,
-	-2, 610,
-	-1, 1081,
-	57, 599,
-	-2, 611,
-	-1, 1142,
-	1, 299,
-	484, 299,
-	-2, 132,
-	-1, 1145,
-	23, 151,
-	-2, 153,
-	-1, 1218,
-	114, 258,
-	179, 258,
-	-2, 349,
-	-1, 1227,
-	184, 39,
-	189, 39,
-	-2, 270,
-	-1, 1438,
-	159, 998,
-	-2, 992,
-	-1, 1523,
-	75, 80,
-	84, 80,
-	-2, 84,
-	-1, 1544,
-	1, 300,
-	484, 300,
-	-2, 132,
-	-1, 1949,
-	47, 934,
-	-2, 928,
-	-1, 1983,
-	5, 43,
-	16, 43,
-	18, 43,
-	85, 43,
-	-2, 638,
+	-1, 662,
+	127, 1280,
+	-2, 106,
+	-1, 668,
+	127, 1331,
+	-2, 1020,
+	-1, 808,
+	127, 1213,
+	-2, 1017,
+	-1, 844,
+	185, 39,
+	190, 39,
+	-2, 276,
+	-1, 921,
+	1, 409,
+	491, 409,
+	-2, 139,
+	-1, 1130,
+	57, 612,
+	-2, 624,
+	-1, 1131,
+	57, 613,
+	-2, 625,
+	-1, 1194,
+	1, 306,
+	491, 306,
+	-2, 139,
+	-1, 1197,
+	23, 158,
+	-2, 160,
+	-1, 1270,
+	114, 265,
+	180, 265,
+	-2, 356,
+	-1, 1279,
+	185, 40,
+	190, 40,
+	-2, 277,
+	-1, 1490,
+	159, 1052,
+	-2, 1046,
+	-1, 1592,
+	75, 87,
+	84, 87,
+	-2, 91,
+	-1, 1613,
+	1, 307,
+	491, 307,
+	-2, 139,
+	-1, 2044,
+	47, 988,
+	-2, 982,
+	-1, 2077,
+	5, 44,
+	16, 44,
+	18, 44,
+	85, 44,
+	-2, 652,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 29816
+const yyLast = 31245
 
 var yyAct = [...]int{
-	582, 2146, 2302, 2042, 2264, 2211, 2277, 1798, 1805, 950,
-	3, 1760, 1961, 1067, 554, 1727, 1806, 2172, 1981, 1611,
-	603, 2177, 1962, 2090, 1473, 2084, 1847, 555, 34, 1752,
-	1761, 540, 1958, 1488, 1950, 898, 1744, 1576, 1851, 1827,
-	840, 1581, 525, 2164, 89, 1890, 523, 1199, 1828, 175,
-	1829, 590, 175, 1596, 488, 175, 1973, 1082, 33, 35,
-	504, 1908, 175, 1739, 1688, 1519, 1431, 1225, 1423, 1435,
-	175, 1334, 1124, 133, 1595, 1583, 1609, 1821, 805, 516,
-	1134, 1127, 1642, 927, 1501, 1508, 1102, 604, 1120, 147,
-	1541, 1117, 777, 84, 1070, 1475, 1118, 632, 1454, 1400,
-	968, 594, 504, 1331, 818, 504, 175, 504, 784, 527,
-	1232, 629, 781, 808, 1593, 1317, 592, 1133, 606, 1493,
-	1572, 1088, 88, 1525, 811, 785, 806, 807, 91, 1106,
-	943, 1339, 595, 596, 150, 110, 948, 111, 1131, 1194,
-	1217, 116, 117, 1243, 1466, 79, 82, 8, 7, 511,
-	6, 1041, 1870, 1869, 883, 1640, 1303, 1746, 1044, 1897,
-	1898, 793, 1489, 1470, 1471, 613, 1389, 618, 969, 1388,
-	1387, 788, 177, 178, 179, 1386, 842, 597, 83, 1385,
-	112, 1384, 1372, 778, 514, 1377, 515, 118, 2298, 856,
-	857, 1725, 860, 861, 862, 863, 1946, 2123, 866, 867,
-	868, 869, 870, 871, 872, 873, 874, 875, 876, 877,
-	878, 879, 880, 512, 969, 2208, 461, 2011, 2207, 2142,
-	844, 843, 2143, 2316, 2287, 822, 2315, 2256, 845, 598,
-	2310, 2147, 95, 979, 821, 1678, 605, 2278, 1628, 1588,
-	626, 171, 2286, 1925, 112, 799, 2074, 798, 1208, 2255,
-	1135, 853, 1136, 846, 847, 848, 1877, 792, 1726, 794,
-	1876, 1586, 520, 1989, 633, 113, 797, 135, 892, 893,
-	97, 98, 99, 100, 101, 800, 1896, 107, 155, 979,
-	172, 1990, 1991, 456, 1676, 1472, 2220, 994, 993, 1003,
-	1004, 996, 997, 998, 999, 1000, 1001, 1002, 995, 1526,
-	1535, 1005, 886, 589, 917, 593, 587, 72, 112, 145,
-	74, 39, 40, 858, 134, 797, 905, 789, 1536, 1537,
-	946, 906, 795, 975, 791, 790, 967, 922, 923, 904,
-	586, 903, 905, 152, 882, 153, 1814, 906, 1909, 2087,
-	122, 123, 144, 143, 170, 1791, 918, 1585, 1790, 911,
-	1434, 1792, 1378, 1379, 1380, 567, 2065, 573, 574, 571,
-	572, 1376, 570, 569, 568, 1556, 1555, 2063, 491, 975,
-	2044, 795, 575, 576, 491, 502, 506, 177, 178, 179,
-	500, 1911, 1074, 1323, 80, 491, 1653, 1651, 1652, 1293,
-	2038, 1852, 1610, 1873, 2314, 1648, 797, 881, 2039, 139,
-	120, 146, 127, 119, 491, 140, 141, 859, 1643, 1318,
-	156, 940, 934, 924, 936, 2299, 926, 945, 1885, 161,
-	128, 888, 919, 925, 801, 912, 1655, 1658, 1656, 796,
-	1657, 1294, 865, 1295, 131, 129, 124, 125, 126, 130,
-	864, 920, 921, 1913, 121, 1917, 2045, 1912, 1649, 1910,
-	933, 935, 2204, 132, 1915, 1647, 175, 2046, 175, 885,
-	1645, 175, 2137, 1914, 829, 827, 974, 971, 972, 973,
-	978, 980, 977, 1612, 976, 1502, 1916, 1918, 796, 938,
-	838, 970, 837, 836, 835, 834, 833, 832, 831, 504,
-	504, 504, 2010, 826, 802, 1646, 1211, 820, 901, 839,
-	907, 908, 909, 910, 1810, 782, 2221, 504, 504, 780,
-	1875, 820, 974, 971, 972, 973, 978, 980, 977, 961,
-	976, 1587, 492, 947, 1801, 148, 2311, 970, 492, 1324,
-	177, 178, 179, 2254, 1526, 782, 34, 939, 931, 492,
-	814, 1889, 932, 915, 2308, 884, 782, 813, 1332, 1594,
-	1231, 620, 937, 1728, 1730, 1886, 819, 491, 492, 796,
-	2088, 823, 813, 830, 828, 1634, 941, 1328, 955, 1802,
-	819, 824, 1677, 849, 930, 2018, 813, 816, 817, 1872,
-	782, 142, 820, 1934, 810, 814, 1933, 175, 820, 825,
-	1932, 1206, 1804, 136, 1205, 1799, 137, 478, 1204, 1305,
-	1304, 1306, 1307, 1308, 820, 109, 477, 1862, 1329, 1808,
-	1809, 1075, 1065, 2306, 1800, 1230, 504, 475, 894, 1077,
-	175, 902, 175, 175, 1202, 504, 952, 953, 891, 460,
-	1542, 504, 1015, 455, 629, 1884, 1098, 1892, 1883, 2242,
-	1630, 819, 1891, 1066, 1892, 75, 2104, 819, 1005, 1891,
-	1988, 855, 823, 813, 900, 472, 964, 962, 1729, 963,
-	1016, 1017, 824, 819, 486, 1322, 1706, 1066, 1703, 813,
-	816, 817, 1751, 782, 1807, 1071, 1697, 810, 814, 483,
-	1620, 1531, 88, 1110, 914, 1029, 1810, 1116, 91, 896,
-	177, 178, 179, 1787, 1425, 916, 809, 149, 154, 151,
-	157, 158, 159, 160, 162, 163, 164, 165, 928, 995,
-	600, 492, 1005, 166, 167, 168, 169, 1090, 1092, 1043,
-	1046, 1048, 1050, 1051, 1053, 1055, 1056, 1047, 1049, 982,
-	1052, 1054, 944, 1057, 104, 1068, 985, 1076, 1340, 462,
-	1091, 464, 479, 820, 494, 985, 493, 468, 2250, 466,
-	470, 480, 471, 1319, 465, 1320, 476, 1426, 1321, 467,
-	481, 482, 484, 498, 497, 485, 841, 474, 495, 1971,
-	2304, 1644, 1325, 2305, 1629, 2303, 899, 175, 1927, 887,
-	1137, 1195, 965, 1455, 1803, 1713, 105, 633, 984, 982,
-	1203, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026,
-	1027, 1455, 819, 1840, 854, 985, 177, 178, 179, 504,
-	1748, 1227, 2186, 1998, 1016, 1017, 1016, 1017, 1997, 1236,
-	1616, 1242, 1241, 1240, 1229, 1627, 504, 504, 2292, 504,
-	1625, 504, 504, 929, 504, 504, 504, 504, 504, 504,
-	1097, 1209, 1210, 998, 999, 1000, 1001, 1002, 995, 504,
-	1622, 1005, 1407, 175, 1276, 996, 997, 998, 999, 1000,
-	1001, 1002, 995, 1341, 1237, 1005, 1405, 1406, 1404, 175,
-	983, 984, 982, 1749, 1626, 829, 827, 2312, 1216, 1622,
-	504, 1132, 175, 1993, 1111, 2259, 1235, 2235, 985, 1271,
-	1272, 619, 496, 1330, 983, 984, 982, 175, 1312, 1279,
-	1280, 1223, 1929, 1624, 2293, 1285, 1286, 1395, 1397, 1398,
-	489, 80, 985, 175, 2260, 2122, 2236, 1681, 1682, 1683,
-	175, 1233, 1233, 1403, 2121, 490, 1396, 1310, 1234, 175,
-	175, 175, 175, 175, 175, 175, 175, 175, 504, 504,
-	504, 1273, 1201, 1300, 1213, 1214, 1212, 1344, 2313, 2270,
-	1226, 2016, 2268, 1825, 1348, 1824, 1350, 1351, 1352, 1353,
-	1311, 2272, 2273, 1357, 1289, 1591, 1808, 1809, 175, 1313,
-	1245, 2269, 1246, 1298, 1248, 1250, 1701, 1371, 1254, 1256,
-	1258, 1260, 1262, 624, 1297, 1700, 1702, 1342, 1343, 1309,
-	171, 621, 622, 1296, 1336, 1287, 1281, 1100, 1333, 1278,
-	2239, 1347, 1277, 1401, 1252, 1299, 1424, 2238, 1354, 1355,
-	1356, 2237, 1274, 2185, 113, 1427, 1094, 983, 984, 982,
-	2183, 2161, 112, 799, 2119, 798, 1207, 155, 504, 1996,
-	1834, 1807, 177, 178, 179, 985, 1794, 1822, 1638, 1436,
-	1383, 1637, 1487, 1810, 1479, 1346, 1373, 994, 993, 1003,
-	1004, 996, 997, 998, 999, 1000, 1001, 1002, 995, 504,
-	504, 1005, 1099, 1428, 1429, 1095, 177, 178, 179, 1795,
-	1604, 1370, 1337, 175, 1367, 1368, 1369, 1443, 1446, 983,
-	984, 982, 152, 1456, 153, 1301, 1288, 1478, 177, 178,
-	179, 1284, 1602, 170, 983, 984, 982, 985, 1402, 1283,
-	1282, 1437, 177, 178, 179, 175, 1689, 1096, 504, 942,
-	2041, 1089, 985, 175, 1826, 175, 1089, 2025, 2284, 2202,
-	1436, 2025, 2244, 175, 175, 2025, 2243, 1438, 1462, 1463,
-	504, 2201, 85, 504, 2145, 1494, 1495, 2225, 1089, 629,
-	1482, 94, 629, 86, 504, 983, 984, 982, 1853, 85,
-	1521, 87, 93, 88, 92, 1837, 87, 2025, 2195, 156,
-	86, 2140, 1089, 985, 1457, 2025, 2138, 1457, 161, 994,
-	993, 1003, 1004, 996, 997, 998, 999, 1000, 1001, 1002,
-	995, 1520, 1499, 1005, 93, 597, 88, 1550, 1090, 1467,
-	1562, 1563, 1564, 1565, 1959, 1970, 1524, 1089, 1557, 504,
-	1558, 1559, 1560, 1561, 1970, 1597, 1598, 1599, 1438, 1089,
-	1601, 1603, 1623, 1485, 1089, 2099, 1568, 1569, 1570, 1571,
-	80, 1089, 1549, 504, 1622, 1089, 983, 984, 982, 504,
-	1236, 1578, 1545, 1236, 981, 1236, 1546, 1497, 1781, 583,
-	2102, 1089, 1504, 1621, 985, 2008, 2007, 1526, 1533, 1529,
-	1399, 1584, 1695, 1408, 1409, 1410, 1411, 1412, 1413, 1414,
-	1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422, 1532, 1622,
-	1548, 1547, 2249, 504, 148, 1424, 2071, 2004, 2005, 2025,
-	1424, 1424, 2004, 2003, 1695, 1089, 1526, 1871, 176, 1198,
-	1855, 176, 633, 1505, 176, 633, 1505, 1615, 2077, 505,
-	1618, 176, 1619, 1849, 1850, 1505, 1089, 1579, 1458, 176,
-	2006, 1574, 1575, 981, 1089, 1608, 175, 1592, 1741, 1590,
-	1534, 1527, 1600, 175, 1589, 1718, 822, 1717, 175, 175,
-	1198, 1197, 175, 87, 175, 821, 1233, 1614, 1753, 1579,
-	175, 505, 1617, 1633, 505, 176, 505, 175, 1635, 1636,
-	1632, 1613, 994, 993, 1003, 1004, 996, 997, 998, 999,
-	1000, 1001, 1002, 995, 72, 1631, 1005, 72, 1527, 1143,
-	1142, 1695, 1753, 1622, 1605, 175, 504, 1492, 1668, 1669,
-	72, 1439, 1440, 1671, 1528, 1445, 1448, 1449, 1641, 1468,
-	1695, 1755, 1672, 1530, 994, 993, 1003, 1004, 996, 997,
-	998, 999, 1000, 1001, 1002, 995, 1381, 1327, 1005, 1505,
-	1129, 1461, 804, 2188, 1464, 1465, 1756, 803, 2213, 1401,
-	1003, 1004, 996, 997, 998, 999, 1000, 1001, 1002, 995,
-	1481, 1528, 1005, 1661, 2124, 1982, 607, 1267, 2198, 2289,
-	1526, 80, 1831, 1970, 80, 2116, 149, 154, 151, 157,
-	158, 159, 160, 162, 163, 164, 165, 80, 2110, 1200,
-	1577, 94, 166, 167, 168, 169, 2040, 2000, 1856, 175,
-	1573, 1567, 93, 1566, 92, 1315, 1228, 175, 1224, 1196,
-	106, 504, 1675, 87, 2125, 2126, 2127, 1268, 1269, 1270,
-	1747, 994, 993, 1003, 1004, 996, 997, 998, 999, 1000,
-	1001, 1002, 995, 886, 1684, 1005, 175, 175, 175, 175,
-	175, 2128, 1757, 80, 1402, 1974, 1975, 1830, 175, 1980,
-	2043, 1743, 2214, 175, 1588, 2265, 175, 175, 2023, 34,
-	175, 175, 175, 543, 542, 545, 546, 547, 548, 2022,
-	1742, 1089, 544, 1793, 549, 2021, 1977, 1762, 1959, 1691,
-	1098, 1841, 1712, 1692, 1662, 1374, 90, 1071, 2129, 2130,
-	1772, 1698, 1699, 1724, 1831, 1773, 1979, 1705, 1769, 1770,
-	1708, 1709, 1732, 1264, 1771, 1738, 1768, 2301, 1715, 1750,
-	1716, 1779, 2285, 1719, 1720, 1721, 1722, 1723, 1485, 504,
-	1486, 1782, 1815, 1816, 175, 1784, 1093, 1763, 1480, 1733,
-	1766, 175, 1775, 1764, 1765, 2103, 1767, 504, 1780, 88,
-	1951, 1953, 1785, 504, 2028, 1788, 1940, 1236, 1236, 1954,
-	1265, 1266, 1939, 504, 2092, 1797, 1833, 2234, 591, 1336,
-	1859, 2176, 2091, 2178, 1796, 1868, 1584, 1774, 2095, 1514,
-	1515, 1777, 1778, 601, 1948, 1823, 175, 175, 175, 175,
-	175, 602, 1326, 1817, 1818, 1819, 1820, 585, 1832, 1812,
-	1553, 1835, 175, 175, 1451, 851, 850, 2053, 1685, 1686,
-	1687, 1866, 1842, 1843, 1844, 1838, 1864, 1830, 1452, 1216,
-	1895, 1087, 1083, 954, 1857, 1858, 1863, 1510, 1513, 1514,
-	1515, 1511, 1437, 1512, 1516, 176, 1084, 176, 504, 113,
-	176, 2097, 1424, 85, 1494, 1495, 2019, 1907, 1865, 1510,
-	1513, 1514, 1515, 1511, 86, 1512, 1516, 87, 1438, 1974,
-	1975, 1483, 1484, 1086, 1867, 1085, 85, 94, 505, 505,
-	505, 1665, 504, 87, 1887, 2246, 2209, 86, 93, 1811,
-	92, 1518, 175, 92, 611, 612, 505, 505, 1938, 87,
-	1899, 1893, 504, 1654, 1894, 592, 1937, 1680, 2184, 504,
-	504, 1907, 1926, 1906, 1920, 1905, 94, 1960, 2182, 1942,
-	1919, 1963, 2181, 1956, 2174, 2096, 93, 93, 2173, 92,
-	1087, 1083, 175, 1693, 1694, 2094, 594, 2024, 1606, 610,
-	1969, 2085, 94, 1753, 1741, 1084, 2291, 2290, 1762, 2291,
-	1944, 1710, 1941, 93, 1707, 1704, 1112, 1943, 1104, 2240,
-	1984, 175, 1986, 1995, 1987, 1903, 1904, 595, 596, 599,
-	1080, 1081, 1086, 1978, 1085, 96, 176, 81, 1, 2267,
-	473, 1469, 1069, 487, 2263, 1302, 2017, 1292, 2148, 615,
-	2210, 2031, 175, 1582, 812, 138, 1543, 1985, 1544, 2280,
-	504, 103, 775, 102, 815, 505, 913, 504, 1607, 176,
-	2141, 176, 176, 175, 505, 1813, 1554, 1149, 1147, 1148,
-	505, 2014, 2015, 175, 1146, 1935, 1151, 1150, 2013, 2012,
-	1966, 2032, 1145, 2030, 1375, 1992, 501, 175, 1517, 173,
-	175, 1138, 1105, 852, 463, 2027, 2009, 2029, 1639, 2054,
-	469, 1983, 1013, 1968, 2035, 517, 2001, 2002, 1936, 1584,
-	1789, 2034, 993, 1003, 1004, 996, 997, 998, 999, 1000,
-	1001, 1002, 995, 630, 623, 1005, 584, 73, 2049, 2051,
-	2052, 1965, 609, 2048, 2089, 1947, 1949, 175, 1745, 1952,
-	1945, 2233, 2026, 2175, 2245, 1551, 1101, 1711, 1038, 1453,
-	1121, 2061, 1901, 1902, 526, 1477, 1394, 2058, 2059, 541,
-	2060, 538, 539, 2062, 1734, 2064, 1921, 1922, 1754, 1923,
-	1924, 2083, 987, 524, 518, 1113, 1509, 1507, 1506, 1663,
-	1930, 1931, 2086, 504, 2093, 1125, 1976, 1972, 1119, 1740,
-	1552, 1874, 2037, 2098, 966, 1079, 513, 787, 1450, 2219,
-	73, 2107, 1679, 2073, 1078, 61, 38, 508, 2297, 2112,
-	957, 617, 32, 1762, 592, 2114, 176, 608, 31, 175,
-	30, 2106, 175, 175, 175, 504, 504, 2115, 2056, 592,
-	2057, 29, 28, 23, 22, 21, 2113, 2118, 20, 2120,
-	19, 2066, 2067, 25, 2149, 504, 504, 504, 505, 18,
-	17, 2135, 16, 108, 48, 2144, 45, 2081, 43, 115,
-	2154, 114, 46, 42, 1994, 505, 505, 889, 505, 27,
-	505, 505, 26, 505, 505, 505, 505, 505, 505, 504,
-	504, 504, 175, 15, 14, 13, 2100, 2101, 505, 12,
-	2105, 11, 176, 2153, 2160, 10, 9, 5, 4, 960,
-	24, 71, 2, 504, 0, 504, 2170, 0, 176, 2171,
-	2179, 504, 2189, 2180, 1963, 0, 2191, 504, 1963, 505,
-	0, 176, 2187, 2168, 2169, 0, 0, 2193, 0, 34,
-	0, 0, 553, 0, 2196, 0, 176, 2194, 0, 0,
-	0, 0, 0, 2197, 0, 0, 0, 0, 504, 0,
-	2203, 0, 176, 2139, 0, 2205, 0, 2212, 0, 176,
-	2206, 0, 0, 2055, 0, 0, 0, 0, 176, 176,
-	176, 176, 176, 176, 176, 176, 176, 505, 505, 505,
-	0, 174, 0, 0, 459, 0, 2229, 499, 2232, 2230,
-	0, 0, 0, 0, 459, 0, 0, 0, 0, 0,
-	2165, 2241, 459, 1963, 0, 0, 0, 176, 0, 2199,
-	0, 2200, 0, 0, 504, 175, 0, 0, 0, 0,
-	2248, 0, 0, 0, 0, 171, 504, 0, 616, 0,
-	616, 0, 0, 0, 0, 0, 0, 34, 459, 0,
-	2251, 0, 0, 504, 0, 0, 0, 0, 0, 113,
-	504, 504, 2261, 2266, 2271, 2279, 0, 2274, 0, 2212,
-	2281, 0, 155, 2117, 0, 0, 0, 505, 2288, 0,
-	0, 0, 0, 0, 34, 0, 0, 2215, 2216, 2217,
-	2218, 2294, 2222, 1762, 2223, 2224, 2226, 0, 2300, 0,
-	2227, 2228, 0, 0, 2307, 0, 0, 0, 505, 505,
-	0, 0, 2309, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 176, 0, 0, 0, 0, 152, 0, 153,
-	0, 0, 0, 0, 0, 0, 0, 0, 170, 0,
-	0, 2155, 2156, 2157, 2158, 2159, 0, 0, 0, 2162,
-	2163, 2253, 0, 0, 176, 0, 0, 505, 0, 0,
-	0, 0, 176, 0, 176, 0, 0, 0, 0, 986,
-	0, 0, 176, 176, 0, 0, 0, 0, 0, 505,
-	0, 0, 505, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 505, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 156, 0, 0, 2295, 2296, 1166,
-	0, 0, 0, 161, 1039, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 949, 949, 949, 0, 0,
-	0, 0, 0, 0, 517, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 73, 0, 0, 505, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1014, 608,
-	1103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 505, 0, 0, 0, 0, 552, 505, 0,
-	0, 0, 0, 0, 0, 0, 2070, 0, 0, 1028,
-	0, 0, 2076, 1030, 1031, 1032, 1033, 1034, 1035, 1036,
-	1037, 0, 1040, 1042, 1045, 1045, 1045, 1042, 1045, 1045,
-	1042, 1045, 1058, 1059, 1060, 1061, 1062, 1063, 1064, 148,
-	2275, 0, 505, 0, 0, 1073, 0, 0, 608, 0,
-	0, 0, 1154, 0, 0, 0, 0, 503, 0, 0,
-	0, 0, 0, 0, 0, 608, 994, 993, 1003, 1004,
-	996, 997, 998, 999, 1000, 1001, 1002, 995, 1122, 0,
-	1005, 0, 0, 0, 0, 176, 0, 0, 0, 0,
-	0, 0, 176, 0, 0, 1167, 0, 176, 176, 631,
-	0, 176, 779, 176, 786, 0, 0, 0, 0, 176,
-	0, 0, 0, 0, 0, 0, 176, 0, 459, 0,
-	459, 0, 0, 459, 994, 993, 1003, 1004, 996, 997,
-	998, 999, 1000, 1001, 1002, 995, 0, 2069, 1005, 0,
-	0, 0, 0, 0, 176, 505, 1180, 1183, 1184, 1185,
-	1186, 1187, 1188, 0, 1189, 1190, 1191, 1192, 1193, 1168,
-	1169, 1170, 1171, 1152, 1153, 1181, 0, 1155, 0, 1156,
-	1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1172,
-	1173, 1174, 1175, 1176, 1177, 1178, 1179, 0, 0, 0,
-	0, 0, 2068, 994, 993, 1003, 1004, 996, 997, 998,
-	999, 1000, 1001, 1002, 995, 0, 0, 1005, 0, 0,
-	0, 149, 154, 151, 157, 158, 159, 160, 162, 163,
-	164, 165, 0, 0, 0, 0, 0, 166, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 176, 0,
-	0, 0, 0, 0, 0, 0, 176, 0, 0, 459,
-	505, 0, 0, 0, 1182, 994, 993, 1003, 1004, 996,
-	997, 998, 999, 1000, 1001, 1002, 995, 0, 0, 1005,
-	1338, 0, 0, 0, 0, 176, 176, 176, 176, 176,
-	0, 0, 459, 0, 459, 1128, 0, 176, 0, 0,
-	0, 0, 176, 0, 0, 176, 176, 0, 0, 176,
-	176, 176, 0, 72, 36, 37, 74, 39, 40, 0,
-	994, 993, 1003, 1004, 996, 997, 998, 999, 1000, 1001,
-	1002, 995, 0, 78, 1005, 0, 0, 41, 67, 68,
-	0, 65, 69, 0, 0, 0, 0, 0, 0, 0,
-	66, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1390, 1391, 1392, 1393, 0, 0, 0, 505, 0,
-	0, 0, 0, 176, 0, 0, 0, 0, 0, 54,
-	176, 0, 0, 0, 0, 0, 505, 0, 0, 0,
-	80, 0, 505, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 505, 0, 949, 949, 949, 0, 0, 0,
-	0, 0, 1441, 1442, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 176, 176, 176, 176, 176,
-	0, 0, 0, 0, 1900, 0, 0, 0, 0, 0,
-	0, 176, 176, 0, 0, 0, 0, 0, 0, 459,
-	0, 0, 0, 517, 994, 993, 1003, 1004, 996, 997,
-	998, 999, 1000, 1001, 1002, 995, 0, 0, 1005, 44,
-	47, 50, 49, 52, 0, 64, 0, 505, 70, 1490,
-	1491, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1239, 0, 631, 631, 631, 0,
-	53, 77, 76, 0, 0, 62, 63, 51, 0, 0,
-	0, 505, 0, 0, 956, 958, 0, 1540, 0, 1239,
-	1239, 176, 0, 0, 0, 459, 0, 0, 0, 0,
-	0, 505, 0, 0, 0, 0, 0, 0, 505, 505,
-	0, 1290, 0, 0, 0, 0, 55, 56, 0, 57,
-	58, 59, 60, 0, 459, 0, 0, 0, 0, 0,
-	0, 176, 0, 0, 0, 1072, 0, 0, 0, 1335,
-	0, 0, 0, 0, 0, 0, 1580, 0, 0, 0,
-	0, 0, 0, 0, 0, 459, 0, 0, 0, 1522,
-	176, 0, 459, 0, 0, 0, 0, 0, 0, 0,
-	0, 1358, 1359, 459, 459, 459, 459, 459, 459, 459,
-	0, 0, 0, 0, 0, 0, 0, 458, 0, 0,
-	0, 176, 0, 1108, 0, 0, 0, 507, 0, 505,
-	0, 0, 631, 0, 0, 588, 505, 0, 1139, 0,
-	459, 0, 176, 0, 0, 0, 0, 0, 0, 0,
-	0, 75, 176, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 989, 176, 992, 0, 176,
-	0, 783, 0, 1006, 1007, 1008, 1009, 1010, 1011, 1012,
-	0, 990, 991, 988, 994, 993, 1003, 1004, 996, 997,
-	998, 999, 1000, 1001, 1002, 995, 0, 1690, 1005, 0,
-	0, 616, 1335, 616, 616, 0, 0, 616, 616, 616,
-	0, 0, 0, 1239, 0, 0, 176, 994, 993, 1003,
-	1004, 996, 997, 998, 999, 1000, 1001, 1002, 995, 0,
-	0, 1005, 0, 616, 616, 616, 616, 616, 0, 0,
-	0, 0, 0, 0, 0, 1290, 0, 0, 0, 0,
-	0, 0, 616, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 505, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 459, 0, 0,
-	0, 0, 0, 1335, 0, 459, 0, 459, 0, 0,
-	0, 0, 0, 0, 0, 459, 459, 0, 176, 0,
-	0, 176, 176, 176, 505, 505, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 779, 0, 0, 0,
-	0, 0, 0, 0, 505, 505, 505, 0, 0, 1238,
-	0, 0, 0, 1244, 1244, 1714, 1244, 0, 1244, 1244,
-	0, 1253, 1244, 1244, 1244, 1244, 1244, 0, 0, 0,
-	0, 0, 0, 0, 1238, 1238, 779, 0, 505, 505,
-	505, 176, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 505, 0, 505, 0, 0, 1314, 0, 0,
-	505, 0, 0, 0, 0, 0, 505, 0, 0, 0,
-	0, 0, 0, 1696, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 505, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1731, 0, 0, 0, 631, 631, 631, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1758, 1759,
-	0, 0, 1122, 1122, 1122, 1122, 1122, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 459, 1522,
-	0, 0, 1122, 505, 176, 459, 1122, 0, 0, 0,
-	459, 459, 0, 0, 459, 505, 1666, 0, 0, 0,
-	0, 0, 459, 0, 0, 0, 0, 0, 0, 459,
-	0, 890, 505, 895, 0, 0, 897, 0, 0, 505,
-	505, 0, 0, 0, 0, 1430, 0, 631, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 459, 1238, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1459, 1460, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1861, 0, 0, 1928, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 616, 616, 0, 0, 0,
-	0, 0, 0, 0, 0, 1108, 0, 0, 631, 171,
-	0, 0, 0, 616, 0, 0, 0, 0, 0, 0,
-	1846, 0, 0, 1957, 0, 0, 0, 631, 0, 0,
-	631, 459, 0, 113, 0, 135, 0, 0, 0, 1290,
-	0, 779, 0, 0, 0, 0, 155, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1239, 459, 459,
-	459, 459, 459, 0, 0, 1115, 0, 145, 1126, 0,
-	1776, 0, 134, 0, 0, 459, 0, 0, 459, 459,
-	0, 0, 459, 1786, 1335, 0, 786, 0, 1122, 0,
-	0, 152, 0, 153, 0, 0, 0, 0, 1219, 1220,
-	144, 143, 170, 1964, 0, 73, 0, 0, 0, 0,
-	779, 0, 0, 0, 0, 0, 786, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1122, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 459, 0, 0, 0,
-	0, 0, 0, 1845, 0, 0, 0, 139, 1221, 146,
-	779, 1218, 0, 140, 141, 0, 0, 0, 156, 0,
-	0, 0, 0, 1335, 0, 0, 0, 161, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2075,
-	0, 0, 0, 0, 0, 0, 0, 0, 459, 459,
-	459, 459, 459, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 459, 459, 0, 0, 0, 0,
-	0, 0, 1144, 0, 517, 0, 0, 0, 0, 0,
-	0, 2108, 0, 0, 2109, 0, 0, 2111, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 616, 616,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1674, 0, 0, 0, 0, 0, 0,
-	0, 0, 2072, 0, 0, 0, 0, 0, 0, 2078,
-	2079, 2080, 0, 148, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 459, 0, 0, 0, 1275, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1239, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1316, 0, 0,
-	0, 0, 0, 0, 459, 0, 0, 0, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 136, 0, 0, 137, 0, 0, 0, 1345, 0,
-	0, 0, 0, 459, 0, 1349, 0, 0, 1735, 0,
-	0, 0, 0, 0, 0, 0, 1360, 1361, 1362, 1363,
-	1364, 1365, 1366, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1238, 0, 459, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1239, 0, 0, 0, 0,
-	0, 0, 0, 1126, 0, 459, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 459, 0, 0, 0, 0,
-	0, 0, 0, 2231, 517, 0, 0, 0, 0, 459,
-	0, 0, 459, 0, 0, 0, 1964, 0, 73, 0,
-	1964, 0, 0, 0, 0, 149, 154, 151, 157, 158,
-	159, 160, 162, 163, 164, 165, 0, 0, 0, 0,
-	0, 166, 167, 168, 169, 0, 1836, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 459,
-	0, 0, 0, 0, 1848, 0, 0, 0, 0, 0,
-	1854, 0, 0, 0, 0, 0, 0, 0, 631, 0,
-	1860, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 171, 1239, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1215, 0, 1964, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 113, 0, 135, 0,
-	1496, 2247, 0, 0, 0, 0, 73, 0, 1500, 155,
-	1503, 0, 0, 0, 0, 0, 0, 0, 0, 1523,
-	0, 459, 0, 0, 459, 459, 459, 0, 0, 0,
-	0, 0, 0, 0, 0, 631, 0, 0, 0, 0,
-	145, 0, 0, 73, 0, 134, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 152, 0, 153, 0, 0, 1244,
-	0, 1219, 1220, 144, 143, 170, 0, 0, 0, 0,
-	0, 0, 0, 0, 1290, 0, 0, 0, 0, 631,
-	0, 0, 0, 1238, 0, 0, 1967, 1244, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	607, 2171, 2243, 2438, 2380, 2135, 2400, 2387, 1883, 1890,
+	3, 2367, 2339, 2327, 1553, 1845, 2275, 2056, 568, 1809,
+	1113, 2313, 618, 1891, 985, 2189, 569, 35, 1537, 1680,
+	639, 2057, 1145, 2267, 1610, 2280, 538, 1846, 553, 2053,
+	2045, 1829, 2183, 1645, 1974, 2172, 1912, 1935, 1665, 536,
+	179, 932, 1650, 179, 36, 499, 179, 1913, 151, 2068,
+	1821, 517, 1837, 179, 1132, 811, 1993, 1914, 1475, 1295,
+	1487, 179, 1757, 961, 1483, 1277, 1588, 1678, 1386, 1186,
+	1176, 1711, 839, 666, 179, 1251, 874, 529, 1652, 34,
+	137, 1664, 1906, 1179, 1577, 1570, 540, 1154, 88, 1169,
+	1452, 622, 1539, 626, 1172, 1557, 517, 1116, 1003, 517,
+	179, 517, 1793, 1506, 1383, 818, 1662, 1369, 1170, 815,
+	1562, 642, 640, 1284, 840, 845, 1641, 841, 819, 1185,
+	663, 628, 620, 1594, 95, 631, 1158, 1524, 852, 842,
+	1183, 977, 1391, 1246, 120, 1269, 121, 629, 94, 1530,
+	524, 983, 154, 114, 115, 917, 8, 7, 92, 6,
+	86, 1079, 1954, 1953, 1709, 99, 627, 1355, 93, 1076,
+	647, 1831, 652, 1558, 82, 827, 1981, 1982, 617, 1441,
+	822, 1534, 1535, 1440, 87, 1439, 633, 181, 182, 183,
+	1438, 812, 533, 122, 1437, 1436, 1424, 1429, 527, 116,
+	528, 879, 2430, 1807, 101, 102, 103, 104, 105, 2372,
+	2041, 111, 2373, 2412, 176, 525, 2330, 466, 2329, 2105,
+	2221, 2340, 619, 1794, 2310, 2309, 876, 2239, 471, 75,
+	2240, 1004, 77, 40, 41, 2455, 878, 614, 634, 890,
+	891, 625, 894, 895, 896, 897, 660, 1004, 900, 901,
+	902, 903, 904, 905, 906, 907, 908, 909, 910, 911,
+	912, 913, 914, 856, 855, 877, 116, 833, 832, 641,
+	2416, 2372, 2410, 2414, 2373, 1747, 2374, 2453, 1486, 667,
+	75, 75, 2366, 880, 881, 882, 616, 75, 1657, 887,
+	834, 2446, 2244, 2401, 1697, 2415, 2409, 1014, 2413, 2365,
+	2010, 2368, 2167, 1260, 1840, 2083, 84, 1536, 2084, 2085,
+	1655, 1961, 1808, 1014, 581, 1960, 587, 588, 585, 586,
+	1980, 584, 583, 582, 1605, 1606, 1745, 1604, 892, 1841,
+	116, 589, 590, 1187, 951, 1188, 2291, 175, 2374, 1595,
+	826, 920, 828, 612, 968, 1876, 970, 611, 1875, 939,
+	952, 1877, 916, 1899, 940, 2186, 945, 84, 84, 502,
+	980, 117, 1625, 1624, 84, 831, 2158, 926, 927, 2156,
+	1430, 1431, 1432, 939, 159, 515, 956, 957, 940, 181,
+	182, 183, 967, 969, 1428, 2137, 938, 1010, 937, 519,
+	1002, 502, 502, 513, 1120, 1936, 1679, 1654, 893, 831,
+	1957, 823, 1712, 1010, 1370, 2131, 502, 1717, 825, 824,
+	1722, 1720, 1721, 2132, 2452, 1724, 649, 1725, 974, 1726,
+	960, 829, 922, 1345, 1375, 1727, 953, 954, 955, 156,
+	835, 157, 946, 1969, 899, 2431, 831, 915, 898, 1716,
+	174, 2322, 1029, 1028, 1038, 1039, 1031, 1032, 1033, 1034,
+	1035, 1036, 1037, 1030, 2139, 829, 1040, 979, 1714, 2306,
+	1718, 2138, 958, 2234, 1681, 1346, 179, 1347, 179, 2173,
+	965, 179, 959, 1263, 966, 2174, 863, 1571, 861, 1715,
+	872, 871, 836, 530, 971, 870, 1895, 873, 869, 972,
+	1994, 868, 867, 866, 865, 860, 2449, 2104, 816, 919,
+	517, 517, 517, 848, 2444, 816, 816, 160, 964, 2442,
+	814, 847, 1384, 643, 503, 854, 165, 1663, 654, 1283,
+	517, 517, 1810, 1812, 935, 1970, 941, 942, 943, 944,
+	830, 949, 996, 1703, 1996, 1380, 1009, 1006, 1007, 1008,
+	1013, 1015, 1012, 113, 1011, 2112, 503, 503, 35, 981,
+	982, 1005, 1009, 1006, 1007, 1008, 1013, 1015, 1012, 973,
+	1011, 503, 2370, 889, 830, 2369, 975, 1005, 1959, 990,
+	78, 1376, 883, 1656, 1956, 853, 864, 2187, 862, 854,
+	857, 847, 2028, 83, 1595, 1282, 2364, 918, 2027, 2026,
+	858, 2174, 2393, 1258, 1257, 2391, 1998, 1973, 2002, 1256,
+	1997, 830, 1995, 1946, 2395, 2396, 1381, 2000, 859, 1893,
+	1894, 854, 179, 1254, 2392, 1746, 1999, 1357, 1356, 1358,
+	1359, 1360, 152, 1886, 2370, 470, 465, 2369, 1811, 2001,
+	2003, 179, 1050, 1699, 83, 83, 1111, 854, 1374, 853,
+	2352, 83, 928, 1138, 1125, 936, 1124, 925, 1775, 1122,
+	517, 1127, 987, 988, 179, 854, 179, 179, 1968, 517,
+	2203, 1967, 1051, 1052, 2323, 517, 2082, 2440, 1887, 1836,
+	2441, 853, 2439, 948, 1772, 1892, 857, 847, 999, 997,
+	1766, 998, 1689, 663, 950, 1600, 858, 1895, 1976, 1162,
+	1064, 1889, 930, 1975, 1884, 1030, 1611, 853, 1040, 1112,
+	1040, 1976, 1872, 847, 850, 851, 1975, 816, 1893, 1894,
+	636, 844, 848, 1885, 1112, 853, 1117, 888, 181, 182,
+	183, 921, 1477, 962, 1392, 95, 1371, 978, 1372, 934,
+	843, 1373, 1168, 1020, 1053, 1054, 1055, 1056, 1057, 1058,
+	1059, 1060, 1061, 1062, 1082, 1084, 2360, 1087, 1089, 92,
+	1092, 1078, 1081, 1083, 1085, 1086, 1088, 1090, 1091, 93,
+	854, 1114, 181, 182, 183, 875, 1833, 1698, 2066, 108,
+	619, 1019, 1017, 1713, 1892, 1377, 1459, 1141, 1144, 1033,
+	1034, 1035, 1036, 1037, 1030, 1478, 1895, 1040, 1020, 1126,
+	1457, 1458, 1456, 1189, 1123, 1000, 1051, 1052, 1563, 1564,
+	153, 158, 155, 161, 162, 163, 164, 166, 167, 168,
+	169, 179, 1507, 2012, 1782, 1247, 170, 171, 172, 173,
+	853, 109, 1051, 1052, 1255, 1507, 847, 850, 851, 1834,
+	816, 1017, 667, 1925, 844, 848, 1018, 1019, 1017, 2434,
+	1018, 1019, 1017, 517, 2014, 1279, 1152, 1020, 963, 1393,
+	2289, 933, 2092, 1288, 1020, 2091, 1685, 1292, 1020, 1294,
+	517, 517, 1293, 517, 1281, 517, 517, 1696, 517, 517,
+	517, 517, 517, 517, 1694, 1031, 1032, 1033, 1034, 1035,
+	1036, 1037, 1030, 517, 1888, 1040, 1691, 179, 1328, 1018,
+	1019, 1017, 1289, 1038, 1039, 1031, 1032, 1033, 1034, 1035,
+	1036, 1037, 1030, 179, 863, 1040, 1771, 1020, 1275, 1691,
+	1695, 1151, 861, 2424, 517, 2087, 179, 1323, 1324, 1163,
+	2220, 1261, 1262, 2382, 2345, 2450, 2175, 1382, 1287, 1268,
+	1297, 179, 1298, 1693, 1300, 1302, 2219, 2110, 1306, 1308,
+	1310, 1312, 1314, 1018, 1019, 1017, 1184, 179, 1325, 1021,
+	653, 1910, 2383, 2346, 179, 1750, 1751, 1752, 1018, 1019,
+	1017, 1020, 1909, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 517, 517, 517, 1286, 1020, 1253, 1770, 1331,
+	1332, 1660, 1266, 1265, 1264, 1337, 1338, 1769, 1278, 1285,
+	1285, 1139, 1365, 1259, 1364, 1074, 2451, 1396, 1911, 1018,
+	1019, 1017, 1362, 179, 1400, 1341, 1402, 1403, 1404, 1405,
+	1350, 1394, 1395, 1409, 1388, 1349, 84, 1020, 1348, 1018,
+	1019, 1017, 1018, 1019, 1017, 1399, 530, 1423, 1455, 1018,
+	1019, 1017, 1406, 1407, 1408, 1805, 1339, 1020, 1801, 1333,
+	1020, 1476, 1453, 1385, 1330, 2425, 1352, 1020, 1329, 2349,
+	1479, 1304, 658, 1121, 655, 656, 1363, 1326, 1447, 1449,
+	1450, 1155, 2348, 517, 1361, 1148, 2347, 1018, 1019, 1017,
+	1018, 1019, 1017, 2288, 116, 833, 832, 1448, 181, 182,
+	183, 2286, 1879, 1435, 2258, 1020, 2217, 2090, 1020, 1488,
+	1398, 181, 182, 183, 517, 517, 181, 182, 183, 1919,
+	1673, 1480, 1481, 1907, 1707, 1706, 1495, 1498, 1351, 181,
+	182, 183, 1508, 1671, 1149, 1556, 1422, 1543, 1425, 179,
+	1454, 1389, 1353, 1419, 1420, 1421, 1340, 1336, 1029, 1028,
+	1038, 1039, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1030,
+	1542, 1335, 1040, 1489, 179, 1334, 1150, 517, 1490, 976,
+	557, 556, 559, 560, 561, 562, 1544, 179, 2134, 558,
+	517, 563, 1827, 2407, 1139, 179, 2304, 179, 98, 1827,
+	2354, 1514, 1515, 89, 2303, 179, 179, 1827, 2353, 97,
+	91, 96, 517, 2242, 90, 517, 1937, 1488, 1758, 98,
+	91, 2333, 1139, 2237, 1139, 1922, 517, 1549, 1827, 2235,
+	97, 1590, 96, 89, 1691, 1139, 663, 2201, 1139, 663,
+	2102, 2101, 2098, 2099, 90, 1451, 2098, 2097, 1460, 1461,
+	1462, 1463, 1464, 1465, 1466, 1467, 1468, 1469, 1470, 1471,
+	1472, 1473, 1474, 1619, 92, 1764, 1139, 97, 1139, 91,
+	633, 1568, 1595, 1955, 93, 1526, 1490, 1596, 1139, 2065,
+	92, 517, 1823, 1614, 1250, 1939, 1593, 1666, 1667, 1668,
+	93, 1615, 1670, 1672, 1589, 1933, 1934, 91, 1139, 1139,
+	1838, 1140, 1142, 1510, 1596, 517, 1574, 1139, 1618, 1551,
+	1573, 517, 1288, 1827, 1826, 1288, 1692, 1288, 1626, 1647,
+	1627, 1628, 1629, 1630, 1838, 1690, 1566, 1139, 1653, 1016,
+	1139, 1250, 1249, 1195, 1194, 1764, 1637, 1638, 1639, 1640,
+	1597, 1598, 1602, 2418, 1631, 1632, 1633, 1634, 2054, 1599,
+	1617, 1866, 1601, 1616, 1764, 517, 2198, 1476, 2065, 1016,
+	1595, 2359, 1476, 1476, 1574, 1827, 1574, 1597, 2100, 1603,
+	1677, 1574, 1787, 1691, 1491, 1492, 1595, 1786, 1497, 1500,
+	1501, 1390, 1764, 1691, 1674, 667, 1561, 1684, 667, 2222,
+	1687, 1143, 1688, 1532, 1433, 2065, 1648, 1379, 179, 1643,
+	1644, 1661, 1659, 1319, 1513, 179, 1669, 1516, 1517, 1658,
+	179, 179, 1181, 838, 179, 623, 179, 837, 84, 2315,
+	1700, 1146, 179, 2214, 2209, 1702, 1683, 1252, 1648, 179,
+	1704, 1705, 1646, 1701, 1682, 608, 2133, 1686, 1548, 2223,
+	2224, 2225, 856, 855, 2094, 1940, 1642, 1636, 1635, 1367,
+	1280, 1276, 1285, 1320, 1321, 1322, 1248, 179, 517, 110,
+	2226, 1916, 1316, 1442, 1443, 1444, 1445, 920, 2136, 1915,
+	2069, 2070, 2316, 1657, 73, 1139, 1737, 1738, 1710, 2421,
+	2388, 1740, 2117, 2433, 2116, 180, 2115, 2072, 180, 2054,
+	1741, 180, 84, 1926, 1731, 1426, 518, 1547, 180, 1857,
+	1859, 2075, 1583, 1584, 1858, 1453, 180, 2227, 2228, 1317,
+	1318, 2074, 1855, 1854, 1493, 1494, 1916, 1856, 1853, 180,
+	2408, 1555, 1147, 2202, 2121, 2034, 1730, 2033, 1029, 1028,
+	1038, 1039, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1030,
+	2191, 518, 1040, 2344, 518, 180, 518, 2279, 2190, 621,
+	2281, 2194, 1523, 1525, 1525, 1527, 1528, 1529, 1579, 1582,
+	1583, 1584, 1580, 179, 1581, 1585, 530, 2043, 2069, 2070,
+	1378, 179, 1744, 1579, 1582, 1583, 1584, 1580, 517, 1581,
+	1585, 2046, 2048, 1454, 637, 610, 1897, 1753, 1622, 1832,
+	2049, 1920, 638, 885, 884, 2146, 1503, 1915, 1559, 1560,
+	1979, 89, 989, 91, 1948, 179, 179, 179, 179, 179,
+	1504, 1842, 90, 1137, 1133, 1947, 117, 179, 2196, 1563,
+	1564, 2113, 179, 1734, 98, 179, 179, 35, 1134, 179,
+	179, 179, 89, 2419, 2356, 97, 1609, 96, 2311, 91,
+	1847, 1896, 1878, 90, 1824, 1587, 91, 1552, 1127, 1781,
+	645, 646, 2032, 1545, 1546, 1136, 1723, 1135, 98, 1749,
+	2031, 96, 1795, 1796, 1797, 1798, 2287, 1117, 2285, 97,
+	1806, 96, 2284, 1814, 2277, 2264, 2195, 1820, 2193, 1825,
+	1754, 1755, 1756, 2118, 1675, 644, 97, 2276, 517, 1835,
+	2184, 1838, 1828, 179, 1823, 1649, 1902, 1903, 1904, 1905,
+	179, 1867, 1881, 1776, 1848, 1869, 517, 1851, 98, 1773,
+	1860, 1864, 517, 1873, 1388, 2423, 1288, 1288, 1865, 97,
+	1932, 1164, 517, 1870, 1849, 1850, 1156, 1852, 1882, 98,
+	1653, 2423, 2422, 2350, 1952, 2089, 635, 100, 85, 1,
+	1137, 1133, 2390, 92, 1943, 179, 179, 179, 179, 179,
+	483, 1509, 1533, 93, 1509, 1134, 1115, 498, 497, 1908,
+	2386, 179, 179, 1900, 1901, 1354, 1344, 1951, 2245, 2312,
+	1917, 1918, 2124, 1923, 1518, 1519, 1520, 1521, 1522, 1950,
+	1130, 1131, 1136, 1651, 1135, 846, 1140, 1531, 1927, 1928,
+	1929, 1941, 1942, 1762, 1763, 1268, 142, 517, 1489, 1612,
+	1613, 1476, 2403, 1490, 107, 809, 106, 849, 1949, 947,
+	1676, 1779, 2238, 1898, 1623, 1550, 1201, 1199, 1200, 1198,
+	1203, 1992, 1202, 1197, 1427, 517, 514, 1586, 177, 1190,
+	1157, 886, 2011, 473, 2103, 1708, 479, 1048, 2030, 1874,
+	1977, 1971, 664, 1978, 517, 657, 2060, 2188, 2042, 2044,
+	1830, 2047, 2040, 2343, 179, 2278, 2355, 1983, 517, 1620,
+	1153, 1780, 1073, 1505, 1173, 1990, 539, 517, 2005, 2004,
+	1991, 1541, 1446, 554, 517, 517, 551, 552, 1816, 1839,
+	1022, 537, 531, 1165, 1578, 1576, 2055, 1575, 1732, 1177,
+	2058, 1992, 2071, 2067, 1171, 1822, 1621, 179, 2051, 1958,
+	626, 180, 2130, 180, 1987, 1001, 180, 1847, 1129, 526,
+	821, 2035, 1502, 2029, 2036, 2321, 1748, 2166, 1128, 2038,
+	62, 39, 615, 521, 2076, 2429, 179, 992, 628, 651,
+	2037, 33, 32, 31, 30, 518, 518, 518, 2064, 29,
+	28, 2073, 2019, 2020, 2063, 2022, 2023, 2024, 23, 22,
+	21, 2111, 20, 19, 1783, 518, 518, 179, 2078, 25,
+	2080, 18, 2081, 627, 517, 2095, 2096, 17, 16, 2086,
+	112, 517, 49, 2079, 46, 44, 119, 179, 118, 47,
+	2170, 1985, 1986, 43, 923, 2123, 27, 179, 26, 15,
+	14, 13, 12, 11, 10, 2006, 2007, 9, 2008, 2009,
+	5, 179, 2107, 2120, 179, 2125, 4, 995, 1155, 2015,
+	2016, 2106, 24, 2147, 2379, 2328, 2371, 555, 1653, 74,
+	2300, 2, 2122, 0, 0, 0, 0, 2128, 2127, 2119,
+	0, 0, 2108, 2109, 1029, 1028, 1038, 1039, 1031, 1032,
+	1033, 1034, 1035, 1036, 1037, 1030, 2141, 180, 1040, 2142,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2144,
+	2145, 179, 0, 0, 0, 0, 180, 0, 0, 0,
+	0, 0, 2154, 1029, 1028, 1038, 1039, 1031, 1032, 1033,
+	1034, 1035, 1036, 1037, 1030, 518, 0, 1040, 0, 180,
+	0, 180, 180, 0, 518, 0, 0, 0, 0, 0,
+	518, 0, 0, 0, 0, 0, 0, 0, 0, 2088,
+	2151, 2152, 2185, 2153, 0, 2192, 2155, 2205, 2157, 0,
+	0, 0, 0, 0, 0, 2197, 0, 0, 2182, 0,
+	0, 2211, 1847, 2206, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 179, 0, 0, 179, 179, 179, 517,
+	0, 0, 0, 1760, 0, 0, 0, 1761, 2213, 0,
+	0, 0, 0, 2233, 0, 1767, 1768, 2246, 517, 517,
+	517, 1774, 2212, 0, 1777, 1778, 0, 0, 0, 0,
+	0, 0, 1784, 2251, 1785, 0, 0, 1788, 1789, 1790,
+	1791, 1792, 2241, 0, 0, 0, 0, 1799, 1800, 0,
+	1802, 1803, 1804, 0, 1550, 0, 0, 2148, 517, 517,
+	517, 179, 0, 0, 0, 1815, 0, 0, 566, 0,
+	0, 0, 0, 0, 0, 2262, 0, 2257, 0, 2013,
+	0, 0, 517, 2216, 517, 2218, 0, 0, 0, 2273,
+	517, 2292, 2271, 2272, 2283, 517, 180, 2058, 2021, 2263,
+	2294, 2058, 2025, 2282, 2297, 2274, 0, 35, 0, 2299,
+	2290, 0, 1862, 1863, 0, 0, 2296, 0, 0, 0,
+	0, 0, 2298, 0, 0, 517, 0, 0, 518, 516,
+	0, 2301, 0, 2302, 0, 2052, 0, 0, 2250, 2307,
+	0, 0, 2305, 0, 0, 518, 518, 2308, 518, 2314,
+	518, 518, 0, 518, 518, 518, 518, 518, 518, 0,
+	0, 0, 0, 0, 0, 2332, 2331, 2215, 518, 2266,
+	0, 2338, 180, 2337, 665, 0, 0, 813, 2342, 820,
+	0, 0, 0, 0, 0, 0, 0, 0, 180, 2351,
+	2058, 0, 0, 0, 0, 0, 517, 179, 0, 518,
+	0, 180, 2358, 0, 0, 0, 0, 0, 0, 0,
+	2361, 0, 0, 0, 517, 0, 180, 0, 35, 0,
+	0, 517, 0, 0, 0, 2375, 0, 0, 0, 0,
+	0, 2378, 180, 517, 2252, 2253, 2254, 2255, 2256, 180,
+	517, 517, 2259, 2260, 2389, 2394, 2384, 2397, 180, 180,
+	180, 180, 180, 180, 180, 180, 180, 518, 518, 518,
+	2411, 2402, 0, 35, 2314, 2404, 0, 1847, 0, 0,
+	0, 0, 0, 2169, 2420, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2426, 0, 1988, 1989, 180, 2164,
+	0, 0, 0, 2432, 0, 0, 0, 0, 517, 517,
+	0, 0, 0, 2436, 0, 0, 0, 2443, 0, 0,
+	0, 2168, 0, 0, 0, 2445, 0, 0, 0, 2448,
+	0, 0, 0, 0, 0, 0, 2447, 1029, 1028, 1038,
+	1039, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1030, 0,
+	0, 1040, 0, 0, 0, 0, 0, 0, 518, 0,
+	0, 0, 0, 0, 0, 0, 530, 0, 0, 0,
+	0, 0, 0, 2207, 2163, 0, 2208, 2061, 0, 2210,
+	0, 0, 0, 1024, 0, 1027, 0, 0, 0, 518,
+	518, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 2077, 1025,
+	1026, 1023, 1029, 1028, 1038, 1039, 1031, 1032, 1033, 1034,
+	1035, 1036, 1037, 1030, 180, 0, 1040, 1029, 1028, 1038,
+	1039, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1030, 2162,
+	0, 1040, 0, 0, 0, 0, 0, 0, 0, 180,
+	2398, 0, 518, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 180, 0, 0, 518, 0, 2417, 0, 0,
+	180, 0, 180, 0, 0, 0, 0, 0, 0, 0,
+	180, 180, 0, 0, 0, 0, 0, 518, 0, 0,
+	518, 0, 2265, 0, 2161, 0, 0, 1984, 0, 0,
+	0, 518, 1029, 1028, 1038, 1039, 1031, 1032, 1033, 1034,
+	1035, 1036, 1037, 1030, 0, 0, 1040, 1029, 1028, 1038,
+	1039, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1030, 0,
+	0, 1040, 0, 0, 0, 2149, 0, 2150, 0, 0,
+	0, 0, 0, 0, 0, 0, 175, 0, 2159, 2160,
+	0, 0, 0, 0, 0, 0, 518, 1029, 1028, 1038,
+	1039, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1030, 2176,
+	117, 1040, 0, 2180, 0, 0, 0, 0, 0, 0,
+	518, 530, 0, 159, 0, 0, 518, 0, 665, 665,
+	665, 0, 0, 2341, 530, 0, 0, 0, 0, 0,
+	0, 0, 0, 2199, 2200, 0, 0, 2204, 991, 993,
+	0, 0, 1029, 1028, 1038, 1039, 1031, 1032, 1033, 1034,
+	1035, 1036, 1037, 1030, 0, 1880, 1040, 0, 0, 0,
+	518, 1759, 0, 0, 0, 0, 0, 0, 156, 0,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	0, 1029, 1028, 1038, 1039, 1031, 1032, 1033, 1034, 1035,
+	1036, 1037, 1030, 0, 0, 1040, 0, 0, 2236, 0,
+	0, 0, 0, 180, 0, 0, 0, 0, 0, 0,
+	180, 0, 0, 0, 0, 180, 180, 0, 0, 180,
+	0, 180, 0, 0, 0, 0, 0, 180, 0, 0,
+	0, 0, 0, 0, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2261, 160, 0, 0, 0,
+	0, 2268, 0, 0, 0, 165, 0, 0, 0, 0,
+	0, 0, 180, 518, 0, 0, 0, 0, 1160, 0,
+	0, 0, 0, 0, 0, 0, 0, 665, 0, 175,
+	0, 0, 0, 1191, 0, 0, 0, 0, 0, 0,
+	1931, 1028, 1038, 1039, 1031, 1032, 1033, 1034, 1035, 1036,
+	1037, 1030, 0, 117, 1040, 139, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 159, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2317, 2318, 2319, 2320,
+	0, 2324, 0, 2325, 2326, 0, 1118, 0, 0, 0,
+	0, 2334, 0, 0, 0, 2335, 2336, 149, 0, 0,
+	0, 0, 138, 0, 0, 0, 0, 0, 0, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 180, 0,
+	0, 156, 0, 157, 0, 0, 180, 0, 1271, 1272,
+	148, 147, 174, 518, 0, 0, 0, 0, 0, 468,
+	0, 0, 0, 0, 0, 2363, 0, 0, 0, 520,
+	0, 0, 0, 0, 0, 0, 0, 613, 0, 0,
+	180, 180, 180, 180, 180, 0, 0, 0, 0, 0,
+	0, 0, 180, 0, 0, 0, 0, 180, 0, 0,
+	180, 180, 0, 0, 180, 180, 180, 143, 1273, 150,
+	0, 1270, 0, 144, 145, 0, 817, 0, 0, 160,
+	0, 0, 0, 0, 0, 0, 0, 0, 165, 0,
+	0, 813, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2427, 2428, 1290, 0, 0, 0, 1296, 1296,
+	0, 1296, 0, 1296, 1296, 0, 1305, 1296, 1296, 1296,
+	1296, 1296, 0, 518, 0, 0, 0, 0, 180, 1290,
+	1290, 813, 0, 0, 0, 180, 0, 0, 0, 0,
+	0, 518, 0, 0, 0, 0, 0, 518, 609, 76,
+	0, 0, 2454, 0, 0, 0, 0, 518, 0, 0,
+	0, 0, 1366, 0, 0, 0, 0, 0, 0, 153,
+	158, 155, 161, 162, 163, 164, 166, 167, 168, 169,
+	180, 180, 180, 180, 180, 170, 171, 172, 173, 0,
+	0, 0, 0, 0, 152, 0, 180, 180, 1029, 1028,
+	1038, 1039, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1030,
+	0, 0, 1040, 0, 0, 0, 0, 0, 0, 0,
+	665, 665, 665, 624, 0, 76, 0, 0, 0, 0,
+	0, 1218, 518, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 624, 0, 0, 0, 0, 0, 0,
+	0, 0, 146, 0, 0, 0, 0, 0, 0, 0,
+	518, 0, 0, 0, 140, 0, 0, 141, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 518,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
+	0, 0, 0, 518, 0, 0, 0, 0, 0, 0,
+	0, 0, 518, 0, 0, 0, 0, 0, 0, 518,
+	518, 1482, 0, 665, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1290, 0, 0, 0, 0, 0,
+	0, 0, 180, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1511, 1512, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1206, 0, 0, 0, 0, 0,
+	0, 180, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 153, 158, 155, 161, 162, 163, 164, 166,
+	167, 168, 169, 0, 0, 0, 0, 0, 170, 171,
+	172, 173, 180, 0, 0, 1554, 0, 0, 1219, 518,
+	0, 0, 0, 0, 0, 0, 518, 0, 1160, 0,
+	0, 665, 180, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 180, 0, 0, 0, 0, 0, 0, 0,
+	665, 0, 924, 665, 929, 0, 180, 931, 0, 180,
+	0, 0, 0, 0, 813, 0, 0, 0, 567, 1232,
+	1235, 1236, 1237, 1238, 1239, 1240, 0, 1241, 1242, 1243,
+	1244, 1245, 1220, 1221, 1222, 1223, 1204, 1205, 1233, 0,
+	1207, 0, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215,
+	1216, 1217, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231,
+	0, 0, 0, 0, 175, 0, 180, 0, 178, 820,
+	0, 469, 0, 0, 512, 0, 0, 0, 0, 0,
+	0, 469, 0, 0, 0, 0, 0, 0, 117, 469,
+	139, 0, 0, 813, 0, 0, 0, 0, 0, 820,
+	0, 159, 632, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 650, 0,
+	650, 0, 0, 0, 0, 0, 0, 0, 469, 1234,
+	0, 0, 149, 0, 0, 0, 0, 138, 0, 0,
+	0, 0, 0, 813, 0, 0, 0, 0, 180, 0,
+	0, 180, 180, 180, 518, 0, 156, 0, 157, 0,
+	0, 0, 0, 126, 127, 148, 147, 174, 0, 0,
+	0, 0, 0, 518, 518, 518, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1167, 0, 0, 1178, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 518, 518, 518, 180, 0, 984, 984,
+	984, 0, 143, 124, 150, 131, 123, 0, 144, 145,
+	0, 0, 0, 0, 160, 0, 0, 518, 0, 518,
+	76, 0, 0, 165, 132, 518, 1743, 0, 0, 0,
+	518, 0, 0, 1049, 624, 0, 0, 0, 135, 133,
+	128, 129, 130, 134, 0, 0, 0, 0, 125, 0,
+	0, 0, 0, 0, 0, 0, 0, 136, 0, 0,
+	518, 0, 0, 0, 0, 1063, 0, 0, 0, 1065,
+	1066, 1067, 1068, 1069, 1070, 1071, 1072, 0, 1075, 1077,
+	1080, 1080, 1080, 1077, 1080, 1080, 1077, 1080, 1093, 1094,
+	1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104,
+	1105, 1106, 1107, 1108, 1109, 1110, 0, 0, 0, 0,
+	0, 0, 1119, 0, 0, 0, 0, 0, 624, 0,
+	0, 0, 0, 624, 0, 0, 0, 1196, 0, 152,
+	0, 518, 180, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1817, 0, 0, 518,
+	0, 0, 0, 0, 1174, 0, 518, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 518, 0,
+	0, 0, 1290, 0, 0, 518, 518, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 146, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 0, 141, 1327, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 75, 37, 38, 77, 40,
+	41, 0, 1368, 518, 518, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 81, 0, 0, 0, 42,
+	68, 69, 0, 66, 70, 0, 1921, 0, 0, 0,
+	0, 0, 67, 1397, 469, 0, 469, 0, 0, 469,
+	1401, 0, 0, 0, 1554, 0, 0, 0, 0, 0,
+	1938, 1412, 1413, 1414, 1415, 1416, 1417, 1418, 665, 0,
+	1944, 55, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 153, 158, 155,
+	161, 162, 163, 164, 166, 167, 168, 169, 0, 1178,
+	0, 0, 0, 170, 171, 172, 173, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	181, 182, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 665, 0, 502, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 45, 48, 51, 50, 53, 0, 65, 0, 0,
+	72, 71, 0, 2018, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 488, 0, 0,
+	469, 0, 1296, 54, 80, 79, 487, 0, 63, 64,
+	52, 0, 0, 0, 0, 0, 2039, 0, 485, 632,
+	0, 0, 0, 0, 0, 665, 0, 0, 0, 1290,
+	0, 0, 2062, 1296, 0, 0, 0, 0, 0, 0,
+	0, 0, 469, 0, 469, 1180, 0, 0, 0, 56,
+	57, 0, 58, 59, 60, 61, 482, 0, 0, 0,
+	984, 984, 984, 1565, 0, 496, 0, 0, 0, 0,
+	0, 1569, 0, 1572, 0, 0, 0, 0, 0, 0,
+	493, 0, 1592, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 503, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 813, 0, 0, 1290, 0, 0, 0, 1554,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	472, 0, 474, 489, 0, 505, 0, 504, 478, 0,
+	476, 480, 490, 481, 0, 475, 78, 486, 0, 0,
+	477, 491, 492, 510, 511, 494, 509, 508, 495, 83,
+	484, 506, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 469,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1291, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1290, 0, 0, 0, 0, 0,
+	0, 0, 0, 1591, 0, 0, 0, 0, 0, 1291,
+	1291, 0, 0, 0, 1178, 469, 0, 0, 0, 0,
+	0, 1719, 0, 0, 0, 0, 1728, 1729, 0, 0,
+	1733, 1342, 0, 0, 0, 0, 0, 0, 1736, 507,
+	0, 0, 0, 0, 469, 1739, 0, 1554, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 500, 0, 1387,
+	0, 0, 0, 0, 0, 0, 2247, 2248, 2249, 0,
+	0, 0, 501, 1742, 0, 469, 0, 0, 0, 0,
+	0, 0, 469, 0, 0, 0, 0, 0, 0, 0,
+	0, 1410, 1411, 469, 469, 469, 469, 469, 469, 469,
+	0, 0, 0, 0, 0, 0, 2269, 2269, 2269, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2293, 469, 2295, 0, 0, 0, 0, 0, 1554, 0,
+	0, 0, 0, 1554, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 665, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 650, 1387, 650, 650, 0, 0, 650, 650,
+	650, 0, 0, 0, 1291, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	139, 1221, 146, 0, 1218, 0, 140, 141, 0, 0,
-	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 650, 650, 650, 650, 650, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1868, 0, 1554, 0, 0, 1342, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 650, 0,
+	0, 0, 2376, 0, 0, 0, 0, 0, 0, 2381,
+	0, 0, 632, 0, 0, 0, 0, 0, 0, 1290,
+	0, 2385, 0, 0, 0, 469, 0, 0, 665, 665,
+	0, 1387, 0, 469, 0, 469, 0, 0, 0, 0,
+	0, 0, 0, 469, 469, 0, 0, 0, 0, 1924,
+	0, 0, 0, 0, 0, 0, 0, 1765, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 779, 0, 0,
-	1238, 0, 0, 0, 1848, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1126, 0, 0, 0, 0, 0, 459, 1650, 0,
-	0, 0, 0, 1659, 1660, 0, 0, 1664, 0, 0,
-	0, 0, 0, 0, 0, 1667, 0, 0, 0, 0,
-	0, 0, 1670, 1239, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2435, 2381, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1673, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1962, 1963, 1964, 1965, 1966, 0, 1813, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1178, 1972, 0,
+	0, 624, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 175, 1843,
+	1844, 0, 0, 1174, 1174, 1174, 1174, 1174, 0, 1267,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1591, 0, 117, 1174, 139, 0, 0, 1174, 0, 0,
+	0, 0, 0, 0, 0, 159, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1238, 0,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	1848, 0, 0, 0, 136, 0, 0, 137, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 149, 0, 0, 0,
+	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	156, 0, 157, 0, 0, 0, 469, 1271, 1272, 148,
+	147, 174, 0, 469, 0, 0, 0, 0, 469, 469,
+	0, 0, 469, 0, 1735, 0, 0, 0, 0, 0,
+	469, 1945, 0, 0, 0, 0, 0, 469, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1848, 2136, 0, 0, 0, 0, 0, 0,
+	0, 0, 2093, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 469, 143, 1273, 150, 0,
+	1270, 0, 144, 145, 0, 0, 0, 0, 160, 0,
+	0, 0, 0, 2114, 0, 0, 0, 165, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2150, 2151, 2152, 0, 0, 0, 0, 0,
+	0, 0, 0, 2126, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2129, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 650, 650, 0, 0, 2140, 0, 0,
+	2143, 0, 0, 2017, 0, 0, 0, 0, 0, 0,
+	0, 650, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1174, 0, 0, 0, 0, 0, 0, 0,
+	0, 469, 0, 0, 0, 0, 0, 0, 0, 1342,
+	2059, 0, 76, 0, 0, 0, 0, 2181, 0, 0,
+	0, 0, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1174, 0, 0, 0, 0,
+	0, 0, 1291, 469, 469, 469, 469, 469, 0, 0,
+	0, 0, 0, 0, 0, 1861, 0, 0, 0, 0,
+	469, 0, 0, 469, 469, 0, 0, 469, 1871, 1387,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2166, 2166, 2166, 0,
-	0, 0, 1783, 0, 0, 0, 0, 0, 149, 154,
-	151, 157, 158, 159, 160, 162, 163, 164, 165, 0,
-	2190, 0, 2192, 0, 166, 167, 168, 169, 1848, 0,
-	0, 0, 0, 0, 1848, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 631, 0, 0, 0, 1839,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1878, 1879, 1880, 1881, 1882, 0, 0, 0, 0,
-	0, 1848, 0, 0, 0, 0, 0, 1126, 1888, 0,
-	0, 0, 0, 2257, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1238, 0,
-	2262, 0, 0, 0, 0, 0, 0, 631, 631, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1999, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2020, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2033, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2036, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2047, 0, 0, 2050, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2082, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 757, 743, 403, 0, 692, 760, 663, 680, 770,
-	683, 686, 726, 642, 705, 327, 677, 0, 667, 638,
-	673, 639, 665, 694, 234, 662, 745, 708, 759, 285,
-	231, 644, 668, 341, 682, 186, 728, 379, 219, 294,
-	292, 408, 245, 237, 233, 218, 269, 300, 339, 397,
-	333, 766, 289, 715, 2131, 388, 312, 2132, 2133, 2134,
-	696, 749, 703, 739, 691, 727, 652, 714, 761, 678,
-	723, 762, 275, 217, 185, 324, 389, 249, 0, 0,
-	0, 0, 177, 178, 179, 0, 2282, 2283, 0, 0,
-	0, 0, 0, 208, 0, 215, 720, 756, 675, 722,
-	229, 273, 236, 228, 405, 767, 748, 0, 0, 201,
-	758, 698, 725, 773, 637, 717, 0, 640, 643, 769,
-	752, 671, 239, 0, 0, 0, 0, 0, 0, 0,
-	695, 704, 736, 689, 0, 0, 0, 0, 0, 0,
-	0, 0, 669, 0, 713, 0, 0, 0, 648, 641,
-	0, 0, 0, 0, 693, 0, 0, 0, 651, 0,
-	670, 737, 0, 635, 257, 645, 313, 0, 741, 751,
-	690, 437, 755, 688, 687, 732, 649, 747, 681, 284,
-	647, 281, 181, 197, 0, 679, 323, 362, 368, 746,
-	666, 674, 220, 672, 366, 337, 422, 204, 247, 359,
-	342, 364, 712, 730, 365, 290, 410, 354, 420, 438,
-	439, 227, 317, 428, 401, 434, 450, 198, 224, 331,
-	394, 425, 385, 310, 406, 407, 280, 384, 255, 184,
-	288, 444, 196, 374, 212, 189, 396, 418, 209, 377,
-	0, 0, 452, 191, 416, 393, 307, 277, 278, 190,
-	2252, 358, 232, 253, 222, 326, 413, 414, 221, 453,
-	200, 433, 193, 951, 432, 319, 409, 417, 308, 299,
-	192, 415, 306, 298, 283, 243, 264, 352, 293, 353,
-	265, 315, 314, 316, 0, 187, 0, 390, 426, 454,
-	205, 206, 207, 661, 242, 246, 252, 254, 260, 261,
-	268, 286, 330, 351, 349, 355, 742, 404, 421, 429,
-	436, 442, 443, 445, 446, 447, 448, 449, 318, 267,
-	386, 282, 291, 734, 772, 336, 367, 210, 424, 387,
-	656, 660, 654, 655, 706, 707, 657, 763, 764, 765,
-	738, 650, 0, 658, 659, 0, 744, 753, 754, 711,
-	180, 194, 287, 768, 356, 250, 451, 431, 427, 636,
-	653, 226, 664, 0, 0, 676, 684, 685, 697, 699,
-	700, 701, 702, 710, 718, 719, 721, 729, 731, 733,
-	735, 740, 750, 771, 182, 183, 195, 203, 213, 225,
-	240, 248, 258, 263, 266, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 411, 412, 423, 435, 440,
-	259, 419, 441, 0, 295, 709, 716, 297, 244, 262,
-	272, 724, 430, 392, 199, 363, 251, 188, 216, 202,
-	223, 238, 241, 276, 305, 311, 340, 343, 256, 235,
-	214, 360, 211, 378, 398, 399, 400, 402, 309, 230,
-	757, 743, 403, 0, 692, 760, 663, 680, 770, 683,
-	686, 726, 642, 705, 327, 677, 0, 667, 638, 673,
-	639, 665, 694, 234, 662, 745, 708, 759, 285, 231,
-	644, 668, 341, 682, 186, 728, 379, 219, 294, 292,
-	408, 245, 237, 233, 218, 269, 300, 339, 397, 333,
-	766, 289, 715, 0, 388, 312, 0, 0, 0, 696,
-	749, 703, 739, 691, 727, 652, 714, 761, 678, 723,
-	762, 275, 217, 185, 324, 389, 249, 0, 0, 0,
-	0, 177, 178, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 208, 0, 215, 720, 756, 675, 722, 229,
-	273, 236, 228, 405, 767, 748, 0, 0, 201, 758,
-	698, 725, 773, 637, 717, 0, 640, 643, 769, 752,
-	671, 239, 0, 0, 0, 0, 0, 0, 0, 695,
-	704, 736, 689, 0, 0, 0, 0, 0, 0, 1955,
-	0, 669, 0, 713, 0, 0, 0, 648, 641, 0,
-	0, 0, 0, 693, 0, 0, 0, 651, 0, 670,
-	737, 0, 635, 257, 645, 313, 0, 741, 751, 690,
-	437, 755, 688, 687, 732, 649, 747, 681, 284, 647,
-	281, 181, 197, 0, 679, 323, 362, 368, 746, 666,
-	674, 220, 672, 366, 337, 422, 204, 247, 359, 342,
-	364, 712, 730, 365, 290, 410, 354, 420, 438, 439,
-	227, 317, 428, 401, 434, 450, 198, 224, 331, 394,
-	425, 385, 310, 406, 407, 280, 384, 255, 184, 288,
-	444, 196, 374, 212, 189, 396, 418, 209, 377, 0,
-	0, 452, 191, 416, 393, 307, 277, 278, 190, 0,
-	358, 232, 253, 222, 326, 413, 414, 221, 453, 200,
-	433, 193, 951, 432, 319, 409, 417, 308, 299, 192,
-	415, 306, 298, 283, 243, 264, 352, 293, 353, 265,
-	315, 314, 316, 0, 187, 0, 390, 426, 454, 205,
-	206, 207, 661, 242, 246, 252, 254, 260, 261, 268,
-	286, 330, 351, 349, 355, 742, 404, 421, 429, 436,
-	442, 443, 445, 446, 447, 448, 449, 318, 267, 386,
-	282, 291, 734, 772, 336, 367, 210, 424, 387, 656,
-	660, 654, 655, 706, 707, 657, 763, 764, 765, 738,
-	650, 0, 658, 659, 0, 744, 753, 754, 711, 180,
-	194, 287, 768, 356, 250, 451, 431, 427, 636, 653,
-	226, 664, 0, 0, 676, 684, 685, 697, 699, 700,
-	701, 702, 710, 718, 719, 721, 729, 731, 733, 735,
-	740, 750, 771, 182, 183, 195, 203, 213, 225, 240,
-	248, 258, 263, 266, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 411, 412, 423, 435, 440, 259,
-	419, 441, 0, 295, 709, 716, 297, 244, 262, 272,
-	724, 430, 392, 199, 363, 251, 188, 216, 202, 223,
-	238, 241, 276, 305, 311, 340, 343, 256, 235, 214,
-	360, 211, 378, 398, 399, 400, 402, 309, 230, 757,
-	743, 403, 0, 692, 760, 663, 680, 770, 683, 686,
-	726, 642, 705, 327, 677, 0, 667, 638, 673, 639,
-	665, 694, 234, 662, 745, 708, 759, 285, 231, 644,
-	668, 341, 682, 186, 728, 379, 219, 294, 292, 408,
-	245, 237, 233, 218, 269, 300, 339, 397, 333, 766,
-	289, 715, 0, 388, 312, 0, 0, 0, 696, 749,
-	703, 739, 691, 727, 652, 714, 761, 678, 723, 762,
-	275, 217, 185, 324, 389, 249, 0, 0, 0, 0,
-	177, 178, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 208, 0, 215, 720, 756, 675, 722, 229, 273,
-	236, 228, 405, 767, 748, 0, 0, 201, 758, 698,
-	725, 773, 637, 717, 0, 640, 643, 769, 752, 671,
-	239, 0, 0, 0, 0, 0, 0, 0, 695, 704,
-	736, 689, 0, 0, 0, 0, 0, 0, 1787, 0,
-	669, 0, 713, 0, 0, 0, 648, 641, 0, 0,
-	0, 0, 693, 0, 0, 0, 651, 0, 670, 737,
-	0, 635, 257, 645, 313, 0, 741, 751, 690, 437,
-	755, 688, 687, 732, 649, 747, 681, 284, 647, 281,
-	181, 197, 0, 679, 323, 362, 368, 746, 666, 674,
-	220, 672, 366, 337, 422, 204, 247, 359, 342, 364,
-	712, 730, 365, 290, 410, 354, 420, 438, 439, 227,
-	317, 428, 401, 434, 450, 198, 224, 331, 394, 425,
-	385, 310, 406, 407, 280, 384, 255, 184, 288, 444,
-	196, 374, 212, 189, 396, 418, 209, 377, 0, 0,
-	452, 191, 416, 393, 307, 277, 278, 190, 0, 358,
-	232, 253, 222, 326, 413, 414, 221, 453, 200, 433,
-	193, 951, 432, 319, 409, 417, 308, 299, 192, 415,
-	306, 298, 283, 243, 264, 352, 293, 353, 265, 315,
-	314, 316, 0, 187, 0, 390, 426, 454, 205, 206,
-	207, 661, 242, 246, 252, 254, 260, 261, 268, 286,
-	330, 351, 349, 355, 742, 404, 421, 429, 436, 442,
-	443, 445, 446, 447, 448, 449, 318, 267, 386, 282,
-	291, 734, 772, 336, 367, 210, 424, 387, 656, 660,
-	654, 655, 706, 707, 657, 763, 764, 765, 738, 650,
-	0, 658, 659, 0, 744, 753, 754, 711, 180, 194,
-	287, 768, 356, 250, 451, 431, 427, 636, 653, 226,
-	664, 0, 0, 676, 684, 685, 697, 699, 700, 701,
-	702, 710, 718, 719, 721, 729, 731, 733, 735, 740,
-	750, 771, 182, 183, 195, 203, 213, 225, 240, 248,
-	258, 263, 266, 270, 271, 274, 279, 296, 301, 302,
-	303, 304, 320, 321, 322, 325, 328, 329, 332, 334,
-	335, 338, 344, 345, 346, 347, 348, 350, 357, 361,
-	369, 370, 371, 372, 373, 375, 376, 380, 381, 382,
-	383, 391, 395, 411, 412, 423, 435, 440, 259, 419,
-	441, 0, 295, 709, 716, 297, 244, 262, 272, 724,
-	430, 392, 199, 363, 251, 188, 216, 202, 223, 238,
-	241, 276, 305, 311, 340, 343, 256, 235, 214, 360,
-	211, 378, 398, 399, 400, 402, 309, 230, 757, 743,
-	403, 0, 692, 760, 663, 680, 770, 683, 686, 726,
-	642, 705, 327, 677, 0, 667, 638, 673, 639, 665,
-	694, 234, 662, 745, 708, 759, 285, 231, 644, 668,
-	341, 682, 186, 728, 379, 219, 294, 292, 408, 245,
-	237, 233, 218, 269, 300, 339, 397, 333, 766, 289,
-	715, 0, 388, 312, 0, 0, 0, 696, 749, 703,
-	739, 691, 727, 652, 714, 761, 678, 723, 762, 275,
-	217, 185, 324, 389, 249, 0, 0, 0, 0, 177,
-	178, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	208, 0, 215, 720, 756, 675, 722, 229, 273, 236,
-	228, 405, 767, 748, 0, 0, 201, 758, 698, 725,
-	773, 637, 717, 0, 640, 643, 769, 752, 671, 239,
-	0, 0, 0, 0, 0, 0, 0, 695, 704, 736,
-	689, 0, 0, 0, 0, 0, 0, 1498, 0, 669,
-	0, 713, 0, 0, 0, 648, 641, 0, 0, 0,
-	0, 693, 0, 0, 0, 651, 0, 670, 737, 0,
-	635, 257, 645, 313, 0, 741, 751, 690, 437, 755,
-	688, 687, 732, 649, 747, 681, 284, 647, 281, 181,
-	197, 0, 679, 323, 362, 368, 746, 666, 674, 220,
-	672, 366, 337, 422, 204, 247, 359, 342, 364, 712,
-	730, 365, 290, 410, 354, 420, 438, 439, 227, 317,
-	428, 401, 434, 450, 198, 224, 331, 394, 425, 385,
-	310, 406, 407, 280, 384, 255, 184, 288, 444, 196,
-	374, 212, 189, 396, 418, 209, 377, 0, 0, 452,
-	191, 416, 393, 307, 277, 278, 190, 0, 358, 232,
-	253, 222, 326, 413, 414, 221, 453, 200, 433, 193,
-	951, 432, 319, 409, 417, 308, 299, 192, 415, 306,
-	298, 283, 243, 264, 352, 293, 353, 265, 315, 314,
-	316, 0, 187, 0, 390, 426, 454, 205, 206, 207,
-	661, 242, 246, 252, 254, 260, 261, 268, 286, 330,
-	351, 349, 355, 742, 404, 421, 429, 436, 442, 443,
-	445, 446, 447, 448, 449, 318, 267, 386, 282, 291,
-	734, 772, 336, 367, 210, 424, 387, 656, 660, 654,
-	655, 706, 707, 657, 763, 764, 765, 738, 650, 0,
-	658, 659, 0, 744, 753, 754, 711, 180, 194, 287,
-	768, 356, 250, 451, 431, 427, 636, 653, 226, 664,
-	0, 0, 676, 684, 685, 697, 699, 700, 701, 702,
-	710, 718, 719, 721, 729, 731, 733, 735, 740, 750,
-	771, 182, 183, 195, 203, 213, 225, 240, 248, 258,
-	263, 266, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 411, 412, 423, 435, 440, 259, 419, 441,
-	0, 295, 709, 716, 297, 244, 262, 272, 724, 430,
-	392, 199, 363, 251, 188, 216, 202, 223, 238, 241,
-	276, 305, 311, 340, 343, 256, 235, 214, 360, 211,
-	378, 398, 399, 400, 402, 309, 230, 757, 743, 403,
-	0, 692, 760, 663, 680, 770, 683, 686, 726, 642,
-	705, 327, 677, 0, 667, 638, 673, 639, 665, 694,
-	234, 662, 745, 708, 759, 285, 231, 644, 668, 341,
-	682, 186, 728, 379, 219, 294, 292, 408, 245, 237,
-	233, 218, 269, 300, 339, 397, 333, 766, 289, 715,
-	0, 388, 312, 0, 0, 0, 696, 749, 703, 739,
-	691, 727, 652, 714, 761, 678, 723, 762, 275, 217,
-	185, 324, 389, 249, 0, 80, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 208,
-	0, 215, 720, 756, 675, 722, 229, 273, 236, 228,
-	405, 767, 748, 0, 0, 201, 758, 698, 725, 773,
-	637, 717, 0, 640, 643, 769, 752, 671, 239, 0,
-	0, 0, 0, 0, 0, 0, 695, 704, 736, 689,
-	0, 0, 0, 0, 0, 0, 0, 0, 669, 0,
-	713, 0, 0, 0, 648, 641, 0, 0, 0, 0,
-	693, 0, 0, 0, 651, 0, 670, 737, 0, 635,
-	257, 645, 313, 0, 741, 751, 690, 437, 755, 688,
-	687, 732, 649, 747, 681, 284, 647, 281, 181, 197,
-	0, 679, 323, 362, 368, 746, 666, 674, 220, 672,
-	366, 337, 422, 204, 247, 359, 342, 364, 712, 730,
-	365, 290, 410, 354, 420, 438, 439, 227, 317, 428,
-	401, 434, 450, 198, 224, 331, 394, 425, 385, 310,
-	406, 407, 280, 384, 255, 184, 288, 444, 196, 374,
-	212, 189, 396, 418, 209, 377, 0, 0, 452, 191,
-	416, 393, 307, 277, 278, 190, 0, 358, 232, 253,
-	222, 326, 413, 414, 221, 453, 200, 433, 193, 951,
-	432, 319, 409, 417, 308, 299, 192, 415, 306, 298,
-	283, 243, 264, 352, 293, 353, 265, 315, 314, 316,
-	0, 187, 0, 390, 426, 454, 205, 206, 207, 661,
-	242, 246, 252, 254, 260, 261, 268, 286, 330, 351,
-	349, 355, 742, 404, 421, 429, 436, 442, 443, 445,
-	446, 447, 448, 449, 318, 267, 386, 282, 291, 734,
-	772, 336, 367, 210, 424, 387, 656, 660, 654, 655,
-	706, 707, 657, 763, 764, 765, 738, 650, 0, 658,
-	659, 0, 744, 753, 754, 711, 180, 194, 287, 768,
-	356, 250, 451, 431, 427, 636, 653, 226, 664, 0,
-	0, 676, 684, 685, 697, 699, 700, 701, 702, 710,
-	718, 719, 721, 729, 731, 733, 735, 740, 750, 771,
-	182, 183, 195, 203, 213, 225, 240, 248, 258, 263,
-	266, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 411, 412, 423, 435, 440, 259, 419, 441, 0,
-	295, 709, 716, 297, 244, 262, 272, 724, 430, 392,
-	199, 363, 251, 188, 216, 202, 223, 238, 241, 276,
-	305, 311, 340, 343, 256, 235, 214, 360, 211, 378,
-	398, 399, 400, 402, 309, 230, 757, 743, 403, 0,
-	692, 760, 663, 680, 770, 683, 686, 726, 642, 705,
-	327, 677, 0, 667, 638, 673, 639, 665, 694, 234,
-	662, 745, 708, 759, 285, 231, 644, 668, 341, 682,
-	186, 728, 379, 219, 294, 292, 408, 245, 237, 233,
-	218, 269, 300, 339, 397, 333, 766, 289, 715, 0,
-	388, 312, 0, 0, 0, 696, 749, 703, 739, 691,
-	727, 652, 714, 761, 678, 723, 762, 275, 217, 185,
-	324, 389, 249, 0, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 208, 0,
-	215, 720, 756, 675, 722, 229, 273, 236, 228, 405,
-	767, 748, 0, 0, 201, 758, 698, 725, 773, 637,
-	717, 0, 640, 643, 769, 752, 671, 239, 0, 0,
-	0, 0, 0, 0, 0, 695, 704, 736, 689, 0,
-	0, 0, 0, 0, 0, 0, 0, 669, 0, 713,
-	0, 0, 0, 648, 641, 0, 0, 0, 0, 693,
-	0, 0, 0, 651, 0, 670, 737, 0, 635, 257,
-	645, 313, 0, 741, 751, 690, 437, 755, 688, 687,
-	732, 649, 747, 681, 284, 647, 281, 181, 197, 0,
-	679, 323, 362, 368, 746, 666, 674, 220, 672, 366,
-	337, 422, 204, 247, 359, 342, 364, 712, 730, 365,
-	290, 410, 354, 420, 438, 439, 227, 317, 428, 401,
-	434, 450, 198, 224, 331, 394, 425, 385, 310, 406,
-	407, 280, 384, 255, 184, 288, 444, 196, 374, 212,
-	189, 396, 418, 209, 377, 0, 0, 452, 191, 416,
-	393, 307, 277, 278, 190, 0, 358, 232, 253, 222,
-	326, 413, 414, 221, 453, 200, 433, 193, 951, 432,
-	319, 409, 417, 308, 299, 192, 415, 306, 298, 283,
-	243, 264, 352, 293, 353, 265, 315, 314, 316, 0,
-	187, 0, 390, 426, 454, 205, 206, 207, 661, 242,
-	246, 252, 254, 260, 261, 268, 286, 330, 351, 349,
-	355, 742, 404, 421, 429, 436, 442, 443, 445, 446,
-	447, 448, 449, 318, 267, 386, 282, 291, 734, 772,
-	336, 367, 210, 424, 387, 656, 660, 654, 655, 706,
-	707, 657, 763, 764, 765, 738, 650, 0, 658, 659,
-	0, 744, 753, 754, 711, 180, 194, 287, 768, 356,
-	250, 451, 431, 427, 636, 653, 226, 664, 0, 0,
-	676, 684, 685, 697, 699, 700, 701, 702, 710, 718,
-	719, 721, 729, 731, 733, 735, 740, 750, 771, 182,
-	183, 195, 203, 213, 225, 240, 248, 258, 263, 266,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	411, 412, 423, 435, 440, 259, 419, 441, 0, 295,
-	709, 716, 297, 244, 262, 272, 724, 430, 392, 199,
-	363, 251, 188, 216, 202, 223, 238, 241, 276, 305,
-	311, 340, 343, 256, 235, 214, 360, 211, 378, 398,
-	399, 400, 402, 309, 230, 757, 743, 403, 0, 692,
-	760, 663, 680, 770, 683, 686, 726, 642, 705, 327,
-	677, 0, 667, 638, 673, 639, 665, 694, 234, 662,
-	745, 708, 759, 285, 231, 644, 668, 341, 682, 186,
-	728, 379, 219, 294, 292, 408, 245, 237, 233, 218,
-	269, 300, 339, 397, 333, 766, 289, 715, 0, 388,
-	312, 0, 0, 0, 696, 749, 703, 739, 691, 727,
-	652, 714, 761, 678, 723, 762, 275, 217, 185, 324,
-	389, 249, 0, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 208, 0, 215,
-	720, 756, 675, 722, 229, 273, 236, 228, 405, 767,
-	748, 0, 0, 774, 758, 698, 725, 773, 637, 717,
-	0, 640, 643, 769, 752, 671, 239, 0, 0, 0,
-	0, 0, 0, 0, 695, 704, 736, 689, 0, 0,
-	0, 0, 0, 0, 0, 0, 669, 0, 713, 0,
-	0, 0, 648, 641, 0, 0, 0, 0, 693, 0,
-	0, 0, 651, 0, 670, 737, 0, 635, 257, 645,
-	313, 0, 741, 751, 690, 437, 755, 688, 687, 732,
-	649, 747, 681, 284, 647, 281, 181, 197, 0, 679,
-	323, 362, 368, 746, 666, 674, 220, 672, 366, 337,
-	422, 204, 247, 359, 342, 364, 712, 730, 365, 290,
-	410, 354, 420, 438, 439, 227, 317, 428, 401, 434,
-	450, 198, 224, 331, 394, 425, 385, 310, 406, 407,
-	280, 384, 255, 184, 288, 444, 196, 374, 212, 189,
-	396, 418, 209, 377, 0, 0, 452, 191, 416, 393,
-	307, 277, 278, 190, 0, 358, 232, 253, 222, 326,
-	413, 414, 221, 453, 200, 433, 193, 646, 432, 319,
-	409, 417, 308, 299, 192, 415, 306, 298, 283, 243,
-	264, 352, 293, 353, 265, 315, 314, 316, 0, 187,
-	0, 390, 426, 454, 205, 206, 207, 661, 242, 246,
-	252, 254, 260, 261, 268, 286, 330, 351, 349, 355,
-	742, 404, 421, 429, 436, 442, 443, 445, 446, 447,
-	448, 449, 634, 628, 627, 282, 291, 734, 772, 336,
-	367, 210, 424, 387, 656, 660, 654, 655, 706, 707,
-	657, 763, 764, 765, 738, 650, 0, 658, 659, 0,
-	744, 753, 754, 711, 180, 194, 287, 768, 356, 250,
-	451, 431, 427, 636, 653, 226, 664, 0, 0, 676,
-	684, 685, 697, 699, 700, 701, 702, 710, 718, 719,
-	721, 729, 731, 733, 735, 740, 750, 771, 182, 183,
-	195, 203, 213, 225, 240, 248, 258, 263, 266, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 411,
-	412, 423, 435, 440, 259, 419, 441, 0, 295, 709,
-	716, 297, 244, 262, 272, 724, 430, 392, 199, 363,
-	251, 188, 216, 202, 223, 238, 241, 276, 305, 311,
-	340, 343, 256, 235, 214, 360, 211, 378, 398, 399,
-	400, 402, 309, 230, 757, 743, 403, 0, 692, 760,
-	663, 680, 770, 683, 686, 726, 642, 705, 327, 677,
-	0, 667, 638, 673, 639, 665, 694, 234, 662, 745,
-	708, 759, 285, 231, 644, 668, 341, 682, 186, 728,
-	379, 219, 294, 292, 408, 245, 237, 233, 218, 269,
-	300, 339, 397, 333, 766, 289, 715, 0, 388, 312,
-	0, 0, 0, 696, 749, 703, 739, 691, 727, 652,
-	714, 761, 678, 723, 762, 275, 217, 185, 324, 389,
-	249, 0, 0, 0, 0, 177, 178, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 208, 0, 215, 720,
-	756, 675, 722, 229, 273, 236, 228, 405, 767, 748,
-	0, 0, 774, 758, 698, 725, 773, 637, 717, 0,
-	640, 643, 769, 752, 671, 239, 0, 0, 0, 0,
-	0, 0, 0, 695, 704, 736, 689, 0, 0, 0,
-	0, 0, 0, 0, 0, 669, 0, 713, 0, 0,
-	0, 648, 641, 0, 0, 0, 0, 693, 0, 0,
-	0, 651, 0, 670, 737, 0, 635, 257, 645, 313,
-	0, 741, 751, 690, 437, 755, 688, 687, 732, 649,
-	747, 681, 284, 647, 281, 181, 197, 0, 679, 323,
-	362, 368, 746, 666, 674, 220, 672, 366, 337, 422,
-	204, 247, 359, 342, 364, 712, 730, 365, 290, 410,
-	354, 420, 438, 439, 227, 317, 428, 401, 434, 450,
-	198, 224, 331, 394, 425, 385, 310, 406, 407, 280,
-	384, 255, 184, 288, 444, 196, 374, 212, 189, 396,
-	1130, 209, 377, 0, 0, 452, 191, 416, 393, 307,
-	277, 278, 190, 0, 358, 232, 253, 222, 326, 413,
-	414, 221, 453, 200, 433, 193, 646, 432, 319, 409,
-	417, 308, 299, 192, 415, 306, 298, 283, 243, 264,
-	352, 293, 353, 265, 315, 314, 316, 0, 187, 0,
-	390, 426, 454, 205, 206, 207, 661, 242, 246, 252,
-	254, 260, 261, 268, 286, 330, 351, 349, 355, 742,
-	404, 421, 429, 436, 442, 443, 445, 446, 447, 448,
-	449, 634, 628, 627, 282, 291, 734, 772, 336, 367,
-	210, 424, 387, 656, 660, 654, 655, 706, 707, 657,
-	763, 764, 765, 738, 650, 0, 658, 659, 0, 744,
-	753, 754, 711, 180, 194, 287, 768, 356, 250, 451,
-	431, 427, 636, 653, 226, 664, 0, 0, 676, 684,
-	685, 697, 699, 700, 701, 702, 710, 718, 719, 721,
-	729, 731, 733, 735, 740, 750, 771, 182, 183, 195,
-	203, 213, 225, 240, 248, 258, 263, 266, 270, 271,
-	274, 279, 296, 301, 302, 303, 304, 320, 321, 322,
-	325, 328, 329, 332, 334, 335, 338, 344, 345, 346,
-	347, 348, 350, 357, 361, 369, 370, 371, 372, 373,
-	375, 376, 380, 381, 382, 383, 391, 395, 411, 412,
-	423, 435, 440, 259, 419, 441, 0, 295, 709, 716,
-	297, 244, 262, 272, 724, 430, 392, 199, 363, 251,
-	188, 216, 202, 223, 238, 241, 276, 305, 311, 340,
-	343, 256, 235, 214, 360, 211, 378, 398, 399, 400,
-	402, 309, 230, 757, 743, 403, 0, 692, 760, 663,
-	680, 770, 683, 686, 726, 642, 705, 327, 677, 0,
-	667, 638, 673, 639, 665, 694, 234, 662, 745, 708,
-	759, 285, 231, 644, 668, 341, 682, 186, 728, 379,
-	219, 294, 292, 408, 245, 237, 233, 218, 269, 300,
-	339, 397, 333, 766, 289, 715, 0, 388, 312, 0,
-	0, 0, 696, 749, 703, 739, 691, 727, 652, 714,
-	761, 678, 723, 762, 275, 217, 185, 324, 389, 249,
-	0, 0, 0, 0, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 208, 0, 215, 720, 756,
-	675, 722, 229, 273, 236, 228, 405, 767, 748, 0,
-	0, 774, 758, 698, 725, 773, 637, 717, 0, 640,
-	643, 769, 752, 671, 239, 0, 0, 0, 0, 0,
-	0, 0, 695, 704, 736, 689, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 713, 0, 0, 0,
-	648, 641, 0, 0, 0, 0, 693, 0, 0, 0,
-	651, 0, 670, 737, 0, 635, 257, 645, 313, 0,
-	741, 751, 690, 437, 755, 688, 687, 732, 649, 747,
-	681, 284, 647, 281, 181, 197, 0, 679, 323, 362,
-	368, 746, 666, 674, 220, 672, 366, 337, 422, 204,
-	247, 359, 342, 364, 712, 730, 365, 290, 410, 354,
-	420, 438, 439, 227, 317, 428, 401, 434, 450, 198,
-	224, 331, 394, 425, 385, 310, 406, 407, 280, 384,
-	255, 184, 288, 444, 196, 374, 212, 189, 396, 625,
-	209, 377, 0, 0, 452, 191, 416, 393, 307, 277,
-	278, 190, 0, 358, 232, 253, 222, 326, 413, 414,
-	221, 453, 200, 433, 193, 646, 432, 319, 409, 417,
-	308, 299, 192, 415, 306, 298, 283, 243, 264, 352,
-	293, 353, 265, 315, 314, 316, 0, 187, 0, 390,
-	426, 454, 205, 206, 207, 661, 242, 246, 252, 254,
-	260, 261, 268, 286, 330, 351, 349, 355, 742, 404,
-	421, 429, 436, 442, 443, 445, 446, 447, 448, 449,
-	634, 628, 627, 282, 291, 734, 772, 336, 367, 210,
-	424, 387, 656, 660, 654, 655, 706, 707, 657, 763,
-	764, 765, 738, 650, 0, 658, 659, 0, 744, 753,
-	754, 711, 180, 194, 287, 768, 356, 250, 451, 431,
-	427, 636, 653, 226, 664, 0, 0, 676, 684, 685,
-	697, 699, 700, 701, 702, 710, 718, 719, 721, 729,
-	731, 733, 735, 740, 750, 771, 182, 183, 195, 203,
-	213, 225, 240, 248, 258, 263, 266, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 411, 412, 423,
-	435, 440, 259, 419, 441, 0, 295, 709, 716, 297,
-	244, 262, 272, 724, 430, 392, 199, 363, 251, 188,
-	216, 202, 223, 238, 241, 276, 305, 311, 340, 343,
-	256, 235, 214, 360, 211, 378, 398, 399, 400, 402,
-	309, 230, 403, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 327, 0, 0, 1432, 0, 522,
-	0, 0, 0, 234, 521, 0, 0, 0, 285, 231,
-	0, 1433, 341, 0, 186, 0, 379, 219, 294, 292,
-	408, 245, 237, 233, 218, 269, 300, 339, 397, 333,
-	565, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 556, 557, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 217, 185, 324, 389, 249, 0, 80, 0,
-	0, 177, 178, 179, 543, 542, 545, 546, 547, 548,
-	0, 0, 208, 544, 215, 549, 550, 551, 0, 229,
-	273, 236, 228, 405, 0, 0, 0, 0, 201, 0,
-	0, 0, 0, 0, 519, 536, 0, 564, 0, 0,
-	0, 239, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 533, 534, 614,
-	0, 0, 0, 580, 0, 535, 0, 0, 528, 529,
-	531, 530, 532, 537, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 257, 0, 313, 0, 579, 0, 0,
-	437, 0, 0, 577, 0, 0, 0, 0, 284, 0,
-	281, 181, 197, 0, 0, 323, 362, 368, 0, 0,
-	0, 220, 0, 366, 337, 422, 204, 247, 359, 342,
-	364, 0, 0, 365, 290, 410, 354, 420, 438, 439,
-	227, 317, 428, 401, 434, 450, 198, 224, 331, 394,
-	425, 385, 310, 406, 407, 280, 384, 255, 184, 288,
-	444, 196, 374, 212, 189, 396, 418, 209, 377, 0,
-	0, 452, 191, 416, 393, 307, 277, 278, 190, 0,
-	358, 232, 253, 222, 326, 413, 414, 221, 453, 200,
-	433, 193, 0, 432, 319, 409, 417, 308, 299, 192,
-	415, 306, 298, 283, 243, 264, 352, 293, 353, 265,
-	315, 314, 316, 0, 187, 0, 390, 426, 454, 205,
-	206, 207, 0, 242, 246, 252, 254, 260, 261, 268,
-	286, 330, 351, 349, 355, 0, 404, 421, 429, 436,
-	442, 443, 445, 446, 447, 448, 449, 318, 267, 386,
-	282, 291, 0, 0, 336, 367, 210, 424, 387, 567,
-	578, 573, 574, 571, 572, 566, 570, 569, 568, 581,
-	558, 559, 560, 561, 563, 0, 575, 576, 562, 180,
-	194, 287, 0, 356, 250, 451, 431, 427, 0, 0,
-	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 182, 183, 195, 203, 213, 225, 240,
-	248, 258, 263, 266, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 411, 412, 423, 435, 440, 259,
-	419, 441, 0, 295, 0, 0, 297, 244, 262, 272,
-	0, 430, 392, 199, 363, 251, 188, 216, 202, 223,
-	238, 241, 276, 305, 311, 340, 343, 256, 235, 214,
-	360, 211, 378, 398, 399, 400, 402, 309, 230, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 0, 0, 0, 0, 522, 0, 0, 0,
-	234, 521, 0, 0, 0, 285, 231, 0, 0, 341,
-	0, 186, 0, 379, 219, 294, 292, 408, 245, 237,
-	233, 218, 269, 300, 339, 397, 333, 565, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 556, 557,
-	0, 0, 0, 0, 0, 0, 1538, 0, 275, 217,
-	185, 324, 389, 249, 0, 80, 0, 0, 177, 178,
-	179, 543, 542, 545, 546, 547, 548, 0, 0, 208,
-	544, 215, 549, 550, 551, 1539, 229, 273, 236, 228,
-	405, 0, 0, 0, 0, 201, 0, 0, 0, 0,
-	0, 519, 536, 0, 564, 0, 0, 0, 239, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
-	580, 0, 535, 0, 0, 528, 529, 531, 530, 532,
-	537, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	257, 0, 313, 0, 579, 0, 0, 437, 0, 0,
-	577, 0, 0, 0, 0, 284, 0, 281, 181, 197,
-	0, 0, 323, 362, 368, 0, 0, 0, 220, 0,
-	366, 337, 422, 204, 247, 359, 342, 364, 0, 0,
-	365, 290, 410, 354, 420, 438, 439, 227, 317, 428,
-	401, 434, 450, 198, 224, 331, 394, 425, 385, 310,
-	406, 407, 280, 384, 255, 184, 288, 444, 196, 374,
-	212, 189, 396, 418, 209, 377, 0, 0, 452, 191,
-	416, 393, 307, 277, 278, 190, 0, 358, 232, 253,
-	222, 326, 413, 414, 221, 453, 200, 433, 193, 0,
-	432, 319, 409, 417, 308, 299, 192, 415, 306, 298,
-	283, 243, 264, 352, 293, 353, 265, 315, 314, 316,
-	0, 187, 0, 390, 426, 454, 205, 206, 207, 0,
-	242, 246, 252, 254, 260, 261, 268, 286, 330, 351,
-	349, 355, 0, 404, 421, 429, 436, 442, 443, 445,
-	446, 447, 448, 449, 318, 267, 386, 282, 291, 0,
-	0, 336, 367, 210, 424, 387, 567, 578, 573, 574,
-	571, 572, 566, 570, 569, 568, 581, 558, 559, 560,
-	561, 563, 0, 575, 576, 562, 180, 194, 287, 0,
-	356, 250, 451, 431, 427, 0, 0, 226, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	182, 183, 195, 203, 213, 225, 240, 248, 258, 263,
-	266, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 411, 412, 423, 435, 440, 259, 419, 441, 0,
-	295, 0, 0, 297, 244, 262, 272, 0, 430, 392,
-	199, 363, 251, 188, 216, 202, 223, 238, 241, 276,
-	305, 311, 340, 343, 256, 235, 214, 360, 211, 378,
-	398, 399, 400, 402, 309, 230, 403, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 327, 0,
-	0, 0, 0, 522, 0, 0, 0, 234, 521, 0,
-	0, 0, 285, 231, 0, 0, 341, 0, 186, 0,
-	379, 219, 294, 292, 408, 245, 237, 233, 218, 269,
-	300, 339, 397, 333, 565, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 556, 557, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 217, 185, 324, 389,
-	249, 0, 80, 0, 1089, 177, 178, 179, 543, 542,
-	545, 546, 547, 548, 0, 0, 208, 544, 215, 549,
-	550, 551, 0, 229, 273, 236, 228, 405, 0, 0,
-	0, 0, 201, 0, 0, 0, 0, 0, 519, 536,
-	0, 564, 0, 0, 0, 239, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 533, 534, 0, 0, 0, 0, 580, 0, 535,
-	0, 0, 528, 529, 531, 530, 532, 537, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 257, 0, 313,
-	0, 579, 0, 0, 437, 0, 0, 577, 0, 0,
-	0, 0, 284, 0, 281, 181, 197, 0, 0, 323,
-	362, 368, 0, 0, 0, 220, 0, 366, 337, 422,
-	204, 247, 359, 342, 364, 0, 0, 365, 290, 410,
-	354, 420, 438, 439, 227, 317, 428, 401, 434, 450,
-	198, 224, 331, 394, 425, 385, 310, 406, 407, 280,
-	384, 255, 184, 288, 444, 196, 374, 212, 189, 396,
-	418, 209, 377, 0, 0, 452, 191, 416, 393, 307,
-	277, 278, 190, 0, 358, 232, 253, 222, 326, 413,
-	414, 221, 453, 200, 433, 193, 0, 432, 319, 409,
-	417, 308, 299, 192, 415, 306, 298, 283, 243, 264,
-	352, 293, 353, 265, 315, 314, 316, 0, 187, 0,
-	390, 426, 454, 205, 206, 207, 0, 242, 246, 252,
-	254, 260, 261, 268, 286, 330, 351, 349, 355, 0,
-	404, 421, 429, 436, 442, 443, 445, 446, 447, 448,
-	449, 318, 267, 386, 282, 291, 0, 0, 336, 367,
-	210, 424, 387, 567, 578, 573, 574, 571, 572, 566,
-	570, 569, 568, 581, 558, 559, 560, 561, 563, 0,
-	575, 576, 562, 180, 194, 287, 0, 356, 250, 451,
-	431, 427, 0, 0, 226, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 182, 183, 195,
-	203, 213, 225, 240, 248, 258, 263, 266, 270, 271,
-	274, 279, 296, 301, 302, 303, 304, 320, 321, 322,
-	325, 328, 329, 332, 334, 335, 338, 344, 345, 346,
-	347, 348, 350, 357, 361, 369, 370, 371, 372, 373,
-	375, 376, 380, 381, 382, 383, 391, 395, 411, 412,
-	423, 435, 440, 259, 419, 441, 0, 295, 0, 0,
-	297, 244, 262, 272, 0, 430, 392, 199, 363, 251,
-	188, 216, 202, 223, 238, 241, 276, 305, 311, 340,
-	343, 256, 235, 214, 360, 211, 378, 398, 399, 400,
-	402, 309, 230, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 0, 0, 0, 0,
-	522, 0, 0, 0, 234, 521, 0, 0, 0, 285,
-	231, 0, 0, 341, 0, 186, 0, 379, 219, 294,
-	292, 408, 245, 237, 233, 218, 269, 300, 339, 397,
-	333, 565, 289, 0, 0, 388, 312, 0, 0, 0,
-	0, 0, 556, 557, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 217, 185, 324, 389, 249, 0, 80,
-	0, 0, 177, 178, 179, 543, 542, 545, 546, 547,
-	548, 0, 0, 208, 544, 215, 549, 550, 551, 0,
-	229, 273, 236, 228, 405, 0, 0, 0, 0, 201,
-	0, 0, 0, 0, 0, 519, 536, 0, 564, 0,
-	0, 0, 239, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 533, 534,
-	614, 0, 0, 0, 580, 0, 535, 0, 0, 528,
-	529, 531, 530, 532, 537, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 257, 0, 313, 0, 579, 0,
-	0, 437, 0, 0, 577, 0, 0, 0, 0, 284,
-	0, 281, 181, 197, 0, 0, 323, 362, 368, 0,
-	0, 0, 220, 0, 366, 337, 422, 204, 247, 359,
-	342, 364, 0, 0, 365, 290, 410, 354, 420, 438,
-	439, 227, 317, 428, 401, 434, 450, 198, 224, 331,
-	394, 425, 385, 310, 406, 407, 280, 384, 255, 184,
-	288, 444, 196, 374, 212, 189, 396, 418, 209, 377,
-	0, 0, 452, 191, 416, 393, 307, 277, 278, 190,
-	0, 358, 232, 253, 222, 326, 413, 414, 221, 453,
-	200, 433, 193, 0, 432, 319, 409, 417, 308, 299,
-	192, 415, 306, 298, 283, 243, 264, 352, 293, 353,
-	265, 315, 314, 316, 0, 187, 0, 390, 426, 454,
-	205, 206, 207, 0, 242, 246, 252, 254, 260, 261,
-	268, 286, 330, 351, 349, 355, 0, 404, 421, 429,
-	436, 442, 443, 445, 446, 447, 448, 449, 318, 267,
-	386, 282, 291, 0, 0, 336, 367, 210, 424, 387,
-	567, 578, 573, 574, 571, 572, 566, 570, 569, 568,
-	581, 558, 559, 560, 561, 563, 0, 575, 576, 562,
-	180, 194, 287, 0, 356, 250, 451, 431, 427, 0,
-	0, 226, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 182, 183, 195, 203, 213, 225,
-	240, 248, 258, 263, 266, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 411, 412, 423, 435, 440,
-	259, 419, 441, 0, 295, 0, 0, 297, 244, 262,
-	272, 0, 430, 392, 199, 363, 251, 188, 216, 202,
-	223, 238, 241, 276, 305, 311, 340, 343, 256, 235,
-	214, 360, 211, 378, 398, 399, 400, 402, 309, 230,
-	403, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 327, 0, 0, 0, 0, 522, 0, 0,
-	0, 234, 521, 0, 0, 0, 285, 231, 0, 0,
-	341, 0, 186, 0, 379, 219, 294, 292, 408, 245,
-	237, 233, 218, 269, 300, 339, 397, 333, 565, 289,
-	0, 0, 388, 312, 0, 0, 0, 0, 0, 556,
-	557, 0, 0, 0, 0, 0, 0, 0, 0, 275,
-	217, 185, 324, 389, 249, 0, 80, 0, 0, 177,
-	178, 179, 543, 1447, 545, 546, 547, 548, 0, 0,
-	208, 544, 215, 549, 550, 551, 0, 229, 273, 236,
-	228, 405, 0, 0, 0, 0, 201, 0, 0, 0,
-	0, 0, 519, 536, 0, 564, 0, 0, 0, 239,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 533, 534, 614, 0, 0,
-	0, 580, 0, 535, 0, 0, 528, 529, 531, 530,
-	532, 537, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 257, 0, 313, 0, 579, 0, 0, 437, 0,
-	0, 577, 0, 0, 0, 0, 284, 0, 281, 181,
-	197, 0, 0, 323, 362, 368, 0, 0, 0, 220,
-	0, 366, 337, 422, 204, 247, 359, 342, 364, 0,
-	0, 365, 290, 410, 354, 420, 438, 439, 227, 317,
-	428, 401, 434, 450, 198, 224, 331, 394, 425, 385,
-	310, 406, 407, 280, 384, 255, 184, 288, 444, 196,
-	374, 212, 189, 396, 418, 209, 377, 0, 0, 452,
-	191, 416, 393, 307, 277, 278, 190, 0, 358, 232,
-	253, 222, 326, 413, 414, 221, 453, 200, 433, 193,
-	0, 432, 319, 409, 417, 308, 299, 192, 415, 306,
-	298, 283, 243, 264, 352, 293, 353, 265, 315, 314,
-	316, 0, 187, 0, 390, 426, 454, 205, 206, 207,
-	0, 242, 246, 252, 254, 260, 261, 268, 286, 330,
-	351, 349, 355, 0, 404, 421, 429, 436, 442, 443,
-	445, 446, 447, 448, 449, 318, 267, 386, 282, 291,
-	0, 0, 336, 367, 210, 424, 387, 567, 578, 573,
-	574, 571, 572, 566, 570, 569, 568, 581, 558, 559,
-	560, 561, 563, 0, 575, 576, 562, 180, 194, 287,
-	0, 356, 250, 451, 431, 427, 0, 0, 226, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 182, 183, 195, 203, 213, 225, 240, 248, 258,
-	263, 266, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 411, 412, 423, 435, 440, 259, 419, 441,
-	0, 295, 0, 0, 297, 244, 262, 272, 0, 430,
-	392, 199, 363, 251, 188, 216, 202, 223, 238, 241,
-	276, 305, 311, 340, 343, 256, 235, 214, 360, 211,
-	378, 398, 399, 400, 402, 309, 230, 403, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 327,
-	0, 0, 0, 0, 522, 0, 0, 0, 234, 521,
-	0, 0, 0, 285, 231, 0, 0, 341, 0, 186,
-	0, 379, 219, 294, 292, 408, 245, 237, 233, 218,
-	269, 300, 339, 397, 333, 565, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 556, 557, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 217, 185, 324,
-	389, 249, 0, 80, 0, 0, 177, 178, 179, 543,
-	1444, 545, 546, 547, 548, 0, 0, 208, 544, 215,
-	549, 550, 551, 0, 229, 273, 236, 228, 405, 0,
-	0, 0, 0, 201, 0, 0, 0, 0, 0, 519,
-	536, 0, 564, 0, 0, 0, 239, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 533, 534, 614, 0, 0, 0, 580, 0,
-	535, 0, 0, 528, 529, 531, 530, 532, 537, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 257, 0,
-	313, 0, 579, 0, 0, 437, 0, 0, 577, 0,
-	0, 0, 0, 284, 0, 281, 181, 197, 0, 0,
-	323, 362, 368, 0, 0, 0, 220, 0, 366, 337,
-	422, 204, 247, 359, 342, 364, 0, 0, 365, 290,
-	410, 354, 420, 438, 439, 227, 317, 428, 401, 434,
-	450, 198, 224, 331, 394, 425, 385, 310, 406, 407,
-	280, 384, 255, 184, 288, 444, 196, 374, 212, 189,
-	396, 418, 209, 377, 0, 0, 452, 191, 416, 393,
-	307, 277, 278, 190, 0, 358, 232, 253, 222, 326,
-	413, 414, 221, 453, 200, 433, 193, 0, 432, 319,
-	409, 417, 308, 299, 192, 415, 306, 298, 283, 243,
-	264, 352, 293, 353, 265, 315, 314, 316, 0, 187,
-	0, 390, 426, 454, 205, 206, 207, 0, 242, 246,
-	252, 254, 260, 261, 268, 286, 330, 351, 349, 355,
-	0, 404, 421, 429, 436, 442, 443, 445, 446, 447,
-	448, 449, 318, 267, 386, 282, 291, 0, 0, 336,
-	367, 210, 424, 387, 567, 578, 573, 574, 571, 572,
-	566, 570, 569, 568, 581, 558, 559, 560, 561, 563,
-	0, 575, 576, 562, 180, 194, 287, 0, 356, 250,
-	451, 431, 427, 0, 0, 226, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 182, 183,
-	195, 203, 213, 225, 240, 248, 258, 263, 266, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 411,
-	412, 423, 435, 440, 259, 419, 441, 0, 295, 0,
-	0, 297, 244, 262, 272, 0, 430, 392, 199, 363,
-	251, 188, 216, 202, 223, 238, 241, 276, 305, 311,
-	340, 343, 256, 235, 214, 360, 211, 378, 398, 399,
-	400, 402, 309, 230, 72, 403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 327, 0, 0,
-	0, 0, 522, 0, 0, 0, 234, 521, 0, 0,
-	0, 285, 231, 0, 0, 341, 0, 186, 0, 379,
-	219, 294, 292, 408, 245, 237, 233, 218, 269, 300,
-	339, 397, 333, 565, 289, 0, 0, 388, 312, 0,
-	0, 0, 0, 0, 556, 557, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 217, 185, 324, 389, 249,
-	0, 80, 0, 0, 177, 178, 179, 543, 542, 545,
-	546, 547, 548, 0, 0, 208, 544, 215, 549, 550,
-	551, 0, 229, 273, 236, 228, 405, 0, 0, 0,
-	0, 201, 0, 0, 0, 0, 0, 519, 536, 0,
-	564, 0, 0, 0, 239, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	533, 534, 0, 0, 0, 0, 580, 0, 535, 0,
-	0, 528, 529, 531, 530, 532, 537, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 257, 0, 313, 0,
-	579, 0, 0, 437, 0, 0, 577, 0, 0, 0,
-	0, 284, 0, 281, 181, 197, 0, 0, 323, 362,
-	368, 0, 0, 0, 220, 0, 366, 337, 422, 204,
-	247, 359, 342, 364, 0, 0, 365, 290, 410, 354,
-	420, 438, 439, 227, 317, 428, 401, 434, 450, 198,
-	224, 331, 394, 425, 385, 310, 406, 407, 280, 384,
-	255, 184, 288, 444, 196, 374, 212, 189, 396, 418,
-	209, 377, 0, 0, 452, 191, 416, 393, 307, 277,
-	278, 190, 0, 358, 232, 253, 222, 326, 413, 414,
-	221, 453, 200, 433, 193, 0, 432, 319, 409, 417,
-	308, 299, 192, 415, 306, 298, 283, 243, 264, 352,
-	293, 353, 265, 315, 314, 316, 0, 187, 0, 390,
-	426, 454, 205, 206, 207, 0, 242, 246, 252, 254,
-	260, 261, 268, 286, 330, 351, 349, 355, 0, 404,
-	421, 429, 436, 442, 443, 445, 446, 447, 448, 449,
-	318, 267, 386, 282, 291, 0, 0, 336, 367, 210,
-	424, 387, 567, 578, 573, 574, 571, 572, 566, 570,
-	569, 568, 581, 558, 559, 560, 561, 563, 0, 575,
-	576, 562, 180, 194, 287, 0, 356, 250, 451, 431,
-	427, 0, 0, 226, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 182, 183, 195, 203,
-	213, 225, 240, 248, 258, 263, 266, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 411, 412, 423,
-	435, 440, 259, 419, 441, 0, 295, 0, 0, 297,
-	244, 262, 272, 0, 430, 392, 199, 363, 251, 188,
-	216, 202, 223, 238, 241, 276, 305, 311, 340, 343,
-	256, 235, 214, 360, 211, 378, 398, 399, 400, 402,
-	309, 230, 403, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 327, 0, 0, 0, 0, 522,
-	0, 0, 0, 234, 521, 0, 0, 0, 285, 231,
-	0, 0, 341, 0, 186, 0, 379, 219, 294, 292,
-	408, 245, 237, 233, 218, 269, 300, 339, 397, 333,
-	565, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 556, 557, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 217, 185, 324, 389, 249, 0, 80, 0,
-	0, 177, 178, 179, 543, 542, 545, 546, 547, 548,
-	0, 0, 208, 544, 215, 549, 550, 551, 0, 229,
-	273, 236, 228, 405, 0, 0, 0, 0, 201, 0,
-	0, 0, 0, 0, 519, 536, 0, 564, 0, 0,
-	0, 239, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 533, 534, 0,
-	0, 0, 0, 580, 0, 535, 0, 0, 528, 529,
-	531, 530, 532, 537, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 257, 0, 313, 0, 579, 0, 0,
-	437, 0, 0, 577, 0, 0, 0, 0, 284, 0,
-	281, 181, 197, 0, 0, 323, 362, 368, 0, 0,
-	0, 220, 0, 366, 337, 422, 204, 247, 359, 342,
-	364, 0, 0, 365, 290, 410, 354, 420, 438, 439,
-	227, 317, 428, 401, 434, 450, 198, 224, 331, 394,
-	425, 385, 310, 406, 407, 280, 384, 255, 184, 288,
-	444, 196, 374, 212, 189, 396, 418, 209, 377, 0,
-	0, 452, 191, 416, 393, 307, 277, 278, 190, 0,
-	358, 232, 253, 222, 326, 413, 414, 221, 453, 200,
-	433, 193, 0, 432, 319, 409, 417, 308, 299, 192,
-	415, 306, 298, 283, 243, 264, 352, 293, 353, 265,
-	315, 314, 316, 0, 187, 0, 390, 426, 454, 205,
-	206, 207, 0, 242, 246, 252, 254, 260, 261, 268,
-	286, 330, 351, 349, 355, 0, 404, 421, 429, 436,
-	442, 443, 445, 446, 447, 448, 449, 318, 267, 386,
-	282, 291, 0, 0, 336, 367, 210, 424, 387, 567,
-	578, 573, 574, 571, 572, 566, 570, 569, 568, 581,
-	558, 559, 560, 561, 563, 0, 575, 576, 562, 180,
-	194, 287, 0, 356, 250, 451, 431, 427, 0, 0,
-	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 182, 183, 195, 203, 213, 225, 240,
-	248, 258, 263, 266, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 411, 412, 423, 435, 440, 259,
-	419, 441, 0, 295, 0, 0, 297, 244, 262, 272,
-	0, 430, 392, 199, 363, 251, 188, 216, 202, 223,
-	238, 241, 276, 305, 311, 340, 343, 256, 235, 214,
-	360, 211, 378, 398, 399, 400, 402, 309, 230, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 0, 0, 0, 0, 285, 231, 0, 0, 341,
-	0, 186, 0, 379, 219, 294, 292, 408, 245, 237,
-	233, 218, 269, 300, 339, 397, 333, 565, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 556, 557,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 217,
-	185, 324, 389, 249, 0, 80, 0, 0, 177, 178,
-	179, 543, 542, 545, 546, 547, 548, 0, 0, 208,
-	544, 215, 549, 550, 551, 0, 229, 273, 236, 228,
-	405, 0, 0, 0, 0, 201, 0, 0, 0, 0,
-	0, 0, 536, 0, 564, 0, 0, 0, 239, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
-	580, 0, 535, 0, 0, 528, 529, 531, 530, 532,
-	537, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	257, 0, 313, 0, 579, 0, 0, 437, 0, 0,
-	577, 0, 0, 0, 0, 284, 0, 281, 181, 197,
-	0, 0, 323, 362, 368, 0, 0, 0, 220, 0,
-	366, 337, 422, 204, 247, 359, 342, 364, 2276, 0,
-	365, 290, 410, 354, 420, 438, 439, 227, 317, 428,
-	401, 434, 450, 198, 224, 331, 394, 425, 385, 310,
-	406, 407, 280, 384, 255, 184, 288, 444, 196, 374,
-	212, 189, 396, 418, 209, 377, 0, 0, 452, 191,
-	416, 393, 307, 277, 278, 190, 0, 358, 232, 253,
-	222, 326, 413, 414, 221, 453, 200, 433, 193, 0,
-	432, 319, 409, 417, 308, 299, 192, 415, 306, 298,
-	283, 243, 264, 352, 293, 353, 265, 315, 314, 316,
-	0, 187, 0, 390, 426, 454, 205, 206, 207, 0,
-	242, 246, 252, 254, 260, 261, 268, 286, 330, 351,
-	349, 355, 0, 404, 421, 429, 436, 442, 443, 445,
-	446, 447, 448, 449, 318, 267, 386, 282, 291, 0,
-	0, 336, 367, 210, 424, 387, 567, 578, 573, 574,
-	571, 572, 566, 570, 569, 568, 581, 558, 559, 560,
-	561, 563, 0, 575, 576, 562, 180, 194, 287, 0,
-	356, 250, 451, 431, 427, 0, 0, 226, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	182, 183, 195, 203, 213, 225, 240, 248, 258, 263,
-	266, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 411, 412, 423, 435, 440, 259, 419, 441, 0,
-	295, 0, 0, 297, 244, 262, 272, 0, 430, 392,
-	199, 363, 251, 188, 216, 202, 223, 238, 241, 276,
-	305, 311, 340, 343, 256, 235, 214, 360, 211, 378,
-	398, 399, 400, 402, 309, 230, 403, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
-	0, 0, 285, 231, 0, 0, 341, 0, 186, 0,
-	379, 219, 294, 292, 408, 245, 237, 233, 218, 269,
-	300, 339, 397, 333, 565, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 556, 557, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 217, 185, 324, 389,
-	249, 0, 80, 0, 1089, 177, 178, 179, 543, 542,
-	545, 546, 547, 548, 0, 0, 208, 544, 215, 549,
-	550, 551, 0, 229, 273, 236, 228, 405, 0, 0,
-	0, 0, 201, 0, 0, 0, 0, 0, 0, 536,
-	0, 564, 0, 0, 0, 239, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 533, 534, 0, 0, 0, 0, 580, 0, 535,
-	0, 0, 528, 529, 531, 530, 532, 537, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 257, 0, 313,
-	0, 579, 0, 0, 437, 0, 0, 577, 0, 0,
-	0, 0, 284, 0, 281, 181, 197, 0, 0, 323,
-	362, 368, 0, 0, 0, 220, 0, 366, 337, 422,
-	204, 247, 359, 342, 364, 0, 0, 365, 290, 410,
-	354, 420, 438, 439, 227, 317, 428, 401, 434, 450,
-	198, 224, 331, 394, 425, 385, 310, 406, 407, 280,
-	384, 255, 184, 288, 444, 196, 374, 212, 189, 396,
-	418, 209, 377, 0, 0, 452, 191, 416, 393, 307,
-	277, 278, 190, 0, 358, 232, 253, 222, 326, 413,
-	414, 221, 453, 200, 433, 193, 0, 432, 319, 409,
-	417, 308, 299, 192, 415, 306, 298, 283, 243, 264,
-	352, 293, 353, 265, 315, 314, 316, 0, 187, 0,
-	390, 426, 454, 205, 206, 207, 0, 242, 246, 252,
-	254, 260, 261, 268, 286, 330, 351, 349, 355, 0,
-	404, 421, 429, 436, 442, 443, 445, 446, 447, 448,
-	449, 318, 267, 386, 282, 291, 0, 0, 336, 367,
-	210, 424, 387, 567, 578, 573, 574, 571, 572, 566,
-	570, 569, 568, 581, 558, 559, 560, 561, 563, 0,
-	575, 576, 562, 180, 194, 287, 0, 356, 250, 451,
-	431, 427, 0, 0, 226, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 182, 183, 195,
-	203, 213, 225, 240, 248, 258, 263, 266, 270, 271,
-	274, 279, 296, 301, 302, 303, 304, 320, 321, 322,
-	325, 328, 329, 332, 334, 335, 338, 344, 345, 346,
-	347, 348, 350, 357, 361, 369, 370, 371, 372, 373,
-	375, 376, 380, 381, 382, 383, 391, 395, 411, 412,
-	423, 435, 440, 259, 419, 441, 0, 295, 0, 0,
-	297, 244, 262, 272, 0, 430, 392, 199, 363, 251,
-	188, 216, 202, 223, 238, 241, 276, 305, 311, 340,
-	343, 256, 235, 214, 360, 211, 378, 398, 399, 400,
-	402, 309, 230, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 0, 0, 0, 0, 285,
-	231, 0, 0, 341, 0, 186, 0, 379, 219, 294,
-	292, 408, 245, 237, 233, 218, 269, 300, 339, 397,
-	333, 565, 289, 0, 0, 388, 312, 0, 0, 0,
-	0, 0, 556, 557, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 217, 185, 324, 389, 249, 0, 80,
-	0, 0, 177, 178, 179, 543, 542, 545, 546, 547,
-	548, 0, 0, 208, 544, 215, 549, 550, 551, 0,
-	229, 273, 236, 228, 405, 0, 0, 0, 0, 201,
-	0, 0, 0, 0, 0, 0, 536, 0, 564, 0,
-	0, 0, 239, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 533, 534,
-	0, 0, 0, 0, 580, 0, 535, 0, 0, 528,
-	529, 531, 530, 532, 537, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 257, 0, 313, 0, 579, 0,
-	0, 437, 0, 0, 577, 0, 0, 0, 0, 284,
-	0, 281, 181, 197, 0, 0, 323, 362, 368, 0,
-	0, 0, 220, 0, 366, 337, 422, 204, 247, 359,
-	342, 364, 0, 0, 365, 290, 410, 354, 420, 438,
-	439, 227, 317, 428, 401, 434, 450, 198, 224, 331,
-	394, 425, 385, 310, 406, 407, 280, 384, 255, 184,
-	288, 444, 196, 374, 212, 189, 396, 418, 209, 377,
-	0, 0, 452, 191, 416, 393, 307, 277, 278, 190,
-	0, 358, 232, 253, 222, 326, 413, 414, 221, 453,
-	200, 433, 193, 0, 432, 319, 409, 417, 308, 299,
-	192, 415, 306, 298, 283, 243, 264, 352, 293, 353,
-	265, 315, 314, 316, 0, 187, 0, 390, 426, 454,
-	205, 206, 207, 0, 242, 246, 252, 254, 260, 261,
-	268, 286, 330, 351, 349, 355, 0, 404, 421, 429,
-	436, 442, 443, 445, 446, 447, 448, 449, 318, 267,
-	386, 282, 291, 0, 0, 336, 367, 210, 424, 387,
-	567, 578, 573, 574, 571, 572, 566, 570, 569, 568,
-	581, 558, 559, 560, 561, 563, 0, 575, 576, 562,
-	180, 194, 287, 0, 356, 250, 451, 431, 427, 0,
-	0, 226, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 182, 183, 195, 203, 213, 225,
-	240, 248, 258, 263, 266, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 411, 412, 423, 435, 440,
-	259, 419, 441, 0, 295, 0, 0, 297, 244, 262,
-	272, 0, 430, 392, 199, 363, 251, 188, 216, 202,
-	223, 238, 241, 276, 305, 311, 340, 343, 256, 235,
-	214, 360, 211, 378, 398, 399, 400, 402, 309, 230,
-	403, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 234, 0, 0, 0, 0, 285, 231, 0, 0,
-	341, 0, 186, 0, 379, 219, 294, 292, 408, 245,
-	237, 233, 218, 269, 300, 339, 397, 333, 0, 289,
-	0, 0, 388, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 275,
-	217, 185, 324, 389, 249, 0, 0, 0, 0, 177,
-	178, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	208, 0, 215, 0, 0, 0, 0, 229, 273, 236,
-	228, 405, 0, 0, 0, 0, 201, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 239,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 994, 993, 1003, 1004, 996, 997, 998, 999, 1000,
-	1001, 1002, 995, 0, 0, 1005, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 257, 0, 313, 0, 0, 0, 0, 437, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 181,
-	197, 0, 0, 323, 362, 368, 0, 0, 0, 220,
-	0, 366, 337, 422, 204, 247, 359, 342, 364, 0,
-	0, 365, 290, 410, 354, 420, 438, 439, 227, 317,
-	428, 401, 434, 450, 198, 224, 331, 394, 425, 385,
-	310, 406, 407, 280, 384, 255, 184, 288, 444, 196,
-	374, 212, 189, 396, 418, 209, 377, 0, 0, 452,
-	191, 416, 393, 307, 277, 278, 190, 0, 358, 232,
-	253, 222, 326, 413, 414, 221, 453, 200, 433, 193,
-	0, 432, 319, 409, 417, 308, 299, 192, 415, 306,
-	298, 283, 243, 264, 352, 293, 353, 265, 315, 314,
-	316, 0, 187, 0, 390, 426, 454, 205, 206, 207,
-	0, 242, 246, 252, 254, 260, 261, 268, 286, 330,
-	351, 349, 355, 0, 404, 421, 429, 436, 442, 443,
-	445, 446, 447, 448, 449, 318, 267, 386, 282, 291,
-	0, 0, 336, 367, 210, 424, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 180, 194, 287,
-	0, 356, 250, 451, 431, 427, 0, 0, 226, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 182, 183, 195, 203, 213, 225, 240, 248, 258,
-	263, 266, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 411, 412, 423, 435, 440, 259, 419, 441,
-	0, 295, 0, 0, 297, 244, 262, 272, 0, 430,
-	392, 199, 363, 251, 188, 216, 202, 223, 238, 241,
-	276, 305, 311, 340, 343, 256, 235, 214, 360, 211,
-	378, 398, 399, 400, 402, 309, 230, 403, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 234, 0,
-	0, 0, 0, 285, 231, 0, 0, 341, 0, 186,
-	0, 379, 219, 294, 292, 408, 245, 237, 233, 218,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 217, 185, 324,
-	389, 249, 0, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 208, 0, 215,
-	0, 0, 0, 0, 229, 273, 236, 228, 405, 0,
-	0, 0, 0, 201, 0, 820, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 239, 0, 0, 0,
+	0, 146, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 140, 0, 0, 141, 0, 0, 2229,
+	0, 0, 2230, 2231, 2232, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 469, 0, 0, 0, 0, 0, 0, 1930, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 257, 0,
-	313, 0, 0, 0, 819, 437, 0, 0, 0, 0,
-	0, 816, 817, 284, 782, 281, 181, 197, 810, 814,
-	323, 362, 368, 0, 0, 0, 220, 0, 366, 337,
-	422, 204, 247, 359, 342, 364, 0, 0, 365, 290,
-	410, 354, 420, 438, 439, 227, 317, 428, 401, 434,
-	450, 198, 224, 331, 394, 425, 385, 310, 406, 407,
-	280, 384, 255, 184, 288, 444, 196, 374, 212, 189,
-	396, 418, 209, 377, 0, 0, 452, 191, 416, 393,
-	307, 277, 278, 190, 0, 358, 232, 253, 222, 326,
-	413, 414, 221, 453, 200, 433, 193, 0, 432, 319,
-	409, 417, 308, 299, 192, 415, 306, 298, 283, 243,
-	264, 352, 293, 353, 265, 315, 314, 316, 0, 187,
-	0, 390, 426, 454, 205, 206, 207, 0, 242, 246,
-	252, 254, 260, 261, 268, 286, 330, 351, 349, 355,
-	0, 404, 421, 429, 436, 442, 443, 445, 446, 447,
-	448, 449, 318, 267, 386, 282, 291, 0, 0, 336,
-	367, 210, 424, 387, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 180, 194, 287, 0, 356, 250,
-	451, 431, 427, 0, 0, 226, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 182, 183,
-	195, 203, 213, 225, 240, 248, 258, 263, 266, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 411,
-	412, 423, 435, 440, 259, 419, 441, 0, 295, 0,
-	0, 297, 244, 262, 272, 0, 430, 392, 199, 363,
-	251, 188, 216, 202, 223, 238, 241, 276, 305, 311,
-	340, 343, 256, 235, 214, 360, 211, 378, 398, 399,
-	400, 402, 309, 230, 403, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 327, 0, 0, 0,
-	1107, 0, 0, 0, 0, 234, 0, 0, 0, 0,
-	285, 231, 0, 0, 341, 0, 186, 0, 379, 219,
-	294, 292, 408, 245, 237, 233, 218, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 217, 185, 324, 389, 249, 0,
-	0, 0, 0, 177, 178, 179, 0, 1109, 0, 0,
-	0, 0, 0, 0, 208, 0, 215, 0, 0, 0,
-	0, 229, 273, 236, 228, 405, 0, 0, 0, 0,
-	201, 0, 0, 983, 984, 982, 0, 0, 0, 0,
-	0, 0, 0, 239, 0, 0, 0, 0, 0, 0,
-	0, 985, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 257, 0, 313, 0, 0,
-	0, 0, 437, 0, 0, 0, 0, 0, 0, 0,
-	284, 0, 281, 181, 197, 0, 0, 323, 362, 368,
-	0, 0, 0, 220, 0, 366, 337, 422, 204, 247,
-	359, 342, 364, 0, 0, 365, 290, 410, 354, 420,
-	438, 439, 227, 317, 428, 401, 434, 450, 198, 224,
-	331, 394, 425, 385, 310, 406, 407, 280, 384, 255,
-	184, 288, 444, 196, 374, 212, 189, 396, 418, 209,
-	377, 0, 0, 452, 191, 416, 393, 307, 277, 278,
-	190, 0, 358, 232, 253, 222, 326, 413, 414, 221,
-	453, 200, 433, 193, 0, 432, 319, 409, 417, 308,
-	299, 192, 415, 306, 298, 283, 243, 264, 352, 293,
-	353, 265, 315, 314, 316, 0, 187, 0, 390, 426,
-	454, 205, 206, 207, 0, 242, 246, 252, 254, 260,
-	261, 268, 286, 330, 351, 349, 355, 0, 404, 421,
-	429, 436, 442, 443, 445, 446, 447, 448, 449, 318,
-	267, 386, 282, 291, 0, 0, 336, 367, 210, 424,
-	387, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 180, 194, 287, 0, 356, 250, 451, 431, 427,
-	0, 0, 226, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 182, 183, 195, 203, 213,
-	225, 240, 248, 258, 263, 266, 270, 271, 274, 279,
-	296, 301, 302, 303, 304, 320, 321, 322, 325, 328,
-	329, 332, 334, 335, 338, 344, 345, 346, 347, 348,
-	350, 357, 361, 369, 370, 371, 372, 373, 375, 376,
-	380, 381, 382, 383, 391, 395, 411, 412, 423, 435,
-	440, 259, 419, 441, 0, 295, 0, 0, 297, 244,
-	262, 272, 0, 430, 392, 199, 363, 251, 188, 216,
-	202, 223, 238, 241, 276, 305, 311, 340, 343, 256,
-	235, 214, 360, 211, 378, 398, 399, 400, 402, 309,
-	230, 72, 403, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 234, 0, 0, 0, 0, 285, 231,
-	0, 0, 341, 0, 186, 0, 379, 219, 294, 292,
-	408, 245, 237, 233, 218, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 217, 185, 324, 389, 249, 0, 80, 0,
-	1089, 177, 178, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 208, 0, 215, 0, 0, 0, 0, 229,
-	273, 236, 228, 405, 0, 0, 0, 0, 201, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 239, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 257, 0, 313, 0, 0, 0, 0,
-	437, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 181, 197, 0, 0, 323, 362, 368, 0, 0,
-	0, 220, 0, 366, 337, 422, 204, 247, 359, 342,
-	364, 0, 0, 365, 290, 410, 354, 420, 438, 439,
-	227, 317, 428, 401, 434, 450, 198, 224, 331, 394,
-	425, 385, 310, 406, 407, 280, 384, 255, 184, 288,
-	444, 196, 374, 212, 189, 396, 418, 209, 377, 0,
-	0, 452, 191, 416, 393, 307, 277, 278, 190, 0,
-	358, 232, 253, 222, 326, 413, 414, 221, 453, 200,
-	433, 193, 0, 432, 319, 409, 417, 308, 299, 192,
-	415, 306, 298, 283, 243, 264, 352, 293, 353, 265,
-	315, 314, 316, 0, 187, 0, 390, 426, 454, 205,
-	206, 207, 0, 242, 246, 252, 254, 260, 261, 268,
-	286, 330, 351, 349, 355, 0, 404, 421, 429, 436,
-	442, 443, 445, 446, 447, 448, 449, 318, 267, 386,
-	282, 291, 0, 0, 336, 367, 210, 424, 387, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1387, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
-	194, 287, 0, 356, 250, 451, 431, 427, 0, 0,
-	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 182, 183, 195, 203, 213, 225, 240,
-	248, 258, 263, 266, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 411, 412, 423, 435, 440, 259,
-	419, 441, 0, 295, 0, 0, 297, 244, 262, 272,
-	0, 430, 392, 199, 363, 251, 188, 216, 202, 223,
-	238, 241, 276, 305, 311, 340, 343, 256, 235, 214,
-	360, 211, 378, 398, 399, 400, 402, 309, 230, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 0, 0, 0, 1476, 0, 0, 0, 0,
-	234, 0, 0, 0, 0, 285, 231, 0, 0, 341,
-	0, 186, 0, 379, 219, 294, 292, 408, 245, 237,
-	233, 218, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 217,
-	185, 324, 389, 249, 0, 0, 0, 0, 177, 178,
-	179, 0, 1291, 0, 0, 0, 0, 0, 0, 208,
-	0, 215, 0, 0, 0, 0, 229, 273, 236, 228,
-	405, 0, 0, 0, 0, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2165,
+	0, 0, 0, 469, 469, 469, 469, 469, 0, 0,
+	0, 0, 0, 0, 0, 2177, 2178, 2179, 0, 469,
+	469, 153, 158, 155, 161, 162, 163, 164, 166, 167,
+	168, 169, 0, 0, 0, 0, 0, 170, 171, 172,
+	173, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 650, 650, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 469, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2362, 0, 0, 0, 0, 0, 1291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 469, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 469, 0, 0, 2059, 0, 76,
+	0, 2059, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 469, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 469, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 469, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 469,
+	0, 0, 469, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2059, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2357, 0, 0, 0, 0,
+	76, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 469,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 76, 0, 0, 0, 0,
+	0, 0, 0, 0, 1291, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 469, 0, 0, 469, 469, 469, 0, 2437, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1342,
+	0, 0, 791, 777, 411, 0, 726, 794, 697, 714,
+	804, 717, 720, 760, 676, 739, 332, 711, 0, 701,
+	672, 707, 673, 699, 728, 239, 696, 779, 742, 793,
+	290, 236, 678, 702, 346, 716, 190, 762, 387, 224,
+	299, 297, 416, 250, 242, 238, 222, 274, 305, 344,
+	405, 338, 800, 294, 749, 0, 396, 317, 0, 0,
+	0, 730, 783, 737, 773, 725, 761, 686, 748, 795,
+	712, 757, 796, 280, 221, 189, 329, 397, 254, 0,
+	0, 0, 0, 181, 182, 183, 0, 2405, 2406, 0,
+	0, 0, 0, 0, 212, 0, 219, 754, 790, 709,
+	756, 234, 278, 241, 233, 413, 801, 782, 0, 0,
+	205, 792, 732, 759, 807, 671, 751, 0, 674, 677,
+	803, 786, 705, 244, 0, 0, 0, 0, 0, 0,
+	0, 729, 738, 770, 723, 469, 0, 0, 0, 0,
+	0, 0, 0, 703, 0, 747, 0, 0, 0, 682,
+	675, 0, 0, 0, 0, 727, 0, 0, 0, 685,
+	0, 704, 771, 0, 669, 262, 679, 318, 0, 1291,
+	775, 785, 724, 445, 789, 722, 721, 766, 683, 781,
+	715, 289, 681, 286, 185, 201, 0, 713, 328, 368,
+	374, 780, 700, 708, 225, 706, 372, 342, 430, 208,
+	252, 365, 347, 370, 746, 764, 371, 295, 418, 359,
+	428, 446, 447, 232, 322, 436, 409, 442, 460, 202,
+	229, 336, 402, 433, 393, 315, 414, 415, 285, 392,
+	260, 188, 293, 452, 200, 380, 216, 193, 404, 426,
+	213, 383, 0, 0, 462, 195, 424, 401, 312, 282,
+	283, 194, 0, 364, 237, 258, 227, 331, 421, 422,
+	226, 463, 204, 441, 197, 986, 440, 324, 417, 425,
+	313, 304, 196, 423, 311, 303, 288, 248, 269, 357,
+	298, 358, 270, 320, 319, 321, 0, 191, 0, 398,
+	434, 464, 209, 210, 211, 695, 247, 251, 257, 259,
+	265, 266, 273, 291, 335, 356, 354, 360, 776, 412,
+	429, 437, 444, 450, 451, 453, 454, 455, 456, 457,
+	458, 459, 323, 272, 394, 287, 296, 768, 806, 341,
+	373, 214, 432, 395, 690, 694, 688, 689, 740, 741,
+	691, 797, 798, 799, 772, 684, 0, 692, 693, 0,
+	778, 787, 788, 745, 184, 198, 292, 802, 361, 255,
+	461, 439, 435, 670, 687, 231, 698, 0, 0, 710,
+	718, 719, 731, 733, 734, 735, 736, 744, 752, 753,
+	755, 763, 765, 767, 769, 774, 784, 805, 186, 187,
+	199, 207, 217, 230, 245, 253, 263, 268, 271, 275,
+	276, 279, 284, 301, 306, 307, 308, 309, 325, 326,
+	327, 330, 333, 334, 337, 339, 340, 343, 349, 350,
+	351, 352, 353, 355, 362, 367, 375, 376, 377, 378,
+	379, 381, 382, 388, 389, 390, 391, 399, 403, 419,
+	420, 431, 443, 448, 223, 363, 384, 385, 264, 427,
+	449, 0, 300, 743, 750, 302, 249, 267, 277, 758,
+	438, 400, 203, 369, 256, 192, 220, 206, 228, 243,
+	246, 281, 310, 316, 345, 348, 261, 240, 218, 366,
+	215, 386, 406, 407, 408, 410, 314, 235, 791, 777,
+	411, 0, 726, 794, 697, 714, 804, 717, 720, 760,
+	676, 739, 332, 711, 0, 701, 672, 707, 673, 699,
+	728, 239, 696, 779, 742, 793, 290, 236, 678, 702,
+	346, 716, 190, 762, 387, 224, 299, 297, 416, 250,
+	242, 238, 222, 274, 305, 344, 405, 338, 800, 294,
+	749, 0, 396, 317, 0, 0, 0, 730, 783, 737,
+	773, 725, 761, 686, 748, 795, 712, 757, 796, 280,
+	221, 189, 329, 397, 254, 0, 0, 0, 0, 181,
+	182, 183, 0, 0, 0, 0, 0, 0, 0, 0,
+	212, 0, 219, 754, 790, 709, 756, 234, 278, 241,
+	233, 413, 801, 782, 0, 0, 205, 792, 732, 759,
+	807, 671, 751, 0, 674, 677, 803, 786, 705, 244,
+	0, 0, 0, 0, 0, 0, 0, 729, 738, 770,
+	723, 0, 0, 0, 0, 0, 0, 2050, 0, 703,
+	0, 747, 0, 0, 0, 682, 675, 0, 0, 0,
+	0, 727, 0, 0, 0, 685, 0, 704, 771, 0,
+	669, 262, 679, 318, 0, 0, 775, 785, 724, 445,
+	789, 722, 721, 766, 683, 781, 715, 289, 681, 286,
+	185, 201, 0, 713, 328, 368, 374, 780, 700, 708,
+	225, 706, 372, 342, 430, 208, 252, 365, 347, 370,
+	746, 764, 371, 295, 418, 359, 428, 446, 447, 232,
+	322, 436, 409, 442, 460, 202, 229, 336, 402, 433,
+	393, 315, 414, 415, 285, 392, 260, 188, 293, 452,
+	200, 380, 216, 193, 404, 426, 213, 383, 0, 0,
+	462, 195, 424, 401, 312, 282, 283, 194, 0, 364,
+	237, 258, 227, 331, 421, 422, 226, 463, 204, 441,
+	197, 986, 440, 324, 417, 425, 313, 304, 196, 423,
+	311, 303, 288, 248, 269, 357, 298, 358, 270, 320,
+	319, 321, 0, 191, 0, 398, 434, 464, 209, 210,
+	211, 695, 247, 251, 257, 259, 265, 266, 273, 291,
+	335, 356, 354, 360, 776, 412, 429, 437, 444, 450,
+	451, 453, 454, 455, 456, 457, 458, 459, 323, 272,
+	394, 287, 296, 768, 806, 341, 373, 214, 432, 395,
+	690, 694, 688, 689, 740, 741, 691, 797, 798, 799,
+	772, 684, 0, 692, 693, 0, 778, 787, 788, 745,
+	184, 198, 292, 802, 361, 255, 461, 439, 435, 670,
+	687, 231, 698, 0, 0, 710, 718, 719, 731, 733,
+	734, 735, 736, 744, 752, 753, 755, 763, 765, 767,
+	769, 774, 784, 805, 186, 187, 199, 207, 217, 230,
+	245, 253, 263, 268, 271, 275, 276, 279, 284, 301,
+	306, 307, 308, 309, 325, 326, 327, 330, 333, 334,
+	337, 339, 340, 343, 349, 350, 351, 352, 353, 355,
+	362, 367, 375, 376, 377, 378, 379, 381, 382, 388,
+	389, 390, 391, 399, 403, 419, 420, 431, 443, 448,
+	223, 363, 384, 385, 264, 427, 449, 0, 300, 743,
+	750, 302, 249, 267, 277, 758, 438, 400, 203, 369,
+	256, 192, 220, 206, 228, 243, 246, 281, 310, 316,
+	345, 348, 261, 240, 218, 366, 215, 386, 406, 407,
+	408, 410, 314, 235, 791, 777, 411, 0, 726, 794,
+	697, 714, 804, 717, 720, 760, 676, 739, 332, 711,
+	0, 701, 672, 707, 673, 699, 728, 239, 696, 779,
+	742, 793, 290, 236, 678, 702, 346, 716, 190, 762,
+	387, 224, 299, 297, 416, 250, 242, 238, 222, 274,
+	305, 344, 405, 338, 800, 294, 749, 0, 396, 317,
+	0, 0, 0, 730, 783, 737, 773, 725, 761, 686,
+	748, 795, 712, 757, 796, 280, 221, 189, 329, 397,
+	254, 0, 0, 0, 0, 181, 182, 183, 0, 0,
+	0, 0, 0, 0, 0, 0, 212, 0, 219, 754,
+	790, 709, 756, 234, 278, 241, 233, 413, 801, 782,
+	0, 0, 205, 792, 732, 759, 807, 671, 751, 0,
+	674, 677, 803, 786, 705, 244, 0, 0, 0, 0,
+	0, 0, 0, 729, 738, 770, 723, 0, 0, 0,
+	0, 0, 0, 1872, 0, 703, 0, 747, 0, 0,
+	0, 682, 675, 0, 0, 0, 0, 727, 0, 0,
+	0, 685, 0, 704, 771, 0, 669, 262, 679, 318,
+	0, 0, 775, 785, 724, 445, 789, 722, 721, 766,
+	683, 781, 715, 289, 681, 286, 185, 201, 0, 713,
+	328, 368, 374, 780, 700, 708, 225, 706, 372, 342,
+	430, 208, 252, 365, 347, 370, 746, 764, 371, 295,
+	418, 359, 428, 446, 447, 232, 322, 436, 409, 442,
+	460, 202, 229, 336, 402, 433, 393, 315, 414, 415,
+	285, 392, 260, 188, 293, 452, 200, 380, 216, 193,
+	404, 426, 213, 383, 0, 0, 462, 195, 424, 401,
+	312, 282, 283, 194, 0, 364, 237, 258, 227, 331,
+	421, 422, 226, 463, 204, 441, 197, 986, 440, 324,
+	417, 425, 313, 304, 196, 423, 311, 303, 288, 248,
+	269, 357, 298, 358, 270, 320, 319, 321, 0, 191,
+	0, 398, 434, 464, 209, 210, 211, 695, 247, 251,
+	257, 259, 265, 266, 273, 291, 335, 356, 354, 360,
+	776, 412, 429, 437, 444, 450, 451, 453, 454, 455,
+	456, 457, 458, 459, 323, 272, 394, 287, 296, 768,
+	806, 341, 373, 214, 432, 395, 690, 694, 688, 689,
+	740, 741, 691, 797, 798, 799, 772, 684, 0, 692,
+	693, 0, 778, 787, 788, 745, 184, 198, 292, 802,
+	361, 255, 461, 439, 435, 670, 687, 231, 698, 0,
+	0, 710, 718, 719, 731, 733, 734, 735, 736, 744,
+	752, 753, 755, 763, 765, 767, 769, 774, 784, 805,
+	186, 187, 199, 207, 217, 230, 245, 253, 263, 268,
+	271, 275, 276, 279, 284, 301, 306, 307, 308, 309,
+	325, 326, 327, 330, 333, 334, 337, 339, 340, 343,
+	349, 350, 351, 352, 353, 355, 362, 367, 375, 376,
+	377, 378, 379, 381, 382, 388, 389, 390, 391, 399,
+	403, 419, 420, 431, 443, 448, 223, 363, 384, 385,
+	264, 427, 449, 0, 300, 743, 750, 302, 249, 267,
+	277, 758, 438, 400, 203, 369, 256, 192, 220, 206,
+	228, 243, 246, 281, 310, 316, 345, 348, 261, 240,
+	218, 366, 215, 386, 406, 407, 408, 410, 314, 235,
+	791, 777, 411, 0, 726, 794, 697, 714, 804, 717,
+	720, 760, 676, 739, 332, 711, 0, 701, 672, 707,
+	673, 699, 728, 239, 696, 779, 742, 793, 290, 236,
+	678, 702, 346, 716, 190, 762, 387, 224, 299, 297,
+	416, 250, 242, 238, 222, 274, 305, 344, 405, 338,
+	800, 294, 749, 0, 396, 317, 0, 0, 0, 730,
+	783, 737, 773, 725, 761, 686, 748, 795, 712, 757,
+	796, 280, 221, 189, 329, 397, 254, 0, 0, 0,
+	0, 181, 182, 183, 0, 0, 0, 0, 0, 0,
+	0, 0, 212, 0, 219, 754, 790, 709, 756, 234,
+	278, 241, 233, 413, 801, 782, 0, 0, 205, 792,
+	732, 759, 807, 671, 751, 0, 674, 677, 803, 786,
+	705, 244, 0, 0, 0, 0, 0, 0, 0, 729,
+	738, 770, 723, 0, 0, 0, 0, 0, 0, 1567,
+	0, 703, 0, 747, 0, 0, 0, 682, 675, 0,
+	0, 0, 0, 727, 0, 0, 0, 685, 0, 704,
+	771, 0, 669, 262, 679, 318, 0, 0, 775, 785,
+	724, 445, 789, 722, 721, 766, 683, 781, 715, 289,
+	681, 286, 185, 201, 0, 713, 328, 368, 374, 780,
+	700, 708, 225, 706, 372, 342, 430, 208, 252, 365,
+	347, 370, 746, 764, 371, 295, 418, 359, 428, 446,
+	447, 232, 322, 436, 409, 442, 460, 202, 229, 336,
+	402, 433, 393, 315, 414, 415, 285, 392, 260, 188,
+	293, 452, 200, 380, 216, 193, 404, 426, 213, 383,
+	0, 0, 462, 195, 424, 401, 312, 282, 283, 194,
+	0, 364, 237, 258, 227, 331, 421, 422, 226, 463,
+	204, 441, 197, 986, 440, 324, 417, 425, 313, 304,
+	196, 423, 311, 303, 288, 248, 269, 357, 298, 358,
+	270, 320, 319, 321, 0, 191, 0, 398, 434, 464,
+	209, 210, 211, 695, 247, 251, 257, 259, 265, 266,
+	273, 291, 335, 356, 354, 360, 776, 412, 429, 437,
+	444, 450, 451, 453, 454, 455, 456, 457, 458, 459,
+	323, 272, 394, 287, 296, 768, 806, 341, 373, 214,
+	432, 395, 690, 694, 688, 689, 740, 741, 691, 797,
+	798, 799, 772, 684, 0, 692, 693, 0, 778, 787,
+	788, 745, 184, 198, 292, 802, 361, 255, 461, 439,
+	435, 670, 687, 231, 698, 0, 0, 710, 718, 719,
+	731, 733, 734, 735, 736, 744, 752, 753, 755, 763,
+	765, 767, 769, 774, 784, 805, 186, 187, 199, 207,
+	217, 230, 245, 253, 263, 268, 271, 275, 276, 279,
+	284, 301, 306, 307, 308, 309, 325, 326, 327, 330,
+	333, 334, 337, 339, 340, 343, 349, 350, 351, 352,
+	353, 355, 362, 367, 375, 376, 377, 378, 379, 381,
+	382, 388, 389, 390, 391, 399, 403, 419, 420, 431,
+	443, 448, 223, 363, 384, 385, 264, 427, 449, 0,
+	300, 743, 750, 302, 249, 267, 277, 758, 438, 400,
+	203, 369, 256, 192, 220, 206, 228, 243, 246, 281,
+	310, 316, 345, 348, 261, 240, 218, 366, 215, 386,
+	406, 407, 408, 410, 314, 235, 791, 777, 411, 0,
+	726, 794, 697, 714, 804, 717, 720, 760, 676, 739,
+	332, 711, 0, 701, 672, 707, 673, 699, 728, 239,
+	696, 779, 742, 793, 290, 236, 678, 702, 346, 716,
+	190, 762, 387, 224, 299, 297, 416, 250, 242, 238,
+	222, 274, 305, 344, 405, 338, 800, 294, 749, 0,
+	396, 317, 0, 0, 0, 730, 783, 737, 773, 725,
+	761, 686, 748, 795, 712, 757, 796, 280, 221, 189,
+	329, 397, 254, 0, 84, 0, 0, 181, 182, 183,
+	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
+	219, 754, 790, 709, 756, 234, 278, 241, 233, 413,
+	801, 782, 0, 0, 205, 792, 732, 759, 807, 671,
+	751, 0, 674, 677, 803, 786, 705, 244, 0, 0,
+	0, 0, 0, 0, 0, 729, 738, 770, 723, 0,
+	0, 0, 0, 0, 0, 0, 0, 703, 0, 747,
+	0, 0, 0, 682, 675, 0, 0, 0, 0, 727,
+	0, 0, 0, 685, 0, 704, 771, 0, 669, 262,
+	679, 318, 0, 0, 775, 785, 724, 445, 789, 722,
+	721, 766, 683, 781, 715, 289, 681, 286, 185, 201,
+	0, 713, 328, 368, 374, 780, 700, 708, 225, 706,
+	372, 342, 430, 208, 252, 365, 347, 370, 746, 764,
+	371, 295, 418, 359, 428, 446, 447, 232, 322, 436,
+	409, 442, 460, 202, 229, 336, 402, 433, 393, 315,
+	414, 415, 285, 392, 260, 188, 293, 452, 200, 380,
+	216, 193, 404, 426, 213, 383, 0, 0, 462, 195,
+	424, 401, 312, 282, 283, 194, 0, 364, 237, 258,
+	227, 331, 421, 422, 226, 463, 204, 441, 197, 986,
+	440, 324, 417, 425, 313, 304, 196, 423, 311, 303,
+	288, 248, 269, 357, 298, 358, 270, 320, 319, 321,
+	0, 191, 0, 398, 434, 464, 209, 210, 211, 695,
+	247, 251, 257, 259, 265, 266, 273, 291, 335, 356,
+	354, 360, 776, 412, 429, 437, 444, 450, 451, 453,
+	454, 455, 456, 457, 458, 459, 323, 272, 394, 287,
+	296, 768, 806, 341, 373, 214, 432, 395, 690, 694,
+	688, 689, 740, 741, 691, 797, 798, 799, 772, 684,
+	0, 692, 693, 0, 778, 787, 788, 745, 184, 198,
+	292, 802, 361, 255, 461, 439, 435, 670, 687, 231,
+	698, 0, 0, 710, 718, 719, 731, 733, 734, 735,
+	736, 744, 752, 753, 755, 763, 765, 767, 769, 774,
+	784, 805, 186, 187, 199, 207, 217, 230, 245, 253,
+	263, 268, 271, 275, 276, 279, 284, 301, 306, 307,
+	308, 309, 325, 326, 327, 330, 333, 334, 337, 339,
+	340, 343, 349, 350, 351, 352, 353, 355, 362, 367,
+	375, 376, 377, 378, 379, 381, 382, 388, 389, 390,
+	391, 399, 403, 419, 420, 431, 443, 448, 223, 363,
+	384, 385, 264, 427, 449, 0, 300, 743, 750, 302,
+	249, 267, 277, 758, 438, 400, 203, 369, 256, 192,
+	220, 206, 228, 243, 246, 281, 310, 316, 345, 348,
+	261, 240, 218, 366, 215, 386, 406, 407, 408, 410,
+	314, 235, 791, 777, 411, 0, 726, 794, 697, 714,
+	804, 717, 720, 760, 676, 739, 332, 711, 0, 701,
+	672, 707, 673, 699, 728, 239, 696, 779, 742, 793,
+	290, 236, 678, 702, 346, 716, 190, 762, 387, 224,
+	299, 297, 416, 250, 242, 238, 222, 274, 305, 344,
+	405, 338, 800, 294, 749, 0, 396, 317, 0, 0,
+	0, 730, 783, 737, 773, 725, 761, 686, 748, 795,
+	712, 757, 796, 280, 221, 189, 329, 397, 254, 0,
+	0, 0, 0, 181, 182, 183, 0, 0, 0, 0,
+	0, 0, 0, 0, 212, 0, 219, 754, 790, 709,
+	756, 234, 278, 241, 233, 413, 801, 782, 0, 0,
+	205, 792, 732, 759, 807, 671, 751, 0, 674, 677,
+	803, 786, 705, 244, 0, 0, 0, 0, 0, 0,
+	0, 729, 738, 770, 723, 0, 0, 0, 0, 0,
+	0, 0, 0, 703, 0, 747, 0, 0, 0, 682,
+	675, 0, 0, 0, 0, 727, 0, 0, 0, 685,
+	0, 704, 771, 0, 669, 262, 679, 318, 0, 0,
+	775, 785, 724, 445, 789, 722, 721, 766, 683, 781,
+	715, 289, 681, 286, 185, 201, 0, 713, 328, 368,
+	374, 780, 700, 708, 225, 706, 372, 342, 430, 208,
+	252, 365, 347, 370, 746, 764, 371, 295, 418, 359,
+	428, 446, 447, 232, 322, 436, 409, 442, 460, 202,
+	229, 336, 402, 433, 393, 315, 414, 415, 285, 392,
+	260, 188, 293, 452, 200, 380, 216, 193, 404, 426,
+	213, 383, 0, 0, 462, 195, 424, 401, 312, 282,
+	283, 194, 0, 364, 237, 258, 227, 331, 421, 422,
+	226, 463, 204, 441, 197, 986, 440, 324, 417, 425,
+	313, 304, 196, 423, 311, 303, 288, 248, 269, 357,
+	298, 358, 270, 320, 319, 321, 0, 191, 0, 398,
+	434, 464, 209, 210, 211, 695, 247, 251, 257, 259,
+	265, 266, 273, 291, 335, 356, 354, 360, 776, 412,
+	429, 437, 444, 450, 451, 453, 454, 455, 456, 457,
+	458, 459, 323, 272, 394, 287, 296, 768, 806, 341,
+	373, 214, 432, 395, 690, 694, 688, 689, 740, 741,
+	691, 797, 798, 799, 772, 684, 0, 692, 693, 0,
+	778, 787, 788, 745, 184, 198, 292, 802, 361, 255,
+	461, 439, 435, 670, 687, 231, 698, 0, 0, 710,
+	718, 719, 731, 733, 734, 735, 736, 744, 752, 753,
+	755, 763, 765, 767, 769, 774, 784, 805, 186, 187,
+	199, 207, 217, 230, 245, 253, 263, 268, 271, 275,
+	276, 279, 284, 301, 306, 307, 308, 309, 325, 326,
+	327, 330, 333, 334, 337, 339, 340, 343, 349, 350,
+	351, 352, 353, 355, 362, 367, 375, 376, 377, 378,
+	379, 381, 382, 388, 389, 390, 391, 399, 403, 419,
+	420, 431, 443, 448, 223, 363, 384, 385, 264, 427,
+	449, 0, 300, 743, 750, 302, 249, 267, 277, 758,
+	438, 400, 203, 369, 256, 192, 220, 206, 228, 243,
+	246, 281, 310, 316, 345, 348, 261, 240, 218, 366,
+	215, 386, 406, 407, 408, 410, 314, 235, 791, 777,
+	411, 0, 726, 794, 697, 714, 804, 717, 720, 760,
+	676, 739, 332, 711, 0, 701, 672, 707, 673, 699,
+	728, 239, 696, 779, 742, 793, 290, 236, 678, 702,
+	346, 716, 190, 762, 387, 224, 299, 297, 416, 250,
+	242, 238, 222, 274, 305, 344, 405, 338, 800, 294,
+	749, 0, 396, 317, 0, 0, 0, 730, 783, 737,
+	773, 725, 761, 686, 748, 795, 712, 757, 796, 280,
+	221, 189, 329, 397, 254, 0, 0, 0, 0, 181,
+	182, 183, 0, 0, 0, 0, 0, 0, 0, 0,
+	212, 0, 219, 754, 790, 709, 756, 234, 278, 241,
+	233, 413, 801, 782, 0, 0, 808, 792, 732, 759,
+	807, 671, 751, 0, 674, 677, 803, 786, 705, 244,
+	0, 0, 0, 0, 0, 0, 0, 729, 738, 770,
+	723, 0, 0, 0, 0, 0, 0, 0, 0, 703,
+	0, 747, 0, 0, 0, 682, 675, 0, 0, 0,
+	0, 727, 0, 0, 0, 685, 0, 704, 771, 0,
+	669, 262, 679, 318, 0, 0, 775, 785, 724, 445,
+	789, 722, 721, 766, 683, 781, 715, 289, 681, 286,
+	185, 201, 0, 713, 328, 368, 374, 780, 700, 708,
+	225, 706, 372, 342, 430, 208, 252, 365, 347, 370,
+	746, 764, 371, 295, 418, 359, 428, 446, 447, 232,
+	322, 436, 409, 442, 460, 202, 229, 336, 402, 433,
+	393, 315, 414, 415, 285, 392, 260, 188, 293, 452,
+	200, 380, 216, 193, 404, 426, 213, 383, 0, 0,
+	462, 195, 424, 401, 312, 282, 283, 194, 0, 364,
+	237, 258, 227, 331, 421, 422, 226, 463, 204, 441,
+	197, 680, 440, 324, 417, 425, 313, 304, 196, 423,
+	311, 303, 288, 248, 269, 357, 298, 358, 270, 320,
+	319, 321, 0, 191, 0, 398, 434, 464, 209, 210,
+	211, 695, 247, 251, 257, 259, 265, 266, 273, 291,
+	335, 356, 354, 360, 776, 412, 429, 437, 444, 450,
+	451, 453, 454, 455, 456, 457, 458, 459, 668, 662,
+	661, 287, 296, 768, 806, 341, 373, 214, 432, 395,
+	690, 694, 688, 689, 740, 741, 691, 797, 798, 799,
+	772, 684, 0, 692, 693, 0, 778, 787, 788, 745,
+	184, 198, 292, 802, 361, 255, 461, 439, 435, 670,
+	687, 231, 698, 0, 0, 710, 718, 719, 731, 733,
+	734, 735, 736, 744, 752, 753, 755, 763, 765, 767,
+	769, 774, 784, 805, 186, 187, 199, 207, 217, 230,
+	245, 253, 263, 268, 271, 275, 276, 279, 284, 301,
+	306, 307, 308, 309, 325, 326, 327, 330, 333, 334,
+	337, 339, 340, 343, 349, 350, 351, 352, 353, 355,
+	362, 367, 375, 376, 377, 378, 379, 381, 382, 388,
+	389, 390, 391, 399, 403, 419, 420, 431, 443, 448,
+	223, 363, 384, 385, 264, 427, 449, 0, 300, 743,
+	750, 302, 249, 267, 277, 758, 438, 400, 203, 369,
+	256, 192, 220, 206, 228, 243, 246, 281, 310, 316,
+	345, 348, 261, 240, 218, 366, 215, 386, 406, 407,
+	408, 410, 314, 235, 791, 777, 411, 0, 726, 794,
+	697, 714, 804, 717, 720, 760, 676, 739, 332, 711,
+	0, 701, 672, 707, 673, 699, 728, 239, 696, 779,
+	742, 793, 290, 236, 678, 702, 346, 716, 190, 762,
+	387, 224, 299, 297, 416, 250, 242, 238, 222, 274,
+	305, 344, 405, 338, 800, 294, 749, 0, 396, 317,
+	0, 0, 0, 730, 783, 737, 773, 725, 761, 686,
+	748, 795, 712, 757, 796, 280, 221, 189, 329, 397,
+	254, 0, 0, 0, 0, 181, 182, 183, 0, 0,
+	0, 0, 0, 0, 0, 0, 212, 0, 219, 754,
+	790, 709, 756, 234, 278, 241, 233, 413, 801, 782,
+	0, 0, 808, 792, 732, 759, 807, 671, 751, 0,
+	674, 677, 803, 786, 705, 244, 0, 0, 0, 0,
+	0, 0, 0, 729, 738, 770, 723, 0, 0, 0,
+	0, 0, 0, 0, 0, 703, 0, 747, 0, 0,
+	0, 682, 675, 0, 0, 0, 0, 727, 0, 0,
+	0, 685, 0, 704, 771, 0, 669, 262, 679, 318,
+	0, 0, 775, 785, 724, 445, 789, 722, 721, 766,
+	683, 781, 715, 289, 681, 286, 185, 201, 0, 713,
+	328, 368, 374, 780, 700, 708, 225, 706, 372, 342,
+	430, 208, 252, 365, 347, 370, 746, 764, 371, 295,
+	418, 359, 428, 446, 447, 232, 322, 436, 409, 442,
+	460, 202, 229, 336, 402, 433, 393, 315, 414, 415,
+	285, 392, 260, 188, 293, 452, 200, 380, 216, 193,
+	404, 1182, 213, 383, 0, 0, 462, 195, 424, 401,
+	312, 282, 283, 194, 0, 364, 237, 258, 227, 331,
+	421, 422, 226, 463, 204, 441, 197, 680, 440, 324,
+	417, 425, 313, 304, 196, 423, 311, 303, 288, 248,
+	269, 357, 298, 358, 270, 320, 319, 321, 0, 191,
+	0, 398, 434, 464, 209, 210, 211, 695, 247, 251,
+	257, 259, 265, 266, 273, 291, 335, 356, 354, 360,
+	776, 412, 429, 437, 444, 450, 451, 453, 454, 455,
+	456, 457, 458, 459, 668, 662, 661, 287, 296, 768,
+	806, 341, 373, 214, 432, 395, 690, 694, 688, 689,
+	740, 741, 691, 797, 798, 799, 772, 684, 0, 692,
+	693, 0, 778, 787, 788, 745, 184, 198, 292, 802,
+	361, 255, 461, 439, 435, 670, 687, 231, 698, 0,
+	0, 710, 718, 719, 731, 733, 734, 735, 736, 744,
+	752, 753, 755, 763, 765, 767, 769, 774, 784, 805,
+	186, 187, 199, 207, 217, 230, 245, 253, 263, 268,
+	271, 275, 276, 279, 284, 301, 306, 307, 308, 309,
+	325, 326, 327, 330, 333, 334, 337, 339, 340, 343,
+	349, 350, 351, 352, 353, 355, 362, 367, 375, 376,
+	377, 378, 379, 381, 382, 388, 389, 390, 391, 399,
+	403, 419, 420, 431, 443, 448, 223, 363, 384, 385,
+	264, 427, 449, 0, 300, 743, 750, 302, 249, 267,
+	277, 758, 438, 400, 203, 369, 256, 192, 220, 206,
+	228, 243, 246, 281, 310, 316, 345, 348, 261, 240,
+	218, 366, 215, 386, 406, 407, 408, 410, 314, 235,
+	791, 777, 411, 0, 726, 794, 697, 714, 804, 717,
+	720, 760, 676, 739, 332, 711, 0, 701, 672, 707,
+	673, 699, 728, 239, 696, 779, 742, 793, 290, 236,
+	678, 702, 346, 716, 190, 762, 387, 224, 299, 297,
+	416, 250, 242, 238, 222, 274, 305, 344, 405, 338,
+	800, 294, 749, 0, 396, 317, 0, 0, 0, 730,
+	783, 737, 773, 725, 761, 686, 748, 795, 712, 757,
+	796, 280, 221, 189, 329, 397, 254, 0, 0, 0,
+	0, 181, 182, 183, 0, 0, 0, 0, 0, 0,
+	0, 0, 212, 0, 219, 754, 790, 709, 756, 234,
+	278, 241, 233, 413, 801, 782, 0, 0, 808, 792,
+	732, 759, 807, 671, 751, 0, 674, 677, 803, 786,
+	705, 244, 0, 0, 0, 0, 0, 0, 0, 729,
+	738, 770, 723, 0, 0, 0, 0, 0, 0, 0,
+	0, 703, 0, 747, 0, 0, 0, 682, 675, 0,
+	0, 0, 0, 727, 0, 0, 0, 685, 0, 704,
+	771, 0, 669, 262, 679, 318, 0, 0, 775, 785,
+	724, 445, 789, 722, 721, 766, 683, 781, 715, 289,
+	681, 286, 185, 201, 0, 713, 328, 368, 374, 780,
+	700, 708, 225, 706, 372, 342, 430, 208, 252, 365,
+	347, 370, 746, 764, 371, 295, 418, 359, 428, 446,
+	447, 232, 322, 436, 409, 442, 460, 202, 229, 336,
+	402, 433, 393, 315, 414, 415, 285, 392, 260, 188,
+	293, 452, 200, 380, 216, 193, 404, 659, 213, 383,
+	0, 0, 462, 195, 424, 401, 312, 282, 283, 194,
+	0, 364, 237, 258, 227, 331, 421, 422, 226, 463,
+	204, 441, 197, 680, 440, 324, 417, 425, 313, 304,
+	196, 423, 311, 303, 288, 248, 269, 357, 298, 358,
+	270, 320, 319, 321, 0, 191, 0, 398, 434, 464,
+	209, 210, 211, 695, 247, 251, 257, 259, 265, 266,
+	273, 291, 335, 356, 354, 360, 776, 412, 429, 437,
+	444, 450, 451, 453, 454, 455, 456, 457, 458, 459,
+	668, 662, 661, 287, 296, 768, 806, 341, 373, 214,
+	432, 395, 690, 694, 688, 689, 740, 741, 691, 797,
+	798, 799, 772, 684, 0, 692, 693, 0, 778, 787,
+	788, 745, 184, 198, 292, 802, 361, 255, 461, 439,
+	435, 670, 687, 231, 698, 0, 0, 710, 718, 719,
+	731, 733, 734, 735, 736, 744, 752, 753, 755, 763,
+	765, 767, 769, 774, 784, 805, 186, 187, 199, 207,
+	217, 230, 245, 253, 263, 268, 271, 275, 276, 279,
+	284, 301, 306, 307, 308, 309, 325, 326, 327, 330,
+	333, 334, 337, 339, 340, 343, 349, 350, 351, 352,
+	353, 355, 362, 367, 375, 376, 377, 378, 379, 381,
+	382, 388, 389, 390, 391, 399, 403, 419, 420, 431,
+	443, 448, 223, 363, 384, 385, 264, 427, 449, 0,
+	300, 743, 750, 302, 249, 267, 277, 758, 438, 400,
+	203, 369, 256, 192, 220, 206, 228, 243, 246, 281,
+	310, 316, 345, 348, 261, 240, 218, 366, 215, 386,
+	406, 407, 408, 410, 314, 235, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 332, 0,
+	0, 1484, 0, 535, 0, 0, 0, 239, 534, 0,
+	0, 0, 290, 236, 0, 1485, 346, 0, 190, 0,
+	387, 224, 299, 297, 416, 250, 242, 238, 222, 274,
+	305, 344, 405, 338, 579, 294, 0, 0, 396, 317,
+	0, 0, 0, 0, 0, 570, 571, 0, 0, 0,
+	0, 0, 0, 0, 0, 280, 221, 189, 329, 397,
+	254, 0, 84, 0, 0, 181, 182, 183, 557, 556,
+	559, 560, 561, 562, 0, 0, 212, 558, 219, 563,
+	564, 565, 0, 234, 278, 241, 233, 413, 0, 0,
+	0, 0, 205, 0, 0, 0, 0, 0, 532, 549,
+	0, 578, 0, 0, 0, 244, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 546, 547, 648, 0, 0, 0, 594, 0, 548,
+	0, 0, 541, 542, 544, 543, 545, 550, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 318,
+	0, 0, 593, 0, 0, 445, 0, 0, 591, 0,
+	0, 0, 0, 289, 0, 286, 185, 201, 0, 0,
+	328, 368, 374, 0, 0, 0, 225, 0, 372, 342,
+	430, 208, 252, 365, 347, 370, 0, 0, 371, 295,
+	418, 359, 428, 446, 447, 232, 322, 436, 409, 442,
+	460, 202, 229, 336, 402, 433, 393, 315, 414, 415,
+	285, 392, 260, 188, 293, 452, 200, 380, 216, 193,
+	404, 426, 213, 383, 0, 0, 462, 195, 424, 401,
+	312, 282, 283, 194, 0, 364, 237, 258, 227, 331,
+	421, 422, 226, 463, 204, 441, 197, 0, 440, 324,
+	417, 425, 313, 304, 196, 423, 311, 303, 288, 248,
+	269, 357, 298, 358, 270, 320, 319, 321, 0, 191,
+	0, 398, 434, 464, 209, 210, 211, 0, 247, 251,
+	257, 259, 265, 266, 273, 291, 335, 356, 354, 360,
+	0, 412, 429, 437, 444, 450, 451, 453, 454, 455,
+	456, 457, 458, 459, 323, 272, 394, 287, 296, 0,
+	0, 341, 373, 214, 432, 395, 581, 592, 587, 588,
+	585, 586, 580, 584, 583, 582, 595, 572, 573, 574,
+	575, 577, 0, 589, 590, 576, 184, 198, 292, 0,
+	361, 255, 461, 439, 435, 0, 599, 231, 598, 0,
+	0, 604, 0, 0, 0, 602, 605, 0, 603, 0,
+	606, 601, 0, 0, 600, 597, 0, 596, 0, 0,
+	186, 187, 199, 207, 217, 230, 245, 253, 263, 268,
+	271, 275, 276, 279, 284, 301, 306, 307, 308, 309,
+	325, 326, 327, 330, 333, 334, 337, 339, 340, 343,
+	349, 350, 351, 352, 353, 355, 362, 367, 375, 376,
+	377, 378, 379, 381, 382, 388, 389, 390, 391, 399,
+	403, 419, 420, 431, 443, 448, 223, 363, 384, 385,
+	264, 427, 449, 0, 300, 0, 0, 302, 249, 267,
+	277, 0, 438, 400, 203, 369, 256, 192, 220, 206,
+	228, 243, 246, 281, 310, 316, 345, 348, 261, 240,
+	218, 366, 215, 386, 406, 407, 408, 410, 314, 235,
+	411, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 332, 0, 0, 0, 0, 535, 0, 0,
+	0, 239, 534, 0, 0, 0, 290, 236, 0, 0,
+	346, 0, 190, 0, 387, 224, 299, 297, 416, 250,
+	242, 238, 222, 274, 305, 344, 405, 338, 579, 294,
+	0, 0, 396, 317, 0, 0, 0, 0, 0, 570,
+	571, 0, 0, 0, 0, 0, 0, 1607, 0, 280,
+	221, 189, 329, 397, 254, 0, 84, 0, 0, 181,
+	182, 183, 557, 556, 559, 560, 561, 562, 0, 0,
+	212, 558, 219, 563, 564, 565, 1608, 234, 278, 241,
+	233, 413, 0, 0, 0, 0, 205, 0, 0, 0,
+	0, 0, 532, 549, 0, 578, 0, 0, 0, 244,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 546, 547, 0, 0, 0,
+	0, 594, 0, 548, 0, 0, 541, 542, 544, 543,
+	545, 550, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 318, 0, 0, 593, 0, 0, 445,
+	0, 0, 591, 0, 0, 0, 0, 289, 0, 286,
+	185, 201, 0, 0, 328, 368, 374, 0, 0, 0,
+	225, 0, 372, 342, 430, 208, 252, 365, 347, 370,
+	0, 0, 371, 295, 418, 359, 428, 446, 447, 232,
+	322, 436, 409, 442, 460, 202, 229, 336, 402, 433,
+	393, 315, 414, 415, 285, 392, 260, 188, 293, 452,
+	200, 380, 216, 193, 404, 426, 213, 383, 0, 0,
+	462, 195, 424, 401, 312, 282, 283, 194, 0, 364,
+	237, 258, 227, 331, 421, 422, 226, 463, 204, 441,
+	197, 0, 440, 324, 417, 425, 313, 304, 196, 423,
+	311, 303, 288, 248, 269, 357, 298, 358, 270, 320,
+	319, 321, 0, 191, 0, 398, 434, 464, 209, 210,
+	211, 0, 247, 251, 257, 259, 265, 266, 273, 291,
+	335, 356, 354, 360, 0, 412, 429, 437, 444, 450,
+	451, 453, 454, 455, 456, 457, 458, 459, 323, 272,
+	394, 287, 296, 0, 0, 341, 373, 214, 432, 395,
+	581, 592, 587, 588, 585, 586, 580, 584, 583, 582,
+	595, 572, 573, 574, 575, 577, 0, 589, 590, 576,
+	184, 198, 292, 0, 361, 255, 461, 439, 435, 0,
+	599, 231, 598, 0, 0, 604, 0, 0, 0, 602,
+	605, 0, 603, 0, 606, 601, 0, 0, 600, 597,
+	0, 596, 0, 0, 186, 187, 199, 207, 217, 230,
+	245, 253, 263, 268, 271, 275, 276, 279, 284, 301,
+	306, 307, 308, 309, 325, 326, 327, 330, 333, 334,
+	337, 339, 340, 343, 349, 350, 351, 352, 353, 355,
+	362, 367, 375, 376, 377, 378, 379, 381, 382, 388,
+	389, 390, 391, 399, 403, 419, 420, 431, 443, 448,
+	223, 363, 384, 385, 264, 427, 449, 0, 300, 0,
+	0, 302, 249, 267, 277, 0, 438, 400, 203, 369,
+	256, 192, 220, 206, 228, 243, 246, 281, 310, 316,
+	345, 348, 261, 240, 218, 366, 215, 386, 406, 407,
+	408, 410, 314, 235, 75, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 332, 0, 0,
+	0, 0, 535, 0, 0, 0, 239, 534, 0, 0,
+	0, 290, 236, 0, 0, 346, 0, 190, 0, 387,
+	224, 299, 297, 416, 250, 242, 238, 222, 274, 305,
+	344, 405, 338, 579, 294, 0, 0, 396, 317, 0,
+	0, 0, 0, 0, 570, 571, 0, 0, 0, 0,
+	0, 0, 0, 0, 280, 221, 189, 329, 397, 254,
+	0, 84, 0, 0, 181, 182, 183, 557, 556, 559,
+	560, 561, 562, 0, 0, 212, 558, 219, 563, 564,
+	565, 0, 234, 278, 241, 233, 413, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 532, 549, 0,
+	578, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	546, 547, 0, 0, 0, 0, 594, 0, 548, 0,
+	0, 541, 542, 544, 543, 545, 550, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 318, 0,
+	0, 593, 0, 0, 445, 0, 0, 591, 0, 0,
+	0, 0, 289, 0, 286, 185, 201, 0, 0, 328,
+	368, 374, 0, 0, 0, 225, 0, 372, 342, 430,
+	208, 252, 365, 347, 370, 0, 0, 371, 295, 418,
+	359, 428, 446, 447, 232, 322, 436, 409, 442, 460,
+	202, 229, 336, 402, 433, 393, 315, 414, 415, 285,
+	392, 260, 188, 293, 452, 200, 380, 216, 193, 404,
+	426, 213, 383, 0, 0, 462, 195, 424, 401, 312,
+	282, 283, 194, 0, 364, 237, 258, 227, 331, 421,
+	422, 226, 463, 204, 441, 197, 0, 440, 324, 417,
+	425, 313, 304, 196, 423, 311, 303, 288, 248, 269,
+	357, 298, 358, 270, 320, 319, 321, 0, 191, 0,
+	398, 434, 464, 209, 210, 211, 0, 247, 251, 257,
+	259, 265, 266, 273, 291, 335, 356, 354, 360, 0,
+	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
+	457, 458, 459, 323, 272, 394, 287, 296, 0, 0,
+	341, 373, 214, 432, 395, 581, 592, 587, 588, 585,
+	586, 580, 584, 583, 582, 595, 572, 573, 574, 575,
+	577, 0, 589, 590, 576, 184, 198, 292, 83, 361,
+	255, 461, 439, 435, 0, 599, 231, 598, 0, 0,
+	604, 0, 0, 0, 602, 605, 0, 603, 0, 606,
+	601, 0, 0, 600, 597, 0, 596, 0, 0, 186,
+	187, 199, 207, 217, 230, 245, 253, 263, 268, 271,
+	275, 276, 279, 284, 301, 306, 307, 308, 309, 325,
+	326, 327, 330, 333, 334, 337, 339, 340, 343, 349,
+	350, 351, 352, 353, 355, 362, 367, 375, 376, 377,
+	378, 379, 381, 382, 388, 389, 390, 391, 399, 403,
+	419, 420, 431, 443, 448, 223, 363, 384, 385, 264,
+	427, 449, 0, 300, 0, 0, 302, 249, 267, 277,
+	0, 438, 400, 203, 369, 256, 192, 220, 206, 228,
+	243, 246, 281, 310, 316, 345, 348, 261, 240, 218,
+	366, 215, 386, 406, 407, 408, 410, 314, 235, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 332, 0, 0, 0, 0, 535, 0, 0, 0,
+	239, 534, 0, 0, 0, 290, 236, 0, 0, 346,
+	0, 190, 0, 387, 224, 299, 297, 416, 250, 242,
+	238, 222, 274, 305, 344, 405, 338, 579, 294, 0,
+	0, 396, 317, 0, 0, 0, 0, 0, 570, 571,
+	0, 0, 0, 0, 0, 0, 0, 0, 280, 221,
+	189, 329, 397, 254, 0, 84, 0, 1139, 181, 182,
+	183, 557, 556, 559, 560, 561, 562, 0, 0, 212,
+	558, 219, 563, 564, 565, 0, 234, 278, 241, 233,
+	413, 0, 0, 0, 0, 205, 0, 0, 0, 0,
+	0, 532, 549, 0, 578, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 546, 547, 0, 0, 0, 0,
+	594, 0, 548, 0, 0, 541, 542, 544, 543, 545,
+	550, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 318, 0, 0, 593, 0, 0, 445, 0,
+	0, 591, 0, 0, 0, 0, 289, 0, 286, 185,
+	201, 0, 0, 328, 368, 374, 0, 0, 0, 225,
+	0, 372, 342, 430, 208, 252, 365, 347, 370, 0,
+	0, 371, 295, 418, 359, 428, 446, 447, 232, 322,
+	436, 409, 442, 460, 202, 229, 336, 402, 433, 393,
+	315, 414, 415, 285, 392, 260, 188, 293, 452, 200,
+	380, 216, 193, 404, 426, 213, 383, 0, 0, 462,
+	195, 424, 401, 312, 282, 283, 194, 0, 364, 237,
+	258, 227, 331, 421, 422, 226, 463, 204, 441, 197,
+	0, 440, 324, 417, 425, 313, 304, 196, 423, 311,
+	303, 288, 248, 269, 357, 298, 358, 270, 320, 319,
+	321, 0, 191, 0, 398, 434, 464, 209, 210, 211,
+	0, 247, 251, 257, 259, 265, 266, 273, 291, 335,
+	356, 354, 360, 0, 412, 429, 437, 444, 450, 451,
+	453, 454, 455, 456, 457, 458, 459, 323, 272, 394,
+	287, 296, 0, 0, 341, 373, 214, 432, 395, 581,
+	592, 587, 588, 585, 586, 580, 584, 583, 582, 595,
+	572, 573, 574, 575, 577, 0, 589, 590, 576, 184,
+	198, 292, 0, 361, 255, 461, 439, 435, 0, 599,
+	231, 598, 0, 0, 604, 0, 0, 0, 602, 605,
+	0, 603, 0, 606, 601, 0, 0, 600, 597, 0,
+	596, 0, 0, 186, 187, 199, 207, 217, 230, 245,
+	253, 263, 268, 271, 275, 276, 279, 284, 301, 306,
+	307, 308, 309, 325, 326, 327, 330, 333, 334, 337,
+	339, 340, 343, 349, 350, 351, 352, 353, 355, 362,
+	367, 375, 376, 377, 378, 379, 381, 382, 388, 389,
+	390, 391, 399, 403, 419, 420, 431, 443, 448, 223,
+	363, 384, 385, 264, 427, 449, 0, 300, 0, 0,
+	302, 249, 267, 277, 0, 438, 400, 203, 369, 256,
+	192, 220, 206, 228, 243, 246, 281, 310, 316, 345,
+	348, 261, 240, 218, 366, 215, 386, 406, 407, 408,
+	410, 314, 235, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 332, 0, 0, 0, 0,
+	535, 0, 0, 0, 239, 534, 0, 0, 0, 290,
+	236, 0, 0, 346, 0, 190, 0, 387, 224, 299,
+	297, 416, 250, 242, 238, 222, 274, 305, 344, 405,
+	338, 579, 294, 0, 0, 396, 317, 0, 0, 0,
+	0, 0, 570, 571, 0, 0, 0, 0, 0, 0,
+	0, 0, 280, 221, 189, 329, 397, 254, 0, 84,
+	0, 0, 181, 182, 183, 557, 556, 559, 560, 561,
+	562, 0, 0, 212, 558, 219, 563, 564, 565, 0,
+	234, 278, 241, 233, 413, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 532, 549, 0, 578, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 546, 547,
+	648, 0, 0, 0, 594, 0, 548, 0, 0, 541,
+	542, 544, 543, 545, 550, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 318, 0, 0, 593,
+	0, 0, 445, 0, 0, 591, 0, 0, 0, 0,
+	289, 0, 286, 185, 201, 0, 0, 328, 368, 374,
+	0, 0, 0, 225, 0, 372, 342, 430, 208, 252,
+	365, 347, 370, 0, 0, 371, 295, 418, 359, 428,
+	446, 447, 232, 322, 436, 409, 442, 460, 202, 229,
+	336, 402, 433, 393, 315, 414, 415, 285, 392, 260,
+	188, 293, 452, 200, 380, 216, 193, 404, 426, 213,
+	383, 0, 0, 462, 195, 424, 401, 312, 282, 283,
+	194, 0, 364, 237, 258, 227, 331, 421, 422, 226,
+	463, 204, 441, 197, 0, 440, 324, 417, 425, 313,
+	304, 196, 423, 311, 303, 288, 248, 269, 357, 298,
+	358, 270, 320, 319, 321, 0, 191, 0, 398, 434,
+	464, 209, 210, 211, 0, 247, 251, 257, 259, 265,
+	266, 273, 291, 335, 356, 354, 360, 0, 412, 429,
+	437, 444, 450, 451, 453, 454, 455, 456, 457, 458,
+	459, 323, 272, 394, 287, 296, 0, 0, 341, 373,
+	214, 432, 395, 581, 592, 587, 588, 585, 586, 580,
+	584, 583, 582, 595, 572, 573, 574, 575, 577, 0,
+	589, 590, 576, 184, 198, 292, 0, 361, 255, 461,
+	439, 435, 0, 599, 231, 598, 0, 0, 604, 0,
+	0, 0, 602, 605, 0, 603, 0, 606, 601, 0,
+	0, 600, 597, 0, 596, 0, 0, 186, 187, 199,
+	207, 217, 230, 245, 253, 263, 268, 271, 275, 276,
+	279, 284, 301, 306, 307, 308, 309, 325, 326, 327,
+	330, 333, 334, 337, 339, 340, 343, 349, 350, 351,
+	352, 353, 355, 362, 367, 375, 376, 377, 378, 379,
+	381, 382, 388, 389, 390, 391, 399, 403, 419, 420,
+	431, 443, 448, 223, 363, 384, 385, 264, 427, 449,
+	0, 300, 0, 0, 302, 249, 267, 277, 0, 438,
+	400, 203, 369, 256, 192, 220, 206, 228, 243, 246,
+	281, 310, 316, 345, 348, 261, 240, 218, 366, 215,
+	386, 406, 407, 408, 410, 314, 235, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 332,
+	0, 0, 0, 0, 535, 0, 0, 0, 239, 534,
+	0, 0, 0, 290, 236, 0, 0, 346, 0, 190,
+	0, 387, 224, 299, 297, 416, 250, 242, 238, 222,
+	274, 305, 344, 405, 338, 579, 294, 0, 0, 396,
+	317, 0, 0, 0, 0, 0, 570, 571, 0, 0,
+	0, 0, 0, 0, 0, 0, 280, 221, 189, 329,
+	397, 254, 0, 84, 0, 0, 181, 182, 183, 557,
+	1499, 559, 560, 561, 562, 0, 0, 212, 558, 219,
+	563, 564, 565, 0, 234, 278, 241, 233, 413, 0,
+	0, 0, 0, 205, 0, 0, 0, 0, 0, 532,
+	549, 0, 578, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 546, 547, 648, 0, 0, 0, 594, 0,
+	548, 0, 0, 541, 542, 544, 543, 545, 550, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	318, 0, 0, 593, 0, 0, 445, 0, 0, 591,
+	0, 0, 0, 0, 289, 0, 286, 185, 201, 0,
+	0, 328, 368, 374, 0, 0, 0, 225, 0, 372,
+	342, 430, 208, 252, 365, 347, 370, 0, 0, 371,
+	295, 418, 359, 428, 446, 447, 232, 322, 436, 409,
+	442, 460, 202, 229, 336, 402, 433, 393, 315, 414,
+	415, 285, 392, 260, 188, 293, 452, 200, 380, 216,
+	193, 404, 426, 213, 383, 0, 0, 462, 195, 424,
+	401, 312, 282, 283, 194, 0, 364, 237, 258, 227,
+	331, 421, 422, 226, 463, 204, 441, 197, 0, 440,
+	324, 417, 425, 313, 304, 196, 423, 311, 303, 288,
+	248, 269, 357, 298, 358, 270, 320, 319, 321, 0,
+	191, 0, 398, 434, 464, 209, 210, 211, 0, 247,
+	251, 257, 259, 265, 266, 273, 291, 335, 356, 354,
+	360, 0, 412, 429, 437, 444, 450, 451, 453, 454,
+	455, 456, 457, 458, 459, 323, 272, 394, 287, 296,
+	0, 0, 341, 373, 214, 432, 395, 581, 592, 587,
+	588, 585, 586, 580, 584, 583, 582, 595, 572, 573,
+	574, 575, 577, 0, 589, 590, 576, 184, 198, 292,
+	0, 361, 255, 461, 439, 435, 0, 599, 231, 598,
+	0, 0, 604, 0, 0, 0, 602, 605, 0, 603,
+	0, 606, 601, 0, 0, 600, 597, 0, 596, 0,
+	0, 186, 187, 199, 207, 217, 230, 245, 253, 263,
+	268, 271, 275, 276, 279, 284, 301, 306, 307, 308,
+	309, 325, 326, 327, 330, 333, 334, 337, 339, 340,
+	343, 349, 350, 351, 352, 353, 355, 362, 367, 375,
+	376, 377, 378, 379, 381, 382, 388, 389, 390, 391,
+	399, 403, 419, 420, 431, 443, 448, 223, 363, 384,
+	385, 264, 427, 449, 0, 300, 0, 0, 302, 249,
+	267, 277, 0, 438, 400, 203, 369, 256, 192, 220,
+	206, 228, 243, 246, 281, 310, 316, 345, 348, 261,
+	240, 218, 366, 215, 386, 406, 407, 408, 410, 314,
+	235, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 332, 0, 0, 0, 0, 535, 0,
+	0, 0, 239, 534, 0, 0, 0, 290, 236, 0,
+	0, 346, 0, 190, 0, 387, 224, 299, 297, 416,
+	250, 242, 238, 222, 274, 305, 344, 405, 338, 579,
+	294, 0, 0, 396, 317, 0, 0, 0, 0, 0,
+	570, 571, 0, 0, 0, 0, 0, 0, 0, 0,
+	280, 221, 189, 329, 397, 254, 0, 84, 0, 0,
+	181, 182, 183, 557, 1496, 559, 560, 561, 562, 0,
+	0, 212, 558, 219, 563, 564, 565, 0, 234, 278,
+	241, 233, 413, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 532, 549, 0, 578, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 546, 547, 648, 0,
+	0, 0, 594, 0, 548, 0, 0, 541, 542, 544,
+	543, 545, 550, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 0, 318, 0, 0, 593, 0, 0,
+	445, 0, 0, 591, 0, 0, 0, 0, 289, 0,
+	286, 185, 201, 0, 0, 328, 368, 374, 0, 0,
+	0, 225, 0, 372, 342, 430, 208, 252, 365, 347,
+	370, 0, 0, 371, 295, 418, 359, 428, 446, 447,
+	232, 322, 436, 409, 442, 460, 202, 229, 336, 402,
+	433, 393, 315, 414, 415, 285, 392, 260, 188, 293,
+	452, 200, 380, 216, 193, 404, 426, 213, 383, 0,
+	0, 462, 195, 424, 401, 312, 282, 283, 194, 0,
+	364, 237, 258, 227, 331, 421, 422, 226, 463, 204,
+	441, 197, 0, 440, 324, 417, 425, 313, 304, 196,
+	423, 311, 303, 288, 248, 269, 357, 298, 358, 270,
+	320, 319, 321, 0, 191, 0, 398, 434, 464, 209,
+	210, 211, 0, 247, 251, 257, 259, 265, 266, 273,
+	291, 335, 356, 354, 360, 0, 412, 429, 437, 444,
+	450, 451, 453, 454, 455, 456, 457, 458, 459, 323,
+	272, 394, 287, 296, 0, 0, 341, 373, 214, 432,
+	395, 581, 592, 587, 588, 585, 586, 580, 584, 583,
+	582, 595, 572, 573, 574, 575, 577, 0, 589, 590,
+	576, 184, 198, 292, 0, 361, 255, 461, 439, 435,
+	0, 599, 231, 598, 0, 0, 604, 0, 0, 0,
+	602, 605, 0, 603, 0, 606, 601, 0, 0, 600,
+	597, 0, 596, 0, 0, 186, 187, 199, 207, 217,
+	230, 245, 253, 263, 268, 271, 275, 276, 279, 284,
+	301, 306, 307, 308, 309, 325, 326, 327, 330, 333,
+	334, 337, 339, 340, 343, 349, 350, 351, 352, 353,
+	355, 362, 367, 375, 376, 377, 378, 379, 381, 382,
+	388, 389, 390, 391, 399, 403, 419, 420, 431, 443,
+	448, 223, 363, 384, 385, 264, 427, 449, 0, 300,
+	0, 0, 302, 249, 267, 277, 0, 438, 400, 203,
+	369, 256, 192, 220, 206, 228, 243, 246, 281, 310,
+	316, 345, 348, 261, 240, 218, 366, 215, 386, 406,
+	407, 408, 410, 314, 235, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 332, 0, 0,
+	0, 0, 535, 0, 0, 0, 239, 534, 0, 0,
+	0, 290, 236, 0, 0, 346, 0, 190, 0, 387,
+	224, 299, 297, 416, 250, 242, 238, 222, 274, 305,
+	344, 405, 338, 579, 294, 0, 0, 396, 317, 0,
+	0, 0, 0, 0, 570, 571, 0, 0, 0, 0,
+	0, 0, 0, 0, 280, 221, 189, 329, 397, 254,
+	0, 84, 0, 0, 181, 182, 183, 557, 556, 559,
+	560, 561, 562, 0, 0, 212, 558, 219, 563, 564,
+	565, 0, 234, 278, 241, 233, 413, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 532, 549, 0,
+	578, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	546, 547, 0, 0, 0, 0, 594, 0, 548, 0,
+	0, 541, 542, 544, 543, 545, 550, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 318, 0,
+	0, 593, 0, 0, 445, 0, 0, 591, 0, 0,
+	0, 0, 289, 0, 286, 185, 201, 0, 0, 328,
+	368, 374, 0, 0, 0, 225, 0, 372, 342, 430,
+	208, 252, 365, 347, 370, 0, 0, 371, 295, 418,
+	359, 428, 446, 447, 232, 322, 436, 409, 442, 460,
+	202, 229, 336, 402, 433, 393, 315, 414, 415, 285,
+	392, 260, 188, 293, 452, 200, 380, 216, 193, 404,
+	426, 213, 383, 0, 0, 462, 195, 424, 401, 312,
+	282, 283, 194, 0, 364, 237, 258, 227, 331, 421,
+	422, 226, 463, 204, 441, 197, 0, 440, 324, 417,
+	425, 313, 304, 196, 423, 311, 303, 288, 248, 269,
+	357, 298, 358, 270, 320, 319, 321, 0, 191, 0,
+	398, 434, 464, 209, 210, 211, 0, 247, 251, 257,
+	259, 265, 266, 273, 291, 335, 356, 354, 360, 0,
+	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
+	457, 458, 459, 323, 272, 394, 287, 296, 0, 0,
+	341, 373, 214, 432, 395, 581, 592, 587, 588, 585,
+	586, 580, 584, 583, 582, 595, 572, 573, 574, 575,
+	577, 0, 589, 590, 576, 184, 198, 292, 0, 361,
+	255, 461, 439, 435, 0, 599, 231, 598, 0, 0,
+	604, 0, 0, 0, 602, 605, 0, 603, 0, 606,
+	601, 0, 0, 600, 597, 0, 596, 0, 0, 186,
+	187, 199, 207, 217, 230, 245, 253, 263, 268, 271,
+	275, 276, 279, 284, 301, 306, 307, 308, 309, 325,
+	326, 327, 330, 333, 334, 337, 339, 340, 343, 349,
+	350, 351, 352, 353, 355, 362, 367, 375, 376, 377,
+	378, 379, 381, 382, 388, 389, 390, 391, 399, 403,
+	419, 420, 431, 443, 448, 223, 363, 384, 385, 264,
+	427, 449, 0, 300, 0, 0, 302, 249, 267, 277,
+	0, 438, 400, 203, 369, 256, 192, 220, 206, 228,
+	243, 246, 281, 310, 316, 345, 348, 261, 240, 218,
+	366, 215, 386, 406, 407, 408, 410, 314, 235, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 290, 236, 0, 0, 346,
+	0, 190, 0, 387, 224, 299, 297, 416, 250, 242,
+	238, 222, 274, 305, 344, 405, 338, 579, 294, 0,
+	0, 396, 317, 0, 0, 0, 0, 0, 570, 571,
+	0, 0, 0, 0, 0, 0, 0, 0, 280, 221,
+	189, 329, 397, 254, 0, 84, 0, 0, 181, 182,
+	183, 557, 556, 559, 560, 561, 562, 0, 0, 212,
+	558, 219, 563, 564, 565, 0, 234, 278, 241, 233,
+	413, 0, 0, 0, 0, 205, 0, 0, 0, 0,
+	0, 0, 549, 0, 578, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 546, 547, 0, 0, 0, 0,
+	594, 0, 548, 0, 0, 541, 542, 544, 543, 545,
+	550, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 318, 0, 0, 593, 0, 0, 445, 0,
+	0, 591, 0, 0, 0, 0, 289, 0, 286, 185,
+	201, 0, 0, 328, 368, 374, 0, 0, 0, 225,
+	0, 372, 342, 430, 208, 252, 365, 347, 370, 2399,
+	0, 371, 295, 418, 359, 428, 446, 447, 232, 322,
+	436, 409, 442, 460, 202, 229, 336, 402, 433, 393,
+	315, 414, 415, 285, 392, 260, 188, 293, 452, 200,
+	380, 216, 193, 404, 426, 213, 383, 0, 0, 462,
+	195, 424, 401, 312, 282, 283, 194, 0, 364, 237,
+	258, 227, 331, 421, 422, 226, 463, 204, 441, 197,
+	0, 440, 324, 417, 425, 313, 304, 196, 423, 311,
+	303, 288, 248, 269, 357, 298, 358, 270, 320, 319,
+	321, 0, 191, 0, 398, 434, 464, 209, 210, 211,
+	0, 247, 251, 257, 259, 265, 266, 273, 291, 335,
+	356, 354, 360, 0, 412, 429, 437, 444, 450, 451,
+	453, 454, 455, 456, 457, 458, 459, 323, 272, 394,
+	287, 296, 0, 0, 341, 373, 214, 432, 395, 581,
+	592, 587, 588, 585, 586, 580, 584, 583, 582, 595,
+	572, 573, 574, 575, 577, 0, 589, 590, 576, 184,
+	198, 292, 0, 361, 255, 461, 439, 435, 0, 599,
+	231, 598, 0, 0, 604, 0, 0, 0, 602, 605,
+	0, 603, 0, 606, 601, 0, 0, 600, 597, 0,
+	596, 0, 0, 186, 187, 199, 207, 217, 230, 245,
+	253, 263, 268, 271, 275, 276, 279, 284, 301, 306,
+	307, 308, 309, 325, 326, 327, 330, 333, 334, 337,
+	339, 340, 343, 349, 350, 351, 352, 353, 355, 362,
+	367, 375, 376, 377, 378, 379, 381, 382, 388, 389,
+	390, 391, 399, 403, 419, 420, 431, 443, 448, 223,
+	363, 384, 385, 264, 427, 449, 0, 300, 0, 0,
+	302, 249, 267, 277, 0, 438, 400, 203, 369, 256,
+	192, 220, 206, 228, 243, 246, 281, 310, 316, 345,
+	348, 261, 240, 218, 366, 215, 386, 406, 407, 408,
+	410, 314, 235, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 239, 0, 0, 0, 0, 290,
+	236, 0, 0, 346, 0, 190, 0, 387, 224, 299,
+	297, 416, 250, 242, 238, 222, 274, 305, 344, 405,
+	338, 579, 294, 0, 0, 396, 317, 0, 0, 0,
+	0, 0, 570, 571, 0, 0, 0, 0, 0, 0,
+	0, 0, 280, 221, 189, 329, 397, 254, 0, 84,
+	0, 1139, 181, 182, 183, 557, 556, 559, 560, 561,
+	562, 0, 0, 212, 558, 219, 563, 564, 565, 0,
+	234, 278, 241, 233, 413, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 0, 549, 0, 578, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 546, 547,
+	0, 0, 0, 0, 594, 0, 548, 0, 0, 541,
+	542, 544, 543, 545, 550, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 318, 0, 0, 593,
+	0, 0, 445, 0, 0, 591, 0, 0, 0, 0,
+	289, 0, 286, 185, 201, 0, 0, 328, 368, 374,
+	0, 0, 0, 225, 0, 372, 342, 430, 208, 252,
+	365, 347, 370, 0, 0, 371, 295, 418, 359, 428,
+	446, 447, 232, 322, 436, 409, 442, 460, 202, 229,
+	336, 402, 433, 393, 315, 414, 415, 285, 392, 260,
+	188, 293, 452, 200, 380, 216, 193, 404, 426, 213,
+	383, 0, 0, 462, 195, 424, 401, 312, 282, 283,
+	194, 0, 364, 237, 258, 227, 331, 421, 422, 226,
+	463, 204, 441, 197, 0, 440, 324, 417, 425, 313,
+	304, 196, 423, 311, 303, 288, 248, 269, 357, 298,
+	358, 270, 320, 319, 321, 0, 191, 0, 398, 434,
+	464, 209, 210, 211, 0, 247, 251, 257, 259, 265,
+	266, 273, 291, 335, 356, 354, 360, 0, 412, 429,
+	437, 444, 450, 451, 453, 454, 455, 456, 457, 458,
+	459, 323, 272, 394, 287, 296, 0, 0, 341, 373,
+	214, 432, 395, 581, 592, 587, 588, 585, 586, 580,
+	584, 583, 582, 595, 572, 573, 574, 575, 577, 0,
+	589, 590, 576, 184, 198, 292, 0, 361, 255, 461,
+	439, 435, 0, 599, 231, 598, 0, 0, 604, 0,
+	0, 0, 602, 605, 0, 603, 0, 606, 601, 0,
+	0, 600, 597, 0, 596, 0, 0, 186, 187, 199,
+	207, 217, 230, 245, 253, 263, 268, 271, 275, 276,
+	279, 284, 301, 306, 307, 308, 309, 325, 326, 327,
+	330, 333, 334, 337, 339, 340, 343, 349, 350, 351,
+	352, 353, 355, 362, 367, 375, 376, 377, 378, 379,
+	381, 382, 388, 389, 390, 391, 399, 403, 419, 420,
+	431, 443, 448, 223, 363, 384, 385, 264, 427, 449,
+	0, 300, 0, 0, 302, 249, 267, 277, 0, 438,
+	400, 203, 369, 256, 192, 220, 206, 228, 243, 246,
+	281, 310, 316, 345, 348, 261, 240, 218, 366, 215,
+	386, 406, 407, 408, 410, 314, 235, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 332,
 	0, 0, 0, 0, 0, 0, 0, 0, 239, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	257, 0, 313, 0, 0, 0, 0, 437, 0, 0,
-	0, 0, 0, 0, 0, 284, 0, 281, 181, 197,
-	0, 0, 323, 362, 368, 0, 0, 0, 220, 0,
-	366, 337, 422, 204, 247, 359, 342, 364, 0, 1474,
-	365, 290, 410, 354, 420, 438, 439, 227, 317, 428,
-	401, 434, 450, 198, 224, 331, 394, 425, 385, 310,
-	406, 407, 280, 384, 255, 184, 288, 444, 196, 374,
-	212, 189, 396, 418, 209, 377, 0, 0, 452, 191,
-	416, 393, 307, 277, 278, 190, 0, 358, 232, 253,
-	222, 326, 413, 414, 221, 453, 200, 433, 193, 0,
-	432, 319, 409, 417, 308, 299, 192, 415, 306, 298,
-	283, 243, 264, 352, 293, 353, 265, 315, 314, 316,
-	0, 187, 0, 390, 426, 454, 205, 206, 207, 0,
-	242, 246, 252, 254, 260, 261, 268, 286, 330, 351,
-	349, 355, 0, 404, 421, 429, 436, 442, 443, 445,
-	446, 447, 448, 449, 318, 267, 386, 282, 291, 0,
-	0, 336, 367, 210, 424, 387, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 194, 287, 0,
-	356, 250, 451, 431, 427, 0, 0, 226, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	182, 183, 195, 203, 213, 225, 240, 248, 258, 263,
-	266, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 411, 412, 423, 435, 440, 259, 419, 441, 0,
-	295, 0, 0, 297, 244, 262, 272, 0, 430, 392,
-	199, 363, 251, 188, 216, 202, 223, 238, 241, 276,
-	305, 311, 340, 343, 256, 235, 214, 360, 211, 378,
-	398, 399, 400, 402, 309, 230, 403, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
-	0, 0, 285, 231, 0, 0, 341, 0, 186, 0,
-	379, 219, 294, 292, 408, 245, 237, 233, 218, 269,
-	300, 339, 397, 333, 0, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 217, 185, 324, 389,
-	249, 0, 0, 0, 0, 177, 178, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 208, 0, 215, 0,
-	0, 0, 0, 229, 273, 236, 228, 405, 0, 0,
-	0, 0, 201, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 290, 236, 0, 0, 346, 0, 190,
+	0, 387, 224, 299, 297, 416, 250, 242, 238, 222,
+	274, 305, 344, 405, 338, 579, 294, 0, 0, 396,
+	317, 0, 0, 0, 0, 0, 570, 571, 0, 0,
+	0, 0, 0, 0, 0, 0, 280, 221, 189, 329,
+	397, 254, 0, 84, 0, 0, 181, 182, 183, 557,
+	556, 559, 560, 561, 562, 0, 0, 212, 558, 219,
+	563, 564, 565, 0, 234, 278, 241, 233, 413, 0,
+	0, 0, 0, 205, 0, 0, 0, 0, 0, 0,
+	549, 0, 578, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 546, 547, 0, 0, 0, 0, 594, 0,
+	548, 0, 0, 541, 542, 544, 543, 545, 550, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	318, 0, 0, 593, 0, 0, 445, 0, 0, 591,
+	0, 0, 0, 0, 289, 0, 286, 185, 201, 0,
+	0, 328, 368, 374, 0, 0, 0, 225, 0, 372,
+	342, 430, 208, 252, 365, 347, 370, 0, 0, 371,
+	295, 418, 359, 428, 446, 447, 232, 322, 436, 409,
+	442, 460, 202, 229, 336, 402, 433, 393, 315, 414,
+	415, 285, 392, 260, 188, 293, 452, 200, 380, 216,
+	193, 404, 426, 213, 383, 0, 0, 462, 195, 424,
+	401, 312, 282, 283, 194, 0, 364, 237, 258, 227,
+	331, 421, 422, 226, 463, 204, 441, 197, 0, 440,
+	324, 417, 425, 313, 304, 196, 423, 311, 303, 288,
+	248, 269, 357, 298, 358, 270, 320, 319, 321, 0,
+	191, 0, 398, 434, 464, 209, 210, 211, 0, 247,
+	251, 257, 259, 265, 266, 273, 291, 335, 356, 354,
+	360, 0, 412, 429, 437, 444, 450, 451, 453, 454,
+	455, 456, 457, 458, 459, 323, 272, 394, 287, 296,
+	0, 0, 341, 373, 214, 432, 395, 581, 592, 587,
+	588, 585, 586, 580, 584, 583, 582, 595, 572, 573,
+	574, 575, 577, 0, 589, 590, 576, 184, 198, 292,
+	0, 361, 255, 461, 439, 435, 0, 599, 231, 598,
+	0, 0, 604, 0, 0, 0, 602, 605, 0, 603,
+	0, 606, 601, 0, 0, 600, 597, 0, 596, 0,
+	0, 186, 187, 199, 207, 217, 230, 245, 253, 263,
+	268, 271, 275, 276, 279, 284, 301, 306, 307, 308,
+	309, 325, 326, 327, 330, 333, 334, 337, 339, 340,
+	343, 349, 350, 351, 352, 353, 355, 362, 367, 375,
+	376, 377, 378, 379, 381, 382, 388, 389, 390, 391,
+	399, 403, 419, 420, 431, 443, 448, 223, 363, 384,
+	385, 264, 427, 449, 0, 300, 0, 0, 302, 249,
+	267, 277, 0, 438, 400, 203, 369, 256, 192, 220,
+	206, 228, 243, 246, 281, 310, 316, 345, 348, 261,
+	240, 218, 366, 215, 386, 406, 407, 408, 410, 314,
+	235, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 239, 0, 0, 0, 0, 290, 236, 0,
+	0, 346, 0, 190, 0, 387, 224, 299, 297, 416,
+	250, 242, 238, 222, 274, 305, 344, 405, 338, 0,
+	294, 0, 0, 396, 317, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	280, 221, 189, 329, 397, 254, 0, 0, 0, 0,
+	181, 182, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 212, 0, 219, 0, 0, 0, 0, 234, 278,
+	241, 233, 413, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1029, 1028, 1038, 1039, 1031, 1032, 1033, 1034,
+	1035, 1036, 1037, 1030, 0, 0, 1040, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 0, 318, 0, 0, 0, 0, 0,
+	445, 0, 0, 0, 0, 0, 0, 0, 289, 0,
+	286, 185, 201, 0, 0, 328, 368, 374, 0, 0,
+	0, 225, 0, 372, 342, 430, 208, 252, 365, 347,
+	370, 0, 0, 371, 295, 418, 359, 428, 446, 447,
+	232, 322, 436, 409, 442, 460, 202, 229, 336, 402,
+	433, 393, 315, 414, 415, 285, 392, 260, 188, 293,
+	452, 200, 380, 216, 193, 404, 426, 213, 383, 0,
+	0, 462, 195, 424, 401, 312, 282, 283, 194, 0,
+	364, 237, 258, 227, 331, 421, 422, 226, 463, 204,
+	441, 197, 0, 440, 324, 417, 425, 313, 304, 196,
+	423, 311, 303, 288, 248, 269, 357, 298, 358, 270,
+	320, 319, 321, 0, 191, 0, 398, 434, 464, 209,
+	210, 211, 0, 247, 251, 257, 259, 265, 266, 273,
+	291, 335, 356, 354, 360, 0, 412, 429, 437, 444,
+	450, 451, 453, 454, 455, 456, 457, 458, 459, 323,
+	272, 394, 287, 296, 0, 0, 341, 373, 214, 432,
+	395, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 184, 198, 292, 0, 361, 255, 461, 439, 435,
+	0, 0, 231, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 186, 187, 199, 207, 217,
+	230, 245, 253, 263, 268, 271, 275, 276, 279, 284,
+	301, 306, 307, 308, 309, 325, 326, 327, 330, 333,
+	334, 337, 339, 340, 343, 349, 350, 351, 352, 353,
+	355, 362, 367, 375, 376, 377, 378, 379, 381, 382,
+	388, 389, 390, 391, 399, 403, 419, 420, 431, 443,
+	448, 223, 363, 384, 385, 264, 427, 449, 0, 300,
+	0, 0, 302, 249, 267, 277, 0, 438, 400, 203,
+	369, 256, 192, 220, 206, 228, 243, 246, 281, 310,
+	316, 345, 348, 261, 240, 218, 366, 215, 386, 406,
+	407, 408, 410, 314, 235, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 239, 0, 0, 0,
+	0, 290, 236, 0, 0, 346, 0, 190, 0, 387,
+	224, 299, 297, 416, 250, 242, 238, 222, 274, 305,
+	344, 405, 338, 0, 294, 0, 0, 396, 317, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 280, 221, 189, 329, 397, 254,
+	0, 0, 0, 0, 181, 182, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 219, 0, 0,
+	0, 0, 234, 278, 241, 233, 413, 0, 0, 0,
+	0, 205, 0, 854, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 318, 0,
+	0, 0, 0, 853, 445, 0, 0, 0, 0, 0,
+	850, 851, 289, 816, 286, 185, 201, 844, 848, 328,
+	368, 374, 0, 0, 0, 225, 0, 372, 342, 430,
+	208, 252, 365, 347, 370, 0, 0, 371, 295, 418,
+	359, 428, 446, 447, 232, 322, 436, 409, 442, 460,
+	202, 229, 336, 402, 433, 393, 315, 414, 415, 285,
+	392, 260, 188, 293, 452, 200, 380, 216, 193, 404,
+	426, 213, 383, 0, 0, 462, 195, 424, 401, 312,
+	282, 283, 194, 0, 364, 237, 258, 227, 331, 421,
+	422, 226, 463, 204, 441, 197, 0, 440, 324, 417,
+	425, 313, 304, 196, 423, 311, 303, 288, 248, 269,
+	357, 298, 358, 270, 320, 319, 321, 0, 191, 0,
+	398, 434, 464, 209, 210, 211, 0, 247, 251, 257,
+	259, 265, 266, 273, 291, 335, 356, 354, 360, 0,
+	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
+	457, 458, 459, 323, 272, 394, 287, 296, 0, 0,
+	341, 373, 214, 432, 395, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 184, 198, 292, 0, 361,
+	255, 461, 439, 435, 0, 0, 231, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
+	187, 199, 207, 217, 230, 245, 253, 263, 268, 271,
+	275, 276, 279, 284, 301, 306, 307, 308, 309, 325,
+	326, 327, 330, 333, 334, 337, 339, 340, 343, 349,
+	350, 351, 352, 353, 355, 362, 367, 375, 376, 377,
+	378, 379, 381, 382, 388, 389, 390, 391, 399, 403,
+	419, 420, 431, 443, 448, 223, 363, 384, 385, 264,
+	427, 449, 0, 300, 0, 0, 302, 249, 267, 277,
+	0, 438, 400, 203, 369, 256, 192, 220, 206, 228,
+	243, 246, 281, 310, 316, 345, 348, 261, 240, 218,
+	366, 215, 386, 406, 407, 408, 410, 314, 235, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 332, 0, 0, 0, 1159, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 290, 236, 0, 0, 346,
+	0, 190, 0, 387, 224, 299, 297, 416, 250, 242,
+	238, 222, 274, 305, 344, 405, 338, 0, 294, 0,
+	0, 396, 317, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 280, 221,
+	189, 329, 397, 254, 0, 0, 0, 0, 181, 182,
+	183, 0, 1161, 0, 0, 0, 0, 0, 0, 212,
+	0, 219, 0, 0, 0, 0, 234, 278, 241, 233,
+	413, 0, 0, 0, 0, 205, 0, 0, 1018, 1019,
+	1017, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 0, 0, 1020, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 318, 0, 0, 0, 0, 0, 445, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 286, 185,
+	201, 0, 0, 328, 368, 374, 0, 0, 0, 225,
+	0, 372, 342, 430, 208, 252, 365, 347, 370, 0,
+	0, 371, 295, 418, 359, 428, 446, 447, 232, 322,
+	436, 409, 442, 460, 202, 229, 336, 402, 433, 393,
+	315, 414, 415, 285, 392, 260, 188, 293, 452, 200,
+	380, 216, 193, 404, 426, 213, 383, 0, 0, 462,
+	195, 424, 401, 312, 282, 283, 194, 0, 364, 237,
+	258, 227, 331, 421, 422, 226, 463, 204, 441, 197,
+	0, 440, 324, 417, 425, 313, 304, 196, 423, 311,
+	303, 288, 248, 269, 357, 298, 358, 270, 320, 319,
+	321, 0, 191, 0, 398, 434, 464, 209, 210, 211,
+	0, 247, 251, 257, 259, 265, 266, 273, 291, 335,
+	356, 354, 360, 0, 412, 429, 437, 444, 450, 451,
+	453, 454, 455, 456, 457, 458, 459, 323, 272, 394,
+	287, 296, 0, 0, 341, 373, 214, 432, 395, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 184,
+	198, 292, 0, 361, 255, 461, 439, 435, 0, 0,
+	231, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 186, 187, 199, 207, 217, 230, 245,
+	253, 263, 268, 271, 275, 276, 279, 284, 301, 306,
+	307, 308, 309, 325, 326, 327, 330, 333, 334, 337,
+	339, 340, 343, 349, 350, 351, 352, 353, 355, 362,
+	367, 375, 376, 377, 378, 379, 381, 382, 388, 389,
+	390, 391, 399, 403, 419, 420, 431, 443, 448, 223,
+	363, 384, 385, 264, 427, 449, 0, 300, 0, 0,
+	302, 249, 267, 277, 0, 438, 400, 203, 369, 256,
+	192, 220, 206, 228, 243, 246, 281, 310, 316, 345,
+	348, 261, 240, 218, 366, 215, 386, 406, 407, 408,
+	410, 314, 235, 75, 411, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 332, 0, 0, 0,
 	0, 0, 0, 0, 0, 239, 0, 0, 0, 0,
-	0, 0, 0, 0, 776, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 257, 0, 313,
-	0, 0, 0, 0, 437, 0, 0, 0, 0, 0,
-	0, 0, 284, 782, 281, 181, 197, 780, 0, 323,
-	362, 368, 0, 0, 0, 220, 0, 366, 337, 422,
-	204, 247, 359, 342, 364, 0, 0, 365, 290, 410,
-	354, 420, 438, 439, 227, 317, 428, 401, 434, 450,
-	198, 224, 331, 394, 425, 385, 310, 406, 407, 280,
-	384, 255, 184, 288, 444, 196, 374, 212, 189, 396,
-	418, 209, 377, 0, 0, 452, 191, 416, 393, 307,
-	277, 278, 190, 0, 358, 232, 253, 222, 326, 413,
-	414, 221, 453, 200, 433, 193, 0, 432, 319, 409,
-	417, 308, 299, 192, 415, 306, 298, 283, 243, 264,
-	352, 293, 353, 265, 315, 314, 316, 0, 187, 0,
-	390, 426, 454, 205, 206, 207, 0, 242, 246, 252,
-	254, 260, 261, 268, 286, 330, 351, 349, 355, 0,
-	404, 421, 429, 436, 442, 443, 445, 446, 447, 448,
-	449, 318, 267, 386, 282, 291, 0, 0, 336, 367,
-	210, 424, 387, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 180, 194, 287, 0, 356, 250, 451,
-	431, 427, 0, 0, 226, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 182, 183, 195,
-	203, 213, 225, 240, 248, 258, 263, 266, 270, 271,
-	274, 279, 296, 301, 302, 303, 304, 320, 321, 322,
-	325, 328, 329, 332, 334, 335, 338, 344, 345, 346,
-	347, 348, 350, 357, 361, 369, 370, 371, 372, 373,
-	375, 376, 380, 381, 382, 383, 391, 395, 411, 412,
-	423, 435, 440, 259, 419, 441, 0, 295, 0, 0,
-	297, 244, 262, 272, 0, 430, 392, 199, 363, 251,
-	188, 216, 202, 223, 238, 241, 276, 305, 311, 340,
-	343, 256, 235, 214, 360, 211, 378, 398, 399, 400,
-	402, 309, 230, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 0, 0, 0, 1476,
-	0, 0, 0, 0, 234, 0, 0, 0, 0, 285,
-	231, 0, 0, 341, 0, 186, 0, 379, 219, 294,
-	292, 408, 245, 237, 233, 218, 269, 300, 339, 397,
-	333, 0, 289, 0, 0, 388, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 217, 185, 324, 389, 249, 0, 0,
-	0, 0, 177, 178, 179, 0, 1291, 0, 0, 0,
-	0, 0, 0, 208, 0, 215, 0, 0, 0, 0,
-	229, 273, 236, 228, 405, 0, 0, 0, 0, 201,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 239, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 257, 0, 313, 0, 0, 0,
-	0, 437, 0, 0, 0, 0, 0, 0, 0, 284,
-	0, 281, 181, 197, 0, 0, 323, 362, 368, 0,
-	0, 0, 220, 0, 366, 337, 422, 204, 247, 359,
-	342, 364, 0, 0, 365, 290, 410, 354, 420, 438,
-	439, 227, 317, 428, 401, 434, 450, 198, 224, 331,
-	394, 425, 385, 310, 406, 407, 280, 384, 255, 184,
-	288, 444, 196, 374, 212, 189, 396, 418, 209, 377,
-	0, 0, 452, 191, 416, 393, 307, 277, 278, 190,
-	0, 358, 232, 253, 222, 326, 413, 414, 221, 453,
-	200, 433, 193, 0, 432, 319, 409, 417, 308, 299,
-	192, 415, 306, 298, 283, 243, 264, 352, 293, 353,
-	265, 315, 314, 316, 0, 187, 0, 390, 426, 454,
-	205, 206, 207, 0, 242, 246, 252, 254, 260, 261,
-	268, 286, 330, 351, 349, 355, 0, 404, 421, 429,
-	436, 442, 443, 445, 446, 447, 448, 449, 318, 267,
-	386, 282, 291, 0, 0, 336, 367, 210, 424, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	180, 194, 287, 0, 356, 250, 451, 431, 427, 0,
-	0, 226, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 182, 183, 195, 203, 213, 225,
-	240, 248, 258, 263, 266, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 411, 412, 423, 435, 440,
-	259, 419, 441, 0, 295, 0, 0, 297, 244, 262,
-	272, 0, 430, 392, 199, 363, 251, 188, 216, 202,
-	223, 238, 241, 276, 305, 311, 340, 343, 256, 235,
-	214, 360, 211, 378, 398, 399, 400, 402, 309, 230,
-	403, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 234, 0, 0, 0, 0, 285, 231, 0, 0,
-	341, 0, 186, 0, 379, 219, 294, 292, 408, 245,
-	237, 233, 218, 269, 300, 339, 397, 333, 0, 289,
-	0, 0, 388, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 275,
-	217, 185, 324, 389, 249, 0, 0, 0, 1089, 177,
-	178, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	208, 0, 215, 0, 0, 0, 0, 229, 273, 236,
-	228, 405, 0, 0, 0, 0, 201, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 239,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 257, 0, 313, 0, 0, 0, 0, 437, 0,
-	0, 0, 2167, 0, 0, 0, 284, 0, 281, 181,
-	197, 0, 0, 323, 362, 368, 0, 0, 0, 220,
-	0, 366, 337, 422, 204, 247, 359, 342, 364, 0,
-	0, 365, 290, 410, 354, 420, 438, 439, 227, 317,
-	428, 401, 434, 450, 198, 224, 331, 394, 425, 385,
-	310, 406, 407, 280, 384, 255, 184, 288, 444, 196,
-	374, 212, 189, 396, 418, 209, 377, 0, 0, 452,
-	191, 416, 393, 307, 277, 278, 190, 0, 358, 232,
-	253, 222, 326, 413, 414, 221, 453, 200, 433, 193,
-	0, 432, 319, 409, 417, 308, 299, 192, 415, 306,
-	298, 283, 243, 264, 352, 293, 353, 265, 315, 314,
-	316, 0, 187, 0, 390, 426, 454, 205, 206, 207,
-	0, 242, 246, 252, 254, 260, 261, 268, 286, 330,
-	351, 349, 355, 0, 404, 421, 429, 436, 442, 443,
-	445, 446, 447, 448, 449, 318, 267, 386, 282, 291,
-	0, 0, 336, 367, 210, 424, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 180, 194, 287,
-	0, 356, 250, 451, 431, 427, 0, 0, 226, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 182, 183, 195, 203, 213, 225, 240, 248, 258,
-	263, 266, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 411, 412, 423, 435, 440, 259, 419, 441,
-	0, 295, 0, 0, 297, 244, 262, 272, 0, 430,
-	392, 199, 363, 251, 188, 216, 202, 223, 238, 241,
-	276, 305, 311, 340, 343, 256, 235, 214, 360, 211,
-	378, 398, 399, 400, 402, 309, 230, 72, 403, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 234,
-	0, 0, 0, 0, 285, 231, 0, 0, 341, 0,
-	186, 0, 379, 219, 294, 292, 408, 245, 237, 233,
-	218, 269, 300, 339, 397, 333, 0, 289, 0, 0,
-	388, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 275, 217, 185,
-	324, 389, 249, 0, 80, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 208, 0,
-	215, 0, 0, 0, 0, 229, 273, 236, 228, 405,
-	0, 0, 0, 0, 201, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 239, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 257,
-	0, 313, 0, 0, 0, 0, 437, 0, 0, 0,
-	0, 0, 0, 0, 284, 0, 281, 181, 197, 0,
-	0, 323, 362, 368, 0, 0, 0, 220, 0, 366,
-	337, 422, 204, 247, 359, 342, 364, 0, 0, 365,
-	290, 410, 354, 420, 438, 439, 227, 317, 428, 401,
-	434, 450, 198, 224, 331, 394, 425, 385, 310, 406,
-	407, 280, 384, 255, 184, 288, 444, 196, 374, 212,
-	189, 396, 418, 209, 377, 0, 0, 452, 191, 416,
-	393, 307, 277, 278, 190, 0, 358, 232, 253, 222,
-	326, 413, 414, 221, 453, 200, 433, 193, 0, 432,
-	319, 409, 417, 308, 299, 192, 415, 306, 298, 283,
-	243, 264, 352, 293, 353, 265, 315, 314, 316, 0,
-	187, 0, 390, 426, 454, 205, 206, 207, 0, 242,
-	246, 252, 254, 260, 261, 268, 286, 330, 351, 349,
-	355, 0, 404, 421, 429, 436, 442, 443, 445, 446,
-	447, 448, 449, 318, 267, 386, 282, 291, 0, 0,
-	336, 367, 210, 424, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 180, 194, 287, 0, 356,
-	250, 451, 431, 427, 0, 0, 226, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 182,
-	183, 195, 203, 213, 225, 240, 248, 258, 263, 266,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	411, 412, 423, 435, 440, 259, 419, 441, 0, 295,
-	0, 0, 297, 244, 262, 272, 0, 430, 392, 199,
-	363, 251, 188, 216, 202, 223, 238, 241, 276, 305,
-	311, 340, 343, 256, 235, 214, 360, 211, 378, 398,
-	399, 400, 402, 309, 230, 403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 0, 0, 0,
-	0, 285, 231, 0, 0, 341, 0, 186, 0, 379,
-	219, 294, 292, 408, 245, 237, 233, 218, 269, 300,
-	339, 397, 333, 0, 289, 0, 0, 388, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 217, 185, 324, 389, 249,
-	0, 0, 0, 0, 177, 178, 179, 0, 0, 1736,
-	0, 0, 1737, 0, 0, 208, 0, 215, 0, 0,
-	0, 0, 229, 273, 236, 228, 405, 0, 0, 0,
-	0, 201, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 239, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 257, 0, 313, 0,
-	0, 0, 0, 437, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 181, 197, 0, 0, 323, 362,
-	368, 0, 0, 0, 220, 0, 366, 337, 422, 204,
-	247, 359, 342, 364, 0, 0, 365, 290, 410, 354,
-	420, 438, 439, 227, 317, 428, 401, 434, 450, 198,
-	224, 331, 394, 425, 385, 310, 406, 407, 280, 384,
-	255, 184, 288, 444, 196, 374, 212, 189, 396, 418,
-	209, 377, 0, 0, 452, 191, 416, 393, 307, 277,
-	278, 190, 0, 358, 232, 253, 222, 326, 413, 414,
-	221, 453, 200, 433, 193, 0, 432, 319, 409, 417,
-	308, 299, 192, 415, 306, 298, 283, 243, 264, 352,
-	293, 353, 265, 315, 314, 316, 0, 187, 0, 390,
-	426, 454, 205, 206, 207, 0, 242, 246, 252, 254,
-	260, 261, 268, 286, 330, 351, 349, 355, 0, 404,
-	421, 429, 436, 442, 443, 445, 446, 447, 448, 449,
-	318, 267, 386, 282, 291, 0, 0, 336, 367, 210,
-	424, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 194, 287, 0, 356, 250, 451, 431,
-	427, 0, 0, 226, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 182, 183, 195, 203,
-	213, 225, 240, 248, 258, 263, 266, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 411, 412, 423,
-	435, 440, 259, 419, 441, 0, 295, 0, 0, 297,
-	244, 262, 272, 0, 430, 392, 199, 363, 251, 188,
-	216, 202, 223, 238, 241, 276, 305, 311, 340, 343,
-	256, 235, 214, 360, 211, 378, 398, 399, 400, 402,
-	309, 230, 403, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 234, 1141, 0, 0, 0, 285, 231,
-	0, 0, 341, 0, 186, 0, 379, 219, 294, 292,
-	408, 245, 237, 233, 218, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 217, 185, 324, 389, 249, 0, 0, 0,
-	0, 177, 178, 179, 0, 1140, 0, 0, 0, 0,
-	0, 0, 208, 0, 215, 0, 0, 0, 0, 229,
-	273, 236, 228, 405, 0, 0, 0, 0, 201, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 239, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 257, 0, 313, 0, 0, 0, 0,
-	437, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 181, 197, 0, 0, 323, 362, 368, 0, 0,
-	0, 220, 0, 366, 337, 422, 204, 247, 359, 342,
-	364, 0, 0, 365, 290, 410, 354, 420, 438, 439,
-	227, 317, 428, 401, 434, 450, 198, 224, 331, 394,
-	425, 385, 310, 406, 407, 280, 384, 255, 184, 288,
-	444, 196, 374, 212, 189, 396, 418, 209, 377, 0,
-	0, 452, 191, 416, 393, 307, 277, 278, 190, 0,
-	358, 232, 253, 222, 326, 413, 414, 221, 453, 200,
-	433, 193, 0, 432, 319, 409, 417, 308, 299, 192,
-	415, 306, 298, 283, 243, 264, 352, 293, 353, 265,
-	315, 314, 316, 0, 187, 0, 390, 426, 454, 205,
-	206, 207, 0, 242, 246, 252, 254, 260, 261, 268,
-	286, 330, 351, 349, 355, 0, 404, 421, 429, 436,
-	442, 443, 445, 446, 447, 448, 449, 318, 267, 386,
-	282, 291, 0, 0, 336, 367, 210, 424, 387, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
-	194, 287, 0, 356, 250, 451, 431, 427, 0, 0,
-	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 182, 183, 195, 203, 213, 225, 240,
-	248, 258, 263, 266, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 411, 412, 423, 435, 440, 259,
-	419, 441, 0, 295, 0, 0, 297, 244, 262, 272,
-	0, 430, 392, 199, 363, 251, 188, 216, 202, 223,
-	238, 241, 276, 305, 311, 340, 343, 256, 235, 214,
-	360, 211, 378, 398, 399, 400, 402, 309, 230, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 0, 0, 0, 0, 285, 231, 0, 0, 341,
-	0, 186, 0, 379, 219, 294, 292, 408, 245, 237,
-	233, 218, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 217,
-	185, 324, 389, 249, 0, 0, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 208,
-	0, 215, 0, 0, 0, 0, 229, 273, 236, 228,
-	405, 0, 0, 0, 0, 201, 0, 0, 0, 0,
+	290, 236, 0, 0, 346, 0, 190, 0, 387, 224,
+	299, 297, 416, 250, 242, 238, 222, 274, 305, 344,
+	405, 338, 0, 294, 0, 0, 396, 317, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 280, 221, 189, 329, 397, 254, 0,
+	84, 0, 1139, 181, 182, 183, 0, 0, 0, 0,
+	0, 0, 0, 0, 212, 0, 219, 0, 0, 0,
+	0, 234, 278, 241, 233, 413, 0, 0, 0, 0,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 262, 0, 318, 0, 0,
+	0, 0, 0, 445, 0, 0, 0, 0, 0, 0,
+	0, 289, 0, 286, 185, 201, 0, 0, 328, 368,
+	374, 0, 0, 0, 225, 0, 372, 342, 430, 208,
+	252, 365, 347, 370, 0, 0, 371, 295, 418, 359,
+	428, 446, 447, 232, 322, 436, 409, 442, 460, 202,
+	229, 336, 402, 433, 393, 315, 414, 415, 285, 392,
+	260, 188, 293, 452, 200, 380, 216, 193, 404, 426,
+	213, 383, 0, 0, 462, 195, 424, 401, 312, 282,
+	283, 194, 0, 364, 237, 258, 227, 331, 421, 422,
+	226, 463, 204, 441, 197, 0, 440, 324, 417, 425,
+	313, 304, 196, 423, 311, 303, 288, 248, 269, 357,
+	298, 358, 270, 320, 319, 321, 0, 191, 0, 398,
+	434, 464, 209, 210, 211, 0, 247, 251, 257, 259,
+	265, 266, 273, 291, 335, 356, 354, 360, 0, 412,
+	429, 437, 444, 450, 451, 453, 454, 455, 456, 457,
+	458, 459, 323, 272, 394, 287, 296, 0, 0, 341,
+	373, 214, 432, 395, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 184, 198, 292, 83, 361, 255,
+	461, 439, 435, 0, 0, 231, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 186, 187,
+	199, 207, 217, 230, 245, 253, 263, 268, 271, 275,
+	276, 279, 284, 301, 306, 307, 308, 309, 325, 326,
+	327, 330, 333, 334, 337, 339, 340, 343, 349, 350,
+	351, 352, 353, 355, 362, 367, 375, 376, 377, 378,
+	379, 381, 382, 388, 389, 390, 391, 399, 403, 419,
+	420, 431, 443, 448, 223, 363, 384, 385, 264, 427,
+	449, 0, 300, 0, 0, 302, 249, 267, 277, 0,
+	438, 400, 203, 369, 256, 192, 220, 206, 228, 243,
+	246, 281, 310, 316, 345, 348, 261, 240, 218, 366,
+	215, 386, 406, 407, 408, 410, 314, 235, 75, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 290, 236, 0, 0, 346,
+	0, 190, 0, 387, 224, 299, 297, 416, 250, 242,
+	238, 222, 274, 305, 344, 405, 338, 0, 294, 0,
+	0, 396, 317, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 280, 221,
+	189, 329, 397, 254, 0, 84, 0, 0, 181, 182,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 212,
+	0, 219, 0, 0, 0, 0, 234, 278, 241, 233,
+	413, 0, 0, 0, 0, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 318, 0, 0, 0, 0, 0, 445, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 286, 185,
+	201, 0, 0, 328, 368, 374, 0, 0, 0, 225,
+	0, 372, 342, 430, 208, 252, 365, 347, 370, 0,
+	0, 371, 295, 418, 359, 428, 446, 447, 232, 322,
+	436, 409, 442, 460, 202, 229, 336, 402, 433, 393,
+	315, 414, 415, 285, 392, 260, 188, 293, 452, 200,
+	380, 216, 193, 404, 426, 213, 383, 0, 0, 462,
+	195, 424, 401, 312, 282, 283, 194, 0, 364, 237,
+	258, 227, 331, 421, 422, 226, 463, 204, 441, 197,
+	0, 440, 324, 417, 425, 313, 304, 196, 423, 311,
+	303, 288, 248, 269, 357, 298, 358, 270, 320, 319,
+	321, 0, 191, 0, 398, 434, 464, 209, 210, 211,
+	0, 247, 251, 257, 259, 265, 266, 273, 291, 335,
+	356, 354, 360, 0, 412, 429, 437, 444, 450, 451,
+	453, 454, 455, 456, 457, 458, 459, 323, 272, 394,
+	287, 296, 0, 0, 341, 373, 214, 432, 395, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 184,
+	198, 292, 83, 361, 255, 461, 439, 435, 0, 0,
+	231, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 186, 187, 199, 207, 217, 230, 245,
+	253, 263, 268, 271, 275, 276, 279, 284, 301, 306,
+	307, 308, 309, 325, 326, 327, 330, 333, 334, 337,
+	339, 340, 343, 349, 350, 351, 352, 353, 355, 362,
+	367, 375, 376, 377, 378, 379, 381, 382, 388, 389,
+	390, 391, 399, 403, 419, 420, 431, 443, 448, 223,
+	363, 384, 385, 264, 427, 449, 0, 300, 0, 0,
+	302, 249, 267, 277, 0, 438, 400, 203, 369, 256,
+	192, 220, 206, 228, 243, 246, 281, 310, 316, 345,
+	348, 261, 240, 218, 366, 215, 386, 406, 407, 408,
+	410, 314, 235, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 332, 0, 0, 0, 1540,
+	0, 0, 0, 0, 239, 0, 0, 0, 0, 290,
+	236, 0, 0, 346, 0, 190, 0, 387, 224, 299,
+	297, 416, 250, 242, 238, 222, 274, 305, 344, 405,
+	338, 0, 294, 0, 0, 396, 317, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 280, 221, 189, 329, 397, 254, 0, 0,
+	0, 0, 181, 182, 183, 0, 1343, 0, 0, 0,
+	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
+	234, 278, 241, 233, 413, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 318, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 0, 0, 0,
+	289, 0, 286, 185, 201, 0, 0, 328, 368, 374,
+	0, 0, 0, 225, 0, 372, 342, 430, 208, 252,
+	365, 347, 370, 0, 1538, 371, 295, 418, 359, 428,
+	446, 447, 232, 322, 436, 409, 442, 460, 202, 229,
+	336, 402, 433, 393, 315, 414, 415, 285, 392, 260,
+	188, 293, 452, 200, 380, 216, 193, 404, 426, 213,
+	383, 0, 0, 462, 195, 424, 401, 312, 282, 283,
+	194, 0, 364, 237, 258, 227, 331, 421, 422, 226,
+	463, 204, 441, 197, 0, 440, 324, 417, 425, 313,
+	304, 196, 423, 311, 303, 288, 248, 269, 357, 298,
+	358, 270, 320, 319, 321, 0, 191, 0, 398, 434,
+	464, 209, 210, 211, 0, 247, 251, 257, 259, 265,
+	266, 273, 291, 335, 356, 354, 360, 0, 412, 429,
+	437, 444, 450, 451, 453, 454, 455, 456, 457, 458,
+	459, 323, 272, 394, 287, 296, 0, 0, 341, 373,
+	214, 432, 395, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 184, 198, 292, 0, 361, 255, 461,
+	439, 435, 0, 0, 231, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 187, 199,
+	207, 217, 230, 245, 253, 263, 268, 271, 275, 276,
+	279, 284, 301, 306, 307, 308, 309, 325, 326, 327,
+	330, 333, 334, 337, 339, 340, 343, 349, 350, 351,
+	352, 353, 355, 362, 367, 375, 376, 377, 378, 379,
+	381, 382, 388, 389, 390, 391, 399, 403, 419, 420,
+	431, 443, 448, 223, 363, 384, 385, 264, 427, 449,
+	0, 300, 0, 0, 302, 249, 267, 277, 0, 438,
+	400, 203, 369, 256, 192, 220, 206, 228, 243, 246,
+	281, 310, 316, 345, 348, 261, 240, 218, 366, 215,
+	386, 406, 407, 408, 410, 314, 235, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 332,
 	0, 0, 0, 0, 0, 0, 0, 0, 239, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	257, 0, 313, 0, 0, 0, 0, 437, 0, 0,
-	0, 2258, 0, 0, 0, 284, 0, 281, 181, 197,
-	0, 0, 323, 362, 368, 0, 0, 0, 220, 0,
-	366, 337, 422, 204, 247, 359, 342, 364, 0, 0,
-	365, 290, 410, 354, 420, 438, 439, 227, 317, 428,
-	401, 434, 450, 198, 224, 331, 394, 425, 385, 310,
-	406, 407, 280, 384, 255, 184, 288, 444, 196, 374,
-	212, 189, 396, 418, 209, 377, 0, 0, 452, 191,
-	416, 393, 307, 277, 278, 190, 0, 358, 232, 253,
-	222, 326, 413, 414, 221, 453, 200, 433, 193, 0,
-	432, 319, 409, 417, 308, 299, 192, 415, 306, 298,
-	283, 243, 264, 352, 293, 353, 265, 315, 314, 316,
-	0, 187, 0, 390, 426, 454, 205, 206, 207, 0,
-	242, 246, 252, 254, 260, 261, 268, 286, 330, 351,
-	349, 355, 0, 404, 421, 429, 436, 442, 443, 445,
-	446, 447, 448, 449, 318, 267, 386, 282, 291, 0,
-	0, 336, 367, 210, 424, 387, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 194, 287, 0,
-	356, 250, 451, 431, 427, 0, 0, 226, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	182, 183, 195, 203, 213, 225, 240, 248, 258, 263,
-	266, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 411, 412, 423, 435, 440, 259, 419, 441, 0,
-	295, 0, 0, 297, 244, 262, 272, 0, 430, 392,
-	199, 363, 251, 188, 216, 202, 223, 238, 241, 276,
-	305, 311, 340, 343, 256, 235, 214, 360, 211, 378,
-	398, 399, 400, 402, 309, 230, 403, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
-	0, 0, 285, 231, 0, 0, 341, 0, 186, 0,
-	379, 219, 294, 292, 408, 245, 237, 233, 218, 269,
-	300, 339, 397, 333, 0, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 217, 185, 324, 389,
-	249, 0, 0, 0, 0, 177, 178, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 208, 0, 215, 0,
-	0, 0, 0, 229, 273, 236, 228, 405, 0, 0,
-	0, 0, 201, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 239, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 257, 0, 313,
-	0, 0, 0, 0, 437, 0, 0, 0, 2167, 0,
-	0, 0, 284, 0, 281, 181, 197, 0, 0, 323,
-	362, 368, 0, 0, 0, 220, 0, 366, 337, 422,
-	204, 247, 359, 342, 364, 0, 0, 365, 290, 410,
-	354, 420, 438, 439, 227, 317, 428, 401, 434, 450,
-	198, 224, 331, 394, 425, 385, 310, 406, 407, 280,
-	384, 255, 184, 288, 444, 196, 374, 212, 189, 396,
-	418, 209, 377, 0, 0, 452, 191, 416, 393, 307,
-	277, 278, 190, 0, 358, 232, 253, 222, 326, 413,
-	414, 221, 453, 200, 433, 193, 0, 432, 319, 409,
-	417, 308, 299, 192, 415, 306, 298, 283, 243, 264,
-	352, 293, 353, 265, 315, 314, 316, 0, 187, 0,
-	390, 426, 454, 205, 206, 207, 0, 242, 246, 252,
-	254, 260, 261, 268, 286, 330, 351, 349, 355, 0,
-	404, 421, 429, 436, 442, 443, 445, 446, 447, 448,
-	449, 318, 267, 386, 282, 291, 0, 0, 336, 367,
-	210, 424, 387, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 180, 194, 287, 0, 356, 250, 451,
-	431, 427, 0, 0, 226, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 182, 183, 195,
-	203, 213, 225, 240, 248, 258, 263, 266, 270, 271,
-	274, 279, 296, 301, 302, 303, 304, 320, 321, 322,
-	325, 328, 329, 332, 334, 335, 338, 344, 345, 346,
-	347, 348, 350, 357, 361, 369, 370, 371, 372, 373,
-	375, 376, 380, 381, 382, 383, 391, 395, 411, 412,
-	423, 435, 440, 259, 419, 441, 0, 295, 0, 0,
-	297, 244, 262, 272, 0, 430, 392, 199, 363, 251,
-	188, 216, 202, 223, 238, 241, 276, 305, 311, 340,
-	343, 256, 235, 214, 360, 211, 378, 398, 399, 400,
-	402, 309, 230, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 0, 0, 0, 0, 285,
-	231, 0, 0, 341, 0, 186, 0, 379, 219, 294,
-	292, 408, 245, 237, 233, 218, 269, 300, 339, 397,
-	333, 0, 289, 0, 0, 388, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 217, 185, 324, 389, 249, 0, 80,
-	0, 0, 177, 178, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 208, 0, 215, 0, 0, 0, 0,
-	229, 273, 236, 228, 405, 0, 0, 0, 0, 201,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 239, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 257, 0, 313, 0, 0, 0,
-	0, 437, 0, 0, 0, 0, 0, 0, 0, 284,
-	0, 281, 181, 197, 0, 0, 323, 362, 368, 0,
-	0, 0, 220, 0, 366, 337, 422, 204, 247, 359,
-	342, 364, 0, 0, 365, 290, 410, 354, 420, 438,
-	439, 227, 317, 428, 401, 434, 450, 198, 224, 331,
-	394, 425, 385, 310, 406, 407, 280, 384, 255, 184,
-	288, 444, 196, 374, 212, 189, 396, 418, 209, 377,
-	0, 0, 452, 191, 416, 393, 307, 277, 278, 190,
-	0, 358, 232, 253, 222, 326, 413, 414, 221, 453,
-	200, 433, 193, 0, 432, 319, 409, 417, 308, 299,
-	192, 415, 306, 298, 283, 243, 264, 352, 293, 353,
-	265, 315, 314, 316, 0, 187, 0, 390, 426, 454,
-	205, 206, 207, 0, 242, 246, 252, 254, 260, 261,
-	268, 286, 330, 351, 349, 355, 0, 404, 421, 429,
-	436, 442, 443, 445, 446, 447, 448, 449, 318, 267,
-	386, 282, 291, 0, 0, 336, 367, 210, 424, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	180, 194, 287, 0, 356, 250, 451, 431, 427, 0,
-	0, 226, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 182, 183, 195, 203, 213, 225,
-	240, 248, 258, 263, 266, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 411, 412, 423, 435, 440,
-	259, 419, 441, 0, 295, 0, 0, 297, 244, 262,
-	272, 0, 430, 392, 199, 363, 251, 188, 216, 202,
-	223, 238, 241, 276, 305, 311, 340, 343, 256, 235,
-	214, 360, 211, 378, 398, 399, 400, 402, 309, 230,
-	403, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 234, 0, 0, 0, 0, 285, 231, 0, 0,
-	341, 0, 186, 0, 379, 219, 294, 292, 408, 245,
-	237, 233, 218, 269, 300, 339, 397, 333, 0, 289,
-	0, 0, 388, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 275,
-	217, 185, 324, 389, 249, 0, 0, 0, 0, 177,
-	178, 179, 0, 1291, 0, 0, 0, 0, 0, 0,
-	208, 0, 215, 0, 0, 0, 0, 229, 273, 236,
-	228, 405, 0, 0, 0, 0, 201, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 239,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 257, 0, 313, 0, 0, 0, 0, 437, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 181,
-	197, 0, 0, 323, 362, 368, 0, 0, 0, 220,
-	0, 366, 337, 422, 204, 247, 359, 342, 364, 0,
-	0, 365, 290, 410, 354, 420, 438, 439, 227, 317,
-	428, 401, 434, 450, 198, 224, 331, 394, 425, 385,
-	310, 406, 407, 280, 384, 255, 184, 288, 444, 196,
-	374, 212, 189, 396, 418, 209, 377, 0, 0, 452,
-	191, 416, 393, 307, 277, 278, 190, 0, 358, 232,
-	253, 222, 326, 413, 414, 221, 453, 200, 433, 193,
-	0, 432, 319, 409, 417, 308, 299, 192, 415, 306,
-	298, 283, 243, 264, 352, 293, 353, 265, 315, 314,
-	316, 0, 187, 0, 390, 426, 454, 205, 206, 207,
-	0, 242, 246, 252, 254, 260, 261, 268, 286, 330,
-	351, 349, 355, 0, 404, 421, 429, 436, 442, 443,
-	445, 446, 447, 448, 449, 318, 267, 386, 282, 291,
-	0, 0, 336, 367, 210, 424, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 180, 194, 287,
-	0, 356, 250, 451, 431, 427, 0, 0, 226, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 182, 183, 195, 203, 213, 225, 240, 248, 258,
-	263, 266, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 411, 412, 423, 435, 440, 259, 419, 441,
-	0, 295, 0, 0, 297, 244, 262, 272, 0, 430,
-	392, 199, 363, 251, 188, 216, 202, 223, 238, 241,
-	276, 305, 311, 340, 343, 256, 235, 214, 360, 211,
-	378, 398, 399, 400, 402, 309, 230, 403, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 234, 0,
-	0, 0, 0, 285, 231, 0, 0, 341, 0, 186,
-	0, 379, 219, 294, 292, 408, 245, 237, 233, 218,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 217, 185, 324,
-	389, 249, 0, 0, 0, 0, 177, 178, 179, 0,
-	1109, 0, 0, 0, 0, 0, 0, 208, 0, 215,
-	0, 0, 0, 0, 229, 273, 236, 228, 405, 0,
-	0, 0, 0, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 290, 236, 0, 0, 346, 0, 190,
+	0, 387, 224, 299, 297, 416, 250, 242, 238, 222,
+	274, 305, 344, 405, 338, 0, 294, 0, 0, 396,
+	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 280, 221, 189, 329,
+	397, 254, 0, 0, 0, 0, 181, 182, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 212, 0, 219,
+	0, 0, 0, 0, 234, 278, 241, 233, 413, 0,
+	0, 0, 0, 205, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 810, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	318, 0, 0, 0, 0, 0, 445, 0, 0, 0,
+	0, 0, 0, 0, 289, 816, 286, 185, 201, 814,
+	0, 328, 368, 374, 0, 0, 0, 225, 0, 372,
+	342, 430, 208, 252, 365, 347, 370, 0, 0, 371,
+	295, 418, 359, 428, 446, 447, 232, 322, 436, 409,
+	442, 460, 202, 229, 336, 402, 433, 393, 315, 414,
+	415, 285, 392, 260, 188, 293, 452, 200, 380, 216,
+	193, 404, 426, 213, 383, 0, 0, 462, 195, 424,
+	401, 312, 282, 283, 194, 0, 364, 237, 258, 227,
+	331, 421, 422, 226, 463, 204, 441, 197, 0, 440,
+	324, 417, 425, 313, 304, 196, 423, 311, 303, 288,
+	248, 269, 357, 298, 358, 270, 320, 319, 321, 0,
+	191, 0, 398, 434, 464, 209, 210, 211, 0, 247,
+	251, 257, 259, 265, 266, 273, 291, 335, 356, 354,
+	360, 0, 412, 429, 437, 444, 450, 451, 453, 454,
+	455, 456, 457, 458, 459, 323, 272, 394, 287, 296,
+	0, 0, 341, 373, 214, 432, 395, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 184, 198, 292,
+	0, 361, 255, 461, 439, 435, 0, 0, 231, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 187, 199, 207, 217, 230, 245, 253, 263,
+	268, 271, 275, 276, 279, 284, 301, 306, 307, 308,
+	309, 325, 326, 327, 330, 333, 334, 337, 339, 340,
+	343, 349, 350, 351, 352, 353, 355, 362, 367, 375,
+	376, 377, 378, 379, 381, 382, 388, 389, 390, 391,
+	399, 403, 419, 420, 431, 443, 448, 223, 363, 384,
+	385, 264, 427, 449, 0, 300, 0, 0, 302, 249,
+	267, 277, 0, 438, 400, 203, 369, 256, 192, 220,
+	206, 228, 243, 246, 281, 310, 316, 345, 348, 261,
+	240, 218, 366, 215, 386, 406, 407, 408, 410, 314,
+	235, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 332, 0, 0, 0, 1540, 0, 0,
+	0, 0, 239, 0, 0, 0, 0, 290, 236, 0,
+	0, 346, 0, 190, 0, 387, 224, 299, 297, 416,
+	250, 242, 238, 222, 274, 305, 344, 405, 338, 0,
+	294, 0, 0, 396, 317, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	280, 221, 189, 329, 397, 254, 0, 0, 0, 0,
+	181, 182, 183, 0, 1343, 0, 0, 0, 0, 0,
+	0, 212, 0, 219, 0, 0, 0, 0, 234, 278,
+	241, 233, 413, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 0, 318, 0, 0, 0, 0, 0,
+	445, 0, 0, 0, 0, 0, 0, 0, 289, 0,
+	286, 185, 201, 0, 0, 328, 368, 374, 0, 0,
+	0, 225, 0, 372, 342, 430, 208, 252, 365, 347,
+	370, 0, 0, 371, 295, 418, 359, 428, 446, 447,
+	232, 322, 436, 409, 442, 460, 202, 229, 336, 402,
+	433, 393, 315, 414, 415, 285, 392, 260, 188, 293,
+	452, 200, 380, 216, 193, 404, 426, 213, 383, 0,
+	0, 462, 195, 424, 401, 312, 282, 283, 194, 0,
+	364, 237, 258, 227, 331, 421, 422, 226, 463, 204,
+	441, 197, 0, 440, 324, 417, 425, 313, 304, 196,
+	423, 311, 303, 288, 248, 269, 357, 298, 358, 270,
+	320, 319, 321, 0, 191, 0, 398, 434, 464, 209,
+	210, 211, 0, 247, 251, 257, 259, 265, 266, 273,
+	291, 335, 356, 354, 360, 0, 412, 429, 437, 444,
+	450, 451, 453, 454, 455, 456, 457, 458, 459, 323,
+	272, 394, 287, 296, 0, 0, 341, 373, 214, 432,
+	395, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 184, 198, 292, 0, 361, 255, 461, 439, 435,
+	0, 0, 231, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 186, 187, 199, 207, 217,
+	230, 245, 253, 263, 268, 271, 275, 276, 279, 284,
+	301, 306, 307, 308, 309, 325, 326, 327, 330, 333,
+	334, 337, 339, 340, 343, 349, 350, 351, 352, 353,
+	355, 362, 367, 375, 376, 377, 378, 379, 381, 382,
+	388, 389, 390, 391, 399, 403, 419, 420, 431, 443,
+	448, 223, 363, 384, 385, 264, 427, 449, 0, 300,
+	0, 0, 302, 249, 267, 277, 0, 438, 400, 203,
+	369, 256, 192, 220, 206, 228, 243, 246, 281, 310,
+	316, 345, 348, 261, 240, 218, 366, 215, 386, 406,
+	407, 408, 410, 314, 235, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 332, 0, 0,
 	0, 0, 0, 0, 0, 0, 239, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 257, 0,
-	313, 0, 0, 0, 0, 437, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 181, 197, 0, 0,
-	323, 362, 368, 0, 0, 0, 220, 0, 366, 337,
-	422, 204, 247, 359, 342, 364, 0, 0, 365, 290,
-	410, 354, 420, 438, 439, 227, 317, 428, 401, 434,
-	450, 198, 224, 331, 394, 425, 385, 310, 406, 407,
-	280, 384, 255, 184, 288, 444, 196, 374, 212, 189,
-	396, 418, 209, 377, 0, 0, 452, 191, 416, 393,
-	307, 277, 278, 190, 0, 358, 232, 253, 222, 326,
-	413, 414, 221, 453, 200, 433, 193, 0, 432, 319,
-	409, 417, 308, 299, 192, 415, 306, 298, 283, 243,
-	264, 352, 293, 353, 265, 315, 314, 316, 0, 187,
-	0, 390, 426, 454, 205, 206, 207, 0, 242, 246,
-	252, 254, 260, 261, 268, 286, 330, 351, 349, 355,
-	0, 404, 421, 429, 436, 442, 443, 445, 446, 447,
-	448, 449, 318, 267, 386, 282, 291, 0, 0, 336,
-	367, 210, 424, 387, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 180, 194, 287, 0, 356, 250,
-	451, 431, 427, 0, 0, 226, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 182, 183,
-	195, 203, 213, 225, 240, 248, 258, 263, 266, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 411,
-	412, 423, 435, 440, 259, 419, 441, 0, 295, 0,
-	0, 297, 244, 262, 272, 0, 430, 392, 199, 363,
-	251, 188, 216, 202, 223, 238, 241, 276, 305, 311,
-	340, 343, 256, 235, 214, 360, 211, 378, 398, 399,
-	400, 402, 309, 230, 403, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
-	285, 231, 0, 0, 341, 0, 186, 0, 379, 219,
-	294, 292, 408, 245, 237, 233, 218, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 217, 185, 324, 389, 249, 0,
-	0, 0, 0, 177, 178, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 208, 0, 215, 0, 0, 0,
-	0, 229, 273, 236, 228, 405, 0, 0, 0, 0,
-	201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 239, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 257, 0, 313, 0, 0,
-	0, 0, 437, 0, 0, 0, 0, 0, 0, 0,
-	284, 0, 281, 181, 197, 0, 0, 323, 362, 368,
-	0, 0, 0, 220, 0, 366, 337, 422, 204, 247,
-	359, 342, 364, 0, 0, 365, 290, 410, 354, 420,
-	438, 439, 227, 317, 428, 401, 434, 450, 198, 224,
-	331, 394, 425, 385, 310, 406, 407, 280, 384, 255,
-	184, 288, 444, 196, 374, 212, 189, 396, 418, 209,
-	377, 0, 0, 452, 191, 416, 393, 307, 277, 278,
-	190, 0, 358, 232, 253, 222, 326, 413, 414, 221,
-	453, 200, 433, 193, 0, 432, 319, 409, 417, 308,
-	299, 192, 415, 306, 298, 283, 243, 264, 352, 293,
-	353, 265, 315, 314, 316, 0, 187, 0, 390, 426,
-	454, 205, 206, 207, 0, 242, 246, 252, 254, 260,
-	261, 268, 286, 330, 351, 349, 355, 0, 404, 421,
-	429, 436, 442, 443, 445, 446, 447, 448, 449, 318,
-	267, 386, 282, 291, 0, 0, 336, 367, 210, 424,
-	387, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 180, 194, 287, 1382, 356, 250, 451, 431, 427,
-	0, 0, 226, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 182, 183, 195, 203, 213,
-	225, 240, 248, 258, 263, 266, 270, 271, 274, 279,
-	296, 301, 302, 303, 304, 320, 321, 322, 325, 328,
-	329, 332, 334, 335, 338, 344, 345, 346, 347, 348,
-	350, 357, 361, 369, 370, 371, 372, 373, 375, 376,
-	380, 381, 382, 383, 391, 395, 411, 412, 423, 435,
-	440, 259, 419, 441, 0, 295, 0, 0, 297, 244,
-	262, 272, 0, 430, 392, 199, 363, 251, 188, 216,
-	202, 223, 238, 241, 276, 305, 311, 340, 343, 256,
-	235, 214, 360, 211, 378, 398, 399, 400, 402, 309,
-	230, 403, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 327, 0, 1263, 0, 0, 0, 0,
-	0, 0, 234, 0, 0, 0, 0, 285, 231, 0,
-	0, 341, 0, 186, 0, 379, 219, 294, 292, 408,
-	245, 237, 233, 218, 269, 300, 339, 397, 333, 0,
-	289, 0, 0, 388, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 217, 185, 324, 389, 249, 0, 0, 0, 0,
-	177, 178, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 208, 0, 215, 0, 0, 0, 0, 229, 273,
-	236, 228, 405, 0, 0, 0, 0, 201, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	239, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 257, 0, 313, 0, 0, 0, 0, 437,
-	0, 0, 0, 0, 0, 0, 0, 284, 0, 281,
-	181, 197, 0, 0, 323, 362, 368, 0, 0, 0,
-	220, 0, 366, 337, 422, 204, 247, 359, 342, 364,
-	0, 0, 365, 290, 410, 354, 420, 438, 439, 227,
-	317, 428, 401, 434, 450, 198, 224, 331, 394, 425,
-	385, 310, 406, 407, 280, 384, 255, 184, 288, 444,
-	196, 374, 212, 189, 396, 418, 209, 377, 0, 0,
-	452, 191, 416, 393, 307, 277, 278, 190, 0, 358,
-	232, 253, 222, 326, 413, 414, 221, 453, 200, 433,
-	193, 0, 432, 319, 409, 417, 308, 299, 192, 415,
-	306, 298, 283, 243, 264, 352, 293, 353, 265, 315,
-	314, 316, 0, 187, 0, 390, 426, 454, 205, 206,
-	207, 0, 242, 246, 252, 254, 260, 261, 268, 286,
-	330, 351, 349, 355, 0, 404, 421, 429, 436, 442,
-	443, 445, 446, 447, 448, 449, 318, 267, 386, 282,
-	291, 0, 0, 336, 367, 210, 424, 387, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 180, 194,
-	287, 0, 356, 250, 451, 431, 427, 0, 0, 226,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 182, 183, 195, 203, 213, 225, 240, 248,
-	258, 263, 266, 270, 271, 274, 279, 296, 301, 302,
-	303, 304, 320, 321, 322, 325, 328, 329, 332, 334,
-	335, 338, 344, 345, 346, 347, 348, 350, 357, 361,
-	369, 370, 371, 372, 373, 375, 376, 380, 381, 382,
-	383, 391, 395, 411, 412, 423, 435, 440, 259, 419,
-	441, 0, 295, 0, 0, 297, 244, 262, 272, 0,
-	430, 392, 199, 363, 251, 188, 216, 202, 223, 238,
-	241, 276, 305, 311, 340, 343, 256, 235, 214, 360,
-	211, 378, 398, 399, 400, 402, 309, 230, 403, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	327, 0, 1261, 0, 0, 0, 0, 0, 0, 234,
-	0, 0, 0, 0, 285, 231, 0, 0, 341, 0,
-	186, 0, 379, 219, 294, 292, 408, 245, 237, 233,
-	218, 269, 300, 339, 397, 333, 0, 289, 0, 0,
-	388, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 275, 217, 185,
-	324, 389, 249, 0, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 208, 0,
-	215, 0, 0, 0, 0, 229, 273, 236, 228, 405,
-	0, 0, 0, 0, 201, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 239, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 257,
-	0, 313, 0, 0, 0, 0, 437, 0, 0, 0,
-	0, 0, 0, 0, 284, 0, 281, 181, 197, 0,
-	0, 323, 362, 368, 0, 0, 0, 220, 0, 366,
-	337, 422, 204, 247, 359, 342, 364, 0, 0, 365,
-	290, 410, 354, 420, 438, 439, 227, 317, 428, 401,
-	434, 450, 198, 224, 331, 394, 425, 385, 310, 406,
-	407, 280, 384, 255, 184, 288, 444, 196, 374, 212,
-	189, 396, 418, 209, 377, 0, 0, 452, 191, 416,
-	393, 307, 277, 278, 190, 0, 358, 232, 253, 222,
-	326, 413, 414, 221, 453, 200, 433, 193, 0, 432,
-	319, 409, 417, 308, 299, 192, 415, 306, 298, 283,
-	243, 264, 352, 293, 353, 265, 315, 314, 316, 0,
-	187, 0, 390, 426, 454, 205, 206, 207, 0, 242,
-	246, 252, 254, 260, 261, 268, 286, 330, 351, 349,
-	355, 0, 404, 421, 429, 436, 442, 443, 445, 446,
-	447, 448, 449, 318, 267, 386, 282, 291, 0, 0,
-	336, 367, 210, 424, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 180, 194, 287, 0, 356,
-	250, 451, 431, 427, 0, 0, 226, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 182,
-	183, 195, 203, 213, 225, 240, 248, 258, 263, 266,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	411, 412, 423, 435, 440, 259, 419, 441, 0, 295,
-	0, 0, 297, 244, 262, 272, 0, 430, 392, 199,
-	363, 251, 188, 216, 202, 223, 238, 241, 276, 305,
-	311, 340, 343, 256, 235, 214, 360, 211, 378, 398,
-	399, 400, 402, 309, 230, 403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 327, 0, 1259,
-	0, 0, 0, 0, 0, 0, 234, 0, 0, 0,
-	0, 285, 231, 0, 0, 341, 0, 186, 0, 379,
-	219, 294, 292, 408, 245, 237, 233, 218, 269, 300,
-	339, 397, 333, 0, 289, 0, 0, 388, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 217, 185, 324, 389, 249,
-	0, 0, 0, 0, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 208, 0, 215, 0, 0,
-	0, 0, 229, 273, 236, 228, 405, 0, 0, 0,
-	0, 201, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 239, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 257, 0, 313, 0,
-	0, 0, 0, 437, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 181, 197, 0, 0, 323, 362,
-	368, 0, 0, 0, 220, 0, 366, 337, 422, 204,
-	247, 359, 342, 364, 0, 0, 365, 290, 410, 354,
-	420, 438, 439, 227, 317, 428, 401, 434, 450, 198,
-	224, 331, 394, 425, 385, 310, 406, 407, 280, 384,
-	255, 184, 288, 444, 196, 374, 212, 189, 396, 418,
-	209, 377, 0, 0, 452, 191, 416, 393, 307, 277,
-	278, 190, 0, 358, 232, 253, 222, 326, 413, 414,
-	221, 453, 200, 433, 193, 0, 432, 319, 409, 417,
-	308, 299, 192, 415, 306, 298, 283, 243, 264, 352,
-	293, 353, 265, 315, 314, 316, 0, 187, 0, 390,
-	426, 454, 205, 206, 207, 0, 242, 246, 252, 254,
-	260, 261, 268, 286, 330, 351, 349, 355, 0, 404,
-	421, 429, 436, 442, 443, 445, 446, 447, 448, 449,
-	318, 267, 386, 282, 291, 0, 0, 336, 367, 210,
-	424, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 194, 287, 0, 356, 250, 451, 431,
-	427, 0, 0, 226, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 182, 183, 195, 203,
-	213, 225, 240, 248, 258, 263, 266, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 411, 412, 423,
-	435, 440, 259, 419, 441, 0, 295, 0, 0, 297,
-	244, 262, 272, 0, 430, 392, 199, 363, 251, 188,
-	216, 202, 223, 238, 241, 276, 305, 311, 340, 343,
-	256, 235, 214, 360, 211, 378, 398, 399, 400, 402,
-	309, 230, 403, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 327, 0, 1257, 0, 0, 0,
-	0, 0, 0, 234, 0, 0, 0, 0, 285, 231,
-	0, 0, 341, 0, 186, 0, 379, 219, 294, 292,
-	408, 245, 237, 233, 218, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 217, 185, 324, 389, 249, 0, 0, 0,
-	0, 177, 178, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 208, 0, 215, 0, 0, 0, 0, 229,
-	273, 236, 228, 405, 0, 0, 0, 0, 201, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 239, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 257, 0, 313, 0, 0, 0, 0,
-	437, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 181, 197, 0, 0, 323, 362, 368, 0, 0,
-	0, 220, 0, 366, 337, 422, 204, 247, 359, 342,
-	364, 0, 0, 365, 290, 410, 354, 420, 438, 439,
-	227, 317, 428, 401, 434, 450, 198, 224, 331, 394,
-	425, 385, 310, 406, 407, 280, 384, 255, 184, 288,
-	444, 196, 374, 212, 189, 396, 418, 209, 377, 0,
-	0, 452, 191, 416, 393, 307, 277, 278, 190, 0,
-	358, 232, 253, 222, 326, 413, 414, 221, 453, 200,
-	433, 193, 0, 432, 319, 409, 417, 308, 299, 192,
-	415, 306, 298, 283, 243, 264, 352, 293, 353, 265,
-	315, 314, 316, 0, 187, 0, 390, 426, 454, 205,
-	206, 207, 0, 242, 246, 252, 254, 260, 261, 268,
-	286, 330, 351, 349, 355, 0, 404, 421, 429, 436,
-	442, 443, 445, 446, 447, 448, 449, 318, 267, 386,
-	282, 291, 0, 0, 336, 367, 210, 424, 387, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
-	194, 287, 0, 356, 250, 451, 431, 427, 0, 0,
-	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 182, 183, 195, 203, 213, 225, 240,
-	248, 258, 263, 266, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 411, 412, 423, 435, 440, 259,
-	419, 441, 0, 295, 0, 0, 297, 244, 262, 272,
-	0, 430, 392, 199, 363, 251, 188, 216, 202, 223,
-	238, 241, 276, 305, 311, 340, 343, 256, 235, 214,
-	360, 211, 378, 398, 399, 400, 402, 309, 230, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 0, 1255, 0, 0, 0, 0, 0, 0,
-	234, 0, 0, 0, 0, 285, 231, 0, 0, 341,
-	0, 186, 0, 379, 219, 294, 292, 408, 245, 237,
-	233, 218, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 217,
-	185, 324, 389, 249, 0, 0, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 208,
-	0, 215, 0, 0, 0, 0, 229, 273, 236, 228,
-	405, 0, 0, 0, 0, 201, 0, 0, 0, 0,
+	0, 290, 236, 0, 0, 346, 0, 190, 0, 387,
+	224, 299, 297, 416, 250, 242, 238, 222, 274, 305,
+	344, 405, 338, 0, 294, 0, 0, 396, 317, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 280, 221, 189, 329, 397, 254,
+	0, 0, 0, 1139, 181, 182, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 219, 0, 0,
+	0, 0, 234, 278, 241, 233, 413, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 318, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 2270, 0,
+	0, 0, 289, 0, 286, 185, 201, 0, 0, 328,
+	368, 374, 0, 0, 0, 225, 0, 372, 342, 430,
+	208, 252, 365, 347, 370, 0, 0, 371, 295, 418,
+	359, 428, 446, 447, 232, 322, 436, 409, 442, 460,
+	202, 229, 336, 402, 433, 393, 315, 414, 415, 285,
+	392, 260, 188, 293, 452, 200, 380, 216, 193, 404,
+	426, 213, 383, 0, 0, 462, 195, 424, 401, 312,
+	282, 283, 194, 0, 364, 237, 258, 227, 331, 421,
+	422, 226, 463, 204, 441, 197, 0, 440, 324, 417,
+	425, 313, 304, 196, 423, 311, 303, 288, 248, 269,
+	357, 298, 358, 270, 320, 319, 321, 0, 191, 0,
+	398, 434, 464, 209, 210, 211, 0, 247, 251, 257,
+	259, 265, 266, 273, 291, 335, 356, 354, 360, 0,
+	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
+	457, 458, 459, 323, 272, 394, 287, 296, 0, 0,
+	341, 373, 214, 432, 395, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 184, 198, 292, 0, 361,
+	255, 461, 439, 435, 0, 0, 231, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
+	187, 199, 207, 217, 230, 245, 253, 263, 268, 271,
+	275, 276, 279, 284, 301, 306, 307, 308, 309, 325,
+	326, 327, 330, 333, 334, 337, 339, 340, 343, 349,
+	350, 351, 352, 353, 355, 362, 367, 375, 376, 377,
+	378, 379, 381, 382, 388, 389, 390, 391, 399, 403,
+	419, 420, 431, 443, 448, 223, 363, 384, 385, 264,
+	427, 449, 0, 300, 0, 0, 302, 249, 267, 277,
+	0, 438, 400, 203, 369, 256, 192, 220, 206, 228,
+	243, 246, 281, 310, 316, 345, 348, 261, 240, 218,
+	366, 215, 386, 406, 407, 408, 410, 314, 235, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 290, 236, 0, 0, 346,
+	0, 190, 0, 387, 224, 299, 297, 416, 250, 242,
+	238, 222, 274, 305, 344, 405, 338, 0, 294, 0,
+	0, 396, 317, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 280, 221,
+	189, 329, 397, 254, 0, 0, 0, 0, 181, 182,
+	183, 0, 0, 1818, 0, 0, 1819, 0, 0, 212,
+	0, 219, 0, 0, 0, 0, 234, 278, 241, 233,
+	413, 0, 0, 0, 0, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 318, 0, 0, 0, 0, 0, 445, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 286, 185,
+	201, 0, 0, 328, 368, 374, 0, 0, 0, 225,
+	0, 372, 342, 430, 208, 252, 365, 347, 370, 0,
+	0, 371, 295, 418, 359, 428, 446, 447, 232, 322,
+	436, 409, 442, 460, 202, 229, 336, 402, 433, 393,
+	315, 414, 415, 285, 392, 260, 188, 293, 452, 200,
+	380, 216, 193, 404, 426, 213, 383, 0, 0, 462,
+	195, 424, 401, 312, 282, 283, 194, 0, 364, 237,
+	258, 227, 331, 421, 422, 226, 463, 204, 441, 197,
+	0, 440, 324, 417, 425, 313, 304, 196, 423, 311,
+	303, 288, 248, 269, 357, 298, 358, 270, 320, 319,
+	321, 0, 191, 0, 398, 434, 464, 209, 210, 211,
+	0, 247, 251, 257, 259, 265, 266, 273, 291, 335,
+	356, 354, 360, 0, 412, 429, 437, 444, 450, 451,
+	453, 454, 455, 456, 457, 458, 459, 323, 272, 394,
+	287, 296, 0, 0, 341, 373, 214, 432, 395, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 184,
+	198, 292, 0, 361, 255, 461, 439, 435, 0, 0,
+	231, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 186, 187, 199, 207, 217, 230, 245,
+	253, 263, 268, 271, 275, 276, 279, 284, 301, 306,
+	307, 308, 309, 325, 326, 327, 330, 333, 334, 337,
+	339, 340, 343, 349, 350, 351, 352, 353, 355, 362,
+	367, 375, 376, 377, 378, 379, 381, 382, 388, 389,
+	390, 391, 399, 403, 419, 420, 431, 443, 448, 223,
+	363, 384, 385, 264, 427, 449, 0, 300, 0, 0,
+	302, 249, 267, 277, 0, 438, 400, 203, 369, 256,
+	192, 220, 206, 228, 243, 246, 281, 310, 316, 345,
+	348, 261, 240, 218, 366, 215, 386, 406, 407, 408,
+	410, 314, 235, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 239, 1193, 0, 0, 0, 290,
+	236, 0, 0, 346, 0, 190, 0, 387, 224, 299,
+	297, 416, 250, 242, 238, 222, 274, 305, 344, 405,
+	338, 0, 294, 0, 0, 396, 317, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 280, 221, 189, 329, 397, 254, 0, 0,
+	0, 0, 181, 182, 183, 0, 1192, 0, 0, 0,
+	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
+	234, 278, 241, 233, 413, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 318, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 0, 0, 0,
+	289, 0, 286, 185, 201, 0, 0, 328, 368, 374,
+	0, 0, 0, 225, 0, 372, 342, 430, 208, 252,
+	365, 347, 370, 0, 0, 371, 295, 418, 359, 428,
+	446, 447, 232, 322, 436, 409, 442, 460, 202, 229,
+	336, 402, 433, 393, 315, 414, 415, 285, 392, 260,
+	188, 293, 452, 200, 380, 216, 193, 404, 426, 213,
+	383, 0, 0, 462, 195, 424, 401, 312, 282, 283,
+	194, 0, 364, 237, 258, 227, 331, 421, 422, 226,
+	463, 204, 441, 197, 0, 440, 324, 417, 425, 313,
+	304, 196, 423, 311, 303, 288, 248, 269, 357, 298,
+	358, 270, 320, 319, 321, 0, 191, 0, 398, 434,
+	464, 209, 210, 211, 0, 247, 251, 257, 259, 265,
+	266, 273, 291, 335, 356, 354, 360, 0, 412, 429,
+	437, 444, 450, 451, 453, 454, 455, 456, 457, 458,
+	459, 323, 272, 394, 287, 296, 0, 0, 341, 373,
+	214, 432, 395, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 184, 198, 292, 0, 361, 255, 461,
+	439, 435, 0, 0, 231, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 187, 199,
+	207, 217, 230, 245, 253, 263, 268, 271, 275, 276,
+	279, 284, 301, 306, 307, 308, 309, 325, 326, 327,
+	330, 333, 334, 337, 339, 340, 343, 349, 350, 351,
+	352, 353, 355, 362, 367, 375, 376, 377, 378, 379,
+	381, 382, 388, 389, 390, 391, 399, 403, 419, 420,
+	431, 443, 448, 223, 363, 384, 385, 264, 427, 449,
+	0, 300, 0, 0, 302, 249, 267, 277, 0, 438,
+	400, 203, 369, 256, 192, 220, 206, 228, 243, 246,
+	281, 310, 316, 345, 348, 261, 240, 218, 366, 215,
+	386, 406, 407, 408, 410, 314, 235, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 332,
 	0, 0, 0, 0, 0, 0, 0, 0, 239, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	257, 0, 313, 0, 0, 0, 0, 437, 0, 0,
-	0, 0, 0, 0, 0, 284, 0, 281, 181, 197,
-	0, 0, 323, 362, 368, 0, 0, 0, 220, 0,
-	366, 337, 422, 204, 247, 359, 342, 364, 0, 0,
-	365, 290, 410, 354, 420, 438, 439, 227, 317, 428,
-	401, 434, 450, 198, 224, 331, 394, 425, 385, 310,
-	406, 407, 280, 384, 255, 184, 288, 444, 196, 374,
-	212, 189, 396, 418, 209, 377, 0, 0, 452, 191,
-	416, 393, 307, 277, 278, 190, 0, 358, 232, 253,
-	222, 326, 413, 414, 221, 453, 200, 433, 193, 0,
-	432, 319, 409, 417, 308, 299, 192, 415, 306, 298,
-	283, 243, 264, 352, 293, 353, 265, 315, 314, 316,
-	0, 187, 0, 390, 426, 454, 205, 206, 207, 0,
-	242, 246, 252, 254, 260, 261, 268, 286, 330, 351,
-	349, 355, 0, 404, 421, 429, 436, 442, 443, 445,
-	446, 447, 448, 449, 318, 267, 386, 282, 291, 0,
-	0, 336, 367, 210, 424, 387, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 194, 287, 0,
-	356, 250, 451, 431, 427, 0, 0, 226, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	182, 183, 195, 203, 213, 225, 240, 248, 258, 263,
-	266, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 411, 412, 423, 435, 440, 259, 419, 441, 0,
-	295, 0, 0, 297, 244, 262, 272, 0, 430, 392,
-	199, 363, 251, 188, 216, 202, 223, 238, 241, 276,
-	305, 311, 340, 343, 256, 235, 214, 360, 211, 378,
-	398, 399, 400, 402, 309, 230, 403, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 327, 0,
-	1251, 0, 0, 0, 0, 0, 0, 234, 0, 0,
-	0, 0, 285, 231, 0, 0, 341, 0, 186, 0,
-	379, 219, 294, 292, 408, 245, 237, 233, 218, 269,
-	300, 339, 397, 333, 0, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 217, 185, 324, 389,
-	249, 0, 0, 0, 0, 177, 178, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 208, 0, 215, 0,
-	0, 0, 0, 229, 273, 236, 228, 405, 0, 0,
-	0, 0, 201, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 239, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 257, 0, 313,
-	0, 0, 0, 0, 437, 0, 0, 0, 0, 0,
-	0, 0, 284, 0, 281, 181, 197, 0, 0, 323,
-	362, 368, 0, 0, 0, 220, 0, 366, 337, 422,
-	204, 247, 359, 342, 364, 0, 0, 365, 290, 410,
-	354, 420, 438, 439, 227, 317, 428, 401, 434, 450,
-	198, 224, 331, 394, 425, 385, 310, 406, 407, 280,
-	384, 255, 184, 288, 444, 196, 374, 212, 189, 396,
-	418, 209, 377, 0, 0, 452, 191, 416, 393, 307,
-	277, 278, 190, 0, 358, 232, 253, 222, 326, 413,
-	414, 221, 453, 200, 433, 193, 0, 432, 319, 409,
-	417, 308, 299, 192, 415, 306, 298, 283, 243, 264,
-	352, 293, 353, 265, 315, 314, 316, 0, 187, 0,
-	390, 426, 454, 205, 206, 207, 0, 242, 246, 252,
-	254, 260, 261, 268, 286, 330, 351, 349, 355, 0,
-	404, 421, 429, 436, 442, 443, 445, 446, 447, 448,
-	449, 318, 267, 386, 282, 291, 0, 0, 336, 367,
-	210, 424, 387, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 180, 194, 287, 0, 356, 250, 451,
-	431, 427, 0, 0, 226, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 182, 183, 195,
-	203, 213, 225, 240, 248, 258, 263, 266, 270, 271,
-	274, 279, 296, 301, 302, 303, 304, 320, 321, 322,
-	325, 328, 329, 332, 334, 335, 338, 344, 345, 346,
-	347, 348, 350, 357, 361, 369, 370, 371, 372, 373,
-	375, 376, 380, 381, 382, 383, 391, 395, 411, 412,
-	423, 435, 440, 259, 419, 441, 0, 295, 0, 0,
-	297, 244, 262, 272, 0, 430, 392, 199, 363, 251,
-	188, 216, 202, 223, 238, 241, 276, 305, 311, 340,
-	343, 256, 235, 214, 360, 211, 378, 398, 399, 400,
-	402, 309, 230, 403, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 0, 1249, 0, 0,
-	0, 0, 0, 0, 234, 0, 0, 0, 0, 285,
-	231, 0, 0, 341, 0, 186, 0, 379, 219, 294,
-	292, 408, 245, 237, 233, 218, 269, 300, 339, 397,
-	333, 0, 289, 0, 0, 388, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 217, 185, 324, 389, 249, 0, 0,
-	0, 0, 177, 178, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 208, 0, 215, 0, 0, 0, 0,
-	229, 273, 236, 228, 405, 0, 0, 0, 0, 201,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 239, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 257, 0, 313, 0, 0, 0,
-	0, 437, 0, 0, 0, 0, 0, 0, 0, 284,
-	0, 281, 181, 197, 0, 0, 323, 362, 368, 0,
-	0, 0, 220, 0, 366, 337, 422, 204, 247, 359,
-	342, 364, 0, 0, 365, 290, 410, 354, 420, 438,
-	439, 227, 317, 428, 401, 434, 450, 198, 224, 331,
-	394, 425, 385, 310, 406, 407, 280, 384, 255, 184,
-	288, 444, 196, 374, 212, 189, 396, 418, 209, 377,
-	0, 0, 452, 191, 416, 393, 307, 277, 278, 190,
-	0, 358, 232, 253, 222, 326, 413, 414, 221, 453,
-	200, 433, 193, 0, 432, 319, 409, 417, 308, 299,
-	192, 415, 306, 298, 283, 243, 264, 352, 293, 353,
-	265, 315, 314, 316, 0, 187, 0, 390, 426, 454,
-	205, 206, 207, 0, 242, 246, 252, 254, 260, 261,
-	268, 286, 330, 351, 349, 355, 0, 404, 421, 429,
-	436, 442, 443, 445, 446, 447, 448, 449, 318, 267,
-	386, 282, 291, 0, 0, 336, 367, 210, 424, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	180, 194, 287, 0, 356, 250, 451, 431, 427, 0,
-	0, 226, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 182, 183, 195, 203, 213, 225,
-	240, 248, 258, 263, 266, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 411, 412, 423, 435, 440,
-	259, 419, 441, 0, 295, 0, 0, 297, 244, 262,
-	272, 0, 430, 392, 199, 363, 251, 188, 216, 202,
-	223, 238, 241, 276, 305, 311, 340, 343, 256, 235,
-	214, 360, 211, 378, 398, 399, 400, 402, 309, 230,
-	403, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 327, 0, 1247, 0, 0, 0, 0, 0,
-	0, 234, 0, 0, 0, 0, 285, 231, 0, 0,
-	341, 0, 186, 0, 379, 219, 294, 292, 408, 245,
-	237, 233, 218, 269, 300, 339, 397, 333, 0, 289,
-	0, 0, 388, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 275,
-	217, 185, 324, 389, 249, 0, 0, 0, 0, 177,
-	178, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	208, 0, 215, 0, 0, 0, 0, 229, 273, 236,
-	228, 405, 0, 0, 0, 0, 201, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 239,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 257, 0, 313, 0, 0, 0, 0, 437, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 181,
-	197, 0, 0, 323, 362, 368, 0, 0, 0, 220,
-	0, 366, 337, 422, 204, 247, 359, 342, 364, 0,
-	0, 365, 290, 410, 354, 420, 438, 439, 227, 317,
-	428, 401, 434, 450, 198, 224, 331, 394, 425, 385,
-	310, 406, 407, 280, 384, 255, 184, 288, 444, 196,
-	374, 212, 189, 396, 418, 209, 377, 0, 0, 452,
-	191, 416, 393, 307, 277, 278, 190, 0, 358, 232,
-	253, 222, 326, 413, 414, 221, 453, 200, 433, 193,
-	0, 432, 319, 409, 417, 308, 299, 192, 415, 306,
-	298, 283, 243, 264, 352, 293, 353, 265, 315, 314,
-	316, 0, 187, 0, 390, 426, 454, 205, 206, 207,
-	0, 242, 246, 252, 254, 260, 261, 268, 286, 330,
-	351, 349, 355, 0, 404, 421, 429, 436, 442, 443,
-	445, 446, 447, 448, 449, 318, 267, 386, 282, 291,
-	0, 0, 336, 367, 210, 424, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 180, 194, 287,
-	0, 356, 250, 451, 431, 427, 0, 0, 226, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 182, 183, 195, 203, 213, 225, 240, 248, 258,
-	263, 266, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 411, 412, 423, 435, 440, 259, 419, 441,
-	0, 295, 0, 0, 297, 244, 262, 272, 0, 430,
-	392, 199, 363, 251, 188, 216, 202, 223, 238, 241,
-	276, 305, 311, 340, 343, 256, 235, 214, 360, 211,
-	378, 398, 399, 400, 402, 309, 230, 403, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 234, 0,
-	0, 0, 0, 285, 231, 0, 0, 341, 0, 186,
-	0, 379, 219, 294, 292, 408, 245, 237, 233, 218,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 217, 185, 324,
-	389, 249, 0, 1222, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 208, 0, 215,
-	0, 0, 0, 0, 229, 273, 236, 228, 405, 0,
-	0, 0, 0, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 290, 236, 0, 0, 346, 0, 190,
+	0, 387, 224, 299, 297, 416, 250, 242, 238, 222,
+	274, 305, 344, 405, 338, 0, 294, 0, 0, 396,
+	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 280, 221, 189, 329,
+	397, 254, 0, 0, 0, 0, 181, 182, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 212, 0, 219,
+	0, 0, 0, 0, 234, 278, 241, 233, 413, 0,
+	0, 0, 0, 205, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	318, 0, 0, 0, 0, 0, 445, 0, 0, 0,
+	2377, 0, 0, 0, 289, 0, 286, 185, 201, 0,
+	0, 328, 368, 374, 0, 0, 0, 225, 0, 372,
+	342, 430, 208, 252, 365, 347, 370, 0, 0, 371,
+	295, 418, 359, 428, 446, 447, 232, 322, 436, 409,
+	442, 460, 202, 229, 336, 402, 433, 393, 315, 414,
+	415, 285, 392, 260, 188, 293, 452, 200, 380, 216,
+	193, 404, 426, 213, 383, 0, 0, 462, 195, 424,
+	401, 312, 282, 283, 194, 0, 364, 237, 258, 227,
+	331, 421, 422, 226, 463, 204, 441, 197, 0, 440,
+	324, 417, 425, 313, 304, 196, 423, 311, 303, 288,
+	248, 269, 357, 298, 358, 270, 320, 319, 321, 0,
+	191, 0, 398, 434, 464, 209, 210, 211, 0, 247,
+	251, 257, 259, 265, 266, 273, 291, 335, 356, 354,
+	360, 0, 412, 429, 437, 444, 450, 451, 453, 454,
+	455, 456, 457, 458, 459, 323, 272, 394, 287, 296,
+	0, 0, 341, 373, 214, 432, 395, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 184, 198, 292,
+	0, 361, 255, 461, 439, 435, 0, 0, 231, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 187, 199, 207, 217, 230, 245, 253, 263,
+	268, 271, 275, 276, 279, 284, 301, 306, 307, 308,
+	309, 325, 326, 327, 330, 333, 334, 337, 339, 340,
+	343, 349, 350, 351, 352, 353, 355, 362, 367, 375,
+	376, 377, 378, 379, 381, 382, 388, 389, 390, 391,
+	399, 403, 419, 420, 431, 443, 448, 223, 363, 384,
+	385, 264, 427, 449, 0, 300, 0, 0, 302, 249,
+	267, 277, 0, 438, 400, 203, 369, 256, 192, 220,
+	206, 228, 243, 246, 281, 310, 316, 345, 348, 261,
+	240, 218, 366, 215, 386, 406, 407, 408, 410, 314,
+	235, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 239, 0, 0, 0, 0, 290, 236, 0,
+	0, 346, 0, 190, 0, 387, 224, 299, 297, 416,
+	250, 242, 238, 222, 274, 305, 344, 405, 338, 0,
+	294, 0, 0, 396, 317, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	280, 221, 189, 329, 397, 254, 0, 0, 0, 0,
+	181, 182, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 212, 0, 219, 0, 0, 0, 0, 234, 278,
+	241, 233, 413, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 0, 318, 0, 0, 0, 0, 0,
+	445, 0, 0, 0, 2270, 0, 0, 0, 289, 0,
+	286, 185, 201, 0, 0, 328, 368, 374, 0, 0,
+	0, 225, 0, 372, 342, 430, 208, 252, 365, 347,
+	370, 0, 0, 371, 295, 418, 359, 428, 446, 447,
+	232, 322, 436, 409, 442, 460, 202, 229, 336, 402,
+	433, 393, 315, 414, 415, 285, 392, 260, 188, 293,
+	452, 200, 380, 216, 193, 404, 426, 213, 383, 0,
+	0, 462, 195, 424, 401, 312, 282, 283, 194, 0,
+	364, 237, 258, 227, 331, 421, 422, 226, 463, 204,
+	441, 197, 0, 440, 324, 417, 425, 313, 304, 196,
+	423, 311, 303, 288, 248, 269, 357, 298, 358, 270,
+	320, 319, 321, 0, 191, 0, 398, 434, 464, 209,
+	210, 211, 0, 247, 251, 257, 259, 265, 266, 273,
+	291, 335, 356, 354, 360, 0, 412, 429, 437, 444,
+	450, 451, 453, 454, 455, 456, 457, 458, 459, 323,
+	272, 394, 287, 296, 0, 0, 341, 373, 214, 432,
+	395, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 184, 198, 292, 0, 361, 255, 461, 439, 435,
+	0, 0, 231, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 186, 187, 199, 207, 217,
+	230, 245, 253, 263, 268, 271, 275, 276, 279, 284,
+	301, 306, 307, 308, 309, 325, 326, 327, 330, 333,
+	334, 337, 339, 340, 343, 349, 350, 351, 352, 353,
+	355, 362, 367, 375, 376, 377, 378, 379, 381, 382,
+	388, 389, 390, 391, 399, 403, 419, 420, 431, 443,
+	448, 223, 363, 384, 385, 264, 427, 449, 0, 300,
+	0, 0, 302, 249, 267, 277, 0, 438, 400, 203,
+	369, 256, 192, 220, 206, 228, 243, 246, 281, 310,
+	316, 345, 348, 261, 240, 218, 366, 215, 386, 406,
+	407, 408, 410, 314, 235, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 332, 0, 0,
 	0, 0, 0, 0, 0, 0, 239, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 257, 0,
-	313, 0, 0, 0, 0, 437, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 181, 197, 0, 0,
-	323, 362, 368, 0, 0, 0, 220, 0, 366, 337,
-	422, 204, 247, 359, 342, 364, 0, 0, 365, 290,
-	410, 354, 420, 438, 439, 227, 317, 428, 401, 434,
-	450, 198, 224, 331, 394, 425, 385, 310, 406, 407,
-	280, 384, 255, 184, 288, 444, 196, 374, 212, 189,
-	396, 418, 209, 377, 0, 0, 452, 191, 416, 393,
-	307, 277, 278, 190, 0, 358, 232, 253, 222, 326,
-	413, 414, 221, 453, 200, 433, 193, 0, 432, 319,
-	409, 417, 308, 299, 192, 415, 306, 298, 283, 243,
-	264, 352, 293, 353, 265, 315, 314, 316, 0, 187,
-	0, 390, 426, 454, 205, 206, 207, 0, 242, 246,
-	252, 254, 260, 261, 268, 286, 330, 351, 349, 355,
-	0, 404, 421, 429, 436, 442, 443, 445, 446, 447,
-	448, 449, 318, 267, 386, 282, 291, 0, 0, 336,
-	367, 210, 424, 387, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 180, 194, 287, 0, 356, 250,
-	451, 431, 427, 0, 0, 226, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 182, 183,
-	195, 203, 213, 225, 240, 248, 258, 263, 266, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 411,
-	412, 423, 435, 440, 259, 419, 441, 0, 295, 0,
-	0, 297, 244, 262, 272, 0, 430, 392, 199, 363,
-	251, 188, 216, 202, 223, 238, 241, 276, 305, 311,
-	340, 343, 256, 235, 214, 360, 211, 378, 398, 399,
-	400, 402, 309, 230, 403, 0, 0, 0, 0, 1123,
-	0, 0, 0, 0, 0, 0, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
-	285, 231, 0, 0, 341, 0, 186, 0, 379, 219,
-	294, 292, 408, 245, 237, 233, 218, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 217, 185, 324, 389, 249, 0,
-	0, 0, 0, 177, 178, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 208, 0, 215, 0, 0, 0,
-	0, 229, 273, 236, 228, 405, 0, 0, 0, 0,
-	201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 239, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 257, 0, 313, 0, 0,
-	0, 0, 437, 0, 0, 0, 0, 0, 0, 0,
-	284, 0, 281, 181, 197, 0, 0, 323, 362, 368,
-	0, 0, 0, 220, 0, 366, 337, 422, 204, 247,
-	359, 342, 364, 0, 0, 365, 290, 410, 354, 420,
-	438, 439, 227, 317, 428, 401, 434, 450, 198, 224,
-	331, 394, 425, 385, 310, 406, 407, 280, 384, 255,
-	184, 288, 444, 196, 374, 212, 189, 396, 418, 209,
-	377, 0, 0, 452, 191, 416, 393, 307, 277, 278,
-	190, 0, 358, 232, 253, 222, 326, 413, 414, 221,
-	453, 200, 433, 193, 0, 432, 319, 409, 417, 308,
-	299, 192, 415, 306, 298, 283, 243, 264, 352, 293,
-	353, 265, 315, 314, 316, 0, 187, 0, 390, 426,
-	454, 205, 206, 207, 0, 242, 246, 252, 254, 260,
-	261, 268, 286, 330, 351, 349, 355, 0, 404, 421,
-	429, 436, 442, 443, 445, 446, 447, 448, 449, 318,
-	267, 386, 282, 291, 0, 0, 336, 367, 210, 424,
-	387, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 180, 194, 287, 0, 356, 250, 451, 431, 427,
-	0, 0, 226, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 182, 183, 195, 203, 213,
-	225, 240, 248, 258, 263, 266, 270, 271, 274, 279,
-	296, 301, 302, 303, 304, 320, 321, 322, 325, 328,
-	329, 332, 334, 335, 338, 344, 345, 346, 347, 348,
-	350, 357, 361, 369, 370, 371, 372, 373, 375, 376,
-	380, 381, 382, 383, 391, 395, 411, 412, 423, 435,
-	440, 259, 419, 441, 0, 295, 0, 0, 297, 244,
-	262, 272, 0, 430, 392, 199, 363, 251, 188, 216,
-	202, 223, 238, 241, 276, 305, 311, 340, 343, 256,
-	235, 214, 360, 211, 378, 398, 399, 400, 402, 309,
-	230, 403, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 327, 0, 0, 0, 0, 0, 0,
-	0, 1114, 234, 0, 0, 0, 0, 285, 231, 0,
-	0, 341, 0, 186, 0, 379, 219, 294, 292, 408,
-	245, 237, 233, 218, 269, 300, 339, 397, 333, 0,
-	289, 0, 0, 388, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 217, 185, 324, 389, 249, 0, 0, 0, 0,
-	177, 178, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 208, 0, 215, 0, 0, 0, 0, 229, 273,
-	236, 228, 405, 0, 0, 0, 0, 201, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	239, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 257, 0, 313, 0, 0, 0, 0, 437,
-	0, 0, 0, 0, 0, 0, 0, 284, 0, 281,
-	181, 197, 0, 0, 323, 362, 368, 0, 0, 0,
-	220, 0, 366, 337, 422, 204, 247, 359, 342, 364,
-	0, 0, 365, 290, 410, 354, 420, 438, 439, 227,
-	317, 428, 401, 434, 450, 198, 224, 331, 394, 425,
-	385, 310, 406, 407, 280, 384, 255, 184, 288, 444,
-	196, 374, 212, 189, 396, 418, 209, 377, 0, 0,
-	452, 191, 416, 393, 307, 277, 278, 190, 0, 358,
-	232, 253, 222, 326, 413, 414, 221, 453, 200, 433,
-	193, 0, 432, 319, 409, 417, 308, 299, 192, 415,
-	306, 298, 283, 243, 264, 352, 293, 353, 265, 315,
-	314, 316, 0, 187, 0, 390, 426, 454, 205, 206,
-	207, 0, 242, 246, 252, 254, 260, 261, 268, 286,
-	330, 351, 349, 355, 0, 404, 421, 429, 436, 442,
-	443, 445, 446, 447, 448, 449, 318, 267, 386, 282,
-	291, 0, 0, 336, 367, 210, 424, 387, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 180, 194,
-	287, 0, 356, 250, 451, 431, 427, 0, 0, 226,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 182, 183, 195, 203, 213, 225, 240, 248,
-	258, 263, 266, 270, 271, 274, 279, 296, 301, 302,
-	303, 304, 320, 321, 322, 325, 328, 329, 332, 334,
-	335, 338, 344, 345, 346, 347, 348, 350, 357, 361,
-	369, 370, 371, 372, 373, 375, 376, 380, 381, 382,
-	383, 391, 395, 411, 412, 423, 435, 440, 259, 419,
-	441, 0, 295, 0, 0, 297, 244, 262, 272, 0,
-	430, 392, 199, 363, 251, 188, 216, 202, 223, 238,
-	241, 276, 305, 311, 340, 343, 256, 235, 214, 360,
-	211, 378, 398, 399, 400, 402, 309, 230, 403, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 234,
-	0, 0, 0, 0, 285, 231, 0, 0, 341, 0,
-	186, 0, 379, 219, 294, 292, 408, 245, 237, 233,
-	218, 269, 300, 339, 397, 333, 0, 289, 0, 0,
-	388, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 275, 217, 185,
-	324, 389, 249, 0, 0, 0, 0, 177, 178, 179,
-	0, 959, 0, 0, 0, 0, 0, 0, 208, 0,
-	215, 0, 0, 0, 0, 229, 273, 236, 228, 405,
-	0, 0, 0, 0, 201, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 239, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 257,
-	0, 313, 0, 0, 0, 0, 437, 0, 0, 0,
-	0, 0, 0, 0, 284, 0, 281, 181, 197, 0,
-	0, 323, 362, 368, 0, 0, 0, 220, 0, 366,
-	337, 422, 204, 247, 359, 342, 364, 0, 0, 365,
-	290, 410, 354, 420, 438, 439, 227, 317, 428, 401,
-	434, 450, 198, 224, 331, 394, 425, 385, 310, 406,
-	407, 280, 384, 255, 184, 288, 444, 196, 374, 212,
-	189, 396, 418, 209, 377, 0, 0, 452, 191, 416,
-	393, 307, 277, 278, 190, 0, 358, 232, 253, 222,
-	326, 413, 414, 221, 453, 200, 433, 193, 0, 432,
-	319, 409, 417, 308, 299, 192, 415, 306, 298, 283,
-	243, 264, 352, 293, 353, 265, 315, 314, 316, 0,
-	187, 0, 390, 426, 454, 205, 206, 207, 0, 242,
-	246, 252, 254, 260, 261, 268, 286, 330, 351, 349,
-	355, 0, 404, 421, 429, 436, 442, 443, 445, 446,
-	447, 448, 449, 318, 267, 386, 282, 291, 0, 0,
-	336, 367, 210, 424, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 180, 194, 287, 0, 356,
-	250, 451, 431, 427, 0, 0, 226, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 182,
-	183, 195, 203, 213, 225, 240, 248, 258, 263, 266,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	411, 412, 423, 435, 440, 259, 419, 441, 0, 295,
-	0, 0, 297, 244, 262, 272, 0, 430, 392, 199,
-	363, 251, 188, 216, 202, 223, 238, 241, 276, 305,
-	311, 340, 343, 256, 235, 214, 360, 211, 378, 398,
-	399, 400, 402, 309, 230, 403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 0, 0, 0,
-	0, 285, 231, 0, 0, 341, 0, 186, 0, 379,
-	219, 294, 292, 408, 245, 237, 233, 218, 269, 300,
-	339, 397, 333, 0, 289, 0, 0, 388, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 217, 185, 324, 389, 249,
-	0, 0, 0, 0, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 208, 0, 215, 0, 0,
-	0, 0, 229, 273, 236, 228, 405, 0, 0, 0,
-	0, 201, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 239, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 510, 0, 257, 0, 313, 0,
-	0, 0, 0, 437, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 181, 197, 0, 0, 323, 362,
-	368, 0, 0, 0, 220, 0, 366, 337, 422, 204,
-	247, 359, 342, 364, 0, 0, 365, 290, 410, 354,
-	420, 438, 439, 227, 317, 428, 401, 434, 450, 198,
-	224, 331, 394, 425, 385, 310, 406, 407, 280, 384,
-	255, 184, 288, 444, 196, 374, 212, 189, 396, 418,
-	209, 377, 0, 0, 452, 191, 416, 393, 307, 277,
-	278, 190, 0, 358, 232, 253, 222, 326, 413, 414,
-	221, 453, 200, 433, 193, 0, 432, 319, 409, 417,
-	308, 299, 192, 415, 306, 298, 283, 243, 264, 352,
-	293, 353, 265, 315, 314, 316, 0, 187, 0, 390,
-	426, 454, 205, 206, 207, 0, 242, 246, 252, 254,
-	260, 261, 268, 286, 330, 351, 349, 355, 0, 404,
-	421, 429, 436, 442, 443, 445, 446, 447, 448, 449,
-	318, 267, 386, 282, 291, 0, 0, 336, 367, 210,
-	424, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 194, 287, 0, 356, 250, 451, 431,
-	427, 0, 0, 226, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 182, 183, 195, 203,
-	213, 225, 240, 248, 258, 263, 266, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 411, 412, 423,
-	435, 440, 509, 419, 441, 0, 295, 0, 0, 297,
-	244, 262, 272, 0, 430, 392, 199, 363, 251, 188,
-	216, 202, 223, 238, 241, 276, 305, 311, 340, 343,
-	256, 235, 214, 360, 211, 378, 398, 399, 400, 402,
-	309, 230, 403, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 234, 0, 0, 0, 0, 285, 231,
-	0, 0, 341, 0, 186, 0, 379, 219, 294, 292,
-	408, 245, 237, 233, 218, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 217, 185, 324, 389, 249, 0, 0, 0,
-	0, 177, 178, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 208, 0, 215, 0, 0, 0, 0, 229,
-	273, 236, 228, 405, 0, 0, 0, 0, 201, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 239, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 257, 0, 313, 0, 0, 457, 0,
-	437, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 181, 197, 0, 0, 323, 362, 368, 0, 0,
-	0, 220, 0, 366, 337, 422, 204, 247, 359, 342,
-	364, 0, 0, 365, 290, 410, 354, 420, 438, 439,
-	227, 317, 428, 401, 434, 450, 198, 224, 331, 394,
-	425, 385, 310, 406, 407, 280, 384, 255, 184, 288,
-	444, 196, 374, 212, 189, 396, 418, 209, 377, 0,
-	0, 452, 191, 416, 393, 307, 277, 278, 190, 0,
-	358, 232, 253, 222, 326, 413, 414, 221, 453, 200,
-	433, 193, 0, 432, 319, 409, 417, 308, 299, 192,
-	415, 306, 298, 283, 243, 264, 352, 293, 353, 265,
-	315, 314, 316, 0, 187, 0, 390, 426, 454, 205,
-	206, 207, 0, 242, 246, 252, 254, 260, 261, 268,
-	286, 330, 351, 349, 355, 0, 404, 421, 429, 436,
-	442, 443, 445, 446, 447, 448, 449, 318, 267, 386,
-	282, 291, 0, 0, 336, 367, 210, 424, 387, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
-	194, 287, 0, 356, 250, 451, 431, 427, 0, 0,
-	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 182, 183, 195, 203, 213, 225, 240,
-	248, 258, 263, 266, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 411, 412, 423, 435, 440, 259,
-	419, 441, 0, 295, 0, 0, 297, 244, 262, 272,
-	0, 430, 392, 199, 363, 251, 188, 216, 202, 223,
-	238, 241, 276, 305, 311, 340, 343, 256, 235, 214,
-	360, 211, 378, 398, 399, 400, 402, 309, 230, 403,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 0, 0, 0, 0, 285, 231, 0, 0, 341,
-	0, 186, 0, 379, 219, 294, 292, 408, 245, 237,
-	233, 218, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 217,
-	185, 324, 389, 249, 0, 0, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 208,
-	0, 215, 0, 0, 0, 0, 229, 273, 236, 228,
-	405, 0, 0, 0, 0, 201, 0, 0, 0, 0,
+	0, 290, 236, 0, 0, 346, 0, 190, 0, 387,
+	224, 299, 297, 416, 250, 242, 238, 222, 274, 305,
+	344, 405, 338, 0, 294, 0, 0, 396, 317, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 280, 221, 189, 329, 397, 254,
+	0, 84, 0, 0, 181, 182, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 219, 0, 0,
+	0, 0, 234, 278, 241, 233, 413, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 318, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 0,
+	0, 0, 289, 0, 286, 185, 201, 0, 0, 328,
+	368, 374, 0, 0, 0, 225, 0, 372, 342, 430,
+	208, 252, 365, 347, 370, 0, 0, 371, 295, 418,
+	359, 428, 446, 447, 232, 322, 436, 409, 442, 460,
+	202, 229, 336, 402, 433, 393, 315, 414, 415, 285,
+	392, 260, 188, 293, 452, 200, 380, 216, 193, 404,
+	426, 213, 383, 0, 0, 462, 195, 424, 401, 312,
+	282, 283, 194, 0, 364, 237, 258, 227, 331, 421,
+	422, 226, 463, 204, 441, 197, 0, 440, 324, 417,
+	425, 313, 304, 196, 423, 311, 303, 288, 248, 269,
+	357, 298, 358, 270, 320, 319, 321, 0, 191, 0,
+	398, 434, 464, 209, 210, 211, 0, 247, 251, 257,
+	259, 265, 266, 273, 291, 335, 356, 354, 360, 0,
+	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
+	457, 458, 459, 323, 272, 394, 287, 296, 0, 0,
+	341, 373, 214, 432, 395, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 184, 198, 292, 0, 361,
+	255, 461, 439, 435, 0, 0, 231, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
+	187, 199, 207, 217, 230, 245, 253, 263, 268, 271,
+	275, 276, 279, 284, 301, 306, 307, 308, 309, 325,
+	326, 327, 330, 333, 334, 337, 339, 340, 343, 349,
+	350, 351, 352, 353, 355, 362, 367, 375, 376, 377,
+	378, 379, 381, 382, 388, 389, 390, 391, 399, 403,
+	419, 420, 431, 443, 448, 223, 363, 384, 385, 264,
+	427, 449, 0, 300, 0, 0, 302, 249, 267, 277,
+	0, 438, 400, 203, 369, 256, 192, 220, 206, 228,
+	243, 246, 281, 310, 316, 345, 348, 261, 240, 218,
+	366, 215, 386, 406, 407, 408, 410, 314, 235, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 290, 236, 0, 0, 346,
+	0, 190, 0, 387, 224, 299, 297, 416, 250, 242,
+	238, 222, 274, 305, 344, 405, 338, 0, 294, 0,
+	0, 396, 317, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 280, 221,
+	189, 329, 397, 254, 0, 0, 0, 0, 181, 182,
+	183, 0, 1343, 0, 0, 0, 0, 0, 0, 212,
+	0, 219, 0, 0, 0, 0, 234, 278, 241, 233,
+	413, 0, 0, 0, 0, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 318, 0, 0, 0, 0, 0, 445, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 286, 185,
+	201, 0, 0, 328, 368, 374, 0, 0, 0, 225,
+	0, 372, 342, 430, 208, 252, 365, 347, 370, 0,
+	0, 371, 295, 418, 359, 428, 446, 447, 232, 322,
+	436, 409, 442, 460, 202, 229, 336, 402, 433, 393,
+	315, 414, 415, 285, 392, 260, 188, 293, 452, 200,
+	380, 216, 193, 404, 426, 213, 383, 0, 0, 462,
+	195, 424, 401, 312, 282, 283, 194, 0, 364, 237,
+	258, 227, 331, 421, 422, 226, 463, 204, 441, 197,
+	0, 440, 324, 417, 425, 313, 304, 196, 423, 311,
+	303, 288, 248, 269, 357, 298, 358, 270, 320, 319,
+	321, 0, 191, 0, 398, 434, 464, 209, 210, 211,
+	0, 247, 251, 257, 259, 265, 266, 273, 291, 335,
+	356, 354, 360, 0, 412, 429, 437, 444, 450, 451,
+	453, 454, 455, 456, 457, 458, 459, 323, 272, 394,
+	287, 296, 0, 0, 341, 373, 214, 432, 395, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 184,
+	198, 292, 0, 361, 255, 461, 439, 435, 0, 0,
+	231, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 186, 187, 199, 207, 217, 230, 245,
+	253, 263, 268, 271, 275, 276, 279, 284, 301, 306,
+	307, 308, 309, 325, 326, 327, 330, 333, 334, 337,
+	339, 340, 343, 349, 350, 351, 352, 353, 355, 362,
+	367, 375, 376, 377, 378, 379, 381, 382, 388, 389,
+	390, 391, 399, 403, 419, 420, 431, 443, 448, 223,
+	363, 384, 385, 264, 427, 449, 0, 300, 0, 0,
+	302, 249, 267, 277, 0, 438, 400, 203, 369, 256,
+	192, 220, 206, 228, 243, 246, 281, 310, 316, 345,
+	348, 261, 240, 218, 366, 215, 386, 406, 407, 408,
+	410, 314, 235, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 239, 0, 0, 0, 0, 290,
+	236, 0, 0, 346, 0, 190, 0, 387, 224, 299,
+	297, 416, 250, 242, 238, 222, 274, 305, 344, 405,
+	338, 0, 294, 0, 0, 396, 317, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 280, 221, 189, 329, 397, 254, 0, 0,
+	0, 0, 181, 182, 183, 0, 1161, 0, 0, 0,
+	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
+	234, 278, 241, 233, 413, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 318, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 0, 0, 0,
+	289, 0, 286, 185, 201, 0, 0, 328, 368, 374,
+	0, 0, 0, 225, 0, 372, 342, 430, 208, 252,
+	365, 347, 370, 0, 0, 371, 295, 418, 359, 428,
+	446, 447, 232, 322, 436, 409, 442, 460, 202, 229,
+	336, 402, 433, 393, 315, 414, 415, 285, 392, 260,
+	188, 293, 452, 200, 380, 216, 193, 404, 426, 213,
+	383, 0, 0, 462, 195, 424, 401, 312, 282, 283,
+	194, 0, 364, 237, 258, 227, 331, 421, 422, 226,
+	463, 204, 441, 197, 0, 440, 324, 417, 425, 313,
+	304, 196, 423, 311, 303, 288, 248, 269, 357, 298,
+	358, 270, 320, 319, 321, 0, 191, 0, 398, 434,
+	464, 209, 210, 211, 0, 247, 251, 257, 259, 265,
+	266, 273, 291, 335, 356, 354, 360, 0, 412, 429,
+	437, 444, 450, 451, 453, 454, 455, 456, 457, 458,
+	459, 323, 272, 394, 287, 296, 0, 0, 341, 373,
+	214, 432, 395, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 184, 198, 292, 0, 361, 255, 461,
+	439, 435, 0, 0, 231, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 187, 199,
+	207, 217, 230, 245, 253, 263, 268, 271, 275, 276,
+	279, 284, 301, 306, 307, 308, 309, 325, 326, 327,
+	330, 333, 334, 337, 339, 340, 343, 349, 350, 351,
+	352, 353, 355, 362, 367, 375, 376, 377, 378, 379,
+	381, 382, 388, 389, 390, 391, 399, 403, 419, 420,
+	431, 443, 448, 223, 363, 384, 385, 264, 427, 449,
+	0, 300, 0, 0, 302, 249, 267, 277, 0, 438,
+	400, 203, 369, 256, 192, 220, 206, 228, 243, 246,
+	281, 310, 316, 345, 348, 261, 240, 218, 366, 215,
+	386, 406, 407, 408, 410, 314, 235, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 332,
 	0, 0, 0, 0, 0, 0, 0, 0, 239, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	257, 0, 313, 0, 0, 0, 0, 437, 0, 0,
-	0, 0, 0, 0, 0, 284, 0, 281, 181, 197,
-	0, 0, 323, 362, 368, 0, 0, 0, 220, 0,
-	366, 337, 422, 204, 247, 359, 342, 364, 0, 0,
-	365, 290, 410, 354, 420, 438, 439, 227, 317, 428,
-	401, 434, 450, 198, 224, 331, 394, 425, 385, 310,
-	406, 407, 280, 384, 255, 184, 288, 444, 196, 374,
-	212, 189, 396, 418, 209, 377, 0, 0, 452, 191,
-	416, 393, 307, 277, 278, 190, 0, 358, 232, 253,
-	222, 326, 413, 414, 221, 453, 200, 433, 193, 0,
-	432, 319, 409, 417, 308, 299, 192, 415, 306, 298,
-	283, 243, 264, 352, 293, 353, 265, 315, 314, 316,
-	0, 187, 0, 390, 426, 454, 205, 206, 207, 0,
-	242, 246, 252, 254, 260, 261, 268, 286, 330, 351,
-	349, 355, 0, 404, 421, 429, 436, 442, 443, 445,
-	446, 447, 448, 449, 318, 267, 386, 282, 291, 0,
-	0, 336, 367, 210, 424, 387, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 194, 287, 0,
-	356, 250, 451, 431, 427, 0, 0, 226, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	182, 183, 195, 203, 213, 225, 240, 248, 258, 263,
-	266, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 411, 412, 423, 435, 440, 259, 419, 441, 0,
-	295, 0, 0, 297, 244, 262, 272, 0, 430, 392,
-	199, 363, 251, 188, 216, 202, 223, 238, 241, 276,
-	305, 311, 340, 343, 256, 235, 214, 360, 211, 378,
-	398, 399, 400, 402, 309, 230,
+	0, 0, 0, 290, 236, 0, 0, 346, 0, 190,
+	0, 387, 224, 299, 297, 416, 250, 242, 238, 222,
+	274, 305, 344, 405, 338, 0, 294, 0, 0, 396,
+	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 280, 221, 189, 329,
+	397, 254, 0, 0, 0, 0, 181, 182, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 212, 0, 219,
+	0, 0, 0, 0, 234, 278, 241, 233, 413, 0,
+	0, 0, 0, 205, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	318, 0, 0, 0, 0, 0, 445, 0, 0, 0,
+	0, 0, 0, 0, 289, 0, 286, 185, 201, 0,
+	0, 328, 368, 374, 0, 0, 0, 225, 0, 372,
+	342, 430, 208, 252, 365, 347, 370, 0, 0, 371,
+	295, 418, 359, 428, 446, 447, 232, 322, 436, 409,
+	442, 460, 202, 229, 336, 402, 433, 393, 315, 414,
+	415, 285, 392, 260, 188, 293, 452, 200, 380, 216,
+	193, 404, 426, 213, 383, 0, 0, 462, 195, 424,
+	401, 312, 282, 283, 194, 0, 364, 237, 258, 227,
+	331, 421, 422, 226, 463, 204, 441, 197, 0, 440,
+	324, 417, 425, 313, 304, 196, 423, 311, 303, 288,
+	248, 269, 357, 298, 358, 270, 320, 319, 321, 0,
+	191, 0, 398, 434, 464, 209, 210, 211, 0, 247,
+	251, 257, 259, 265, 266, 273, 291, 335, 356, 354,
+	360, 0, 412, 429, 437, 444, 450, 451, 453, 454,
+	455, 456, 457, 458, 459, 323, 272, 394, 287, 296,
+	0, 0, 341, 373, 214, 432, 395, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 184, 198, 292,
+	1434, 361, 255, 461, 439, 435, 0, 0, 231, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 187, 199, 207, 217, 230, 245, 253, 263,
+	268, 271, 275, 276, 279, 284, 301, 306, 307, 308,
+	309, 325, 326, 327, 330, 333, 334, 337, 339, 340,
+	343, 349, 350, 351, 352, 353, 355, 362, 367, 375,
+	376, 377, 378, 379, 381, 382, 388, 389, 390, 391,
+	399, 403, 419, 420, 431, 443, 448, 223, 363, 384,
+	385, 264, 427, 449, 0, 300, 0, 0, 302, 249,
+	267, 277, 0, 438, 400, 203, 369, 256, 192, 220,
+	206, 228, 243, 246, 281, 310, 316, 345, 348, 261,
+	240, 218, 366, 215, 386, 406, 407, 408, 410, 314,
+	235, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 332, 0, 1315, 0, 0, 0, 0,
+	0, 0, 239, 0, 0, 0, 0, 290, 236, 0,
+	0, 346, 0, 190, 0, 387, 224, 299, 297, 416,
+	250, 242, 238, 222, 274, 305, 344, 405, 338, 0,
+	294, 0, 0, 396, 317, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	280, 221, 189, 329, 397, 254, 0, 0, 0, 0,
+	181, 182, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 212, 0, 219, 0, 0, 0, 0, 234, 278,
+	241, 233, 413, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 0, 318, 0, 0, 0, 0, 0,
+	445, 0, 0, 0, 0, 0, 0, 0, 289, 0,
+	286, 185, 201, 0, 0, 328, 368, 374, 0, 0,
+	0, 225, 0, 372, 342, 430, 208, 252, 365, 347,
+	370, 0, 0, 371, 295, 418, 359, 428, 446, 447,
+	232, 322, 436, 409, 442, 460, 202, 229, 336, 402,
+	433, 393, 315, 414, 415, 285, 392, 260, 188, 293,
+	452, 200, 380, 216, 193, 404, 426, 213, 383, 0,
+	0, 462, 195, 424, 401, 312, 282, 283, 194, 0,
+	364, 237, 258, 227, 331, 421, 422, 226, 463, 204,
+	441, 197, 0, 440, 324, 417, 425, 313, 304, 196,
+	423, 311, 303, 288, 248, 269, 357, 298, 358, 270,
+	320, 319, 321, 0, 191, 0, 398, 434, 464, 209,
+	210, 211, 0, 247, 251, 257, 259, 265, 266, 273,
+	291, 335, 356, 354, 360, 0, 412, 429, 437, 444,
+	450, 451, 453, 454, 455, 456, 457, 458, 459, 323,
+	272, 394, 287, 296, 0, 0, 341, 373, 214, 432,
+	395, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 184, 198, 292, 0, 361, 255, 461, 439, 435,
+	0, 0, 231, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 186, 187, 199, 207, 217,
+	230, 245, 253, 263, 268, 271, 275, 276, 279, 284,
+	301, 306, 307, 308, 309, 325, 326, 327, 330, 333,
+	334, 337, 339, 340, 343, 349, 350, 351, 352, 353,
+	355, 362, 367, 375, 376, 377, 378, 379, 381, 382,
+	388, 389, 390, 391, 399, 403, 419, 420, 431, 443,
+	448, 223, 363, 384, 385, 264, 427, 449, 0, 300,
+	0, 0, 302, 249, 267, 277, 0, 438, 400, 203,
+	369, 256, 192, 220, 206, 228, 243, 246, 281, 310,
+	316, 345, 348, 261, 240, 218, 366, 215, 386, 406,
+	407, 408, 410, 314, 235, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 332, 0, 1313,
+	0, 0, 0, 0, 0, 0, 239, 0, 0, 0,
+	0, 290, 236, 0, 0, 346, 0, 190, 0, 387,
+	224, 299, 297, 416, 250, 242, 238, 222, 274, 305,
+	344, 405, 338, 0, 294, 0, 0, 396, 317, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 280, 221, 189, 329, 397, 254,
+	0, 0, 0, 0, 181, 182, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 219, 0, 0,
+	0, 0, 234, 278, 241, 233, 413, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 318, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 0,
+	0, 0, 289, 0, 286, 185, 201, 0, 0, 328,
+	368, 374, 0, 0, 0, 225, 0, 372, 342, 430,
+	208, 252, 365, 347, 370, 0, 0, 371, 295, 418,
+	359, 428, 446, 447, 232, 322, 436, 409, 442, 460,
+	202, 229, 336, 402, 433, 393, 315, 414, 415, 285,
+	392, 260, 188, 293, 452, 200, 380, 216, 193, 404,
+	426, 213, 383, 0, 0, 462, 195, 424, 401, 312,
+	282, 283, 194, 0, 364, 237, 258, 227, 331, 421,
+	422, 226, 463, 204, 441, 197, 0, 440, 324, 417,
+	425, 313, 304, 196, 423, 311, 303, 288, 248, 269,
+	357, 298, 358, 270, 320, 319, 321, 0, 191, 0,
+	398, 434, 464, 209, 210, 211, 0, 247, 251, 257,
+	259, 265, 266, 273, 291, 335, 356, 354, 360, 0,
+	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
+	457, 458, 459, 323, 272, 394, 287, 296, 0, 0,
+	341, 373, 214, 432, 395, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 184, 198, 292, 0, 361,
+	255, 461, 439, 435, 0, 0, 231, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
+	187, 199, 207, 217, 230, 245, 253, 263, 268, 271,
+	275, 276, 279, 284, 301, 306, 307, 308, 309, 325,
+	326, 327, 330, 333, 334, 337, 339, 340, 343, 349,
+	350, 351, 352, 353, 355, 362, 367, 375, 376, 377,
+	378, 379, 381, 382, 388, 389, 390, 391, 399, 403,
+	419, 420, 431, 443, 448, 223, 363, 384, 385, 264,
+	427, 449, 0, 300, 0, 0, 302, 249, 267, 277,
+	0, 438, 400, 203, 369, 256, 192, 220, 206, 228,
+	243, 246, 281, 310, 316, 345, 348, 261, 240, 218,
+	366, 215, 386, 406, 407, 408, 410, 314, 235, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 332, 0, 1311, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 290, 236, 0, 0, 346,
+	0, 190, 0, 387, 224, 299, 297, 416, 250, 242,
+	238, 222, 274, 305, 344, 405, 338, 0, 294, 0,
+	0, 396, 317, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 280, 221,
+	189, 329, 397, 254, 0, 0, 0, 0, 181, 182,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 212,
+	0, 219, 0, 0, 0, 0, 234, 278, 241, 233,
+	413, 0, 0, 0, 0, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 318, 0, 0, 0, 0, 0, 445, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 286, 185,
+	201, 0, 0, 328, 368, 374, 0, 0, 0, 225,
+	0, 372, 342, 430, 208, 252, 365, 347, 370, 0,
+	0, 371, 295, 418, 359, 428, 446, 447, 232, 322,
+	436, 409, 442, 460, 202, 229, 336, 402, 433, 393,
+	315, 414, 415, 285, 392, 260, 188, 293, 452, 200,
+	380, 216, 193, 404, 426, 213, 383, 0, 0, 462,
+	195, 424, 401, 312, 282, 283, 194, 0, 364, 237,
+	258, 227, 331, 421, 422, 226, 463, 204, 441, 197,
+	0, 440, 324, 417, 425, 313, 304, 196, 423, 311,
+	303, 288, 248, 269, 357, 298, 358, 270, 320, 319,
+	321, 0, 191, 0, 398, 434, 464, 209, 210, 211,
+	0, 247, 251, 257, 259, 265, 266, 273, 291, 335,
+	356, 354, 360, 0, 412, 429, 437, 444, 450, 451,
+	453, 454, 455, 456, 457, 458, 459, 323, 272, 394,
+	287, 296, 0, 0, 341, 373, 214, 432, 395, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 184,
+	198, 292, 0, 361, 255, 461, 439, 435, 0, 0,
+	231, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 186, 187, 199, 207, 217, 230, 245,
+	253, 263, 268, 271, 275, 276, 279, 284, 301, 306,
+	307, 308, 309, 325, 326, 327, 330, 333, 334, 337,
+	339, 340, 343, 349, 350, 351, 352, 353, 355, 362,
+	367, 375, 376, 377, 378, 379, 381, 382, 388, 389,
+	390, 391, 399, 403, 419, 420, 431, 443, 448, 223,
+	363, 384, 385, 264, 427, 449, 0, 300, 0, 0,
+	302, 249, 267, 277, 0, 438, 400, 203, 369, 256,
+	192, 220, 206, 228, 243, 246, 281, 310, 316, 345,
+	348, 261, 240, 218, 366, 215, 386, 406, 407, 408,
+	410, 314, 235, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 332, 0, 1309, 0, 0,
+	0, 0, 0, 0, 239, 0, 0, 0, 0, 290,
+	236, 0, 0, 346, 0, 190, 0, 387, 224, 299,
+	297, 416, 250, 242, 238, 222, 274, 305, 344, 405,
+	338, 0, 294, 0, 0, 396, 317, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 280, 221, 189, 329, 397, 254, 0, 0,
+	0, 0, 181, 182, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
+	234, 278, 241, 233, 413, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 318, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 0, 0, 0,
+	289, 0, 286, 185, 201, 0, 0, 328, 368, 374,
+	0, 0, 0, 225, 0, 372, 342, 430, 208, 252,
+	365, 347, 370, 0, 0, 371, 295, 418, 359, 428,
+	446, 447, 232, 322, 436, 409, 442, 460, 202, 229,
+	336, 402, 433, 393, 315, 414, 415, 285, 392, 260,
+	188, 293, 452, 200, 380, 216, 193, 404, 426, 213,
+	383, 0, 0, 462, 195, 424, 401, 312, 282, 283,
+	194, 0, 364, 237, 258, 227, 331, 421, 422, 226,
+	463, 204, 441, 197, 0, 440, 324, 417, 425, 313,
+	304, 196, 423, 311, 303, 288, 248, 269, 357, 298,
+	358, 270, 320, 319, 321, 0, 191, 0, 398, 434,
+	464, 209, 210, 211, 0, 247, 251, 257, 259, 265,
+	266, 273, 291, 335, 356, 354, 360, 0, 412, 429,
+	437, 444, 450, 451, 453, 454, 455, 456, 457, 458,
+	459, 323, 272, 394, 287, 296, 0, 0, 341, 373,
+	214, 432, 395, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 184, 198, 292, 0, 361, 255, 461,
+	439, 435, 0, 0, 231, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 187, 199,
+	207, 217, 230, 245, 253, 263, 268, 271, 275, 276,
+	279, 284, 301, 306, 307, 308, 309, 325, 326, 327,
+	330, 333, 334, 337, 339, 340, 343, 349, 350, 351,
+	352, 353, 355, 362, 367, 375, 376, 377, 378, 379,
+	381, 382, 388, 389, 390, 391, 399, 403, 419, 420,
+	431, 443, 448, 223, 363, 384, 385, 264, 427, 449,
+	0, 300, 0, 0, 302, 249, 267, 277, 0, 438,
+	400, 203, 369, 256, 192, 220, 206, 228, 243, 246,
+	281, 310, 316, 345, 348, 261, 240, 218, 366, 215,
+	386, 406, 407, 408, 410, 314, 235, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 332,
+	0, 1307, 0, 0, 0, 0, 0, 0, 239, 0,
+	0, 0, 0, 290, 236, 0, 0, 346, 0, 190,
+	0, 387, 224, 299, 297, 416, 250, 242, 238, 222,
+	274, 305, 344, 405, 338, 0, 294, 0, 0, 396,
+	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 280, 221, 189, 329,
+	397, 254, 0, 0, 0, 0, 181, 182, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 212, 0, 219,
+	0, 0, 0, 0, 234, 278, 241, 233, 413, 0,
+	0, 0, 0, 205, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	318, 0, 0, 0, 0, 0, 445, 0, 0, 0,
+	0, 0, 0, 0, 289, 0, 286, 185, 201, 0,
+	0, 328, 368, 374, 0, 0, 0, 225, 0, 372,
+	342, 430, 208, 252, 365, 347, 370, 0, 0, 371,
+	295, 418, 359, 428, 446, 447, 232, 322, 436, 409,
+	442, 460, 202, 229, 336, 402, 433, 393, 315, 414,
+	415, 285, 392, 260, 188, 293, 452, 200, 380, 216,
+	193, 404, 426, 213, 383, 0, 0, 462, 195, 424,
+	401, 312, 282, 283, 194, 0, 364, 237, 258, 227,
+	331, 421, 422, 226, 463, 204, 441, 197, 0, 440,
+	324, 417, 425, 313, 304, 196, 423, 311, 303, 288,
+	248, 269, 357, 298, 358, 270, 320, 319, 321, 0,
+	191, 0, 398, 434, 464, 209, 210, 211, 0, 247,
+	251, 257, 259, 265, 266, 273, 291, 335, 356, 354,
+	360, 0, 412, 429, 437, 444, 450, 451, 453, 454,
+	455, 456, 457, 458, 459, 323, 272, 394, 287, 296,
+	0, 0, 341, 373, 214, 432, 395, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 184, 198, 292,
+	0, 361, 255, 461, 439, 435, 0, 0, 231, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 187, 199, 207, 217, 230, 245, 253, 263,
+	268, 271, 275, 276, 279, 284, 301, 306, 307, 308,
+	309, 325, 326, 327, 330, 333, 334, 337, 339, 340,
+	343, 349, 350, 351, 352, 353, 355, 362, 367, 375,
+	376, 377, 378, 379, 381, 382, 388, 389, 390, 391,
+	399, 403, 419, 420, 431, 443, 448, 223, 363, 384,
+	385, 264, 427, 449, 0, 300, 0, 0, 302, 249,
+	267, 277, 0, 438, 400, 203, 369, 256, 192, 220,
+	206, 228, 243, 246, 281, 310, 316, 345, 348, 261,
+	240, 218, 366, 215, 386, 406, 407, 408, 410, 314,
+	235, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 332, 0, 1303, 0, 0, 0, 0,
+	0, 0, 239, 0, 0, 0, 0, 290, 236, 0,
+	0, 346, 0, 190, 0, 387, 224, 299, 297, 416,
+	250, 242, 238, 222, 274, 305, 344, 405, 338, 0,
+	294, 0, 0, 396, 317, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	280, 221, 189, 329, 397, 254, 0, 0, 0, 0,
+	181, 182, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 212, 0, 219, 0, 0, 0, 0, 234, 278,
+	241, 233, 413, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 0, 318, 0, 0, 0, 0, 0,
+	445, 0, 0, 0, 0, 0, 0, 0, 289, 0,
+	286, 185, 201, 0, 0, 328, 368, 374, 0, 0,
+	0, 225, 0, 372, 342, 430, 208, 252, 365, 347,
+	370, 0, 0, 371, 295, 418, 359, 428, 446, 447,
+	232, 322, 436, 409, 442, 460, 202, 229, 336, 402,
+	433, 393, 315, 414, 415, 285, 392, 260, 188, 293,
+	452, 200, 380, 216, 193, 404, 426, 213, 383, 0,
+	0, 462, 195, 424, 401, 312, 282, 283, 194, 0,
+	364, 237, 258, 227, 331, 421, 422, 226, 463, 204,
+	441, 197, 0, 440, 324, 417, 425, 313, 304, 196,
+	423, 311, 303, 288, 248, 269, 357, 298, 358, 270,
+	320, 319, 321, 0, 191, 0, 398, 434, 464, 209,
+	210, 211, 0, 247, 251, 257, 259, 265, 266, 273,
+	291, 335, 356, 354, 360, 0, 412, 429, 437, 444,
+	450, 451, 453, 454, 455, 456, 457, 458, 459, 323,
+	272, 394, 287, 296, 0, 0, 341, 373, 214, 432,
+	395, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 184, 198, 292, 0, 361, 255, 461, 439, 435,
+	0, 0, 231, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 186, 187, 199, 207, 217,
+	230, 245, 253, 263, 268, 271, 275, 276, 279, 284,
+	301, 306, 307, 308, 309, 325, 326, 327, 330, 333,
+	334, 337, 339, 340, 343, 349, 350, 351, 352, 353,
+	355, 362, 367, 375, 376, 377, 378, 379, 381, 382,
+	388, 389, 390, 391, 399, 403, 419, 420, 431, 443,
+	448, 223, 363, 384, 385, 264, 427, 449, 0, 300,
+	0, 0, 302, 249, 267, 277, 0, 438, 400, 203,
+	369, 256, 192, 220, 206, 228, 243, 246, 281, 310,
+	316, 345, 348, 261, 240, 218, 366, 215, 386, 406,
+	407, 408, 410, 314, 235, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 332, 0, 1301,
+	0, 0, 0, 0, 0, 0, 239, 0, 0, 0,
+	0, 290, 236, 0, 0, 346, 0, 190, 0, 387,
+	224, 299, 297, 416, 250, 242, 238, 222, 274, 305,
+	344, 405, 338, 0, 294, 0, 0, 396, 317, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 280, 221, 189, 329, 397, 254,
+	0, 0, 0, 0, 181, 182, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 219, 0, 0,
+	0, 0, 234, 278, 241, 233, 413, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 318, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 0,
+	0, 0, 289, 0, 286, 185, 201, 0, 0, 328,
+	368, 374, 0, 0, 0, 225, 0, 372, 342, 430,
+	208, 252, 365, 347, 370, 0, 0, 371, 295, 418,
+	359, 428, 446, 447, 232, 322, 436, 409, 442, 460,
+	202, 229, 336, 402, 433, 393, 315, 414, 415, 285,
+	392, 260, 188, 293, 452, 200, 380, 216, 193, 404,
+	426, 213, 383, 0, 0, 462, 195, 424, 401, 312,
+	282, 283, 194, 0, 364, 237, 258, 227, 331, 421,
+	422, 226, 463, 204, 441, 197, 0, 440, 324, 417,
+	425, 313, 304, 196, 423, 311, 303, 288, 248, 269,
+	357, 298, 358, 270, 320, 319, 321, 0, 191, 0,
+	398, 434, 464, 209, 210, 211, 0, 247, 251, 257,
+	259, 265, 266, 273, 291, 335, 356, 354, 360, 0,
+	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
+	457, 458, 459, 323, 272, 394, 287, 296, 0, 0,
+	341, 373, 214, 432, 395, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 184, 198, 292, 0, 361,
+	255, 461, 439, 435, 0, 0, 231, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
+	187, 199, 207, 217, 230, 245, 253, 263, 268, 271,
+	275, 276, 279, 284, 301, 306, 307, 308, 309, 325,
+	326, 327, 330, 333, 334, 337, 339, 340, 343, 349,
+	350, 351, 352, 353, 355, 362, 367, 375, 376, 377,
+	378, 379, 381, 382, 388, 389, 390, 391, 399, 403,
+	419, 420, 431, 443, 448, 223, 363, 384, 385, 264,
+	427, 449, 0, 300, 0, 0, 302, 249, 267, 277,
+	0, 438, 400, 203, 369, 256, 192, 220, 206, 228,
+	243, 246, 281, 310, 316, 345, 348, 261, 240, 218,
+	366, 215, 386, 406, 407, 408, 410, 314, 235, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 332, 0, 1299, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 290, 236, 0, 0, 346,
+	0, 190, 0, 387, 224, 299, 297, 416, 250, 242,
+	238, 222, 274, 305, 344, 405, 338, 0, 294, 0,
+	0, 396, 317, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 280, 221,
+	189, 329, 397, 254, 0, 0, 0, 0, 181, 182,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 212,
+	0, 219, 0, 0, 0, 0, 234, 278, 241, 233,
+	413, 0, 0, 0, 0, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 318, 0, 0, 0, 0, 0, 445, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 286, 185,
+	201, 0, 0, 328, 368, 374, 0, 0, 0, 225,
+	0, 372, 342, 430, 208, 252, 365, 347, 370, 0,
+	0, 371, 295, 418, 359, 428, 446, 447, 232, 322,
+	436, 409, 442, 460, 202, 229, 336, 402, 433, 393,
+	315, 414, 415, 285, 392, 260, 188, 293, 452, 200,
+	380, 216, 193, 404, 426, 213, 383, 0, 0, 462,
+	195, 424, 401, 312, 282, 283, 194, 0, 364, 237,
+	258, 227, 331, 421, 422, 226, 463, 204, 441, 197,
+	0, 440, 324, 417, 425, 313, 304, 196, 423, 311,
+	303, 288, 248, 269, 357, 298, 358, 270, 320, 319,
+	321, 0, 191, 0, 398, 434, 464, 209, 210, 211,
+	0, 247, 251, 257, 259, 265, 266, 273, 291, 335,
+	356, 354, 360, 0, 412, 429, 437, 444, 450, 451,
+	453, 454, 455, 456, 457, 458, 459, 323, 272, 394,
+	287, 296, 0, 0, 341, 373, 214, 432, 395, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 184,
+	198, 292, 0, 361, 255, 461, 439, 435, 0, 0,
+	231, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 186, 187, 199, 207, 217, 230, 245,
+	253, 263, 268, 271, 275, 276, 279, 284, 301, 306,
+	307, 308, 309, 325, 326, 327, 330, 333, 334, 337,
+	339, 340, 343, 349, 350, 351, 352, 353, 355, 362,
+	367, 375, 376, 377, 378, 379, 381, 382, 388, 389,
+	390, 391, 399, 403, 419, 420, 431, 443, 448, 223,
+	363, 384, 385, 264, 427, 449, 0, 300, 0, 0,
+	302, 249, 267, 277, 0, 438, 400, 203, 369, 256,
+	192, 220, 206, 228, 243, 246, 281, 310, 316, 345,
+	348, 261, 240, 218, 366, 215, 386, 406, 407, 408,
+	410, 314, 235, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 239, 0, 0, 0, 0, 290,
+	236, 0, 0, 346, 0, 190, 0, 387, 224, 299,
+	297, 416, 250, 242, 238, 222, 274, 305, 344, 405,
+	338, 0, 294, 0, 0, 396, 317, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 280, 221, 189, 329, 397, 254, 0, 1274,
+	0, 0, 181, 182, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
+	234, 278, 241, 233, 413, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 318, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 0, 0, 0,
+	289, 0, 286, 185, 201, 0, 0, 328, 368, 374,
+	0, 0, 0, 225, 0, 372, 342, 430, 208, 252,
+	365, 347, 370, 0, 0, 371, 295, 418, 359, 428,
+	446, 447, 232, 322, 436, 409, 442, 460, 202, 229,
+	336, 402, 433, 393, 315, 414, 415, 285, 392, 260,
+	188, 293, 452, 200, 380, 216, 193, 404, 426, 213,
+	383, 0, 0, 462, 195, 424, 401, 312, 282, 283,
+	194, 0, 364, 237, 258, 227, 331, 421, 422, 226,
+	463, 204, 441, 197, 0, 440, 324, 417, 425, 313,
+	304, 196, 423, 311, 303, 288, 248, 269, 357, 298,
+	358, 270, 320, 319, 321, 0, 191, 0, 398, 434,
+	464, 209, 210, 211, 0, 247, 251, 257, 259, 265,
+	266, 273, 291, 335, 356, 354, 360, 0, 412, 429,
+	437, 444, 450, 451, 453, 454, 455, 456, 457, 458,
+	459, 323, 272, 394, 287, 296, 0, 0, 341, 373,
+	214, 432, 395, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 184, 198, 292, 0, 361, 255, 461,
+	439, 435, 0, 0, 231, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 187, 199,
+	207, 217, 230, 245, 253, 263, 268, 271, 275, 276,
+	279, 284, 301, 306, 307, 308, 309, 325, 326, 327,
+	330, 333, 334, 337, 339, 340, 343, 349, 350, 351,
+	352, 353, 355, 362, 367, 375, 376, 377, 378, 379,
+	381, 382, 388, 389, 390, 391, 399, 403, 419, 420,
+	431, 443, 448, 223, 363, 384, 385, 264, 427, 449,
+	0, 300, 0, 0, 302, 249, 267, 277, 0, 438,
+	400, 203, 369, 256, 192, 220, 206, 228, 243, 246,
+	281, 310, 316, 345, 348, 261, 240, 218, 366, 215,
+	386, 406, 407, 408, 410, 314, 235, 411, 0, 0,
+	0, 0, 1175, 0, 0, 0, 0, 0, 0, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 239, 0,
+	0, 0, 0, 290, 236, 0, 0, 346, 0, 190,
+	0, 387, 224, 299, 297, 416, 250, 242, 238, 222,
+	274, 305, 344, 405, 338, 0, 294, 0, 0, 396,
+	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 280, 221, 189, 329,
+	397, 254, 0, 0, 0, 0, 181, 182, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 212, 0, 219,
+	0, 0, 0, 0, 234, 278, 241, 233, 413, 0,
+	0, 0, 0, 205, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	318, 0, 0, 0, 0, 0, 445, 0, 0, 0,
+	0, 0, 0, 0, 289, 0, 286, 185, 201, 0,
+	0, 328, 368, 374, 0, 0, 0, 225, 0, 372,
+	342, 430, 208, 252, 365, 347, 370, 0, 0, 371,
+	295, 418, 359, 428, 446, 447, 232, 322, 436, 409,
+	442, 460, 202, 229, 336, 402, 433, 393, 315, 414,
+	415, 285, 392, 260, 188, 293, 452, 200, 380, 216,
+	193, 404, 426, 213, 383, 0, 0, 462, 195, 424,
+	401, 312, 282, 283, 194, 0, 364, 237, 258, 227,
+	331, 421, 422, 226, 463, 204, 441, 197, 0, 440,
+	324, 417, 425, 313, 304, 196, 423, 311, 303, 288,
+	248, 269, 357, 298, 358, 270, 320, 319, 321, 0,
+	191, 0, 398, 434, 464, 209, 210, 211, 0, 247,
+	251, 257, 259, 265, 266, 273, 291, 335, 356, 354,
+	360, 0, 412, 429, 437, 444, 450, 451, 453, 454,
+	455, 456, 457, 458, 459, 323, 272, 394, 287, 296,
+	0, 0, 341, 373, 214, 432, 395, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 184, 198, 292,
+	0, 361, 255, 461, 439, 435, 0, 0, 231, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 187, 199, 207, 217, 230, 245, 253, 263,
+	268, 271, 275, 276, 279, 284, 301, 306, 307, 308,
+	309, 325, 326, 327, 330, 333, 334, 337, 339, 340,
+	343, 349, 350, 351, 352, 353, 355, 362, 367, 375,
+	376, 377, 378, 379, 381, 382, 388, 389, 390, 391,
+	399, 403, 419, 420, 431, 443, 448, 223, 363, 384,
+	385, 264, 427, 449, 0, 300, 0, 0, 302, 249,
+	267, 277, 0, 438, 400, 203, 369, 256, 192, 220,
+	206, 228, 243, 246, 281, 310, 316, 345, 348, 261,
+	240, 218, 366, 215, 386, 406, 407, 408, 410, 314,
+	235, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 1166, 239, 0, 0, 0, 0, 290, 236, 0,
+	0, 346, 0, 190, 0, 387, 224, 299, 297, 416,
+	250, 242, 238, 222, 274, 305, 344, 405, 338, 0,
+	294, 0, 0, 396, 317, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	280, 221, 189, 329, 397, 254, 0, 0, 0, 0,
+	181, 182, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 212, 0, 219, 0, 0, 0, 0, 234, 278,
+	241, 233, 413, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 0, 318, 0, 0, 0, 0, 0,
+	445, 0, 0, 0, 0, 0, 0, 0, 289, 0,
+	286, 185, 201, 0, 0, 328, 368, 374, 0, 0,
+	0, 225, 0, 372, 342, 430, 208, 252, 365, 347,
+	370, 0, 0, 371, 295, 418, 359, 428, 446, 447,
+	232, 322, 436, 409, 442, 460, 202, 229, 336, 402,
+	433, 393, 315, 414, 415, 285, 392, 260, 188, 293,
+	452, 200, 380, 216, 193, 404, 426, 213, 383, 0,
+	0, 462, 195, 424, 401, 312, 282, 283, 194, 0,
+	364, 237, 258, 227, 331, 421, 422, 226, 463, 204,
+	441, 197, 0, 440, 324, 417, 425, 313, 304, 196,
+	423, 311, 303, 288, 248, 269, 357, 298, 358, 270,
+	320, 319, 321, 0, 191, 0, 398, 434, 464, 209,
+	210, 211, 0, 247, 251, 257, 259, 265, 266, 273,
+	291, 335, 356, 354, 360, 0, 412, 429, 437, 444,
+	450, 451, 453, 454, 455, 456, 457, 458, 459, 323,
+	272, 394, 287, 296, 0, 0, 341, 373, 214, 432,
+	395, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 184, 198, 292, 0, 361, 255, 461, 439, 435,
+	0, 0, 231, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 186, 187, 199, 207, 217,
+	230, 245, 253, 263, 268, 271, 275, 276, 279, 284,
+	301, 306, 307, 308, 309, 325, 326, 327, 330, 333,
+	334, 337, 339, 340, 343, 349, 350, 351, 352, 353,
+	355, 362, 367, 375, 376, 377, 378, 379, 381, 382,
+	388, 389, 390, 391, 399, 403, 419, 420, 431, 443,
+	448, 223, 363, 384, 385, 264, 427, 449, 0, 300,
+	0, 0, 302, 249, 267, 277, 0, 438, 400, 203,
+	369, 256, 192, 220, 206, 228, 243, 246, 281, 310,
+	316, 345, 348, 261, 240, 218, 366, 215, 386, 406,
+	407, 408, 410, 314, 235, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 239, 0, 0, 0,
+	0, 290, 236, 0, 0, 346, 0, 190, 0, 387,
+	224, 299, 297, 416, 250, 242, 238, 222, 274, 305,
+	344, 405, 338, 0, 294, 0, 0, 396, 317, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 280, 221, 189, 329, 397, 254,
+	0, 0, 0, 0, 181, 182, 183, 0, 994, 0,
+	0, 0, 0, 0, 0, 212, 0, 219, 0, 0,
+	0, 0, 234, 278, 241, 233, 413, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 318, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 0,
+	0, 0, 289, 0, 286, 185, 201, 0, 0, 328,
+	368, 374, 0, 0, 0, 225, 0, 372, 342, 430,
+	208, 252, 365, 347, 370, 0, 0, 371, 295, 418,
+	359, 428, 446, 447, 232, 322, 436, 409, 442, 460,
+	202, 229, 336, 402, 433, 393, 315, 414, 415, 285,
+	392, 260, 188, 293, 452, 200, 380, 216, 193, 404,
+	426, 213, 383, 0, 0, 462, 195, 424, 401, 312,
+	282, 283, 194, 0, 364, 237, 258, 227, 331, 421,
+	422, 226, 463, 204, 441, 197, 0, 440, 324, 417,
+	425, 313, 304, 196, 423, 311, 303, 288, 248, 269,
+	357, 298, 358, 270, 320, 319, 321, 0, 191, 0,
+	398, 434, 464, 209, 210, 211, 0, 247, 251, 257,
+	259, 265, 266, 273, 291, 335, 356, 354, 360, 0,
+	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
+	457, 458, 459, 323, 272, 394, 287, 296, 0, 0,
+	341, 373, 214, 432, 395, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 184, 198, 292, 0, 361,
+	255, 461, 439, 435, 0, 0, 231, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
+	187, 199, 207, 217, 230, 245, 253, 263, 268, 271,
+	275, 276, 279, 284, 301, 306, 307, 308, 309, 325,
+	326, 327, 330, 333, 334, 337, 339, 340, 343, 349,
+	350, 351, 352, 353, 355, 362, 367, 375, 376, 377,
+	378, 379, 381, 382, 388, 389, 390, 391, 399, 403,
+	419, 420, 431, 443, 448, 223, 363, 384, 385, 264,
+	427, 449, 0, 300, 0, 0, 302, 249, 267, 277,
+	0, 438, 400, 203, 369, 256, 192, 220, 206, 228,
+	243, 246, 281, 310, 316, 345, 348, 261, 240, 218,
+	366, 215, 386, 406, 407, 408, 410, 314, 235, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 290, 236, 0, 0, 346,
+	0, 190, 0, 387, 224, 299, 297, 416, 250, 242,
+	238, 222, 274, 305, 344, 405, 338, 0, 294, 0,
+	0, 396, 317, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 280, 221,
+	189, 329, 397, 254, 0, 0, 0, 0, 181, 182,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 212,
+	0, 219, 0, 0, 0, 0, 234, 278, 241, 233,
+	413, 0, 0, 0, 0, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 318, 0, 0, 0, 0, 0, 445, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 286, 185,
+	201, 0, 0, 328, 368, 374, 0, 0, 0, 225,
+	0, 372, 342, 430, 208, 252, 365, 347, 370, 0,
+	0, 371, 295, 418, 359, 428, 446, 447, 232, 322,
+	436, 409, 442, 460, 202, 229, 336, 402, 433, 393,
+	315, 414, 415, 285, 392, 260, 188, 293, 452, 200,
+	380, 216, 193, 404, 426, 213, 383, 0, 0, 462,
+	195, 424, 401, 312, 282, 283, 194, 0, 364, 237,
+	258, 227, 331, 421, 422, 226, 463, 204, 441, 197,
+	0, 440, 324, 417, 425, 313, 304, 196, 423, 311,
+	303, 288, 248, 269, 357, 298, 358, 270, 320, 319,
+	321, 0, 191, 0, 398, 434, 464, 209, 210, 211,
+	0, 247, 251, 257, 259, 265, 266, 273, 291, 335,
+	356, 354, 360, 0, 412, 429, 437, 444, 450, 451,
+	453, 454, 455, 456, 457, 458, 459, 323, 272, 394,
+	287, 296, 0, 0, 341, 373, 214, 432, 395, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 184,
+	198, 292, 0, 361, 255, 461, 439, 435, 0, 0,
+	231, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 630,
+	0, 0, 0, 186, 187, 199, 207, 217, 230, 245,
+	253, 263, 268, 271, 275, 276, 279, 284, 301, 306,
+	307, 308, 309, 325, 326, 327, 330, 333, 334, 337,
+	339, 340, 343, 349, 350, 351, 352, 353, 355, 362,
+	367, 375, 376, 377, 378, 379, 381, 382, 388, 389,
+	390, 391, 399, 403, 419, 420, 431, 443, 448, 223,
+	363, 384, 385, 264, 427, 449, 0, 300, 0, 0,
+	302, 249, 267, 277, 0, 438, 400, 203, 369, 256,
+	192, 220, 206, 228, 243, 246, 281, 310, 316, 345,
+	348, 261, 240, 218, 366, 215, 386, 406, 407, 408,
+	410, 314, 235, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 239, 0, 0, 0, 0, 290,
+	236, 0, 0, 346, 0, 190, 0, 387, 224, 299,
+	297, 416, 250, 242, 238, 222, 274, 305, 344, 405,
+	338, 0, 294, 0, 0, 396, 317, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 280, 221, 189, 329, 397, 254, 0, 0,
+	0, 0, 181, 182, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
+	234, 278, 241, 233, 413, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 523, 0, 262, 0, 318, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 0, 0, 0,
+	289, 0, 286, 185, 201, 0, 0, 328, 368, 374,
+	0, 0, 0, 225, 0, 372, 342, 430, 208, 252,
+	365, 347, 370, 0, 0, 371, 295, 418, 359, 428,
+	446, 447, 232, 322, 436, 409, 442, 460, 202, 229,
+	336, 402, 433, 393, 315, 414, 415, 285, 392, 260,
+	188, 293, 452, 200, 380, 216, 193, 404, 426, 213,
+	383, 0, 0, 462, 195, 424, 401, 312, 282, 283,
+	194, 0, 364, 237, 258, 227, 331, 421, 422, 226,
+	463, 204, 441, 197, 0, 440, 324, 417, 425, 313,
+	304, 196, 423, 311, 303, 288, 248, 269, 357, 298,
+	358, 270, 320, 319, 321, 0, 191, 0, 398, 434,
+	464, 209, 210, 211, 0, 247, 251, 257, 259, 265,
+	266, 273, 291, 335, 356, 354, 360, 0, 412, 429,
+	437, 444, 450, 451, 453, 454, 455, 456, 457, 458,
+	459, 323, 272, 394, 287, 296, 0, 0, 341, 373,
+	214, 432, 395, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 184, 198, 292, 0, 361, 255, 461,
+	439, 435, 0, 0, 231, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 187, 199,
+	207, 217, 230, 245, 253, 263, 268, 271, 275, 276,
+	279, 284, 301, 306, 307, 308, 309, 325, 326, 327,
+	330, 333, 334, 337, 339, 340, 343, 349, 350, 351,
+	352, 353, 355, 362, 367, 375, 376, 377, 378, 379,
+	381, 382, 388, 389, 390, 391, 399, 403, 419, 420,
+	431, 443, 448, 223, 363, 384, 385, 522, 427, 449,
+	0, 300, 0, 0, 302, 249, 267, 277, 0, 438,
+	400, 203, 369, 256, 192, 220, 206, 228, 243, 246,
+	281, 310, 316, 345, 348, 261, 240, 218, 366, 215,
+	386, 406, 407, 408, 410, 314, 235, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 239, 0,
+	0, 0, 0, 290, 236, 0, 0, 346, 0, 190,
+	0, 387, 224, 299, 297, 416, 250, 242, 238, 222,
+	274, 305, 344, 405, 338, 0, 294, 0, 0, 396,
+	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 280, 221, 189, 329,
+	397, 254, 0, 0, 0, 0, 181, 182, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 212, 0, 219,
+	0, 0, 0, 0, 234, 278, 241, 233, 413, 0,
+	0, 0, 0, 205, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	318, 0, 0, 0, 467, 0, 445, 0, 0, 0,
+	0, 0, 0, 0, 289, 0, 286, 185, 201, 0,
+	0, 328, 368, 374, 0, 0, 0, 225, 0, 372,
+	342, 430, 208, 252, 365, 347, 370, 0, 0, 371,
+	295, 418, 359, 428, 446, 447, 232, 322, 436, 409,
+	442, 460, 202, 229, 336, 402, 433, 393, 315, 414,
+	415, 285, 392, 260, 188, 293, 452, 200, 380, 216,
+	193, 404, 426, 213, 383, 0, 0, 462, 195, 424,
+	401, 312, 282, 283, 194, 0, 364, 237, 258, 227,
+	331, 421, 422, 226, 463, 204, 441, 197, 0, 440,
+	324, 417, 425, 313, 304, 196, 423, 311, 303, 288,
+	248, 269, 357, 298, 358, 270, 320, 319, 321, 0,
+	191, 0, 398, 434, 464, 209, 210, 211, 0, 247,
+	251, 257, 259, 265, 266, 273, 291, 335, 356, 354,
+	360, 0, 412, 429, 437, 444, 450, 451, 453, 454,
+	455, 456, 457, 458, 459, 323, 272, 394, 287, 296,
+	0, 0, 341, 373, 214, 432, 395, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 184, 198, 292,
+	0, 361, 255, 461, 439, 435, 0, 0, 231, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 187, 199, 207, 217, 230, 245, 253, 263,
+	268, 271, 275, 276, 279, 284, 301, 306, 307, 308,
+	309, 325, 326, 327, 330, 333, 334, 337, 339, 340,
+	343, 349, 350, 351, 352, 353, 355, 362, 367, 375,
+	376, 377, 378, 379, 381, 382, 388, 389, 390, 391,
+	399, 403, 419, 420, 431, 443, 448, 223, 363, 384,
+	385, 264, 427, 449, 0, 300, 0, 0, 302, 249,
+	267, 277, 0, 438, 400, 203, 369, 256, 192, 220,
+	206, 228, 243, 246, 281, 310, 316, 345, 348, 261,
+	240, 218, 366, 215, 386, 406, 407, 408, 410, 314,
+	235, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 239, 0, 0, 0, 0, 290, 236, 0,
+	0, 346, 0, 190, 0, 387, 224, 299, 297, 416,
+	250, 242, 238, 222, 274, 305, 344, 405, 338, 0,
+	294, 0, 0, 396, 317, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	280, 221, 189, 329, 397, 254, 0, 0, 0, 0,
+	181, 182, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 212, 0, 219, 0, 0, 0, 0, 234, 278,
+	241, 233, 413, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 0, 318, 0, 0, 0, 0, 0,
+	445, 0, 0, 0, 0, 0, 0, 0, 289, 0,
+	286, 185, 201, 0, 0, 328, 368, 374, 0, 0,
+	0, 225, 0, 372, 342, 430, 208, 252, 365, 347,
+	370, 0, 0, 371, 295, 418, 359, 428, 446, 447,
+	232, 322, 436, 409, 442, 460, 202, 229, 336, 402,
+	433, 393, 315, 414, 415, 285, 392, 260, 188, 293,
+	452, 200, 380, 216, 193, 404, 426, 213, 383, 0,
+	0, 462, 195, 424, 401, 312, 282, 283, 194, 0,
+	364, 237, 258, 227, 331, 421, 422, 226, 463, 204,
+	441, 197, 0, 440, 324, 417, 425, 313, 304, 196,
+	423, 311, 303, 288, 248, 269, 357, 298, 358, 270,
+	320, 319, 321, 0, 191, 0, 398, 434, 464, 209,
+	210, 211, 0, 247, 251, 257, 259, 265, 266, 273,
+	291, 335, 356, 354, 360, 0, 412, 429, 437, 444,
+	450, 451, 453, 454, 455, 456, 457, 458, 459, 323,
+	272, 394, 287, 296, 0, 0, 341, 373, 214, 432,
+	395, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 184, 198, 292, 0, 361, 255, 461, 439, 435,
+	0, 0, 231, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 186, 187, 199, 207, 217,
+	230, 245, 253, 263, 268, 271, 275, 276, 279, 284,
+	301, 306, 307, 308, 309, 325, 326, 327, 330, 333,
+	334, 337, 339, 340, 343, 349, 350, 351, 352, 353,
+	355, 362, 367, 375, 376, 377, 378, 379, 381, 382,
+	388, 389, 390, 391, 399, 403, 419, 420, 431, 443,
+	448, 223, 363, 384, 385, 264, 427, 449, 0, 300,
+	0, 0, 302, 249, 267, 277, 0, 438, 400, 203,
+	369, 256, 192, 220, 206, 228, 243, 246, 281, 310,
+	316, 345, 348, 261, 240, 218, 366, 215, 386, 406,
+	407, 408, 410, 314, 235,
 }
 
 var yyPact = [...]int{
-	2767, -1000, -338, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	3809, -1000, -331, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1706, 1722, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 651, 1397, -1000, 1670, 236, -1000, 29332, 460,
-	-1000, 28855, 456, 444, 29332, -1000, 139, -1000, 129, 29332,
-	132, 28378, -1000, -1000, -264, 12635, 1615, 19, -5, 29332,
-	-1000, 1787, -1000, 1374, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1690, 1683, 1809, 573, 1603, -1000, 1725,
-	1430, -1000, 12635, 1772, 1723, 10726, -1000, 10726, 374, 374,
-	374, 8818, -1000, -1000, 16929, 29332, 29332, 143, -1000, 1670,
-	-1000, -1000, 272, -1000, 289, 1333, -1000, 1328, -1000, 489,
-	382, 286, 357, 356, 281, 280, 279, 278, 277, 276,
-	275, 273, 295, -1000, 639, 639, -140, -141, 2220, 367,
-	367, 367, 397, 1633, 1632, -1000, 628, -1000, 639, 639,
-	255, 639, 639, 639, 639, 225, 217, 639, 639, 639,
-	639, 639, 639, 639, 639, 639, 639, 639, 639, 639,
-	639, 639, 224, 1670, 204, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1582, 1589, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 686, 1346, -1000, 1557, 3429, -1000, 30754,
+	452, -1000, 30270, 451, 3844, 30754, -1000, 151, -1000, 128,
+	30754, 144, 29786, -1000, -1000, -257, 13328, 1513, 35, 31,
+	30754, -1000, -183, 1673, 1379, -1000, 275, -1000, -1000, -1000,
+	-1000, -1000, -1000, 29302, -1000, -1000, -1000, 1546, 1551, 1696,
+	573, 1514, -1000, 1613, 1379, -1000, 13328, 1638, 1599, 11876,
+	-1000, 11876, 340, 340, 340, 9455, -1000, -1000, 18170, 30754,
+	30754, 226, -1000, 1557, -1000, -1000, 278, -1000, 276, 1303,
+	-1000, 1299, -1000, 522, 400, 287, 370, 368, 286, 285,
+	284, 283, 280, 277, 273, 272, 282, -1000, 638, 638,
+	-99, -128, 332, 330, 330, 330, 395, 1531, 1530, -1000,
+	540, -1000, 638, 638, 246, 638, 638, 638, 638, 222,
+	218, 638, 638, 638, 638, 638, 638, 638, 638, 638,
+	638, 638, 638, 638, 638, 638, 263, 1557, 204, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4149,27 +4306,30 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 29332, 94, 29332, -1000, 530,
-	29332, 641, 641, 20, 641, 641, 641, 641, 131, 510,
-	-7, -1000, 128, 223, 109, 198, 696, 239, 86, -1000,
-	-1000, 192, 696, 1019, 597, 106, -1000, 641, 6902, 6902,
-	6902, -1000, 1653, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 392, -1000, -1000, -1000, -1000, 29332, 27901, 301, 655,
-	-1000, -1000, -1000, 15, -1000, -1000, 1150, 754, -1000, 12635,
-	3006, 1137, 1137, -1000, -1000, 500, -1000, -1000, 14066, 14066,
-	14066, 14066, 14066, 14066, 14066, 14066, 14066, 14066, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1137, 526, -1000, -1000, 1137, 1137, 1137, 1137,
-	1137, 1137, 1137, 1137, 12635, 1137, 1137, 1137, 1137, 1137,
-	1137, 1137, 1137, 1137, 1137, 1137, 1137, 1137, 1137, 1137,
-	1137, 1137, -1000, -1000, 12158, -1000, 29332, -1000, 1137, 147,
-	1725, 1430, -1000, 1759, 1456, 1129, 1026, -1000, -1000, -1000,
-	1537, 975, 1017, -1000, -1000, -1000, -1000, -1000, 1374, 978,
-	12635, -1000, -1000, 1796, -1000, 15497, 524, 788, 1794, 27424,
-	-1000, 20746, 26947, 1326, 8339, -75, -1000, -1000, -1000, 653,
-	19315, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1653, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 30754, 192, 30754, -1000, 533,
+	30754, 716, 716, 76, 716, 716, 716, 716, 137, 498,
+	22, -1000, 131, 208, 157, 201, 711, 170, 101, -1000,
+	-1000, 198, 711, 1059, 592, 145, -1000, 716, 716, 7511,
+	7511, 7511, -1000, 1542, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 392, -1000, -1000, -1000, -1000, 30754,
+	28818, 223, 668, -1000, -1000, -1000, 78, -1000, -1000, 1245,
+	724, -1000, 13328, 2374, 1305, 1305, -1000, -1000, 502, -1000,
+	-1000, 14780, 14780, 14780, 14780, 14780, 14780, 14780, 14780, 14780,
+	14780, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1305, 531, -1000, -1000,
+	1305, 1305, 1305, 1305, 1305, 1305, 1305, 1305, 13328, 1305,
+	1305, 1305, 1305, 1305, 1305, 1305, 1305, 1305, 1305, 1305,
+	1305, 1305, 1305, 1305, 1305, 1305, 1305, 1305, 1305, 1305,
+	1305, 1305, 1305, 1305, 1305, 1305, 1305, -1000, -1000, 10908,
+	-1000, 30754, -1000, 1305, 158, 962, -1000, -1000, 1613, 1379,
+	-1000, 1673, 1694, -1000, 275, 1689, 1163, 1153, 1079, 1277,
+	30754, -1000, 1308, -1000, -1000, -1000, 1433, 1024, 1056, -1000,
+	-1000, -1000, -1000, 827, 13328, -1000, -1000, 1684, -1000, 16232,
+	530, 823, 1679, 28334, -1000, 21558, 27850, 1298, 8969, 5,
+	-1000, -1000, -1000, 666, 20106, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1542, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4181,197 +4341,210 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1285, 29332, -1000, -1000, 2369,
-	1016, -1000, 1396, -1000, 1246, -1000, 1376, 1425, 451, 1016,
-	424, 420, 417, -1000, -96, -1000, -1000, -1000, -1000, -1000,
-	639, 639, 292, 236, 4097, -1000, -1000, -1000, 26470, 1395,
-	1016, -1000, 1393, -1000, 709, 436, 467, 467, 1016, -1000,
-	-1000, 29332, 1016, 707, 706, 29332, 29332, -1000, 25993, -1000,
-	25516, 25039, 913, 29332, 24562, 24085, 23608, 23131, 22654, -1000,
-	1544, -1000, 1408, -1000, -1000, -1000, 29332, 29332, 29332, 261,
-	-1000, -1000, 29332, 1016, -1000, -1000, 911, 908, 639, 639,
-	905, 1010, 1009, 1001, 639, 639, 904, 996, 21223, 199,
-	902, 893, 882, 914, 995, 127, 898, 869, 878, 29332,
-	1392, 29332, -1000, 187, 591, 293, 645, 1670, 1610, 1323,
-	391, 435, 1016, 370, 370, -1000, 7381, -1000, -1000, 982,
-	12635, -1000, 726, 696, 696, -1000, -1000, -1000, -1000, -1000,
-	-1000, 641, 29332, 726, -1000, -1000, -1000, 696, 641, 29332,
-	641, 641, 641, 641, 696, 696, 696, 641, 29332, 29332,
-	29332, 29332, 29332, 29332, 29332, 29332, 29332, 6902, 6902, 6902,
-	597, 641, -269, -1000, 956, -1000, 1481, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 117, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -93, 1322, 22177, -1000, -270,
-	-272, -276, -281, -1000, -1000, -1000, -282, -285, -1000, -1000,
-	-1000, 12635, 12635, 12635, 12635, 807, 602, 14066, 828, 731,
-	14066, 14066, 14066, 14066, 14066, 14066, 14066, 14066, 14066, 14066,
-	14066, 14066, 14066, 14066, 14066, 604, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1016, -1000, 1444, 1444, 496, 496,
-	496, 496, 496, 496, 496, 496, 496, 14543, 9295, 7381,
-	10726, 10726, 12635, 12635, 11680, 11203, 10726, 1642, 678, 754,
-	29332, -1000, 1026, -1000, -1000, 13589, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 29332, 29332,
-	10726, 10726, 10726, 10726, 10726, 1136, 1112, 1229, -1000, 1305,
-	-1000, -164, 16452, 12635, 954, -1000, -1000, -1000, 1541, 10726,
-	-1000, -1000, 1660, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1124, -1000, 1530, 952, 261, -1000, 1660, 1136, 12635,
-	12635, 1293, -1000, 1110, 29332, -1000, -1000, 21700, -1000, -1000,
-	6423, -1000, 29332, 268, 29332, -1000, 1212, 1623, -1000, -1000,
-	-1000, 1718, 18361, 29332, 1356, 1309, -1000, -1000, 522, 7860,
-	-75, -1000, 7860, 1236, -1000, -26, -10, 9772, 478, -1000,
-	-1000, -1000, 2220, 15020, 1102, 1622, 74, -1000, -1000, -1000,
-	1376, -1000, 1376, 1376, 1376, 1376, 261, 261, 261, 261,
-	-1000, -1000, -1000, -1000, -1000, 1390, 1388, -1000, 1376, 1376,
-	1376, 1376, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1387,
-	1387, 1387, 1377, 1377, 362, -1000, 12635, 164, 29332, 1679,
-	874, 187, 371, 1449, 1016, 1016, 1016, 371, -1000, 1002,
-	980, -1000, 1290, -1000, -1000, 1771, -1000, -1000, 396, 768,
-	767, 473, 29332, 160, 266, -1000, 351, -1000, 29332, 1016,
-	705, 467, 1016, -1000, 1016, -1000, -1000, -1000, -1000, 521,
-	-1000, -1000, 1016, 1289, -1000, 1185, 795, 722, 766, 717,
-	1289, -1000, -1000, -119, 1289, -1000, 1289, -1000, 1289, -1000,
-	1289, -1000, 1289, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 610, 29332, 160, 604, -1000, 389, -1000, -1000, 604,
-	604, -1000, -1000, -1000, -1000, 951, 948, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1219,
+	30754, -1000, -1000, 3141, 1005, -1000, 1343, -1000, 1217, -1000,
+	1314, 1359, 439, 1005, 424, 419, 418, -1000, -44, -1000,
+	-1000, -1000, -1000, -1000, 638, 638, 268, 3429, 4653, -1000,
+	-1000, -1000, 27366, 1338, 1005, -1000, 1337, -1000, 749, 405,
+	464, 464, 1005, -1000, -1000, 30754, 1005, 747, 744, 30754,
+	30754, -1000, 26882, -1000, 26398, 25914, 960, 30754, 25430, 24946,
+	24462, 23978, 23494, -1000, 1403, -1000, 1344, -1000, -1000, -1000,
+	30754, 30754, 30754, 279, -1000, -1000, 30754, 1005, -1000, -1000,
+	957, 953, 638, 638, 948, 1055, 1051, 1037, 638, 638,
+	945, 1036, 22042, 232, 927, 924, 919, 1017, 1032, 138,
+	973, 965, 901, 30754, 1336, 30754, -1000, 181, 564, 334,
+	648, 1557, 1498, 1283, 358, 432, 1005, 333, 333, -1000,
+	7997, -1000, -1000, 1031, 13328, -1000, 712, 711, 711, -1000,
+	-1000, -1000, -1000, -1000, -1000, 716, 30754, 712, -1000, -1000,
+	-1000, 711, 716, 30754, 716, 716, 716, 716, 711, 711,
+	711, 716, 30754, 30754, 30754, 30754, 30754, 30754, 30754, 30754,
+	30754, 7511, 7511, 7511, 592, 716, -262, -1000, 1028, -1000,
+	1391, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	139, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-82, 1280, 23010, -1000, -263, -264, -268, -273, -1000, -1000,
+	-1000, -275, -279, -1000, -1000, -1000, 13328, 13328, 13328, 13328,
+	958, 599, 14780, 933, 655, 14780, 14780, 14780, 14780, 14780,
+	14780, 14780, 14780, 14780, 14780, 14780, 14780, 14780, 14780, 14780,
+	632, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1005,
+	-1000, 1061, 1061, 548, 548, 548, 548, 548, 548, 548,
+	548, 548, 15264, 9939, 7997, 11876, 11876, 13328, 13328, 12844,
+	12360, 11876, 1544, 702, 724, 30754, -1000, 1079, -1000, -1000,
+	14296, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 30754, 30754, 11876, 11876, 11876, 11876, 11876,
+	1079, 1079, 1079, 1079, 1079, 13328, 13328, 13328, 13328, 13328,
+	13328, 1184, 1183, 1215, -1000, 1279, -1000, -149, 17686, 13328,
+	1027, -1000, -1000, -1000, -1000, 1613, 1562, 1184, 1410, 11876,
+	-1000, -1000, 1562, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1212, -1000, 30754, 1277, 1594, 30754, 1431, 1025, 279,
+	-1000, 13328, 13328, 1272, -1000, 773, 30754, -1000, -1000, 22526,
+	-1000, -1000, 7025, -1000, 30754, 269, 30754, -1000, 1250, 1479,
+	-1000, -1000, -1000, 1592, 17202, 30754, 1262, 1235, -1000, -1000,
+	526, 8483, 5, -1000, 8483, 1255, -1000, -2, -7, 10423,
+	544, -1000, -1000, -1000, 332, 15748, 1148, 1520, 70, -1000,
+	-1000, -1000, 1314, -1000, 1314, 1314, 1314, 1314, 279, 279,
+	279, 279, -1000, -1000, -1000, -1000, -1000, 1335, 1334, -1000,
+	1314, 1314, 1314, 1314, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1333, 1333, 1333, 1319, 1319, 320, -1000, 13328, 213,
+	30754, 1564, 890, 181, 338, 1368, 1005, 1005, 1005, 338,
+	-1000, 1023, 1010, -1000, 1270, -1000, -1000, 1637, -1000, -1000,
+	645, 804, 796, 496, 30754, 163, 256, -1000, 313, -1000,
+	30754, 1005, 741, 464, 1005, -1000, 1005, -1000, -1000, -1000,
+	-1000, 523, -1000, -1000, 1005, 1269, -1000, 1259, 825, 766,
+	802, 759, 1269, -1000, -1000, -66, 1269, -1000, 1269, -1000,
+	1269, -1000, 1269, -1000, 1269, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 603, 30754, 163, 632, -1000, 356, -1000,
+	-1000, 632, 632, -1000, -1000, -1000, -1000, 1015, 1014, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -326, 29332, -1000, 184, 644, 247,
-	282, 222, 29332, 150, 1732, 201, 212, 29332, 29332, 370,
-	1480, 29332, 1707, 29332, -1000, -1000, -1000, -1000, 754, 29332,
-	-1000, -1000, 641, 641, -1000, -1000, 29332, 641, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 641, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 29332, 29332, -1000, -1000, -1000, -1000,
-	-1000, 61, -43, 215, -1000, -1000, -1000, -1000, 1737, -1000,
-	754, 602, 671, 611, -1000, -1000, 817, -1000, -1000, 2525,
-	-1000, -1000, -1000, -1000, 828, 14066, 14066, 14066, 909, 2525,
-	3039, 1280, 1773, 496, 699, 699, 560, 560, 560, 560,
-	560, 713, 713, -1000, -1000, -1000, -1000, 1026, -1000, -1000,
-	-1000, 1026, 10726, 10726, 1287, -1000, 1137, 517, -1000, 1200,
-	1200, 901, 963, 656, 1793, 1200, 654, 1792, 1200, 1200,
-	10726, -1000, -1000, 660, -1000, 12635, 1026, -1000, 1031, 1243,
-	1241, 1200, 1026, 1026, 1200, 1200, 1026, -1000, 29332, -1000,
-	-257, -1000, -70, 481, 1137, -1000, 21223, 1026, 1150, -1000,
-	18838, 1306, 1660, -1000, -1000, -1000, -1000, 261, 36, 720,
-	754, 754, 12635, -1000, -1000, -1000, -1000, -1000, -1000, 513,
-	1780, 1361, 1137, -1000, 29332, 20746, 20746, 20746, 20746, 20746,
-	-1000, 1512, 1504, -1000, 1505, 1496, 1573, 29332, -1000, 1221,
-	1026, 1761, 18361, 268, 1163, 20746, 29332, -1000, -1000, 20746,
-	29332, 5944, -1000, 1236, -75, 18, -1000, -1000, -1000, -1000,
-	754, -1000, 946, -1000, 985, -1000, 321, -1000, -1000, -1000,
-	-1000, 495, 1716, 1620, 43, -1000, -1000, -1000, 261, 261,
-	-1000, -1000, 478, 478, 478, 478, 947, 947, -1000, -1000,
-	-1000, -1000, -1000, 864, -1000, -1000, -1000, 862, -1000, -1000,
-	1029, 1486, 164, -1000, -1000, 639, 940, 1624, 29332, -1000,
-	-1000, 1070, 184, 29332, 684, 1477, -1000, 1449, 1449, 1449,
-	29332, -1000, -1000, -1000, -1000, 3574, 29332, 1219, -1000, 158,
-	-1000, 1063, 29332, -1000, 1205, 1385, 1016, 1016, -1000, -1000,
-	7381, -1000, 29332, 1137, -1000, -1000, -1000, -1000, 434, 1657,
-	1647, 160, 158, 478, 1016, -1000, -1000, -1000, -1000, -1000,
-	-330, 1202, 404, 162, 170, 29332, 29332, 29332, 29332, 29332,
-	471, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 202, 379,
-	-1000, 29332, 29332, 457, -1000, -1000, -1000, 696, -1000, -1000,
-	696, -1000, -1000, -1000, -1000, -1000, 1649, -51, -301, -1000,
-	-298, -1000, -1000, -1000, -1000, 909, 2525, 2776, -1000, 14066,
-	14066, -1000, -1000, 1200, 1200, 10726, 10726, 7381, -1000, -1000,
-	185, 604, 185, 14066, 14066, -1000, 14066, 14066, -1000, -111,
-	1168, 652, -1000, 12635, 778, -1000, -1000, 14066, 14066, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 416, 412,
-	409, 29332, -1000, -1000, 1736, -1000, 1567, 1561, 1782, 1780,
-	-1000, 20746, 1660, -248, -1000, 1600, 1565, -1000, -1000, -1000,
-	-1000, 5465, 1725, 12635, 1474, 29332, 1137, -1000, 15975, 29332,
-	1359, -1000, 642, 1623, 1441, 1472, 1645, -1000, -1000, -1000,
-	-1000, 1502, -1000, 1455, -1000, -1000, 1352, -1000, -1000, 1112,
-	1780, 20746, 1325, -1000, 1325, -1000, 491, -1000, -1000, -1000,
-	-64, -50, -1000, -1000, -1000, 2220, -1000, -1000, -1000, -1000,
-	783, 14066, 1803, -1000, 939, -1000, -1000, 703, 698, -1000,
-	29332, 1384, -1000, -1000, -1000, 478, 478, -1000, -1000, -1000,
-	-1000, 1198, -1000, 1193, 1226, 1161, 98, -1000, 1364, 1646,
-	639, 639, -1000, 860, -1000, 1016, -1000, -1000, 400, -1000,
-	1682, 29332, 1471, 1465, 1454, -1000, 1770, 1195, -1000, 29332,
-	-1000, -1000, 29332, -1000, 1559, 164, 29332, -1000, -1000, -1000,
-	-1000, 266, 29332, -1000, 1444, 158, -1000, -1000, -1000, -1000,
-	-1000, -1000, 29332, 168, -1000, 1383, 1023, -1000, 1445, -1000,
-	-1000, -1000, -1000, 157, 244, -1000, 29332, 450, 1486, 29332,
-	-1000, -1000, -1000, 641, 641, -1000, 1636, -1000, 1016, -1000,
-	14066, 2525, 2525, -1000, -1000, -1000, 1026, -1000, 1026, 1376,
-	1376, -1000, 1376, 1377, -1000, 1376, 115, 1376, 104, 1026,
-	1026, 2642, 2587, 2456, 1256, 1137, -104, -1000, 754, 12635,
-	2398, 1214, 1137, 1137, 1137, 1140, 29332, -1000, -1000, -1000,
-	-1000, 1780, 1777, 1209, -1000, 36, 290, -1000, 1579, 1565,
-	-1000, 1768, 1591, 1758, -1000, -1000, -1000, 754, -1000, 1673,
-	1120, 1131, -1000, -1000, 10249, 1156, 1550, 487, 1140, 1760,
-	29332, 12635, -1000, -1000, 12635, 1375, -1000, 12635, -1000, -1000,
-	-1000, -1000, 29332, -1000, 1760, 1325, -1000, -1000, 549, -1000,
-	-1000, -1000, -1000, -1000, 2525, 21, -1000, -1000, -1000, 1362,
-	14066, -1000, -1000, 261, 934, 261, 833, -1000, 824, -1000,
-	-1000, -197, -1000, -1000, 1405, 1482, -1000, -1000, 29332, -1000,
-	-1000, 29332, 29332, 29332, 29332, 29332, -1000, -1000, 253, -1000,
-	1081, 1077, -1000, -138, -1000, -1000, 1352, -1000, -1000, -1000,
-	1049, -1000, -126, 1016, 29332, 29332, 29332, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 2525, -1000, -1000, -1000, 291,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14066, 14066,
-	14066, 14066, 14066, 1725, 931, 754, 14066, 14066, 17883, 20269,
-	20269, 17406, -1000, 1777, 1763, 1757, 1580, 1583, 1583, 1579,
-	-1000, 1755, 1751, -1000, 930, 1741, 923, 697, -1000, 1137,
-	-1000, 1358, 29332, 1137, 29332, -1000, 1725, -1000, 754, 754,
-	29332, 754, 1073, 1725, -1000, -1000, 29332, 1353, 478, -1000,
-	478, 1046, 1034, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1352, -1000, -1000, -1000, 1195, -1000, 242, 313, -1000,
-	266, -1000, -143, -146, 1713, -1000, -1000, 7381, -1000, -1000,
-	1335, 1447, -1000, -1000, -1000, 1031, 1031, 1031, 1031, 149,
-	1026, -1000, 1031, 1031, 1053, -1000, -1000, -1000, 1053, 1053,
-	481, 1763, -1000, 12635, 12635, 1575, 813, -1000, -1000, -1000,
-	-1000, 921, 917, -1000, 910, -1000, 1799, -1000, 1137, -1000,
-	480, 1131, -1000, -1000, 1041, -1000, -1000, 1037, -1000, -1000,
-	-1000, -1000, -1000, 1712, 1137, -1000, -1000, -1000, -1000, 1374,
-	1188, -1000, 621, 29332, 29332, -1000, -1000, -1000, -1000, 1026,
-	178, -131, -1000, -1000, -1000, 19792, -1000, -1000, -1000, -1000,
-	-1000, 754, 1150, -1000, 811, -1000, -1000, -1000, -1000, -1000,
-	29332, 1131, 29332, -1000, 1451, 852, 1374, 13112, -120, 7381,
-	4986, 1033, -1000, -1000, 1522, -114, -135, -1000, -1000, -1000,
-	-1000, 1111, -1000, 1365, -1000, 1786, -1000, -1000, -1000, 728,
-	814, -1000, -1000, -1000, -120, 1031, 1026, -1000, -33, -1000,
-	-1000, -1000, -1000, -1000, 1445, -1000, 1517, -1000, -1000, 1789,
-	583, 583, -1000, -1000, -1000, -1000, -1000, 360, -1000, -1000,
-	-126, -127, -1000, -1000, -1000, 340, 848, -1000, 171, -1000,
-	-132, -1000, -1000, -1000, -1000, -136, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -324, 30754, -1000, 177,
+	646, 244, 265, 233, 30754, 173, 1605, 189, 209, 30754,
+	30754, 333, 1390, 30754, 1569, 30754, -1000, -1000, -1000, -1000,
+	724, 30754, -1000, -1000, 716, 716, -1000, -1000, 30754, 716,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 716, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 30754, 30754, -1000, -1000,
+	-1000, -1000, -1000, 94, -4, 255, -1000, -1000, -1000, -1000,
+	1609, -1000, 724, 599, 654, 713, -1000, -1000, 855, -1000,
+	-1000, 3000, -1000, -1000, -1000, -1000, 933, 14780, 14780, 14780,
+	990, 3000, 2613, 753, 2722, 548, 635, 635, 546, 546,
+	546, 546, 546, 733, 733, -1000, -1000, -1000, -1000, 1079,
+	-1000, -1000, -1000, 1079, 11876, 11876, 1268, -1000, 1305, 521,
+	-1000, 1151, 1151, 903, 883, 662, 1667, 1151, 636, 1661,
+	1151, 1151, 11876, -1000, -1000, 689, -1000, 13328, 1079, -1000,
+	1360, 1263, 1258, 1151, 1079, 1079, 1151, 1151, -161, -161,
+	-161, -161, -161, 906, 1079, 954, 1079, 906, 906, 951,
+	1079, -1000, 30754, -1000, -252, -1000, -19, 450, 1305, -1000,
+	22042, 1079, 1245, -1000, -1000, -1000, -1000, 19622, 1240, 1562,
+	-1000, -1000, 1305, 1199, -1000, -1000, 279, 52, 676, 724,
+	724, 13328, -1000, -1000, -1000, -1000, -1000, -1000, 510, 1648,
+	274, 1305, -1000, 30754, 21558, 21558, 21558, 21558, 21558, -1000,
+	1424, 1419, -1000, 1418, 1405, 1406, 30754, -1000, 1192, 1079,
+	1623, 17202, 269, 1246, 21558, 30754, -1000, -1000, 21558, 30754,
+	6539, -1000, 1255, 5, 15, -1000, -1000, -1000, -1000, 724,
+	-1000, 992, -1000, 2641, -1000, 321, -1000, -1000, -1000, -1000,
+	594, 1588, 1517, 59, -1000, -1000, -1000, 279, 279, -1000,
+	-1000, 544, 544, 544, 544, 1013, 1013, -1000, -1000, -1000,
+	-1000, -1000, 871, -1000, -1000, -1000, 860, -1000, -1000, 913,
+	1408, 213, -1000, -1000, 638, 1009, 1524, 30754, -1000, -1000,
+	1110, 177, 30754, 714, 1389, -1000, 1368, 1368, 1368, 30754,
+	-1000, -1000, -1000, -1000, 2844, 30754, 1181, -1000, 161, -1000,
+	1101, 30754, -1000, 1170, 1332, 1005, 1005, -1000, -1000, 7997,
+	-1000, 30754, 1305, -1000, -1000, -1000, -1000, 429, 1556, 1545,
+	163, 161, 544, 1005, -1000, -1000, -1000, -1000, -1000, -327,
+	1158, 398, 168, 225, 30754, 30754, 30754, 30754, 30754, 494,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 216, 348, -1000,
+	30754, 30754, 513, -1000, -1000, -1000, 711, -1000, -1000, 711,
+	-1000, -1000, -1000, -1000, -1000, 1539, -10, -291, -1000, -288,
+	-1000, -1000, -1000, -1000, 990, 3000, 2479, -1000, 14780, 14780,
+	-1000, -161, 1151, 1151, 11876, 11876, 7997, -1000, -1000, 337,
+	632, 337, 14780, 14780, -1000, 14780, 14780, -1000, -57, 1221,
+	687, -1000, 13328, 720, -1000, -1000, 14780, 14780, -1000, -1000,
+	-1000, -1000, -1000, -1000, 21558, -1000, -1000, -1000, -1000, -161,
+	-161, 13328, -161, -161, -161, 13328, -1000, -1000, -1000, -1000,
+	414, 413, 407, 30754, -1000, -1000, 1610, -1000, 1442, 1440,
+	1652, 1648, -1000, 21558, 1562, -1000, -1000, 30754, -241, -1000,
+	1493, 1506, -1000, -1000, -1000, -1000, 6053, 1613, 13328, 1385,
+	30754, 1305, -1000, 16717, 30754, 1281, -1000, 641, 1479, 1366,
+	1383, 1464, -1000, -1000, -1000, -1000, 1417, -1000, 1407, -1000,
+	-1000, 1308, -1000, -1000, 1183, 1648, 21558, 1257, -1000, 1257,
+	-1000, 507, -1000, -1000, -1000, -25, -26, -1000, -1000, -1000,
+	332, -1000, -1000, -1000, -1000, 815, 14780, 1695, -1000, 997,
+	-1000, -1000, 740, 737, -1000, 30754, 1331, -1000, -1000, -1000,
+	544, 544, -1000, -1000, -1000, -1000, 1132, -1000, 1128, 1254,
+	1126, 100, -1000, 1353, 1536, 638, 638, -1000, 846, -1000,
+	1005, -1000, -1000, 369, -1000, 1567, 30754, 1382, 1380, 1378,
+	-1000, 1636, 1251, 30754, -1000, -1000, 30754, -1000, 1439, 213,
+	30754, -1000, -1000, -1000, -1000, 256, 30754, -1000, 1061, 161,
+	-1000, -1000, -1000, -1000, -1000, -1000, 30754, 182, -1000, 1323,
+	1071, -1000, 1363, -1000, -1000, -1000, -1000, 171, 240, -1000,
+	30754, 500, 1408, 30754, -1000, -1000, -1000, 716, 716, -1000,
+	1534, -1000, 1005, -1000, 14780, 3000, 3000, -1000, -1000, -1000,
+	-1000, 1079, -1000, 1079, 1314, 1314, -1000, 1314, 1319, -1000,
+	1314, 116, 1314, 113, 1079, 1079, 2574, 2519, 2464, 2389,
+	1305, -51, -1000, 724, 13328, 2309, 1866, 383, -1000, -1000,
+	-1000, 842, -1000, -1000, -1000, 906, 1305, 1305, 1305, 1120,
+	30754, -1000, -1000, -1000, -1000, 1648, 1646, 1252, -1000, -1000,
+	52, 306, -1000, 1465, 1506, -1000, 1631, 1474, 1629, -1000,
+	-1000, -1000, 724, -1000, 1560, 1244, 1242, -1000, -1000, 11392,
+	1123, 1438, 501, 1120, 1640, 30754, 13328, -1000, -1000, 13328,
+	1311, -1000, 13328, -1000, -1000, -1000, -1000, -1000, 1640, 1257,
+	-1000, -1000, 558, -1000, -1000, -1000, -1000, -1000, 3000, -23,
+	-1000, -1000, -1000, 1310, 14780, -1000, -1000, 279, 996, 279,
+	845, -1000, 829, -1000, -1000, -177, -1000, -1000, 1330, 1401,
+	-1000, -1000, 30754, -1000, -1000, 30754, 30754, 30754, 30754, -1000,
+	-1000, 253, -1000, 1114, 1109, -1000, -133, -1000, -1000, 1308,
+	-1000, -1000, -1000, 1098, -1000, -68, 1005, 30754, 30754, 30754,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 3000, -1000,
+	-1000, -1000, 293, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 14780, 14780, 14780, 14780, 14780, 1613, 994, 724, 14780,
+	14780, 1079, 1640, 267, 1628, 13328, -161, 19138, 21074, 21074,
+	18654, -1000, 1646, 1642, 1627, 1466, 1470, 1470, 1465, -1000,
+	1625, 1621, -1000, 991, 1619, 983, 735, -1000, 1305, -1000,
+	281, 30754, 1305, 30754, -1000, 1613, -1000, 724, 724, 30754,
+	724, 1613, -1000, -1000, 30754, 1905, 544, -1000, 544, 1089,
+	1081, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1308,
+	-1000, -1000, -1000, 1251, 248, 294, -1000, 256, -1000, -139,
+	-140, 1585, -1000, -1000, 7997, -1000, -1000, 1306, 1367, -1000,
+	-1000, -1000, 1360, 1360, 1360, 1360, 304, 1079, -1000, 1360,
+	1360, -1000, -232, 1640, 13328, 724, -1000, 1107, -1000, -1000,
+	-1000, 1107, 1107, 450, 1642, -169, 13328, 13328, 1461, 850,
+	-1000, -1000, -1000, -1000, 976, 972, -1000, 959, -1000, 1693,
+	-1000, 1305, -1000, 481, 1242, -1000, -1000, 1093, -1000, 1085,
+	-1000, -1000, -1000, -1000, -1000, 1581, 1305, -1000, -1000, -1000,
+	-1000, 275, 1247, -1000, 619, 30754, 30754, -1000, -1000, -1000,
+	-1000, 1079, 228, -79, -1000, -1000, -1000, -1000, 180, -1000,
+	-1000, -232, 1245, 20590, -1000, -1000, -1000, -1000, -169, -1000,
+	30754, 724, 1245, -1000, 849, -1000, -1000, -1000, -1000, -1000,
+	30754, 1242, 30754, -1000, 1376, 495, 275, 13812, -67, 7997,
+	5567, 1078, -1000, -1000, 1430, -63, -90, -1000, 118, -236,
+	-126, -129, -1000, -1000, 14780, -1000, -1000, -1000, -1000, 1229,
+	-1000, 1580, -1000, -1000, 1165, -1000, 1375, -1000, 1691, -1000,
+	-1000, -1000, 813, 955, -1000, -1000, -1000, -67, 1360, 1079,
+	-1000, -20, -1000, -1000, -1000, -1000, -1000, 1363, -1000, 1393,
+	-1000, 721, -1000, -1000, -1000, -1000, -1000, 15264, 30754, 1305,
+	-1000, 1675, 479, 479, -1000, -1000, -1000, -1000, -1000, 319,
+	-1000, -1000, -68, -69, 118, -1000, -1000, 383, -1000, -1000,
+	-1000, 309, 896, -1000, 190, -1000, -84, -1000, 1079, -1000,
+	-1000, -1000, -1000, -127, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 2102, 27, 58, 2101, 10, 145, 59, 2100, 2099,
-	2098, 2097, 150, 148, 147, 2096, 2095, 2091, 2089, 2085,
-	2084, 2083, 2072, 2069, 2067, 2063, 2062, 73, 140, 48,
-	50, 142, 2061, 2059, 39, 2058, 2056, 2054, 137, 135,
-	605, 2053, 134, 76, 2052, 2050, 2049, 2043, 2040, 2038,
-	2035, 2034, 2033, 2032, 2031, 2020, 2018, 2012, 232, 2011,
-	2010, 6, 2008, 45, 2007, 2006, 2005, 2004, 2003, 100,
-	2002, 1999, 1998, 1556, 1997, 1996, 64, 350, 66, 69,
-	1995, 1994, 57, 154, 1992, 82, 115, 1991, 1990, 1839,
-	63, 65, 1989, 91, 96, 1988, 56, 1987, 1986, 72,
-	1985, 1979, 1978, 85, 1977, 1976, 3025, 1975, 81, 88,
-	15, 29, 1974, 1973, 1972, 1968, 46, 262, 1964, 1962,
-	31, 1961, 1959, 158, 1956, 99, 13, 1955, 12, 14,
-	22, 1954, 109, 1950, 42, 38, 1949, 98, 1948, 1947,
-	25, 17, 51, 1946, 44, 86, 119, 20, 87, 93,
-	1945, 1944, 21, 36, 1943, 1941, 1940, 1939, 1938, 1936,
-	34, 1935, 23, 1934, 144, 1931, 26, 18, 43, 84,
-	143, 32, 11, 1924, 162, 1923, 30, 138, 80, 117,
-	1910, 1908, 1902, 891, 157, 1900, 1898, 35, 130, 103,
-	114, 1896, 171, 1894, 1893, 97, 1239, 2467, 9, 129,
-	1892, 1891, 2132, 71, 95, 24, 1889, 83, 1888, 1886,
-	1884, 149, 136, 68, 881, 61, 1882, 1877, 1876, 1874,
-	1869, 1868, 1867, 47, 33, 90, 120, 37, 1866, 1865,
-	1860, 77, 92, 1858, 127, 126, 78, 104, 1856, 131,
-	110, 67, 1854, 40, 1853, 1852, 1851, 1849, 89, 1848,
-	1846, 1845, 1844, 125, 108, 75, 53, 1843, 41, 74,
-	124, 112, 1841, 19, 141, 5, 1840, 1, 0, 1838,
-	3, 139, 161, 113, 1837, 1835, 2, 1834, 4, 1833,
-	1832, 94, 1831, 1830, 1829, 8, 16, 7, 1828, 1827,
-	1926, 121, 123, 1825, 151,
-}
-
-//line sql.y:5415
+	0, 1991, 26, 89, 1444, 10, 174, 54, 1989, 147,
+	135, 1987, 1986, 112, 1, 45, 13, 11, 1985, 4,
+	12, 1984, 1982, 1977, 1976, 1970, 159, 157, 156, 1967,
+	1964, 1963, 1962, 1961, 1960, 1959, 1958, 1956, 1954, 1953,
+	1949, 90, 145, 57, 67, 146, 1948, 1946, 46, 1945,
+	1944, 1942, 154, 153, 543, 1940, 152, 77, 1938, 1937,
+	1931, 1929, 1923, 1922, 1920, 1919, 1918, 1910, 1909, 1904,
+	1903, 1902, 1901, 165, 1899, 1897, 6, 1895, 44, 1893,
+	1892, 1891, 1890, 1888, 1887, 108, 1886, 1885, 1882, 148,
+	1880, 1879, 72, 278, 74, 137, 70, 1878, 1875, 64,
+	155, 1872, 81, 117, 1869, 1866, 416, 60, 76, 1865,
+	99, 118, 1864, 59, 1863, 1862, 80, 1859, 1858, 1857,
+	94, 1855, 1854, 2906, 1853, 93, 104, 19, 62, 1852,
+	1851, 1850, 1849, 49, 192, 1848, 1847, 38, 1846, 1843,
+	161, 1842, 100, 20, 1841, 17, 18, 31, 1836, 96,
+	1834, 36, 47, 1833, 113, 1832, 1831, 42, 16, 22,
+	1830, 132, 97, 120, 30, 122, 98, 1829, 1826, 35,
+	41, 1825, 1823, 1822, 1821, 1820, 1819, 40, 1818, 25,
+	1817, 149, 1816, 14, 32, 33, 95, 69, 39, 15,
+	1815, 173, 1812, 37, 140, 79, 129, 1809, 1808, 1807,
+	950, 171, 1806, 1805, 51, 141, 114, 116, 1804, 180,
+	1803, 1801, 83, 1405, 2188, 24, 136, 1800, 1799, 3388,
+	78, 102, 28, 1798, 73, 1797, 1796, 1794, 150, 151,
+	68, 946, 66, 1793, 1792, 1790, 1789, 1788, 1787, 1786,
+	85, 105, 34, 126, 43, 1784, 1783, 1782, 92, 65,
+	1780, 127, 124, 82, 138, 1779, 142, 123, 75, 1777,
+	86, 1776, 1775, 1774, 1772, 58, 1770, 1769, 1766, 1755,
+	128, 115, 88, 48, 1753, 52, 91, 125, 119, 1742,
+	29, 144, 21, 1739, 2, 0, 1738, 5, 143, 175,
+	139, 1736, 1735, 3, 1730, 7, 1728, 1727, 1726, 107,
+	1722, 1720, 1712, 9, 23, 8, 1709, 1708, 3088, 643,
+	133, 1707, 169,
+}
+
+//line sql.y:5715
 type yySymType struct {
 	union             interface{}
 	empty             struct{}
@@ -4518,6 +4691,16 @@ func (st *yySymType) createTableUnion() *CreateTable {
 	return v
 }
 
+func (st *yySymType) cteUnion() *CommonTableExpr {
+	v, _ := st.union.(*CommonTableExpr)
+	return v
+}
+
+func (st *yySymType) ctesUnion() []*CommonTableExpr {
+	v, _ := st.union.([]*CommonTableExpr)
+	return v
+}
+
 func (st *yySymType) derivedTableUnion() *DerivedTable {
 	v, _ := st.union.(*DerivedTable)
 	return v
@@ -4538,6 +4721,21 @@ func (st *yySymType) exprsUnion() Exprs {
 	return v
 }
 
+func (st *yySymType) frameClauseUnion() *FrameClause {
+	v, _ := st.union.(*FrameClause)
+	return v
+}
+
+func (st *yySymType) framePointUnion() *FramePoint {
+	v, _ := st.union.(*FramePoint)
+	return v
+}
+
+func (st *yySymType) frameUnitUnion() FrameUnitType {
+	v, _ := st.union.(FrameUnitType)
+	return v
+}
+
 func (st *yySymType) ignoreUnion() Ignore {
 	v, _ := st.union.(Ignore)
 	return v
@@ -4603,6 +4801,11 @@ func (st *yySymType) joinTypeUnion() JoinType {
 	return v
 }
 
+func (st *yySymType) killTypeUnion() KillType {
+	v, _ := st.union.(KillType)
+	return v
+}
+
 func (st *yySymType) limitUnion() *Limit {
 	v, _ := st.union.(*Limit)
 	return v
@@ -4653,6 +4856,11 @@ func (st *yySymType) orderDirectionUnion() OrderDirection {
 	return v
 }
 
+func (st *yySymType) overClauseUnion() *OverClause {
+	v, _ := st.union.(*OverClause)
+	return v
+}
+
 func (st *yySymType) partDefUnion() *PartitionDefinition {
 	v, _ := st.union.(*PartitionDefinition)
 	return v
@@ -4818,252 +5026,284 @@ func (st *yySymType) whensUnion() []*When {
 	return v
 }
 
+func (st *yySymType) windowDefUnion() *WindowDefinition {
+	v, _ := st.union.(*WindowDefinition)
+	return v
+}
+
+func (st *yySymType) windowDefsUnion() WindowDefinitions {
+	v, _ := st.union.(WindowDefinitions)
+	return v
+}
+
+func (st *yySymType) windowSpecUnion() *WindowSpecification {
+	v, _ := st.union.(*WindowSpecification)
+	return v
+}
+
+func (st *yySymType) withUnion() *With {
+	v, _ := st.union.(*With)
+	return v
+}
+
 var yyR1 = [...]int{
-	0, 288, 289, 289, 1, 1, 1, 1, 1, 1,
+	0, 306, 307, 307, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 268, 268, 268, 271, 271,
-	23, 53, 2, 2, 2, 3, 3, 3, 3, 4,
-	4, 4, 4, 4, 5, 5, 5, 5, 7, 7,
-	7, 7, 7, 10, 11, 6, 6, 12, 12, 65,
-	65, 13, 14, 14, 14, 14, 292, 292, 101, 101,
-	99, 99, 100, 100, 169, 169, 15, 16, 16, 179,
-	179, 178, 178, 178, 180, 180, 180, 180, 214, 214,
-	17, 17, 17, 17, 17, 74, 74, 270, 270, 269,
-	267, 267, 266, 266, 265, 25, 26, 35, 35, 35,
-	35, 36, 37, 272, 272, 244, 41, 41, 40, 40,
-	40, 40, 42, 42, 39, 39, 38, 38, 246, 246,
-	233, 233, 245, 245, 245, 245, 245, 245, 245, 232,
-	232, 88, 88, 150, 150, 150, 150, 150, 150, 150,
-	150, 284, 284, 151, 151, 151, 151, 151, 151, 285,
-	285, 285, 285, 216, 216, 216, 216, 219, 219, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 218, 218,
-	218, 218, 218, 220, 220, 220, 220, 220, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 222, 222, 222, 222, 222, 222, 222,
-	222, 231, 231, 223, 223, 226, 226, 227, 227, 227,
-	228, 228, 228, 229, 229, 224, 224, 224, 224, 225,
-	225, 225, 234, 258, 258, 257, 257, 255, 255, 255,
-	255, 243, 243, 252, 252, 252, 252, 252, 242, 242,
-	238, 238, 238, 239, 239, 240, 240, 237, 237, 241,
-	241, 254, 254, 253, 235, 235, 236, 236, 260, 286,
-	286, 286, 286, 287, 287, 261, 277, 278, 276, 276,
-	276, 276, 276, 63, 63, 63, 191, 191, 191, 250,
-	250, 249, 249, 249, 251, 251, 248, 248, 248, 248,
-	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
-	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
-	248, 248, 248, 248, 186, 186, 186, 275, 275, 275,
-	275, 275, 275, 274, 274, 274, 247, 247, 247, 273,
-	273, 43, 43, 135, 135, 32, 32, 32, 32, 32,
-	32, 31, 31, 31, 27, 27, 27, 27, 27, 27,
-	27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
-	27, 27, 27, 27, 27, 27, 33, 33, 28, 28,
-	28, 28, 28, 28, 28, 28, 28, 18, 18, 18,
-	18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
-	18, 18, 18, 18, 18, 18, 264, 264, 264, 264,
-	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
-	264, 264, 264, 264, 264, 264, 264, 264, 230, 230,
-	230, 262, 262, 263, 263, 19, 24, 24, 20, 20,
-	20, 20, 21, 21, 44, 45, 45, 45, 45, 45,
-	45, 45, 45, 45, 45, 45, 45, 45, 45, 45,
-	45, 45, 45, 45, 45, 45, 45, 45, 45, 45,
-	45, 45, 45, 45, 45, 45, 45, 45, 45, 45,
-	45, 45, 45, 45, 45, 45, 45, 45, 45, 45,
-	45, 45, 45, 279, 279, 185, 185, 193, 193, 184,
-	184, 207, 207, 207, 187, 187, 187, 188, 188, 283,
-	283, 283, 46, 46, 48, 48, 49, 50, 50, 209,
-	209, 210, 210, 51, 52, 64, 64, 64, 64, 64,
-	64, 66, 66, 66, 9, 9, 9, 9, 60, 60,
-	60, 8, 8, 47, 47, 54, 280, 280, 281, 282,
-	282, 282, 282, 55, 57, 22, 22, 22, 22, 22,
-	22, 81, 81, 69, 69, 69, 69, 69, 69, 69,
-	69, 69, 69, 69, 69, 75, 75, 75, 70, 70,
-	293, 58, 59, 59, 73, 73, 73, 67, 67, 67,
-	72, 72, 72, 78, 78, 80, 80, 80, 80, 80,
-	82, 82, 82, 82, 82, 82, 82, 77, 77, 79,
-	79, 79, 79, 200, 200, 200, 199, 199, 90, 90,
-	92, 91, 91, 93, 93, 94, 94, 94, 133, 109,
-	109, 167, 167, 166, 166, 168, 168, 168, 168, 170,
-	170, 95, 95, 95, 95, 96, 96, 97, 97, 98,
-	98, 208, 208, 205, 205, 205, 204, 204, 102, 102,
-	102, 104, 103, 103, 103, 103, 105, 105, 107, 107,
-	106, 106, 108, 110, 110, 110, 110, 110, 111, 111,
-	89, 89, 89, 89, 89, 89, 89, 89, 182, 182,
-	113, 113, 112, 112, 112, 112, 112, 112, 112, 112,
-	112, 112, 124, 124, 124, 124, 124, 124, 114, 114,
-	114, 114, 114, 114, 114, 76, 76, 125, 125, 125,
-	132, 126, 126, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 121, 121, 121,
-	121, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 120, 120, 120, 120, 120,
-	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
-	120, 294, 294, 123, 122, 122, 122, 122, 122, 122,
-	122, 71, 71, 71, 71, 71, 213, 213, 213, 215,
-	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
-	215, 215, 138, 138, 68, 68, 136, 136, 137, 139,
-	139, 134, 134, 134, 116, 116, 116, 116, 116, 116,
-	116, 116, 118, 118, 118, 140, 140, 141, 141, 142,
-	142, 144, 143, 143, 145, 146, 146, 146, 147, 147,
-	148, 148, 148, 34, 34, 34, 34, 34, 29, 29,
-	29, 29, 30, 30, 30, 83, 83, 83, 83, 85,
-	85, 84, 84, 61, 61, 62, 62, 62, 86, 86,
-	87, 87, 87, 87, 164, 164, 149, 149, 149, 156,
-	156, 156, 152, 152, 154, 154, 154, 155, 155, 155,
-	153, 161, 161, 163, 163, 162, 162, 158, 158, 159,
-	159, 160, 160, 160, 157, 157, 115, 115, 115, 115,
-	115, 165, 165, 165, 165, 171, 171, 128, 128, 130,
-	130, 129, 131, 172, 172, 176, 173, 173, 177, 177,
-	177, 177, 177, 174, 174, 175, 175, 201, 201, 201,
-	181, 181, 192, 192, 189, 189, 190, 190, 183, 183,
-	194, 194, 194, 56, 127, 127, 259, 259, 256, 197,
-	197, 198, 198, 202, 202, 206, 206, 203, 203, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 290, 291, 211, 212, 212, 212,
+	1, 1, 1, 1, 1, 1, 285, 285, 285, 288,
+	288, 37, 67, 2, 2, 2, 3, 3, 3, 3,
+	3, 4, 4, 4, 4, 4, 8, 8, 9, 9,
+	10, 5, 5, 5, 5, 7, 7, 7, 7, 7,
+	24, 25, 6, 6, 26, 26, 81, 81, 27, 28,
+	28, 28, 28, 310, 310, 118, 118, 116, 116, 117,
+	117, 186, 186, 29, 30, 30, 196, 196, 195, 195,
+	195, 197, 197, 197, 197, 231, 231, 31, 31, 31,
+	31, 31, 90, 90, 287, 287, 286, 284, 284, 283,
+	283, 282, 39, 40, 49, 49, 49, 49, 50, 51,
+	289, 289, 261, 55, 55, 54, 54, 54, 54, 56,
+	56, 53, 53, 52, 52, 263, 263, 250, 250, 262,
+	262, 262, 262, 262, 262, 262, 249, 249, 105, 105,
+	167, 167, 167, 167, 167, 167, 167, 167, 302, 302,
+	168, 168, 168, 168, 168, 168, 303, 303, 303, 303,
+	233, 233, 233, 233, 236, 236, 234, 234, 234, 234,
+	234, 234, 234, 234, 234, 235, 235, 235, 235, 235,
+	237, 237, 237, 237, 237, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	239, 239, 239, 239, 239, 239, 239, 239, 248, 248,
+	240, 240, 243, 243, 244, 244, 244, 245, 245, 245,
+	246, 246, 241, 241, 241, 241, 242, 242, 242, 251,
+	275, 275, 274, 274, 272, 272, 272, 272, 260, 260,
+	269, 269, 269, 269, 269, 259, 259, 255, 255, 255,
+	256, 256, 257, 257, 254, 254, 258, 258, 271, 271,
+	270, 252, 252, 253, 253, 277, 304, 304, 304, 304,
+	305, 305, 278, 294, 295, 293, 293, 293, 293, 293,
+	78, 78, 78, 208, 208, 208, 267, 267, 266, 266,
+	266, 268, 268, 265, 265, 265, 265, 265, 265, 265,
+	265, 265, 265, 265, 265, 265, 265, 265, 265, 265,
+	265, 265, 265, 265, 265, 265, 265, 265, 265, 265,
+	265, 203, 203, 203, 292, 292, 292, 292, 292, 292,
+	291, 291, 291, 264, 264, 264, 290, 290, 57, 57,
+	152, 152, 46, 46, 46, 46, 46, 46, 45, 45,
+	45, 41, 41, 41, 41, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 41, 41, 41,
+	41, 41, 41, 47, 47, 42, 42, 42, 42, 42,
+	42, 42, 42, 42, 32, 32, 32, 32, 32, 32,
+	32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
+	32, 32, 32, 281, 281, 281, 281, 281, 281, 281,
+	281, 281, 281, 281, 281, 281, 281, 281, 281, 281,
+	281, 281, 281, 281, 281, 247, 247, 247, 279, 279,
+	280, 280, 33, 38, 38, 34, 34, 34, 34, 35,
+	35, 58, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 296, 296, 297, 297, 202, 202, 210, 210, 201,
+	201, 224, 224, 224, 204, 204, 204, 205, 205, 301,
+	301, 301, 60, 60, 62, 62, 63, 64, 64, 226,
+	226, 227, 227, 65, 66, 79, 79, 79, 79, 79,
+	79, 82, 82, 82, 23, 23, 23, 23, 75, 75,
+	75, 22, 22, 61, 61, 68, 298, 298, 299, 300,
+	300, 300, 300, 69, 72, 80, 80, 80, 71, 36,
+	36, 36, 36, 36, 36, 98, 98, 85, 85, 85,
+	85, 85, 85, 85, 85, 85, 85, 85, 85, 91,
+	91, 91, 86, 86, 311, 73, 74, 74, 89, 89,
+	89, 83, 83, 83, 88, 88, 88, 94, 94, 97,
+	97, 97, 97, 97, 99, 99, 99, 99, 99, 99,
+	99, 93, 93, 96, 96, 96, 96, 217, 217, 217,
+	216, 216, 107, 107, 109, 108, 108, 110, 110, 111,
+	111, 111, 150, 126, 126, 184, 184, 183, 183, 185,
+	185, 185, 185, 187, 187, 112, 112, 112, 112, 113,
+	113, 114, 114, 115, 115, 225, 225, 222, 222, 222,
+	221, 221, 119, 119, 119, 121, 120, 120, 120, 120,
+	122, 122, 124, 124, 123, 123, 125, 127, 127, 127,
+	127, 127, 128, 128, 106, 106, 106, 106, 106, 106,
+	106, 106, 199, 199, 130, 130, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 129, 141, 141, 141, 141,
+	141, 141, 131, 131, 131, 131, 131, 131, 131, 92,
+	92, 142, 142, 142, 149, 143, 143, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 138, 138, 138, 138, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 11, 11, 11, 95, 95,
+	95, 13, 13, 14, 14, 15, 15, 16, 16, 16,
+	18, 18, 17, 17, 17, 17, 17, 12, 12, 12,
+	20, 20, 21, 21, 19, 136, 136, 136, 136, 136,
+	136, 136, 136, 136, 136, 136, 136, 136, 136, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 312, 312, 140, 139, 139,
+	139, 139, 139, 139, 139, 87, 87, 87, 87, 87,
+	230, 230, 230, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 155, 155, 84, 84,
+	153, 153, 154, 156, 156, 151, 151, 151, 133, 133,
+	133, 133, 133, 133, 133, 133, 135, 135, 135, 157,
+	157, 158, 158, 159, 159, 161, 160, 160, 162, 163,
+	163, 163, 164, 164, 165, 165, 165, 48, 48, 48,
+	48, 48, 43, 43, 43, 43, 44, 44, 44, 100,
+	100, 100, 100, 102, 102, 101, 101, 76, 76, 77,
+	77, 77, 103, 103, 104, 104, 104, 104, 181, 181,
+	166, 166, 166, 173, 173, 173, 169, 169, 171, 171,
+	171, 172, 172, 172, 170, 178, 178, 180, 180, 179,
+	179, 175, 175, 176, 176, 177, 177, 177, 174, 174,
+	132, 132, 132, 132, 132, 182, 182, 182, 182, 188,
+	188, 145, 145, 147, 147, 146, 148, 189, 189, 193,
+	190, 190, 194, 194, 194, 194, 194, 191, 191, 192,
+	192, 218, 218, 218, 198, 198, 209, 209, 206, 206,
+	207, 207, 200, 200, 211, 211, 211, 70, 144, 144,
+	276, 276, 273, 214, 214, 215, 215, 219, 219, 223,
+	223, 220, 220, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 308, 309, 228, 229, 229, 229,
 }
 
 var yyR2 = [...]int{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 0, 1, 1, 1, 0, 1,
-	2, 3, 3, 3, 4, 3, 2, 3, 7, 1,
-	3, 3, 3, 3, 1, 2, 1, 1, 3, 2,
-	3, 3, 2, 5, 7, 9, 8, 7, 8, 1,
-	1, 9, 9, 8, 7, 7, 1, 1, 1, 3,
-	1, 3, 1, 3, 0, 4, 3, 5, 4, 1,
-	3, 3, 2, 2, 2, 2, 2, 1, 1, 1,
-	2, 2, 6, 12, 2, 0, 2, 0, 2, 1,
-	0, 2, 1, 3, 3, 6, 4, 7, 8, 8,
-	8, 6, 3, 1, 1, 4, 0, 1, 1, 1,
-	2, 2, 0, 1, 4, 4, 4, 4, 2, 4,
-	1, 3, 1, 1, 3, 4, 3, 3, 3, 4,
-	9, 0, 2, 0, 2, 3, 3, 4, 2, 3,
-	2, 1, 1, 0, 2, 2, 3, 3, 2, 2,
-	1, 2, 1, 3, 1, 1, 1, 2, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
-	2, 2, 2, 1, 2, 2, 2, 2, 4, 4,
-	2, 2, 3, 3, 3, 3, 1, 1, 1, 1,
-	1, 6, 6, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 0, 3, 0, 5, 0, 3, 5,
-	0, 1, 1, 0, 1, 0, 2, 2, 2, 0,
-	2, 2, 5, 0, 1, 1, 2, 1, 3, 2,
-	3, 0, 1, 4, 3, 3, 4, 2, 0, 2,
 	1, 1, 1, 1, 1, 0, 1, 1, 1, 0,
-	1, 1, 3, 3, 3, 1, 3, 1, 7, 5,
-	6, 6, 7, 0, 1, 5, 3, 3, 1, 1,
-	2, 2, 2, 0, 1, 1, 0, 1, 2, 0,
-	1, 1, 3, 2, 1, 2, 3, 3, 4, 4,
-	3, 3, 3, 3, 4, 4, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 4, 5, 0, 2, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
-	1, 0, 1, 0, 2, 0, 1, 5, 1, 3,
-	7, 1, 3, 3, 1, 2, 2, 2, 5, 5,
-	5, 6, 6, 5, 5, 2, 2, 2, 2, 3,
-	3, 3, 4, 1, 3, 5, 1, 3, 3, 3,
-	3, 3, 3, 3, 3, 2, 2, 2, 4, 4,
-	2, 11, 3, 6, 8, 6, 6, 6, 13, 8,
-	6, 10, 5, 5, 5, 5, 5, 3, 7, 4,
-	4, 4, 4, 3, 3, 3, 7, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 2, 0, 2,
-	2, 1, 3, 8, 8, 3, 3, 5, 7, 7,
-	6, 5, 3, 2, 3, 3, 3, 7, 3, 3,
-	3, 3, 4, 7, 5, 2, 4, 4, 4, 4,
-	4, 5, 5, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 4, 4, 2, 4, 2, 4, 5, 4,
-	4, 4, 4, 4, 3, 3, 3, 5, 2, 3,
-	3, 3, 3, 1, 1, 0, 1, 0, 1, 1,
+	1, 2, 3, 3, 3, 4, 3, 4, 2, 3,
+	7, 1, 3, 3, 3, 3, 2, 3, 1, 3,
+	4, 1, 2, 1, 1, 3, 2, 3, 3, 2,
+	5, 7, 10, 9, 7, 8, 1, 1, 9, 9,
+	8, 7, 7, 1, 1, 1, 3, 1, 3, 1,
+	3, 0, 4, 3, 5, 4, 1, 3, 3, 2,
+	2, 2, 2, 2, 1, 1, 1, 2, 2, 6,
+	12, 2, 0, 2, 0, 2, 1, 0, 2, 1,
+	3, 3, 6, 4, 7, 8, 8, 8, 6, 3,
+	1, 1, 4, 0, 1, 1, 1, 2, 2, 0,
+	1, 4, 4, 4, 4, 2, 4, 1, 3, 1,
+	1, 3, 4, 3, 3, 3, 4, 9, 0, 2,
+	0, 2, 3, 3, 4, 2, 3, 2, 1, 1,
+	0, 2, 2, 3, 3, 2, 2, 1, 2, 1,
+	3, 1, 1, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 2, 2, 2,
+	1, 2, 2, 2, 2, 4, 4, 2, 2, 3,
+	3, 3, 3, 1, 1, 1, 1, 1, 6, 6,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	0, 3, 0, 5, 0, 3, 5, 0, 1, 1,
+	0, 1, 0, 2, 2, 2, 0, 2, 2, 5,
+	0, 1, 1, 2, 1, 3, 2, 3, 0, 1,
+	4, 3, 3, 4, 2, 0, 2, 1, 1, 1,
+	1, 1, 0, 1, 1, 1, 0, 1, 1, 3,
+	3, 3, 1, 3, 1, 7, 5, 6, 6, 7,
+	0, 1, 5, 3, 3, 1, 1, 2, 2, 2,
+	0, 1, 1, 0, 1, 2, 0, 1, 1, 3,
+	2, 1, 2, 3, 3, 4, 4, 3, 3, 3,
+	3, 4, 4, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
+	5, 0, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 1, 0, 1,
+	0, 2, 0, 1, 5, 1, 3, 7, 1, 3,
+	3, 1, 2, 2, 2, 5, 5, 5, 6, 6,
+	5, 5, 2, 2, 2, 2, 3, 3, 3, 4,
+	1, 3, 5, 1, 3, 3, 3, 3, 3, 3,
+	3, 3, 2, 2, 2, 4, 4, 2, 11, 3,
+	6, 8, 6, 6, 6, 13, 8, 6, 10, 5,
+	5, 5, 5, 5, 3, 7, 4, 4, 4, 4,
+	3, 3, 3, 7, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 2, 0, 2, 2, 1, 3,
+	8, 8, 3, 3, 5, 7, 7, 6, 5, 3,
+	2, 3, 3, 3, 7, 3, 3, 3, 3, 4,
+	7, 5, 2, 4, 4, 4, 4, 4, 5, 5,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 2, 4, 2, 4, 5, 4, 4, 4, 4,
+	4, 3, 3, 3, 5, 2, 3, 3, 3, 3,
+	3, 1, 1, 1, 1, 0, 1, 0, 1, 1,
 	1, 0, 2, 2, 0, 2, 2, 0, 2, 0,
 	1, 1, 2, 1, 1, 2, 1, 1, 5, 0,
 	1, 0, 1, 2, 3, 0, 3, 3, 3, 3,
 	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
 	1, 3, 3, 2, 2, 3, 1, 3, 2, 1,
-	2, 1, 2, 2, 4, 3, 3, 6, 4, 7,
-	6, 1, 3, 2, 2, 2, 2, 1, 1, 1,
-	3, 2, 1, 1, 1, 0, 1, 1, 0, 3,
-	0, 2, 0, 2, 1, 2, 2, 0, 1, 1,
-	0, 1, 1, 0, 1, 0, 1, 2, 3, 4,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
-	2, 3, 5, 0, 1, 2, 1, 1, 0, 1,
-	2, 1, 3, 1, 1, 1, 4, 3, 3, 3,
-	7, 0, 3, 1, 3, 1, 1, 3, 3, 1,
-	3, 4, 4, 4, 3, 2, 4, 0, 1, 0,
-	2, 0, 1, 0, 1, 2, 1, 1, 1, 2,
-	2, 1, 2, 3, 2, 3, 2, 2, 2, 1,
-	1, 3, 3, 0, 5, 4, 5, 5, 0, 2,
-	1, 3, 3, 3, 2, 3, 1, 2, 0, 3,
-	1, 1, 3, 3, 4, 4, 5, 3, 4, 5,
-	6, 2, 1, 2, 1, 2, 1, 2, 1, 1,
-	1, 1, 1, 1, 1, 0, 2, 1, 1, 1,
-	1, 1, 3, 1, 1, 1, 1, 1, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 3, 1, 1, 1, 1, 4, 5, 5,
-	6, 4, 4, 6, 6, 6, 8, 8, 8, 8,
-	9, 8, 5, 4, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 8,
-	8, 0, 2, 3, 4, 4, 4, 4, 4, 4,
-	4, 0, 3, 4, 7, 3, 1, 1, 1, 2,
-	3, 3, 1, 2, 2, 1, 2, 1, 2, 2,
-	1, 2, 0, 1, 0, 2, 1, 2, 4, 0,
-	2, 1, 3, 5, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 2, 2, 0, 3, 0, 2, 0,
-	1, 3, 1, 3, 2, 0, 1, 1, 0, 1,
-	2, 4, 4, 0, 2, 2, 1, 1, 3, 3,
-	3, 3, 3, 3, 3, 0, 3, 3, 3, 0,
-	3, 1, 1, 0, 4, 0, 1, 1, 0, 3,
-	1, 3, 2, 1, 2, 4, 9, 3, 5, 0,
-	3, 3, 0, 1, 0, 2, 2, 0, 2, 2,
-	2, 0, 2, 1, 2, 3, 3, 0, 2, 1,
-	2, 3, 4, 3, 0, 1, 2, 1, 5, 4,
-	4, 1, 3, 3, 5, 0, 5, 1, 3, 1,
-	2, 3, 1, 1, 3, 3, 1, 3, 3, 3,
-	3, 3, 2, 1, 2, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 0, 2, 0, 3, 0, 1,
-	0, 1, 1, 5, 0, 1, 0, 1, 2, 1,
-	1, 1, 1, 1, 1, 0, 1, 1, 1, 1,
+	2, 1, 2, 2, 3, 0, 1, 1, 4, 3,
+	3, 6, 4, 7, 6, 1, 3, 2, 2, 2,
+	2, 1, 1, 1, 3, 2, 1, 1, 1, 0,
+	1, 1, 0, 3, 0, 2, 0, 2, 1, 2,
+	2, 0, 1, 1, 0, 1, 1, 0, 1, 0,
+	1, 2, 3, 4, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 1, 2, 3, 5, 0, 1, 2,
+	1, 1, 0, 1, 2, 1, 3, 1, 1, 1,
+	4, 3, 3, 3, 7, 0, 3, 1, 3, 1,
+	1, 3, 3, 1, 3, 4, 4, 4, 3, 2,
+	4, 0, 1, 0, 2, 0, 1, 0, 1, 2,
+	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
+	2, 2, 2, 1, 1, 3, 3, 0, 5, 4,
+	5, 5, 0, 2, 1, 3, 3, 3, 2, 3,
+	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
+	5, 3, 4, 5, 6, 2, 1, 2, 1, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	2, 1, 1, 1, 1, 1, 3, 1, 1, 1,
+	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
+	1, 1, 4, 5, 5, 6, 4, 4, 4, 4,
+	4, 5, 5, 5, 5, 5, 7, 5, 1, 3,
+	5, 4, 2, 3, 4, 0, 3, 0, 2, 5,
+	1, 1, 2, 2, 2, 2, 2, 1, 1, 3,
+	0, 2, 1, 3, 5, 4, 4, 6, 6, 6,
+	8, 8, 8, 8, 9, 8, 5, 4, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 8, 8, 0, 2, 3, 4, 4,
+	4, 4, 4, 4, 4, 0, 3, 4, 7, 3,
+	1, 1, 1, 2, 3, 3, 1, 2, 2, 1,
+	2, 1, 2, 2, 1, 2, 0, 1, 0, 2,
+	1, 2, 4, 0, 2, 1, 3, 5, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 0,
+	3, 0, 2, 0, 1, 3, 1, 3, 2, 0,
+	1, 1, 0, 1, 2, 4, 4, 0, 2, 2,
+	1, 1, 3, 3, 3, 3, 3, 3, 3, 0,
+	3, 3, 3, 0, 3, 1, 1, 0, 4, 0,
+	1, 1, 0, 3, 1, 3, 2, 1, 2, 4,
+	9, 3, 5, 0, 3, 3, 0, 1, 0, 2,
+	2, 0, 2, 2, 2, 0, 2, 1, 2, 3,
+	3, 0, 2, 1, 2, 3, 4, 3, 0, 1,
+	2, 1, 5, 4, 4, 1, 3, 3, 5, 0,
+	5, 1, 3, 1, 2, 3, 1, 1, 3, 3,
+	1, 3, 3, 3, 3, 3, 2, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 0, 1, 0, 2,
+	0, 3, 0, 1, 0, 1, 1, 5, 0, 1,
+	0, 1, 2, 1, 1, 1, 1, 1, 1, 0,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5109,265 +5349,274 @@ var yyR2 = [...]int{
 }
 
 var yyChk = [...]int{
-	-1000, -288, -1, -5, -10, -11, -12, -13, -14, -15,
-	-16, -17, -18, -19, -20, -21, -44, -45, -46, -48,
-	-49, -50, -51, -52, -8, -47, -22, -23, -53, -54,
-	-55, -56, -57, -3, -2, -7, 7, 8, -65, 10,
-	11, 30, -25, -35, 162, -36, -26, 163, -37, 165,
-	164, 200, 166, 193, 72, 239, 240, 242, 243, 244,
-	245, -66, 198, 199, 168, 34, 43, 31, 32, 35,
-	171, -4, 6, -290, 9, 344, 195, 194, 26, -6,
-	83, -289, 484, -164, -149, 20, 31, 27, -148, -144,
-	-73, -149, 18, 16, 5, -58, -293, -58, -58, -58,
-	-58, -58, -244, -246, 83, 135, 83, -58, -41, -40,
-	-39, -38, -42, 29, -32, -33, -264, -31, -28, 167,
-	164, 208, 104, 105, 200, 201, 202, 166, 184, 199,
-	203, 198, 217, -27, 78, 31, 357, 360, -251, 163,
-	169, 170, 345, 107, 106, 73, 165, -248, 289, 461,
-	-42, 463, 97, 99, 462, 42, 174, 464, 465, 466,
-	467, 183, 468, 469, 470, 471, 477, 478, 479, 480,
-	108, 5, -58, -206, -202, -268, -196, 86, 87, 88,
-	354, 186, 388, 389, 233, 78, 39, 289, 461, 239,
-	253, 247, 274, 266, 355, 390, 236, 187, 221, 458,
-	264, 113, 463, 391, 201, 294, 295, 296, 97, 242,
-	331, 476, 238, 392, 474, 99, 462, 77, 49, 42,
-	196, 262, 258, 464, 222, 393, 365, 215, 107, 104,
-	483, 34, 256, 48, 28, 473, 106, 47, 465, 126,
-	394, 466, 298, 279, 452, 46, 299, 202, 395, 81,
-	359, 460, 300, 257, 301, 232, 472, 168, 396, 444,
-	302, 303, 453, 397, 280, 284, 398, 323, 304, 50,
-	399, 400, 454, 105, 401, 76, 467, 251, 252, 402,
-	230, 185, 325, 278, 183, 33, 305, 356, 234, 56,
-	209, 326, 44, 282, 43, 448, 403, 451, 277, 273,
-	51, 404, 405, 406, 407, 468, 276, 250, 272, 482,
-	227, 469, 60, 170, 286, 285, 287, 216, 322, 269,
-	408, 409, 410, 190, 79, 411, 259, 19, 412, 413,
-	306, 223, 414, 54, 415, 416, 329, 199, 417, 52,
-	470, 37, 204, 471, 418, 419, 420, 421, 422, 308,
-	423, 307, 281, 283, 211, 309, 358, 424, 255, 203,
-	475, 425, 191, 459, 205, 208, 198, 330, 192, 426,
-	427, 428, 429, 430, 237, 431, 432, 243, 477, 41,
-	433, 434, 435, 436, 231, 226, 324, 333, 59, 80,
-	291, 437, 457, 249, 224, 438, 240, 53, 478, 479,
-	480, 218, 481, 7, 311, 108, 228, 229, 45, 270,
-	210, 439, 440, 260, 261, 275, 248, 271, 241, 445,
-	212, 312, 200, 441, 332, 225, 292, 362, 217, 313,
-	456, 361, 268, 265, 219, 442, 314, 175, 213, 214,
-	443, 446, 315, 316, 235, 317, 318, 319, 320, 321,
-	220, 360, 246, 263, 293, 173, -58, 173, -106, -202,
-	173, -174, 295, -193, 297, 310, 305, 315, 303, -185,
-	306, 308, 211, -283, 323, 173, 312, 162, 153, 298,
-	307, 316, 317, 235, 318, 321, 220, -279, -268, 466,
-	481, 113, 267, 302, 300, 324, 448, 320, 319, -202,
-	241, -209, 246, -197, -268, -196, 244, -106, -64, 444,
-	166, -211, -211, -75, 448, 450, -126, -89, -112, 119,
-	-117, 29, 24, -116, -113, -134, -131, -132, 153, 154,
-	156, 155, 157, 142, 143, 150, 120, 158, -121, -119,
-	-120, -122, 90, 89, 98, 91, 92, 93, 94, 100,
-	101, 102, -197, -202, -129, -2, 66, 67, 345, 346,
-	347, 348, 353, 349, 122, 55, 340, 334, 343, 342,
-	341, 338, 339, 336, 337, 351, 352, 178, 335, 172,
-	148, 344, -268, -196, -290, 42, 311, 311, -106, -58,
-	-142, -73, -144, -58, -2, -3, -7, -149, -164, 10,
-	137, 40, 48, -147, -148, -6, -2, 6, -290, -89,
-	17, 21, 22, -79, 144, -89, -202, -59, -79, -183,
-	177, -183, -183, -173, -214, 241, -177, 324, 323, -198,
-	-175, -197, -195, -174, 322, 167, 363, 118, 23, 25,
-	121, 153, 17, 122, 35, 169, 267, 184, 152, 180,
-	345, 162, 70, 364, 336, 337, 334, 340, 347, 348,
-	335, 297, 29, 11, 366, 26, 194, 22, 36, 146,
-	164, 125, 197, 24, 195, 102, 369, 20, 73, 189,
-	12, 182, 38, 14, 370, 371, 15, 178, 177, 137,
-	174, 68, 9, 158, 27, 134, 64, 372, 115, 373,
-	374, 375, 376, 66, 135, 18, 338, 339, 31, 449,
-	377, 353, 206, 148, 71, 57, 450, 119, 378, 379,
-	100, 380, 103, 74, 455, 116, 16, 69, 40, 381,
-	207, 382, 179, 383, 327, 384, 136, 165, 344, 67,
-	385, 172, 310, 6, 350, 30, 193, 181, 110, 65,
-	386, 173, 124, 351, 352, 176, 101, 5, 114, 32,
-	10, 72, 75, 341, 342, 343, 55, 109, 357, 123,
-	13, 387, 328, 117, 113, -245, 135, -232, -236, -197,
-	188, -261, 184, -106, -254, -253, -197, -74, -192, 174,
-	182, 181, 114, -272, 116, 228, 335, 172, -38, -39,
-	-174, 152, 205, 84, 84, -236, -235, -234, -273, 207,
-	188, -260, -252, 180, 189, -242, 181, 182, -237, 174,
-	115, -273, -237, 179, 189, 207, 207, 108, 207, 108,
-	207, 207, 207, 207, 207, 207, 207, 207, 207, 204,
-	-243, 127, -243, 361, 361, -248, -273, -273, -273, 176,
-	33, 33, -194, -237, 176, 23, -243, -243, -174, 152,
-	-243, -243, -243, -243, 215, 215, -243, -243, -243, -243,
-	-243, -243, -243, -243, -243, -243, -243, -243, -243, -243,
-	-243, 173, -272, -83, 321, 235, 78, -40, 217, -24,
-	-106, -192, 174, 175, -272, -106, 159, -106, -187, 135,
-	13, -187, -184, 311, 309, 296, 301, -187, -187, -187,
-	-187, 218, 294, -238, 174, 33, 185, 311, 218, 294,
-	218, 219, 218, 219, 304, 314, 218, -207, 12, 137,
-	335, 299, 303, 211, 173, 212, 175, 313, -268, 451,
-	219, -207, 90, -188, 135, 311, 214, -187, -212, -290,
-	-198, 267, -212, -212, 30, 176, -197, -60, -197, 90,
-	-9, -5, -13, -12, -14, 127, -81, 311, -69, 153,
-	466, 452, 453, 454, 451, 308, 459, 457, 455, 218,
-	456, 84, 118, 116, 117, 134, -89, -114, 137, 119,
-	135, 136, 121, 139, 138, 149, 142, 143, 144, 145,
-	146, 147, 148, 140, 141, 152, 127, 128, 129, 130,
-	131, 132, 133, -182, -290, -132, 160, 161, -117, -117,
-	-117, -117, -117, -117, -117, -117, -117, -117, -290, 159,
-	-290, -290, -290, -290, -290, -290, -290, -290, -138, -89,
-	-290, -294, -290, -294, -123, -290, -294, -123, -294, -123,
-	-294, -294, -123, -294, -123, -294, -294, -123, -290, -290,
-	-290, -290, -290, -290, -290, -2, -3, -126, -211, -280,
-	-281, -109, -106, -290, 235, -147, -6, -2, -67, -80,
-	61, 62, -82, 22, 36, 65, 63, 21, -291, 85,
-	-291, -164, -291, 59, 41, 90, 90, -58, -2, 84,
-	19, -143, -145, -89, 12, -200, -199, 23, -197, 90,
-	159, 96, 12, -107, 27, -106, -91, -93, -94, -95,
-	-109, -133, -290, 12, -99, -100, -106, -108, -202, 84,
-	241, -177, -214, -179, -178, 325, 327, 127, -201, -197,
-	90, 29, 85, 84, -106, -216, -219, -221, -220, -222,
-	-217, -218, 264, 265, 153, 268, 270, 271, 272, 273,
-	274, 275, 276, 277, 278, 279, 30, 196, 260, 261,
-	262, 263, 280, 281, 282, 283, 284, 285, 286, 287,
-	247, 266, 355, 248, 249, 250, 251, 252, 253, 255,
-	256, 257, 258, 259, -271, -268, 83, 85, 84, -223,
-	83, -83, 173, -268, 174, 174, 174, -58, 344, -243,
-	-243, 204, -31, -28, -264, 16, -27, -28, 167, 104,
-	105, 164, 83, -232, 83, -241, -271, -268, 83, 115,
-	179, 114, -240, -237, -240, -241, -268, -134, -197, -202,
-	-268, 115, 115, -170, -197, -170, -170, 21, -170, 21,
-	-170, 21, 91, -197, -170, 21, -170, 21, -170, 21,
-	-170, 21, -170, 21, 29, 76, 77, 29, 79, 80,
-	81, -134, -134, -232, -174, -106, -268, 91, 91, -243,
-	-243, 91, 90, 90, 90, -243, -243, 91, 90, -204,
-	-202, 90, -274, 190, 232, 234, 91, 91, 91, 91,
-	29, 90, -275, 29, 473, 472, 474, 475, 476, 91,
-	29, 91, 29, 91, -197, 83, -106, -86, 222, 162,
-	164, 167, 74, 90, 236, 127, 42, 84, 176, 173,
-	-268, -189, 178, -189, -203, -202, -195, 90, -89, -239,
-	12, 137, -207, -207, -187, -106, -239, -207, -187, -106,
-	-187, -187, -187, -187, -207, -207, -207, -187, -202, -202,
-	-106, -106, -106, -106, -106, -106, -106, -212, -212, -212,
-	-188, -187, 451, 90, 74, -210, 244, 278, 445, 446,
-	447, 84, 357, -99, 451, 451, 451, 451, 451, 451,
-	-89, -89, -89, -89, -124, 100, 119, 101, 102, -117,
-	-125, -129, -132, 95, 137, 135, 136, 121, -117, -117,
-	-117, -117, -117, -117, -117, -117, -117, -117, -117, -117,
-	-117, -117, -117, -213, -268, 90, 153, -268, -116, -116,
-	-197, -78, 22, 36, -77, -79, -198, -203, -195, -77,
-	-77, -89, -89, -134, 90, -77, -134, 90, -77, -77,
-	-72, 22, 36, -136, -137, 123, -134, -291, -117, -197,
-	-197, -77, -78, -78, -77, -77, -164, -291, 84, -282,
-	327, 328, 449, -205, 207, -204, 23, -127, -126, 90,
-	57, -77, -82, 61, 62, -291, 60, 90, -224, -174,
-	-89, -89, 84, -146, 25, 26, -106, -199, 144, -203,
-	-106, -169, 207, -106, 30, 84, -102, -104, -103, -105,
-	64, 68, 70, 65, 66, 67, 71, -208, 23, -91,
-	-3, -2, -290, -106, -99, -292, 84, 12, 75, -292,
-	84, 159, -177, -179, 84, 326, 328, 329, 74, 103,
-	-89, -225, 152, -250, -249, -248, -232, -234, -235, -236,
-	85, -150, -88, 38, -228, 292, 291, -223, -223, -223,
-	-223, -223, -224, -224, -224, -224, 83, 83, -223, -223,
-	-223, -223, -226, 83, -226, -226, -227, 83, -227, -261,
-	-89, -258, -257, -255, -256, 183, 97, 357, 75, -253,
-	-146, 91, -86, -190, 178, -259, -256, -268, -268, -268,
-	-190, -268, 90, -268, 90, 84, 17, -233, -232, -43,
-	232, -263, 207, -260, -254, -241, 115, -240, -241, -241,
-	159, -268, 84, 27, 108, 108, 108, 108, 357, 164,
-	30, -232, -43, -213, 176, -213, -213, 90, 90, -186,
-	481, -99, -85, 224, 127, 213, 213, 173, 173, 226,
-	-106, 237, 238, 236, 21, 225, 227, 229, 215, -106,
-	-106, -189, 74, -101, -106, 24, -202, -106, -187, -187,
-	-106, -187, -187, -106, -197, -69, 327, 357, 20, -70,
-	20, 100, 101, 102, -125, -117, -117, -117, -76, 197,
-	118, -291, -291, -77, -77, 84, -290, 159, -291, -291,
-	84, 75, 23, 12, 12, -291, 12, 12, -291, -291,
-	-77, -139, -137, 125, -89, -291, -291, 84, 84, -291,
-	-291, -291, -291, -291, -281, 448, 328, -110, 72, 177,
-	73, -290, -204, -291, -118, -197, 91, 94, -149, -90,
-	-92, 12, -82, -224, -153, -158, -184, -268, 90, 153,
-	-145, 159, -111, 13, -115, 30, 55, -5, -290, -290,
-	-172, -176, -134, -93, -94, -94, -93, -94, 64, 64,
-	64, 69, 64, 69, 64, -103, -202, -291, -291, -3,
-	-169, 75, -91, -106, -91, -108, -202, 144, -178, -180,
-	330, 327, 333, -268, 90, 84, -248, -236, -287, 100,
-	119, 29, 74, 289, 97, -285, -286, 179, 114, 115,
-	191, 23, 39, -229, 293, -224, -224, -225, -225, -225,
-	-225, -231, 90, -231, 91, 91, 85, -34, -29, -30,
-	31, 78, -255, -243, 90, 37, -197, 85, -85, -106,
-	119, 74, -259, -259, -259, -202, 16, -166, -197, 84,
-	85, -135, 233, 85, -197, 85, 83, -241, -241, -198,
-	-197, -290, 173, 29, 29, -43, -135, -225, -268, 483,
-	482, 85, 175, 231, -87, 340, 90, 86, -106, -106,
-	-106, -106, -106, 167, 164, 216, 176, -99, -106, 84,
-	-63, 192, 187, -207, -207, 31, 327, 460, 458, -76,
-	118, -117, -117, -291, -291, -79, -78, -198, -215, 153,
-	264, 196, 262, 258, 278, 269, 291, 260, 292, -213,
-	-215, -117, -117, -117, -117, 354, -142, 126, -89, 124,
-	-117, -117, 174, 174, 174, -170, -181, 20, 12, 55,
-	55, -90, -111, -91, -82, -156, 444, -161, 44, -159,
-	-160, 45, -157, 46, 54, 144, -147, -89, -171, 74,
-	-172, -128, -130, -129, -290, -165, -291, -197, -170, -111,
-	84, 127, -97, -96, 74, 75, -98, 74, -96, 64,
-	64, -167, 83, -291, -111, -91, -111, -111, 159, 327,
-	331, 332, -248, 100, -117, 10, 90, 115, 115, -106,
-	83, -225, -225, 85, 84, 85, 84, 85, 84, -191,
-	394, 119, -30, -29, -243, -243, 91, -268, 175, 24,
-	-106, 74, 74, 74, 17, 84, -232, -134, 55, -258,
-	-166, -262, -263, -106, -116, -135, -106, -84, 222, 230,
-	83, 87, -270, 75, 213, 289, 213, -106, -63, -34,
-	-106, -187, -187, 31, -268, -117, -291, -291, -223, -223,
-	-223, -227, -223, 252, -223, 252, -291, -291, 20, 20,
-	20, 20, -290, -68, 350, -89, 84, 84, -290, -290,
-	-290, -291, -106, -111, -140, 14, -153, 49, 270, -163,
-	-162, 53, 45, -160, 17, 47, 17, 28, -171, 84,
-	-291, -291, 84, 55, 159, -291, -142, -176, -89, -89,
-	83, -89, -166, -142, -111, -120, 83, -117, -224, 90,
-	-224, 91, 91, 394, 29, 79, 80, 81, 29, 76,
-	77, -106, -106, -106, -106, -166, -197, 209, 85, -291,
-	84, -230, 357, 360, -167, 85, -267, 357, -269, -268,
-	-197, -197, -197, -224, -268, -117, -117, -117, -117, -117,
-	-147, 90, -117, -117, -168, -291, -197, 179, -168, -168,
-	-205, -140, -141, 15, 17, -154, 51, -152, 50, -152,
-	-162, 17, 17, 90, 17, 90, 115, -130, 55, -5,
-	-197, -128, -197, -147, -166, 85, -147, -166, 85, -225,
-	-225, 85, 85, -167, 210, -286, -263, 361, 361, 23,
-	-266, -265, -198, 83, 75, -291, -291, -291, -291, -71,
-	137, 357, -291, -291, -291, 84, -291, -291, -291, -110,
-	-141, -89, -126, -155, 52, 74, 103, 90, 90, 90,
-	10, -128, 159, 85, 85, -151, 23, -290, -5, 84,
-	127, -166, -106, -291, 355, 71, 358, -197, 179, 74,
-	103, -172, -197, -277, -278, 74, -287, -284, 100, 119,
-	97, -285, 109, 110, -5, -117, 206, -61, 357, -265,
-	-247, -198, 90, 91, 85, 60, 356, 359, -278, 74,
-	11, 10, 100, 90, -61, -291, -291, -62, 221, 448,
-	-270, 60, -276, 192, 187, 190, 30, -276, 184, -267,
-	357, 186, 29, 100, 223, 358, 359,
+	-1000, -306, -1, -5, -24, -25, -26, -27, -28, -29,
+	-30, -31, -32, -33, -34, -35, -58, -59, -60, -62,
+	-63, -64, -65, -66, -22, -61, -36, -37, -67, -68,
+	-69, -70, -71, -72, -3, -2, -7, 7, 8, -81,
+	10, 11, 30, -39, -49, 162, -50, -40, 163, -51,
+	165, 164, 201, 166, 194, 72, 240, 241, 243, 244,
+	245, 246, -82, 199, 200, 168, 34, 43, 31, 32,
+	35, 172, 171, -4, -8, 6, -308, 9, 347, 196,
+	195, 26, -6, 360, 83, -307, 491, -181, -166, 20,
+	31, 27, -165, -161, -89, -166, 18, 16, 5, -73,
+	-311, -73, -73, -73, -73, -73, -261, -263, 83, 135,
+	83, -73, -55, -54, -53, -52, -56, 29, -46, -47,
+	-281, -45, -42, 167, 164, 209, 104, 105, 201, 202,
+	203, 166, 185, 200, 204, 199, 218, -41, 78, 31,
+	360, 363, -268, 163, 169, 170, 348, 107, 106, 73,
+	165, -265, 290, 468, -56, 470, 97, 99, 469, 42,
+	175, 471, 472, 473, 474, 184, 475, 476, 477, 478,
+	484, 485, 486, 487, 108, 5, -73, -223, -219, -285,
+	-213, 86, 87, 88, 357, 187, 391, 392, 234, 78,
+	39, 290, 468, 240, 254, 248, 275, 267, 358, 393,
+	237, 188, 222, 465, 265, 113, 470, 394, 202, 295,
+	296, 297, 97, 243, 334, 483, 239, 395, 481, 99,
+	469, 77, 49, 447, 42, 197, 263, 259, 471, 223,
+	396, 368, 216, 107, 104, 490, 34, 257, 48, 28,
+	480, 106, 47, 472, 126, 397, 473, 299, 280, 459,
+	46, 300, 203, 398, 81, 362, 467, 301, 258, 302,
+	233, 479, 168, 399, 451, 303, 304, 460, 400, 281,
+	285, 401, 326, 305, 50, 402, 403, 461, 105, 404,
+	76, 474, 252, 253, 405, 231, 186, 328, 279, 184,
+	33, 306, 359, 235, 56, 210, 329, 44, 283, 43,
+	455, 406, 458, 278, 274, 51, 407, 408, 409, 410,
+	475, 277, 251, 273, 489, 228, 476, 60, 170, 287,
+	286, 288, 217, 325, 270, 411, 412, 413, 191, 79,
+	414, 260, 19, 415, 416, 307, 224, 417, 54, 418,
+	419, 332, 200, 420, 52, 477, 37, 205, 478, 421,
+	422, 423, 424, 425, 309, 426, 308, 282, 284, 212,
+	310, 361, 427, 448, 256, 204, 482, 428, 192, 466,
+	206, 209, 199, 333, 193, 429, 430, 431, 432, 433,
+	238, 434, 435, 244, 449, 450, 484, 41, 436, 437,
+	438, 439, 232, 227, 327, 336, 59, 80, 292, 440,
+	464, 250, 225, 441, 241, 53, 485, 486, 487, 219,
+	488, 7, 312, 108, 229, 230, 45, 271, 211, 442,
+	443, 261, 262, 276, 249, 272, 242, 452, 213, 313,
+	201, 444, 335, 226, 293, 365, 218, 314, 463, 364,
+	269, 266, 220, 445, 315, 176, 214, 215, 446, 453,
+	316, 317, 236, 318, 319, 320, 321, 322, 323, 324,
+	221, 363, 247, 264, 294, 174, -73, 174, -123, -219,
+	174, -191, 296, -210, 298, 311, 306, 316, 304, -202,
+	307, 309, 212, -301, 326, 174, 313, 162, 153, 299,
+	308, 317, 318, 236, 321, 324, 221, -296, -297, -285,
+	473, 488, 113, 268, 303, 301, 327, 455, 323, 322,
+	319, 320, -219, 242, -226, 247, -214, -285, -213, 245,
+	-123, -79, 451, 166, -228, -228, -91, 455, 457, -143,
+	-106, -129, 119, -134, 29, 24, -133, -130, -151, -148,
+	-149, 153, 154, 156, 155, 157, 142, 143, 150, 120,
+	158, -138, -136, -137, -139, -11, 90, 89, 98, 91,
+	92, 93, 94, 100, 101, 102, -214, -219, -146, -2,
+	66, 67, 348, 349, 350, 351, 356, 352, 122, 55,
+	343, 337, 346, 345, 344, 341, 342, 339, 340, 354,
+	355, 179, 338, 173, 148, 347, 388, 386, 369, 367,
+	385, 382, 376, 379, 372, 377, 381, -285, -213, -308,
+	42, 312, 312, -123, -73, -80, 469, 361, -159, -89,
+	-161, -4, -2, 6, -308, -73, -2, -3, -7, -9,
+	387, -10, -219, -166, -181, 10, 137, 40, 48, -164,
+	-165, -6, -2, -106, 17, 21, 22, -96, 144, -106,
+	-219, -74, -96, -200, 178, -200, -200, -190, -231, 242,
+	-194, 327, 326, -215, -192, -214, -212, -191, 325, 167,
+	366, 118, 23, 25, 121, 153, 17, 122, 35, 169,
+	268, 185, 152, 181, 348, 162, 70, 367, 339, 340,
+	337, 343, 350, 351, 338, 298, 29, 11, 369, 26,
+	195, 22, 36, 146, 164, 125, 198, 24, 196, 102,
+	372, 20, 73, 190, 12, 183, 38, 14, 373, 374,
+	15, 179, 178, 137, 175, 68, 9, 158, 27, 134,
+	64, 375, 115, 376, 377, 378, 379, 66, 135, 18,
+	341, 342, 31, 456, 380, 356, 207, 148, 71, 57,
+	457, 119, 381, 382, 100, 383, 103, 74, 462, 116,
+	16, 69, 40, 384, 208, 385, 180, 386, 330, 387,
+	136, 165, 347, 67, 388, 173, 311, 6, 353, 30,
+	194, 182, 110, 65, 389, 174, 124, 354, 355, 177,
+	101, 5, 114, 32, 10, 72, 75, 344, 345, 346,
+	55, 109, 360, 123, 13, 390, 331, 117, 113, -262,
+	135, -249, -253, -214, 189, -278, 185, -123, -271, -270,
+	-214, -90, -209, 175, 183, 182, 114, -289, 116, 229,
+	338, 173, -52, -53, -191, 152, 206, 84, 84, -253,
+	-252, -251, -290, 208, 189, -277, -269, 181, 190, -259,
+	182, 183, -254, 175, 115, -290, -254, 180, 190, 208,
+	208, 108, 208, 108, 208, 208, 208, 208, 208, 208,
+	208, 208, 208, 205, -260, 127, -260, 364, 364, -265,
+	-290, -290, -290, 177, 33, 33, -211, -254, 177, 23,
+	-260, -260, -191, 152, -260, -260, -260, -260, 216, 216,
+	-260, -260, -260, -260, -260, -260, -260, -260, -260, -260,
+	-260, -260, -260, -260, -260, 174, -289, -100, 324, 236,
+	78, -54, 218, -38, -123, -209, 175, 176, -289, -123,
+	159, -123, -204, 135, 13, -204, -201, 312, 310, 297,
+	302, -204, -204, -204, -204, 219, 295, -255, 175, 33,
+	186, 312, 219, 295, 219, 220, 219, 220, 305, 315,
+	219, -224, 12, 137, 338, 300, 304, 212, 174, 213,
+	176, 314, -285, 458, 220, -224, 90, -205, 135, 312,
+	215, -204, -204, -229, -308, -215, 268, -229, -229, 30,
+	177, -214, -75, -214, 90, -23, -5, -27, -26, -28,
+	127, -98, 312, -85, 153, 473, 459, 460, 461, 458,
+	309, 466, 464, 462, 219, 463, 84, 118, 116, 117,
+	134, -106, -131, 137, 119, 135, 136, 121, 139, 138,
+	149, 142, 143, 144, 145, 146, 147, 148, 140, 141,
+	152, 127, 128, 129, 130, 131, 132, 133, -199, -308,
+	-149, 160, 161, -134, -134, -134, -134, -134, -134, -134,
+	-134, -134, -134, -308, 159, -308, -308, -308, -308, -308,
+	-308, -308, -308, -155, -106, -308, -312, -308, -312, -140,
+	-308, -312, -140, -312, -140, -312, -312, -140, -312, -140,
+	-312, -312, -140, -308, -308, -308, -308, -308, -308, -308,
+	-308, -308, -308, -308, -308, -308, -308, -308, -308, -308,
+	-308, -2, -3, -143, -228, -298, -299, -126, -123, -308,
+	236, 91, -164, -6, -2, -159, -73, -2, -83, -97,
+	61, 62, -99, 22, 36, 65, 63, 21, -309, 85,
+	-309, -181, -309, 84, -9, -184, 83, 59, 41, 90,
+	90, 84, 19, -160, -162, -106, 12, -217, -216, 23,
+	-214, 90, 159, 96, 12, -124, 27, -123, -108, -110,
+	-111, -112, -126, -150, -308, 12, -116, -117, -123, -125,
+	-219, 84, 242, -194, -231, -196, -195, 328, 330, 127,
+	-218, -214, 90, 29, 85, 84, -123, -233, -236, -238,
+	-237, -239, -234, -235, 265, 266, 153, 269, 271, 272,
+	273, 274, 275, 276, 277, 278, 279, 280, 30, 197,
+	261, 262, 263, 264, 281, 282, 283, 284, 285, 286,
+	287, 288, 248, 267, 358, 249, 250, 251, 252, 253,
+	254, 256, 257, 258, 259, 260, -288, -285, 83, 85,
+	84, -240, 83, -100, 174, -285, 175, 175, 175, -73,
+	347, -260, -260, 205, -45, -42, -281, 16, -41, -42,
+	167, 104, 105, 164, 83, -249, 83, -258, -288, -285,
+	83, 115, 180, 114, -257, -254, -257, -258, -285, -151,
+	-214, -219, -285, 115, 115, -187, -214, -187, -187, 21,
+	-187, 21, -187, 21, 91, -214, -187, 21, -187, 21,
+	-187, 21, -187, 21, -187, 21, 29, 76, 77, 29,
+	79, 80, 81, -151, -151, -249, -191, -123, -285, 91,
+	91, -260, -260, 91, 90, 90, 90, -260, -260, 91,
+	90, -221, -219, 90, -291, 191, 233, 235, 91, 91,
+	91, 91, 29, 90, -292, 29, 480, 479, 481, 482,
+	483, 91, 29, 91, 29, 91, -214, 83, -123, -103,
+	223, 162, 164, 167, 74, 90, 237, 127, 42, 84,
+	177, 174, -285, -206, 179, -206, -220, -219, -212, 90,
+	-106, -256, 12, 137, -224, -224, -204, -123, -256, -224,
+	-204, -123, -204, -204, -204, -204, -224, -224, -224, -204,
+	-219, -219, -123, -123, -123, -123, -123, -123, -123, -229,
+	-229, -229, -205, -204, 458, 90, 74, -227, 245, 279,
+	452, 453, 454, 84, 360, -116, 458, 458, 458, 458,
+	458, 458, -106, -106, -106, -106, -141, 100, 119, 101,
+	102, -134, -142, -146, -149, 95, 137, 135, 136, 121,
+	-134, -134, -134, -134, -134, -134, -134, -134, -134, -134,
+	-134, -134, -134, -134, -134, -230, -285, 90, 153, -285,
+	-133, -133, -214, -94, 22, 36, -93, -96, -215, -220,
+	-212, -93, -93, -106, -106, -151, 90, -93, -151, 90,
+	-93, -93, -88, 22, 36, -153, -154, 123, -151, -309,
+	-134, -214, -214, -93, -94, -94, -93, -93, -309, -309,
+	-309, -309, -309, -106, -95, -106, -95, -106, -106, -106,
+	-181, -309, 84, -300, 330, 331, 456, -222, 208, -221,
+	23, -144, -143, 90, -164, 61, 62, 57, -93, -99,
+	-309, -10, 23, -183, -214, 60, 90, -241, -191, -106,
+	-106, 84, -163, 25, 26, -123, -216, 144, -220, -123,
+	-186, 208, -123, 30, 84, -119, -121, -120, -122, 64,
+	68, 70, 65, 66, 67, 71, -225, 23, -108, -3,
+	-2, -308, -123, -116, -310, 84, 12, 75, -310, 84,
+	159, -194, -196, 84, 329, 331, 332, 74, 103, -106,
+	-242, 152, -267, -266, -265, -249, -251, -252, -253, 85,
+	-167, -105, 38, -245, 293, 292, -240, -240, -240, -240,
+	-240, -241, -241, -241, -241, 83, 83, -240, -240, -240,
+	-240, -243, 83, -243, -243, -244, 83, -244, -278, -106,
+	-275, -274, -272, -273, 184, 97, 360, 75, -270, -163,
+	91, -103, -207, 179, -276, -273, -285, -285, -285, -207,
+	-285, 90, -285, 90, 84, 17, -250, -249, -57, 233,
+	-280, 208, -277, -271, -258, 115, -257, -258, -258, 159,
+	-285, 84, 27, 108, 108, 108, 108, 360, 164, 30,
+	-249, -57, -230, 177, -230, -230, 90, 90, -203, 488,
+	-116, -102, 225, 127, 214, 214, 174, 174, 227, -123,
+	238, 239, 237, 21, 226, 228, 230, 216, -123, -123,
+	-206, 74, -118, -123, 24, -219, -123, -204, -204, -123,
+	-204, -204, -123, -214, -85, 330, 360, 20, -86, 20,
+	100, 101, 102, -142, -134, -134, -134, -92, 198, 118,
+	-309, -309, -93, -93, 84, -308, 159, -309, -309, 84,
+	75, 23, 12, 12, -309, 12, 12, -309, -309, -93,
+	-156, -154, 125, -106, -309, -309, 84, 84, -309, -309,
+	-309, -309, -309, -13, 384, -13, -13, -13, -13, -309,
+	-309, 84, -309, -309, -309, 84, -299, 455, 331, -127,
+	72, 178, 73, -308, -221, -309, -135, -214, 91, 94,
+	-166, -107, -109, 12, -99, -149, 85, 84, -241, -170,
+	-175, -201, -285, 90, 153, -162, 159, -128, 13, -132,
+	30, 55, -5, -308, -308, -189, -193, -151, -110, -111,
+	-111, -110, -111, 64, 64, 64, 69, 64, 69, 64,
+	-120, -219, -309, -309, -3, -186, 75, -108, -123, -108,
+	-125, -219, 144, -195, -197, 333, 330, 336, -285, 90,
+	84, -265, -253, -305, 100, 119, 29, 74, 290, 97,
+	-303, -304, 180, 114, 115, 192, 23, 39, -246, 294,
+	-241, -241, -242, -242, -242, -242, -248, 90, -248, 91,
+	91, 85, -48, -43, -44, 31, 78, -272, -260, 90,
+	37, -214, 85, -102, -123, 119, 74, -276, -276, -276,
+	-219, 16, -183, 84, 85, -152, 234, 85, -214, 85,
+	83, -258, -258, -215, -214, -308, 174, 29, 29, -57,
+	-152, -242, -285, 490, 489, 85, 176, 232, -104, 343,
+	90, 86, -123, -123, -123, -123, -123, 167, 164, 217,
+	177, -116, -123, 84, -78, 193, 188, -224, -224, 31,
+	330, 467, 465, -92, 118, -134, -134, -13, -309, -309,
+	-96, -94, -215, -232, 153, 265, 197, 263, 259, 279,
+	270, 292, 261, 293, -230, -232, -134, -134, -134, -134,
+	357, -159, 126, -106, 124, -134, -134, -308, -214, -13,
+	-13, -106, -13, -13, -13, -106, 175, 175, 175, -187,
+	-198, 20, 12, 55, 55, -107, -128, -108, -99, -214,
+	-173, 451, -178, 44, -176, -177, 45, -174, 46, 54,
+	144, -164, -106, -188, 74, -189, -145, -147, -146, -308,
+	-182, -309, -214, -187, -128, 84, 127, -114, -113, 74,
+	75, -115, 74, -113, 64, 64, -184, -309, -128, -108,
+	-128, -128, 159, 330, 334, 335, -265, 100, -134, 10,
+	90, 115, 115, -123, 83, -242, -242, 85, 84, 85,
+	84, 85, 84, -208, 397, 119, -44, -43, -260, -260,
+	91, -285, 176, 24, -123, 74, 74, 74, 17, -249,
+	-151, 55, -275, -183, -279, -280, -123, -133, -152, -123,
+	-101, 223, 231, 83, 87, -287, 75, 214, 290, 214,
+	-123, -78, -48, -123, -204, -204, 31, -285, -134, -309,
+	-309, -240, -240, -240, -244, -240, 253, -240, 253, -309,
+	-309, 20, 20, 20, 20, -308, -84, 353, -106, 84,
+	84, -14, -15, 86, 208, 84, -309, -308, -308, -308,
+	-309, -123, -128, -157, 14, -170, 49, 271, -180, -179,
+	53, 45, -177, 17, 47, 17, 28, -188, 84, -309,
+	-309, 84, 55, 159, -309, -159, -193, -106, -106, 83,
+	-106, -159, -128, -137, 83, -134, -241, 90, -241, 91,
+	91, 397, 29, 79, 80, 81, 29, 76, 77, -123,
+	-123, -123, -123, -183, 210, 85, -309, 84, -247, 360,
+	363, -184, 85, -284, 360, -286, -285, -214, -214, -214,
+	-241, -285, -134, -134, -134, -134, -134, -164, 90, -134,
+	-134, -309, -159, -15, 17, -106, -13, -185, -309, -214,
+	180, -185, -185, -222, -157, -158, 15, 17, -171, 51,
+	-169, 50, -169, -179, 17, 17, 90, 17, 90, 115,
+	-147, 55, -5, -214, -145, -214, -164, -183, -164, -183,
+	85, -242, -242, 85, 85, -184, 211, -304, -280, 364,
+	364, 23, -283, -282, -215, 83, 75, -309, -309, -309,
+	-309, -87, 137, 360, -309, -309, -309, -16, -18, 450,
+	448, -159, -143, 84, -309, -309, -309, -127, -158, -20,
+	390, -106, -143, -172, 52, 74, 103, 90, 90, 90,
+	10, -145, 159, 85, 85, -168, 23, -308, -5, 84,
+	127, -183, -123, -309, 358, 71, 361, -17, 121, 447,
+	444, -12, 91, 94, 158, -16, -214, 180, -20, -21,
+	-19, -214, 74, 103, -189, -214, -294, -295, 74, -305,
+	-302, 100, 119, 97, -303, 109, 110, -5, -134, 207,
+	-76, 360, -282, -264, -215, 90, 91, 85, 60, 359,
+	362, -17, 449, 424, 399, 424, 399, -134, 84, 23,
+	-295, 74, 11, 10, 100, 90, -76, -309, -309, -77,
+	222, 455, -287, 60, 118, -214, -19, -308, -293, 193,
+	188, 191, 30, -293, 185, -284, 360, -17, -14, 187,
+	29, 100, 224, 361, -309, 362,
 }
 
 var yyDef = [...]int{
-	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
+	35, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 54, 56, 57, 590, 590, 590, 590,
-	590, 590, 0, 0, 590, -2, -2, 590, 995, 0,
-	590, 0, 0, -2, 523, 524, 0, 526, -2, 0,
-	0, 535, 1415, 1415, 585, 0, 0, 0, 0, 0,
-	590, 859, 590, 0, 69, 70, 541, 542, 543, 49,
-	1413, 1, 3, 55, 59, 0, 0, 0, 46, 868,
-	0, 62, 0, 0, 594, 0, 592, 0, 978, 978,
-	978, 0, 100, 101, 0, 0, 0, -2, 104, -2,
-	128, 129, 0, 133, 397, 358, 400, 356, 386, -2,
-	349, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 361, 251, 251, 0, 0, -2, 349,
-	349, 349, 0, 0, 0, 383, 980, 304, 251, 251,
-	0, 251, 251, 251, 251, 0, 0, 251, 251, 251,
-	251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
-	251, 251, 885, 132, 996, 993, 994, 35, 36, 37,
-	1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147,
-	1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157,
-	1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167,
-	1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177,
-	1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187,
-	1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197,
+	31, 32, 33, 34, 61, 63, 64, 604, 604, 604,
+	604, 604, 604, 0, 0, 604, -2, -2, 604, 1049,
+	0, 604, 0, 0, -2, 533, 534, 0, 536, -2,
+	0, 0, 545, 1475, 1475, 599, 0, 0, 0, 0,
+	0, 604, 575, 913, 0, 604, 0, 76, 77, 551,
+	552, 553, 51, 0, 1473, 1, 3, 62, 66, 0,
+	0, 0, 48, 922, 0, 69, 0, 0, 608, 0,
+	606, 0, 1032, 1032, 1032, 0, 107, 108, 0, 0,
+	0, -2, 111, -2, 135, 136, 0, 140, 404, 365,
+	407, 363, 393, -2, 356, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 368, 258, 258,
+	0, 0, -2, 356, 356, 356, 0, 0, 0, 390,
+	1034, 311, 258, 258, 0, 258, 258, 258, 258, 0,
+	0, 258, 258, 258, 258, 258, 258, 258, 258, 258,
+	258, 258, 258, 258, 258, 258, 939, 139, 1050, 1047,
+	1048, 36, 37, 38, 1192, 1193, 1194, 1195, 1196, 1197,
 	1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207,
 	1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217,
 	1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227,
@@ -5389,30 +5638,33 @@ var yyDef = [...]int{
 	1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387,
 	1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395, 1396, 1397,
 	1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405, 1406, 1407,
-	1408, 1409, 1410, 1411, 1412, 0, 972, 0, 453, 680,
-	0, 514, 514, 0, 514, 514, 514, 514, 0, 0,
-	0, 465, 0, 0, 0, 0, 511, 0, 0, 484,
-	486, 0, 511, 0, 517, 0, 498, 514, 1416, 1416,
-	1416, 963, 0, 508, 506, 520, 521, 503, 504, 522,
-	525, 0, 530, 533, 989, 990, 0, 548, 0, 1217,
-	540, 553, 554, 0, 586, 587, 40, 731, 690, 0,
-	696, 698, 0, 733, 734, 735, 736, 737, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 763, 764,
-	765, 766, 844, 845, 846, 847, 848, 849, 850, 851,
-	700, 701, 841, 0, 952, 730, 0, 0, 0, 0,
-	0, 0, 0, 0, 832, 0, 801, 801, 801, 801,
-	801, 801, 801, 801, 801, 0, 0, 0, 0, 0,
-	0, 0, -2, -2, 0, 1415, 0, 563, 0, 0,
-	868, 0, 860, -2, 0, 0, 0, 61, 60, 904,
-	0, 0, 0, 47, 869, 51, 53, 590, 0, 870,
-	0, 595, 596, 0, 619, 623, 0, 591, 0, 0,
-	979, 0, 0, 86, 0, 1376, 956, -2, -2, 0,
-	0, 991, 992, 965, -2, 999, 1000, 1001, 1002, 1003,
-	1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013,
-	1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023,
-	1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033,
-	1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043,
-	1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053,
+	1408, 1409, 1410, 1411, 1412, 1413, 1414, 1415, 1416, 1417,
+	1418, 1419, 1420, 1421, 1422, 1423, 1424, 1425, 1426, 1427,
+	1428, 1429, 1430, 1431, 1432, 1433, 1434, 1435, 1436, 1437,
+	1438, 1439, 1440, 1441, 1442, 1443, 1444, 1445, 1446, 1447,
+	1448, 1449, 1450, 1451, 1452, 1453, 1454, 1455, 1456, 1457,
+	1458, 1459, 1460, 1461, 1462, 1463, 1464, 1465, 1466, 1467,
+	1468, 1469, 1470, 1471, 1472, 0, 1026, 0, 460, 694,
+	0, 524, 524, 0, 524, 524, 524, 524, 0, 0,
+	0, 472, 0, 0, 0, 0, 521, 0, 0, 491,
+	493, 0, 521, 0, 527, 0, 505, 524, 524, 1476,
+	1476, 1476, 1017, 0, 518, 516, 530, 531, 511, 512,
+	513, 514, 532, 535, 0, 540, 543, 1043, 1044, 0,
+	558, 0, 1272, 550, 563, 564, 0, 600, 601, 41,
+	745, 704, 0, 710, 712, 0, 747, 748, 749, 750,
+	751, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 777, 778, 779, 780, 781, 898, 899, 900, 901,
+	902, 903, 904, 905, 714, 715, 895, 0, 1006, 744,
+	0, 0, 0, 0, 0, 0, 0, 0, 886, 0,
+	855, 855, 855, 855, 855, 855, 855, 855, 855, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, -2, -2, 0,
+	1475, 0, 573, 0, 0, 0, 576, 577, 922, 0,
+	914, 913, 0, 604, 0, -2, 0, 0, 0, 56,
+	0, 58, 655, 68, 67, 958, 0, 0, 0, 49,
+	923, 53, 55, 924, 0, 609, 610, 0, 633, 637,
+	0, 605, 0, 0, 1033, 0, 0, 93, 0, 1434,
+	1010, -2, -2, 0, 0, 1045, 1046, 1019, -2, 1053,
 	1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063,
 	1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073,
 	1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083,
@@ -5421,161 +5673,177 @@ var yyDef = [...]int{
 	1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113,
 	1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123,
 	1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133,
-	1134, 1135, 1136, 1137, -2, 0, 0, 142, 143, 0,
-	38, 277, 0, 138, 0, 271, 223, 885, 0, 0,
-	0, 0, 0, 590, 0, 973, 123, 124, 130, 131,
-	251, 251, 0, 132, 132, 365, 366, 367, 0, 0,
-	-2, 275, 0, 350, 0, 0, 265, 265, 269, 267,
-	268, 0, 0, 0, 0, 0, 0, 377, 0, 378,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 437,
-	0, 252, 0, 395, 396, 305, 0, 0, 0, 0,
-	375, 376, 0, 0, 981, 982, 0, 0, 251, 251,
-	0, 0, 0, 0, 251, 251, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 122, 898, 0, 0, 0, -2, 0, 445,
-	0, 0, 0, 974, 974, 452, 0, 454, 455, 0,
-	0, 456, 0, 511, 511, 509, 510, 458, 459, 460,
-	461, 514, 0, 0, 260, 261, 262, 511, 514, 0,
-	514, 514, 514, 514, 511, 511, 511, 514, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1416, 1416, 1416,
-	517, 514, 0, 494, 0, 495, 496, 499, 500, 1417,
-	1418, 1010, 501, 502, 964, 531, 534, 551, 549, 550,
-	552, 544, 545, 546, 547, 0, 565, 566, 571, 0,
-	0, 0, 0, 577, 578, 579, 0, 0, 582, 583,
-	584, 0, 0, 0, 0, 0, 694, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 718, 719, 720, 721,
-	722, 723, 724, 697, 0, 711, 0, 0, 753, 754,
-	755, 756, 757, 758, 759, 760, 761, 0, 603, 0,
-	0, 0, 0, 0, 0, 0, 0, 600, 0, 833,
-	0, 784, 0, 785, 793, 0, 786, 794, 787, 795,
-	788, 789, 796, 790, 797, 791, 792, 798, 0, 0,
-	0, 603, 603, 0, 0, 730, 0, 0, 41, 555,
-	556, 0, 663, 984, 0, 45, 50, 52, 0, 0,
-	-2, -2, 606, 612, 613, 614, 615, 616, 42, 1414,
-	43, 0, 58, 0, 0, 235, 907, 605, 0, 0,
-	0, 861, 862, 865, 0, 620, 624, 0, 626, 627,
-	0, 593, 0, 84, 0, 679, 0, 631, 633, 634,
-	635, 661, 0, 0, 0, 0, 80, 82, 680, 0,
-	1376, 962, 0, 88, 89, 0, 0, 0, 239, 967,
-	968, 969, -2, 258, 0, -2, 230, 174, 175, 176,
-	223, 178, 223, 223, 223, 223, 235, 235, 235, 235,
-	206, 207, 208, 209, 210, 0, 0, 193, 223, 223,
-	223, 223, 213, 214, 215, 216, 217, 218, 219, 220,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 225,
-	225, 225, 227, 227, 0, 39, 0, 243, 0, 865,
-	0, 898, 976, 986, 0, 0, 0, 976, 106, 0,
-	0, 398, 359, 387, 399, 0, 362, 363, -2, 0,
-	0, 349, 0, 351, 0, 259, 0, -2, 0, 269,
-	0, 265, 269, 266, 269, 257, 270, 379, 841, 0,
-	380, 381, 0, 417, 649, 0, 0, 0, 0, 0,
-	423, 424, 425, 0, 427, 428, 429, 430, 431, 432,
-	433, 434, 435, 436, 388, 389, 390, 391, 392, 393,
-	394, 0, 0, 351, 0, 384, 0, 306, 307, 0,
-	0, 310, 311, 312, 313, 0, 0, 316, 317, 318,
-	666, 667, 319, 343, 344, 345, 320, 321, 322, 323,
-	324, 325, 326, 337, 338, 339, 340, 341, 342, 327,
-	328, 329, 330, 331, 334, 0, 116, 889, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 974,
-	0, 0, 0, 0, 681, 997, 998, 515, 516, 0,
-	263, 264, 514, 514, 462, 485, 0, 514, 466, 487,
-	467, 469, 468, 470, 489, 490, 514, 473, 512, 513,
-	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
-	491, 492, 493, 518, 0, 0, 532, 536, 537, 538,
-	539, 0, 0, 568, 573, 574, 575, 576, 588, 581,
-	732, 691, 692, 693, 695, 712, 0, 714, 716, 702,
-	703, 727, 728, 729, 0, 0, 0, 0, 725, 707,
-	0, 738, 739, 740, 741, 742, 743, 744, 745, 746,
-	747, 748, 749, 752, 816, 817, 818, 0, 750, 751,
-	762, 0, 0, 0, 604, 617, 842, 0, -2, 0,
-	0, 0, 0, 735, 844, 0, 735, 844, 0, 0,
-	0, 601, 602, 839, 836, 0, 0, 802, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 951, 0, 558,
-	559, 561, 0, 683, 0, 664, 0, 0, 985, 564,
-	0, 628, 607, 610, 611, 44, 905, 235, 927, 0,
-	871, 872, 0, 864, 866, 867, 63, 625, 621, 0,
-	688, 0, 0, 678, 0, 0, 0, 0, 0, 0,
-	668, 0, 0, 671, 0, 0, 0, 0, 662, 0,
-	0, 0, 0, -2, 0, 0, 0, 76, 77, 0,
-	0, 0, 957, 87, 0, 0, 92, 93, 958, 959,
-	960, 961, 0, 125, -2, 301, 144, 146, 147, 148,
-	139, 283, 0, 0, 233, 231, 232, 177, 235, 235,
-	200, 201, 239, 239, 239, 239, 0, 0, 194, 195,
-	196, 197, 188, 0, 189, 190, 191, 0, 192, 276,
-	0, 873, 244, 245, 247, 251, 0, 0, 0, 272,
-	273, 0, 889, 0, 0, 0, 987, 986, 986, 986,
-	0, 134, 135, 136, 137, 132, 0, 0, 140, 353,
-	352, 0, 0, 274, 0, 0, 269, 269, 254, 255,
-	0, 382, 0, 0, 419, 420, 421, 422, 0, 0,
-	0, 351, 353, 239, 0, 308, 309, 314, 315, 332,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 412, 413, 414, 415, 886, 887, 888, 0, 0,
-	446, 0, 0, 293, 78, 975, 451, 511, 472, 488,
-	511, 464, 471, 497, 528, 572, 0, 0, 0, 580,
-	0, 713, 715, 717, 704, 725, 708, 0, 705, 0,
-	0, 699, 767, 0, 0, 0, 603, 0, 771, 772,
-	0, 0, 0, 0, 0, 809, 0, 0, 810, 0,
-	859, 0, 837, 0, 0, 783, 803, 0, 0, 804,
-	805, 806, 807, 808, 557, 560, 562, 639, 0, 0,
-	0, 0, 665, 983, 0, 852, 0, 0, 628, 688,
-	629, 0, 608, 909, 908, 921, 934, 236, 237, 238,
-	863, 0, 868, 0, 945, 0, 0, 937, 0, 0,
-	688, 953, 0, 632, 657, 659, 0, 654, 669, 670,
-	672, 0, 674, 0, 676, 677, 641, 637, 638, 0,
-	688, 0, 688, 81, 688, 83, 0, 682, 90, 91,
-	0, 0, 97, 240, 241, 132, 303, 145, 149, 154,
-	0, 0, 0, 158, 0, 160, 284, 0, 170, 172,
-	0, 0, 152, 173, 234, 239, 239, 202, 203, 204,
-	205, 0, 221, 0, 0, 0, 296, 102, 877, 876,
-	251, 251, 246, 0, 249, 0, 988, 224, 0, 115,
-	0, 0, 0, 0, 0, 121, 0, 357, 643, 0,
-	368, 369, 0, 416, 0, 243, 0, 253, 256, 842,
-	650, 0, 0, 370, 0, 353, 373, 374, 385, 335,
-	336, 333, 0, 0, 899, 900, 0, 903, 107, 405,
-	407, 406, 410, 0, 0, 403, 0, 293, 873, 0,
-	450, 294, 295, 514, 514, 567, 0, 570, 0, 706,
-	0, 726, 709, 768, 769, 618, 0, 843, 0, 223,
-	223, 822, 223, 227, 825, 223, 827, 223, 830, 0,
-	0, 0, 0, 0, 0, 0, 834, 782, 840, 0,
-	0, 0, 0, 0, 0, 0, 0, 970, 971, 853,
-	854, 688, 855, 630, 609, 927, 0, 920, 0, -2,
-	929, 0, 0, 0, 935, 622, 64, 689, 67, 0,
-	945, 936, 947, 949, 0, 0, 0, 941, 0, 859,
-	0, 0, 651, 658, 0, 0, 652, 0, 653, 673,
-	675, 636, 0, -2, 859, 688, 74, 75, 0, 94,
-	95, 96, 302, 155, 156, 0, 159, 169, 171, 0,
-	0, 198, 199, 235, 0, 235, 0, 228, 0, 285,
-	297, 0, 874, 875, 0, 0, 248, 250, 0, 977,
-	117, 0, 0, 0, 0, 0, 141, 354, 0, 242,
-	0, 0, 441, 438, 371, 372, 641, 890, 891, 892,
-	0, 902, 110, 0, 0, 0, 0, 447, 448, 449,
-	79, 457, 463, 569, 589, 710, 770, 773, 819, 235,
-	823, 824, 826, 828, 829, 831, 775, 774, 0, 0,
-	0, 0, 0, 868, 0, 838, 0, 0, 0, 0,
-	0, 663, 48, 855, 857, 0, 914, 912, 912, 922,
-	923, 0, 0, 930, 0, 0, 0, 0, 68, 0,
-	950, 0, 0, 0, 0, 85, 868, 954, 955, 655,
-	0, 660, 0, 868, 73, 157, 0, 0, 239, 222,
-	239, 0, 0, 298, 878, 879, 880, 881, 882, 883,
-	884, 641, 118, 119, 120, 360, 644, 0, 0, 418,
-	0, 426, 0, 0, 0, 901, 404, 0, 108, 109,
-	0, 0, 409, 820, 821, 0, 0, 0, 0, 811,
-	0, 835, 0, 0, 0, 685, 645, 646, 0, 0,
-	683, 857, 66, 0, 0, 917, 0, 910, 913, 911,
-	924, 0, 0, 931, 0, 933, 0, 948, 0, 940,
-	943, 939, 942, 71, 0, 642, 72, 0, 163, 211,
-	212, 226, 229, 0, 0, 278, 442, 439, 440, 0,
-	111, 112, 0, 0, 0, 776, 778, 777, 779, 0,
-	0, 0, 781, 799, 800, 0, 684, 686, 687, 640,
-	65, 858, 856, 906, 0, 915, 916, 925, 926, 932,
-	0, 938, 0, 656, 279, 283, 0, 0, 893, 0,
-	0, 0, 411, 780, 0, 0, 0, 647, 648, 918,
-	919, 946, 944, 280, 281, 0, 150, 164, 165, 0,
-	0, 168, 161, 162, 893, 0, 0, 401, 895, 113,
-	114, 346, 347, 348, 107, 812, 0, 815, 282, 0,
-	0, 0, 166, 167, 103, 443, 444, 0, 896, 897,
-	110, 813, 286, 288, 289, 0, 0, 287, 0, 408,
-	0, 290, 291, 292, 894, 0, 814,
+	1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143,
+	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153,
+	1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163,
+	1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173,
+	1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
+	1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, -2, 0,
+	0, 149, 150, 0, 39, 284, 0, 145, 0, 278,
+	230, 939, 0, 0, 0, 0, 0, 604, 0, 1027,
+	130, 131, 137, 138, 258, 258, 0, 139, 139, 372,
+	373, 374, 0, 0, -2, 282, 0, 357, 0, 0,
+	272, 272, 276, 274, 275, 0, 0, 0, 0, 0,
+	0, 384, 0, 385, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 444, 0, 259, 0, 402, 403, 312,
+	0, 0, 0, 0, 382, 383, 0, 0, 1035, 1036,
+	0, 0, 258, 258, 0, 0, 0, 0, 258, 258,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 952, 0, 0,
+	0, -2, 0, 452, 0, 0, 0, 1028, 1028, 459,
+	0, 461, 462, 0, 0, 463, 0, 521, 521, 519,
+	520, 465, 466, 467, 468, 524, 0, 0, 267, 268,
+	269, 521, 524, 0, 524, 524, 524, 524, 521, 521,
+	521, 524, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1476, 1476, 1476, 527, 524, 0, 501, 0, 502,
+	503, 506, 507, 508, 1477, 1478, 1064, 509, 510, 1018,
+	541, 544, 561, 559, 560, 562, 554, 555, 556, 557,
+	0, 579, 580, 585, 0, 0, 0, 0, 591, 592,
+	593, 0, 0, 596, 597, 598, 0, 0, 0, 0,
+	0, 708, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 732, 733, 734, 735, 736, 737, 738, 711, 0,
+	725, 0, 0, 767, 768, 769, 770, 771, 772, 773,
+	774, 775, 0, 617, 0, 0, 0, 0, 0, 0,
+	0, 0, 614, 0, 887, 0, 838, 0, 839, 847,
+	0, 840, 848, 841, 849, 842, 843, 850, 844, 851,
+	845, 846, 852, 0, 0, 0, 617, 617, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 744, 0, 0, 42, 565, 566, 0, 677, 1038,
+	0, 574, 46, 52, 54, 922, 619, 0, 0, 0,
+	-2, -2, 620, 626, 627, 628, 629, 630, 43, 1474,
+	44, 0, 65, 0, 57, 0, 0, 0, 0, 242,
+	961, 0, 0, 915, 916, 919, 0, 634, 638, 0,
+	640, 641, 0, 607, 0, 91, 0, 693, 0, 645,
+	647, 648, 649, 675, 0, 0, 0, 0, 87, 89,
+	694, 0, 1434, 1016, 0, 95, 96, 0, 0, 0,
+	246, 1021, 1022, 1023, -2, 265, 0, -2, 237, 181,
+	182, 183, 230, 185, 230, 230, 230, 230, 242, 242,
+	242, 242, 213, 214, 215, 216, 217, 0, 0, 200,
+	230, 230, 230, 230, 220, 221, 222, 223, 224, 225,
+	226, 227, 186, 187, 188, 189, 190, 191, 192, 193,
+	194, 232, 232, 232, 234, 234, 0, 40, 0, 250,
+	0, 919, 0, 952, 1030, 1040, 0, 0, 0, 1030,
+	113, 0, 0, 405, 366, 394, 406, 0, 369, 370,
+	-2, 0, 0, 356, 0, 358, 0, 266, 0, -2,
+	0, 276, 0, 272, 276, 273, 276, 264, 277, 386,
+	895, 0, 387, 388, 0, 424, 663, 0, 0, 0,
+	0, 0, 430, 431, 432, 0, 434, 435, 436, 437,
+	438, 439, 440, 441, 442, 443, 395, 396, 397, 398,
+	399, 400, 401, 0, 0, 358, 0, 391, 0, 313,
+	314, 0, 0, 317, 318, 319, 320, 0, 0, 323,
+	324, 325, 680, 681, 326, 350, 351, 352, 327, 328,
+	329, 330, 331, 332, 333, 344, 345, 346, 347, 348,
+	349, 334, 335, 336, 337, 338, 341, 0, 123, 943,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1028, 0, 0, 0, 0, 695, 1051, 1052, 525,
+	526, 0, 270, 271, 524, 524, 469, 492, 0, 524,
+	473, 494, 474, 476, 475, 477, 496, 497, 524, 480,
+	522, 523, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 498, 499, 500, 528, 0, 0, 542, 546,
+	547, 548, 549, 0, 0, 582, 587, 588, 589, 590,
+	602, 595, 746, 705, 706, 707, 709, 726, 0, 728,
+	730, 716, 717, 741, 742, 743, 0, 0, 0, 0,
+	739, 721, 0, 752, 753, 754, 755, 756, 757, 758,
+	759, 760, 761, 762, 763, 766, 870, 871, 872, 0,
+	764, 765, 776, 0, 0, 0, 618, 631, 896, 0,
+	-2, 0, 0, 0, 0, 749, 898, 0, 749, 898,
+	0, 0, 0, 615, 616, 893, 890, 0, 0, 856,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 798, 0, 0, 0, 0,
+	0, 1005, 0, 568, 569, 571, 0, 697, 0, 678,
+	0, 0, 1039, 578, 47, 624, 625, 0, 642, 621,
+	45, 59, 0, 0, 657, 959, 242, 981, 0, 925,
+	926, 0, 918, 920, 921, 70, 639, 635, 0, 702,
+	0, 0, 692, 0, 0, 0, 0, 0, 0, 682,
+	0, 0, 685, 0, 0, 0, 0, 676, 0, 0,
+	0, 0, -2, 0, 0, 0, 83, 84, 0, 0,
+	0, 1011, 94, 0, 0, 99, 100, 1012, 1013, 1014,
+	1015, 0, 132, -2, 308, 151, 153, 154, 155, 146,
+	290, 0, 0, 240, 238, 239, 184, 242, 242, 207,
+	208, 246, 246, 246, 246, 0, 0, 201, 202, 203,
+	204, 195, 0, 196, 197, 198, 0, 199, 283, 0,
+	927, 251, 252, 254, 258, 0, 0, 0, 279, 280,
+	0, 943, 0, 0, 0, 1041, 1040, 1040, 1040, 0,
+	141, 142, 143, 144, 139, 0, 0, 147, 360, 359,
+	0, 0, 281, 0, 0, 276, 276, 261, 262, 0,
+	389, 0, 0, 426, 427, 428, 429, 0, 0, 0,
+	358, 360, 246, 0, 315, 316, 321, 322, 339, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	419, 420, 421, 422, 940, 941, 942, 0, 0, 453,
+	0, 0, 300, 85, 1029, 458, 521, 479, 495, 521,
+	471, 478, 504, 538, 586, 0, 0, 0, 594, 0,
+	727, 729, 731, 718, 739, 722, 0, 719, 0, 0,
+	713, 782, 0, 0, 0, 617, 0, 825, 826, 0,
+	0, 0, 0, 0, 863, 0, 0, 864, 0, 913,
+	0, 891, 0, 0, 837, 857, 0, 0, 858, 859,
+	860, 861, 862, 786, 0, 787, 788, 789, 790, 0,
+	0, 0, 0, 0, 0, 0, 567, 570, 572, 653,
+	0, 0, 0, 0, 679, 1037, 0, 906, 0, 0,
+	642, 702, 643, 0, 622, 60, 656, 0, 963, 962,
+	975, 988, 243, 244, 245, 917, 0, 922, 0, 999,
+	0, 0, 991, 0, 0, 702, 1007, 0, 646, 671,
+	673, 0, 668, 683, 684, 686, 0, 688, 0, 690,
+	691, 655, 651, 652, 0, 702, 0, 702, 88, 702,
+	90, 0, 696, 97, 98, 0, 0, 104, 247, 248,
+	139, 310, 152, 156, 161, 0, 0, 0, 165, 0,
+	167, 291, 0, 177, 179, 0, 0, 159, 180, 241,
+	246, 246, 209, 210, 211, 212, 0, 228, 0, 0,
+	0, 303, 109, 931, 930, 258, 258, 253, 0, 256,
+	0, 1042, 231, 0, 122, 0, 0, 0, 0, 0,
+	128, 0, 364, 0, 375, 376, 0, 423, 0, 250,
+	0, 260, 263, 896, 664, 0, 0, 377, 0, 360,
+	380, 381, 392, 342, 343, 340, 0, 0, 953, 954,
+	0, 957, 114, 412, 414, 413, 417, 0, 0, 410,
+	0, 300, 927, 0, 457, 301, 302, 524, 524, 581,
+	0, 584, 0, 720, 0, 740, 723, 797, 783, 784,
+	632, 0, 897, 0, 230, 230, 876, 230, 234, 879,
+	230, 881, 230, 884, 0, 0, 0, 0, 0, 0,
+	0, 888, 836, 894, 0, 0, 0, 805, 802, 791,
+	792, 799, 793, 794, 795, 0, 0, 0, 0, 0,
+	0, 1024, 1025, 907, 908, 702, 909, 644, 623, 658,
+	981, 0, 974, 0, -2, 983, 0, 0, 0, 989,
+	636, 71, 703, 74, 0, 999, 990, 1001, 1003, 0,
+	0, 0, 995, 0, 913, 0, 0, 665, 672, 0,
+	0, 666, 0, 667, 687, 689, 650, -2, 913, 702,
+	81, 82, 0, 101, 102, 103, 309, 162, 163, 0,
+	166, 176, 178, 0, 0, 205, 206, 242, 0, 242,
+	0, 235, 0, 292, 304, 0, 928, 929, 0, 0,
+	255, 257, 0, 1031, 124, 0, 0, 0, 0, 148,
+	361, 0, 249, 0, 0, 448, 445, 378, 379, 655,
+	944, 945, 946, 0, 956, 117, 0, 0, 0, 0,
+	454, 455, 456, 86, 464, 470, 583, 603, 724, 785,
+	827, 873, 242, 877, 878, 880, 882, 883, 885, 829,
+	828, 0, 0, 0, 0, 0, 922, 0, 892, 0,
+	0, 0, 913, 805, 0, 0, 0, 0, 0, 0,
+	677, 50, 909, 911, 0, 968, 966, 966, 976, 977,
+	0, 0, 984, 0, 0, 0, 0, 75, 0, 1004,
+	0, 0, 0, 0, 92, 922, 1008, 1009, 669, 0,
+	674, 922, 80, 164, 0, 0, 246, 229, 246, 0,
+	0, 305, 932, 933, 934, 935, 936, 937, 938, 655,
+	125, 126, 127, 367, 0, 0, 425, 0, 433, 0,
+	0, 0, 955, 411, 0, 115, 116, 0, 0, 416,
+	874, 875, 0, 0, 0, 0, 865, 0, 889, 0,
+	0, 801, 807, 913, 0, 800, 796, 0, 699, 659,
+	660, 0, 0, 697, 911, 820, 0, 0, 971, 0,
+	964, 967, 965, 978, 0, 0, 985, 0, 987, 0,
+	1002, 0, 994, 997, 993, 996, 78, 0, 79, 0,
+	170, 218, 219, 233, 236, 0, 0, 285, 449, 446,
+	447, 0, 118, 119, 0, 0, 0, 830, 832, 831,
+	833, 0, 0, 0, 835, 853, 854, 803, 0, 810,
+	811, 807, 806, 0, 698, 700, 701, 654, 820, 73,
+	0, 912, 910, 960, 0, 969, 970, 979, 980, 986,
+	0, 992, 0, 670, 286, 290, 0, 0, 947, 0,
+	0, 0, 418, 834, 0, 0, 0, 808, 0, 0,
+	0, 0, 817, 818, 0, 804, 661, 662, 72, 821,
+	822, 0, 972, 973, 1000, 998, 287, 288, 0, 157,
+	171, 172, 0, 0, 175, 168, 169, 947, 0, 0,
+	408, 949, 120, 121, 353, 354, 355, 114, 866, 0,
+	869, 0, 812, 813, 814, 815, 816, 0, 0, 0,
+	289, 0, 0, 0, 173, 174, 110, 450, 451, 0,
+	950, 951, 117, 867, 0, 819, 823, 805, 293, 295,
+	296, 0, 0, 294, 0, 415, 0, 809, 0, 297,
+	298, 299, 948, 0, 824, 868,
 }
 
 var yyTok1 = [...]int{
@@ -5584,7 +5852,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 120, 3, 3, 3, 147, 139, 3,
 	83, 85, 144, 142, 84, 143, 159, 145, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 484,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 491,
 	128, 127, 129, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -5665,7 +5933,9 @@ var yyTok3 = [...]int{
 	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
 	57795, 470, 57796, 471, 57797, 472, 57798, 473, 57799, 474,
 	57800, 475, 57801, 476, 57802, 477, 57803, 478, 57804, 479,
-	57805, 480, 57806, 481, 57807, 482, 57808, 483, 0,
+	57805, 480, 57806, 481, 57807, 482, 57808, 483, 57809, 484,
+	57810, 485, 57811, 486, 57812, 487, 57813, 488, 57814, 489,
+	57815, 490, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -6015,297 +6285,351 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:439
+//line sql.y:466
 		{
 			setParseTree(yylex, yyDollar[1].statementUnion())
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:444
+//line sql.y:471
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:445
+//line sql.y:472
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:449
+//line sql.y:476
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:482
+//line sql.y:510
 		{
 			setParseTree(yylex, nil)
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:488
+//line sql.y:516
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), NoAt)
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:492
+//line sql.y:520
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), SingleAt)
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:496
+//line sql.y:524
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), DoubleAt)
 		}
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:501
+//line sql.y:529
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:505
+//line sql.y:533
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:511
+//line sql.y:539
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:517
+//line sql.y:545
 		{
 			yyLOCAL = &Load{}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:522
+//line sql.y:550
 		{
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:526
+//line sql.y:554
 		{
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:530
+//line sql.y:558
 		{
 			setLockInSelect(yyDollar[2].selStmtUnion(), yyDollar[3].lockUnion())
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:553
+//line sql.y:581
 		{
 			yyDollar[1].selStmtUnion().SetOrderBy(yyDollar[2].orderByUnion())
 			yyDollar[1].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 47:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:587
+		{
+			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
+			yyDollar[2].selStmtUnion().SetOrderBy(yyDollar[3].orderByUnion())
+			yyDollar[2].selStmtUnion().SetLimit(yyDollar[4].limitUnion())
+			yyLOCAL = yyDollar[2].selStmtUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 48:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:559
+//line sql.y:594
 		{
 			yyDollar[1].selStmtUnion().SetLimit(yyDollar[2].limitUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 47:
+	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:564
+//line sql.y:599
 		{
 			yyDollar[1].selStmtUnion().SetOrderBy(yyDollar[2].orderByUnion())
 			yyDollar[1].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 48:
+	case 50:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:570
+//line sql.y:605
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, nil, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
 		yyVAL.union = yyLOCAL
-	case 49:
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:576
+//line sql.y:611
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 50:
+	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:580
+//line sql.y:615
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:584
+//line sql.y:619
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:588
+//line sql.y:623
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:592
+//line sql.y:627
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 56:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *With
+//line sql.y:633
+		{
+			yyLOCAL = &With{CTEs: yyDollar[2].ctesUnion(), Recursive: false}
+		}
+		yyVAL.union = yyLOCAL
+	case 57:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *With
+//line sql.y:637
+		{
+			yyLOCAL = &With{CTEs: yyDollar[3].ctesUnion(), Recursive: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 58:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*CommonTableExpr
+//line sql.y:643
+		{
+			yyLOCAL = []*CommonTableExpr{yyDollar[1].cteUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 59:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:647
+		{
+			yySLICE := (*[]*CommonTableExpr)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].cteUnion())
+		}
+	case 60:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *CommonTableExpr
+//line sql.y:653
+		{
+			yyLOCAL = &CommonTableExpr{TableID: yyDollar[1].tableIdent, Columns: yyDollar[2].columnsUnion(), Subquery: yyDollar[4].subqueryUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:598
+//line sql.y:659
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 62:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:602
+//line sql.y:663
 		{
 			setLockInSelect(yyDollar[1].selStmtUnion(), yyDollar[2].lockUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:607
+//line sql.y:668
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:611
+//line sql.y:672
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:617
+//line sql.y:678
 		{
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 66:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:621
+//line sql.y:682
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:626
+//line sql.y:687
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
 			yyDollar[1].selStmtUnion().SetLock(yyDollar[3].lockUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:632
+//line sql.y:693
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[3].selectIntoUnion())
 			yyDollar[1].selStmtUnion().SetLock(yyDollar[2].lockUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:638
+//line sql.y:699
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 70:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:645
+//line sql.y:706
 		{
 			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 71:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:651
+//line sql.y:712
 		{
 			yyLOCAL = &VStream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].exprUnion()), Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
-		yyDollar = yyS[yypt-9 : yypt+1]
+	case 72:
+		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:659
+//line sql.y:720
 		{
-			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].selectIntoUnion() /*into*/, yyDollar[6].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[7].exprUnion()), GroupBy(yyDollar[8].exprsUnion()), NewWhere(HavingClause, yyDollar[9].exprUnion()))
+			sel := NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].selectIntoUnion() /*into*/, yyDollar[6].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[7].exprUnion()), GroupBy(yyDollar[8].exprsUnion()), NewWhere(HavingClause, yyDollar[9].exprUnion()))
+			sel.Windows = yyDollar[10].windowDefsUnion()
+			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 73:
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:663
+//line sql.y:726
 		{
-			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, nil, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
+			sel := NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, nil, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
+			sel.Windows = yyDollar[9].windowDefsUnion()
+			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 74:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:671
+//line sql.y:736
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].insUnion()
@@ -6318,10 +6642,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 75:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:683
+//line sql.y:748
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
@@ -6332,258 +6656,258 @@ yydefault:
 			yyLOCAL = &Insert{Action: yyDollar[1].insertActionUnion(), Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitionsUnion(), Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprsUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:695
+//line sql.y:760
 		{
 			yyLOCAL = InsertAct
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:699
+//line sql.y:764
 		{
 			yyLOCAL = ReplaceAct
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 78:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:705
+//line sql.y:770
 		{
 			yyLOCAL = &Update{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: yyDollar[4].tableExprsUnion(), Exprs: yyDollar[6].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 79:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:711
+//line sql.y:776
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 80:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:715
+//line sql.y:780
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 81:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:719
+//line sql.y:784
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 82:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:723
+//line sql.y:788
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:728
+//line sql.y:793
 		{
 		}
-	case 77:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:729
+//line sql.y:794
 		{
 		}
-	case 78:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:733
+//line sql.y:798
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:737
+//line sql.y:802
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
 		}
-	case 80:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:743
+//line sql.y:808
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:747
+//line sql.y:812
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-	case 82:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:753
+//line sql.y:818
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:757
+//line sql.y:822
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-	case 84:
+	case 91:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:762
+//line sql.y:827
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 92:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:766
+//line sql.y:831
 		{
 			yyLOCAL = yyDollar[3].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:772
+//line sql.y:837
 		{
 			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 94:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:778
+//line sql.y:843
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 95:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:782
+//line sql.y:847
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []Characteristic
-//line sql.y:788
+//line sql.y:853
 		{
 			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:792
+//line sql.y:857
 		{
 			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
 		}
-	case 91:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:798
+//line sql.y:863
 		{
 			yyLOCAL = yyDollar[3].isolationLevelUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 99:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:802
+//line sql.y:867
 		{
 			yyLOCAL = ReadWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:806
+//line sql.y:871
 		{
 			yyLOCAL = ReadOnly
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:812
+//line sql.y:877
 		{
 			yyLOCAL = RepeatableRead
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 102:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:816
+//line sql.y:881
 		{
 			yyLOCAL = ReadCommitted
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:820
+//line sql.y:885
 		{
 			yyLOCAL = ReadUncommitted
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:824
+//line sql.y:889
 		{
 			yyLOCAL = Serializable
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:830
+//line sql.y:895
 		{
 			yyLOCAL = SessionScope
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:834
+//line sql.y:899
 		{
 			yyLOCAL = GlobalScope
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:840
+//line sql.y:905
 		{
 			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
 			yyDollar[1].createTableUnion().FullyParsed = true
 			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 108:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:846
+//line sql.y:911
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
@@ -6591,10 +6915,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 109:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:853
+//line sql.y:918
 		{
 			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumnsUnion()
@@ -6604,358 +6928,358 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 110:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:862
+//line sql.y:927
 		{
 			yyLOCAL = &CreateView{ViewName: yyDollar[8].tableName.ToViewName(), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].str, Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:866
+//line sql.y:931
 		{
 			yyDollar[1].createDatabaseUnion().FullyParsed = true
 			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
 			yyLOCAL = yyDollar[1].createDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 112:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:873
+//line sql.y:938
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:877
+//line sql.y:942
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 114:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:882
+//line sql.y:947
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 108:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:886
+//line sql.y:951
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 109:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:892
+//line sql.y:957
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 110:
+	case 117:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:897
+//line sql.y:962
 		{
 			var v []VindexParam
 			yyLOCAL = v
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:902
+//line sql.y:967
 		{
 			yyLOCAL = yyDollar[2].vindexParamsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:908
+//line sql.y:973
 		{
 			yyLOCAL = make([]VindexParam, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 120:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:913
+//line sql.y:978
 		{
 			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
 		}
-	case 114:
+	case 121:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:919
+//line sql.y:984
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 115:
+	case 122:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateTable
-//line sql.y:925
+//line sql.y:990
 		{
 			yyLOCAL = &CreateTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[6].tableName, IfNotExists: yyDollar[5].booleanUnion(), Temp: yyDollar[3].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:932
+//line sql.y:997
 		{
 			yyLOCAL = &AlterTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[4].tableName}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 124:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:939
+//line sql.y:1004
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[3].str)}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 125:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:944
+//line sql.y:1009
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Fulltext: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 119:
+	case 126:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:949
+//line sql.y:1014
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Spatial: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 120:
+	case 127:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:954
+//line sql.y:1019
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Unique: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 121:
+	case 128:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateDatabase
-//line sql.y:961
+//line sql.y:1026
 		{
 			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[4].strs), DBName: yyDollar[6].tableIdent, IfNotExists: yyDollar[5].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 122:
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AlterDatabase
-//line sql.y:968
+//line sql.y:1033
 		{
 			yyLOCAL = &AlterDatabase{}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 132:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:979
+//line sql.y:1044
 		{
 			yyLOCAL = yyDollar[2].tableSpecUnion()
 			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 133:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:985
+//line sql.y:1050
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:989
+//line sql.y:1054
 		{
 			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:995
+//line sql.y:1060
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:999
+//line sql.y:1064
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 137:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1003
+//line sql.y:1068
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-	case 131:
+	case 138:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1007
+//line sql.y:1072
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-	case 132:
+	case 139:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1013
+//line sql.y:1078
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1017
+//line sql.y:1082
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 141:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1023
+//line sql.y:1088
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 135:
+	case 142:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1027
+//line sql.y:1092
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 136:
+	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1033
+//line sql.y:1098
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 137:
+	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1037
+//line sql.y:1102
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 138:
+	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:1044
+//line sql.y:1109
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 146:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:1048
+//line sql.y:1113
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*ColumnDefinition
-//line sql.y:1054
+//line sql.y:1119
 		{
 			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1058
+//line sql.y:1123
 		{
 			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
 		}
-	case 142:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1064
+//line sql.y:1129
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1069
+//line sql.y:1134
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1074
+//line sql.y:1139
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 		}
-	case 145:
+	case 152:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1078
+//line sql.y:1143
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
 		}
-	case 146:
+	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1083
+//line sql.y:1148
 		{
 			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
 		}
-	case 147:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1087
+//line sql.y:1152
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-	case 148:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1091
+//line sql.y:1156
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-	case 149:
+	case 156:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1097
+//line sql.y:1162
 		{
 			yyDollar[2].columnType.Options = yyDollar[3].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.Reference = yyDollar[4].referenceDefinitionUnion()
 			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 157:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1103
+//line sql.y:1168
 		{
 			yyDollar[2].columnType.Options = yyDollar[8].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.As = yyDollar[6].exprUnion()
@@ -6963,1741 +7287,1741 @@ yydefault:
 			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 158:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1111
+//line sql.y:1176
 		{
 			yyVAL.str = ""
 		}
-	case 152:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1115
+//line sql.y:1180
 		{
 			yyVAL.str = ""
 		}
-	case 153:
+	case 160:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1124
+//line sql.y:1189
 		{
 			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil, As: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1128
+//line sql.y:1193
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 162:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1134
+//line sql.y:1199
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1140
+//line sql.y:1205
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 157:
+	case 164:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1145
+//line sql.y:1210
 		{
 			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 158:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1150
+//line sql.y:1215
 		{
 			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 159:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1155
+//line sql.y:1220
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 160:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1160
+//line sql.y:1225
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 161:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1167
+//line sql.y:1232
 		{
 			yyLOCAL = VirtualStorage
 		}
 		yyVAL.union = yyLOCAL
-	case 162:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1171
+//line sql.y:1236
 		{
 			yyLOCAL = StoredStorage
 		}
 		yyVAL.union = yyLOCAL
-	case 163:
+	case 170:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1176
+//line sql.y:1241
 		{
 			yyLOCAL = &ColumnTypeOptions{}
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1180
+//line sql.y:1245
 		{
 			yyDollar[1].columnTypeOptionsUnion().Storage = yyDollar[2].columnStorageUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1185
+//line sql.y:1250
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1191
+//line sql.y:1256
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1197
+//line sql.y:1262
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1202
+//line sql.y:1267
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1209
+//line sql.y:1274
 		{
 			yyLOCAL = colKeyPrimary
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1213
+//line sql.y:1278
 		{
 			yyLOCAL = colKeyUnique
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1217
+//line sql.y:1282
 		{
 			yyLOCAL = colKeyUniqueKey
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1221
+//line sql.y:1286
 		{
 			yyLOCAL = colKey
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 180:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1227
+//line sql.y:1292
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
 			yyVAL.columnType.Zerofill = yyDollar[3].booleanUnion()
 		}
-	case 177:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1238
+//line sql.y:1303
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literalUnion()
 		}
-	case 178:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1243
+//line sql.y:1308
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 179:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1249
+//line sql.y:1314
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 180:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1253
+//line sql.y:1318
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 181:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1257
+//line sql.y:1322
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 182:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1261
+//line sql.y:1326
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 183:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1265
+//line sql.y:1330
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 184:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1269
+//line sql.y:1334
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 185:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1273
+//line sql.y:1338
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 186:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1277
+//line sql.y:1342
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 187:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1281
+//line sql.y:1346
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 188:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1287
+//line sql.y:1352
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 189:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1293
+//line sql.y:1358
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 190:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1299
+//line sql.y:1364
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 191:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1305
+//line sql.y:1370
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 192:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1311
+//line sql.y:1376
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 193:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1319
+//line sql.y:1384
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 194:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1323
+//line sql.y:1388
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 195:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1327
+//line sql.y:1392
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 196:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1331
+//line sql.y:1396
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 197:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1335
+//line sql.y:1400
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 198:
+	case 205:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1341
+//line sql.y:1406
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 199:
+	case 206:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1345
+//line sql.y:1410
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 200:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1349
+//line sql.y:1414
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 201:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1353
+//line sql.y:1418
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 202:
+	case 209:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1357
+//line sql.y:1422
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 203:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1361
+//line sql.y:1426
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 204:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1365
+//line sql.y:1430
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 205:
+	case 212:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1369
+//line sql.y:1434
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 206:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1373
+//line sql.y:1438
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 207:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1377
+//line sql.y:1442
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 208:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1381
+//line sql.y:1446
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 209:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1385
+//line sql.y:1450
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 210:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1389
+//line sql.y:1454
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 211:
+	case 218:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1393
+//line sql.y:1458
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 212:
+	case 219:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1398
+//line sql.y:1463
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 213:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1404
+//line sql.y:1469
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 214:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1408
+//line sql.y:1473
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 215:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1412
+//line sql.y:1477
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 216:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1416
+//line sql.y:1481
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 217:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1420
+//line sql.y:1485
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 218:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1424
+//line sql.y:1489
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 219:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1428
+//line sql.y:1493
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 220:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1432
+//line sql.y:1497
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 221:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1438
+//line sql.y:1503
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
-	case 222:
+	case 229:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1443
+//line sql.y:1508
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
-	case 223:
+	case 230:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1448
+//line sql.y:1513
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 231:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1452
+//line sql.y:1517
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 225:
+	case 232:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1457
+//line sql.y:1522
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 226:
+	case 233:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1461
+//line sql.y:1526
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 227:
+	case 234:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1469
+//line sql.y:1534
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 228:
+	case 235:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1473
+//line sql.y:1538
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 			}
 		}
-	case 229:
+	case 236:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1479
+//line sql.y:1544
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 230:
+	case 237:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1487
+//line sql.y:1552
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 231:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1491
+//line sql.y:1556
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1495
+//line sql.y:1560
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 233:
+	case 240:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1500
+//line sql.y:1565
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1504
+//line sql.y:1569
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 242:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1509
+//line sql.y:1574
 		{
 			yyVAL.str = ""
 		}
-	case 236:
+	case 243:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1513
+//line sql.y:1578
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 237:
+	case 244:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1517
+//line sql.y:1582
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 238:
+	case 245:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1521
+//line sql.y:1586
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
-	case 239:
+	case 246:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1526
+//line sql.y:1591
 		{
 			yyVAL.str = ""
 		}
-	case 240:
+	case 247:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1530
+//line sql.y:1595
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 241:
+	case 248:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1534
+//line sql.y:1599
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 242:
+	case 249:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1541
+//line sql.y:1606
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 250:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1546
+//line sql.y:1611
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1550
+//line sql.y:1615
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1556
+//line sql.y:1621
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 253:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1560
+//line sql.y:1625
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
 		}
-	case 247:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1566
+//line sql.y:1631
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1570
+//line sql.y:1635
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1575
+//line sql.y:1640
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1579
+//line sql.y:1644
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 258:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1585
+//line sql.y:1650
 		{
 			yyVAL.str = ""
 		}
-	case 252:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1589
+//line sql.y:1654
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 253:
+	case 260:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1595
+//line sql.y:1660
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 261:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1599
+//line sql.y:1664
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1603
+//line sql.y:1668
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 263:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1607
+//line sql.y:1672
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1611
+//line sql.y:1676
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 265:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1616
+//line sql.y:1681
 		{
 			yyVAL.str = ""
 		}
-	case 259:
+	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1620
+//line sql.y:1685
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 260:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1626
+//line sql.y:1691
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 261:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1630
+//line sql.y:1695
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 262:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1634
+//line sql.y:1699
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 263:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1641
+//line sql.y:1706
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 264:
+	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1645
+//line sql.y:1710
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 265:
+	case 272:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1650
+//line sql.y:1715
 		{
 			yyVAL.str = "key"
 		}
-	case 266:
+	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1654
+//line sql.y:1719
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 267:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1660
+//line sql.y:1725
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 268:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1664
+//line sql.y:1729
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 269:
+	case 276:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1669
+//line sql.y:1734
 		{
 			yyVAL.str = ""
 		}
-	case 270:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1673
+//line sql.y:1738
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 271:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:1679
+//line sql.y:1744
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1683
+//line sql.y:1748
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
 		}
-	case 273:
+	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:1689
+//line sql.y:1754
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1695
+//line sql.y:1760
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 282:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1699
+//line sql.y:1764
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1705
+//line sql.y:1770
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1709
+//line sql.y:1774
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 278:
+	case 285:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1715
+//line sql.y:1780
 		{
 			yyLOCAL = &ForeignKeyDefinition{IndexName: NewColIdent(yyDollar[3].str), Source: yyDollar[5].columnsUnion(), ReferenceDefinition: yyDollar[7].referenceDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 286:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1721
+//line sql.y:1786
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 287:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1725
+//line sql.y:1790
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 288:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1729
+//line sql.y:1794
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnUpdate: yyDollar[6].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 289:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1733
+//line sql.y:1798
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion(), OnUpdate: yyDollar[7].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 290:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1738
+//line sql.y:1803
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1742
+//line sql.y:1807
 		{
 			yyLOCAL = yyDollar[1].referenceDefinitionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 292:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1748
+//line sql.y:1813
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1754
+//line sql.y:1819
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1760
+//line sql.y:1825
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1766
+//line sql.y:1831
 		{
 			yyLOCAL = Restrict
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1770
+//line sql.y:1835
 		{
 			yyLOCAL = Cascade
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 297:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1774
+//line sql.y:1839
 		{
 			yyLOCAL = NoAction
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 298:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1778
+//line sql.y:1843
 		{
 			yyLOCAL = SetDefault
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 299:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1782
+//line sql.y:1847
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 300:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1787
+//line sql.y:1852
 		{
 			yyVAL.str = ""
 		}
-	case 294:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1791
+//line sql.y:1856
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 295:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1795
+//line sql.y:1860
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 296:
+	case 303:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1800
+//line sql.y:1865
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1804
+//line sql.y:1869
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1808
+//line sql.y:1873
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 306:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1813
+//line sql.y:1878
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 300:
+	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1817
+//line sql.y:1882
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1823
+//line sql.y:1888
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1827
+//line sql.y:1892
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
-	case 303:
+	case 310:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1831
+//line sql.y:1896
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 304:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1837
+//line sql.y:1902
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1841
+//line sql.y:1906
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 306:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1847
+//line sql.y:1912
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1851
+//line sql.y:1916
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 315:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1855
+//line sql.y:1920
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
+	case 316:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1859
+//line sql.y:1924
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1863
+//line sql.y:1928
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 318:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1867
+//line sql.y:1932
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 319:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1871
+//line sql.y:1936
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1875
+//line sql.y:1940
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 321:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1879
+//line sql.y:1944
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 322:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1883
+//line sql.y:1948
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 323:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1887
+//line sql.y:1952
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1891
+//line sql.y:1956
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 325:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1895
+//line sql.y:1960
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].tableIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1899
+//line sql.y:1964
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 327:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1903
+//line sql.y:1968
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 328:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1907
+//line sql.y:1972
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 329:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1911
+//line sql.y:1976
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 330:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1915
+//line sql.y:1980
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 331:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1919
+//line sql.y:1984
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1923
+//line sql.y:1988
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1927
+//line sql.y:1992
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1931
+//line sql.y:1996
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1935
+//line sql.y:2000
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 336:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1939
+//line sql.y:2004
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1943
+//line sql.y:2008
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 338:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1947
+//line sql.y:2012
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 339:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1951
+//line sql.y:2016
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 340:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1955
+//line sql.y:2020
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1960
+//line sql.y:2025
 		{
 			yyVAL.str = ""
 		}
-	case 335:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1964
+//line sql.y:2029
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 336:
+	case 343:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1968
+//line sql.y:2033
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 346:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1987
+//line sql.y:2052
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 347:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1991
+//line sql.y:2056
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 348:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1995
+//line sql.y:2060
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 349:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2000
+//line sql.y:2065
 		{
 			yyVAL.str = ""
 		}
-	case 351:
+	case 358:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2006
+//line sql.y:2071
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2010
+//line sql.y:2075
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:2015
+//line sql.y:2080
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:2019
+//line sql.y:2084
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 362:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2024
+//line sql.y:2089
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2028
+//line sql.y:2093
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 364:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2032
+//line sql.y:2097
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
 		}
-	case 358:
+	case 365:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2036
+//line sql.y:2101
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2040
+//line sql.y:2105
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
 		}
-	case 360:
+	case 367:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2044
+//line sql.y:2109
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2050
+//line sql.y:2115
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2054
+//line sql.y:2119
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 363:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2058
+//line sql.y:2123
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 364:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2064
+//line sql.y:2129
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2068
+//line sql.y:2133
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2072
+//line sql.y:2137
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2076
+//line sql.y:2141
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 375:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2080
+//line sql.y:2145
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 376:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2084
+//line sql.y:2149
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 377:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2088
+//line sql.y:2153
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 378:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2092
+//line sql.y:2157
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 379:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2096
+//line sql.y:2161
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].booleanUnion(), After: yyDollar[6].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 380:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2100
+//line sql.y:2165
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 381:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2104
+//line sql.y:2169
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2108
+//line sql.y:2173
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2112
+//line sql.y:2177
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2116
+//line sql.y:2181
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 385:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2120
+//line sql.y:2185
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2124
+//line sql.y:2189
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2128
+//line sql.y:2193
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 388:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2132
+//line sql.y:2197
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 389:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2136
+//line sql.y:2201
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2140
+//line sql.y:2205
 		{
 			yyLOCAL = &Force{}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2144
+//line sql.y:2209
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 392:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2148
+//line sql.y:2213
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2154
+//line sql.y:2219
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 394:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2158
+//line sql.y:2223
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 388:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2164
+//line sql.y:2229
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2168
+//line sql.y:2233
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2172
+//line sql.y:2237
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2176
+//line sql.y:2241
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2180
+//line sql.y:2245
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2184
+//line sql.y:2249
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2188
+//line sql.y:2253
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2192
+//line sql.y:2257
 		{
 			yyLOCAL = &Validation{With: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 403:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2196
+//line sql.y:2261
 		{
 			yyLOCAL = &Validation{With: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 404:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2202
+//line sql.y:2267
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 405:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2208
+//line sql.y:2273
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8705,10 +9029,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 406:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2215
+//line sql.y:2280
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8716,28 +9040,28 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 407:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2222
+//line sql.y:2287
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 408:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2228
+//line sql.y:2293
 		{
 			yyLOCAL = &AlterView{ViewName: yyDollar[7].tableName.ToViewName(), Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2232
+//line sql.y:2297
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8745,10 +9069,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 410:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2239
+//line sql.y:2304
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8756,10 +9080,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 411:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2246
+//line sql.y:2311
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -8772,10 +9096,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 412:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2258
+//line sql.y:2323
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -8786,26 +9110,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 413:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2268
+//line sql.y:2333
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 414:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2272
+//line sql.y:2337
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 415:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2276
+//line sql.y:2341
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -8819,10 +9143,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 416:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2289
+//line sql.y:2354
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -8833,18 +9157,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 417:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2299
+//line sql.y:2364
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 418:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2303
+//line sql.y:2368
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -8856,10 +9180,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 419:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2314
+//line sql.y:2379
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -8867,10 +9191,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 420:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2321
+//line sql.y:2386
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -8878,10 +9202,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 421:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2328
+//line sql.y:2393
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -8889,282 +9213,282 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 422:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2335
+//line sql.y:2400
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 423:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2343
+//line sql.y:2408
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2347
+//line sql.y:2412
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 425:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2351
+//line sql.y:2416
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 426:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2355
+//line sql.y:2420
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 427:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2359
+//line sql.y:2424
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 428:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2363
+//line sql.y:2428
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 429:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2367
+//line sql.y:2432
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2371
+//line sql.y:2436
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2375
+//line sql.y:2440
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2379
+//line sql.y:2444
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 433:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2383
+//line sql.y:2448
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2387
+//line sql.y:2452
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2391
+//line sql.y:2456
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2395
+//line sql.y:2460
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2399
+//line sql.y:2464
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2403
+//line sql.y:2468
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2407
+//line sql.y:2472
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 440:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2411
+//line sql.y:2476
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2415
+//line sql.y:2480
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2419
+//line sql.y:2484
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2423
+//line sql.y:2488
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 444:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2427
+//line sql.y:2492
 		{
 			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 445:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2432
+//line sql.y:2497
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 446:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2436
+//line sql.y:2501
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2440
+//line sql.y:2505
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2447
+//line sql.y:2512
 		{
 			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2451
+//line sql.y:2516
 		{
 			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
 		}
-	case 443:
+	case 450:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2457
+//line sql.y:2522
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 451:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2461
+//line sql.y:2526
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2467
+//line sql.y:2532
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 453:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:2473
+//line sql.y:2538
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 454:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2477
+//line sql.y:2542
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 448:
+	case 455:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2483
+//line sql.y:2548
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[6].tableNamesUnion(), IfExists: yyDollar[5].booleanUnion(), Comments: Comments(yyDollar[2].strs), Temp: yyDollar[3].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 456:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2487
+//line sql.y:2552
 		{
 			// Change this to an alter statement
 			if yyDollar[4].colIdent.Lowered() == "primary" {
@@ -9174,404 +9498,404 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 457:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2496
+//line sql.y:2561
 		{
 			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 458:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2500
+//line sql.y:2565
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[2].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 459:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2506
+//line sql.y:2571
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2510
+//line sql.y:2575
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2515
+//line sql.y:2580
 		{
 			yyLOCAL = &OtherRead{}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2521
+//line sql.y:2586
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2525
+//line sql.y:2590
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 464:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2529
+//line sql.y:2594
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 465:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2533
+//line sql.y:2598
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2537
+//line sql.y:2602
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2541
+//line sql.y:2606
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 468:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2545
+//line sql.y:2610
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 469:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2549
+//line sql.y:2614
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 470:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2553
+//line sql.y:2618
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 471:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2557
+//line sql.y:2622
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 472:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2561
+//line sql.y:2626
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 473:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2565
+//line sql.y:2630
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 474:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2569
+//line sql.y:2634
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 475:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2573
+//line sql.y:2638
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 476:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2577
+//line sql.y:2642
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 477:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2581
+//line sql.y:2646
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 478:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2585
+//line sql.y:2650
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 479:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2589
+//line sql.y:2654
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 480:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2593
+//line sql.y:2658
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 481:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2597
+//line sql.y:2662
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 482:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2601
+//line sql.y:2666
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 483:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2605
+//line sql.y:2670
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 484:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2609
+//line sql.y:2674
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 485:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2613
+//line sql.y:2678
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 486:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2617
+//line sql.y:2682
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 487:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2621
+//line sql.y:2686
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 488:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2625
+//line sql.y:2690
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 489:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2629
+//line sql.y:2694
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 490:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2633
+//line sql.y:2698
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2637
+//line sql.y:2702
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 492:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2641
+//line sql.y:2706
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2645
+//line sql.y:2710
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 494:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2649
+//line sql.y:2714
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 495:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2653
+//line sql.y:2718
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 496:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2657
+//line sql.y:2722
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 497:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2661
+//line sql.y:2726
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 498:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2665
+//line sql.y:2730
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 499:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2670
+//line sql.y:2735
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 500:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2674
+//line sql.y:2739
 		{
 			yyLOCAL = &ShowMigrationLogs{UUID: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2678
+//line sql.y:2743
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2683
+//line sql.y:2748
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 503:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2687
+//line sql.y:2752
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 504:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2691
+//line sql.y:2756
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 505:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2695
+//line sql.y:2760
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Warnings}}
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2700
+//line sql.y:2765
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -9579,1242 +9903,1300 @@ yydefault:
 			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2714
+//line sql.y:2773
+		{
+			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilterUnion()}
+			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
+		}
+		yyVAL.union = yyLOCAL
+	case 508:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2785
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 509:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2718
+//line sql.y:2789
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2722
+//line sql.y:2793
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2728
+//line sql.y:2799
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 504:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2732
+//line sql.y:2803
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 505:
+	case 513:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2809
+		{
+			yyVAL.str = string(yyDollar[1].str)
+		}
+	case 514:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2813
+		{
+			yyVAL.str = string(yyDollar[1].str)
+		}
+	case 515:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2738
+//line sql.y:2819
 		{
 			yyVAL.str = ""
 		}
-	case 506:
+	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2742
+//line sql.y:2823
 		{
 			yyVAL.str = "extended "
 		}
-	case 507:
+	case 517:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2748
+//line sql.y:2829
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2752
+//line sql.y:2833
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2758
+//line sql.y:2839
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 510:
+	case 520:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2762
+//line sql.y:2843
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 511:
+	case 521:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2768
+//line sql.y:2849
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 512:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2772
+//line sql.y:2853
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 513:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2776
+//line sql.y:2857
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 514:
+	case 524:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2782
+//line sql.y:2863
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2786
+//line sql.y:2867
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2790
+//line sql.y:2871
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 527:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2796
+//line sql.y:2877
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2800
+//line sql.y:2881
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 529:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2806
+//line sql.y:2887
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 520:
+	case 530:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2810
+//line sql.y:2891
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 521:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2814
+//line sql.y:2895
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 522:
+	case 532:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2820
+//line sql.y:2901
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2824
+//line sql.y:2905
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 534:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2830
+//line sql.y:2911
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2834
+//line sql.y:2915
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2840
+//line sql.y:2921
 		{
 			yyLOCAL = &Commit{}
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 537:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2846
+//line sql.y:2927
 		{
 			yyLOCAL = &Rollback{}
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 538:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2850
+//line sql.y:2931
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 539:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2855
+//line sql.y:2936
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 530:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2857
+//line sql.y:2938
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 531:
+	case 541:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2860
+//line sql.y:2941
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 532:
+	case 542:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2862
+//line sql.y:2943
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 533:
+	case 543:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2867
+//line sql.y:2948
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2873
+//line sql.y:2954
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 545:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2878
+//line sql.y:2959
 		{
 			yyLOCAL = EmptyType
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 546:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2882
+//line sql.y:2963
 		{
 			yyLOCAL = JSONType
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 547:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2886
+//line sql.y:2967
 		{
 			yyLOCAL = TreeType
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 548:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2890
+//line sql.y:2971
 		{
 			yyLOCAL = VitessType
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2894
+//line sql.y:2975
 		{
 			yyLOCAL = TraditionalType
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2898
+//line sql.y:2979
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2904
+//line sql.y:2985
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 542:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2908
+//line sql.y:2989
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 543:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2912
+//line sql.y:2993
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 544:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2918
+//line sql.y:2999
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2922
+//line sql.y:3003
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2926
+//line sql.y:3007
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2930
+//line sql.y:3011
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2935
+//line sql.y:3016
 		{
 			yyVAL.str = ""
 		}
-	case 549:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2939
+//line sql.y:3020
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 550:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2943
+//line sql.y:3024
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 551:
+	case 561:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2949
+//line sql.y:3030
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 562:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2953
+//line sql.y:3034
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2959
+//line sql.y:3040
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2963
+//line sql.y:3044
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2969
+//line sql.y:3050
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:2975
+//line sql.y:3056
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 567:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2979
+//line sql.y:3060
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
 		}
-	case 558:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:2985
+//line sql.y:3066
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2991
+//line sql.y:3072
 		{
 			yyLOCAL = Read
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2995
+//line sql.y:3076
 		{
 			yyLOCAL = ReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2999
+//line sql.y:3080
 		{
 			yyLOCAL = Write
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3003
+//line sql.y:3084
 		{
 			yyLOCAL = LowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3009
+//line sql.y:3090
 		{
 			yyLOCAL = &UnlockTables{}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 574:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:3096
+		{
+			id, ok := parseProcesslistID(yyDollar[3].str)
+			if !ok {
+				yylex.Error("invalid processlist id")
+				return 1
+			}
+			yyLOCAL = &Kill{Type: yyDollar[2].killTypeUnion(), ProcesslistID: id}
+		}
+		yyVAL.union = yyLOCAL
+	case 575:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL KillType
+//line sql.y:3106
+		{
+			yyLOCAL = ConnectionType
+		}
+		yyVAL.union = yyLOCAL
+	case 576:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL KillType
+//line sql.y:3110
+		{
+			yyLOCAL = ConnectionType
+		}
+		yyVAL.union = yyLOCAL
+	case 577:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL KillType
+//line sql.y:3114
+		{
+			yyLOCAL = QueryType
+		}
+		yyVAL.union = yyLOCAL
+	case 578:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3015
+//line sql.y:3120
 		{
 			yyLOCAL = &RevertMigration{Comments: Comments(yyDollar[2].strs), UUID: string(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 579:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3021
+//line sql.y:3126
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3025
+//line sql.y:3130
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 581:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3029
+//line sql.y:3134
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 582:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3033
+//line sql.y:3138
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 583:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3037
+//line sql.y:3142
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 584:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3041
+//line sql.y:3146
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3047
+//line sql.y:3152
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 572:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3051
+//line sql.y:3156
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 573:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3057
+//line sql.y:3162
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 574:
+	case 588:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3061
+//line sql.y:3166
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 575:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3065
+//line sql.y:3170
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 576:
+	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3069
+//line sql.y:3174
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 577:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3073
+//line sql.y:3178
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 578:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3077
+//line sql.y:3182
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 579:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3081
+//line sql.y:3186
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 580:
+	case 594:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3085
+//line sql.y:3190
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
-	case 581:
+	case 595:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3089
+//line sql.y:3194
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 582:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3093
+//line sql.y:3198
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 583:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3097
+//line sql.y:3202
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 584:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3101
+//line sql.y:3206
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 585:
+	case 599:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3106
+//line sql.y:3211
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 600:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3110
+//line sql.y:3215
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3114
+//line sql.y:3219
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 602:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3119
+//line sql.y:3224
 		{
 			yyVAL.str = ""
 		}
-	case 589:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3123
+//line sql.y:3228
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
-	case 590:
+	case 604:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3128
+//line sql.y:3233
 		{
 			setAllowComments(yylex, true)
 		}
-	case 591:
+	case 605:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3132
+//line sql.y:3237
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
-	case 592:
+	case 606:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3138
+//line sql.y:3243
 		{
 			yyVAL.strs = nil
 		}
-	case 593:
+	case 607:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3142
+//line sql.y:3247
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 594:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3148
+//line sql.y:3253
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 609:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3152
+//line sql.y:3257
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 610:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3156
+//line sql.y:3261
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 611:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3161
+//line sql.y:3266
 		{
 			yyVAL.str = ""
 		}
-	case 598:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3165
+//line sql.y:3270
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 599:
+	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3169
+//line sql.y:3274
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 600:
+	case 614:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3174
+//line sql.y:3279
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3178
+//line sql.y:3283
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3182
+//line sql.y:3287
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 617:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3187
+//line sql.y:3292
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3191
+//line sql.y:3296
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 619:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3196
+//line sql.y:3301
 		{
 			yyVAL.strs = nil
 		}
-	case 606:
+	case 620:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3200
+//line sql.y:3305
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 607:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3204
+//line sql.y:3309
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 608:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3208
+//line sql.y:3313
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 609:
+	case 623:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3212
+//line sql.y:3317
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 610:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3218
+//line sql.y:3323
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 611:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3222
+//line sql.y:3327
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 612:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3226
+//line sql.y:3331
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 613:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3230
+//line sql.y:3335
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 614:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3234
+//line sql.y:3339
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 615:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3238
+//line sql.y:3343
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 616:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3242
+//line sql.y:3347
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 617:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3248
+//line sql.y:3353
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3252
+//line sql.y:3357
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
 		}
-	case 619:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3258
+//line sql.y:3363
 		{
 			yyLOCAL = &StarExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 634:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3262
+//line sql.y:3367
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 635:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3266
+//line sql.y:3371
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 636:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3270
+//line sql.y:3375
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 637:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3275
+//line sql.y:3380
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 624:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3279
+//line sql.y:3384
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 625:
+	case 639:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3283
+//line sql.y:3388
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 627:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3290
+//line sql.y:3395
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 628:
+	case 642:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3295
+//line sql.y:3400
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3299
+//line sql.y:3404
 		{
 			yyLOCAL = yyDollar[1].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3305
+//line sql.y:3410
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3311
+//line sql.y:3416
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 646:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3315
+//line sql.y:3420
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
 		}
-	case 635:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3325
+//line sql.y:3430
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 650:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3329
+//line sql.y:3434
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent, Columns: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3333
+//line sql.y:3438
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 652:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3339
+//line sql.y:3444
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 653:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3345
+//line sql.y:3450
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 654:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3349
+//line sql.y:3454
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 655:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3354
+//line sql.y:3459
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 656:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3358
+//line sql.y:3463
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3364
+//line sql.y:3469
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3368
+//line sql.y:3473
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 645:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3374
+//line sql.y:3479
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3378
+//line sql.y:3483
 		{
 			yyLOCAL = Columns{NewColIdent(string(yyDollar[1].str))}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 661:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3382
+//line sql.y:3487
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 648:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3386
+//line sql.y:3491
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdent(string(yyDollar[3].str)))
 		}
-	case 649:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:3392
+//line sql.y:3497
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 664:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3396
+//line sql.y:3501
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 651:
+	case 665:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3409
+//line sql.y:3514
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 666:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3413
+//line sql.y:3518
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 667:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3417
+//line sql.y:3522
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 668:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3421
+//line sql.y:3526
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3427
+//line sql.y:3532
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 656:
+	case 670:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3429
+//line sql.y:3534
 		{
 			yyVAL.joinCondition = &JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
-	case 657:
+	case 671:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3433
+//line sql.y:3538
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
-	case 658:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3435
+//line sql.y:3540
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 659:
+	case 673:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3439
+//line sql.y:3544
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
-	case 660:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3441
+//line sql.y:3546
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 661:
+	case 675:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3444
+//line sql.y:3549
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 662:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3446
+//line sql.y:3551
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 663:
+	case 677:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3449
+//line sql.y:3554
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 664:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3453
+//line sql.y:3558
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 665:
+	case 679:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3457
+//line sql.y:3562
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 667:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3464
+//line sql.y:3569
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 668:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3470
+//line sql.y:3575
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 683:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3474
+//line sql.y:3579
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 684:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3478
+//line sql.y:3583
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3484
+//line sql.y:3589
 		{
 			yyLOCAL = StraightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3490
+//line sql.y:3595
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 687:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3494
+//line sql.y:3599
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3498
+//line sql.y:3603
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3502
+//line sql.y:3607
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3508
+//line sql.y:3613
 		{
 			yyLOCAL = NaturalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 691:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3512
+//line sql.y:3617
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -10823,1336 +11205,1655 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 692:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3522
+//line sql.y:3627
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 679:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3526
+//line sql.y:3631
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 680:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3532
+//line sql.y:3637
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 681:
+	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3536
+//line sql.y:3641
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 682:
+	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3542
+//line sql.y:3647
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 683:
+	case 697:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3547
+//line sql.y:3652
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 698:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3551
+//line sql.y:3656
 		{
 			yyLOCAL = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 699:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3555
+//line sql.y:3660
 		{
 			yyLOCAL = &IndexHints{Type: UseOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 700:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3559
+//line sql.y:3664
 		{
 			yyLOCAL = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 701:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3563
+//line sql.y:3668
 		{
 			yyLOCAL = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 702:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3568
+//line sql.y:3673
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 703:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3572
+//line sql.y:3677
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3578
+//line sql.y:3683
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3582
+//line sql.y:3687
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 706:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3586
+//line sql.y:3691
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 707:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3590
+//line sql.y:3695
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 708:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3594
+//line sql.y:3699
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 709:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3598
+//line sql.y:3703
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].isExprOperatorUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3602
+//line sql.y:3707
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3606
+//line sql.y:3711
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 712:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3612
+//line sql.y:3717
 		{
 			yyVAL.str = ""
 		}
-	case 699:
+	case 713:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3616
+//line sql.y:3721
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 700:
+	case 714:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3622
+//line sql.y:3727
 		{
 			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3626
+//line sql.y:3731
 		{
 			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 716:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3632
+//line sql.y:3737
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 717:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3636
+//line sql.y:3741
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 718:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3640
+//line sql.y:3745
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 719:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3644
+//line sql.y:3749
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 720:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3648
+//line sql.y:3753
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 721:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3652
+//line sql.y:3757
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 722:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3656
+//line sql.y:3761
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 723:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3660
+//line sql.y:3765
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: BetweenOp, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 724:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3664
+//line sql.y:3769
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: NotBetweenOp, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3668
+//line sql.y:3773
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3674
+//line sql.y:3779
 		{
 			yyLOCAL = IsNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3678
+//line sql.y:3783
 		{
 			yyLOCAL = IsNotNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 728:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3682
+//line sql.y:3787
 		{
 			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 729:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3686
+//line sql.y:3791
 		{
 			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3690
+//line sql.y:3795
 		{
 			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 731:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3694
+//line sql.y:3799
 		{
 			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 732:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3700
+//line sql.y:3805
 		{
 			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3704
+//line sql.y:3809
 		{
 			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 734:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3708
+//line sql.y:3813
 		{
 			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3712
+//line sql.y:3817
 		{
 			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 736:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3716
+//line sql.y:3821
 		{
 			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3720
+//line sql.y:3825
 		{
 			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3724
+//line sql.y:3829
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 739:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3729
+//line sql.y:3834
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 740:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3733
+//line sql.y:3838
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 741:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3739
+//line sql.y:3844
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 742:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3743
+//line sql.y:3848
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3747
+//line sql.y:3852
 		{
 			yyLOCAL = ListArg(yyDollar[1].str[2:])
 			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:3754
+//line sql.y:3859
 		{
 			yyLOCAL = &Subquery{yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 745:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:3760
+//line sql.y:3865
 		{
 			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3764
+//line sql.y:3869
 		{
 			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
 		}
-	case 733:
+	case 747:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3770
+//line sql.y:3875
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3774
+//line sql.y:3879
 		{
 			yyLOCAL = yyDollar[1].boolValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 749:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3778
+//line sql.y:3883
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 750:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3782
+//line sql.y:3887
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 751:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3786
+//line sql.y:3891
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3790
+//line sql.y:3895
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 753:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3794
+//line sql.y:3899
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 754:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3798
+//line sql.y:3903
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3802
+//line sql.y:3907
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3806
+//line sql.y:3911
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3810
+//line sql.y:3915
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 758:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3814
+//line sql.y:3919
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3818
+//line sql.y:3923
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 760:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3822
+//line sql.y:3927
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3826
+//line sql.y:3931
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 762:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3830
+//line sql.y:3935
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3834
+//line sql.y:3939
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 764:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3838
+//line sql.y:3943
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 765:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3842
+//line sql.y:3947
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3846
+//line sql.y:3951
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Charset: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 767:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3850
+//line sql.y:3955
 		{
 			yyLOCAL = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 768:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3854
+//line sql.y:3959
 		{
 			yyLOCAL = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 769:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3858
+//line sql.y:3963
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 770:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3862
+//line sql.y:3967
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 771:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3971
+		{
+			yyLOCAL = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 772:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3975
+		{
+			yyLOCAL = yyDollar[2].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 773:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3979
+		{
+			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 774:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3983
+		{
+			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 775:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3987
+		{
+			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 776:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3991
+		{
+			// This rule prevents the usage of INTERVAL
+			// as a function. If support is needed for that,
+			// we'll need to revisit this. The solution
+			// will be non-trivial because of grammar conflicts.
+			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
+		}
+		yyVAL.union = yyLOCAL
+	case 782:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4010
+		{
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 783:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4014
+		{
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 784:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4018
+		{
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 785:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4022
+		{
+			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 786:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4031
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent(string(yyDollar[1].str)), Over: yyDollar[4].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 787:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4035
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent(string(yyDollar[1].str)), Over: yyDollar[4].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 788:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4039
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent(string(yyDollar[1].str)), Over: yyDollar[4].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 789:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4043
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent(string(yyDollar[1].str)), Over: yyDollar[4].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 790:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4047
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent(string(yyDollar[1].str)), Over: yyDollar[4].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 791:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4051
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent(string(yyDollar[1].str)), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[3].exprUnion()}}, Over: yyDollar[5].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 792:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4055
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent(string(yyDollar[1].str)), Exprs: yyDollar[3].selectExprsUnion(), Over: yyDollar[5].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 793:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4059
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent(string(yyDollar[1].str)), Exprs: yyDollar[3].selectExprsUnion(), Over: yyDollar[5].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 794:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4063
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent(string(yyDollar[1].str)), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[3].exprUnion()}}, Over: yyDollar[5].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 795:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4067
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent(string(yyDollar[1].str)), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[3].exprUnion()}}, Over: yyDollar[5].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 796:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4071
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent(string(yyDollar[1].str)), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[3].exprUnion()}, &AliasedExpr{Expr: yyDollar[5].exprUnion()}}, Over: yyDollar[7].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 797:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4075
+		{
+			// Of the other functions, only the aggregates can be used as window functions
+			if !IsWindowAggregate(yyDollar[1].colIdent) {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyLOCAL = &WindowFuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion(), Over: yyDollar[5].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 798:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL SelectExprs
+//line sql.y:4086
+		{
+			yyLOCAL = SelectExprs{&AliasedExpr{Expr: yyDollar[1].exprUnion()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 799:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL SelectExprs
+//line sql.y:4090
+		{
+			yyLOCAL = SelectExprs{&AliasedExpr{Expr: yyDollar[1].exprUnion()}, &AliasedExpr{Expr: yyDollar[3].exprUnion()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 800:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL SelectExprs
+//line sql.y:4094
+		{
+			yyLOCAL = SelectExprs{&AliasedExpr{Expr: yyDollar[1].exprUnion()}, &AliasedExpr{Expr: yyDollar[3].exprUnion()}, &AliasedExpr{Expr: yyDollar[5].exprUnion()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 801:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4100
+		{
+			yyLOCAL = &OverClause{WindowSpec: yyDollar[3].windowSpecUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 802:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4104
+		{
+			yyLOCAL = &OverClause{WindowName: yyDollar[2].colIdent}
+		}
+		yyVAL.union = yyLOCAL
+	case 803:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *WindowSpecification
+//line sql.y:4110
+		{
+			yyLOCAL = &WindowSpecification{PartitionClause: yyDollar[1].exprsUnion(), OrderClause: yyDollar[2].orderByUnion(), FrameClause: yyDollar[3].frameClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 804:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *WindowSpecification
+//line sql.y:4114
+		{
+			// Only plain identifiers can name the window being refined here,
+			// ROWS and RANGE would otherwise be ambiguous with the frame clause
+			yyLOCAL = &WindowSpecification{Name: NewColIdent(string(yyDollar[1].str)), PartitionClause: yyDollar[2].exprsUnion(), OrderClause: yyDollar[3].orderByUnion(), FrameClause: yyDollar[4].frameClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 805:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4121
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 806:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4125
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 807:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4130
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 808:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4134
+		{
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitUnion(), Start: yyDollar[2].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 809:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4138
+		{
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitUnion(), Start: yyDollar[3].framePointUnion(), End: yyDollar[5].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 810:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL FrameUnitType
+//line sql.y:4144
+		{
+			yyLOCAL = RowsUnit
+		}
+		yyVAL.union = yyLOCAL
+	case 811:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL FrameUnitType
+//line sql.y:4148
+		{
+			yyLOCAL = RangeUnit
+		}
+		yyVAL.union = yyLOCAL
+	case 812:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4154
+		{
+			yyLOCAL = &FramePoint{Type: CurrentRowType}
+		}
+		yyVAL.union = yyLOCAL
+	case 813:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4158
+		{
+			yyLOCAL = &FramePoint{Type: UnboundedPrecedingType}
+		}
+		yyVAL.union = yyLOCAL
+	case 814:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3866
+		var yyLOCAL *FramePoint
+//line sql.y:4162
 		{
-			yyLOCAL = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].exprUnion()}
+			yyLOCAL = &FramePoint{Type: UnboundedFollowingType}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 815:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3870
+		var yyLOCAL *FramePoint
+//line sql.y:4166
 		{
-			yyLOCAL = yyDollar[2].exprUnion()
+			yyLOCAL = &FramePoint{Type: ExprPrecedingType, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 816:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3874
+		var yyLOCAL *FramePoint
+//line sql.y:4170
 		{
-			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
+			yyLOCAL = &FramePoint{Type: ExprFollowingType, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 817:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3878
+//line sql.y:4176
 		{
-			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
+			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 818:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3882
+//line sql.y:4180
 		{
-			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
+			yyLOCAL = NewArgument(yyDollar[1].str[1:])
+			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 819:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3886
+//line sql.y:4185
 		{
-			// This rule prevents the usage of INTERVAL
-			// as a function. If support is needed for that,
-			// we'll need to revisit this. The solution
-			// will be non-trivial because of grammar conflicts.
 			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3904
+	case 820:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL WindowDefinitions
+//line sql.y:4190
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3908
+	case 821:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL WindowDefinitions
+//line sql.y:4194
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+			yyLOCAL = yyDollar[2].windowDefsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3912
+	case 822:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL WindowDefinitions
+//line sql.y:4200
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+			yyLOCAL = WindowDefinitions{yyDollar[1].windowDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3916
+	case 823:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4204
 		{
-			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
+			yySLICE := (*WindowDefinitions)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].windowDefUnion())
+		}
+	case 824:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *WindowDefinition
+//line sql.y:4210
+		{
+			yyLOCAL = &WindowDefinition{Name: yyDollar[1].colIdent, WindowSpec: yyDollar[4].windowSpecUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 825:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3926
+//line sql.y:4220
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 826:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3930
+//line sql.y:4224
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 827:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3934
+//line sql.y:4228
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 828:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3938
+//line sql.y:4232
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 829:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3942
+//line sql.y:4236
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 830:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3946
+//line sql.y:4240
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 831:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3950
+//line sql.y:4244
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 832:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3954
+//line sql.y:4248
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 833:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3958
+//line sql.y:4252
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 834:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3962
+//line sql.y:4256
 		{
 			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 835:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3966
+//line sql.y:4260
 		{
 			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 836:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3970
+//line sql.y:4264
 		{
 			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 837:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3974
+//line sql.y:4268
 		{
 			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 838:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3978
+//line sql.y:4272
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 839:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3988
+//line sql.y:4282
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 840:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3992
+//line sql.y:4286
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 841:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3996
+//line sql.y:4290
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 842:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4001
+//line sql.y:4295
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 843:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4006
+//line sql.y:4300
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtime")}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 844:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4011
+//line sql.y:4305
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 845:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4017
+//line sql.y:4311
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 846:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4022
+//line sql.y:4316
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 847:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4027
+//line sql.y:4321
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 848:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4031
+//line sql.y:4325
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 849:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4035
+//line sql.y:4329
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 850:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4040
+//line sql.y:4334
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 851:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4045
+//line sql.y:4339
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 852:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4050
+//line sql.y:4344
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 853:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4054
+//line sql.y:4348
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 854:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4058
+//line sql.y:4352
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 857:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4068
+//line sql.y:4362
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 858:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4078
+//line sql.y:4372
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 859:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4082
+//line sql.y:4376
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 860:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4086
+//line sql.y:4380
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 861:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4090
+//line sql.y:4384
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 862:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4094
+//line sql.y:4388
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 863:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4098
+//line sql.y:4392
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 864:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4102
+//line sql.y:4396
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 865:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4108
+//line sql.y:4402
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 866:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4112
+//line sql.y:4406
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 867:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4116
+//line sql.y:4410
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 868:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4120
+//line sql.y:4414
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 869:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4124
+//line sql.y:4418
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4130
+//line sql.y:4424
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 817:
+	case 871:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4134
+//line sql.y:4428
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 818:
+	case 872:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4138
+//line sql.y:4432
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 819:
+	case 873:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4144
+//line sql.y:4438
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 874:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4148
+//line sql.y:4442
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 875:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4152
+//line sql.y:4446
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: string(yyDollar[3].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 876:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4156
+//line sql.y:4450
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 877:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4160
+//line sql.y:4454
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 878:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4164
+//line sql.y:4458
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 879:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4170
+//line sql.y:4464
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 880:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4174
+//line sql.y:4468
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4178
+//line sql.y:4472
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 882:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4182
+//line sql.y:4476
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 883:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4186
+//line sql.y:4480
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 884:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4190
+//line sql.y:4484
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 885:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4194
+//line sql.y:4488
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 886:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4199
+//line sql.y:4493
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 887:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4203
+//line sql.y:4497
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 888:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4208
+//line sql.y:4502
 		{
 			yyVAL.str = string("")
 		}
-	case 835:
+	case 889:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4212
+//line sql.y:4506
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 836:
+	case 890:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:4218
+//line sql.y:4512
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 891:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4222
+//line sql.y:4516
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 838:
+	case 892:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:4228
+//line sql.y:4522
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 893:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4233
+//line sql.y:4527
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 894:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4237
+//line sql.y:4531
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 895:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4243
+//line sql.y:4537
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 896:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4247
+//line sql.y:4541
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 897:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4251
+//line sql.y:4545
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 898:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4257
+//line sql.y:4551
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 899:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4261
+//line sql.y:4555
 		{
 			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 900:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4265
+//line sql.y:4559
 		{
 			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 901:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4269
+//line sql.y:4563
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4273
+//line sql.y:4567
 		{
 			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 903:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4277
+//line sql.y:4571
 		{
 			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 904:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4281
+//line sql.y:4575
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 905:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4286
+//line sql.y:4580
 		{
 			yyLOCAL = &NullVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 906:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4292
+//line sql.y:4586
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -12162,707 +12863,707 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 907:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4301
+//line sql.y:4595
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 908:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4305
+//line sql.y:4599
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 909:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4311
+//line sql.y:4605
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 910:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4315
+//line sql.y:4609
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 911:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4320
+//line sql.y:4614
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 912:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4324
+//line sql.y:4618
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 913:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4329
+//line sql.y:4623
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 914:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4333
+//line sql.y:4627
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 915:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4339
+//line sql.y:4633
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 916:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4345
+//line sql.y:4639
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4349
+//line sql.y:4643
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 864:
+	case 918:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:4355
+//line sql.y:4649
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 919:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4360
+//line sql.y:4654
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 920:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4364
+//line sql.y:4658
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 921:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4368
+//line sql.y:4662
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 922:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4373
+//line sql.y:4667
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 923:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4377
+//line sql.y:4671
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 924:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4383
+//line sql.y:4677
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 925:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4387
+//line sql.y:4681
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 926:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4391
+//line sql.y:4685
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 927:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4396
+//line sql.y:4690
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 928:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4400
+//line sql.y:4694
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 929:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4404
+//line sql.y:4698
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 930:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4408
+//line sql.y:4702
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 931:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4412
+//line sql.y:4706
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 932:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4419
+//line sql.y:4713
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 933:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4423
+//line sql.y:4717
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 934:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4427
+//line sql.y:4721
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 935:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4431
+//line sql.y:4725
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 936:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4437
+//line sql.y:4731
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 937:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4441
+//line sql.y:4735
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 938:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4445
+//line sql.y:4739
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 939:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4450
+//line sql.y:4744
 		{
 			yyVAL.str = ""
 		}
-	case 886:
+	case 940:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4454
+//line sql.y:4748
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 887:
+	case 941:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4458
+//line sql.y:4752
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 888:
+	case 942:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4462
+//line sql.y:4756
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 889:
+	case 943:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4467
+//line sql.y:4761
 		{
 			yyVAL.str = ""
 		}
-	case 890:
+	case 944:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4471
+//line sql.y:4765
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 891:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4477
+//line sql.y:4771
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 892:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4481
+//line sql.y:4775
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 893:
+	case 947:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4486
+//line sql.y:4780
 		{
 			yyVAL.str = ""
 		}
-	case 894:
+	case 948:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4490
+//line sql.y:4784
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 895:
+	case 949:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4495
+//line sql.y:4789
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 896:
+	case 950:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4499
+//line sql.y:4793
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 897:
+	case 951:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4503
+//line sql.y:4797
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 898:
+	case 952:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4508
+//line sql.y:4802
 		{
 			yyVAL.str = ""
 		}
-	case 899:
+	case 953:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4512
+//line sql.y:4806
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 900:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4518
+//line sql.y:4812
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 901:
+	case 955:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4522
+//line sql.y:4816
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 902:
+	case 956:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4526
+//line sql.y:4820
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str) + "@" + string(yyDollar[2].str)
 		}
-	case 903:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4530
+//line sql.y:4824
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 904:
+	case 958:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4536
+//line sql.y:4830
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 959:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4540
+//line sql.y:4834
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 960:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4546
+//line sql.y:4840
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 961:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4550
+//line sql.y:4844
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 962:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4554
+//line sql.y:4848
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 963:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4559
+//line sql.y:4853
 		{
 			yyVAL.str = ""
 		}
-	case 910:
+	case 964:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4563
+//line sql.y:4857
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 911:
+	case 965:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4567
+//line sql.y:4861
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 912:
+	case 966:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4572
+//line sql.y:4866
 		{
 			yyVAL.str = ""
 		}
-	case 913:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4576
+//line sql.y:4870
 		{
 			yyVAL.str = " header"
 		}
-	case 914:
+	case 968:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4581
+//line sql.y:4875
 		{
 			yyVAL.str = ""
 		}
-	case 915:
+	case 969:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4585
+//line sql.y:4879
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 916:
+	case 970:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4589
+//line sql.y:4883
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 917:
+	case 971:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4594
+//line sql.y:4888
 		{
 			yyVAL.str = ""
 		}
-	case 918:
+	case 972:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4598
+//line sql.y:4892
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 919:
+	case 973:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4602
+//line sql.y:4896
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 920:
+	case 974:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4608
+//line sql.y:4902
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 921:
+	case 975:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4613
+//line sql.y:4907
 		{
 			yyVAL.str = ""
 		}
-	case 922:
+	case 976:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4617
+//line sql.y:4911
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 923:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4623
+//line sql.y:4917
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 924:
+	case 978:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4627
+//line sql.y:4921
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 925:
+	case 979:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4633
+//line sql.y:4927
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 926:
+	case 980:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4637
+//line sql.y:4931
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 927:
+	case 981:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4642
+//line sql.y:4936
 		{
 			yyVAL.str = ""
 		}
-	case 928:
+	case 982:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4646
+//line sql.y:4940
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 929:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4652
+//line sql.y:4946
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 930:
+	case 984:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4656
+//line sql.y:4950
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 931:
+	case 985:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4662
+//line sql.y:4956
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 932:
+	case 986:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4666
+//line sql.y:4960
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 933:
+	case 987:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4670
+//line sql.y:4964
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 934:
+	case 988:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4675
+//line sql.y:4969
 		{
 			yyVAL.str = ""
 		}
-	case 935:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4679
+//line sql.y:4973
 		{
 			yyVAL.str = " optionally"
 		}
-	case 936:
+	case 990:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4692
+//line sql.y:4986
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 991:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4696
+//line sql.y:4990
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 992:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4700
+//line sql.y:4994
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 993:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4704
+//line sql.y:4998
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 994:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4708
+//line sql.y:5002
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4714
+//line sql.y:5008
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 996:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4718
+//line sql.y:5012
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 997:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4722
+//line sql.y:5016
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 944:
+	case 998:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4726
+//line sql.y:5020
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 945:
+	case 999:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4731
+//line sql.y:5025
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 1000:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4735
+//line sql.y:5029
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 1001:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:4741
+//line sql.y:5035
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 1002:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4745
+//line sql.y:5039
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 949:
+	case 1003:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4751
+//line sql.y:5045
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1004:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4755
+//line sql.y:5049
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1005:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4761
+//line sql.y:5055
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4767
+//line sql.y:5061
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -12871,329 +13572,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1007:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4777
+//line sql.y:5071
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1008:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4781
+//line sql.y:5075
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 955:
+	case 1009:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:4787
+//line sql.y:5081
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:4793
+//line sql.y:5087
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1011:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4797
+//line sql.y:5091
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 958:
+	case 1012:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4803
+//line sql.y:5097
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 1013:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4807
+//line sql.y:5101
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1014:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4811
+//line sql.y:5105
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1015:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4815
+//line sql.y:5109
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1016:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4819
+//line sql.y:5113
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1018:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4827
+//line sql.y:5121
 		{
 			yyVAL.str = "charset"
 		}
-	case 967:
+	case 1021:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4837
+//line sql.y:5131
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1022:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4841
+//line sql.y:5135
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4845
+//line sql.y:5139
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1026:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4854
+//line sql.y:5148
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1027:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4856
+//line sql.y:5150
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1028:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4859
+//line sql.y:5153
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1029:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4861
+//line sql.y:5155
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1030:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4864
+//line sql.y:5158
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1031:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4866
+//line sql.y:5160
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1032:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4869
+//line sql.y:5163
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1033:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4871
+//line sql.y:5165
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1034:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4874
+//line sql.y:5168
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 981:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4876
+//line sql.y:5170
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 982:
+	case 1036:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4878
+//line sql.y:5172
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 983:
+	case 1037:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:4882
+//line sql.y:5176
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1038:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4887
+//line sql.y:5181
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1039:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4891
+//line sql.y:5185
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1040:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4896
+//line sql.y:5190
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1041:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4898
+//line sql.y:5192
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1042:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:4902
+//line sql.y:5196
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1043:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4908
+//line sql.y:5202
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 990:
+	case 1044:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4912
+//line sql.y:5206
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 992:
+	case 1046:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4919
+//line sql.y:5213
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 993:
+	case 1047:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4925
+//line sql.y:5219
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 994:
+	case 1048:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4929
+//line sql.y:5223
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 995:
+	case 1049:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4935
+//line sql.y:5229
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 996:
+	case 1050:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4939
+//line sql.y:5233
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 998:
+	case 1052:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4946
+//line sql.y:5240
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1413:
+	case 1473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5385
+//line sql.y:5685
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1414:
+	case 1474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5394
+//line sql.y:5694
 		{
 			decNesting(yylex)
 		}
-	case 1415:
+	case 1475:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5399
+//line sql.y:5699
 		{
 			skipToEnd(yylex)
 		}
-	case 1416:
+	case 1476:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5404
+//line sql.y:5704
 		{
 			skipToEnd(yylex)
 		}
-	case 1417:
+	case 1477:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5408
+//line sql.y:5708
 		{
 			skipToEnd(yylex)
 		}
-	case 1418:
+	case 1478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5412
+//line sql.y:5712
 		{
 			skipToEnd(yylex)
 		}
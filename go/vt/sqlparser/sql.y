@@ -113,8 +113,18 @@ func bindVariable(yylex yyLexer, bvar string) {
   constraintDefinition *ConstraintDefinition
   revertMigration *RevertMigration
   alterMigration  *AlterMigration
+  with          *With
+  cte           *CommonTableExpr
+  overClause    *OverClause
+  windowSpec    *WindowSpecification
+  frameClause   *FrameClause
+  framePoint    *FramePoint
+  frameUnit     FrameUnitType
+  windowDef     *WindowDefinition
+  windowDefs    WindowDefinitions
 
   whens         []*When
+  ctes          []*CommonTableExpr
   columnDefinitions []*ColumnDefinition
   indexOptions  []*IndexOption
   indexColumns  []*IndexColumn
@@ -152,6 +162,7 @@ func bindVariable(yylex yyLexer, bvar string) {
   matchExprOption MatchExprOption
   orderDirection  OrderDirection
   explainType 	  ExplainType
+  killType        KillType
   lockType LockType
   referenceDefinition *ReferenceDefinition
 
@@ -217,7 +228,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <empty> JSON_EXTRACT_OP JSON_UNQUOTE_EXTRACT_OP
 
 // DDL Tokens
-%token <str> CREATE ALTER DROP RENAME ANALYZE ADD FLUSH CHANGE MODIFY
+%token <str> CREATE ALTER DROP RENAME ANALYZE ADD FLUSH CHANGE MODIFY KILL
 %token <str> REVERT
 %token <str> SCHEMA TABLE INDEX VIEW TO IGNORE IF PRIMARY COLUMN SPATIAL FULLTEXT KEY_BLOCK_SIZE CHECK INDEXES
 %token <str> ACTION CASCADE CONSTRAINT FOREIGN NO REFERENCES RESTRICT
@@ -248,7 +259,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 // SHOW tokens
 %token <str> CODE COLLATION COLUMNS DATABASES ENGINES EVENT EXTENDED FIELDS FULL FUNCTION GTID_EXECUTED
 %token <str> KEYSPACES OPEN PLUGINS PRIVILEGES PROCESSLIST SCHEMAS TABLES TRIGGERS USER
-%token <str> VGTID_EXECUTED VITESS_KEYSPACES VITESS_METADATA VITESS_MIGRATIONS VITESS_REPLICATION_STATUS VITESS_SHARDS VITESS_TABLETS VSCHEMA
+%token <str> VGTID_EXECUTED VITESS_KEYSPACES VITESS_METADATA VITESS_MIGRATIONS VITESS_PLANS VITESS_QUERY_STATS VITESS_REPLICATION_STATUS VITESS_SHARDS VITESS_TABLETS VSCHEMA
 
 // SET tokens
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
@@ -275,6 +286,9 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
 %token <str> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
 
+// Window functions
+%token <str> CURRENT RANGE ROW ROWS
+
 // Explain tokens
 %token <str> FORMAT TREE VITESS TRADITIONAL
 
@@ -290,6 +304,18 @@ func bindVariable(yylex yyLexer, bvar string) {
 
 %type <statement> command
 %type <selStmt> query_expression_parens query_expression query_expression_body select_statement query_primary select_stmt_with_into
+%type <with> with_clause
+%type <ctes> with_list
+%type <cte> common_table_expr
+%type <expr> function_call_window frame_expression
+%type <overClause> over_clause
+%type <windowSpec> window_spec
+%type <exprs> partition_by_opt
+%type <frameClause> frame_clause_opt
+%type <framePoint> frame_point
+%type <frameUnit> frame_unit
+%type <windowDef> window_definition
+%type <windowDefs> window_clause_opt window_definition_list
 %type <statement> explain_statement explainable_statement
 %type <statement> stream_statement vstream_statement insert_statement update_statement delete_statement set_statement set_transaction_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement do_statement
@@ -307,17 +333,18 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <statement> analyze_statement show_statement use_statement other_statement
 %type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement load_statement
 %type <statement> lock_statement unlock_statement call_statement
-%type <statement> revert_statement
+%type <statement> revert_statement kill_statement
 %type <strs> comment_opt comment_list
 %type <str> wild_opt check_option_opt cascade_or_local_opt restrict_or_cascade_opt
 %type <explainType> explain_format_opt
+%type <killType> kill_type_opt
 %type <insertAction> insert_or_replace
 %type <str> explain_synonyms
 %type <str> cache_opt separator_opt flush_option for_channel_opt
 %type <matchExprOption> match_option
 %type <boolean> distinct_opt union_op replace_opt local_opt
 %type <expr> like_escape_opt
-%type <selectExprs> select_expression_list select_expression_list_opt
+%type <selectExprs> select_expression_list select_expression_list_opt lag_lead_args
 %type <selectExpr> select_expression
 %type <strs> select_options flush_option_list
 %type <str> select_option algorithm_view security_view security_view_opt
@@ -421,7 +448,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <colIdent> id_or_var vindex_type vindex_type_opt id_or_var_opt
 %type <str> database_or_schema column_opt insert_method_options row_format_options
 %type <ReferenceAction> fk_reference_action fk_on_delete fk_on_update
-%type <str> vitess_topo
+%type <str> vitess_topo vitess_plan_cache
 %type <tableAndLockTypes> lock_table_list
 %type <tableAndLockType> lock_table
 %type <lockType> lock_type
@@ -478,6 +505,7 @@ command:
 | unlock_statement
 | call_statement
 | revert_statement
+| kill_statement
 | /*empty*/
 {
   setParseTree(yylex, nil)
@@ -555,6 +583,13 @@ query_expression:
 		$1.SetLimit($3)
 		$$ = $1
   }
+| with_clause query_expression_body order_by_opt limit_opt
+  {
+	$2.SetWith($1)
+	$2.SetOrderBy($3)
+	$2.SetLimit($4)
+	$$ = $2
+  }
 | query_expression_parens limit_clause
   {
 	$1.SetLimit($2)
@@ -593,6 +628,32 @@ query_expression_body:
 	$$ = &Union{Left: $1, Distinct: $2, Right: $3}
   }
 
+with_clause:
+  WITH with_list
+  {
+	$$ = &With{CTEs: $2, Recursive: false}
+  }
+| WITH RECURSIVE with_list
+  {
+	$$ = &With{CTEs: $3, Recursive: true}
+  }
+
+with_list:
+  common_table_expr
+  {
+	$$ = []*CommonTableExpr{$1}
+  }
+| with_list ',' common_table_expr
+  {
+	$$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id column_list_opt AS subquery
+  {
+	$$ = &CommonTableExpr{TableID: $1, Columns: $2, Subquery: $4}
+  }
+
 select_statement:
 query_expression
   {
@@ -655,13 +716,17 @@ vstream_statement:
 // query_primary is an unparenthesized SELECT with no order by clause or beyond.
 query_primary:
 //  1         2            3              4                    5             6                7           8
-  SELECT comment_opt select_options select_expression_list into_clause from_opt where_expression_opt group_by_opt having_opt
+  SELECT comment_opt select_options select_expression_list into_clause from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*into*/, $6/*from*/, NewWhere(WhereClause, $7), GroupBy($8), NewWhere(HavingClause, $9))
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*into*/, $6/*from*/, NewWhere(WhereClause, $7), GroupBy($8), NewWhere(HavingClause, $9))
+    sel.Windows = $10
+    $$ = sel
   }
-| SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt
+| SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, nil, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, nil, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel.Windows = $9
+    $$ = sel
   }
 
 
@@ -2703,6 +2768,12 @@ show_statement:
     showTablesOpt := &ShowTablesOpt{Filter: $3}
     $$ = &Show{&ShowLegacy{Type: $2, ShowTablesOpt: showTablesOpt}}
   }
+/* vitess_plan_cache supports SHOW VITESS_PLANS / SHOW VITESS_QUERY_STATS */
+| SHOW vitess_plan_cache like_or_where_opt
+  {
+    showTablesOpt := &ShowTablesOpt{Filter: $3}
+    $$ = &Show{&ShowLegacy{Type: $2, ShowTablesOpt: showTablesOpt}}
+  }
 /*
  * Catch-all for show statements without vitess keywords:
  *
@@ -2733,6 +2804,16 @@ vitess_topo:
     $$ = string($1)
   }
 
+vitess_plan_cache:
+  VITESS_PLANS
+  {
+    $$ = string($1)
+  }
+| VITESS_QUERY_STATS
+  {
+    $$ = string($1)
+  }
+
 extended_opt:
   /* empty */
   {
@@ -3010,6 +3091,30 @@ unlock_statement:
     $$ = &UnlockTables{}
   }
 
+kill_statement:
+  KILL kill_type_opt INTEGRAL
+  {
+    id, ok := parseProcesslistID($3)
+    if !ok {
+      yylex.Error("invalid processlist id")
+      return 1
+    }
+    $$ = &Kill{Type: $2, ProcesslistID: id}
+  }
+
+kill_type_opt:
+  {
+    $$ = ConnectionType
+  }
+| CONNECTION
+  {
+    $$ = ConnectionType
+  }
+| QUERY
+  {
+    $$ = QueryType
+  }
+
 revert_statement:
   REVERT comment_opt VITESS_MIGRATION STRING
   {
@@ -3894,6 +3999,7 @@ value_expression:
 | function_call_keyword
 | function_call_nonkeyword
 | function_call_conflict
+| function_call_window
 
 /*
   Regular function calls without special token or syntax, guaranteed to not
@@ -3917,6 +4023,194 @@ function_call_generic:
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
 
+/*
+  Calls to window functions, and to aggregate functions used as window functions
+*/
+function_call_window:
+  ROW_NUMBER openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent(string($1)), Over: $4}
+  }
+| RANK openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent(string($1)), Over: $4}
+  }
+| DENSE_RANK openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent(string($1)), Over: $4}
+  }
+| CUME_DIST openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent(string($1)), Over: $4}
+  }
+| PERCENT_RANK openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent(string($1)), Over: $4}
+  }
+| NTILE openb expression closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent(string($1)), Exprs: SelectExprs{&AliasedExpr{Expr: $3}}, Over: $5}
+  }
+| LAG openb lag_lead_args closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent(string($1)), Exprs: $3, Over: $5}
+  }
+| LEAD openb lag_lead_args closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent(string($1)), Exprs: $3, Over: $5}
+  }
+| FIRST_VALUE openb expression closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent(string($1)), Exprs: SelectExprs{&AliasedExpr{Expr: $3}}, Over: $5}
+  }
+| LAST_VALUE openb expression closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent(string($1)), Exprs: SelectExprs{&AliasedExpr{Expr: $3}}, Over: $5}
+  }
+| NTH_VALUE openb expression ',' expression closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent(string($1)), Exprs: SelectExprs{&AliasedExpr{Expr: $3}, &AliasedExpr{Expr: $5}}, Over: $7}
+  }
+| sql_id openb select_expression_list_opt closeb over_clause
+  {
+    // Of the other functions, only the aggregates can be used as window functions
+    if !IsWindowAggregate($1) {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &WindowFuncExpr{Name: $1, Exprs: $3, Over: $5}
+  }
+
+lag_lead_args:
+  expression
+  {
+    $$ = SelectExprs{&AliasedExpr{Expr: $1}}
+  }
+| expression ',' expression
+  {
+    $$ = SelectExprs{&AliasedExpr{Expr: $1}, &AliasedExpr{Expr: $3}}
+  }
+| expression ',' expression ',' expression
+  {
+    $$ = SelectExprs{&AliasedExpr{Expr: $1}, &AliasedExpr{Expr: $3}, &AliasedExpr{Expr: $5}}
+  }
+
+over_clause:
+  OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+
+window_spec:
+  partition_by_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpecification{PartitionClause: $1, OrderClause: $2, FrameClause: $3}
+  }
+| ID partition_by_opt order_by_opt frame_clause_opt
+  {
+    // Only plain identifiers can name the window being refined here,
+    // ROWS and RANGE would otherwise be ambiguous with the frame clause
+    $$ = &WindowSpecification{Name: NewColIdent(string($1)), PartitionClause: $2, OrderClause: $3, FrameClause: $4}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsUnit
+  }
+| RANGE
+  {
+    $$ = RangeUnit
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowType}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingType}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingType}
+  }
+| frame_expression PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: $1}
+  }
+| frame_expression FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: $1}
+  }
+
+frame_expression:
+  INTEGRAL
+  {
+    $$ = NewIntLiteral($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewArgument($1[1:])
+    bindVariable(yylex, $1[1:])
+  }
+| INTERVAL value_expression sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
+window_clause_opt:
+  {
+    $$ = nil
+  }
+| WINDOW window_definition_list
+  {
+    $$ = $2
+  }
+
+window_definition_list:
+  window_definition
+  {
+    $$ = WindowDefinitions{$1}
+  }
+| window_definition_list ',' window_definition
+  {
+    $$ = append($1, $3)
+  }
+
+window_definition:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &WindowDefinition{Name: $1, WindowSpec: $4}
+  }
+
 /*
   Function calls using reserved keywords, with dedicated grammar rules
   as a result
@@ -5143,6 +5437,7 @@ non_reserved_keyword:
 | CONNECTION
 | COPY
 | CSV
+| CURRENT
 | DATA
 | DATE
 | DATETIME
@@ -5282,6 +5577,7 @@ non_reserved_keyword:
 | PROCESSLIST
 | QUERY
 | RANDOM
+| RANGE
 | REAL
 | REBUILD
 | REDUNDANT
@@ -5302,6 +5598,8 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROW
+| ROWS
 | ROW_FORMAT
 | S3
 | SECONDARY
@@ -5370,6 +5668,8 @@ non_reserved_keyword:
 | VITESS_METADATA
 | VITESS_MIGRATION
 | VITESS_MIGRATIONS
+| VITESS_PLANS
+| VITESS_QUERY_STATS
 | VITESS_REPLICATION_STATUS
 | VITESS_SHARDS
 | VITESS_TABLETS
@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/wrangler"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file contains the DistributedTransactions command group for vtctl.
+
+const distributedTransactionsGroupName = "DistributedTransactions"
+
+func init() {
+	addCommandGroup(distributedTransactionsGroupName)
+
+	addCommand(distributedTransactionsGroupName, command{
+		"DistributedTransactionList",
+		commandDistributedTransactionList,
+		"[-abandon_age <duration>] [-json] <keyspace>",
+		"Lists the unresolved distributed transactions whose metadata is stored in the keyspace. With -abandon_age, only lists the transactions created more than this duration ago."})
+
+	addCommand(distributedTransactionsGroupName, command{
+		"DistributedTransactionRead",
+		commandDistributedTransactionRead,
+		"[-json] <dtid>",
+		"Shows the state and the participants of a distributed transaction."})
+
+	addCommand(distributedTransactionsGroupName, command{
+		"DistributedTransactionConclude",
+		commandDistributedTransactionConclude,
+		"<dtid>",
+		"Concludes a distributed transaction like its vtgate would: commits it on all its participants if the decision to commit it was made, and rolls it back otherwise."})
+
+	addCommand(distributedTransactionsGroupName, command{
+		"DistributedTransactionRollback",
+		commandDistributedTransactionRollback,
+		"<dtid>",
+		"Rolls back a distributed transaction on all its participants. Fails if the decision to commit it was already made."})
+
+	addCommand(distributedTransactionsGroupName, command{
+		"DistributedTransactionClearFailures",
+		commandDistributedTransactionClearFailures,
+		"",
+		"Resets the DistributedTransactionResolveFailures stats of the resolver run by this vtctld."})
+}
+
+func commandDistributedTransactionList(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	abandonAge := subFlags.Duration("abandon_age", 0, "Only list the transactions created more than this duration ago")
+	outputJSON := subFlags.Bool("json", false, "Output the transactions in JSON")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the DistributedTransactionList command")
+	}
+	transactions, err := wr.ListDistributedTransactions(ctx, subFlags.Arg(0), *abandonAge)
+	if err != nil {
+		return err
+	}
+	if *outputJSON {
+		return printJSON(wr.Logger(), transactions)
+	}
+	for _, transaction := range transactions {
+		printDistributedTransaction(wr, transaction)
+	}
+	return nil
+}
+
+func commandDistributedTransactionRead(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	outputJSON := subFlags.Bool("json", false, "Output the transaction in JSON")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <dtid> argument is required for the DistributedTransactionRead command")
+	}
+	transaction, err := wr.ReadDistributedTransaction(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	if transaction.Dtid == "" {
+		return fmt.Errorf("distributed transaction %s not found: it is resolved or does not exist", subFlags.Arg(0))
+	}
+	if *outputJSON {
+		return printJSON(wr.Logger(), transaction)
+	}
+	printDistributedTransaction(wr, transaction)
+	return nil
+}
+
+func commandDistributedTransactionConclude(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <dtid> argument is required for the DistributedTransactionConclude command")
+	}
+	return wr.ConcludeDistributedTransaction(ctx, subFlags.Arg(0))
+}
+
+func commandDistributedTransactionRollback(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <dtid> argument is required for the DistributedTransactionRollback command")
+	}
+	return wr.RollbackDistributedTransaction(ctx, subFlags.Arg(0))
+}
+
+func commandDistributedTransactionClearFailures(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("the DistributedTransactionClearFailures command takes no arguments")
+	}
+	wrangler.ClearDistributedTransactionFailures()
+	return nil
+}
+
+func printDistributedTransaction(wr *wrangler.Wrangler, transaction *querypb.TransactionMetadata) {
+	participants := make([]string, 0, len(transaction.Participants))
+	for _, target := range transaction.Participants {
+		participants = append(participants, target.Keyspace+"/"+target.Shard)
+	}
+	wr.Logger().Printf("%s %v created %v participants %s\n", transaction.Dtid, transaction.State,
+		time.Unix(0, transaction.TimeCreated).UTC().Format(time.RFC3339), strings.Join(participants, ","))
+}
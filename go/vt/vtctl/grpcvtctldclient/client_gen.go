@@ -46,6 +46,15 @@ func (client *gRPCVtctldClient) AddCellsAlias(ctx context.Context, in *vtctldata
 	return client.c.AddCellsAlias(ctx, in, opts...)
 }
 
+// AddVindexRange is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) AddVindexRange(ctx context.Context, in *vtctldatapb.AddVindexRangeRequest, opts ...grpc.CallOption) (*vtctldatapb.AddVindexRangeResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.AddVindexRange(ctx, in, opts...)
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldatapb.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	if client.c == nil {
@@ -145,6 +154,51 @@ func (client *gRPCVtctldClient) DeleteTablets(ctx context.Context, in *vtctldata
 	return client.c.DeleteTablets(ctx, in, opts...)
 }
 
+// DistributedTransactionClearFailures is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) DistributedTransactionClearFailures(ctx context.Context, in *vtctldatapb.DistributedTransactionClearFailuresRequest, opts ...grpc.CallOption) (*vtctldatapb.DistributedTransactionClearFailuresResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.DistributedTransactionClearFailures(ctx, in, opts...)
+}
+
+// DistributedTransactionConclude is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) DistributedTransactionConclude(ctx context.Context, in *vtctldatapb.DistributedTransactionConcludeRequest, opts ...grpc.CallOption) (*vtctldatapb.DistributedTransactionConcludeResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.DistributedTransactionConclude(ctx, in, opts...)
+}
+
+// DistributedTransactionList is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) DistributedTransactionList(ctx context.Context, in *vtctldatapb.DistributedTransactionListRequest, opts ...grpc.CallOption) (*vtctldatapb.DistributedTransactionListResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.DistributedTransactionList(ctx, in, opts...)
+}
+
+// DistributedTransactionRead is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) DistributedTransactionRead(ctx context.Context, in *vtctldatapb.DistributedTransactionReadRequest, opts ...grpc.CallOption) (*vtctldatapb.DistributedTransactionReadResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.DistributedTransactionRead(ctx, in, opts...)
+}
+
+// DistributedTransactionRollback is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) DistributedTransactionRollback(ctx context.Context, in *vtctldatapb.DistributedTransactionRollbackRequest, opts ...grpc.CallOption) (*vtctldatapb.DistributedTransactionRollbackResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.DistributedTransactionRollback(ctx, in, opts...)
+}
+
 // EmergencyReparentShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldatapb.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldatapb.EmergencyReparentShardResponse, error) {
 	if client.c == nil {
@@ -325,6 +379,42 @@ func (client *gRPCVtctldClient) InitShardPrimary(ctx context.Context, in *vtctld
 	return client.c.InitShardPrimary(ctx, in, opts...)
 }
 
+// LookupVindexCreate is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) LookupVindexCreate(ctx context.Context, in *vtctldatapb.LookupVindexCreateRequest, opts ...grpc.CallOption) (*vtctldatapb.LookupVindexCreateResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.LookupVindexCreate(ctx, in, opts...)
+}
+
+// LookupVindexExternalize is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) LookupVindexExternalize(ctx context.Context, in *vtctldatapb.LookupVindexExternalizeRequest, opts ...grpc.CallOption) (*vtctldatapb.LookupVindexExternalizeResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.LookupVindexExternalize(ctx, in, opts...)
+}
+
+// LookupVindexShow is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) LookupVindexShow(ctx context.Context, in *vtctldatapb.LookupVindexShowRequest, opts ...grpc.CallOption) (*vtctldatapb.LookupVindexShowResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.LookupVindexShow(ctx, in, opts...)
+}
+
+// LookupVindexVerify is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) LookupVindexVerify(ctx context.Context, in *vtctldatapb.LookupVindexVerifyRequest, opts ...grpc.CallOption) (*vtctldatapb.LookupVindexVerifyResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.LookupVindexVerify(ctx, in, opts...)
+}
+
 // PingTablet is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) PingTablet(ctx context.Context, in *vtctldatapb.PingTabletRequest, opts ...grpc.CallOption) (*vtctldatapb.PingTabletResponse, error) {
 	if client.c == nil {
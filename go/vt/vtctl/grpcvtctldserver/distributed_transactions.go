@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// DistributedTransactionManager inspects and resolves the distributed
+// transactions for the DistributedTransaction RPCs. They are implemented by
+// package wrangler, which imports this package, so it registers its
+// implementation with RegisterDistributedTransactionManagerFactory.
+type DistributedTransactionManager interface {
+	DistributedTransactionClearFailures(ctx context.Context, req *vtctldatapb.DistributedTransactionClearFailuresRequest) (*vtctldatapb.DistributedTransactionClearFailuresResponse, error)
+	DistributedTransactionConclude(ctx context.Context, req *vtctldatapb.DistributedTransactionConcludeRequest) (*vtctldatapb.DistributedTransactionConcludeResponse, error)
+	DistributedTransactionList(ctx context.Context, req *vtctldatapb.DistributedTransactionListRequest) (*vtctldatapb.DistributedTransactionListResponse, error)
+	DistributedTransactionRead(ctx context.Context, req *vtctldatapb.DistributedTransactionReadRequest) (*vtctldatapb.DistributedTransactionReadResponse, error)
+	DistributedTransactionRollback(ctx context.Context, req *vtctldatapb.DistributedTransactionRollbackRequest) (*vtctldatapb.DistributedTransactionRollbackResponse, error)
+}
+
+// DistributedTransactionManagerFactory returns the DistributedTransactionManager of a VtctldServer.
+type DistributedTransactionManagerFactory func(ts *topo.Server, tmc tmclient.TabletManagerClient) DistributedTransactionManager
+
+var distributedTransactionManagerFactory DistributedTransactionManagerFactory
+
+// RegisterDistributedTransactionManagerFactory registers the implementation of
+// the DistributedTransaction RPCs. It can only be called once.
+func RegisterDistributedTransactionManagerFactory(factory DistributedTransactionManagerFactory) {
+	if distributedTransactionManagerFactory != nil {
+		log.Fatalf("RegisterDistributedTransactionManagerFactory called twice")
+	}
+	distributedTransactionManagerFactory = factory
+}
+
+func (s *VtctldServer) distributedTransactionManager() (DistributedTransactionManager, error) {
+	if distributedTransactionManagerFactory == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "no DistributedTransactionManager is registered")
+	}
+	return distributedTransactionManagerFactory(s.ts, s.tmc), nil
+}
+
+func checkDtid(dtid string) error {
+	if dtid == "" {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "dtid field is required")
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// LookupVindexManager runs the workflows backfilling lookup vindexes for the
+// LookupVindex RPCs. They are implemented by package wrangler, which imports
+// this package, so it registers its implementation with
+// RegisterLookupVindexManagerFactory.
+type LookupVindexManager interface {
+	LookupVindexCreate(ctx context.Context, req *vtctldatapb.LookupVindexCreateRequest) (*vtctldatapb.LookupVindexCreateResponse, error)
+	LookupVindexExternalize(ctx context.Context, req *vtctldatapb.LookupVindexExternalizeRequest) (*vtctldatapb.LookupVindexExternalizeResponse, error)
+	LookupVindexShow(ctx context.Context, req *vtctldatapb.LookupVindexShowRequest) (*vtctldatapb.LookupVindexShowResponse, error)
+	LookupVindexVerify(ctx context.Context, req *vtctldatapb.LookupVindexVerifyRequest) (*vtctldatapb.LookupVindexVerifyResponse, error)
+}
+
+// LookupVindexManagerFactory returns the LookupVindexManager of a VtctldServer.
+type LookupVindexManagerFactory func(ts *topo.Server, tmc tmclient.TabletManagerClient) LookupVindexManager
+
+var lookupVindexManagerFactory LookupVindexManagerFactory
+
+// RegisterLookupVindexManagerFactory registers the implementation of the
+// LookupVindex RPCs. It can only be called once.
+func RegisterLookupVindexManagerFactory(factory LookupVindexManagerFactory) {
+	if lookupVindexManagerFactory != nil {
+		log.Fatalf("RegisterLookupVindexManagerFactory called twice")
+	}
+	lookupVindexManagerFactory = factory
+}
+
+func (s *VtctldServer) lookupVindexManager() (LookupVindexManager, error) {
+	if lookupVindexManagerFactory == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "no LookupVindexManager is registered")
+	}
+	return lookupVindexManagerFactory(s.ts, s.tmc), nil
+}
+
+func checkLookupVindexName(keyspace string, name string) error {
+	if keyspace == "" {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	if name == "" {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "name field is required")
+	}
+
+	return nil
+}
@@ -48,6 +48,7 @@ import (
 	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
@@ -125,6 +126,63 @@ func (s *VtctldServer) AddCellsAlias(ctx context.Context, req *vtctldatapb.AddCe
 	return &vtctldatapb.AddCellsAliasResponse{}, nil
 }
 
+// AddVindexRange is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) AddVindexRange(ctx context.Context, req *vtctldatapb.AddVindexRangeRequest) (*vtctldatapb.AddVindexRangeResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.AddVindexRange")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("vindex", req.Vindex)
+	span.Annotate("start", req.Start)
+	span.Annotate("keyspace_id", req.KeyspaceId)
+	span.Annotate("cells", strings.Join(req.Cells, ","))
+	span.Annotate("skip_rebuild", req.SkipRebuild)
+	span.Annotate("dry_run", req.DryRun)
+
+	// The vschema is read and saved under the keyspace lock, so that a concurrent
+	// change of the vschema is not overwritten.
+	ctx, unlock, lockErr := s.ts.LockKeyspace(ctx, req.Keyspace, fmt.Sprintf("AddVindexRange(%v,%v)", req.Vindex, req.Start))
+	if lockErr != nil {
+		return nil, lockErr
+	}
+
+	var err error
+	defer unlock(&err)
+
+	vs, err := s.ts.GetVSchema(ctx, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "GetVSchema(%s)", req.Keyspace)
+	}
+	vindex, ok := vs.Vindexes[req.Vindex]
+	if !ok {
+		err = vterrors.Errorf(vtrpc.Code_NOT_FOUND, "vindex %s not found in keyspace %s", req.Vindex, req.Keyspace)
+		return nil, err
+	}
+	// AddRange refuses a range that starts at the same value as an existing one,
+	// or that would move the values of an existing range to another keyspace id.
+	params, err := vindexes.AddRange(vindex.Type, vindex.Params, req.Start, req.KeyspaceId)
+	if err != nil {
+		err = vterrors.Wrapf(err, "cannot add range to vindex %s", req.Vindex)
+		return nil, err
+	}
+	vindex.Params = params
+
+	if req.DryRun {
+		return &vtctldatapb.AddVindexRangeResponse{Vindex: vindex}, nil
+	}
+
+	if err = s.ts.SaveVSchema(ctx, req.Keyspace, vs); err != nil {
+		return nil, vterrors.Wrapf(err, "SaveVSchema(%s)", req.Keyspace)
+	}
+
+	if !req.SkipRebuild {
+		if err = s.ts.RebuildSrvVSchema(ctx, req.Cells); err != nil {
+			return nil, vterrors.Wrapf(err, "RebuildSrvVSchema")
+		}
+	}
+	return &vtctldatapb.AddVindexRangeResponse{Vindex: vindex}, nil
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ApplyRoutingRules(ctx context.Context, req *vtctldatapb.ApplyRoutingRulesRequest) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyRoutingRules")
@@ -576,6 +634,95 @@ func (s *VtctldServer) DeleteTablets(ctx context.Context, req *vtctldatapb.Delet
 	return &vtctldatapb.DeleteTabletsResponse{}, nil
 }
 
+// DistributedTransactionClearFailures is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DistributedTransactionClearFailures(ctx context.Context, req *vtctldatapb.DistributedTransactionClearFailuresRequest) (*vtctldatapb.DistributedTransactionClearFailuresResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.DistributedTransactionClearFailures")
+	defer span.Finish()
+
+	dtm, err := s.distributedTransactionManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return dtm.DistributedTransactionClearFailures(ctx, req)
+}
+
+// DistributedTransactionConclude is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DistributedTransactionConclude(ctx context.Context, req *vtctldatapb.DistributedTransactionConcludeRequest) (*vtctldatapb.DistributedTransactionConcludeResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.DistributedTransactionConclude")
+	defer span.Finish()
+
+	if err := checkDtid(req.Dtid); err != nil {
+		return nil, err
+	}
+
+	span.Annotate("dtid", req.Dtid)
+
+	dtm, err := s.distributedTransactionManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return dtm.DistributedTransactionConclude(ctx, req)
+}
+
+// DistributedTransactionList is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DistributedTransactionList(ctx context.Context, req *vtctldatapb.DistributedTransactionListRequest) (*vtctldatapb.DistributedTransactionListResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.DistributedTransactionList")
+	defer span.Finish()
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	dtm, err := s.distributedTransactionManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return dtm.DistributedTransactionList(ctx, req)
+}
+
+// DistributedTransactionRead is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DistributedTransactionRead(ctx context.Context, req *vtctldatapb.DistributedTransactionReadRequest) (*vtctldatapb.DistributedTransactionReadResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.DistributedTransactionRead")
+	defer span.Finish()
+
+	if err := checkDtid(req.Dtid); err != nil {
+		return nil, err
+	}
+
+	span.Annotate("dtid", req.Dtid)
+
+	dtm, err := s.distributedTransactionManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return dtm.DistributedTransactionRead(ctx, req)
+}
+
+// DistributedTransactionRollback is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DistributedTransactionRollback(ctx context.Context, req *vtctldatapb.DistributedTransactionRollbackRequest) (*vtctldatapb.DistributedTransactionRollbackResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.DistributedTransactionRollback")
+	defer span.Finish()
+
+	if err := checkDtid(req.Dtid); err != nil {
+		return nil, err
+	}
+
+	span.Annotate("dtid", req.Dtid)
+
+	dtm, err := s.distributedTransactionManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return dtm.DistributedTransactionRollback(ctx, req)
+}
+
 // EmergencyReparentShard is part of the vtctldservicepb.VtctldServer interface.
 func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldatapb.EmergencyReparentShardRequest) (*vtctldatapb.EmergencyReparentShardResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.EmergencyReparentShard")
@@ -1524,6 +1671,97 @@ func (s *VtctldServer) InitShardPrimaryLocked(
 	return nil
 }
 
+// LookupVindexCreate is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) LookupVindexCreate(ctx context.Context, req *vtctldatapb.LookupVindexCreateRequest) (*vtctldatapb.LookupVindexCreateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.LookupVindexCreate")
+	defer span.Finish()
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	if req.Vindex == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "vindex field is required")
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("cells", strings.Join(req.Cells, ","))
+	span.Annotate("tablet_types", strings.Join(topoproto.MakeStringTypeList(req.TabletTypes), ","))
+	span.Annotate("continue_after_copy_with_owner", req.ContinueAfterCopyWithOwner)
+
+	lvm, err := s.lookupVindexManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return lvm.LookupVindexCreate(ctx, req)
+}
+
+// LookupVindexExternalize is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) LookupVindexExternalize(ctx context.Context, req *vtctldatapb.LookupVindexExternalizeRequest) (*vtctldatapb.LookupVindexExternalizeResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.LookupVindexExternalize")
+	defer span.Finish()
+
+	if err := checkLookupVindexName(req.Keyspace, req.Name); err != nil {
+		return nil, err
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("name", req.Name)
+
+	lvm, err := s.lookupVindexManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return lvm.LookupVindexExternalize(ctx, req)
+}
+
+// LookupVindexShow is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) LookupVindexShow(ctx context.Context, req *vtctldatapb.LookupVindexShowRequest) (*vtctldatapb.LookupVindexShowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.LookupVindexShow")
+	defer span.Finish()
+
+	if err := checkLookupVindexName(req.Keyspace, req.Name); err != nil {
+		return nil, err
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("name", req.Name)
+
+	lvm, err := s.lookupVindexManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return lvm.LookupVindexShow(ctx, req)
+}
+
+// LookupVindexVerify is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) LookupVindexVerify(ctx context.Context, req *vtctldatapb.LookupVindexVerifyRequest) (*vtctldatapb.LookupVindexVerifyResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.LookupVindexVerify")
+	defer span.Finish()
+
+	if err := checkLookupVindexName(req.Keyspace, req.Name); err != nil {
+		return nil, err
+	}
+
+	if req.Limit < 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "limit must not be negative")
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("name", req.Name)
+	span.Annotate("limit", req.Limit)
+
+	lvm, err := s.lookupVindexManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return lvm.LookupVindexVerify(ctx, req)
+}
+
 // PingTablet is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) PingTablet(ctx context.Context, req *vtctldatapb.PingTabletRequest) (*vtctldatapb.PingTabletResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.PingTablet")
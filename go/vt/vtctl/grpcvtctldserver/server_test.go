@@ -208,6 +208,100 @@ func TestAddCellsAlias(t *testing.T) {
 	}
 }
 
+func TestAddVindexRange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.AddVindexRangeRequest
+		expRanges string
+		// savedRanges are the ranges of the vindex in the topo after the call
+		savedRanges string
+		shouldErr   bool
+	}{
+		{
+			name: "split a range",
+			req: &vtctldatapb.AddVindexRangeRequest{
+				Keyspace: "testkeyspace",
+				Vindex:   "range",
+				Start:    "100",
+			},
+			expRanges:   "10=40,100=40,1000=80",
+			savedRanges: "10=40,100=40,1000=80",
+		},
+		{
+			name: "dry run",
+			req: &vtctldatapb.AddVindexRangeRequest{
+				Keyspace: "testkeyspace",
+				Vindex:   "range",
+				Start:    "100",
+				DryRun:   true,
+			},
+			expRanges:   "10=40,100=40,1000=80",
+			savedRanges: "10=40,1000=80",
+		},
+		{
+			name: "a range already starts at the value",
+			req: &vtctldatapb.AddVindexRangeRequest{
+				Keyspace: "testkeyspace",
+				Vindex:   "range",
+				Start:    "1000",
+			},
+			savedRanges: "10=40,1000=80",
+			shouldErr:   true,
+		},
+		{
+			name: "unknown vindex",
+			req: &vtctldatapb.AddVindexRangeRequest{
+				Keyspace: "testkeyspace",
+				Vindex:   "nonexistent",
+				Start:    "100",
+			},
+			savedRanges: "10=40,1000=80",
+			shouldErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+				Name:     "testkeyspace",
+				Keyspace: &topodatapb.Keyspace{},
+			})
+			err := ts.SaveVSchema(ctx, "testkeyspace", &vschemapb.Keyspace{
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"range": {
+						Type:   "numeric_range",
+						Params: map[string]string{"ranges": "10=40,1000=80"},
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.AddVindexRange(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expRanges, resp.Vindex.Params["ranges"])
+			}
+
+			vs, err := ts.GetVSchema(ctx, "testkeyspace")
+			require.NoError(t, err)
+			assert.Equal(t, tt.savedRanges, vs.Vindexes["range"].Params["ranges"])
+		})
+	}
+}
+
 func TestApplyRoutingRules(t *testing.T) {
 	t.Parallel()
 
@@ -2583,6 +2677,47 @@ func TestDeleteTablets(t *testing.T) {
 	}
 }
 
+// fakeDistributedTransactionManager reads a transaction whose dtid is the dtid
+// of the request, and that has no participants.
+type fakeDistributedTransactionManager struct {
+	DistributedTransactionManager
+}
+
+func (dtm *fakeDistributedTransactionManager) DistributedTransactionRead(ctx context.Context, req *vtctldatapb.DistributedTransactionReadRequest) (*vtctldatapb.DistributedTransactionReadResponse, error) {
+	return &vtctldatapb.DistributedTransactionReadResponse{
+		Transaction: &querypb.TransactionMetadata{Dtid: req.Dtid, State: querypb.TransactionState_PREPARE},
+	}, nil
+}
+
+func TestDistributedTransactionRead(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+	req := &vtctldatapb.DistributedTransactionReadRequest{Dtid: "ks:-80:1234"}
+
+	// The RPC is unimplemented until package wrangler registers its implementation.
+	_, err := vtctld.DistributedTransactionRead(ctx, req)
+	assert.EqualError(t, err, "no DistributedTransactionManager is registered")
+
+	defer func(factory DistributedTransactionManagerFactory) {
+		distributedTransactionManagerFactory = factory
+	}(distributedTransactionManagerFactory)
+	distributedTransactionManagerFactory = func(ts *topo.Server, tmc tmclient.TabletManagerClient) DistributedTransactionManager {
+		return &fakeDistributedTransactionManager{}
+	}
+
+	resp, err := vtctld.DistributedTransactionRead(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, &vtctldatapb.DistributedTransactionReadResponse{
+		Transaction: &querypb.TransactionMetadata{Dtid: "ks:-80:1234", State: querypb.TransactionState_PREPARE},
+	}, resp)
+
+	_, err = vtctld.DistributedTransactionRead(ctx, &vtctldatapb.DistributedTransactionReadRequest{})
+	assert.EqualError(t, err, "dtid field is required")
+}
+
 func TestEmergencyReparentShard(t *testing.T) {
 	t.Parallel()
 
@@ -4595,6 +4730,43 @@ func TestGetVSchema(t *testing.T) {
 	})
 }
 
+// fakeLookupVindexManager returns the keyspace and the name of the vindex of
+// the requests of LookupVindexShow as its table and workflow.
+type fakeLookupVindexManager struct {
+	LookupVindexManager
+}
+
+func (lvm *fakeLookupVindexManager) LookupVindexShow(ctx context.Context, req *vtctldatapb.LookupVindexShowRequest) (*vtctldatapb.LookupVindexShowResponse, error) {
+	return &vtctldatapb.LookupVindexShowResponse{Table: req.Keyspace, Workflow: req.Name}, nil
+}
+
+func TestLookupVindexShow(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+	req := &vtctldatapb.LookupVindexShowRequest{Keyspace: "testkeyspace", Name: "lookup"}
+
+	// The RPC is unimplemented until package wrangler registers its implementation.
+	_, err := vtctld.LookupVindexShow(ctx, req)
+	assert.EqualError(t, err, "no LookupVindexManager is registered")
+
+	defer func(factory LookupVindexManagerFactory) {
+		lookupVindexManagerFactory = factory
+	}(lookupVindexManagerFactory)
+	lookupVindexManagerFactory = func(ts *topo.Server, tmc tmclient.TabletManagerClient) LookupVindexManager {
+		return &fakeLookupVindexManager{}
+	}
+
+	resp, err := vtctld.LookupVindexShow(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, &vtctldatapb.LookupVindexShowResponse{Table: "testkeyspace", Workflow: "lookup"}, resp)
+
+	_, err = vtctld.LookupVindexShow(ctx, &vtctldatapb.LookupVindexShowRequest{Keyspace: "testkeyspace"})
+	assert.EqualError(t, err, "name field is required")
+}
+
 func TestPingTablet(t *testing.T) {
 	t.Parallel()
 
@@ -36,6 +36,11 @@ func (client *localVtctldClient) AddCellsAlias(ctx context.Context, in *vtctldat
 	return client.s.AddCellsAlias(ctx, in)
 }
 
+// AddVindexRange is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) AddVindexRange(ctx context.Context, in *vtctldatapb.AddVindexRangeRequest, opts ...grpc.CallOption) (*vtctldatapb.AddVindexRangeResponse, error) {
+	return client.s.AddVindexRange(ctx, in)
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldatapb.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	return client.s.ApplyRoutingRules(ctx, in)
@@ -91,6 +96,31 @@ func (client *localVtctldClient) DeleteTablets(ctx context.Context, in *vtctldat
 	return client.s.DeleteTablets(ctx, in)
 }
 
+// DistributedTransactionClearFailures is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) DistributedTransactionClearFailures(ctx context.Context, in *vtctldatapb.DistributedTransactionClearFailuresRequest, opts ...grpc.CallOption) (*vtctldatapb.DistributedTransactionClearFailuresResponse, error) {
+	return client.s.DistributedTransactionClearFailures(ctx, in)
+}
+
+// DistributedTransactionConclude is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) DistributedTransactionConclude(ctx context.Context, in *vtctldatapb.DistributedTransactionConcludeRequest, opts ...grpc.CallOption) (*vtctldatapb.DistributedTransactionConcludeResponse, error) {
+	return client.s.DistributedTransactionConclude(ctx, in)
+}
+
+// DistributedTransactionList is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) DistributedTransactionList(ctx context.Context, in *vtctldatapb.DistributedTransactionListRequest, opts ...grpc.CallOption) (*vtctldatapb.DistributedTransactionListResponse, error) {
+	return client.s.DistributedTransactionList(ctx, in)
+}
+
+// DistributedTransactionRead is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) DistributedTransactionRead(ctx context.Context, in *vtctldatapb.DistributedTransactionReadRequest, opts ...grpc.CallOption) (*vtctldatapb.DistributedTransactionReadResponse, error) {
+	return client.s.DistributedTransactionRead(ctx, in)
+}
+
+// DistributedTransactionRollback is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) DistributedTransactionRollback(ctx context.Context, in *vtctldatapb.DistributedTransactionRollbackRequest, opts ...grpc.CallOption) (*vtctldatapb.DistributedTransactionRollbackResponse, error) {
+	return client.s.DistributedTransactionRollback(ctx, in)
+}
+
 // EmergencyReparentShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldatapb.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldatapb.EmergencyReparentShardResponse, error) {
 	return client.s.EmergencyReparentShard(ctx, in)
@@ -191,6 +221,26 @@ func (client *localVtctldClient) InitShardPrimary(ctx context.Context, in *vtctl
 	return client.s.InitShardPrimary(ctx, in)
 }
 
+// LookupVindexCreate is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) LookupVindexCreate(ctx context.Context, in *vtctldatapb.LookupVindexCreateRequest, opts ...grpc.CallOption) (*vtctldatapb.LookupVindexCreateResponse, error) {
+	return client.s.LookupVindexCreate(ctx, in)
+}
+
+// LookupVindexExternalize is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) LookupVindexExternalize(ctx context.Context, in *vtctldatapb.LookupVindexExternalizeRequest, opts ...grpc.CallOption) (*vtctldatapb.LookupVindexExternalizeResponse, error) {
+	return client.s.LookupVindexExternalize(ctx, in)
+}
+
+// LookupVindexShow is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) LookupVindexShow(ctx context.Context, in *vtctldatapb.LookupVindexShowRequest, opts ...grpc.CallOption) (*vtctldatapb.LookupVindexShowResponse, error) {
+	return client.s.LookupVindexShow(ctx, in)
+}
+
+// LookupVindexVerify is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) LookupVindexVerify(ctx context.Context, in *vtctldatapb.LookupVindexVerifyRequest, opts ...grpc.CallOption) (*vtctldatapb.LookupVindexVerifyResponse, error) {
+	return client.s.LookupVindexVerify(ctx, in)
+}
+
 // PingTablet is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) PingTablet(ctx context.Context, in *vtctldatapb.PingTabletRequest, opts ...grpc.CallOption) (*vtctldatapb.PingTabletResponse, error) {
 	return client.s.PingTablet(ctx, in)
@@ -325,6 +325,9 @@ var commands = []commandGroup{
 			{"ExternalizeVindex", commandExternalizeVindex,
 				"<keyspace>.<vindex>",
 				`Externalize a backfilled vindex.`},
+			{"LookupVindex", commandLookupVindex,
+				"[-cells=<source_cells>] [-tablet_types=<tablet_types>] [-continue_after_copy_with_owner] [-source_cell=<cell>] [-target_cell=<cell>] [-filtered_replication_wait_time=30s] [-limit=<max_rows>] [-debug_query] [-only_pks] [-format=json] <action> 'action must be one of the following: Create, Show, Verify, Externalize' <keyspace> <json_spec> | <keyspace>.<vindex>",
+				`Manage the workflow backfilling a lookup vindex. Create creates the vindex and backfills it like CreateLookupVindex. Show reports the rows copied and the lag of the backfill. Verify compares the lookup table with its owner table like VDiff. Externalize verifies the lookup table, and externalizes the vindex if it matches its owner table.`},
 			{"Materialize", commandMaterialize,
 				`[-cells=<cells>] [-tablet_types=<source_tablet_types>] <json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
 				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL."},
@@ -2344,6 +2347,73 @@ func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFla
 	return wr.ExternalizeVindex(ctx, subFlags.Arg(0))
 }
 
+func commandLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cells := subFlags.String("cells", "", "Create: source cells to replicate from.")
+	tabletTypes := subFlags.String("tablet_types", "", "Create: source tablet types to replicate from. Verify, Externalize: tablet types for source and target, primary,replica,rdonly by default.")
+	continueAfterCopyWithOwner := subFlags.Bool("continue_after_copy_with_owner", false, "Create: vindex will continue materialization after copy when an owner is provided")
+	sourceCell := subFlags.String("source_cell", "", "Verify, Externalize: the source cell to compare from; default is any available cell")
+	targetCell := subFlags.String("target_cell", "", "Verify, Externalize: the target cell to compare with; default is any available cell")
+	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 30*time.Second, "Verify, Externalize: specifies the maximum time to wait, in seconds, for filtered replication to catch up before the comparison.")
+	maxRows := subFlags.Int64("limit", math.MaxInt64, "Verify: max rows to stop comparing after")
+	debugQuery := subFlags.Bool("debug_query", false, "Verify: adds a mysql query to the report that can be used for further debugging")
+	onlyPks := subFlags.Bool("only_pks", false, "Verify: when reporting missing rows, only show primary keys in the report.")
+	format := subFlags.String("format", "", "Show, Verify: format of the report") //"json" or ""
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 2 {
+		return fmt.Errorf("usage: LookupVindex Create <keyspace> <json_spec> | LookupVindex Show/Verify/Externalize <keyspace>.<vindex>")
+	}
+	action := strings.ToLower(subFlags.Arg(0))
+	if action == "create" {
+		if subFlags.NArg() != 3 {
+			return fmt.Errorf("two arguments are required for Create: keyspace and json_spec")
+		}
+		specs := &vschemapb.Keyspace{}
+		if err := json2.Unmarshal([]byte(subFlags.Arg(2)), specs); err != nil {
+			return err
+		}
+		return wr.CreateLookupVindex(ctx, subFlags.Arg(1), specs, *cells, *tabletTypes, *continueAfterCopyWithOwner)
+	}
+
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("one argument is required for %s: keyspace.vindex", subFlags.Arg(0))
+	}
+	vindex := subFlags.Arg(1)
+	if *tabletTypes == "" {
+		*tabletTypes = "primary,replica,rdonly"
+	}
+	switch action {
+	case "show":
+		status, err := wr.LookupVindexShow(ctx, vindex)
+		if err != nil {
+			return err
+		}
+		if *format == "json" {
+			return printJSON(wr.Logger(), status)
+		}
+		wr.Logger().Printf("Lookup vindex %s, table %s, workflow %s\n", status.Vindex, status.Table, status.Workflow)
+		wr.Logger().Printf("\tWriteOnly: %v\n", status.WriteOnly)
+		wr.Logger().Printf("\tRowsCopied: %v\n", status.RowsCopied)
+		wr.Logger().Printf("\tMaxVReplicationLag: %v\n", status.MaxVReplicationLag)
+		for shard, shardStatus := range status.Streams.ShardStatuses {
+			for _, stream := range shardStatus.PrimaryReplicationStatuses {
+				wr.Logger().Printf("\tStream %s/%d: State: %s, RowsCopied: %d, Message: %s\n", shard, stream.ID, stream.State, stream.RowsCopied, stream.Message)
+			}
+		}
+		return nil
+	case "verify":
+		if *maxRows <= 0 {
+			return fmt.Errorf("maximum number of rows to compare needs to be greater than 0")
+		}
+		_, err := wr.LookupVindexVerify(ctx, vindex, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime, *format, *maxRows, *debugQuery, *onlyPks)
+		return err
+	case "externalize":
+		return wr.LookupVindexExternalize(ctx, vindex, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime)
+	}
+	return fmt.Errorf("action %s not supported for LookupVindex, must be one of Create, Show, Verify, Externalize", subFlags.Arg(0))
+}
+
 func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	cells := subFlags.String("cells", "", "Source cells to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var (
+	twoPCResolverInterval   = flag.Duration("twopc_resolver_interval", 0, "interval at which vtctld looks for the distributed transactions abandoned by their vtgate, and concludes them. The resolver is disabled if 0.")
+	twoPCResolverAbandonAge = flag.Duration("twopc_resolver_abandon_age", 5*time.Minute, "age after which the resolver considers a distributed transaction abandoned by its vtgate.")
+)
+
+// initTwoPCResolver starts the resolver of abandoned distributed transactions.
+// Unlike the watchdog of the tablets, it concludes the transactions itself
+// instead of asking the vtgate configured as the coordinator of the tablet
+// that stores their metadata. Every vtctld runs the resolver, but the keyspaces
+// are locked while their transactions are resolved, so that a single vtctld
+// concludes them at a time.
+func initTwoPCResolver(ts *topo.Server) {
+	if *twoPCResolverInterval <= 0 {
+		return
+	}
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	ticks := timer.NewTimer(*twoPCResolverInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ticks.Start(func() { resolveAbandonedTransactions(ctx, wr) })
+	servenv.OnTermSync(func() {
+		cancel()
+		ticks.Stop()
+	})
+}
+
+// resolveAbandonedTransactions concludes the abandoned distributed transactions of all the keyspaces.
+func resolveAbandonedTransactions(ctx context.Context, wr *wrangler.Wrangler) {
+	ctx, cancel := context.WithTimeout(ctx, *twoPCResolverInterval)
+	defer cancel()
+
+	keyspaces, err := wr.TopoServer().GetKeyspaces(ctx)
+	if err != nil {
+		log.Errorf("TwoPC resolver: cannot list keyspaces: %v", err)
+		return
+	}
+	for _, keyspace := range keyspaces {
+		if err := resolveKeyspaceAbandonedTransactions(ctx, wr, keyspace); err != nil {
+			log.Errorf("TwoPC resolver: keyspace %s: %v", keyspace, err)
+		}
+	}
+}
+
+// resolveKeyspaceAbandonedTransactions concludes the abandoned distributed transactions
+// of a keyspace while holding its lock.
+func resolveKeyspaceAbandonedTransactions(ctx context.Context, wr *wrangler.Wrangler, keyspace string) (err error) {
+	ctx, unlock, lockErr := wr.TopoServer().LockKeyspace(ctx, keyspace, "ResolveAbandonedDistributedTransactions")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	return wr.ResolveAbandonedDistributedTransactions(ctx, keyspace, *twoPCResolverAbandonAge)
+}
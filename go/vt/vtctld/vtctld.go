@@ -183,6 +183,9 @@ func InitVtctld(ts *topo.Server) {
 	// Init online DDL schema manager
 	initSchemaManager(ts)
 
+	// Init the resolver of abandoned distributed transactions
+	initTwoPCResolver(ts)
+
 	// Setup reverse proxy for all vttablets through /vttablet/.
 	initVTTabletRedirection(ts)
 }
@@ -58,6 +58,7 @@ const (
 	UnknownSystemVariable
 	UnknownTable
 	NoSuchSession
+	NoSuchThread
 
 	// already exists
 	DbCreateExists
@@ -74,6 +75,7 @@ const (
 
 	// permission denied
 	AccessDeniedError
+	KillDeniedError
 
 	// server not available
 	ServerNotAvailable
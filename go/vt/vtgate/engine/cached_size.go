@@ -149,6 +149,42 @@ func (cached *DML) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.OwnedVindexQuery)))
 	return size
 }
+
+//go:nocheckptr
+func (cached *DMLWithInput) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field DML vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.DML.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field ListVar string
+	size += hack.RuntimeAllocSize(int64(len(cached.ListVar)))
+	// field Vars map[string]int
+	if cached.Vars != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.Vars)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += hack.RuntimeAllocSize(int64(numOldBuckets * 208))
+		if len(cached.Vars) > 0 || numBuckets > 1 {
+			size += hack.RuntimeAllocSize(int64(numBuckets * 208))
+		}
+		for k := range cached.Vars {
+			size += hack.RuntimeAllocSize(int64(len(k)))
+		}
+	}
+	return size
+}
 func (cached *Delete) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -167,12 +203,34 @@ func (cached *Distinct) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(40)
 	}
 	// field Source vitess.io/vitess/go/vt/vtgate/engine.Primitive
 	if cc, ok := cached.Source.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
+	// field ColCollations []vitess.io/vitess/go/mysql/collations.ID
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ColCollations)) * int64(2))
+	}
+	return size
+}
+func (cached *Filter) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Predicate vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Predicate.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
 	return size
 }
 func (cached *Gen4CompareV3) CachedSize(alloc bool) int64 {
@@ -199,7 +257,7 @@ func (cached *Generate) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(120)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -223,13 +281,47 @@ func (cached *GroupByParams) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *HashJoin) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(136)
+	}
+	// field Left vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Right.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Cols []int
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Cols)) * int64(8))
+	}
+	// field LHSKeys []int
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.LHSKeys)) * int64(8))
+	}
+	// field RHSKeys []int
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.RHSKeys)) * int64(8))
+	}
+	// field Collations []vitess.io/vitess/go/mysql/collations.ID
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Collations)) * int64(2))
+	}
+	return size
+}
 func (cached *Insert) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(184)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -257,6 +349,19 @@ func (cached *Insert) CachedSize(alloc bool) int64 {
 	}
 	// field Suffix string
 	size += hack.RuntimeAllocSize(int64(len(cached.Suffix)))
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field VindexValueOffset [][]int
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.VindexValueOffset)) * int64(24))
+		for _, elem := range cached.VindexValueOffset {
+			{
+				size += hack.RuntimeAllocSize(int64(cap(elem)) * int64(8))
+			}
+		}
+	}
 	return size
 }
 
@@ -363,7 +468,7 @@ func (cached *MemorySort) CachedSize(alloc bool) int64 {
 	size += cached.UpperLimit.CachedSize(false)
 	// field OrderBy []vitess.io/vitess/go/vt/vtgate/engine.OrderByParams
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(33))
+		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(36))
 	}
 	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
 	if cc, ok := cached.Input.(cachedObject); ok {
@@ -390,7 +495,7 @@ func (cached *MergeSort) CachedSize(alloc bool) int64 {
 	}
 	// field OrderBy []vitess.io/vitess/go/vt/vtgate/engine.OrderByParams
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(33))
+		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(36))
 	}
 	return size
 }
@@ -452,7 +557,7 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(128)
+		size += int64(160)
 	}
 	// field Original string
 	size += hack.RuntimeAllocSize(int64(len(cached.Original)))
@@ -469,6 +574,25 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field ResultCacheTables []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ResultCacheTables)) * int64(16))
+		for _, elem := range cached.ResultCacheTables {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
+	return size
+}
+func (cached *PlanCacheTable) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Table string
+	size += hack.RuntimeAllocSize(int64(len(cached.Table)))
 	return size
 }
 func (cached *Projection) CachedSize(alloc bool) int64 {
@@ -590,7 +714,7 @@ func (cached *Route) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(208)
+		size += int64(224)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -617,7 +741,7 @@ func (cached *Route) CachedSize(alloc bool) int64 {
 	}
 	// field OrderBy []vitess.io/vitess/go/vt/vtgate/engine.OrderByParams
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(33))
+		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(36))
 	}
 	// field SysTableTableSchema []vitess.io/vitess/go/vt/vtgate/evalengine.Expr
 	{
@@ -647,6 +771,38 @@ func (cached *Route) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+
+//go:nocheckptr
+func (cached *RowMove) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	// field SelectQuery string
+	size += hack.RuntimeAllocSize(int64(len(cached.SelectQuery)))
+	// field DeleteQuery string
+	size += hack.RuntimeAllocSize(int64(len(cached.DeleteQuery)))
+	// field Values map[string]vitess.io/vitess/go/sqltypes.PlanValue
+	if cached.Values != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.Values)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += hack.RuntimeAllocSize(int64(numOldBuckets * 848))
+		if len(cached.Values) > 0 || numBuckets > 1 {
+			size += hack.RuntimeAllocSize(int64(numBuckets * 848))
+		}
+		for k, v := range cached.Values {
+			size += hack.RuntimeAllocSize(int64(len(k)))
+			size += v.CachedSize(false)
+		}
+	}
+	return size
+}
+
 func (cached *Rows) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -694,6 +850,58 @@ func (cached *SQLCalcFoundRows) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+
+//go:nocheckptr
+func (cached *SemiJoin) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Left vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Right.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Cols []int
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Cols)) * int64(8))
+	}
+	// field Vars map[string]int
+	if cached.Vars != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.Vars)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += hack.RuntimeAllocSize(int64(numOldBuckets * 208))
+		if len(cached.Vars) > 0 || numBuckets > 1 {
+			size += hack.RuntimeAllocSize(int64(numBuckets * 208))
+		}
+		for k := range cached.Vars {
+			size += hack.RuntimeAllocSize(int64(len(k)))
+		}
+	}
+	// field ListVars map[string]int
+	if cached.ListVars != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.ListVars)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += hack.RuntimeAllocSize(int64(numOldBuckets * 208))
+		if len(cached.ListVars) > 0 || numBuckets > 1 {
+			size += hack.RuntimeAllocSize(int64(numBuckets * 208))
+		}
+		for k := range cached.ListVars {
+			size += hack.RuntimeAllocSize(int64(len(k)))
+		}
+	}
+	return size
+}
 func (cached *Send) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -843,7 +1051,7 @@ func (cached *Update) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(160)
+		size += int64(168)
 	}
 	// field DML vitess.io/vitess/go/vt/vtgate/engine.DML
 	size += cached.DML.CachedSize(false)
@@ -862,6 +1070,8 @@ func (cached *Update) CachedSize(alloc bool) int64 {
 			size += v.CachedSize(true)
 		}
 	}
+	// field RowMove *vitess.io/vitess/go/vt/vtgate/engine.RowMove
+	size += cached.RowMove.CachedSize(true)
 	return size
 }
 func (cached *UpdateTarget) CachedSize(alloc bool) int64 {
@@ -967,6 +1177,61 @@ func (cached *VindexValues) CachedSize(alloc bool) int64 {
 	return size
 }
 
+//go:nocheckptr
+func (cached *Window) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field PartitionBy []*vitess.io/vitess/go/vt/vtgate/engine.GroupByParams
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.PartitionBy)) * int64(8))
+		for _, elem := range cached.PartitionBy {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field OrderBy []vitess.io/vitess/go/vt/vtgate/engine.OrderByParams
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(36))
+	}
+	// field Functions []*vitess.io/vitess/go/vt/vtgate/engine.WindowFunctionParams
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Functions)) * int64(8))
+		for _, elem := range cached.Functions {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *WindowFunctionParams) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Args []vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Args)) * int64(16))
+		for _, elem := range cached.Args {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field Alias string
+	size += hack.RuntimeAllocSize(int64(len(cached.Alias)))
+	return size
+}
+
 //go:nocheckptr
 func (cached *shardRoute) CachedSize(alloc bool) int64 {
 	if cached == nil {
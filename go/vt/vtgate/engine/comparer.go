@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
@@ -25,6 +26,7 @@ import (
 type comparer struct {
 	orderBy, weightString, starColFixedIndex int
 	desc                                     bool
+	collation                                collations.ID
 }
 
 // compare compares two rows given the comparer and returns which one should be earlier in the result set
@@ -38,7 +40,7 @@ func (c *comparer) compare(r1, r2 []sqltypes.Value) (int, error) {
 	} else {
 		colIndex = c.orderBy
 	}
-	cmp, err := evalengine.NullsafeCompare(r1[colIndex], r2[colIndex])
+	cmp, err := evalengine.NullsafeCompareCollated(r1[colIndex], r2[colIndex], c.collation)
 	if err != nil {
 		_, isComparisonErr := err.(evalengine.UnsupportedComparisonError)
 		if !(isComparisonErr && c.weightString != -1) {
@@ -68,6 +70,7 @@ func extractSlices(input []OrderByParams) []*comparer {
 			weightString:      order.WeightStringCol,
 			desc:              order.Desc,
 			starColFixedIndex: order.StarColFixedIndex,
+			collation:         order.CollationID,
 		})
 	}
 	return result
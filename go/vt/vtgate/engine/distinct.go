@@ -17,6 +17,9 @@ limitations under the License.
 package engine
 
 import (
+	"fmt"
+
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
@@ -28,36 +31,67 @@ var _ Primitive = (*Distinct)(nil)
 // Distinct Primitive is used to uniqueify results
 type Distinct struct {
 	Source Primitive
+	// ColCollations are the collations used to compare the text columns of the rows.
+	// A column that is missing or set to collations.Unknown is compared without collation.
+	ColCollations []collations.ID
 }
 
 type row = []sqltypes.Value
 
 type probeTable struct {
-	m map[int64][]row
+	m          map[int64][]row
+	collations []collations.ID
+	rows       int
+}
+
+func (pt *probeTable) collation(col int) collations.ID {
+	if col < len(pt.collations) {
+		return pt.collations[col]
+	}
+	return collations.Unknown
 }
 
 func (pt *probeTable) exists(inputRow row) (bool, error) {
+	code, err := pt.hashcode(inputRow)
+	if err != nil {
+		return false, err
+	}
+	exists, err := pt.find(code, inputRow)
+	if err != nil || exists {
+		return exists, err
+	}
+	pt.m[code] = append(pt.m[code], inputRow)
+	pt.rows++
+	return false, nil
+}
+
+// contains is like exists, but doesn't add the row to the probe table.
+func (pt *probeTable) contains(inputRow row) (bool, error) {
+	code, err := pt.hashcode(inputRow)
+	if err != nil {
+		return false, err
+	}
+	return pt.find(code, inputRow)
+}
+
+func (pt *probeTable) hashcode(inputRow row) (int64, error) {
 	// calculate hashcode from all column values in the input row
 	code := int64(17)
-	for _, value := range inputRow {
-		hashcode, err := evalengine.NullsafeHashcode(value)
+	for i, value := range inputRow {
+		hashcode, err := evalengine.NullsafeHashcodeCollated(value, pt.collation(i))
 		if err != nil {
-			return false, err
+			return 0, err
 		}
 		code = code*31 + hashcode
 	}
+	return code, nil
+}
 
-	existingRows, found := pt.m[code]
-	if !found {
-		// nothing with this hash code found, we can be sure it's a not seen row
-		pt.m[code] = []row{inputRow}
-		return false, nil
-	}
-
+func (pt *probeTable) find(code int64, inputRow row) (bool, error) {
 	// we found something in the map - still need to check all individual values
 	// so we don't just fall for a hash collision
-	for _, existingRow := range existingRows {
-		exists, err := equal(existingRow, inputRow)
+	for _, existingRow := range pt.m[code] {
+		exists, err := pt.equal(existingRow, inputRow)
 		if err != nil {
 			return false, err
 		}
@@ -65,15 +99,12 @@ func (pt *probeTable) exists(inputRow row) (bool, error) {
 			return true, nil
 		}
 	}
-
-	pt.m[code] = append(existingRows, inputRow)
-
 	return false, nil
 }
 
-func equal(a, b []sqltypes.Value) (bool, error) {
+func (pt *probeTable) equal(a, b []sqltypes.Value) (bool, error) {
 	for i, aVal := range a {
-		cmp, err := evalengine.NullsafeCompare(aVal, b[i])
+		cmp, err := evalengine.NullsafeCompareCollated(aVal, b[i], pt.collation(i))
 		if err != nil {
 			return false, err
 		}
@@ -84,12 +115,18 @@ func equal(a, b []sqltypes.Value) (bool, error) {
 	return true, nil
 }
 
-func newProbeTable() *probeTable {
-	return &probeTable{m: map[int64][]row{}}
+func newProbeTable(colCollations []collations.ID) *probeTable {
+	return &probeTable{m: map[int64][]row{}, collations: colCollations}
 }
 
 // TryExecute implements the Primitive interface
 func (d *Distinct) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	if streamsInput(vcursor) {
+		// the streaming implementation spills the rows that exceed MaxMemoryRows to disk
+		return collectStream(func(callback func(*sqltypes.Result) error) error {
+			return d.TryStreamExecute(vcursor, bindVars, wantfields, callback)
+		})
+	}
 	input, err := vcursor.ExecutePrimitive(d.Source, bindVars, wantfields)
 	if err != nil {
 		return nil, err
@@ -100,7 +137,7 @@ func (d *Distinct) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 		InsertID: input.InsertID,
 	}
 
-	pt := newProbeTable()
+	pt := newProbeTable(d.ColCollations)
 
 	for _, row := range input.Rows {
 		exists, err := pt.exists(row)
@@ -116,8 +153,13 @@ func (d *Distinct) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 }
 
 // TryStreamExecute implements the Primitive interface
+// When spilling to disk is enabled, the probe table holds at most MaxMemoryRows rows. The rows it
+// doesn't contain once it is full are written to disk, and deduplicated after all the rows are read.
 func (d *Distinct) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
-	pt := newProbeTable()
+	pt := newProbeTable(d.ColCollations)
+	var spiller *rowSpiller
+	var pending [][]sqltypes.Value
+	defer func() { spiller.close() }()
 
 	err := vcursor.StreamExecutePrimitive(d.Source, bindVars, wantfields, func(input *sqltypes.Result) error {
 		result := &sqltypes.Result{
@@ -125,18 +167,99 @@ func (d *Distinct) TryStreamExecute(vcursor VCursor, bindVars map[string]*queryp
 			InsertID: input.InsertID,
 		}
 		for _, row := range input.Rows {
-			exists, err := pt.exists(row)
+			if spiller == nil {
+				exists, err := pt.exists(row)
+				if err != nil {
+					return err
+				}
+				if !exists {
+					result.Rows = append(result.Rows, row)
+				}
+				if vcursor.SpillDir() != "" && vcursor.ExceedsMaxMemoryRows(pt.rows) {
+					spiller, err = newRowSpiller(vcursor, d.comparers(len(row)))
+					if err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			exists, err := pt.contains(row)
 			if err != nil {
 				return err
 			}
-			if !exists {
-				result.Rows = append(result.Rows, row)
+			if exists {
+				continue
+			}
+			pending = append(pending, row)
+			if vcursor.ExceedsMaxMemoryRows(len(pending)) {
+				if err := spiller.spill(pending); err != nil {
+					return err
+				}
+				pending = nil
 			}
 		}
 		return callback(result)
 	})
+	if err != nil || spiller == nil {
+		return err
+	}
+	return d.streamSpilledRows(vcursor, spiller, pt, pending, callback)
+}
+
+// streamSpilledRows merges the rows written to disk with the pending rows still in memory,
+// and sends the distinct ones in chunks of at most MaxMemoryRows rows.
+func (d *Distinct) streamSpilledRows(vcursor VCursor, spiller *rowSpiller, pt *probeTable, pending [][]sqltypes.Value, callback func(*sqltypes.Result) error) error {
+	merger, err := spiller.merge(pending)
+	if err != nil {
+		return err
+	}
+	result := &sqltypes.Result{}
+	var previous []sqltypes.Value
+	for {
+		row, err := merger.next()
+		if err != nil {
+			return err
+		}
+		if row == nil {
+			break
+		}
+		// the rows are sorted, so the duplicates follow each other
+		if previous != nil {
+			equal, err := pt.equal(previous, row)
+			if err != nil {
+				return err
+			}
+			if equal {
+				continue
+			}
+		}
+		previous = row
+		result.Rows = append(result.Rows, row)
+		if vcursor.ExceedsMaxMemoryRows(len(result.Rows) + 1) {
+			if err := callback(result); err != nil {
+				return err
+			}
+			result = &sqltypes.Result{}
+		}
+	}
+	if len(result.Rows) == 0 {
+		return nil
+	}
+	return callback(result)
+}
 
-	return err
+// comparers returns the comparers used to sort the rows on all their columns.
+func (d *Distinct) comparers(columns int) []*comparer {
+	result := make([]*comparer, 0, columns)
+	for i := 0; i < columns; i++ {
+		collation := collations.Unknown
+		if i < len(d.ColCollations) {
+			collation = d.ColCollations[i]
+		}
+		result = append(result, &comparer{orderBy: i, weightString: -1, collation: collation})
+	}
+	return result
 }
 
 // RouteType implements the Primitive interface
@@ -170,7 +293,18 @@ func (d *Distinct) Inputs() []Primitive {
 }
 
 func (d *Distinct) description() PrimitiveDescription {
+	var other map[string]interface{}
+	var colls []string
+	for i, id := range d.ColCollations {
+		if id != collations.Unknown {
+			colls = append(colls, fmt.Sprintf("%d: %s", i, id.Name()))
+		}
+	}
+	if colls != nil {
+		other = map[string]interface{}{"Collations": colls}
+	}
 	return PrimitiveDescription{
 		OperatorType: "Distinct",
+		Other:        other,
 	}
 }
@@ -19,12 +19,14 @@ package engine
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 
 	"vitess.io/vitess/go/test/utils"
 
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 )
 
@@ -32,6 +34,7 @@ func TestDistinct(t *testing.T) {
 	type testCase struct {
 		testName       string
 		inputs         *sqltypes.Result
+		collations     []collations.ID
 		expectedResult *sqltypes.Result
 		expectedError  string
 	}
@@ -60,11 +63,24 @@ func TestDistinct(t *testing.T) {
 		testName:      "varchar columns",
 		inputs:        r("myid", "varchar", "monkey", "horse"),
 		expectedError: "types does not support hashcode yet: VARCHAR",
+	}, {
+		testName:       "varchar columns with a case insensitive collation",
+		inputs:         r("myid", "varchar", "monkey", "Monkey", "horse", "MONKEY  ", "hörse", "null"),
+		collations:     []collations.ID{collations.Utf8mb4GeneralCi},
+		expectedResult: r("myid", "varchar", "monkey", "horse", "null"),
+	}, {
+		testName:       "varchar columns with a binary collation",
+		inputs:         r("a|b", "varchar|int64", "monkey|1", "Monkey|1", "monkey  |1", "monkey|2"),
+		collations:     []collations.ID{collations.Utf8mb4Bin},
+		expectedResult: r("a|b", "varchar|int64", "monkey|1", "Monkey|1", "monkey|2"),
 	}}
 
 	for _, tc := range testCases {
 		t.Run(tc.testName+"-Execute", func(t *testing.T) {
-			distinct := &Distinct{Source: &fakePrimitive{results: []*sqltypes.Result{tc.inputs}}}
+			distinct := &Distinct{
+				Source:        &fakePrimitive{results: []*sqltypes.Result{tc.inputs}},
+				ColCollations: tc.collations,
+			}
 
 			qr, err := distinct.TryExecute(&noopVCursor{ctx: context.Background()}, nil, true)
 			if tc.expectedError == "" {
@@ -77,7 +93,10 @@ func TestDistinct(t *testing.T) {
 			}
 		})
 		t.Run(tc.testName+"-StreamExecute", func(t *testing.T) {
-			distinct := &Distinct{Source: &fakePrimitive{results: []*sqltypes.Result{tc.inputs}}}
+			distinct := &Distinct{
+				Source:        &fakePrimitive{results: []*sqltypes.Result{tc.inputs}},
+				ColCollations: tc.collations,
+			}
 
 			result, err := wrapStreamExecute(distinct, &noopVCursor{ctx: context.Background()}, nil, true)
 
@@ -92,3 +111,37 @@ func TestDistinct(t *testing.T) {
 		})
 	}
 }
+
+func TestDistinctSpill(t *testing.T) {
+	saveMax := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() {
+		testMaxMemoryRows = saveMax
+	}()
+
+	spillDir := t.TempDir()
+	fp := &fakePrimitive{results: []*sqltypes.Result{
+		r("myid", "int64", "5", "3", "5", "1", "4", "1", "2", "3", "null", "4"),
+	}}
+	distinct := &Distinct{Source: fp}
+
+	vc := &noopVCursor{ctx: context.Background(), spillDir: spillDir}
+	result, err := wrapStreamExecute(distinct, vc, nil, true)
+	require.NoError(t, err)
+	// the rows found once the probe table is full are returned sorted, after the other ones
+	expected := r("myid", "int64", "5", "3", "1", "null", "2", "4")
+	utils.MustMatch(t, fmt.Sprintf("%v", expected.Rows), fmt.Sprintf("%v", result.Rows), "result not what correct")
+	require.NotZero(t, vc.spilledBytes)
+
+	// TryExecute streams the input to spill it as well
+	fp.rewind()
+	vc = &noopVCursor{ctx: context.Background(), spillDir: spillDir}
+	result, err = distinct.TryExecute(vc, nil, true)
+	require.NoError(t, err)
+	utils.MustMatch(t, fmt.Sprintf("%v", expected.Rows), fmt.Sprintf("%v", result.Rows), "result not what correct")
+	require.NotZero(t, vc.spilledBytes)
+
+	files, err := os.ReadDir(spillDir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*DMLWithInput)(nil)
+
+// DMLWithInput executes an UPDATE or DELETE for the rows returned by its input.
+// It is used when the WHERE clause of the DML contains subqueries that can't be
+// evaluated by the shards of the target table. The input evaluates the WHERE clause
+// and returns, for every matching row, the value of the column of the primary vindex
+// followed by the columns of the target table the subqueries depend on.
+// The DML is executed once for every distinct set of values of these columns, with
+// the primary vindex values of the matching rows in the ListVar bind variable.
+type DMLWithInput struct {
+	txNeeded
+
+	Input Primitive
+	DML   Primitive
+
+	// ListVar is the list bind variable used by the DML
+	// to select the rows by their primary vindex column.
+	ListVar string
+
+	// Vars defines the bind variables of the columns the subqueries
+	// depend on, and their offset in the rows returned by Input.
+	Vars map[string]int `json:",omitempty"`
+}
+
+// dmlGroup is the set of rows of the input sharing the same values for the Vars.
+type dmlGroup struct {
+	vars   map[string]*querypb.BindVariable
+	values []*querypb.Value
+	seen   map[string]bool
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (dml *DMLWithInput) RouteType() string {
+	return dml.DML.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (dml *DMLWithInput) GetKeyspaceName() string {
+	return dml.DML.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (dml *DMLWithInput) GetTableName() string {
+	return dml.DML.GetTableName()
+}
+
+// Inputs returns the input primitives for this primitive
+func (dml *DMLWithInput) Inputs() []Primitive {
+	return []Primitive{dml.Input, dml.DML}
+}
+
+// TryExecute performs a non-streaming exec.
+func (dml *DMLWithInput) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, _ bool) (*sqltypes.Result, error) {
+	inputRes, err := vcursor.ExecutePrimitive(dml.Input, bindVars, false)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{}
+	for _, group := range dml.groupRows(inputRes.Rows) {
+		dmlVars := combineVars(bindVars, group.vars)
+		dmlVars[dml.ListVar] = &querypb.BindVariable{
+			Type:   querypb.Type_TUPLE,
+			Values: group.values,
+		}
+		qr, err := vcursor.ExecutePrimitive(dml.DML, dmlVars, false)
+		if err != nil {
+			return nil, err
+		}
+		result.RowsAffected += qr.RowsAffected
+	}
+	return result, nil
+}
+
+// groupRows groups the rows of the input by the values of the Vars, keeping the order
+// in which the groups are found. Rows without a primary vindex value can't be
+// addressed by the DML, and can't exist in a sharded table, so they are skipped.
+func (dml *DMLWithInput) groupRows(rows [][]sqltypes.Value) []*dmlGroup {
+	varNames := make([]string, 0, len(dml.Vars))
+	for k := range dml.Vars {
+		varNames = append(varNames, k)
+	}
+	sort.Strings(varNames)
+
+	var groups []*dmlGroup
+	byKey := map[string]*dmlGroup{}
+	for _, row := range rows {
+		if row[0].IsNull() {
+			continue
+		}
+		var key strings.Builder
+		for _, k := range varNames {
+			val := row[dml.Vars[k]]
+			fmt.Fprintf(&key, "%d:%s,", len(val.Raw()), val.String())
+		}
+		group, ok := byKey[key.String()]
+		if !ok {
+			group = &dmlGroup{
+				vars: make(map[string]*querypb.BindVariable, len(varNames)),
+				seen: map[string]bool{},
+			}
+			for _, k := range varNames {
+				group.vars[k] = sqltypes.ValueBindVariable(row[dml.Vars[k]])
+			}
+			byKey[key.String()] = group
+			groups = append(groups, group)
+		}
+		if group.seen[row[0].String()] {
+			continue
+		}
+		group.seen[row[0].String()] = true
+		group.values = append(group.values, sqltypes.ValueToProto(row[0]))
+	}
+	return groups
+}
+
+// TryStreamExecute performs a streaming exec.
+func (dml *DMLWithInput) TryStreamExecute(VCursor, map[string]*querypb.BindVariable, bool, func(*sqltypes.Result) error) error {
+	return fmt.Errorf("DMLWithInput cannot be used for streaming")
+}
+
+// GetFields fetches the field info.
+func (dml *DMLWithInput) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, fmt.Errorf("BUG: unreachable code for DMLWithInput")
+}
+
+func (dml *DMLWithInput) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"ListVar": dml.ListVar,
+	}
+	if len(dml.Vars) > 0 {
+		other["JoinVars"] = orderedStringIntMap(dml.Vars)
+	}
+	return PrimitiveDescription{
+		OperatorType: "DMLWithInput",
+		Other:        other,
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestDMLWithInputExecute(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|col",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|a",
+				"1|a",
+				"null|c",
+			),
+		},
+	}
+	dml := &fakePrimitive{
+		results: []*sqltypes.Result{
+			{RowsAffected: 2},
+			{RowsAffected: 1},
+		},
+	}
+
+	del := &DMLWithInput{
+		Input:   input,
+		DML:     dml,
+		ListVar: "dml_vals",
+		Vars: map[string]int{
+			"t_col": 1,
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+	r, err := del.TryExecute(&noopVCursor{}, bv, false)
+	require.NoError(t, err)
+	input.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" false`,
+	})
+	// the DML runs once for every distinct value of the vars
+	dml.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" dml_vals: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"3"} t_col: type:VARCHAR value:"a" false`,
+		`Execute a: type:INT64 value:"10" dml_vals: type:TUPLE values:{type:INT64 value:"2"} t_col: type:VARCHAR value:"b" false`,
+	})
+	expectResult(t, "del.Execute", r, &sqltypes.Result{RowsAffected: 3})
+
+	// no matching rows
+	input.results = []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|col", "int64|varchar"))}
+	input.rewind()
+	dml.rewind()
+	r, err = del.TryExecute(&noopVCursor{}, bv, false)
+	require.NoError(t, err)
+	dml.ExpectLog(t, nil)
+	expectResult(t, "del.Execute", r, &sqltypes.Result{})
+
+	// input error
+	del.Input = &fakePrimitive{
+		results: []*sqltypes.Result{nil},
+		sendErr: errors.New("input err"),
+	}
+	_, err = del.TryExecute(&noopVCursor{}, bv, false)
+	require.EqualError(t, err, "input err")
+}
@@ -52,6 +52,10 @@ var _ SessionActions = (*noopVCursor)(nil)
 // noopVCursor is used to build other vcursors.
 type noopVCursor struct {
 	ctx context.Context
+
+	spillDir      string
+	maxSpillBytes int64
+	spilledBytes  int64
 }
 
 func (t *noopVCursor) ExecutePrimitive(primitive Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
@@ -146,7 +150,11 @@ func (t *noopVCursor) SetSysVar(name string, expr string) {
 }
 
 func (t *noopVCursor) InReservedConn() bool {
-	panic("implement me")
+	return false
+}
+
+func (t *noopVCursor) InTransaction() bool {
+	return false
 }
 
 func (t *noopVCursor) ShardSession() []*srvtopo.ResolvedShard {
@@ -181,6 +189,10 @@ func (t *noopVCursor) SetTransactionMode(vtgatepb.TransactionMode) {
 	panic("implement me")
 }
 
+func (t *noopVCursor) GetTransactionMode() vtgatepb.TransactionMode {
+	panic("implement me")
+}
+
 func (t *noopVCursor) SetWorkload(querypb.ExecuteOptions_Workload) {
 	panic("implement me")
 }
@@ -207,6 +219,18 @@ func (t *noopVCursor) ExceedsMaxMemoryRows(numRows int) bool {
 	return !testIgnoreMaxMemoryRows && numRows > testMaxMemoryRows
 }
 
+func (t *noopVCursor) SpillDir() string {
+	return t.spillDir
+}
+
+func (t *noopVCursor) RecordSpill(numBytes int64) error {
+	t.spilledBytes += numBytes
+	if t.maxSpillBytes > 0 && t.spilledBytes > t.maxSpillBytes {
+		return fmt.Errorf("spilled bytes exceeded allowed limit of %d", t.maxSpillBytes)
+	}
+	return nil
+}
+
 func (t *noopVCursor) GetKeyspace() string {
 	return ""
 }
@@ -258,6 +282,14 @@ func (t *noopVCursor) SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error {
 	panic("unimplemented")
 }
 
+func (t *noopVCursor) SequenceCache() *SequenceCache {
+	return nil
+}
+
+func (t *noopVCursor) ForEachCachedPlan(func(plan *Plan) bool) error {
+	return nil
+}
+
 func (t *noopVCursor) GetDBDDLPluginName() string {
 	panic("unimplemented")
 }
@@ -290,9 +322,11 @@ type loggingVCursor struct {
 
 	resolvedTargetTabletType topodatapb.TabletType
 
-	tableRoutes tableRoutes
-	dbDDLPlugin string
-	ksAvailable bool
+	tableRoutes     tableRoutes
+	dbDDLPlugin     string
+	ksAvailable     bool
+	transactionMode vtgatepb.TransactionMode
+	sequenceCache   *SequenceCache
 }
 
 type tableRoutes struct {
@@ -336,7 +370,11 @@ func (f *loggingVCursor) NeedsReservedConn() {
 }
 
 func (f *loggingVCursor) InReservedConn() bool {
-	panic("implement me")
+	return false
+}
+
+func (f *loggingVCursor) InTransaction() bool {
+	return false
 }
 
 func (f *loggingVCursor) ShardSession() []*srvtopo.ResolvedShard {
@@ -545,6 +583,10 @@ func (f *loggingVCursor) SetTransactionMode(vtgatepb.TransactionMode) {
 	panic("implement me")
 }
 
+func (f *loggingVCursor) GetTransactionMode() vtgatepb.TransactionMode {
+	return f.transactionMode
+}
+
 func (f *loggingVCursor) SetWorkload(querypb.ExecuteOptions_Workload) {
 	panic("implement me")
 }
@@ -562,6 +604,10 @@ func (f *loggingVCursor) GetDBDDLPluginName() string {
 	return f.dbDDLPlugin
 }
 
+func (f *loggingVCursor) SequenceCache() *SequenceCache {
+	return f.sequenceCache
+}
+
 func (f *loggingVCursor) nextResult() (*sqltypes.Result, error) {
 	if f.results == nil || f.curResult >= len(f.results) {
 		return &sqltypes.Result{}, f.resultErr
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*Filter)(nil)
+
+// Filter evaluates a predicate on the rows of its input, and only
+// returns the rows for which it is true. Rows for which the predicate
+// evaluates to NULL are discarded, like in a WHERE clause.
+type Filter struct {
+	Predicate evalengine.Expr
+	Input     Primitive
+	noTxNeeded
+}
+
+// RouteType implements the Primitive interface
+func (f *Filter) RouteType() string {
+	return f.Input.RouteType()
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (f *Filter) GetKeyspaceName() string {
+	return f.Input.GetKeyspaceName()
+}
+
+// GetTableName implements the Primitive interface
+func (f *Filter) GetTableName() string {
+	return f.Input.GetTableName()
+}
+
+// TryExecute implements the Primitive interface
+func (f *Filter) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := vcursor.ExecutePrimitive(f.Input, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	return f.filter(result, bindVars)
+}
+
+// TryStreamExecute implements the Primitive interface
+func (f *Filter) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return vcursor.StreamExecutePrimitive(f.Input, bindVars, wantfields, func(result *sqltypes.Result) error {
+		filtered, err := f.filter(result, bindVars)
+		if err != nil {
+			return err
+		}
+		return callback(filtered)
+	})
+}
+
+// GetFields implements the Primitive interface
+func (f *Filter) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return f.Input.GetFields(vcursor, bindVars)
+}
+
+func (f *Filter) filter(result *sqltypes.Result, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	env := evalengine.ExpressionEnv{BindVars: bindVars}
+	var rows [][]sqltypes.Value
+	for _, row := range result.Rows {
+		env.Row = row
+		matched, err := evalengine.EvaluateToBool(f.Predicate, env)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			rows = append(rows, row)
+		}
+	}
+	result.Rows = rows
+	return result, nil
+}
+
+// Inputs implements the Primitive interface
+func (f *Filter) Inputs() []Primitive {
+	return []Primitive{f.Input}
+}
+
+func (f *Filter) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "Filter",
+		Other: map[string]interface{}{
+			"Predicate": f.Predicate.String(),
+		},
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func TestFilterExecute(t *testing.T) {
+	// col2 is not null and col1 > :min
+	predicate := &evalengine.LogicalExpr{
+		Op: evalengine.AndOp,
+		Left: &evalengine.IsExpr{
+			Inner: evalengine.NewColumn(1),
+			Op:    evalengine.IsNotNullOp,
+		},
+		Right: &evalengine.ComparisonExpr{
+			Op:    evalengine.GreaterThanOp,
+			Left:  evalengine.NewColumn(0),
+			Right: evalengine.NewBindVar("min"),
+		},
+	}
+	fields := sqltypes.MakeTestFields("col1|col2", "int64|varchar")
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "1|a", "2|null", "3|c", "4|d"),
+		},
+	}
+	filter := &Filter{Predicate: predicate, Input: input}
+	bv := map[string]*querypb.BindVariable{"min": sqltypes.Int64BindVariable(1)}
+
+	result, err := filter.TryExecute(&noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	expectResult(t, "filter.Execute", result, sqltypes.MakeTestResult(fields, "3|c", "4|d"))
+
+	input.rewind()
+	result, err = wrapStreamExecute(filter, &noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	expectResult(t, "filter.StreamExecute", result, sqltypes.MakeTestResult(fields, "3|c", "4|d"))
+}
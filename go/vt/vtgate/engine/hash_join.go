@@ -0,0 +1,318 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*HashJoin)(nil)
+
+// HashJoin joins the results of its inputs on the equality of one or more columns.
+// Unlike Join, both inputs are executed only once: the rows of the smaller one are
+// stored in an in-memory hash table, which is probed with the rows of the other one.
+// The rows are returned in the order of the left input.
+type HashJoin struct {
+	Opcode JoinOpcode
+	// Left and Right are the LHS and RHS primitives
+	// of the HashJoin. They can be any primitive.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left
+	// or right results should be used to build the
+	// return result, the same way as for Join.
+	Cols []int `json:",omitempty"`
+
+	// LHSKeys and RHSKeys are the offsets of the columns of the left
+	// and right rows that have to be equal for the rows to be joined.
+	LHSKeys, RHSKeys []int
+
+	// Collations are the collations used to compare the text keys.
+	// A key that is missing or set to collations.Unknown is compared without collation.
+	Collations []collations.ID `json:",omitempty"`
+}
+
+// hashTable stores rows by the hashcode of their keys.
+type hashTable struct {
+	keys       []int
+	collations []collations.ID
+	m          map[int64][]int
+}
+
+// TryExecute performs a non-streaming exec.
+func (hj *HashJoin) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := vcursor.ExecutePrimitive(hj.Left, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	if vcursor.ExceedsMaxMemoryRows(len(lresult.Rows)) {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	result := &sqltypes.Result{}
+	if len(lresult.Rows) == 0 && hj.Opcode == InnerJoin {
+		if wantfields {
+			rresult, err := hj.Right.GetFields(vcursor, bindVars)
+			if err != nil {
+				return nil, err
+			}
+			result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+		}
+		return result, nil
+	}
+	rresult, err := vcursor.ExecutePrimitive(hj.Right, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	if vcursor.ExceedsMaxMemoryRows(len(rresult.Rows)) {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	if wantfields {
+		result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+	}
+
+	// matches holds, for every left row, the offsets of the right rows it is joined with
+	matches := make([][]int, len(lresult.Rows))
+	if len(lresult.Rows) < len(rresult.Rows) {
+		ht, err := hj.newHashTable(lresult.Rows, hj.LHSKeys)
+		if err != nil {
+			return nil, err
+		}
+		for r, rrow := range rresult.Rows {
+			lrows, err := ht.probe(lresult.Rows, rrow, hj.RHSKeys)
+			if err != nil {
+				return nil, err
+			}
+			for _, l := range lrows {
+				matches[l] = append(matches[l], r)
+			}
+		}
+	} else {
+		ht, err := hj.newHashTable(rresult.Rows, hj.RHSKeys)
+		if err != nil {
+			return nil, err
+		}
+		for l, lrow := range lresult.Rows {
+			matches[l], err = ht.probe(rresult.Rows, lrow, hj.LHSKeys)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for l, lrow := range lresult.Rows {
+		for _, r := range matches[l] {
+			result.Rows = append(result.Rows, joinRows(lrow, rresult.Rows[r], hj.Cols))
+		}
+		if len(matches[l]) == 0 && hj.Opcode == LeftJoin {
+			result.Rows = append(result.Rows, joinRows(lrow, nil, hj.Cols))
+		}
+		if vcursor.ExceedsMaxMemoryRows(len(result.Rows)) {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	return result, nil
+}
+
+// TryStreamExecute performs a streaming exec.
+// The hash table is built with the rows of the right input, and the rows
+// of the left input are streamed while probing it.
+func (hj *HashJoin) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var rfields []*querypb.Field
+	var rrows [][]sqltypes.Value
+	err := vcursor.StreamExecutePrimitive(hj.Right, bindVars, wantfields, func(rresult *sqltypes.Result) error {
+		if rresult.Fields != nil {
+			rfields = rresult.Fields
+		}
+		rrows = append(rrows, rresult.Rows...)
+		if vcursor.ExceedsMaxMemoryRows(len(rrows)) {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	ht, err := hj.newHashTable(rrows, hj.RHSKeys)
+	if err != nil {
+		return err
+	}
+
+	return vcursor.StreamExecutePrimitive(hj.Left, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if lresult.Fields != nil {
+			result.Fields = joinFields(lresult.Fields, rfields, hj.Cols)
+		}
+		for _, lrow := range lresult.Rows {
+			matches, err := ht.probe(rrows, lrow, hj.LHSKeys)
+			if err != nil {
+				return err
+			}
+			for _, r := range matches {
+				result.Rows = append(result.Rows, joinRows(lrow, rrows[r], hj.Cols))
+			}
+			if len(matches) == 0 && hj.Opcode == LeftJoin {
+				result.Rows = append(result.Rows, joinRows(lrow, nil, hj.Cols))
+			}
+		}
+		return callback(result)
+	})
+}
+
+func (hj *HashJoin) newHashTable(rows [][]sqltypes.Value, keys []int) (*hashTable, error) {
+	ht := &hashTable{
+		keys:       keys,
+		collations: hj.Collations,
+		m:          map[int64][]int{},
+	}
+	for i, row := range rows {
+		code, ok, err := ht.hashcode(row, keys)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			ht.m[code] = append(ht.m[code], i)
+		}
+	}
+	return ht, nil
+}
+
+func (ht *hashTable) collation(key int) collations.ID {
+	if key < len(ht.collations) {
+		return ht.collations[key]
+	}
+	return collations.Unknown
+}
+
+// hashcode returns the hashcode of the keys of the row.
+// It returns false if one of them is NULL, in which case the row can't be joined.
+func (ht *hashTable) hashcode(row []sqltypes.Value, keys []int) (int64, bool, error) {
+	code := int64(17)
+	for i, key := range keys {
+		if row[key].IsNull() {
+			return 0, false, nil
+		}
+		hashcode, err := evalengine.NullsafeHashcodeCollated(row[key], ht.collation(i))
+		if err != nil {
+			return 0, false, err
+		}
+		code = code*31 + hashcode
+	}
+	return code, true, nil
+}
+
+// probe returns the offsets of the rows of the hash table whose keys are equal to the given ones.
+func (ht *hashTable) probe(rows [][]sqltypes.Value, probeRow []sqltypes.Value, keys []int) ([]int, error) {
+	code, ok, err := ht.hashcode(probeRow, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+	var matches []int
+	for _, candidate := range ht.m[code] {
+		// the hashcodes can collide, all the values still need to be compared
+		equal, err := ht.equal(rows[candidate], probeRow, keys)
+		if err != nil {
+			return nil, err
+		}
+		if equal {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches, nil
+}
+
+func (ht *hashTable) equal(row, probeRow []sqltypes.Value, probeKeys []int) (bool, error) {
+	for i, key := range ht.keys {
+		cmp, err := evalengine.NullsafeCompareCollated(row[key], probeRow[probeKeys[i]], ht.collation(i))
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// GetFields fetches the field info.
+func (hj *HashJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, hj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this join
+func (hj *HashJoin) Inputs() []Primitive {
+	return []Primitive{hj.Left, hj.Right}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (hj *HashJoin) RouteType() string {
+	return "HashJoin"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (hj *HashJoin) GetKeyspaceName() string {
+	if hj.Left.GetKeyspaceName() == hj.Right.GetKeyspaceName() {
+		return hj.Left.GetKeyspaceName()
+	}
+	return hj.Left.GetKeyspaceName() + "_" + hj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (hj *HashJoin) GetTableName() string {
+	return hj.Left.GetTableName() + "_" + hj.Right.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (hj *HashJoin) NeedsTransaction() bool {
+	return hj.Right.NeedsTransaction() || hj.Left.NeedsTransaction()
+}
+
+func (hj *HashJoin) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":         hj.GetTableName(),
+		"JoinColumnIndexes": strings.Trim(strings.Join(strings.Fields(fmt.Sprint(hj.Cols)), ","), "[]"),
+		"LHSKeys":           strings.Trim(strings.Join(strings.Fields(fmt.Sprint(hj.LHSKeys)), ","), "[]"),
+		"RHSKeys":           strings.Trim(strings.Join(strings.Fields(fmt.Sprint(hj.RHSKeys)), ","), "[]"),
+	}
+	var colls []string
+	for i, id := range hj.Collations {
+		if id != collations.Unknown {
+			colls = append(colls, fmt.Sprintf("%d: %s", i, id.Name()))
+		}
+	}
+	if colls != nil {
+		other["Collations"] = colls
+	}
+	return PrimitiveDescription{
+		OperatorType: "HashJoin",
+		Variant:      hj.Opcode.String(),
+		Other:        other,
+	}
+}
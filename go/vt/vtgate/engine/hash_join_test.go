@@ -0,0 +1,249 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+				"null|d",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3|col4",
+		"int64|varchar",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"3|cc",
+				"1|aa",
+				"3|ccc",
+				"null|dd",
+				"5|ee",
+				"6|ff",
+			),
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	// the hash table is built with the left rows, which are less
+	hj := &HashJoin{
+		Opcode:  InnerJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := hj.TryExecute(&noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" true`,
+	})
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4",
+			"int64|varchar|varchar",
+		),
+		"1|a|aa",
+		"3|c|cc",
+		"3|c|ccc",
+	))
+
+	// Left Join, with the hash table built with the right rows
+	leftPrim.rewind()
+	rightPrim.rewind()
+	hj.Opcode = LeftJoin
+	hj.Left, hj.Right = rightPrim, leftPrim
+	hj.Cols = []int{-1, -2, 2}
+	r, err = hj.TryExecute(&noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col3|col4|col2",
+			"int64|varchar|varchar",
+		),
+		"3|cc|c",
+		"1|aa|a",
+		"3|ccc|c",
+		"null|dd|null",
+		"5|ee|null",
+		"6|ff|null",
+	))
+}
+
+func TestHashJoinCollations(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|name",
+					"int64|varchar",
+				),
+				"1|Abc",
+				"2|def",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"name|id",
+					"varchar|decimal",
+				),
+				"abc|1.0",
+				"DEF|3",
+				"abc|2",
+			),
+		},
+	}
+
+	hj := &HashJoin{
+		Opcode:     InnerJoin,
+		Left:       leftPrim,
+		Right:      rightPrim,
+		Cols:       []int{-1, -2, 1},
+		LHSKeys:    []int{1, 0},
+		RHSKeys:    []int{0, 1},
+		Collations: []collations.ID{collations.Utf8mb4GeneralCi, collations.Unknown},
+	}
+	r, err := hj.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|name|name",
+			"int64|varchar|varchar",
+		),
+		"1|Abc|abc",
+	))
+}
+
+func TestHashJoinStreamExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+				"3|cc",
+				"1|aa",
+				"3|ccc",
+			),
+		},
+	}
+
+	hj := &HashJoin{
+		Opcode:  LeftJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := wrapStreamExecute(hj, &noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	expectResult(t, "hj.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col4",
+			"int64|varchar",
+		),
+		"1|aa",
+		"2|null",
+		"3|cc",
+		"3|ccc",
+	))
+}
+
+func TestHashJoinMaxMemoryRows(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("col1", "int64"),
+				"1", "2", "3",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("col2", "int64"),
+				"1",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:  InnerJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 1},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	saveMax := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() {
+		testMaxMemoryRows = saveMax
+	}()
+	_, err := hj.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "in-memory row count exceeded allowed limit of 2")
+}
@@ -68,10 +68,25 @@ type Insert struct {
 	Generate *Generate
 
 	// Prefix, Mid and Suffix are for sharded insert plans.
+	// For InsertSelect plans, Mid is unused: the values of
+	// each row are generated from the rows of the Input.
 	Prefix string
 	Mid    []string
 	Suffix string
 
+	// Input is the SELECT plan that produces the rows of an InsertSelect.
+	Input Primitive
+
+	// VindexValueOffset is only used by InsertSelect plans: for each
+	// colVindex of the table, it contains the offsets of its columns
+	// in the rows produced by the Input.
+	VindexValueOffset [][]int
+
+	// Ignore is set for InsertSelect plans of INSERT IGNORE and
+	// INSERT...ON DUPLICATE KEY statements. The rows that cannot
+	// be routed are dropped instead of failing the statement.
+	Ignore bool
+
 	// Option to override the standard behavior and allow a multi-shard insert
 	// to use single round trip autocommit.
 	//
@@ -84,9 +99,6 @@ type Insert struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
-	// Insert does not take inputs
-	noInputs
-
 	// Insert needs tx handling
 	txNeeded
 }
@@ -132,6 +144,9 @@ type Generate struct {
 	// values will be generated based on how many were not
 	// supplied (NULL).
 	Values sqltypes.PlanValue
+	// Offset is only used by InsertSelect plans. It is the
+	// offset of the column in the rows produced by the Input.
+	Offset int
 }
 
 // InsertOpcode is a number representing the opcode
@@ -150,14 +165,23 @@ const (
 	// InsertShardedIgnore is for INSERT IGNORE and
 	// INSERT...ON DUPLICATE KEY constructs.
 	InsertShardedIgnore
+	// InsertSelect is for routing an INSERT...SELECT statement
+	// to individual shards. The rows are produced by the Input
+	// plan, and are then routed like the rows of an InsertSharded.
+	InsertSelect
 )
 
 var insName = map[InsertOpcode]string{
 	InsertUnsharded:     "InsertUnsharded",
 	InsertSharded:       "InsertSharded",
 	InsertShardedIgnore: "InsertShardedIgnore",
+	InsertSelect:        "InsertSelect",
 }
 
+// insertSelectBatchSize is the number of rows of an InsertSelect
+// that are routed and sent to the shards in a single round of queries.
+var insertSelectBatchSize = 500
+
 // String returns the opcode
 func (code InsertOpcode) String() string {
 	return strings.ReplaceAll(insName[code], "Insert", "")
@@ -199,12 +223,22 @@ func (ins *Insert) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 		return ins.execInsertUnsharded(vcursor, bindVars)
 	case InsertSharded, InsertShardedIgnore:
 		return ins.execInsertSharded(vcursor, bindVars)
+	case InsertSelect:
+		return ins.execInsertFromSelect(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, fmt.Errorf("unsupported query route: %v", ins)
 	}
 }
 
+// Inputs implements the Primitive interface
+func (ins *Insert) Inputs() []Primitive {
+	if ins.Input == nil {
+		return nil
+	}
+	return []Primitive{ins.Input}
+}
+
 // TryStreamExecute performs a streaming exec.
 func (ins *Insert) TryStreamExecute(VCursor, map[string]*querypb.BindVariable, bool, func(*sqltypes.Result) error) error {
 	return fmt.Errorf("query %q cannot be used for streaming", ins.Query)
@@ -273,6 +307,103 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	return result, nil
 }
 
+// execInsertFromSelect streams the rows of the Input of an InsertSelect, and inserts
+// them in batches of insertSelectBatchSize rows as they arrive, so that the memory
+// used does not depend on the size of the source. The batches are sent through the
+// same session as the other multi-shard DMLs, so they are part of the same transaction
+// and are subject to the transaction mode of the session.
+func (ins *Insert) execInsertFromSelect(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	var insertID int64
+	var pending [][]sqltypes.Value
+	flushed := false
+
+	insertBatch := func(rows [][]sqltypes.Value, last bool) error {
+		rows = ins.padRows(rows)
+		batchInsertID, err := ins.processGenerateFromRows(vcursor, rows)
+		if err != nil {
+			return err
+		}
+		if insertID == 0 {
+			insertID = batchInsertID
+		}
+		rss, queries, err := ins.getInsertSelectQueries(vcursor, bindVars, rows)
+		if err != nil {
+			return err
+		}
+		if len(rss) == 0 {
+			// InsertSelect with Ignore: none of the rows of the batch can be routed.
+			return nil
+		}
+		err = allowOnlyPrimary(rss...)
+		if err != nil {
+			return err
+		}
+		// The statement can only autocommit if all of its rows are sent at once.
+		autocommit := last && !flushed && (len(rss) == 1 || ins.MultiShardAutocommit) && vcursor.AutocommitApproval()
+		flushed = true
+		batchResult, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, autocommit)
+		if errs != nil {
+			return vterrors.Aggregate(errs)
+		}
+		result.RowsAffected += batchResult.RowsAffected
+		return nil
+	}
+
+	err := vcursor.StreamExecutePrimitive(ins.Input, bindVars, false, func(qr *sqltypes.Result) error {
+		pending = append(pending, qr.Rows...)
+		// A batch is only sent once more rows are known to follow it, so that a
+		// statement that fits in a single batch can still autocommit.
+		for len(pending) > insertSelectBatchSize {
+			if err := insertBatch(pending[:insertSelectBatchSize], false); err != nil {
+				return err
+			}
+			pending = pending[insertSelectBatchSize:]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) > 0 {
+		if err := insertBatch(pending, true); err != nil {
+			return nil, err
+		}
+	}
+
+	if insertID != 0 {
+		result.InsertID = uint64(insertID)
+	}
+	return result, nil
+}
+
+// padRows copies the rows produced by the Input of an InsertSelect. The vindex
+// and auto-increment columns that are not part of the SELECT are added as NULL
+// values, at the offsets that were given to them by the planner.
+func (ins *Insert) padRows(rows [][]sqltypes.Value) [][]sqltypes.Value {
+	width := 0
+	for _, offsets := range ins.VindexValueOffset {
+		for _, offset := range offsets {
+			if offset >= width {
+				width = offset + 1
+			}
+		}
+	}
+	if ins.Generate != nil && ins.Generate.Offset >= width {
+		width = ins.Generate.Offset + 1
+	}
+
+	padded := make([][]sqltypes.Value, len(rows))
+	for i, row := range rows {
+		if len(row) > width {
+			width = len(row)
+		}
+		padded[i] = make([]sqltypes.Value, width)
+		copy(padded[i], row)
+	}
+	return padded
+}
+
 // shouldGenerate determines if a sequence value should be generated for a given value
 func shouldGenerate(v sqltypes.Value) bool {
 	if v.IsNull() {
@@ -297,36 +428,78 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 		return 0, nil
 	}
 
-	// Scan input values to compute the number of values to generate, and
-	// keep track of where they should be filled.
 	resolved, err := ins.Generate.Values.ResolveList(bindVars)
 	if err != nil {
 		return 0, err
 	}
+	insertID, err = ins.generateValues(vcursor, resolved)
+	if err != nil {
+		return 0, err
+	}
+	for i, v := range resolved {
+		bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.ValueBindVariable(v)
+	}
+	return insertID, nil
+}
+
+// processGenerateFromRows is the equivalent of processGenerate for the rows
+// of an InsertSelect: the generated values are stored in the rows themselves.
+func (ins *Insert) processGenerateFromRows(vcursor VCursor, rows [][]sqltypes.Value) (insertID int64, err error) {
+	if ins.Generate == nil {
+		return 0, nil
+	}
+
+	values := make([]sqltypes.Value, len(rows))
+	for i, row := range rows {
+		values[i] = row[ins.Generate.Offset]
+	}
+	insertID, err = ins.generateValues(vcursor, values)
+	if err != nil {
+		return 0, err
+	}
+	for i, row := range rows {
+		row[ins.Generate.Offset] = values[i]
+	}
+	return insertID, nil
+}
+
+// generateValues replaces the values that were not supplied with
+// new values from the sequence. It returns the first generated value,
+// or 0 if no value was generated.
+func (ins *Insert) generateValues(vcursor VCursor, values []sqltypes.Value) (insertID int64, err error) {
+	// Scan input values to compute the number of values to generate.
 	count := int64(0)
-	for _, val := range resolved {
+	for _, val := range values {
 		if shouldGenerate(val) {
 			count++
 		}
 	}
 
-	// If generation is needed, generate the requested number of values (as one call).
+	// If generation is needed, generate the requested number of values (as one call),
+	// or take them from the block of values reserved by the sequence cache.
 	if count != 0 {
-		rss, _, err := vcursor.ResolveDestinations(ins.Generate.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
-		if err != nil {
-			return 0, err
-		}
-		if len(rss) != 1 {
-			return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "auto sequence generation can happen through single shard only, it is getting routed to %d shards", len(rss))
+		fetch := func(n int64) (int64, error) {
+			rss, _, err := vcursor.ResolveDestinations(ins.Generate.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
+			if err != nil {
+				return 0, err
+			}
+			if len(rss) != 1 {
+				return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "auto sequence generation can happen through single shard only, it is getting routed to %d shards", len(rss))
+			}
+			bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(n)}
+			qr, err := vcursor.ExecuteStandalone(ins.Generate.Query, bindVars, rss[0])
+			if err != nil {
+				return 0, err
+			}
+			// If no rows are returned, it's an internal error, and the code
+			// must panic, which will be caught and reported.
+			return evalengine.ToInt64(qr.Rows[0][0])
 		}
-		bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}
-		qr, err := vcursor.ExecuteStandalone(ins.Generate.Query, bindVars, rss[0])
-		if err != nil {
-			return 0, err
+		if sc := vcursor.SequenceCache(); sc != nil {
+			insertID, err = sc.Reserve(ins.Generate, count, fetch)
+		} else {
+			insertID, err = fetch(count)
 		}
-		// If no rows are returned, it's an internal error, and the code
-		// must panic, which will be caught and reported.
-		insertID, err = evalengine.ToInt64(qr.Rows[0][0])
 		if err != nil {
 			return 0, err
 		}
@@ -334,12 +507,10 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 
 	// Fill the holes where no value was supplied.
 	cur := insertID
-	for i, v := range resolved {
+	for i, v := range values {
 		if shouldGenerate(v) {
-			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.Int64BindVariable(cur)
+			values[i] = sqltypes.NewInt64(cur)
 			cur++
-		} else {
-			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.ValueBindVariable(v)
 		}
 	}
 	return insertID, nil
@@ -390,31 +561,11 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		}
 	}
 
-	// The output from the following 'process' functions is a list of
-	// keyspace ids. For regular inserts, a failure to find a route
-	// results in an error. For 'ignore' type inserts, the keyspace
-	// id is returned as nil, which is used later to drop the corresponding rows.
-	if len(vindexRowsValues) == 0 || len(ins.Table.ColumnVindexes) == 0 {
-		return nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RequiresPrimaryKey, vterrors.PrimaryVindexNotSet, ins.Table.Name)
-	}
-	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], ins.Table.ColumnVindexes[0])
+	keyspaceIDs, err := ins.processVindexes(vcursor, vindexRowsValues)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	for vIdx := 1; vIdx < len(ins.Table.ColumnVindexes); vIdx++ {
-		colVindex := ins.Table.ColumnVindexes[vIdx]
-		var err error
-		if colVindex.Owned {
-			err = ins.processOwned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
-		} else {
-			err = ins.processUnowned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
-		}
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-
 	// Build 3-d bindvars. Skip rows with nil keyspace ids in case
 	// we're executing an insert ignore.
 	for vIdx, colVindex := range ins.Table.ColumnVindexes {
@@ -431,27 +582,8 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		}
 	}
 
-	// We need to know the keyspace ids and the Mids associated with
-	// each RSS.  So we pass the ksid indexes in as ids, and get them back
-	// as values. We also skip nil KeyspaceIds, no need to resolve them.
-	var indexes []*querypb.Value
-	var destinations []key.Destination
-	for i, ksid := range keyspaceIDs {
-		if ksid != nil {
-			indexes = append(indexes, &querypb.Value{
-				Value: strconv.AppendInt(nil, int64(i), 10),
-			})
-			destinations = append(destinations, key.DestinationKeyspaceID(ksid))
-		}
-	}
-	if len(destinations) == 0 {
-		// In this case, all we have is nil KeyspaceIds, we don't do
-		// anything at all.
-		return nil, nil, nil
-	}
-
-	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
-	if err != nil {
+	rss, indexesPerRss, err := ins.resolveKeyspaceIDs(vcursor, keyspaceIDs)
+	if err != nil || len(rss) == 0 {
 		return nil, nil, err
 	}
 
@@ -474,6 +606,133 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	return rss, queries, nil
 }
 
+// getInsertSelectQueries is the equivalent of getInsertShardedRoute for
+// a batch of rows produced by the Input of an InsertSelect. The values
+// of each row are sent as bind variables to the shard the row belongs to.
+func (ins *Insert) getInsertSelectQueries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+	if len(ins.VindexValueOffset) != len(ins.Table.ColumnVindexes) {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] vindex offsets don't match vschema: %v %v", ins.VindexValueOffset, ins.Table.ColumnVindexes)
+	}
+	// The 3-d structure indexes are colVindex, row, col, like in getInsertShardedRoute.
+	vindexRowsValues := make([][][]sqltypes.Value, len(ins.VindexValueOffset))
+	for vIdx, offsets := range ins.VindexValueOffset {
+		vindexRowsValues[vIdx] = make([][]sqltypes.Value, len(rows))
+		for rowNum, row := range rows {
+			rowColumnKeys := make([]sqltypes.Value, len(offsets))
+			for colIdx, offset := range offsets {
+				rowColumnKeys[colIdx] = row[offset]
+			}
+			vindexRowsValues[vIdx][rowNum] = rowColumnKeys
+		}
+	}
+
+	keyspaceIDs, err := ins.processVindexes(vcursor, vindexRowsValues)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Unowned vindexes may have reverse mapped some of the values.
+	for vIdx, offsets := range ins.VindexValueOffset {
+		for rowNum, rowColumnKeys := range vindexRowsValues[vIdx] {
+			for colIdx, offset := range offsets {
+				rows[rowNum][offset] = rowColumnKeys[colIdx]
+			}
+		}
+	}
+
+	rss, indexesPerRss, err := ins.resolveKeyspaceIDs(vcursor, keyspaceIDs)
+	if err != nil || len(rss) == 0 {
+		return nil, nil, err
+	}
+
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		bvs := make(map[string]*querypb.BindVariable, len(bindVars))
+		for k, v := range bindVars {
+			bvs[k] = v
+		}
+		var mids []string
+		for _, indexValue := range indexesPerRss[i] {
+			index, _ := strconv.Atoi(string(indexValue.Value))
+			if keyspaceIDs[index] == nil {
+				continue
+			}
+			names := make([]string, len(rows[index]))
+			for colIdx, value := range rows[index] {
+				name := insertSelectVarName(index, colIdx)
+				bvs[name] = sqltypes.ValueBindVariable(value)
+				names[colIdx] = ":" + name
+			}
+			mids = append(mids, "("+strings.Join(names, ", ")+")")
+		}
+		queries[i] = &querypb.BoundQuery{
+			Sql:           ins.Prefix + strings.Join(mids, ", ") + ins.Suffix,
+			BindVariables: bvs,
+		}
+	}
+
+	return rss, queries, nil
+}
+
+// processVindexes computes the keyspace ids of the rows, using the values of
+// all the vindex columns. The 3-d structure indexes are colVindex, row, col.
+// For regular inserts, a failure to find a route results in an error.
+// For 'ignore' type inserts, the keyspace id is returned as nil, which
+// is used later to drop the corresponding rows.
+func (ins *Insert) processVindexes(vcursor VCursor, vindexRowsValues [][][]sqltypes.Value) ([][]byte, error) {
+	if len(vindexRowsValues) == 0 || len(ins.Table.ColumnVindexes) == 0 {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RequiresPrimaryKey, vterrors.PrimaryVindexNotSet, ins.Table.Name)
+	}
+	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], ins.Table.ColumnVindexes[0])
+	if err != nil {
+		return nil, err
+	}
+
+	for vIdx := 1; vIdx < len(ins.Table.ColumnVindexes); vIdx++ {
+		colVindex := ins.Table.ColumnVindexes[vIdx]
+		var err error
+		if colVindex.Owned {
+			err = ins.processOwned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
+		} else {
+			err = ins.processUnowned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keyspaceIDs, nil
+}
+
+// resolveKeyspaceIDs resolves the shards of the keyspace ids. For each shard,
+// it also returns the indexes of the keyspace ids that belong to it. Nil
+// keyspace ids are skipped: if there are only nil keyspace ids, no shard is returned.
+func (ins *Insert) resolveKeyspaceIDs(vcursor VCursor, keyspaceIDs [][]byte) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	// We need to know the keyspace ids and the rows associated with
+	// each RSS.  So we pass the ksid indexes in as ids, and get them back
+	// as values.
+	var indexes []*querypb.Value
+	var destinations []key.Destination
+	for i, ksid := range keyspaceIDs {
+		if ksid != nil {
+			indexes = append(indexes, &querypb.Value{
+				Value: strconv.AppendInt(nil, int64(i), 10),
+			})
+			destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+		}
+	}
+	if len(destinations) == 0 {
+		// In this case, all we have is nil KeyspaceIds, we don't do
+		// anything at all.
+		return nil, nil, nil
+	}
+	return vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
+}
+
+// isIgnore returns true if the rows that cannot be routed must be dropped.
+func (ins *Insert) isIgnore() bool {
+	return ins.Opcode == InsertShardedIgnore || (ins.Opcode == InsertSelect && ins.Ignore)
+}
+
 // processPrimary maps the primary vindex values to the keyspace ids.
 func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex) ([][]byte, error) {
 	destinations, err := vindexes.Map(colVindex.Vindex, vcursor, vindexColumnsKeys)
@@ -489,7 +748,7 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltype
 			keyspaceIDs[i] = d
 		case key.DestinationNone:
 			// No valid keyspace id, we may return an error.
-			if ins.Opcode != InsertShardedIgnore {
+			if !ins.isIgnore() {
 				return nil, fmt.Errorf("could not map %v to a keyspace id", vindexColumnsKeys[i])
 			}
 		default:
@@ -502,7 +761,7 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltype
 
 // processOwned creates vindex entries for the values of an owned column.
 func (ins *Insert) processOwned(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, ksids [][]byte) error {
-	if ins.Opcode == InsertSharded {
+	if !ins.isIgnore() {
 		return colVindex.Vindex.(vindexes.Lookup).Create(vcursor, vindexColumnsKeys, ksids, false /* ignoreMode */)
 	}
 
@@ -594,7 +853,7 @@ func (ins *Insert) processUnowned(vcursor VCursor, vindexColumnsKeys [][]sqltype
 		for i, v := range verified {
 			rowNum := verifyIndexes[i]
 			if !v {
-				if ins.Opcode != InsertShardedIgnore {
+				if !ins.isIgnore() {
 					mismatchVindexKeys = append(mismatchVindexKeys, vindexColumnsKeys[rowNum])
 					continue
 				}
@@ -615,6 +874,11 @@ func InsertVarName(col sqlparser.ColIdent, rowNum int) string {
 	return fmt.Sprintf("_%s_%d", col.CompliantName(), rowNum)
 }
 
+// insertSelectVarName returns the name of the bind var for a value of a row of an InsertSelect.
+func insertSelectVarName(rowNum, colIdx int) string {
+	return fmt.Sprintf("_c%d_%d", rowNum, colIdx)
+}
+
 func (ins *Insert) description() PrimitiveDescription {
 	other := map[string]interface{}{
 		"Query":                ins.Query,
@@ -622,6 +886,19 @@ func (ins *Insert) description() PrimitiveDescription {
 		"MultiShardAutocommit": ins.MultiShardAutocommit,
 		"QueryTimeout":         ins.QueryTimeout,
 	}
+	if ins.Opcode == InsertSelect {
+		offsets := map[string][]int{}
+		for vIdx, colVindex := range ins.Table.ColumnVindexes {
+			offsets[colVindex.Name] = ins.VindexValueOffset[vIdx]
+		}
+		other["VindexOffsetFromSelect"] = offsets
+		if ins.Generate != nil {
+			other["AutoIncrement"] = fmt.Sprintf("%s:%d", ins.Generate.Keyspace.Name, ins.Generate.Offset)
+		}
+		if ins.Ignore {
+			other["Ignore"] = true
+		}
+	}
 	return PrimitiveDescription{
 		OperatorType:     "Insert",
 		Keyspace:         ins.Keyspace,
@@ -119,6 +119,66 @@ func TestInsertUnshardedGenerate(t *testing.T) {
 	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 4})
 }
 
+func TestInsertUnshardedGenerateSequenceCache(t *testing.T) {
+	ins := NewQueryInsert(
+		InsertUnsharded,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: false,
+		},
+		"dummy_insert",
+	)
+	ins.Generate = &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: false,
+		},
+		Query: "select next :n values from seq",
+		Values: sqltypes.PlanValue{
+			Values: []sqltypes.PlanValue{
+				{Value: sqltypes.NULL},
+				{Value: sqltypes.NewInt64(2)},
+				{Value: sqltypes.NULL},
+			},
+		},
+	}
+
+	vc := newDMLTestVCursor("0")
+	vc.sequenceCache = NewSequenceCache(10)
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"nextval",
+				"int64",
+			),
+			"4",
+		),
+		{InsertID: 1},
+		{InsertID: 1},
+	}
+
+	result, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		// Reserve a block of sequence values.
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone select next :n values from seq n: type:INT64 value:"10" ks2 0`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: dummy_insert {__seq0: type:INT64 value:"4" __seq1: type:INT64 value:"2" __seq2: type:INT64 value:"5"} true true`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 4})
+
+	// The second insert takes its values from the block.
+	vc.log = nil
+	result, err = ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: dummy_insert {__seq0: type:INT64 value:"6" __seq1: type:INT64 value:"2" __seq2: type:INT64 value:"7"} true true`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 6})
+}
+
 func TestInsertUnshardedGenerate_Zeros(t *testing.T) {
 	ins := NewQueryInsert(
 		InsertUnsharded,
@@ -1584,3 +1644,234 @@ func TestInsertShardedUnownedReverseMapFail(t *testing.T) {
 	_, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
 	require.EqualError(t, err, `value must be supplied for column [c3]`)
 }
+
+func TestInsertSelectSimple(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs := vindexes.BuildVSchema(invschema)
+	ks := vs.Keyspaces["sharded"]
+
+	ins := &Insert{
+		Opcode:            InsertSelect,
+		Keyspace:          ks.Keyspace,
+		Table:             ks.Tables["t1"],
+		Prefix:            "prefix ",
+		Suffix:            " suffix",
+		VindexValueOffset: [][]int{{1}, {0}},
+		Input: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("c3|id", "int64|int64"),
+				"10|1",
+				"11|2",
+				"12|3",
+			)},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+
+	_, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0), (:from_1, :toc_1), (:from_2, :toc_2) ` +
+			`from_0: type:INT64 value:"10" from_1: type:INT64 value:"11" from_2: type:INT64 value:"12" ` +
+			`toc_0: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" toc_1: type:VARBINARY value:"\x06\xe7\xea\"Βp\x8f" toc_2: type:VARBINARY value:"N\xb1\x90ɢ\xfa\x16\x9c" true`,
+		// Based on shardForKsid, values returned will be 20-, -20, 20-.
+		`ResolveDestinations sharded [value:"0" value:"1" value:"2"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_c0_0, :_c0_1), (:_c2_0, :_c2_1) suffix ` +
+			`{_c0_0: type:INT64 value:"10" _c0_1: type:INT64 value:"1" _c2_0: type:INT64 value:"12" _c2_1: type:INT64 value:"3"} ` +
+			`sharded.-20: prefix (:_c1_0, :_c1_1) suffix ` +
+			`{_c1_0: type:INT64 value:"11" _c1_1: type:INT64 value:"2"} ` +
+			`true false`,
+	})
+}
+
+func TestInsertSelectGenerate(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs := vindexes.BuildVSchema(invschema)
+	ks := vs.Keyspaces["sharded"]
+
+	// the id column is not selected: it is added at offset 1 and filled by the sequence
+	ins := &Insert{
+		Opcode:            InsertSelect,
+		Keyspace:          ks.Keyspace,
+		Table:             ks.Tables["t1"],
+		Prefix:            "prefix ",
+		Suffix:            " suffix",
+		VindexValueOffset: [][]int{{1}},
+		Generate: &Generate{
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks2",
+				Sharded: false,
+			},
+			Query:  "dummy_generate",
+			Offset: 1,
+		},
+		Input: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("name", "varchar"),
+				"a",
+				"b",
+				"c",
+			)},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"nextval",
+				"int64",
+			),
+			"1",
+		),
+		{RowsAffected: 3},
+	}
+
+	result, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"3" ks2 -20`,
+		`ResolveDestinations sharded [value:"0" value:"1" value:"2"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_c0_0, :_c0_1), (:_c2_0, :_c2_1) suffix ` +
+			`{_c0_0: type:VARCHAR value:"a" _c0_1: type:INT64 value:"1" _c2_0: type:VARCHAR value:"c" _c2_1: type:INT64 value:"3"} ` +
+			`sharded.-20: prefix (:_c1_0, :_c1_1) suffix ` +
+			`{_c1_0: type:VARCHAR value:"b" _c1_1: type:INT64 value:"2"} ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 1, RowsAffected: 3})
+}
+
+func TestInsertSelectBatches(t *testing.T) {
+	defer func(size int) {
+		insertSelectBatchSize = size
+	}(insertSelectBatchSize)
+	insertSelectBatchSize = 2
+
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs := vindexes.BuildVSchema(invschema)
+	ks := vs.Keyspaces["sharded"]
+
+	ins := &Insert{
+		Opcode:               InsertSelect,
+		Keyspace:             ks.Keyspace,
+		Table:                ks.Tables["t1"],
+		Prefix:               "prefix ",
+		VindexValueOffset:    [][]int{{0}},
+		MultiShardAutocommit: true,
+		Input: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("id", "int64"),
+				"1",
+				"2",
+				"3",
+			)},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+	vc.results = []*sqltypes.Result{{RowsAffected: 2}, {RowsAffected: 1}}
+
+	result, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	// The rows are sent in two batches, which cannot be autocommitted.
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0" value:"1"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_c0_0) {_c0_0: type:INT64 value:"1"} ` +
+			`sharded.-20: prefix (:_c1_0) {_c1_0: type:INT64 value:"2"} ` +
+			`true false`,
+		`ResolveDestinations sharded [value:"0"] Destinations:DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_c0_0) {_c0_0: type:INT64 value:"3"} ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3})
+
+	// The rows are inserted as they are streamed, so the input is not limited
+	// by the row count of the primitives that buffer rows in memory.
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = 100 }()
+	ins.Input.(*fakePrimitive).rewind()
+	vc.Rewind()
+	vc.results = []*sqltypes.Result{{RowsAffected: 2}, {RowsAffected: 1}}
+	result, err = ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3})
+	ins.Input.(*fakePrimitive).ExpectLog(t, []string{`StreamExecute  false`})
+}
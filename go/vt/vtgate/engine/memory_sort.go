@@ -66,6 +66,12 @@ func (ms *MemorySort) SetTruncateColumnCount(count int) {
 
 // TryExecute satisfies the Primitive interface.
 func (ms *MemorySort) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	if streamsInput(vcursor) {
+		// the streaming implementation spills the rows that exceed MaxMemoryRows to disk
+		return collectStream(func(callback func(*sqltypes.Result) error) error {
+			return ms.TryStreamExecute(vcursor, bindVars, wantfields, callback)
+		})
+	}
 	count, err := ms.fetchCount(bindVars)
 	if err != nil {
 		return nil, err
@@ -107,6 +113,8 @@ func (ms *MemorySort) TryStreamExecute(vcursor VCursor, bindVars map[string]*que
 		comparers: extractSlices(ms.OrderBy),
 		reverse:   true,
 	}
+	var spiller *rowSpiller
+	defer func() { spiller.close() }()
 	err = vcursor.StreamExecutePrimitive(ms.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
 			if err := cb(&sqltypes.Result{Fields: qr.Fields}); err != nil {
@@ -122,7 +130,18 @@ func (ms *MemorySort) TryStreamExecute(vcursor VCursor, bindVars map[string]*que
 			}
 		}
 		if vcursor.ExceedsMaxMemoryRows(len(sh.rows)) {
-			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+			// the rows that don't fit in memory are written to disk, and sorted with the other ones at the end
+			if spiller == nil {
+				var err error
+				spiller, err = newRowSpiller(vcursor, extractSlices(ms.OrderBy))
+				if err != nil {
+					return err
+				}
+			}
+			if err := spiller.spill(sh.rows); err != nil {
+				return err
+			}
+			sh.rows = nil
 		}
 		return nil
 	})
@@ -132,6 +151,9 @@ func (ms *MemorySort) TryStreamExecute(vcursor VCursor, bindVars map[string]*que
 	if sh.err != nil {
 		return sh.err
 	}
+	if spiller != nil {
+		return ms.streamSpilledRows(vcursor, spiller, sh.rows, count, cb)
+	}
 	// Set ordering to normal for the final ordering.
 	sh.reverse = false
 	sort.Sort(sh)
@@ -142,6 +164,36 @@ func (ms *MemorySort) TryStreamExecute(vcursor VCursor, bindVars map[string]*que
 	return cb(&sqltypes.Result{Rows: sh.rows})
 }
 
+// streamSpilledRows merges the rows written to disk with the rows still in memory,
+// and sends the first count ones in chunks of at most MaxMemoryRows rows.
+func (ms *MemorySort) streamSpilledRows(vcursor VCursor, spiller *rowSpiller, rows [][]sqltypes.Value, count int, callback func(*sqltypes.Result) error) error {
+	merger, err := spiller.merge(rows)
+	if err != nil {
+		return err
+	}
+	result := &sqltypes.Result{}
+	for sent := 0; sent < count; sent++ {
+		row, err := merger.next()
+		if err != nil {
+			return err
+		}
+		if row == nil {
+			break
+		}
+		result.Rows = append(result.Rows, row)
+		if vcursor.ExceedsMaxMemoryRows(len(result.Rows) + 1) {
+			if err := callback(result); err != nil {
+				return err
+			}
+			result = &sqltypes.Result{}
+		}
+	}
+	if len(result.Rows) == 0 {
+		return nil
+	}
+	return callback(result)
+}
+
 // GetFields satisfies the Primitive interface.
 func (ms *MemorySort) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	return ms.Input.GetFields(vcursor, bindVars)
@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"os"
 	"testing"
 
 	"vitess.io/vitess/go/test/utils"
@@ -516,3 +517,98 @@ func TestMemorySortExecuteNoVarChar(t *testing.T) {
 		t.Errorf("StreamExecute err: %v, want %v", err, want)
 	}
 }
+
+func TestMemorySortStreamExecuteSpill(t *testing.T) {
+	saveMax := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() {
+		testMaxMemoryRows = saveMax
+	}()
+
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"varbinary|decimal",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"g|2",
+			"a|1",
+			"c|4",
+			"c|3",
+			"e|5",
+			"f|null",
+		)},
+	}
+
+	ms := &MemorySort{
+		OrderBy: []OrderByParams{{
+			WeightStringCol: -1,
+			Col:             1,
+		}},
+		Input: fp,
+	}
+
+	spillDir := t.TempDir()
+	vc := &noopVCursor{spillDir: spillDir}
+	var results []*sqltypes.Result
+	err := ms.TryStreamExecute(vc, nil, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// the sorted rows are sent in chunks of at most max memory rows
+	wantResults := []*sqltypes.Result{
+		{Fields: fields},
+		{Rows: sqltypes.MakeTestResult(fields, "f|null", "a|1").Rows},
+		{Rows: sqltypes.MakeTestResult(fields, "a|1", "g|2").Rows},
+		{Rows: sqltypes.MakeTestResult(fields, "c|3", "c|4").Rows},
+		{Rows: sqltypes.MakeTestResult(fields, "e|5").Rows},
+	}
+	utils.MustMatch(t, wantResults, results)
+	require.NotZero(t, vc.spilledBytes)
+
+	files, err := os.ReadDir(spillDir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	// TryExecute streams the input to spill it as well
+	fp.rewind()
+	vc = &noopVCursor{spillDir: spillDir}
+	result, err := ms.TryExecute(vc, nil, false)
+	require.NoError(t, err)
+	utils.MustMatch(t, sqltypes.MakeTestResult(fields, "f|null", "a|1", "a|1", "g|2", "c|3", "c|4", "e|5"), result)
+	require.NotZero(t, vc.spilledBytes)
+
+	fp.rewind()
+	upperlimit, err := sqlparser.NewPlanValue(sqlparser.NewArgument("__upper_limit"))
+	require.NoError(t, err)
+	ms.UpperLimit = upperlimit
+	bv := map[string]*querypb.BindVariable{"__upper_limit": sqltypes.Int64BindVariable(3)}
+
+	results = nil
+	err = ms.TryStreamExecute(&noopVCursor{spillDir: spillDir}, bv, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+	wantResults = []*sqltypes.Result{
+		{Fields: fields},
+		{Rows: sqltypes.MakeTestResult(fields, "f|null", "a|1").Rows},
+		{Rows: sqltypes.MakeTestResult(fields, "a|1").Rows},
+	}
+	utils.MustMatch(t, wantResults, results)
+
+	fp.rewind()
+	ms.UpperLimit = sqltypes.PlanValue{}
+	err = ms.TryStreamExecute(&noopVCursor{spillDir: spillDir, maxSpillBytes: 10}, nil, false, func(qr *sqltypes.Result) error {
+		return nil
+	})
+	require.EqualError(t, err, "spilled bytes exceeded allowed limit of 10")
+
+	files, err = os.ReadDir(spillDir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -147,6 +148,56 @@ func TestMergeSortWeightString(t *testing.T) {
 	utils.MustMatch(t, wantResults, results)
 }
 
+// TestMergeSortCollation tests that text columns are
+// compared using their collation when it is known.
+func TestMergeSortCollation(t *testing.T) {
+	idColFields := sqltypes.MakeTestFields("id|col", "int32|varchar")
+	shardResults := []*shardResult{{
+		results: sqltypes.MakeTestStreamingResults(idColFields,
+			"1|a",
+			"7|Zebra",
+		),
+	}, {
+		results: sqltypes.MakeTestStreamingResults(idColFields,
+			"2|B",
+			"---",
+			"3|écrit",
+		),
+	}, {
+		results: sqltypes.MakeTestStreamingResults(idColFields,
+			"4|D",
+			"6|yak",
+		),
+	}}
+	orderBy := []OrderByParams{{
+		WeightStringCol: -1,
+		Col:             1,
+		CollationID:     collations.Utf8mb4_0900AiCi,
+	}}
+
+	var results []*sqltypes.Result
+	err := testMergeSort(shardResults, orderBy, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	wantResults := sqltypes.MakeTestStreamingResults(idColFields,
+		"1|a",
+		"---",
+		"2|B",
+		"---",
+		"4|D",
+		"---",
+		"3|écrit",
+		"---",
+		"6|yak",
+		"---",
+		"7|Zebra",
+	)
+	utils.MustMatch(t, wantResults, results)
+}
+
 // TestMergeSortDescending tests the normal flow of a merge
 // sort where all shards return descending rows.
 func TestMergeSortDescending(t *testing.T) {
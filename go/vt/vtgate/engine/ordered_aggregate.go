@@ -24,6 +24,7 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -66,14 +67,22 @@ type GroupByParams struct {
 	WeightStringCol int
 	Expr            sqlparser.Expr
 	FromGroupBy     bool
+	// CollationID is the collation used to compare the text values of the key in vtgate.
+	CollationID collations.ID
 }
 
 // String returns a string. Used for plan descriptions
 func (gbp GroupByParams) String() string {
+	var out string
 	if gbp.WeightStringCol == -1 || gbp.KeyCol == gbp.WeightStringCol {
-		return strconv.Itoa(gbp.KeyCol)
+		out = strconv.Itoa(gbp.KeyCol)
+	} else {
+		out = fmt.Sprintf("(%d|%d)", gbp.KeyCol, gbp.WeightStringCol)
 	}
-	return fmt.Sprintf("(%d|%d)", gbp.KeyCol, gbp.WeightStringCol)
+	if gbp.CollationID != collations.Unknown {
+		out += " COLLATE " + gbp.CollationID.Name()
+	}
+	return out
 }
 
 // AggregateParams specify the parameters for each aggregation.
@@ -83,9 +92,14 @@ type AggregateParams struct {
 	Col    int
 
 	// These are used only for distinct opcodes.
-	KeyCol    int
-	WCol      int
-	WAssigned bool
+	KeyCol      int
+	WCol        int
+	WAssigned   bool
+	CollationID collations.ID
+	// HashDistinct is set when the input is not sorted on the values of the
+	// aggregate, which is the case for all but the first distinct expression
+	// of a query. The values of every group are then kept in a hash table.
+	HashDistinct bool
 
 	Alias string `json:",omitempty"`
 	Expr  sqlparser.Expr
@@ -104,6 +118,12 @@ func (ap *AggregateParams) String() string {
 	if ap.WAssigned {
 		keyCol = fmt.Sprintf("%s|%d", keyCol, ap.WCol)
 	}
+	if ap.CollationID != collations.Unknown {
+		keyCol += " COLLATE " + ap.CollationID.Name()
+	}
+	if ap.HashDistinct {
+		keyCol += " HASHED"
+	}
 	if ap.Alias != "" {
 		return fmt.Sprintf("%s(%s) AS %s", ap.Opcode.String(), keyCol, ap.Alias)
 	}
@@ -198,40 +218,49 @@ func (oa *OrderedAggregate) TryExecute(vcursor VCursor, bindVars map[string]*que
 }
 
 func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
-	result, err := vcursor.ExecutePrimitive(oa.Input, bindVars, wantfields)
-	if err != nil {
-		return nil, err
-	}
 	out := &sqltypes.Result{
-		Fields: oa.convertFields(result.Fields),
-		Rows:   make([][]sqltypes.Value, 0, len(result.Rows)),
+		Rows: [][]sqltypes.Value{},
 	}
 	// This code is similar to the one in StreamExecute.
 	var current []sqltypes.Value
-	var curDistincts []sqltypes.Value
-	for _, row := range result.Rows {
-		if current == nil {
-			current, curDistincts = oa.convertRow(row)
-			continue
+	var curDistincts *distinctValues
+	defer func() { curDistincts.close() }()
+	err := executeInput(vcursor, oa.Input, bindVars, wantfields, func(result *sqltypes.Result) error {
+		if len(result.Fields) != 0 {
+			out.Fields = oa.convertFields(result.Fields)
 		}
+		for _, row := range result.Rows {
+			if current == nil {
+				current, curDistincts = oa.convertRow(vcursor, row)
+				continue
+			}
 
-		equal, err := oa.keysEqual(current, row)
-		if err != nil {
-			return nil, err
-		}
+			equal, err := oa.keysEqual(current, row)
+			if err != nil {
+				return err
+			}
 
-		if equal {
-			current, curDistincts, err = oa.merge(result.Fields, current, row, curDistincts)
+			if equal {
+				current, curDistincts, err = oa.merge(out.Fields, current, row, curDistincts)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			current, err = oa.mergeSpilled(current, curDistincts)
 			if err != nil {
-				return nil, err
+				return err
 			}
-			continue
+			out.Rows = append(out.Rows, current)
+			current, curDistincts = oa.convertRow(vcursor, row)
 		}
-		out.Rows = append(out.Rows, current)
-		current, curDistincts = oa.convertRow(row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if len(result.Rows) == 0 && len(oa.GroupByKeys) == 0 {
+	if current == nil && len(oa.GroupByKeys) == 0 {
 		// When doing aggregation without grouping keys, we need to produce a single row containing zero-value for the
 		// different aggregation functions
 		row, err := oa.createEmptyRow()
@@ -242,6 +271,10 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	}
 
 	if current != nil {
+		current, err = oa.mergeSpilled(current, curDistincts)
+		if err != nil {
+			return nil, err
+		}
 		final, err := oa.convertFinal(current)
 		if err != nil {
 			return nil, err
@@ -254,8 +287,9 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 // TryStreamExecute is a Primitive function.
 func (oa *OrderedAggregate) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	var current []sqltypes.Value
-	var curDistincts []sqltypes.Value
+	var curDistincts *distinctValues
 	var fields []*querypb.Field
+	defer func() { curDistincts.close() }()
 
 	cb := func(qr *sqltypes.Result) error {
 		return callback(qr.Truncate(oa.TruncateColumnCount))
@@ -271,7 +305,7 @@ func (oa *OrderedAggregate) TryStreamExecute(vcursor VCursor, bindVars map[strin
 		// This code is similar to the one in Execute.
 		for _, row := range qr.Rows {
 			if current == nil {
-				current, curDistincts = oa.convertRow(row)
+				current, curDistincts = oa.convertRow(vcursor, row)
 				continue
 			}
 
@@ -287,10 +321,14 @@ func (oa *OrderedAggregate) TryStreamExecute(vcursor VCursor, bindVars map[strin
 				}
 				continue
 			}
+			current, err = oa.mergeSpilled(current, curDistincts)
+			if err != nil {
+				return err
+			}
 			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
 				return err
 			}
-			current, curDistincts = oa.convertRow(row)
+			current, curDistincts = oa.convertRow(vcursor, row)
 		}
 		return nil
 	})
@@ -299,6 +337,10 @@ func (oa *OrderedAggregate) TryStreamExecute(vcursor VCursor, bindVars map[strin
 	}
 
 	if current != nil {
+		current, err = oa.mergeSpilled(current, curDistincts)
+		if err != nil {
+			return err
+		}
 		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
 			return err
 		}
@@ -325,16 +367,16 @@ func (oa *OrderedAggregate) convertFields(fields []*querypb.Field) []*querypb.Fi
 	return fields
 }
 
-func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.Value, curDistincts []sqltypes.Value) {
+func (oa *OrderedAggregate) convertRow(vcursor VCursor, row []sqltypes.Value) (newRow []sqltypes.Value, curDistincts *distinctValues) {
 	if !oa.PreProcess {
 		return row, nil
 	}
 	newRow = append(newRow, row...)
-	curDistincts = make([]sqltypes.Value, len(oa.Aggregates))
+	curDistincts = &distinctValues{vcursor: vcursor, last: make([]sqltypes.Value, len(oa.Aggregates))}
 	for index, aggr := range oa.Aggregates {
 		switch aggr.Opcode {
 		case AggregateCountDistinct:
-			curDistincts[index] = findComparableCurrentDistinct(row, aggr)
+			curDistincts.last[index] = firstDistinct(row, aggr)
 			// Type is int64. Ok to call MakeTrusted.
 			if row[aggr.KeyCol].IsNull() {
 				newRow[aggr.Col] = countZero
@@ -342,7 +384,7 @@ func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.
 				newRow[aggr.Col] = countOne
 			}
 		case AggregateSumDistinct:
-			curDistincts[index] = findComparableCurrentDistinct(row, aggr)
+			curDistincts.last[index] = firstDistinct(row, aggr)
 			var err error
 			newRow[aggr.Col], err = evalengine.Cast(row[aggr.Col], OpcodeType[aggr.Opcode])
 			if err != nil {
@@ -363,6 +405,24 @@ func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.
 	return newRow, curDistincts
 }
 
+// firstDistinct returns the value of the distinct aggregate in the first row of a group.
+func firstDistinct(row []sqltypes.Value, aggr *AggregateParams) sqltypes.Value {
+	if aggr.HashDistinct {
+		value, _ := hashDistinctValue(row, aggr)
+		return value
+	}
+	return findComparableCurrentDistinct(row, aggr)
+}
+
+// hashDistinctValue returns the value of a HashDistinct aggregate that is kept in
+// the hash table, and the collation it is compared with.
+func hashDistinctValue(row []sqltypes.Value, aggr *AggregateParams) (sqltypes.Value, collations.ID) {
+	if aggr.WAssigned {
+		return row[aggr.WCol], collations.Unknown
+	}
+	return row[aggr.KeyCol], aggr.CollationID
+}
+
 func findComparableCurrentDistinct(row []sqltypes.Value, aggr *AggregateParams) sqltypes.Value {
 	curDistinct := row[aggr.KeyCol]
 	if aggr.WAssigned && !curDistinct.IsComparable() {
@@ -394,7 +454,7 @@ func (oa *OrderedAggregate) NeedsTransaction() bool {
 
 func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value) (bool, error) {
 	for _, key := range oa.GroupByKeys {
-		cmp, err := evalengine.NullsafeCompare(row1[key.KeyCol], row2[key.KeyCol])
+		cmp, err := evalengine.NullsafeCompareCollated(row1[key.KeyCol], row2[key.KeyCol], key.CollationID)
 		if err != nil {
 			_, isComparisonErr := err.(evalengine.UnsupportedComparisonError)
 			if !(isComparisonErr && key.WeightStringCol != -1) {
@@ -413,21 +473,20 @@ func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value) (bool, error)
 	return true, nil
 }
 
-func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value, curDistincts []sqltypes.Value) ([]sqltypes.Value, []sqltypes.Value, error) {
+func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value, curDistincts *distinctValues) ([]sqltypes.Value, *distinctValues, error) {
 	result := sqltypes.CopyRow(row1)
 	for index, aggr := range oa.Aggregates {
 		if aggr.isDistinct() {
 			if row2[aggr.KeyCol].IsNull() {
 				continue
 			}
-			cmp, err := evalengine.NullsafeCompare(curDistincts[index], row2[aggr.KeyCol])
+			duplicate, err := curDistincts.isDuplicate(index, aggr, row2)
 			if err != nil {
 				return nil, nil, err
 			}
-			if cmp == 0 {
+			if duplicate {
 				continue
 			}
-			curDistincts[index] = findComparableCurrentDistinct(row2, aggr)
 		}
 		var err error
 		switch aggr.Opcode {
@@ -467,15 +526,162 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 	return result, curDistincts, nil
 }
 
+// distinctValues holds the values of the distinct aggregates that were
+// already aggregated in the current group.
+type distinctValues struct {
+	vcursor VCursor
+	// last is the last value of every distinct aggregate, which is enough
+	// to skip the duplicates when the input is sorted on these values.
+	last []sqltypes.Value
+	// seen holds all the values of the HashDistinct aggregates. The hash
+	// tables are only created once a group has more than one row.
+	seen []*probeTable
+	// Once a hash table holds more than MaxMemoryRows values, the values it
+	// doesn't contain are kept in pending, and written to disk by the spiller
+	// of the aggregate. They are aggregated by mergeSpilled at the end of the group.
+	spillers []*rowSpiller
+	pending  [][][]sqltypes.Value
+}
+
+// isDuplicate returns true if the value of the distinct aggregate in the row
+// was already aggregated in the current group, and remembers it otherwise.
+// The values that are spilled to disk are also reported as duplicates, since
+// they are only aggregated once the whole group was read.
+func (dv *distinctValues) isDuplicate(index int, aggr *AggregateParams, row []sqltypes.Value) (bool, error) {
+	if !aggr.HashDistinct {
+		cmp, err := evalengine.NullsafeCompareCollated(dv.last[index], row[aggr.KeyCol], aggr.CollationID)
+		if err != nil {
+			return false, err
+		}
+		if cmp == 0 {
+			return true, nil
+		}
+		dv.last[index] = findComparableCurrentDistinct(row, aggr)
+		return false, nil
+	}
+
+	if dv.seen == nil {
+		dv.seen = make([]*probeTable, len(dv.last))
+		dv.spillers = make([]*rowSpiller, len(dv.last))
+		dv.pending = make([][][]sqltypes.Value, len(dv.last))
+	}
+	value, collation := hashDistinctValue(row, aggr)
+	pt := dv.seen[index]
+	if pt == nil {
+		pt = newProbeTable([]collations.ID{collation})
+		if first := dv.last[index]; !first.IsNull() {
+			if _, err := pt.exists([]sqltypes.Value{first}); err != nil {
+				return false, err
+			}
+		}
+		dv.seen[index] = pt
+	}
+
+	if dv.spillers[index] == nil {
+		duplicate, err := pt.exists([]sqltypes.Value{value})
+		if err != nil || duplicate {
+			return duplicate, err
+		}
+		if dv.vcursor.SpillDir() != "" && dv.vcursor.ExceedsMaxMemoryRows(pt.rows) {
+			comparers := []*comparer{{orderBy: 0, weightString: -1, collation: collation}}
+			dv.spillers[index], err = newRowSpiller(dv.vcursor, comparers)
+		}
+		return false, err
+	}
+
+	duplicate, err := pt.contains([]sqltypes.Value{value})
+	if err != nil || duplicate {
+		return true, err
+	}
+	// the value to aggregate is kept next to the value it is deduplicated on
+	dv.pending[index] = append(dv.pending[index], []sqltypes.Value{value, row[aggr.Col]})
+	if dv.vcursor.ExceedsMaxMemoryRows(len(dv.pending[index])) {
+		if err := dv.spillers[index].spill(dv.pending[index]); err != nil {
+			return false, err
+		}
+		dv.pending[index] = nil
+	}
+	return true, nil
+}
+
+// close removes the values that were spilled to disk.
+func (dv *distinctValues) close() {
+	if dv == nil {
+		return
+	}
+	for _, spiller := range dv.spillers {
+		spiller.close()
+	}
+	dv.spillers = nil
+	dv.pending = nil
+}
+
+// mergeSpilled aggregates the distinct values that were spilled to disk
+// while the group of the row was read.
+func (oa *OrderedAggregate) mergeSpilled(row []sqltypes.Value, curDistincts *distinctValues) ([]sqltypes.Value, error) {
+	if curDistincts == nil {
+		return row, nil
+	}
+	defer curDistincts.close()
+	for index, spiller := range curDistincts.spillers {
+		if spiller == nil {
+			continue
+		}
+		merger, err := spiller.merge(curDistincts.pending[index])
+		if err != nil {
+			return nil, err
+		}
+		aggr := oa.Aggregates[index]
+		pt := curDistincts.seen[index]
+		var previous []sqltypes.Value
+		for {
+			value, err := merger.next()
+			if err != nil {
+				return nil, err
+			}
+			if value == nil {
+				break
+			}
+			// the values are sorted, so the duplicates follow each other
+			if previous != nil {
+				equal, err := pt.equal(previous[:1], value[:1])
+				if err != nil {
+					return nil, err
+				}
+				if equal {
+					continue
+				}
+			}
+			previous = value
+			switch aggr.Opcode {
+			case AggregateCountDistinct:
+				row[aggr.Col] = evalengine.NullsafeAdd(row[aggr.Col], countOne, OpcodeType[aggr.Opcode])
+			case AggregateSumDistinct:
+				row[aggr.Col] = evalengine.NullsafeAdd(row[aggr.Col], value[1], OpcodeType[aggr.Opcode])
+			}
+		}
+	}
+	return row, nil
+}
+
 // creates the empty row for the case when we are missing grouping keys and have empty input table
 func (oa *OrderedAggregate) createEmptyRow() ([]sqltypes.Value, error) {
-	out := make([]sqltypes.Value, len(oa.Aggregates))
-	for i, aggr := range oa.Aggregates {
+	width := len(oa.Aggregates)
+	for _, aggr := range oa.Aggregates {
+		if aggr.Col >= width {
+			width = aggr.Col + 1
+		}
+	}
+	out := make([]sqltypes.Value, width)
+	for i := range out {
+		out[i] = sqltypes.NULL
+	}
+	for _, aggr := range oa.Aggregates {
 		value, err := createEmptyValueFor(aggr.Opcode)
 		if err != nil {
 			return nil, err
 		}
-		out[i] = value
+		out[aggr.Col] = value
 	}
 	return out, nil
 }
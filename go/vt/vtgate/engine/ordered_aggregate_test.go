@@ -19,11 +19,13 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/test/utils"
 
@@ -69,6 +71,44 @@ func TestOrderedAggregateExecute(t *testing.T) {
 	assert.Equal(wantResult, result)
 }
 
+func TestOrderedAggregateExecuteCollation(t *testing.T) {
+	assert := assert.New(t)
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"varchar|decimal",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"A|1",
+			"b|2",
+			"c|3",
+			"C  |4",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}},
+		GroupByKeys: []*GroupByParams{{KeyCol: 0, WeightStringCol: -1, CollationID: collations.Utf8mb4GeneralCi}},
+		Input:       fp,
+	}
+
+	result, err := oa.TryExecute(&noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"a|2",
+		"b|2",
+		"c|7",
+	)
+	assert.Equal(wantResult, result)
+}
+
 func TestOrderedAggregateExecuteTruncate(t *testing.T) {
 	assert := assert.New(t)
 	fp := &fakePrimitive{
@@ -1050,3 +1090,182 @@ func TestMultiDistinct(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, want, results)
 }
+
+func TestMultiDistinctUnsorted(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"c1|c2|c3",
+		"int64|int64|int64",
+	)
+	// the input is sorted on c1 and c2, but not on c3
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"10|1|3",
+			"10|1|2",
+			"10|2|3",
+			"10|2|1",
+			"10|3|null",
+			"20|null|null",
+			"20|1|5",
+			"30|1|null",
+			"30|2|7",
+			"30|3|7",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		PreProcess: true,
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateCountDistinct,
+			Col:    1,
+			Alias:  "count(distinct c2)",
+		}, {
+			Opcode:       AggregateSumDistinct,
+			Col:          2,
+			Alias:        "sum(distinct c3)",
+			HashDistinct: true,
+		}},
+		GroupByKeys: []*GroupByParams{{KeyCol: 0}},
+		Input:       fp,
+	}
+
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"c1|count(distinct c2)|sum(distinct c3)",
+			"int64|int64|decimal",
+		),
+		`10|3|6`,
+		`20|1|5`,
+		`30|3|7`,
+	)
+
+	qr, err := oa.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, want, qr)
+
+	fp.rewind()
+	results := &sqltypes.Result{}
+	err = oa.TryStreamExecute(&noopVCursor{}, nil, false, func(qr *sqltypes.Result) error {
+		if qr.Fields != nil {
+			results.Fields = qr.Fields
+		}
+		results.Rows = append(results.Rows, qr.Rows...)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, want, results)
+}
+
+func TestOrderedAggregateSpill(t *testing.T) {
+	saveMax := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() {
+		testMaxMemoryRows = saveMax
+	}()
+
+	fields := sqltypes.MakeTestFields(
+		"c1|c2|c3",
+		"int64|int64|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"10|1|3",
+			"10|2|2",
+			"10|1|3",
+			"10|3|1",
+			"10|4|4",
+			"10|2|2",
+			"10|5|5",
+			"10|3|null",
+			"10|6|1",
+			"20|1|5",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		PreProcess: true,
+		Aggregates: []*AggregateParams{{
+			Opcode:       AggregateCountDistinct,
+			Col:          1,
+			Alias:        "count(distinct c2)",
+			HashDistinct: true,
+		}, {
+			Opcode:       AggregateSumDistinct,
+			Col:          2,
+			Alias:        "sum(distinct c3)",
+			HashDistinct: true,
+		}},
+		GroupByKeys: []*GroupByParams{{KeyCol: 0}},
+		Input:       fp,
+	}
+
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"c1|count(distinct c2)|sum(distinct c3)",
+			"int64|int64|decimal",
+		),
+		`10|6|15`,
+		`20|1|5`,
+	)
+
+	spillDir := t.TempDir()
+	vc := &noopVCursor{spillDir: spillDir}
+	qr, err := oa.TryExecute(vc, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, want, qr)
+	require.NotZero(t, vc.spilledBytes)
+
+	fp.rewind()
+	vc = &noopVCursor{spillDir: spillDir}
+	results := &sqltypes.Result{}
+	err = oa.TryStreamExecute(vc, nil, true, func(qr *sqltypes.Result) error {
+		if qr.Fields != nil {
+			results.Fields = qr.Fields
+		}
+		results.Rows = append(results.Rows, qr.Rows...)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, want, results)
+	require.NotZero(t, vc.spilledBytes)
+
+	files, err := os.ReadDir(spillDir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestNoInputAndNoGroupingKeysWithOtherColumns(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2|col3",
+				"int64|int64|int64",
+			),
+			// Empty input table
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateSum,
+			Col:    2,
+		}, {
+			Opcode: AggregateCount,
+			Col:    1,
+		}},
+		Input: fp,
+	}
+
+	result, err := oa.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col3",
+			"int64|int64|int64",
+		),
+		"null|0|null",
+	)
+	assert.Equal(t, wantResult, result)
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"sort"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+const (
+	// PlanCacheSchema is the database of the virtual tables of the plan cache of vtgate.
+	PlanCacheSchema = "vitess_schema"
+	// PlansTable is the virtual table of the plans of the plan cache.
+	PlansTable = "plans"
+	// QueryStatsTable is the virtual table of the execution statistics of the plans of the plan cache.
+	QueryStatsTable = "query_stats"
+)
+
+var planCacheTableFields = map[string][]*querypb.Field{
+	PlansTable: {
+		planCacheField("Query", sqltypes.VarChar),
+		planCacheField("Type", sqltypes.VarChar),
+		planCacheField("Keyspace", sqltypes.VarChar),
+		planCacheField("Table", sqltypes.VarChar),
+		planCacheField("Instructions", sqltypes.VarChar),
+	},
+	QueryStatsTable: {
+		planCacheField("Query", sqltypes.VarChar),
+		planCacheField("ExecCount", sqltypes.Uint64),
+		planCacheField("ExecTime", sqltypes.Float64),
+		planCacheField("ShardQueries", sqltypes.Uint64),
+		planCacheField("RowsAffected", sqltypes.Uint64),
+		planCacheField("RowsReturned", sqltypes.Uint64),
+		planCacheField("Errors", sqltypes.Uint64),
+	},
+}
+
+func planCacheField(name string, typ querypb.Type) *querypb.Field {
+	field := &querypb.Field{
+		Name:  name,
+		Type:  typ,
+		Flags: uint32(querypb.MySqlFlag_NOT_NULL_FLAG),
+	}
+	if sqltypes.IsText(typ) {
+		field.Charset = mysql.CharacterSetUtf8
+	} else {
+		field.Charset = mysql.CharacterSetBinary
+	}
+	if sqltypes.IsUnsigned(typ) {
+		field.Flags |= uint32(querypb.MySqlFlag_UNSIGNED_FLAG)
+	}
+	return field
+}
+
+// PlanCacheTableFields returns the fields of a virtual table of the plan cache,
+// or nil if there is no such table.
+func PlanCacheTableFields(table string) []*querypb.Field {
+	return planCacheTableFields[table]
+}
+
+// PlanCacheCollation returns the collation of the values of a column of a virtual table
+// of the plan cache. The text columns compare like utf8_general_ci, the default collation
+// of their charset, which utf8mb4_general_ci implements.
+func PlanCacheCollation(field *querypb.Field) collations.ID {
+	if sqltypes.IsText(field.Type) {
+		return collations.Utf8mb4GeneralCi
+	}
+	return collations.Unknown
+}
+
+// PlanCacheTableResult returns the rows of a virtual table of the plan cache
+// for the plans returned by forEach, ordered by query.
+func PlanCacheTableResult(table string, forEach func(each func(plan *Plan) bool) error) (*sqltypes.Result, error) {
+	var plans []*Plan
+	err := forEach(func(plan *Plan) bool {
+		plans = append(plans, plan)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].Original < plans[j].Original
+	})
+
+	result := &sqltypes.Result{Fields: PlanCacheTableFields(table)}
+	for _, plan := range plans {
+		switch table {
+		case PlansTable:
+			var keyspace, tableName, instructions string
+			if plan.Instructions != nil {
+				keyspace, tableName = plan.Instructions.GetKeyspaceName(), plan.Instructions.GetTableName()
+				description, _ := json.Marshal(PrimitiveToPlanDescription(plan.Instructions))
+				instructions = string(description)
+			}
+			result.Rows = append(result.Rows, []sqltypes.Value{
+				sqltypes.NewVarChar(plan.Original),
+				sqltypes.NewVarChar(plan.Type.String()),
+				sqltypes.NewVarChar(keyspace),
+				sqltypes.NewVarChar(tableName),
+				sqltypes.NewVarChar(instructions),
+			})
+		case QueryStatsTable:
+			execCount, execTime, shardQueries, rowsAffected, rowsReturned, errors := plan.Stats()
+			result.Rows = append(result.Rows, []sqltypes.Value{
+				sqltypes.NewVarChar(plan.Original),
+				sqltypes.NewUint64(execCount),
+				sqltypes.NewFloat64(execTime.Seconds()),
+				sqltypes.NewUint64(shardQueries),
+				sqltypes.NewUint64(rowsAffected),
+				sqltypes.NewUint64(rowsReturned),
+				sqltypes.NewUint64(errors),
+			})
+		}
+	}
+	return result, nil
+}
+
+var _ Primitive = (*PlanCacheTable)(nil)
+
+// PlanCacheTable returns the rows of a virtual table of the plan cache of vtgate.
+type PlanCacheTable struct {
+	Table string
+
+	noInputs
+	noTxNeeded
+}
+
+// RouteType implements the Primitive interface
+func (p *PlanCacheTable) RouteType() string {
+	return "PlanCacheTable"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (p *PlanCacheTable) GetKeyspaceName() string {
+	return ""
+}
+
+// GetTableName implements the Primitive interface
+func (p *PlanCacheTable) GetTableName() string {
+	return p.Table
+}
+
+// TryExecute implements the Primitive interface
+func (p *PlanCacheTable) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	return PlanCacheTableResult(p.Table, vcursor.ForEachCachedPlan)
+}
+
+// TryStreamExecute implements the Primitive interface
+func (p *PlanCacheTable) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := p.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (p *PlanCacheTable) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: PlanCacheTableFields(p.Table)}, nil
+}
+
+func (p *PlanCacheTable) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "PlanCacheTable",
+		Other:        map[string]interface{}{"Table": p.Table},
+	}
+}
+
+var _ Primitive = (*ResetQueryStats)(nil)
+
+// ResetQueryStats resets the execution statistics of the plans of the plan cache of vtgate.
+type ResetQueryStats struct {
+	noInputs
+	noTxNeeded
+}
+
+// RouteType implements the Primitive interface
+func (r *ResetQueryStats) RouteType() string {
+	return "ResetQueryStats"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (r *ResetQueryStats) GetKeyspaceName() string {
+	return ""
+}
+
+// GetTableName implements the Primitive interface
+func (r *ResetQueryStats) GetTableName() string {
+	return QueryStatsTable
+}
+
+// TryExecute implements the Primitive interface
+func (r *ResetQueryStats) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	err := vcursor.ForEachCachedPlan(func(plan *Plan) bool {
+		plan.ResetStats()
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// TryStreamExecute implements the Primitive interface
+func (r *ResetQueryStats) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := r.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (r *ResetQueryStats) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{}, nil
+}
+
+func (r *ResetQueryStats) description() PrimitiveDescription {
+	return PrimitiveDescription{OperatorType: "ResetQueryStats"}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+type planCacheVCursor struct {
+	noopVCursor
+	plans []*Plan
+}
+
+func (vc *planCacheVCursor) ForEachCachedPlan(each func(plan *Plan) bool) error {
+	for _, plan := range vc.plans {
+		if !each(plan) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func TestPlanCacheTable(t *testing.T) {
+	vc := &planCacheVCursor{plans: []*Plan{{
+		Type:         sqlparser.StmtSelect,
+		Original:     "select 2",
+		Instructions: &Rows{},
+	}, {
+		Type:     sqlparser.StmtInsert,
+		Original: "insert 1",
+	}}}
+	vc.plans[0].AddStats(2, time.Second, 4, 0, 6, 1)
+
+	qr, err := (&PlanCacheTable{Table: PlansTable}).TryExecute(vc, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, PlanCacheTableFields(PlansTable), qr.Fields)
+	assert.Equal(t, `[[VARCHAR("insert 1") VARCHAR("INSERT") VARCHAR("") VARCHAR("") VARCHAR("")] `+
+		`[VARCHAR("select 2") VARCHAR("SELECT") VARCHAR("") VARCHAR("") VARCHAR("{\"OperatorType\":\"Rows\"}")]]`, fmt.Sprintf("%v", qr.Rows))
+
+	qr, err = (&PlanCacheTable{Table: QueryStatsTable}).TryExecute(vc, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, `[[VARCHAR("insert 1") UINT64(0) FLOAT64(0) UINT64(0) UINT64(0) UINT64(0) UINT64(0)] `+
+		`[VARCHAR("select 2") UINT64(2) FLOAT64(1) UINT64(4) UINT64(0) UINT64(6) UINT64(1)]]`, fmt.Sprintf("%v", qr.Rows))
+
+	_, err = (&ResetQueryStats{}).TryExecute(vc, nil, true)
+	require.NoError(t, err)
+	execCount, execTime, shardQueries, rowsAffected, rowsReturned, errors := vc.plans[0].Stats()
+	assert.Zero(t, execCount+shardQueries+rowsAffected+rowsReturned+errors)
+	assert.Zero(t, execTime)
+}
@@ -61,6 +61,15 @@ type (
 		// if the max memory rows override directive is set to true
 		ExceedsMaxMemoryRows(numRows int) bool
 
+		// SpillDir returns the directory in which the primitives can write the rows
+		// exceeding the maxMemoryRows value to temporary files. Returns an empty
+		// string if spilling to disk is disabled.
+		SpillDir() string
+
+		// RecordSpill records the number of bytes written to the spill directory,
+		// and returns an error if the query exceeds its disk quota.
+		RecordSpill(numBytes int64) error
+
 		// SetContextTimeout updates the context and sets a timeout.
 		SetContextTimeout(timeout time.Duration) context.CancelFunc
 
@@ -101,6 +110,14 @@ type (
 
 		FindRoutedTable(tablename sqlparser.TableName) (*vindexes.Table, error)
 
+		// SequenceCache returns the cache of sequence values of vtgate,
+		// or nil if sequence values are not cached.
+		SequenceCache() *SequenceCache
+
+		// ForEachCachedPlan calls each for the plans of the plan cache of vtgate,
+		// until it returns false. It fails if the caller cannot access the plan cache.
+		ForEachCachedPlan(each func(plan *Plan) bool) error
+
 		// GetDBDDLPlugin gets the configured plugin for DROP/CREATE DATABASE
 		GetDBDDLPluginName() string
 
@@ -129,6 +146,9 @@ type (
 		// InReservedConn provides whether this session is using reserved connection
 		InReservedConn() bool
 
+		// InTransaction returns true if the session is in a transaction
+		InTransaction() bool
+
 		// ShardSession returns shard info about open connections
 		ShardSession() []*srvtopo.ResolvedShard
 
@@ -137,6 +157,8 @@ type (
 		SetSkipQueryPlanCache(bool) error
 		SetSQLSelectLimit(int64) error
 		SetTransactionMode(vtgatepb.TransactionMode)
+		// GetTransactionMode returns the transaction mode in effect for the session
+		GetTransactionMode() vtgatepb.TransactionMode
 		SetWorkload(querypb.ExecuteOptions_Workload)
 		SetPlannerVersion(querypb.ExecuteOptions_PlannerVersion)
 		SetFoundRows(uint64)
@@ -171,6 +193,9 @@ type (
 		BindVarNeeds *sqlparser.BindVarNeeds // Stores BindVars needed to be provided as part of expression rewriting
 		Warnings     []*querypb.QueryWarning // Warnings that need to be yielded every time this query runs
 
+		ResultCacheTTL    time.Duration // ResultCacheTTL is how long the results of the query can be cached, 0 if they cannot.
+		ResultCacheTables []string      // ResultCacheTables are the keyspace.table read by the query, whose changes invalidate its results.
+
 		ExecCount    uint64 // Count of times this plan was executed
 		ExecTime     uint64 // Total execution time
 		ShardQueries uint64 // Total number of shard queries
@@ -241,6 +266,16 @@ func (p *Plan) Stats() (execCount uint64, execTime time.Duration, shardQueries,
 	return
 }
 
+// ResetStats resets the plan execution statistics
+func (p *Plan) ResetStats() {
+	atomic.StoreUint64(&p.ExecCount, 0)
+	atomic.StoreUint64(&p.ExecTime, 0)
+	atomic.StoreUint64(&p.ShardQueries, 0)
+	atomic.StoreUint64(&p.RowsAffected, 0)
+	atomic.StoreUint64(&p.RowsReturned, 0)
+	atomic.StoreUint64(&p.Errors, 0)
+}
+
 // Find will return the first Primitive that matches the evaluate function. If no match is found, nil will be returned
 func Find(isMatch Match, start Primitive) Primitive {
 	if isMatch(start) {
@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/key"
@@ -74,8 +75,9 @@ type Route struct {
 	FieldQuery string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 	// Values specifies the vindex values to use for routing.
+	// For a multi-column vindex, they are the values of its first columns.
 	Values []sqltypes.PlanValue
 
 	// OrderBy specifies the key order for merge sorting. This will be
@@ -83,6 +85,13 @@ type Route struct {
 	// merge-sorted.
 	OrderBy []OrderByParams
 
+	// OrderedByVindex is set when the rows are ordered first by the column
+	// of a vindexes.Ordered vindex, so every shard holds a distinct range of
+	// values. Below a LIMIT, the shards are then read one after the other in
+	// the order of their key ranges, until the limit is reached, instead of
+	// reading all of them and merge-sorting their results.
+	OrderedByVindex bool
+
 	// TruncateColumnCount specifies the number of columns to return
 	// in the final result. Rest of the columns are truncated
 	// from the result received. If 0, no truncation happens.
@@ -134,6 +143,9 @@ type OrderByParams struct {
 	StarColFixedIndex int
 	// v3 specific boolean. Used to also add weight strings originating from GroupBys to the Group by clause
 	FromGroupBy bool
+	// CollationID is the collation used to compare the text values of the column in vtgate.
+	// It is collations.Unknown when the column is not text, or when its collation is not known.
+	CollationID collations.ID
 }
 
 // String returns a string. Used for plan descriptions
@@ -150,6 +162,9 @@ func (obp OrderByParams) String() string {
 	} else {
 		val += " ASC"
 	}
+	if obp.CollationID != collations.Unknown {
+		val += " COLLATE " + obp.CollationID.Name()
+	}
 	return val
 }
 
@@ -190,6 +205,11 @@ const (
 	SelectReference
 	// SelectNone is used for queries that always return empty values
 	SelectNone
+	// SelectRange is for routing a query using a range
+	// predicate on an ordered vindex. Requires: A Vindex
+	// that is vindexes.Ordered, and the start and end
+	// Values of the range, NULL if it is open on that side.
+	SelectRange
 	// NumRouteOpcodes is the number of opcodes
 	NumRouteOpcodes
 )
@@ -205,6 +225,7 @@ var routeName = map[RouteOpcode]string{
 	SelectDBA:         "SelectDBA",
 	SelectReference:   "SelectReference",
 	SelectNone:        "SelectNone",
+	SelectRange:       "SelectRange",
 }
 
 var (
@@ -272,6 +293,8 @@ func (route *Route) executeInternal(vcursor VCursor, bindVars map[string]*queryp
 		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
 	case SelectMultiEqual:
 		rss, bvs, err = route.paramsSelectMultiEqual(vcursor, bindVars)
+	case SelectRange:
+		rss, bvs, err = route.paramsSelectRange(vcursor, bindVars)
 	case SelectNone:
 		rss, bvs, err = nil, nil, nil
 	default:
@@ -290,6 +313,10 @@ func (route *Route) executeInternal(vcursor VCursor, bindVars map[string]*queryp
 		return &sqltypes.Result{}, nil
 	}
 
+	if limit, ok := route.shardOrderLimit(rss, bvs, bindVars); ok {
+		return route.executeInShardOrder(vcursor, rss, bvs, limit)
+	}
+
 	queries := getQueries(route.Query, bvs)
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, false /* rollbackOnError */, false /* autocommit */)
 
@@ -346,6 +373,8 @@ func (route *Route) TryStreamExecute(vcursor VCursor, bindVars map[string]*query
 		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
 	case SelectMultiEqual:
 		rss, bvs, err = route.paramsSelectMultiEqual(vcursor, bindVars)
+	case SelectRange:
+		rss, bvs, err = route.paramsSelectRange(vcursor, bindVars)
 	case SelectNone:
 		rss, bvs, err = nil, nil, nil
 	default:
@@ -384,10 +413,106 @@ func (route *Route) TryStreamExecute(vcursor VCursor, bindVars map[string]*query
 		return nil
 	}
 
+	if _, ok := route.shardOrderLimit(rss, bvs, bindVars); ok {
+		return route.streamInShardOrder(vcursor, rss, bvs, callback)
+	}
+
 	// There is an order by. We have to merge-sort.
 	return route.mergeSort(vcursor, bindVars, wantfields, callback, rss, bvs)
 }
 
+// shardOrderLimit returns the LIMIT the route is executed for if its shards can be read in
+// the order of their key ranges, see OrderedByVindex. In that case, rss and bvs are sorted.
+func (route *Route) shardOrderLimit(rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable, bindVars map[string]*querypb.BindVariable) (int64, bool) {
+	if !route.OrderedByVindex || route.ScatterErrorsAsWarnings || len(rss) < 2 {
+		return 0, false
+	}
+	upperLimit, ok := bindVars["__upper_limit"]
+	if !ok {
+		return 0, false
+	}
+	value, err := sqltypes.BindVariableToValue(upperLimit)
+	if err != nil {
+		return 0, false
+	}
+	limit, err := evalengine.ToInt64(value)
+	if err != nil {
+		return 0, false
+	}
+	keyRanges := make([]*topodatapb.KeyRange, len(rss))
+	for i, rs := range rss {
+		spec, err := key.ParseShardingSpec(rs.Target.Shard)
+		if err != nil || len(spec) != 1 {
+			return 0, false
+		}
+		keyRanges[i] = spec[0]
+	}
+	sort.Sort(shardsByKeyRange{rss: rss, bvs: bvs, keyRanges: keyRanges, desc: route.OrderBy[0].Desc})
+	return limit, true
+}
+
+type shardsByKeyRange struct {
+	rss       []*srvtopo.ResolvedShard
+	bvs       []map[string]*querypb.BindVariable
+	keyRanges []*topodatapb.KeyRange
+	desc      bool
+}
+
+func (s shardsByKeyRange) Len() int { return len(s.rss) }
+
+func (s shardsByKeyRange) Less(i, j int) bool {
+	if s.desc {
+		i, j = j, i
+	}
+	return key.KeyRangeStartSmaller(s.keyRanges[i], s.keyRanges[j])
+}
+
+func (s shardsByKeyRange) Swap(i, j int) {
+	s.rss[i], s.rss[j] = s.rss[j], s.rss[i]
+	s.bvs[i], s.bvs[j] = s.bvs[j], s.bvs[i]
+	s.keyRanges[i], s.keyRanges[j] = s.keyRanges[j], s.keyRanges[i]
+}
+
+// executeInShardOrder reads the shards one after the other until the limit is reached.
+// The rows of every shard are ordered by the query, and come before the rows of the
+// next shards, so the result doesn't need to be sorted.
+func (route *Route) executeInShardOrder(vcursor VCursor, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable, limit int64) (*sqltypes.Result, error) {
+	queries := getQueries(route.Query, bvs)
+	result := &sqltypes.Result{}
+	for i := range rss {
+		qr, errs := vcursor.ExecuteMultiShard(rss[i:i+1], queries[i:i+1], false /* rollbackOnError */, false /* autocommit */)
+		if errs = filterOutNilErrors(errs); errs != nil {
+			return nil, vterrors.Aggregate(errs)
+		}
+		result.AppendResult(qr)
+		if int64(len(result.Rows)) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+// streamInShardOrder streams the shards one after the other, until the callback stops the stream.
+func (route *Route) streamInShardOrder(vcursor VCursor, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error) error {
+	sentFields := false
+	for i := range rss {
+		errs := vcursor.StreamExecuteMulti(route.Query, rss[i:i+1], bvs[i:i+1], func(qr *sqltypes.Result) error {
+			if qr.Fields != nil {
+				if sentFields {
+					qr = &sqltypes.Result{Rows: qr.Rows}
+				}
+				sentFields = true
+			}
+			return callback(qr.Truncate(route.TruncateColumnCount))
+		})
+		if errs = filterOutNilErrors(errs); len(errs) > 0 {
+			// the callback returns io.EOF once it has all the rows it needs
+			return errs[0]
+		}
+	}
+	return nil
+}
+
 func (route *Route) mergeSort(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) error {
 	prims := make([]StreamExecutor, 0, len(rss))
 	for i, rs := range rss {
@@ -581,11 +706,18 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 }
 
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
-	key, err := route.Values[0].ResolveValue(bindVars)
-	if err != nil {
-		return nil, nil, err
+	var rss []*srvtopo.ResolvedShard
+	var err error
+	if multiCol, isMultiCol := route.Vindex.(vindexes.MultiColumn); isMultiCol {
+		rss, err = route.resolveMultiColShards(vcursor, multiCol, bindVars)
+	} else {
+		var key sqltypes.Value
+		key, err = route.Values[0].ResolveValue(bindVars)
+		if err != nil {
+			return nil, nil, err
+		}
+		rss, _, err = resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
 	}
-	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -624,7 +756,59 @@ func (route *Route) paramsSelectMultiEqual(vcursor VCursor, bindVars map[string]
 	return rss, multiBindVars, nil
 }
 
-func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+func (route *Route) paramsSelectRange(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	ordered, ok := route.Vindex.(vindexes.Ordered)
+	if !ok {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] vindex %s cannot be used to route a range", route.Vindex.String())
+	}
+	start, err := route.Values[0].ResolveValue(bindVars)
+	if err != nil {
+		return nil, nil, err
+	}
+	end, err := route.Values[1].ResolveValue(bindVars)
+	if err != nil {
+		return nil, nil, err
+	}
+	destination, err := ordered.RangeMap(vcursor, start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{destination})
+	if err != nil {
+		return nil, nil, err
+	}
+	multiBindVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range multiBindVars {
+		multiBindVars[i] = bindVars
+	}
+	return rss, multiBindVars, nil
+}
+
+// resolveMultiColShards resolves the shards of the values of all the columns of a multi-column
+// vindex, or of its first columns if the vindex can map a prefix of its columns.
+func (route *Route) resolveMultiColShards(vcursor VCursor, vindex vindexes.MultiColumn, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, error) {
+	colValues := make([]sqltypes.Value, len(route.Values))
+	for i, value := range route.Values {
+		var err error
+		colValues[i], err = value.ResolveValue(bindVars)
+		if err != nil {
+			return nil, err
+		}
+	}
+	destinations, err := vindex.Map(vcursor, [][]sqltypes.Value{colValues})
+	if err != nil {
+		return nil, err
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, destinations)
+	return rss, err
+}
+
+func resolveShards(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	single, ok := vindex.(vindexes.SingleColumn)
+	if !ok {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] multi-column vindex %s cannot be used to route a list of values", vindex.String())
+	}
+
 	// Convert vindexKeys to []*querypb.Value
 	ids := make([]*querypb.Value, len(vindexKeys))
 	for i, vik := range vindexKeys {
@@ -632,7 +816,7 @@ func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vind
 	}
 
 	// Map using the Vindex
-	destinations, err := vindex.Map(vcursor, vindexKeys)
+	destinations, err := single.Map(vcursor, vindexKeys)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -817,6 +1001,9 @@ func (route *Route) description() PrimitiveDescription {
 	if route.TruncateColumnCount > 0 {
 		other["ResultColumns"] = route.TruncateColumnCount
 	}
+	if route.OrderedByVindex {
+		other["OrderedByVindex"] = true
+	}
 	if route.ScatterErrorsAsWarnings {
 		other["ScatterErrorsAsWarnings"] = true
 	}
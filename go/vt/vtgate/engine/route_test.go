@@ -580,6 +580,101 @@ func TestSelectMultiEqual(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectRange(t *testing.T) {
+	vindex, _ := vindexes.NewNumeric("", nil)
+	sel := NewRoute(
+		SelectRange,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []sqltypes.PlanValue{
+		{Value: sqltypes.NewInt64(1)},
+		{Key: "end"},
+	}
+	bv := map[string]*querypb.BindVariable{"end": sqltypes.Int64BindVariable(2)}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"-20"},
+		results:      []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.TryExecute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(0000000000000001-000000000000000200)`,
+		`ExecuteMultiShard ks.-20: dummy_select {end: type:INT64 value:"2"} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	vc.Rewind()
+	result, err = wrapStreamExecute(sel, vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(0000000000000001-000000000000000200)`,
+		`StreamExecuteMulti dummy_select ks.-20: {end: type:INT64 value:"2"} `,
+	})
+	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
+
+	// an empty range doesn't go to any shard
+	vc.Rewind()
+	sel.Values[0] = sqltypes.PlanValue{Value: sqltypes.NewInt64(3)}
+	result, err = sel.TryExecute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationNone()`,
+	})
+	expectResult(t, "sel.Execute", result, &sqltypes.Result{})
+}
+
+func TestSelectEqualMultiCol(t *testing.T) {
+	vindex, _ := vindexes.NewMultiCol("", map[string]string{"column_count": "2"})
+	sel := NewRoute(
+		SelectEqualUnique,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex
+	sel.Values = []sqltypes.PlanValue{
+		{Value: sqltypes.NewInt64(1)},
+		{Key: "user_id"},
+	}
+	bv := map[string]*querypb.BindVariable{"user_id": sqltypes.Int64BindVariable(1)}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"-20"},
+		results:      []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.TryExecute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(166b40b4166b40b4)`,
+		`ExecuteMultiShard ks.-20: dummy_select {user_id: type:INT64 value:"1"} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	// the value of the first column routes to its keyspace range
+	vc.Rewind()
+	sel.Opcode = SelectEqual
+	sel.Values = sel.Values[:1]
+	result, err = wrapStreamExecute(sel, vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(166b40b4-166b40b5)`,
+		`StreamExecuteMulti dummy_select ks.-20: {user_id: type:INT64 value:"1"} `,
+	})
+	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
+}
+
 func TestSelectLike(t *testing.T) {
 	subshard, _ := vindexes.NewCFC("cfc", map[string]string{"hash": "md5", "offsets": "[1,2]"})
 	vindex := subshard.(*vindexes.CFC).PrefixVindex()
@@ -1030,6 +1125,70 @@ func TestRouteSortTruncate(t *testing.T) {
 	expectResult(t, "sel.Execute", result, wantResult)
 }
 
+func TestRouteOrderedByVindex(t *testing.T) {
+	sel := NewRoute(
+		SelectScatter,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.OrderBy = []OrderByParams{{
+		Col:             0,
+		WeightStringCol: -1,
+		Desc:            true,
+	}}
+	sel.OrderedByVindex = true
+	limit := &Limit{
+		Count: sqltypes.PlanValue{Value: sqltypes.NewInt64(3)},
+		Input: sel,
+	}
+
+	fields := sqltypes.MakeTestFields(
+		"id",
+		"int64",
+	)
+	vc := &loggingVCursor{
+		shards: []string{"-40", "40-80", "80-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "9", "8"),
+			sqltypes.MakeTestResult(fields, "7", "6"),
+		},
+	}
+	result, err := limit.TryExecute(vc, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// the shards are read in the order of the rows, until the limit is reached
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.80-: dummy_select {__upper_limit: type:INT64 value:"3"} false false`,
+		`ExecuteMultiShard ks.40-80: dummy_select {__upper_limit: type:INT64 value:"3"} false false`,
+	})
+	expectResult(t, "sel.Execute", result, sqltypes.MakeTestResult(fields, "9", "8", "7"))
+
+	vc.Rewind()
+	result, err = wrapStreamExecute(limit, vc, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`StreamExecuteMulti dummy_select ks.80-: {__upper_limit: type:INT64 value:"3"} `,
+		`StreamExecuteMulti dummy_select ks.40-80: {__upper_limit: type:INT64 value:"3"} `,
+	})
+	expectResult(t, "sel.StreamExecute", result, sqltypes.MakeTestResult(fields, "9", "8", "7"))
+
+	// without a limit, all the shards are read at once
+	vc.Rewind()
+	vc.results = []*sqltypes.Result{sqltypes.MakeTestResult(fields, "1", "9", "5")}
+	result, err = sel.TryExecute(vc, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.-40: dummy_select {} ks.40-80: dummy_select {} ks.80-: dummy_select {} false false`,
+	})
+	expectResult(t, "sel.Execute", result, sqltypes.MakeTestResult(fields, "9", "5", "1"))
+}
+
 func TestRouteStreamTruncate(t *testing.T) {
 	sel := NewRoute(
 		SelectUnsharded,
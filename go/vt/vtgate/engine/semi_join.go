@@ -0,0 +1,353 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*SemiJoin)(nil)
+
+// SemiJoin filters the rows of its left input using a correlated subquery.
+// For every left row, the right input is executed with the join variables
+// of that row. A semi-join keeps the rows for which the right input returns
+// at least one row (EXISTS), an anti-join keeps the rows for which it returns
+// nothing (NOT EXISTS).
+type SemiJoin struct {
+	Opcode SemiJoinOpcode
+	// Left and Right are the LHS and RHS primitives
+	// of the SemiJoin. They can be any primitive.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left
+	// results should be used to build the
+	// return result.
+	Cols []int `json:",omitempty"`
+
+	// Vars defines the list of joinVars that need to
+	// be built from the LHS result before invoking
+	// the RHS subquery.
+	Vars map[string]int `json:",omitempty"`
+
+	// ListVars is used instead of Vars when the subquery only compares
+	// the LHS columns for equality. Every list bind variable holds the
+	// distinct values of its LHS column for a batch of LHS rows, and the
+	// RHS runs once per batch. The RHS returns the values it matched,
+	// ordered by the name of their list bind variable, so that the LHS
+	// rows can be matched back.
+	ListVars map[string]int `json:",omitempty"`
+}
+
+// semiJoinBatchSize is the maximum number of distinct LHS values
+// sent to the RHS in a list bind variable.
+var semiJoinBatchSize = 500
+
+// SemiJoinOpcode is a number representing the opcode
+// for the SemiJoin primitive.
+type SemiJoinOpcode int
+
+// This is the list of SemiJoinOpcode values.
+const (
+	Semi = SemiJoinOpcode(iota)
+	Anti
+)
+
+func (code SemiJoinOpcode) String() string {
+	if code == Semi {
+		return "SemiJoin"
+	}
+	return "AntiJoin"
+}
+
+// MarshalJSON serializes the SemiJoinOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code SemiJoinOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// TryExecute performs a non-streaming exec.
+func (sj *SemiJoin) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := vcursor.ExecutePrimitive(sj.Left, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sj.filter(vcursor, bindVars, lresult.Rows, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{Rows: rows}
+	if wantfields {
+		result.Fields = projectFields(lresult.Fields, sj.Cols)
+	}
+	return result, nil
+}
+
+// TryStreamExecute performs a streaming exec.
+func (sj *SemiJoin) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	matches := map[string]bool{}
+	return vcursor.StreamExecutePrimitive(sj.Left, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		rows, err := sj.filter(vcursor, bindVars, lresult.Rows, matches)
+		if err != nil {
+			return err
+		}
+		result := &sqltypes.Result{Rows: rows}
+		if lresult.Fields != nil {
+			result.Fields = projectFields(lresult.Fields, sj.Cols)
+		}
+		return callback(result)
+	})
+}
+
+// filter returns the projected left rows that are kept by the semi-join.
+// The outcome of the subquery only depends on the join variables, so it is
+// remembered in matches and the right side only runs once for every distinct
+// set of values, or once for every batch of them when ListVars are used.
+func (sj *SemiJoin) filter(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lrows [][]sqltypes.Value, matches map[string]bool) ([][]sqltypes.Value, error) {
+	if len(sj.ListVars) > 0 {
+		return sj.filterBatched(vcursor, bindVars, lrows, matches)
+	}
+	varNames := sortedVarNames(sj.Vars)
+
+	var rows [][]sqltypes.Value
+	joinVars := make(map[string]*querypb.BindVariable, len(sj.Vars))
+	for _, lrow := range lrows {
+		values := joinValues(lrow, sj.Vars, varNames)
+		for i, k := range varNames {
+			joinVars[k] = sqltypes.ValueBindVariable(values[i])
+		}
+		key := semiJoinKey(values)
+		matched, ok := matches[key]
+		if !ok {
+			rresult, err := vcursor.ExecutePrimitive(sj.Right, combineVars(bindVars, joinVars), false)
+			if err != nil {
+				return nil, err
+			}
+			matched = len(rresult.Rows) > 0
+			matches[key] = matched
+		}
+		if matched == (sj.Opcode == Semi) {
+			rows = append(rows, projectRow(lrow, sj.Cols))
+		}
+	}
+	return rows, nil
+}
+
+// filterBatched is filter for ListVars: the values of the left rows whose outcome
+// is not known yet are sent to the right side in batches of semiJoinBatchSize.
+func (sj *SemiJoin) filterBatched(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lrows [][]sqltypes.Value, matches map[string]bool) ([][]sqltypes.Value, error) {
+	varNames := sortedVarNames(sj.ListVars)
+
+	var pending [][]sqltypes.Value
+	for _, lrow := range lrows {
+		values := joinValues(lrow, sj.ListVars, varNames)
+		key := semiJoinKey(values)
+		if _, ok := matches[key]; ok {
+			continue
+		}
+		// NULL is never equal to anything, there is no need to ask the right side
+		matches[key] = false
+		if !hasNull(values) {
+			pending = append(pending, values)
+		}
+	}
+
+	for len(pending) > 0 {
+		batch := pending
+		if len(batch) > semiJoinBatchSize {
+			batch = batch[:semiJoinBatchSize]
+		}
+		pending = pending[len(batch):]
+		if err := sj.matchBatch(vcursor, bindVars, varNames, batch, matches); err != nil {
+			return nil, err
+		}
+	}
+
+	var rows [][]sqltypes.Value
+	for _, lrow := range lrows {
+		if matches[semiJoinKey(joinValues(lrow, sj.ListVars, varNames))] == (sj.Opcode == Semi) {
+			rows = append(rows, projectRow(lrow, sj.Cols))
+		}
+	}
+	return rows, nil
+}
+
+// matchBatch runs the right side once for the given batch of values,
+// and records in matches the values it returned a row for.
+func (sj *SemiJoin) matchBatch(vcursor VCursor, bindVars map[string]*querypb.BindVariable, varNames []string, batch [][]sqltypes.Value, matches map[string]bool) error {
+	joinVars := make(map[string]*querypb.BindVariable, len(varNames))
+	for i, k := range varNames {
+		list := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+		seen := map[string]bool{}
+		for _, values := range batch {
+			if key := semiJoinKey(values[i : i+1]); !seen[key] {
+				seen[key] = true
+				list.Values = append(list.Values, sqltypes.ValueToProto(values[i]))
+			}
+		}
+		joinVars[k] = list
+	}
+	rresult, err := vcursor.ExecutePrimitive(sj.Right, combineVars(bindVars, joinVars), true)
+	if err != nil {
+		return err
+	}
+
+	// The right side compared the values with the collation of its columns, the rows
+	// it returned are matched back to the values the same way. A batch is at most
+	// semiJoinBatchSize distinct values, and the right side returns distinct rows.
+	colls := make([]collations.ID, len(varNames))
+	for i := range colls {
+		colls[i] = collations.Unknown
+		if i < len(rresult.Fields) && sqltypes.IsText(rresult.Fields[i].Type) {
+			colls[i] = collations.ID(rresult.Fields[i].Charset)
+		}
+	}
+	for _, values := range batch {
+		for _, rrow := range rresult.Rows {
+			matched, err := semiJoinMatch(values, rrow, colls)
+			if err != nil {
+				return err
+			}
+			if matched {
+				matches[semiJoinKey(values)] = true
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func semiJoinMatch(values, rrow []sqltypes.Value, colls []collations.ID) (bool, error) {
+	for i, value := range values {
+		cmp, err := evalengine.NullsafeCompareCollated(value, rrow[i], colls[i])
+		if err != nil || cmp != 0 {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func sortedVarNames(vars map[string]int) []string {
+	varNames := make([]string, 0, len(vars))
+	for k := range vars {
+		varNames = append(varNames, k)
+	}
+	sort.Strings(varNames)
+	return varNames
+}
+
+func joinValues(lrow []sqltypes.Value, vars map[string]int, varNames []string) []sqltypes.Value {
+	values := make([]sqltypes.Value, len(varNames))
+	for i, k := range varNames {
+		values[i] = lrow[vars[k]]
+	}
+	return values
+}
+
+func semiJoinKey(values []sqltypes.Value) string {
+	var key strings.Builder
+	for _, val := range values {
+		fmt.Fprintf(&key, "%d:%s,", len(val.Raw()), val.String())
+	}
+	return key.String()
+}
+
+func hasNull(values []sqltypes.Value) bool {
+	for _, val := range values {
+		if val.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// GetFields fetches the field info.
+func (sj *SemiJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := sj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: projectFields(lresult.Fields, sj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this semi-join
+func (sj *SemiJoin) Inputs() []Primitive {
+	return []Primitive{sj.Left, sj.Right}
+}
+
+func projectFields(fields []*querypb.Field, cols []int) []*querypb.Field {
+	result := make([]*querypb.Field, len(cols))
+	for i, col := range cols {
+		result[i] = fields[col]
+	}
+	return result
+}
+
+func projectRow(row []sqltypes.Value, cols []int) []sqltypes.Value {
+	result := make([]sqltypes.Value, len(cols))
+	for i, col := range cols {
+		result[i] = row[col]
+	}
+	return result
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (sj *SemiJoin) RouteType() string {
+	return sj.Opcode.String()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (sj *SemiJoin) GetKeyspaceName() string {
+	if sj.Left.GetKeyspaceName() == sj.Right.GetKeyspaceName() {
+		return sj.Left.GetKeyspaceName()
+	}
+	return sj.Left.GetKeyspaceName() + "_" + sj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (sj *SemiJoin) GetTableName() string {
+	return sj.Left.GetTableName() + "_" + sj.Right.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (sj *SemiJoin) NeedsTransaction() bool {
+	return sj.Right.NeedsTransaction() || sj.Left.NeedsTransaction()
+}
+
+func (sj *SemiJoin) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":        sj.GetTableName(),
+		"ProjectedIndexes": strings.Trim(strings.Join(strings.Fields(fmt.Sprint(sj.Cols)), ","), "[]"),
+	}
+	if len(sj.Vars) > 0 {
+		other["JoinVars"] = orderedStringIntMap(sj.Vars)
+	}
+	if len(sj.ListVars) > 0 {
+		other["ListVars"] = orderedStringIntMap(sj.ListVars)
+	}
+	return PrimitiveDescription{
+		OperatorType: "SemiJoin",
+		Variant:      sj.Opcode.String(),
+		Other:        other,
+	}
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestSemiJoinExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varchar",
+				),
+				"1|a|aa",
+				"2|b|bb",
+				"3|a|cc",
+				"4|c|dd",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col4",
+		"int64",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"4",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"5",
+			),
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	sj := &SemiJoin{
+		Opcode: Semi,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0, 2},
+		Vars: map[string]int{
+			"bv": 1,
+		},
+	}
+	r, err := sj.TryExecute(&noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" true`,
+	})
+	// the subquery is only executed once for every distinct value of the join vars
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" bv: type:VARCHAR value:"a" false`,
+		`Execute a: type:INT64 value:"10" bv: type:VARCHAR value:"b" false`,
+		`Execute a: type:INT64 value:"10" bv: type:VARCHAR value:"c" false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col3",
+			"int64|varchar",
+		),
+		"1|aa",
+		"3|cc",
+		"4|dd",
+	))
+
+	// Anti join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = Anti
+	r, err = sj.TryExecute(&noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col3",
+			"int64|varchar",
+		),
+		"2|bb",
+	))
+
+	// Right side error
+	leftPrim.rewind()
+	sj.Right = &fakePrimitive{
+		results: []*sqltypes.Result{nil},
+		sendErr: errors.New("right err"),
+	}
+	_, err = sj.TryExecute(&noopVCursor{}, bv, true)
+	require.EqualError(t, err, "right err")
+}
+
+func TestSemiJoinStreamExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varchar",
+				),
+				"1|a|aa",
+				"2|b|bb",
+				"3|a|cc",
+				"4|b|dd",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col4",
+		"int64",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"4",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+		},
+	}
+
+	sj := &SemiJoin{
+		Opcode: Semi,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0},
+		Vars: map[string]int{
+			"bv": 1,
+		},
+	}
+	r, err := wrapStreamExecute(sj, &noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// the outcome of the subquery is remembered across the streamed results
+	rightPrim.ExpectLog(t, []string{
+		`Execute bv: type:VARCHAR value:"a" false`,
+		`Execute bv: type:VARCHAR value:"b" false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+		"1",
+		"3",
+	))
+}
+
+func TestSemiJoinExecuteBatched(t *testing.T) {
+	defer func(size int) { semiJoinBatchSize = size }(semiJoinBatchSize)
+	semiJoinBatchSize = 2
+
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|a",
+				"4|null",
+				"5|c",
+				"6|d",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3",
+		"varchar",
+	)
+	// utf8mb4_general_ci
+	rightFields[0].Charset = 45
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"A",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"d",
+			),
+		},
+	}
+
+	sj := &SemiJoin{
+		Opcode: Semi,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0},
+		ListVars: map[string]int{
+			"bv": 1,
+		},
+	}
+	r, err := sj.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// the subquery is executed once for every batch of distinct values, NULL is never sent
+	rightPrim.ExpectLog(t, []string{
+		`Execute bv: type:TUPLE values:{type:VARCHAR value:"a"} values:{type:VARCHAR value:"b"} true`,
+		`Execute bv: type:TUPLE values:{type:VARCHAR value:"c"} values:{type:VARCHAR value:"d"} true`,
+	})
+	// the rows are matched back with the collation of the subquery column
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+		"1",
+		"3",
+		"6",
+	))
+
+	// Anti join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = Anti
+	r, err = sj.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+		"2",
+		"4",
+		"5",
+	))
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+)
+
+var (
+	sequenceBlocksReserved = stats.NewCountersWithSingleLabel("SequenceCacheBlocksReserved", "Number of blocks of sequence values reserved by vtgate", "Sequence")
+	sequenceValuesServed   = stats.NewCountersWithSingleLabel("SequenceCacheValuesServed", "Number of sequence values served from the blocks reserved by vtgate", "Sequence")
+	sequenceBlockLifetime  = stats.NewTimings("SequenceCacheBlockLifetime", "Time taken to use up a block of sequence values reserved by vtgate", "Sequence")
+)
+
+// SequenceCache reserves blocks of values from the sequence tables,
+// and serves the values that inserts need to generate from them, so
+// that most inserts do not need a round trip to the sequence tablet.
+// The values left in the blocks are lost when vtgate restarts: at most
+// blockSize values per sequence are wasted by each restart.
+type SequenceCache struct {
+	blockSize int64
+
+	mu        sync.Mutex
+	sequences map[string]*sequenceBlock
+}
+
+// sequenceBlock is the block of values reserved for a sequence.
+// Values in [next, end) are available.
+type sequenceBlock struct {
+	mu         sync.Mutex
+	next, end  int64
+	reservedAt time.Time
+}
+
+// NewSequenceCache creates a SequenceCache reserving blocks of blockSize values.
+// It returns nil, which disables caching, if blockSize is not greater than 1.
+func NewSequenceCache(blockSize int64) *SequenceCache {
+	if blockSize <= 1 {
+		return nil
+	}
+	return &SequenceCache{
+		blockSize: blockSize,
+		sequences: make(map[string]*sequenceBlock),
+	}
+}
+
+// Reserve returns the first of count consecutive values of the sequence.
+// The values are served from the block reserved for the sequence if it has
+// enough of them left. Otherwise, fetch is called to reserve a new block of
+// n values from the sequence table, and must return the first one of them.
+// Requests for at least a block of values are sent to the sequence table
+// as they are, without changing the current block.
+func (sc *SequenceCache) Reserve(gen *Generate, count int64, fetch func(n int64) (int64, error)) (int64, error) {
+	name := gen.sequenceName()
+	if count >= sc.blockSize {
+		return fetch(count)
+	}
+	block := sc.block(name)
+	block.mu.Lock()
+	defer block.mu.Unlock()
+
+	if block.end-block.next < count {
+		// The values left in the current block, if any, are dropped:
+		// they are fewer than count, which is less than a block.
+		start, err := fetch(sc.blockSize)
+		if err != nil {
+			return 0, err
+		}
+		if !block.reservedAt.IsZero() {
+			sequenceBlockLifetime.Record(name, block.reservedAt)
+		}
+		sequenceBlocksReserved.Add(name, 1)
+		block.next, block.end, block.reservedAt = start, start+sc.blockSize, time.Now()
+	}
+	first := block.next
+	block.next += count
+	sequenceValuesServed.Add(name, count)
+	return first, nil
+}
+
+func (sc *SequenceCache) block(name string) *sequenceBlock {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	block, ok := sc.sequences[name]
+	if !ok {
+		block = &sequenceBlock{}
+		sc.sequences[name] = block
+	}
+	return block
+}
+
+// sequenceName returns the name of the sequence as keyspace.table.
+func (gen *Generate) sequenceName() string {
+	table := gen.Query[strings.LastIndex(gen.Query, " ")+1:]
+	return gen.Keyspace.Name + "." + table
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+func TestSequenceCacheDisabled(t *testing.T) {
+	assert.Nil(t, NewSequenceCache(0))
+	assert.Nil(t, NewSequenceCache(1))
+	assert.NotNil(t, NewSequenceCache(2))
+}
+
+func TestSequenceCacheReserve(t *testing.T) {
+	gen := &Generate{
+		Keyspace: &vindexes.Keyspace{Name: "uks"},
+		Query:    "select next :n values from user_seq",
+	}
+	name := "uks.user_seq"
+	assert.Equal(t, name, gen.sequenceName())
+
+	var fetched []int64
+	next := int64(100)
+	fetch := func(n int64) (int64, error) {
+		fetched = append(fetched, n)
+		start := next
+		next += n
+		return start, nil
+	}
+	blocks := sequenceBlocksReserved.Counts()[name]
+	served := sequenceValuesServed.Counts()[name]
+
+	sc := NewSequenceCache(10)
+	testcases := []struct {
+		count       int64
+		want        int64
+		wantFetched []int64
+	}{{
+		// The first block is reserved.
+		count:       3,
+		want:        100,
+		wantFetched: []int64{10},
+	}, {
+		count: 5,
+		want:  103,
+	}, {
+		// A request for a block or more bypasses the cache.
+		count:       10,
+		want:        110,
+		wantFetched: []int64{10, 10},
+	}, {
+		count: 2,
+		want:  108,
+	}, {
+		// The block is exhausted: a new one is reserved.
+		count:       1,
+		want:        120,
+		wantFetched: []int64{10, 10, 10},
+	}}
+	for _, tcase := range testcases {
+		got, err := sc.Reserve(gen, tcase.count, fetch)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, got, "Reserve(%d)", tcase.count)
+		if tcase.wantFetched != nil {
+			assert.Equal(t, tcase.wantFetched, fetched, "Reserve(%d)", tcase.count)
+		}
+	}
+	assert.EqualValues(t, 2, sequenceBlocksReserved.Counts()[name]-blocks)
+	assert.EqualValues(t, 11, sequenceValuesServed.Counts()[name]-served)
+	assert.EqualValues(t, 1, sequenceBlockLifetime.Counts()[name])
+
+	got, err := sc.Reserve(gen, 9, fetch)
+	require.NoError(t, err)
+	assert.EqualValues(t, 121, got)
+
+	// A failed reservation leaves the exhausted block as is.
+	_, err = sc.Reserve(gen, 1, func(n int64) (int64, error) {
+		return 0, errors.New("fetch failed")
+	})
+	require.EqualError(t, err, "fetch failed")
+	got, err = sc.Reserve(gen, 1, fetch)
+	require.NoError(t, err)
+	assert.EqualValues(t, 130, got)
+}
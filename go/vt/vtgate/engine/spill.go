@@ -0,0 +1,315 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// rowSpiller is used by the primitives that need to hold more rows than
+// MaxMemoryRows allows. The rows are sorted and written to temporary files
+// in the spill directory of the VCursor, called runs, which are merged back
+// once all the rows are known.
+type rowSpiller struct {
+	vcursor   VCursor
+	comparers []*comparer
+	runs      []*os.File
+}
+
+// newRowSpiller returns a rowSpiller sorting the rows with the comparers,
+// or an error if spilling to disk is disabled.
+func newRowSpiller(vcursor VCursor, comparers []*comparer) (*rowSpiller, error) {
+	if vcursor.SpillDir() == "" {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	return &rowSpiller{vcursor: vcursor, comparers: comparers}, nil
+}
+
+// spill sorts the rows and writes them to a new run.
+func (rs *rowSpiller) spill(rows [][]sqltypes.Value) error {
+	sh := &sortHeap{rows: rows, comparers: rs.comparers}
+	sort.Sort(sh)
+	if sh.err != nil {
+		return sh.err
+	}
+
+	file, err := os.CreateTemp(rs.vcursor.SpillDir(), "vtgate-spill-")
+	if err != nil {
+		return err
+	}
+	rs.runs = append(rs.runs, file)
+
+	w := bufio.NewWriter(file)
+	var buf []byte
+	for _, row := range sh.rows {
+		buf = appendSpilledRow(buf[:0], row)
+		if err := rs.vcursor.RecordSpill(int64(len(buf))); err != nil {
+			return err
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// merge returns an iterator over the rows of all the runs and the given rows,
+// which don't need to be sorted, in order.
+func (rs *rowSpiller) merge(rows [][]sqltypes.Value) (*spillMerger, error) {
+	sh := &sortHeap{rows: rows, comparers: rs.comparers}
+	sort.Sort(sh)
+	if sh.err != nil {
+		return nil, sh.err
+	}
+
+	sm := &spillMerger{comparers: rs.comparers}
+	for _, file := range rs.runs {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		src := &spillSource{reader: bufio.NewReader(file)}
+		if err := sm.advance(src); err != nil {
+			return nil, err
+		}
+	}
+	if err := sm.advance(&spillSource{rows: sh.rows}); err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
+
+// close removes the runs from the disk.
+func (rs *rowSpiller) close() {
+	if rs == nil {
+		return
+	}
+	for _, file := range rs.runs {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}
+	rs.runs = nil
+}
+
+// streamsInput returns true if the primitives that spill their rows should stream
+// their input, even when they are executed with TryExecute. Streaming queries
+// don't use the transaction or the reserved connection of the session, so the
+// input is executed as usual inside of them.
+func streamsInput(vcursor VCursor) bool {
+	session := vcursor.Session()
+	return vcursor.SpillDir() != "" && !session.InTransaction() && !session.InReservedConn()
+}
+
+// executeInput calls process with the rows of the input, which are streamed if
+// streamsInput allows it, so that they can be spilled to disk as they arrive
+// instead of exceeding MaxMemoryRows all at once.
+func executeInput(vcursor VCursor, input Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool, process func(*sqltypes.Result) error) error {
+	if streamsInput(vcursor) {
+		return vcursor.StreamExecutePrimitive(input, bindVars, wantfields, process)
+	}
+	result, err := vcursor.ExecutePrimitive(input, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return process(result)
+}
+
+// collectStream returns the results sent by stream as a single result.
+func collectStream(stream func(callback func(*sqltypes.Result) error) error) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	err := stream(func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			result.Fields = qr.Fields
+		}
+		if qr.InsertID != 0 {
+			result.InsertID = qr.InsertID
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// spillSource is either a run, or the rows that were still in memory.
+type spillSource struct {
+	reader  *bufio.Reader
+	rows    [][]sqltypes.Value
+	current []sqltypes.Value
+}
+
+func (src *spillSource) next() ([]sqltypes.Value, error) {
+	if src.reader != nil {
+		row, err := readSpilledRow(src.reader)
+		if err == io.EOF {
+			return nil, nil
+		}
+		return row, err
+	}
+	if len(src.rows) == 0 {
+		return nil, nil
+	}
+	row := src.rows[0]
+	src.rows = src.rows[1:]
+	return row, nil
+}
+
+// spillMerger returns the rows of its sources in order.
+// Implementation is similar to scatterHeap
+type spillMerger struct {
+	sources   []*spillSource
+	comparers []*comparer
+	err       error
+}
+
+// next returns the next row, or nil once all the rows were returned.
+func (sm *spillMerger) next() ([]sqltypes.Value, error) {
+	if len(sm.sources) == 0 {
+		return nil, nil
+	}
+	src := heap.Pop(sm).(*spillSource)
+	if sm.err != nil {
+		return nil, sm.err
+	}
+	row := src.current
+	if err := sm.advance(src); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// advance reads the next row of the source, and puts it back in the heap if there is one.
+func (sm *spillMerger) advance(src *spillSource) error {
+	row, err := src.next()
+	if err != nil || row == nil {
+		return err
+	}
+	src.current = row
+	heap.Push(sm, src)
+	return sm.err
+}
+
+// Len satisfies sort.Interface and heap.Interface.
+func (sm *spillMerger) Len() int {
+	return len(sm.sources)
+}
+
+// Less satisfies sort.Interface and heap.Interface.
+func (sm *spillMerger) Less(i, j int) bool {
+	for _, c := range sm.comparers {
+		if sm.err != nil {
+			return true
+		}
+		cmp, err := c.compare(sm.sources[i].current, sm.sources[j].current)
+		if err != nil {
+			sm.err = err
+			return true
+		}
+		if cmp == 0 {
+			continue
+		}
+		return cmp < 0
+	}
+	return true
+}
+
+// Swap satisfies sort.Interface and heap.Interface.
+func (sm *spillMerger) Swap(i, j int) {
+	sm.sources[i], sm.sources[j] = sm.sources[j], sm.sources[i]
+}
+
+// Push satisfies heap.Interface.
+func (sm *spillMerger) Push(x interface{}) {
+	sm.sources = append(sm.sources, x.(*spillSource))
+}
+
+// Pop satisfies heap.Interface.
+func (sm *spillMerger) Pop() interface{} {
+	n := len(sm.sources)
+	x := sm.sources[n-1]
+	sm.sources = sm.sources[:n-1]
+	return x
+}
+
+// appendSpilledRow encodes the row as its number of values, followed by
+// the type and, unless it is NULL, the length and the bytes of every value.
+func appendSpilledRow(buf []byte, row []sqltypes.Value) []byte {
+	buf = appendUvarint(buf, uint64(len(row)))
+	for _, value := range row {
+		buf = appendUvarint(buf, uint64(value.Type()))
+		if value.IsNull() {
+			continue
+		}
+		raw := value.Raw()
+		buf = appendUvarint(buf, uint64(len(raw)))
+		buf = append(buf, raw...)
+	}
+	return buf
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+// readSpilledRow decodes a row encoded by appendSpilledRow.
+// It returns io.EOF if there are no more rows to read.
+func readSpilledRow(r *bufio.Reader) ([]sqltypes.Value, error) {
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	row := make([]sqltypes.Value, count)
+	for i := range row {
+		typ, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if querypb.Type(typ) == sqltypes.Null {
+			row[i] = sqltypes.NULL
+			continue
+		}
+		length, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		raw := make([]byte, length)
+		if _, err := io.ReadFull(r, raw); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		row[i] = sqltypes.MakeTrusted(querypb.Type(typ), raw)
+	}
+	return row, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -19,6 +19,8 @@ package engine
 import (
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
@@ -27,11 +29,13 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -43,6 +47,18 @@ type VindexValues struct {
 	Offset int // Offset from ownedVindexQuery to provide input decision for vindex update.
 }
 
+// RowMove contains the instructions to perform an update that changes the
+// primary vindex columns of a table. The updated rows are deleted from their
+// current shard and inserted in the shard of their new keyspace id.
+type RowMove struct {
+	// SelectQuery selects and locks the complete rows that are updated.
+	SelectQuery string
+	// DeleteQuery deletes the rows selected by SelectQuery.
+	DeleteQuery string
+	// Values contains the new values of the updated columns, by lowered column name.
+	Values map[string]sqltypes.PlanValue
+}
+
 // Update represents the instructions to perform an update.
 type Update struct {
 	DML
@@ -50,6 +66,9 @@ type Update struct {
 	// ChangedVindexValues contains values for updated Vindexes during an update statement.
 	ChangedVindexValues map[string]*VindexValues
 
+	// RowMove is set when the update changes the primary vindex columns.
+	RowMove *RowMove
+
 	// Update does not take inputs
 	noInputs
 }
@@ -145,6 +164,9 @@ func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb
 	if len(ksid) == 0 {
 		return &sqltypes.Result{}, nil
 	}
+	if upd.RowMove != nil {
+		return upd.moveRows(vcursor, bindVars, []*srvtopo.ResolvedShard{rs})
+	}
 	if len(upd.ChangedVindexValues) != 0 {
 		if err := upd.updateVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs}); err != nil {
 			return nil, err
@@ -162,6 +184,9 @@ func (upd *Update) execUpdateIn(vcursor VCursor, bindVars map[string]*querypb.Bi
 	if err != nil {
 		return nil, err
 	}
+	if upd.RowMove != nil {
+		return upd.moveRows(vcursor, bindVars, rss)
+	}
 	if len(upd.ChangedVindexValues) != 0 {
 		if err := upd.updateVindexEntries(vcursor, bindVars, rss); err != nil {
 			return nil, err
@@ -179,6 +204,9 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 	if err != nil {
 		return nil, err
 	}
+	if upd.RowMove != nil {
+		return upd.moveRows(vcursor, bindVars, rss)
+	}
 
 	queries := make([]*querypb.BoundQuery, len(rss))
 	for i := range rss {
@@ -269,6 +297,211 @@ func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*que
 	return nil
 }
 
+// moveRows performs an update that changes the primary vindex columns. The rows
+// are selected from the shards in rss, and are then deleted and inserted back
+// with their new values in the shards of their new keyspace ids. The owned
+// lookup vindexes are updated to point to the new keyspace ids.
+// Since the rows can move to other shards, the update is only allowed if it
+// touches a single shard, or if the session commits with two-phase commit.
+func (upd *Update) moveRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) (*sqltypes.Result, error) {
+	if err := upd.checkSingleShardMove(vcursor, bindVars, rss); err != nil {
+		return nil, err
+	}
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{Sql: upd.RowMove.SelectQuery, BindVariables: bindVars}
+	}
+	selected, errs := vcursor.ExecuteMultiShard(rss, queries, false /* rollbackOnError */, false /* canAutocommit */)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return nil, err
+	}
+	if len(selected.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+
+	fieldColNumMap := make(map[string]int, len(selected.Fields))
+	for colNum, field := range selected.Fields {
+		fieldColNumMap[strings.ToLower(field.Name)] = colNum
+	}
+	colNum := func(col sqlparser.ColIdent) (int, error) {
+		num, ok := fieldColNumMap[col.Lowered()]
+		if !ok {
+			return 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] column %s is missing from the rows of %s", col.String(), upd.GetTableName())
+		}
+		return num, nil
+	}
+
+	// Compute the new rows, and the old and new keyspace ids of each row.
+	newValues := make(map[int]sqltypes.Value, len(upd.RowMove.Values))
+	for name, pv := range upd.RowMove.Values {
+		num, err := colNum(sqlparser.NewColIdent(name))
+		if err != nil {
+			return nil, err
+		}
+		newValues[num], err = pv.ResolveValue(bindVars)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ksidColNum, err := colNum(upd.Table.ColumnVindexes[0].Columns[0])
+	if err != nil {
+		return nil, err
+	}
+	oldKsids := make([][]byte, len(selected.Rows))
+	newKsids := make([][]byte, len(selected.Rows))
+	newRows := make([][]sqltypes.Value, len(selected.Rows))
+	for rowNum, row := range selected.Rows {
+		newRow := make([]sqltypes.Value, len(row))
+		copy(newRow, row)
+		for num, value := range newValues {
+			newRow[num] = value
+		}
+		if oldKsids[rowNum], err = resolveKeyspaceID(vcursor, upd.KsidVindex, row[ksidColNum]); err != nil {
+			return nil, err
+		}
+		if newKsids[rowNum], err = resolveKeyspaceID(vcursor, upd.KsidVindex, newRow[ksidColNum]); err != nil {
+			return nil, err
+		}
+		if newKsids[rowNum] == nil {
+			return nil, fmt.Errorf("could not map %v to a keyspace id", newRow[ksidColNum])
+		}
+		newRows[rowNum] = newRow
+	}
+
+	var indexes []*querypb.Value
+	var destinations []key.Destination
+	for rowNum, ksid := range newKsids {
+		indexes = append(indexes, &querypb.Value{Value: strconv.AppendInt(nil, int64(rowNum), 10)})
+		destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+	}
+	insertRss, indexesPerRss, err := vcursor.ResolveDestinations(upd.Keyspace.Name, indexes, destinations)
+	if err != nil {
+		return nil, err
+	}
+	if err := allowOnlyPrimary(insertRss...); err != nil {
+		return nil, err
+	}
+
+	// Move the entries of the owned vindexes to the new keyspace ids.
+	for _, colVindex := range upd.Table.Owned {
+		lookup := colVindex.Vindex.(vindexes.Lookup)
+		oldKeys := make([][]sqltypes.Value, len(selected.Rows))
+		newKeys := make([][]sqltypes.Value, len(selected.Rows))
+		for _, vCol := range colVindex.Columns {
+			num, err := colNum(vCol)
+			if err != nil {
+				return nil, err
+			}
+			for rowNum, row := range selected.Rows {
+				oldKeys[rowNum] = append(oldKeys[rowNum], row[num])
+				newKeys[rowNum] = append(newKeys[rowNum], newRows[rowNum][num])
+			}
+		}
+		for rowNum := range oldKeys {
+			if err := lookup.Delete(vcursor, oldKeys[rowNum:rowNum+1], oldKsids[rowNum]); err != nil {
+				return nil, err
+			}
+		}
+		if err := lookup.Create(vcursor, newKeys, newKsids, false /* ignoreMode */); err != nil {
+			return nil, err
+		}
+	}
+
+	// Delete the old rows.
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{Sql: upd.RowMove.DeleteQuery, BindVariables: bindVars}
+	}
+	if _, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, false /* canAutocommit */); errs != nil {
+		return nil, vterrors.Aggregate(errs)
+	}
+
+	// Insert the new rows.
+	columns := make([]string, len(selected.Fields))
+	for i, field := range selected.Fields {
+		columns[i] = sqlparser.String(sqlparser.NewColIdent(field.Name))
+	}
+	prefix := fmt.Sprintf("insert into %s(%s) values ", sqlparser.String(upd.Table.Name), strings.Join(columns, ", "))
+	insertQueries := make([]*querypb.BoundQuery, len(insertRss))
+	for i := range insertRss {
+		bvs := make(map[string]*querypb.BindVariable)
+		var mids []string
+		for _, indexValue := range indexesPerRss[i] {
+			rowNum, _ := strconv.Atoi(string(indexValue.Value))
+			names := make([]string, len(newRows[rowNum]))
+			for colIdx, value := range newRows[rowNum] {
+				name := insertSelectVarName(rowNum, colIdx)
+				bvs[name] = sqltypes.ValueBindVariable(value)
+				names[colIdx] = ":" + name
+			}
+			mids = append(mids, "("+strings.Join(names, ", ")+")")
+		}
+		insertQueries[i] = &querypb.BoundQuery{
+			Sql:           prefix + strings.Join(mids, ", "),
+			BindVariables: bvs,
+		}
+	}
+	if _, errs := vcursor.ExecuteMultiShard(insertRss, insertQueries, true /* rollbackOnError */, false /* canAutocommit */); errs != nil {
+		return nil, vterrors.Aggregate(errs)
+	}
+	return &sqltypes.Result{RowsAffected: uint64(len(selected.Rows))}, nil
+}
+
+// checkSingleShardMove returns an error if the session doesn't use two-phase
+// commit, and the rows can move out of the shards in rss or come from more than
+// one shard. The new keyspace id only depends on the new value of the primary
+// vindex column, so it is checked before the rows are selected for update.
+func (upd *Update) checkSingleShardMove(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
+	if vcursor.Session().GetTransactionMode() == vtgatepb.TransactionMode_TWOPC {
+		return nil
+	}
+	errTwoPC := vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "moving rows between shards to update the primary vindex of %s requires the TWOPC transaction mode", upd.GetTableName())
+	if !isSingleShard(rss) {
+		return errTwoPC
+	}
+	ksidCol := upd.Table.ColumnVindexes[0].Columns[0]
+	for name, pv := range upd.RowMove.Values {
+		if !ksidCol.EqualString(name) {
+			continue
+		}
+		value, err := pv.ResolveValue(bindVars)
+		if err != nil {
+			return err
+		}
+		ksid, err := resolveKeyspaceID(vcursor, upd.KsidVindex, value)
+		if err != nil {
+			return err
+		}
+		if ksid == nil {
+			return fmt.Errorf("could not map %v to a keyspace id", value)
+		}
+		insertRss, _, err := vcursor.ResolveDestinations(upd.Keyspace.Name, nil, []key.Destination{key.DestinationKeyspaceID(ksid)})
+		if err != nil {
+			return err
+		}
+		if !isSingleShard(rss, insertRss) {
+			return errTwoPC
+		}
+	}
+	return nil
+}
+
+// isSingleShard returns true if all the shards are the same shard.
+func isSingleShard(rssList ...[]*srvtopo.ResolvedShard) bool {
+	var target *querypb.Target
+	for _, rss := range rssList {
+		for _, rs := range rss {
+			if target == nil {
+				target = rs.Target
+				continue
+			}
+			if rs.Target.Keyspace != target.Keyspace || rs.Target.Shard != target.Shard {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (upd *Update) description() PrimitiveDescription {
 	other := map[string]interface{}{
 		"Query":                upd.Query,
@@ -288,6 +521,18 @@ func (upd *Update) description() PrimitiveDescription {
 	if len(changedVindexes) > 0 {
 		other["ChangedVindexValues"] = changedVindexes
 	}
+	if upd.RowMove != nil {
+		var columns []string
+		for name := range upd.RowMove.Values {
+			columns = append(columns, name)
+		}
+		sort.Strings(columns)
+		other["RowMove"] = map[string]interface{}{
+			"SelectQuery":    upd.RowMove.SelectQuery,
+			"DeleteQuery":    upd.RowMove.DeleteQuery,
+			"UpdatedColumns": columns,
+		}
+	}
 
 	return PrimitiveDescription{
 		OperatorType:     "Update",
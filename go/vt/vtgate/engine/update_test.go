@@ -29,6 +29,7 @@ import (
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
 func TestUpdateUnsharded(t *testing.T) {
@@ -332,6 +333,103 @@ func TestUpdateEqualChangedVindex(t *testing.T) {
 
 }
 
+func TestUpdateEqualChangedPrimaryVindex(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{
+		DML: DML{
+			Opcode:     Equal,
+			Keyspace:   ks.Keyspace,
+			Query:      "dummy_update",
+			Vindex:     ks.Vindexes["hash"].(vindexes.SingleColumn),
+			Values:     []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}},
+			Table:      ks.Tables["t1"],
+			KsidVindex: ks.Vindexes["hash"].(vindexes.SingleColumn),
+		},
+		RowMove: &RowMove{
+			SelectQuery: "dummy_select",
+			DeleteQuery: "dummy_delete",
+			Values: map[string]sqltypes.PlanValue{
+				"id": {Value: sqltypes.NewInt64(2)},
+				"c3": {Value: sqltypes.NewInt64(3)},
+			},
+		},
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c1|c2|c3|name",
+			"int64|int64|int64|int64|varchar",
+		),
+		"1|4|5|6|foo",
+	)}
+
+	// The row stays in -20: the update is single shard.
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.results = results
+
+	result, err := upd.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.-20: dummy_select {} false false`,
+		`ResolveDestinations sharded [value:"0"] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		// The entries of the owned vindexes are moved to the new keyspace id.
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute insert into lkp2(from1, from2, toc) values(:from1_0, :from2_0, :toc_0) from1_0: type:INT64 value:"4" from2_0: type:INT64 value:"5" toc_0: type:VARBINARY value:"\x06\xe7\xea\"Βp\x8f" true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0) from_0: type:INT64 value:"3" toc_0: type:VARBINARY value:"\x06\xe7\xea\"Βp\x8f" true`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {} true false`,
+		`ExecuteMultiShard sharded.-20: insert into t1(id, c1, c2, c3, ` + "`name`" + `) values (:_c0_0, :_c0_1, :_c0_2, :_c0_3, :_c0_4) ` +
+			`{_c0_0: type:INT64 value:"2" _c0_1: type:INT64 value:"4" _c0_2: type:INT64 value:"5" _c0_3: type:INT64 value:"3" _c0_4: type:VARCHAR value:"foo"} true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 1})
+
+	// The row moves to 20-: the update is rejected unless the session uses TWOPC.
+	vc = newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-"}
+	vc.results = results
+
+	_, err = upd.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "moving rows between shards to update the primary vindex of t1 requires the TWOPC transaction mode")
+	// The update is rejected before the rows are locked.
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+	})
+
+	vc = newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-"}
+	vc.transactionMode = vtgatepb.TransactionMode_TWOPC
+	vc.results = results
+
+	_, err = upd.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_select {} false false`,
+		`ResolveDestinations sharded [value:"0"] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute insert into lkp2(from1, from2, toc) values(:from1_0, :from2_0, :toc_0) from1_0: type:INT64 value:"4" from2_0: type:INT64 value:"5" toc_0: type:VARBINARY value:"\x06\xe7\xea\"Βp\x8f" true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0) from_0: type:INT64 value:"3" toc_0: type:VARBINARY value:"\x06\xe7\xea\"Βp\x8f" true`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {} true false`,
+		`ExecuteMultiShard sharded.20-: insert into t1(id, c1, c2, c3, ` + "`name`" + `) values (:_c0_0, :_c0_1, :_c0_2, :_c0_3, :_c0_4) ` +
+			`{_c0_0: type:INT64 value:"2" _c0_1: type:INT64 value:"4" _c0_2: type:INT64 value:"5" _c0_3: type:INT64 value:"3" _c0_4: type:VARCHAR value:"foo"} true false`,
+	})
+
+	// No rows changing
+	vc = newDMLTestVCursor("-20", "20-")
+
+	_, err = upd.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.-20: dummy_select {} false false`,
+	})
+}
+
 func TestUpdateScatterChangedVindex(t *testing.T) {
 	// update t1 set c1 = 1, c2 = 2, c3 = 3
 	ks := buildTestVSchema().Keyspaces["sharded"]
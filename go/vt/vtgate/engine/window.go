@@ -0,0 +1,576 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that computes window functions over the rows of its input.
+// It expects the underlying primitive to feed rows sorted by the PartitionBy keys
+// followed by the OrderBy keys of the window, which is what a merge sort over
+// the shards gives us. The rows of one partition are buffered in memory, and the
+// result of each window function is appended as a new column at the end of the row.
+//
+// Only the default window frame is supported: when the window is ordered, the frame
+// goes from the start of the partition to the last peer of the current row,
+// otherwise it spans the whole partition.
+type Window struct {
+	// PartitionBy specifies the input values that split the rows into partitions.
+	PartitionBy []*GroupByParams
+	// OrderBy specifies the ordering of the rows inside a partition.
+	// Rows that are equal according to it are peers.
+	OrderBy []OrderByParams
+	// Functions specifies the window functions to compute.
+	Functions []*WindowFunctionParams
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// WindowFunctionParams specify the parameters of a single window function.
+type WindowFunctionParams struct {
+	Opcode WindowOpcode
+	// Col is the input column holding the expression the function is computed over,
+	// or -1 for the functions that do not take one, like ROW_NUMBER() or COUNT(*).
+	Col int
+	// Args holds the other arguments of the function: N for NTILE(N) and NTH_VALUE(expr, N),
+	// or the offset and the default value for LAG and LEAD. They are evaluated once per row.
+	Args []evalengine.Expr
+
+	Alias string `json:",omitempty"`
+}
+
+func (wf *WindowFunctionParams) String() string {
+	var args []string
+	if wf.Col != -1 {
+		args = append(args, strconv.Itoa(wf.Col))
+	}
+	for _, arg := range wf.Args {
+		args = append(args, arg.String())
+	}
+	if wf.Alias != "" {
+		return fmt.Sprintf("%s(%s) AS %s", wf.Opcode.String(), strings.Join(args, ", "), wf.Alias)
+	}
+	return fmt.Sprintf("%s(%s)", wf.Opcode.String(), strings.Join(args, ", "))
+}
+
+// WindowOpcode is the window function Opcode.
+type WindowOpcode int
+
+// These constants list the possible window function opcodes.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+	WindowPercentRank
+	WindowCumeDist
+	WindowNtile
+	WindowLag
+	WindowLead
+	WindowFirstValue
+	WindowLastValue
+	WindowNthValue
+	WindowCount
+	WindowSum
+	WindowAvg
+	WindowMin
+	WindowMax
+)
+
+// SupportedWindowFunctions maps the list of window functions
+// that can be computed at the vtgate level to their opcodes.
+var SupportedWindowFunctions = map[string]WindowOpcode{
+	"row_number":   WindowRowNumber,
+	"rank":         WindowRank,
+	"dense_rank":   WindowDenseRank,
+	"percent_rank": WindowPercentRank,
+	"cume_dist":    WindowCumeDist,
+	"ntile":        WindowNtile,
+	"lag":          WindowLag,
+	"lead":         WindowLead,
+	"first_value":  WindowFirstValue,
+	"last_value":   WindowLastValue,
+	"nth_value":    WindowNthValue,
+	"count":        WindowCount,
+	"sum":          WindowSum,
+	"avg":          WindowAvg,
+	"min":          WindowMin,
+	"max":          WindowMax,
+}
+
+func (code WindowOpcode) String() string {
+	for k, v := range SupportedWindowFunctions {
+		if v == code {
+			return k
+		}
+	}
+	panic("unreachable")
+}
+
+// MarshalJSON serializes the WindowOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code WindowOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// TryExecute is a Primitive function.
+func (w *Window) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := vcursor.ExecutePrimitive(w.Input, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{
+		Fields: w.convertFields(result.Fields),
+		Rows:   make([][]sqltypes.Value, 0, len(result.Rows)),
+	}
+	// This code is similar to the one in StreamExecute.
+	start := 0
+	for i := 1; i <= len(result.Rows); i++ {
+		if i < len(result.Rows) {
+			equal, err := w.samePartition(result.Rows[start], result.Rows[i])
+			if err != nil {
+				return nil, err
+			}
+			if equal {
+				continue
+			}
+		}
+		rows, err := w.computePartition(result.Rows[start:i], bindVars)
+		if err != nil {
+			return nil, err
+		}
+		out.Rows = append(out.Rows, rows...)
+		start = i
+	}
+	return out, nil
+}
+
+// TryStreamExecute is a Primitive function.
+func (w *Window) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var partition [][]sqltypes.Value
+	flush := func() error {
+		if len(partition) == 0 {
+			return nil
+		}
+		rows, err := w.computePartition(partition, bindVars)
+		if err != nil {
+			return err
+		}
+		partition = nil
+		return callback(&sqltypes.Result{Rows: rows})
+	}
+
+	err := vcursor.StreamExecutePrimitive(w.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			if err := callback(&sqltypes.Result{Fields: w.convertFields(qr.Fields)}); err != nil {
+				return err
+			}
+		}
+		// This code is similar to the one in Execute.
+		for _, row := range qr.Rows {
+			if len(partition) > 0 {
+				equal, err := w.samePartition(partition[0], row)
+				if err != nil {
+					return err
+				}
+				if !equal {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
+			}
+			partition = append(partition, row)
+			if vcursor.ExceedsMaxMemoryRows(len(partition)) {
+				return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+// GetFields is a Primitive function.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: w.convertFields(qr.Fields)}, nil
+}
+
+// Inputs returns the Primitive input for this window
+func (w *Window) Inputs() []Primitive {
+	return []Primitive{w.Input}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (w *Window) NeedsTransaction() bool {
+	return w.Input.NeedsTransaction()
+}
+
+func (w *Window) convertFields(fields []*querypb.Field) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	out := make([]*querypb.Field, 0, len(fields)+len(w.Functions))
+	out = append(out, fields...)
+	for _, wf := range w.Functions {
+		out = append(out, &querypb.Field{
+			Name: wf.Alias,
+			Type: wf.resultType(fields),
+		})
+	}
+	return out
+}
+
+func (wf *WindowFunctionParams) resultType(fields []*querypb.Field) querypb.Type {
+	switch wf.Opcode {
+	case WindowRowNumber, WindowRank, WindowDenseRank, WindowNtile, WindowCount:
+		return sqltypes.Int64
+	case WindowPercentRank, WindowCumeDist:
+		return sqltypes.Float64
+	case WindowSum, WindowAvg:
+		if wf.Col != -1 && sqltypes.IsFloat(fields[wf.Col].Type) {
+			return sqltypes.Float64
+		}
+		return sqltypes.Decimal
+	default:
+		if wf.Col != -1 {
+			return fields[wf.Col].Type
+		}
+		return sqltypes.Null
+	}
+}
+
+func (w *Window) samePartition(row1, row2 []sqltypes.Value) (bool, error) {
+	for _, key := range w.PartitionBy {
+		cmp, err := evalengine.NullsafeCompareCollated(row1[key.KeyCol], row2[key.KeyCol], key.CollationID)
+		if err != nil {
+			_, isComparisonErr := err.(evalengine.UnsupportedComparisonError)
+			if !(isComparisonErr && key.WeightStringCol != -1) {
+				return false, err
+			}
+			key.KeyCol = key.WeightStringCol
+			cmp, err = evalengine.NullsafeCompare(row1[key.WeightStringCol], row2[key.WeightStringCol])
+			if err != nil {
+				return false, err
+			}
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// peerGroups returns, for each row of the partition, the index of its first and of its last peer.
+func (w *Window) peerGroups(partition [][]sqltypes.Value) (first, last []int, err error) {
+	first = make([]int, len(partition))
+	last = make([]int, len(partition))
+	comparers := extractSlices(w.OrderBy)
+	start := 0
+	for i := 1; i <= len(partition); i++ {
+		if i < len(partition) {
+			peers := true
+			for _, c := range comparers {
+				cmp, err := c.compare(partition[start], partition[i])
+				if err != nil {
+					return nil, nil, err
+				}
+				if cmp != 0 {
+					peers = false
+					break
+				}
+			}
+			if peers {
+				continue
+			}
+		}
+		for j := start; j < i; j++ {
+			first[j] = start
+			last[j] = i - 1
+		}
+		start = i
+	}
+	return first, last, nil
+}
+
+// computePartition computes all the window functions over the rows of a single partition,
+// and returns the rows with the results appended to them.
+func (w *Window) computePartition(partition [][]sqltypes.Value, bindVars map[string]*querypb.BindVariable) ([][]sqltypes.Value, error) {
+	first, last, err := w.peerGroups(partition)
+	if err != nil {
+		return nil, err
+	}
+	out := make([][]sqltypes.Value, len(partition))
+	for i, row := range partition {
+		out[i] = make([]sqltypes.Value, len(row), len(row)+len(w.Functions))
+		copy(out[i], row)
+	}
+	for _, wf := range w.Functions {
+		values, err := wf.compute(partition, first, last, bindVars)
+		if err != nil {
+			return nil, err
+		}
+		for i := range out {
+			out[i] = append(out[i], values[i])
+		}
+	}
+	return out, nil
+}
+
+func (wf *WindowFunctionParams) compute(partition [][]sqltypes.Value, first, last []int, bindVars map[string]*querypb.BindVariable) ([]sqltypes.Value, error) {
+	size := len(partition)
+	values := make([]sqltypes.Value, size)
+	switch wf.Opcode {
+	case WindowRowNumber:
+		for i := range partition {
+			values[i] = sqltypes.NewInt64(int64(i + 1))
+		}
+	case WindowRank:
+		for i := range partition {
+			values[i] = sqltypes.NewInt64(int64(first[i] + 1))
+		}
+	case WindowDenseRank:
+		rank := int64(0)
+		for i := range partition {
+			if first[i] == i {
+				rank++
+			}
+			values[i] = sqltypes.NewInt64(rank)
+		}
+	case WindowPercentRank:
+		for i := range partition {
+			percent := float64(0)
+			if size > 1 {
+				percent = float64(first[i]) / float64(size-1)
+			}
+			values[i] = sqltypes.NewFloat64(percent)
+		}
+	case WindowCumeDist:
+		for i := range partition {
+			values[i] = sqltypes.NewFloat64(float64(last[i]+1) / float64(size))
+		}
+	case WindowNtile:
+		for i, row := range partition {
+			buckets, err := wf.intArg(0, 0, row, bindVars)
+			if err != nil {
+				return nil, err
+			}
+			if buckets <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect arguments to ntile")
+			}
+			values[i] = sqltypes.NewInt64(ntile(int64(i), int64(size), buckets))
+		}
+	case WindowLag, WindowLead:
+		for i, row := range partition {
+			offset, err := wf.intArg(0, 1, row, bindVars)
+			if err != nil {
+				return nil, err
+			}
+			if wf.Opcode == WindowLag {
+				offset = -offset
+			}
+			if target := int64(i) + offset; target >= 0 && target < int64(size) {
+				values[i] = partition[target][wf.Col]
+				continue
+			}
+			values[i], err = wf.valueArg(1, row, bindVars)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case WindowFirstValue:
+		for i := range partition {
+			values[i] = partition[0][wf.Col]
+		}
+	case WindowLastValue:
+		for i := range partition {
+			values[i] = partition[last[i]][wf.Col]
+		}
+	case WindowNthValue:
+		for i, row := range partition {
+			nth, err := wf.intArg(0, 0, row, bindVars)
+			if err != nil {
+				return nil, err
+			}
+			if nth <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect arguments to nth_value")
+			}
+			values[i] = sqltypes.NULL
+			if nth-1 <= int64(last[i]) {
+				values[i] = partition[nth-1][wf.Col]
+			}
+		}
+	case WindowCount, WindowSum, WindowAvg, WindowMin, WindowMax:
+		return wf.computeAggregate(partition, last)
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unknown window function: %d", wf.Opcode)
+	}
+	return values, nil
+}
+
+// computeAggregate computes an aggregate function used as a window function. Since the frame
+// of every row ends with its last peer, the aggregate is accumulated one peer group at a time.
+func (wf *WindowFunctionParams) computeAggregate(partition [][]sqltypes.Value, last []int) ([]sqltypes.Value, error) {
+	values := make([]sqltypes.Value, len(partition))
+	var fieldType querypb.Type
+	if wf.Col != -1 && len(partition) > 0 {
+		fieldType = partition[0][wf.Col].Type()
+	}
+	sumType := sqltypes.Decimal
+	if sqltypes.IsFloat(fieldType) {
+		sumType = sqltypes.Float64
+	}
+
+	count := int64(0)
+	acc := sqltypes.NULL
+	for i := 0; i < len(partition); {
+		end := last[i]
+		for ; i <= end; i++ {
+			if wf.Col == -1 {
+				count++
+				continue
+			}
+			v := partition[i][wf.Col]
+			if v.IsNull() {
+				continue
+			}
+			count++
+			var err error
+			switch wf.Opcode {
+			case WindowSum, WindowAvg:
+				acc = evalengine.NullsafeAdd(acc, v, sumType)
+			case WindowMin:
+				acc, err = evalengine.Min(acc, v)
+			case WindowMax:
+				acc, err = evalengine.Max(acc, v)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var result sqltypes.Value
+		switch wf.Opcode {
+		case WindowCount:
+			result = sqltypes.NewInt64(count)
+		case WindowAvg:
+			var err error
+			result, err = evalengine.Divide(acc, sqltypes.NewInt64(count))
+			if err != nil {
+				return nil, err
+			}
+		default:
+			result = acc
+		}
+		for j := end; j >= 0 && last[j] == end; j-- {
+			values[j] = result
+		}
+	}
+	return values, nil
+}
+
+// intArg evaluates the idx-th argument of the function as an integer,
+// returning def when the argument was not given.
+func (wf *WindowFunctionParams) intArg(idx int, def int64, row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (int64, error) {
+	if idx >= len(wf.Args) {
+		return def, nil
+	}
+	v, err := wf.valueArg(idx, row, bindVars)
+	if err != nil {
+		return 0, err
+	}
+	return evalengine.ToInt64(v)
+}
+
+// valueArg evaluates the idx-th argument of the function, returning NULL when it was not given.
+func (wf *WindowFunctionParams) valueArg(idx int, row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	if idx >= len(wf.Args) {
+		return sqltypes.NULL, nil
+	}
+	env := evalengine.ExpressionEnv{
+		BindVars: bindVars,
+		Row:      row,
+	}
+	res, err := wf.Args[idx].Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return res.Value(), nil
+}
+
+// ntile returns the bucket, between 1 and buckets, of the row at the given index.
+// Like MySQL does, when the rows cannot be evenly distributed, the first buckets get one more row.
+func ntile(index, size, buckets int64) int64 {
+	perBucket := size / buckets
+	remainder := size % buckets
+	big := remainder * (perBucket + 1)
+	if index < big {
+		return index/(perBucket+1) + 1
+	}
+	return remainder + (index-big)/perBucket + 1
+}
+
+func (w *Window) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Functions": GenericJoin(w.Functions, windowFunctionParamsToString),
+	}
+	if len(w.PartitionBy) > 0 {
+		other["PartitionBy"] = GenericJoin(w.PartitionBy, groupByParamsToString)
+	}
+	if len(w.OrderBy) > 0 {
+		other["OrderBy"] = GenericJoin(w.OrderBy, orderByParamsToString)
+	}
+	return PrimitiveDescription{
+		OperatorType: "Window",
+		Other:        other,
+	}
+}
+
+func windowFunctionParamsToString(i interface{}) string {
+	return i.(*WindowFunctionParams).String()
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func TestWindowRankingFunctions(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"a|b",
+		"varbinary|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"x|1",
+			"x|2",
+			"x|2",
+			"x|3",
+			"y|5",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []*GroupByParams{{KeyCol: 0, WeightStringCol: -1}},
+		OrderBy:     []OrderByParams{{Col: 1, WeightStringCol: -1}},
+		Functions: []*WindowFunctionParams{
+			{Opcode: WindowRowNumber, Col: -1, Alias: "rn"},
+			{Opcode: WindowRank, Col: -1, Alias: "r"},
+			{Opcode: WindowDenseRank, Col: -1, Alias: "dr"},
+			{Opcode: WindowCumeDist, Col: -1, Alias: "cd"},
+			{Opcode: WindowNtile, Col: -1, Args: []evalengine.Expr{evalengine.NewLiteralInt(3)}, Alias: "nt"},
+		},
+		Input: fp,
+	}
+
+	result, err := w.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"a|b|rn|r|dr|cd|nt",
+			"varbinary|int64|int64|int64|int64|float64|int64",
+		),
+		"x|1|1|1|1|0.25|1",
+		"x|2|2|2|2|0.75|1",
+		"x|2|3|2|2|0.75|2",
+		"x|3|4|4|3|1|3",
+		"y|5|1|1|1|1|1",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestWindowValueFunctions(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"a|b",
+		"varbinary|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"x|1",
+			"x|2",
+			"x|3",
+			"y|5",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []*GroupByParams{{KeyCol: 0, WeightStringCol: -1}},
+		OrderBy:     []OrderByParams{{Col: 1, WeightStringCol: -1}},
+		Functions: []*WindowFunctionParams{
+			{Opcode: WindowLag, Col: 1, Alias: "lag"},
+			{Opcode: WindowLead, Col: 1, Args: []evalengine.Expr{evalengine.NewLiteralInt(2), evalengine.NewLiteralInt(0)}, Alias: "lead"},
+			{Opcode: WindowFirstValue, Col: 1, Alias: "fv"},
+			{Opcode: WindowLastValue, Col: 1, Alias: "lv"},
+			{Opcode: WindowNthValue, Col: 1, Args: []evalengine.Expr{evalengine.NewLiteralInt(2)}, Alias: "nv"},
+		},
+		Input: fp,
+	}
+
+	result, err := w.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"a|b|lag|lead|fv|lv|nv",
+			"varbinary|int64|int64|int64|int64|int64|int64",
+		),
+		"x|1|null|3|1|1|null",
+		"x|2|1|0|1|2|2",
+		"x|3|2|0|1|3|2",
+		"y|5|null|0|5|5|null",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestWindowAggregateFunctions(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"a|b",
+		"varbinary|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"x|1",
+			"x|2",
+			"x|2",
+			"x|null",
+		)},
+	}
+
+	w := &Window{
+		OrderBy: []OrderByParams{{Col: 0, WeightStringCol: -1}},
+		Functions: []*WindowFunctionParams{
+			{Opcode: WindowCount, Col: -1, Alias: "cnt"},
+			{Opcode: WindowCount, Col: 1, Alias: "cntb"},
+			{Opcode: WindowSum, Col: 1, Alias: "s"},
+			{Opcode: WindowMax, Col: 1, Alias: "m"},
+		},
+		Input: fp,
+	}
+
+	result, err := w.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	// all the rows are peers, so the frame is the whole partition
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"a|b|cnt|cntb|s|m",
+			"varbinary|int64|int64|int64|decimal|int64",
+		),
+		"x|1|4|3|5|2",
+		"x|2|4|3|5|2",
+		"x|2|4|3|5|2",
+		"x|null|4|3|5|2",
+	)
+	utils.MustMatch(t, wantResult, result)
+
+	// with an ordered window, the frame ends with the last peer of the row
+	fp.rewind()
+	w.OrderBy = []OrderByParams{{Col: 1, WeightStringCol: -1}}
+	fp.results = []*sqltypes.Result{sqltypes.MakeTestResult(
+		fields,
+		"x|null",
+		"x|1",
+		"x|2",
+		"x|2",
+	)}
+	result, err = w.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	wantResult = sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"a|b|cnt|cntb|s|m",
+			"varbinary|int64|int64|int64|decimal|int64",
+		),
+		"x|null|1|0|null|null",
+		"x|1|2|1|1|1",
+		"x|2|4|3|5|2",
+		"x|2|4|3|5|2",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestWindowStreamExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"a|b",
+		"varbinary|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"x|1",
+			"x|2",
+			"x|3",
+			"y|1",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []*GroupByParams{{KeyCol: 0, WeightStringCol: -1}},
+		OrderBy:     []OrderByParams{{Col: 1, WeightStringCol: -1}},
+		Functions:   []*WindowFunctionParams{{Opcode: WindowRowNumber, Col: -1, Alias: "rn"}},
+		Input:       fp,
+	}
+
+	var results []*sqltypes.Result
+	err := w.TryStreamExecute(&noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	wantResults := sqltypes.MakeTestStreamingResults(
+		sqltypes.MakeTestFields(
+			"a|b|rn",
+			"varbinary|int64|int64",
+		),
+		"x|1|1",
+		"x|2|2",
+		"x|3|3",
+		"-----",
+		"y|1|1",
+	)
+	utils.MustMatch(t, wantResults, results)
+}
+
+func TestNtile(t *testing.T) {
+	// 7 rows in 3 buckets: 3, 2, 2
+	var got []int64
+	for i := int64(0); i < 7; i++ {
+		got = append(got, ntile(i, 7, 3))
+	}
+	assert.Equal(t, []int64{1, 1, 1, 2, 2, 3, 3}, got)
+
+	// more buckets than rows
+	got = nil
+	for i := int64(0); i < 2; i++ {
+		got = append(got, ntile(i, 2, 5))
+	}
+	assert.Equal(t, []int64{1, 2}, got)
+}
@@ -403,8 +403,13 @@ func makeNumericAndprioritize(i1, i2 EvalResult) (EvalResult, EvalResult) {
 }
 
 func makeNumeric(v EvalResult) EvalResult {
-	if sqltypes.IsNumber(v.typ) {
-		return v
+	switch {
+	case sqltypes.IsSigned(v.typ):
+		return EvalResult{ival: v.ival, typ: sqltypes.Int64}
+	case sqltypes.IsUnsigned(v.typ):
+		return EvalResult{uval: v.uval, typ: sqltypes.Uint64}
+	case sqltypes.IsFloat(v.typ) || v.typ == sqltypes.Decimal:
+		return EvalResult{fval: v.fval, typ: sqltypes.Float64}
 	}
 	if ival, err := strconv.ParseInt(string(v.bytes), 10, 64); err == nil {
 		return EvalResult{ival: ival, typ: sqltypes.Int64}
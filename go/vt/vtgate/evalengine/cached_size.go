@@ -23,6 +23,28 @@ type cachedObject interface {
 	CachedSize(alloc bool) int64
 }
 
+func (cached *BetweenExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field Left vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field From vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.From.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field To vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.To.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *BinaryOp) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -57,13 +79,109 @@ func (cached *BindVariable) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Key)))
 	return size
 }
+func (cached *CallExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field Arguments []vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Arguments)) * int64(16))
+		for _, elem := range cached.Arguments {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
+func (cached *CaseExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field Base vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Base.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Whens []vitess.io/vitess/go/vt/vtgate/evalengine.WhenThen
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Whens)) * int64(32))
+		for _, elem := range cached.Whens {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Else vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Else.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *CastExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Inner vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Inner.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Column) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(8)
+		size += int64(16)
+	}
+	return size
+}
+func (cached *ComparisonExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	// field Left vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Right.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *DateAddExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	// field Date vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Date.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Interval vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Interval.(cachedObject); ok {
+		size += cc.CachedSize(true)
 	}
 	return size
 }
@@ -79,6 +197,61 @@ func (cached *EvalResult) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(cap(cached.bytes)))
 	return size
 }
+func (cached *InExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Left vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right []vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Right)) * int64(16))
+		for _, elem := range cached.Right {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
+func (cached *IsExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Inner vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Inner.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *LikeExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	// field Left vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Right.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Literal) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -91,3 +264,67 @@ func (cached *Literal) CachedSize(alloc bool) int64 {
 	size += cached.Val.CachedSize(false)
 	return size
 }
+func (cached *LogicalExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	// field Left vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Right.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *NegateExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Inner vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Inner.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *NotExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Inner vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Inner.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *WhenThen) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field When vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.When.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Then vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Then.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
@@ -17,9 +17,13 @@ limitations under the License.
 package evalengine
 
 import (
+	"fmt"
+	"math"
 	"strings"
+	"time"
 
 	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 )
@@ -56,3 +60,62 @@ func (e *EvalResult) ToBooleanStrict() (bool, error) {
 	}
 	return false, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "is not a boolean")
 }
+
+// CastExpr represents CAST(Inner AS type) and CONVERT(Inner, type).
+// Values that cannot be converted to a temporal type become NULL, like in MySQL.
+type CastExpr struct {
+	Inner  Expr
+	Target querypb.Type
+	// Scale is the number of decimals kept when casting to DECIMAL
+	Scale int
+}
+
+var _ Expr = (*CastExpr)(nil)
+
+// Evaluate implements the Expr interface
+func (c *CastExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	inner, err := c.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if inner.null() {
+		return inner, nil
+	}
+	switch c.Target {
+	case sqltypes.Int64:
+		if inner.typ == sqltypes.Uint64 {
+			return newEvalInt64(int64(inner.uval)), nil
+		}
+		return newEvalInt64(inner.toInt64()), nil
+	case sqltypes.Uint64:
+		switch {
+		case inner.typ == sqltypes.Uint64:
+			return inner, nil
+		case inner.numeric() && inner.toFloat() >= math.MaxInt64:
+			return newEvalUint64(uint64(inner.toFloat())), nil
+		}
+		return newEvalUint64(uint64(inner.toInt64())), nil
+	case sqltypes.Float64:
+		return newEvalFloat(inner.toFloat()), nil
+	case sqltypes.Decimal:
+		return newEvalDecimal(inner.toFloat(), c.Scale), nil
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Time:
+		t, typ, ok := parseTemporal(inner.toBytes())
+		if !ok || (c.Target != sqltypes.Time && typ == sqltypes.Time) {
+			return newEvalNull(), nil
+		}
+		return newEvalTemporal(t.Round(time.Second), c.Target), nil
+	default:
+		return EvalResult{typ: c.Target, bytes: inner.toBytes()}, nil
+	}
+}
+
+// Type implements the Expr interface
+func (c *CastExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return c.Target, nil
+}
+
+// String implements the Expr interface
+func (c *CastExpr) String() string {
+	return fmt.Sprintf("cast(%s as %s)", c.Inner.String(), strings.ToLower(c.Target.String()))
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"hash/fnv"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// NullsafeCompareCollated is like NullsafeCompare, but text values are compared
+// using the given collation instead of failing with an UnsupportedComparisonError.
+// If the collation is not known, it behaves exactly like NullsafeCompare.
+func NullsafeCompareCollated(v1, v2 sqltypes.Value, collationID collations.ID) (int, error) {
+	if coll := collations.LookupByID(collationID); coll != nil && isCollatable(v1, v2) {
+		return coll.Collate(v1.Raw(), v2.Raw()), nil
+	}
+	return NullsafeCompare(v1, v2)
+}
+
+// NullsafeHashcodeCollated is like NullsafeHashcode, but text values are hashed
+// using their weight string in the given collation, so that two values that are
+// equal for NullsafeCompareCollated have the same hashcode.
+func NullsafeHashcodeCollated(v sqltypes.Value, collationID collations.ID) (int64, error) {
+	if coll := collations.LookupByID(collationID); coll != nil && isCollatable(v, v) {
+		hash := fnv.New64a()
+		_, _ = hash.Write(coll.WeightString(nil, v.Raw()))
+		return int64(hash.Sum64()), nil
+	}
+	return NullsafeHashcode(v)
+}
+
+// mergeCollations returns the collation used to compare two text values. Like in MySQL,
+// a binary string is compared byte by byte, and the collation of a column wins over the
+// one of a literal. Values of an unknown collation cannot be compared in vtgate, because
+// the comparison with a binary collation would not match the rows that MySQL matches.
+func mergeCollations(v1, v2 EvalResult) (collations.Collation, error) {
+	id1, id2 := v1.collation, v2.collation
+	switch {
+	case id1 == collations.Binary || id2 == collations.Binary:
+		id1 = collations.Binary
+	case id1 == collations.Unknown:
+		id1 = id2
+	case id2 != collations.Unknown && id1 != id2:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Illegal mix of collations (%s) and (%s)", id1.Name(), id2.Name())
+	}
+	coll := collations.LookupByID(id1)
+	if coll == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: comparison of text values of an unknown collation in vtgate")
+	}
+	return coll, nil
+}
+
+// textCollation returns the collation used to compare two values as strings, like
+// LIKE and STRCMP do. Numbers are compared by their binary representation.
+func textCollation(v1, v2 EvalResult) (collations.Collation, error) {
+	if !v1.textual() && !v2.textual() {
+		return collations.LookupByID(collations.Binary), nil
+	}
+	return mergeCollations(v1, v2)
+}
+
+// collationOf returns the collation of the text values, or Unknown if they don't
+// have the same collation
+func collationOf(values []EvalResult) collations.ID {
+	var id collations.ID
+	for _, v := range values {
+		if !v.textual() || v.collation == collations.Unknown {
+			continue
+		}
+		if id != collations.Unknown && id != v.collation {
+			return collations.Unknown
+		}
+		id = v.collation
+	}
+	return id
+}
+
+// isCollatable returns true if both values are strings, and at least one of them is text
+func isCollatable(v1, v2 sqltypes.Value) bool {
+	if !v1.IsQuoted() || !v2.IsQuoted() {
+		return false
+	}
+	return v1.IsText() || v2.IsText()
+}
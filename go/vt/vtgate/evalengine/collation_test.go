@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestNullsafeCompareCollated(t *testing.T) {
+	tests := []struct {
+		v1, v2    sqltypes.Value
+		collation collations.ID
+		out       int
+		err       string
+	}{
+		{sqltypes.NewVarChar("a"), sqltypes.NewVarChar("B"), collations.Utf8mb4GeneralCi, -1, ""},
+		{sqltypes.NewVarChar("a"), sqltypes.NewVarChar("A"), collations.Utf8mb4GeneralCi, 0, ""},
+		{sqltypes.NewVarChar("a"), sqltypes.NewVarBinary("A"), collations.Utf8mb4Bin, 1, ""},
+		{sqltypes.NULL, sqltypes.NewVarChar("a"), collations.Utf8mb4GeneralCi, -1, ""},
+		{sqltypes.NewInt64(10), sqltypes.NewVarChar("9"), collations.Utf8mb4GeneralCi, 1, ""},
+		{sqltypes.NewVarBinary("a"), sqltypes.NewVarBinary("A"), collations.Utf8mb4GeneralCi, 1, ""},
+		{sqltypes.NewVarChar("a"), sqltypes.NewVarChar("A"), collations.Unknown, 0, "types are not comparable: VARCHAR vs VARCHAR"},
+	}
+	for _, tcase := range tests {
+		got, err := NullsafeCompareCollated(tcase.v1, tcase.v2, tcase.collation)
+		if tcase.err != "" {
+			require.EqualError(t, err, tcase.err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tcase.out, got, "%v <=> %v", tcase.v1, tcase.v2)
+	}
+}
+
+func TestNullsafeHashcodeCollated(t *testing.T) {
+	h1, err := NullsafeHashcodeCollated(sqltypes.NewVarChar("Résumé"), collations.Utf8mb4GeneralCi)
+	require.NoError(t, err)
+	h2, err := NullsafeHashcodeCollated(sqltypes.NewVarChar("resume  "), collations.Utf8mb4GeneralCi)
+	require.NoError(t, err)
+	assert.Equal(t, h1, h2)
+
+	_, err = NullsafeHashcodeCollated(sqltypes.NewVarChar("resume"), collations.Unknown)
+	require.EqualError(t, err, "types does not support hashcode yet: VARCHAR")
+}
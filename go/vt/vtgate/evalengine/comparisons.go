@@ -0,0 +1,334 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// ComparisonOp is the operator of a ComparisonExpr
+	ComparisonOp int8
+
+	// ComparisonExpr compares two values. Like in MySQL, the result is NULL if
+	// any of the values is NULL, except for the NULL-safe equality operator.
+	ComparisonExpr struct {
+		Op          ComparisonOp
+		Left, Right Expr
+	}
+
+	// InExpr represents `Left IN (Right...)` and `Left NOT IN (Right...)`
+	InExpr struct {
+		Left   Expr
+		Right  []Expr
+		Negate bool
+	}
+
+	// BetweenExpr represents `Left BETWEEN From AND To` and its negation
+	BetweenExpr struct {
+		Left, From, To Expr
+		Negate         bool
+	}
+
+	// LikeExpr represents `Left LIKE Right` and `Left NOT LIKE Right`.
+	// The pattern can use `%` to match any number of characters, and `_` to match
+	// exactly one character. The Escape character defaults to a backslash.
+	LikeExpr struct {
+		Left, Right Expr
+		Escape      byte
+		Negate      bool
+	}
+)
+
+// These constants list the comparison operators
+const (
+	EqualOp ComparisonOp = iota
+	NotEqualOp
+	LessThanOp
+	LessEqualOp
+	GreaterThanOp
+	GreaterEqualOp
+	NullSafeEqualOp
+)
+
+var _ Expr = (*ComparisonExpr)(nil)
+var _ Expr = (*InExpr)(nil)
+var _ Expr = (*BetweenExpr)(nil)
+var _ Expr = (*LikeExpr)(nil)
+
+// compareValues compares two non NULL values following the MySQL rules: when one of
+// the values is a number, they are compared as numbers, and two strings are compared
+// with their collation.
+func compareValues(v1, v2 EvalResult) (int, error) {
+	switch {
+	case v1.numeric() && v2.numeric():
+		return compareNumeric(makeNumeric(v1), makeNumeric(v2))
+	case v1.numeric() || v2.numeric():
+		return compareNumeric(newEvalFloat(v1.toFloat()), newEvalFloat(v2.toFloat()))
+	case v1.textual() && v2.textual():
+		coll, err := mergeCollations(v1, v2)
+		if err != nil {
+			return 0, err
+		}
+		return coll.Collate(v1.bytes, v2.bytes), nil
+	}
+	return bytes.Compare(v1.toBytes(), v2.toBytes()), nil
+}
+
+// Evaluate implements the Expr interface
+func (c *ComparisonExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := c.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	right, err := c.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if left.null() || right.null() {
+		if c.Op == NullSafeEqualOp {
+			return newEvalBool(left.null() && right.null()), nil
+		}
+		return newEvalNull(), nil
+	}
+	cmp, err := compareValues(left, right)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	switch c.Op {
+	case EqualOp, NullSafeEqualOp:
+		return newEvalBool(cmp == 0), nil
+	case NotEqualOp:
+		return newEvalBool(cmp != 0), nil
+	case LessThanOp:
+		return newEvalBool(cmp < 0), nil
+	case LessEqualOp:
+		return newEvalBool(cmp <= 0), nil
+	case GreaterThanOp:
+		return newEvalBool(cmp > 0), nil
+	default:
+		return newEvalBool(cmp >= 0), nil
+	}
+}
+
+// Type implements the Expr interface
+func (c *ComparisonExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (c *ComparisonExpr) String() string {
+	return c.Left.String() + " " + c.Op.String() + " " + c.Right.String()
+}
+
+// String returns the SQL representation of the operator
+func (op ComparisonOp) String() string {
+	switch op {
+	case EqualOp:
+		return "="
+	case NotEqualOp:
+		return "!="
+	case LessThanOp:
+		return "<"
+	case LessEqualOp:
+		return "<="
+	case GreaterThanOp:
+		return ">"
+	case GreaterEqualOp:
+		return ">="
+	case NullSafeEqualOp:
+		return "<=>"
+	}
+	return "?"
+}
+
+// Evaluate implements the Expr interface
+func (i *InExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := i.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if left.null() {
+		return newEvalNull(), nil
+	}
+	foundNull := false
+	for _, expr := range i.Right {
+		right, err := expr.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if right.null() {
+			foundNull = true
+			continue
+		}
+		cmp, err := compareValues(left, right)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if cmp == 0 {
+			return newEvalBool(!i.Negate), nil
+		}
+	}
+	if foundNull {
+		// the value might be equal to the NULL, so we don't know
+		return newEvalNull(), nil
+	}
+	return newEvalBool(i.Negate), nil
+}
+
+// Type implements the Expr interface
+func (i *InExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (i *InExpr) String() string {
+	var values []string
+	for _, expr := range i.Right {
+		values = append(values, expr.String())
+	}
+	op := " in "
+	if i.Negate {
+		op = " not in "
+	}
+	return i.Left.String() + op + "(" + strings.Join(values, ", ") + ")"
+}
+
+// Evaluate implements the Expr interface
+func (b *BetweenExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	lower := &ComparisonExpr{Op: GreaterEqualOp, Left: b.Left, Right: b.From}
+	upper := &ComparisonExpr{Op: LessEqualOp, Left: b.Left, Right: b.To}
+	result, err := (&LogicalExpr{Op: AndOp, Left: lower, Right: upper}).Evaluate(env)
+	if err != nil || !b.Negate {
+		return result, err
+	}
+	return not(result), nil
+}
+
+// Type implements the Expr interface
+func (b *BetweenExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (b *BetweenExpr) String() string {
+	op := " between "
+	if b.Negate {
+		op = " not between "
+	}
+	return b.Left.String() + op + b.From.String() + " and " + b.To.String()
+}
+
+// Evaluate implements the Expr interface
+func (l *LikeExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := l.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	right, err := l.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if left.null() || right.null() {
+		return newEvalNull(), nil
+	}
+	coll, err := textCollation(left, right)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	escape := l.Escape
+	if escape == 0 {
+		escape = '\\'
+	}
+	matched := matchLike(left.toBytes(), right.toBytes(), escape, coll)
+	return newEvalBool(matched != l.Negate), nil
+}
+
+// Type implements the Expr interface
+func (l *LikeExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (l *LikeExpr) String() string {
+	op := " like "
+	if l.Negate {
+		op = " not like "
+	}
+	return l.Left.String() + op + l.Right.String()
+}
+
+type likeToken struct {
+	r        rune
+	wildcard byte // '%', '_' or 0 for a literal character
+}
+
+// matchLike reports whether the text matches the LIKE pattern.
+// The comparison is done character by character, with the given collation.
+func matchLike(text, pattern []byte, escape byte, coll collations.Collation) bool {
+	var tokens []likeToken
+	for len(pattern) > 0 {
+		r, size := utf8.DecodeRune(pattern)
+		pattern = pattern[size:]
+		switch {
+		case r == rune(escape) && len(pattern) > 0:
+			r, size = utf8.DecodeRune(pattern)
+			pattern = pattern[size:]
+			tokens = append(tokens, likeToken{r: r})
+		case r == '%' || r == '_':
+			tokens = append(tokens, likeToken{wildcard: byte(r)})
+		default:
+			tokens = append(tokens, likeToken{r: r})
+		}
+	}
+	runes := []rune(string(text))
+
+	// classic wildcard matching, backtracking to the last '%' on mismatch
+	ti, pi := 0, 0
+	star, starText := -1, 0
+	for ti < len(runes) {
+		switch {
+		case pi < len(tokens) && tokens[pi].wildcard == '%':
+			star, starText = pi, ti
+			pi++
+		case pi < len(tokens) && (tokens[pi].wildcard == '_' || (tokens[pi].wildcard == 0 && equalRunes(coll, tokens[pi].r, runes[ti]))):
+			pi++
+			ti++
+		case star != -1:
+			starText++
+			ti = starText
+			pi = star + 1
+		default:
+			return false
+		}
+	}
+	for pi < len(tokens) && tokens[pi].wildcard == '%' {
+		pi++
+	}
+	return pi == len(tokens)
+}
+
+// equalRunes returns true if the two characters are equal in the collation
+func equalRunes(coll collations.Collation, r1, r2 rune) bool {
+	return r1 == r2 || coll.Collate([]byte(string(r1)), []byte(string(r2))) == 0
+}
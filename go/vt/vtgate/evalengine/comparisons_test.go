@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+)
+
+func newEvalText(s string, collation collations.ID) EvalResult {
+	result := newEvalBytes([]byte(s))
+	result.collation = collation
+	return result
+}
+
+func TestMatchLike(t *testing.T) {
+	tests := []struct {
+		text, pattern string
+		escape        byte
+		match         bool
+	}{
+		{"", "", '\\', true},
+		{"", "%", '\\', true},
+		{"abc", "abc", '\\', true},
+		{"abc", "ab", '\\', false},
+		{"abc", "a%", '\\', true},
+		{"abc", "%c", '\\', true},
+		{"abc", "%b%", '\\', true},
+		{"abc", "a_c", '\\', true},
+		{"abc", "a__c", '\\', false},
+		{"aXbXc", "a%b%c", '\\', true},
+		{"abcbd", "%b_", '\\', true},
+		{"abcbd", "%bc", '\\', false},
+		{"ñandú", "_and_", '\\', true},
+		{"10%", "10\\%", '\\', true},
+		{"100", "10\\%", '\\', false},
+		{"a_b", "a|_b", '|', true},
+		{"axb", "a|_b", '|', false},
+		{"ABC", "abc", '\\', false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.text+" like "+tc.pattern, func(t *testing.T) {
+			assert.Equal(t, tc.match, matchLike([]byte(tc.text), []byte(tc.pattern), tc.escape, collations.LookupByID(collations.Binary)))
+		})
+	}
+}
+
+func TestMatchLikeCollation(t *testing.T) {
+	generalCi := collations.LookupByID(collations.Utf8mb4GeneralCi)
+	tests := []struct {
+		text, pattern string
+		match         bool
+	}{
+		{"ABC", "abc", true},
+		{"ABC", "a%", true},
+		{"Ábc", "a_C", true},
+		{"abc", "abd", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.text+" like "+tc.pattern, func(t *testing.T) {
+			assert.Equal(t, tc.match, matchLike([]byte(tc.text), []byte(tc.pattern), '\\', generalCi))
+		})
+	}
+}
+
+func TestCompareValues(t *testing.T) {
+	tests := []struct {
+		v1, v2 EvalResult
+		out    int
+	}{
+		{newEvalInt64(1), newEvalInt64(2), -1},
+		{newEvalInt64(-1), newEvalUint64(1), -1},
+		{newEvalFloat(1.5), newEvalInt64(1), 1},
+		{newEvalBytes([]byte("10")), newEvalInt64(9), 1},
+		{newEvalText("10", collations.Binary), newEvalBytes([]byte("9")), -1},
+		{newEvalBytes([]byte("1abc")), newEvalInt64(1), 0},
+		{newEvalText("abc", collations.Utf8mb4GeneralCi), newEvalBytes([]byte("ABC")), 0},
+		{newEvalText("abc", collations.Utf8mb4Bin), newEvalBytes([]byte("ABC")), 1},
+		{newEvalBytes([]byte("abc")), newEvalText("ABC", collations.Utf8mb4GeneralCi), 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.v1.debugString()+" vs "+tc.v2.debugString(), func(t *testing.T) {
+			cmp, err := compareValues(tc.v1, tc.v2)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.out, cmp)
+		})
+	}
+}
+
+func TestCompareValuesCollation(t *testing.T) {
+	_, err := compareValues(newEvalBytes([]byte("a")), newEvalBytes([]byte("A")))
+	require.EqualError(t, err, "unsupported: comparison of text values of an unknown collation in vtgate")
+
+	_, err = compareValues(newEvalText("a", collations.Utf8mb4GeneralCi), newEvalText("A", collations.Latin1SwedishCi))
+	require.EqualError(t, err, "Illegal mix of collations (utf8mb4_general_ci) and (latin1_swedish_ci)")
+
+	cmp, err := compareValues(newEvalText("a", collations.Utf8mb4GeneralCi), newEvalText("A", collations.Binary))
+	require.NoError(t, err)
+	assert.Equal(t, 1, cmp)
+}
@@ -17,9 +17,11 @@ limitations under the License.
 package evalengine
 
 import (
-	"vitess.io/vitess/go/sqltypes"
-
+	"math"
 	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -204,7 +206,7 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.ival), 10))
 		case sqltypes.Uint64, sqltypes.Uint32:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.uval), 10))
-		case sqltypes.Float64, sqltypes.Float32:
+		case sqltypes.Float64, sqltypes.Float32, sqltypes.Decimal:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.fval), 10))
 		}
 	case sqltypes.IsUnsigned(resultType):
@@ -213,7 +215,7 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.uval), 10))
 		case sqltypes.Int64, sqltypes.Int32:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.ival), 10))
-		case sqltypes.Float64, sqltypes.Float32:
+		case sqltypes.Float64, sqltypes.Float32, sqltypes.Decimal:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.fval), 10))
 		}
 	case sqltypes.IsFloat(resultType) || resultType == sqltypes.Decimal:
@@ -228,6 +230,8 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 				format = 'f'
 			}
 			return sqltypes.MakeTrusted(resultType, strconv.AppendFloat(nil, float64(v.fval), format, -1, 64))
+		case sqltypes.Decimal:
+			return sqltypes.MakeTrusted(resultType, v.toBytes())
 		}
 	default:
 		return sqltypes.MakeTrusted(resultType, v.bytes)
@@ -312,3 +316,176 @@ func compareNumeric(v1, v2 EvalResult) (int, error) {
 	// v1>v2
 	return 1, nil
 }
+
+func newEvalNull() EvalResult {
+	return EvalResult{typ: sqltypes.Null}
+}
+
+func newEvalInt64(i int64) EvalResult {
+	return EvalResult{typ: sqltypes.Int64, ival: i}
+}
+
+func newEvalUint64(u uint64) EvalResult {
+	return EvalResult{typ: sqltypes.Uint64, uval: u}
+}
+
+func newEvalFloat(f float64) EvalResult {
+	return EvalResult{typ: sqltypes.Float64, fval: f}
+}
+
+func newEvalBytes(b []byte) EvalResult {
+	return EvalResult{typ: sqltypes.VarBinary, bytes: b}
+}
+
+// newEvalDecimal returns a DECIMAL value rounded to the given number of decimals
+func newEvalDecimal(f float64, scale int) EvalResult {
+	pow := math.Pow10(scale)
+	f = math.Round(f*pow) / pow
+	return EvalResult{typ: sqltypes.Decimal, fval: f, bytes: strconv.AppendFloat(nil, f, 'f', scale, 64)}
+}
+
+func newEvalBool(b bool) EvalResult {
+	if b {
+		return newEvalInt64(1)
+	}
+	return newEvalInt64(0)
+}
+
+func (v *EvalResult) null() bool {
+	return v.typ == sqltypes.Null
+}
+
+func (v *EvalResult) numeric() bool {
+	return sqltypes.IsNumber(v.typ)
+}
+
+func (v *EvalResult) textual() bool {
+	return sqltypes.IsText(v.typ) || sqltypes.IsBinary(v.typ)
+}
+
+// toFloat converts the value to a float64 the way MySQL does it in a numeric context:
+// strings are parsed up to the first character that cannot be part of a number.
+func (v *EvalResult) toFloat() float64 {
+	switch {
+	case sqltypes.IsSigned(v.typ):
+		return float64(v.ival)
+	case sqltypes.IsUnsigned(v.typ):
+		return float64(v.uval)
+	case sqltypes.IsFloat(v.typ) || v.typ == sqltypes.Decimal:
+		return v.fval
+	}
+	return parseFloatPrefix(v.bytes)
+}
+
+// toInt64 converts the value to an int64 the way MySQL does it in an integer context:
+// floats are rounded, and strings are parsed up to the first non digit character.
+func (v *EvalResult) toInt64() int64 {
+	switch {
+	case sqltypes.IsSigned(v.typ):
+		return v.ival
+	case sqltypes.IsUnsigned(v.typ):
+		return int64(v.uval)
+	case sqltypes.IsFloat(v.typ) || v.typ == sqltypes.Decimal:
+		return roundToInt64(v.fval)
+	}
+	return parseIntPrefix(v.bytes)
+}
+
+// toBytes returns the textual representation of the value.
+func (v *EvalResult) toBytes() []byte {
+	switch {
+	case sqltypes.IsSigned(v.typ):
+		return strconv.AppendInt(nil, v.ival, 10)
+	case sqltypes.IsUnsigned(v.typ):
+		return strconv.AppendUint(nil, v.uval, 10)
+	case sqltypes.IsFloat(v.typ):
+		return strconv.AppendFloat(nil, v.fval, 'g', -1, 64)
+	case v.typ == sqltypes.Decimal && v.bytes == nil:
+		return strconv.AppendFloat(nil, v.fval, 'f', -1, 64)
+	}
+	return v.bytes
+}
+
+// truthy returns the value of the expression when used as a condition.
+// The second return value is true when the value is NULL, which is neither true nor false.
+func (v *EvalResult) truthy() (bool, bool) {
+	switch {
+	case v.null():
+		return false, true
+	case sqltypes.IsSigned(v.typ):
+		return v.ival != 0, false
+	case sqltypes.IsUnsigned(v.typ):
+		return v.uval != 0, false
+	}
+	return v.toFloat() != 0, false
+}
+
+func roundToInt64(f float64) int64 {
+	switch {
+	case f >= math.MaxInt64:
+		return math.MaxInt64
+	case f <= math.MinInt64:
+		return math.MinInt64
+	}
+	return int64(math.Round(f))
+}
+
+// parseFloatPrefix parses the longest prefix of the input that is a valid number,
+// returning 0 if there is none, like MySQL does when converting a string to a number.
+func parseFloatPrefix(b []byte) float64 {
+	s := strings.TrimLeft(string(b), " \t\n\r")
+	end := 0
+	if end < len(s) && (s[end] == '+' || s[end] == '-') {
+		end++
+	}
+	digits := 0
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+		digits++
+	}
+	if end < len(s) && s[end] == '.' {
+		end++
+		for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+			end++
+			digits++
+		}
+	}
+	if digits == 0 {
+		return 0
+	}
+	if end < len(s) && (s[end] == 'e' || s[end] == 'E') {
+		exp := end + 1
+		if exp < len(s) && (s[exp] == '+' || s[exp] == '-') {
+			exp++
+		}
+		if exp < len(s) && s[exp] >= '0' && s[exp] <= '9' {
+			for exp < len(s) && s[exp] >= '0' && s[exp] <= '9' {
+				exp++
+			}
+			end = exp
+		}
+	}
+	f, _ := strconv.ParseFloat(s[:end], 64)
+	return f
+}
+
+// parseIntPrefix parses the longest prefix of the input that is a valid integer,
+// returning 0 if there is none. Values out of range are clamped.
+func parseIntPrefix(b []byte) int64 {
+	s := strings.TrimLeft(string(b), " \t\n\r")
+	end := 0
+	if end < len(s) && (s[end] == '+' || s[end] == '-') {
+		end++
+	}
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	i, err := strconv.ParseInt(s[:end], 10, 64)
+	if err != nil {
+		if numErr, ok := err.(*strconv.NumError); ok && numErr.Err == strconv.ErrRange {
+			return i
+		}
+		return 0
+	}
+	return i
+}
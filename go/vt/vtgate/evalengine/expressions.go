@@ -18,8 +18,10 @@ package evalengine
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -34,6 +36,8 @@ type (
 		uval  uint64
 		fval  float64
 		bytes []byte
+		// collation is the collation of a text value, when it is known
+		collation collations.ID
 	}
 	//ExpressionEnv contains the environment that the expression
 	//evaluates in, such as the current row and bindvars
@@ -59,17 +63,28 @@ type (
 	// Expressions
 	Literal      struct{ Val EvalResult }
 	BindVariable struct{ Key string }
-	Column       struct{ Offset int }
-	BinaryOp     struct {
+	Column       struct {
+		Offset int
+		// Collation is the collation of the column, when it holds text
+		Collation collations.ID
+	}
+	BinaryOp struct {
 		Expr        BinaryExpr
 		Left, Right Expr
 	}
 
+	// NegateExpr represents the unary minus operator
+	NegateExpr struct {
+		Inner Expr
+	}
+
 	// Binary ops
-	Addition       struct{}
-	Subtraction    struct{}
-	Multiplication struct{}
-	Division       struct{}
+	Addition        struct{}
+	Subtraction     struct{}
+	Multiplication  struct{}
+	Division        struct{}
+	IntegerDivision struct{}
+	Modulo          struct{}
 )
 
 //Value allows for retrieval of the value we expose for public consumption
@@ -105,6 +120,11 @@ func NewLiteralString(val []byte) Expr {
 	return &Literal{EvalResult{typ: sqltypes.VarBinary, bytes: val}}
 }
 
+//NewLiteralNull returns a NULL literal expression
+func NewLiteralNull() Expr {
+	return &Literal{EvalResult{typ: sqltypes.Null}}
+}
+
 //NewBindVar returns a bind variable
 func NewBindVar(key string) Expr {
 	return &BindVariable{Key: key}
@@ -117,15 +137,26 @@ func NewColumn(offset int) Expr {
 	}
 }
 
+// NewColumnWithCollation returns a column holding text values of the given collation
+func NewColumnWithCollation(offset int, collation collations.ID) Expr {
+	return &Column{
+		Offset:    offset,
+		Collation: collation,
+	}
+}
+
 var _ Expr = (*Literal)(nil)
 var _ Expr = (*BindVariable)(nil)
 var _ Expr = (*BinaryOp)(nil)
 var _ Expr = (*Column)(nil)
+var _ Expr = (*NegateExpr)(nil)
 
 var _ BinaryExpr = (*Addition)(nil)
 var _ BinaryExpr = (*Subtraction)(nil)
 var _ BinaryExpr = (*Multiplication)(nil)
 var _ BinaryExpr = (*Division)(nil)
+var _ BinaryExpr = (*IntegerDivision)(nil)
+var _ BinaryExpr = (*Modulo)(nil)
 
 //Evaluate implements the Expr interface
 func (b *BinaryOp) Evaluate(env ExpressionEnv) (EvalResult, error) {
@@ -137,9 +168,41 @@ func (b *BinaryOp) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	if err != nil {
 		return EvalResult{}, err
 	}
+	if lVal.null() || rVal.null() {
+		return newEvalNull(), nil
+	}
 	return b.Expr.Evaluate(lVal, rVal)
 }
 
+//Evaluate implements the Expr interface
+func (n *NegateExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	inner, err := n.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if inner.null() {
+		return inner, nil
+	}
+	inner = makeNumeric(inner)
+	switch inner.typ {
+	case sqltypes.Int64:
+		if inner.ival == math.MinInt64 {
+			return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT value is out of range in -%d", inner.ival)
+		}
+		return newEvalInt64(-inner.ival), nil
+	case sqltypes.Uint64:
+		if inner.uval <= math.MaxInt64 {
+			return newEvalInt64(-int64(inner.uval)), nil
+		}
+		if inner.uval == math.MaxInt64+1 {
+			return newEvalInt64(math.MinInt64), nil
+		}
+		return newEvalFloat(-float64(inner.uval)), nil
+	default:
+		return newEvalFloat(-inner.fval), nil
+	}
+}
+
 //Evaluate implements the Expr interface
 func (l *Literal) Evaluate(ExpressionEnv) (EvalResult, error) {
 	return l.Val, nil
@@ -157,8 +220,17 @@ func (b *BindVariable) Evaluate(env ExpressionEnv) (EvalResult, error) {
 //Evaluate implements the Expr interface
 func (c *Column) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	value := env.Row[c.Offset]
-	numeric, err := newEvalResult(value)
-	return numeric, err
+	result, err := newEvalResult(value)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	switch {
+	case value.IsBinary():
+		result.collation = collations.Binary
+	case value.IsText():
+		result.collation = c.Collation
+	}
+	return result, nil
 }
 
 //Evaluate implements the BinaryOp interface
@@ -178,9 +250,74 @@ func (m *Multiplication) Evaluate(left, right EvalResult) (EvalResult, error) {
 
 //Evaluate implements the BinaryOp interface
 func (d *Division) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if right.toFloat() == 0 {
+		// division by zero returns NULL in MySQL
+		return newEvalNull(), nil
+	}
 	return divideNumericWithError(left, right)
 }
 
+//Evaluate implements the BinaryOp interface
+func (d *IntegerDivision) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if right.toFloat() == 0 {
+		return newEvalNull(), nil
+	}
+	left, right = makeNumeric(left), makeNumeric(right)
+	if left.typ == sqltypes.Int64 && right.typ == sqltypes.Int64 {
+		if left.ival == math.MinInt64 && right.ival == -1 {
+			return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT value is out of range in %d DIV %d", left.ival, right.ival)
+		}
+		return newEvalInt64(left.ival / right.ival), nil
+	}
+	if left.typ == sqltypes.Uint64 && right.typ == sqltypes.Uint64 {
+		return newEvalUint64(left.uval / right.uval), nil
+	}
+	result := math.Trunc(left.toFloat() / right.toFloat())
+	if result > math.MaxInt64 || result < math.MinInt64 {
+		return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT value is out of range in %v DIV %v", left.toFloat(), right.toFloat())
+	}
+	return newEvalInt64(int64(result)), nil
+}
+
+//Evaluate implements the BinaryOp interface
+func (m *Modulo) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return modulo(left, right), nil
+}
+
+// modulo returns the remainder of left divided by right, which has the sign of left
+// like in MySQL, or NULL if right is zero
+func modulo(left, right EvalResult) EvalResult {
+	if right.toFloat() == 0 {
+		return newEvalNull()
+	}
+	left, right = makeNumeric(left), makeNumeric(right)
+	switch {
+	case left.typ == sqltypes.Int64 && right.typ == sqltypes.Int64:
+		if right.ival == -1 {
+			return newEvalInt64(0)
+		}
+		return newEvalInt64(left.ival % right.ival)
+	case left.typ == sqltypes.Uint64 && right.typ == sqltypes.Uint64:
+		return newEvalUint64(left.uval % right.uval)
+	case left.typ == sqltypes.Uint64 && right.typ == sqltypes.Int64:
+		return newEvalUint64(left.uval % absUint64(right.ival))
+	case left.typ == sqltypes.Int64 && right.typ == sqltypes.Uint64:
+		rem := absUint64(left.ival) % right.uval
+		if left.ival < 0 {
+			return newEvalInt64(-int64(rem))
+		}
+		return newEvalInt64(int64(rem))
+	}
+	return newEvalFloat(math.Mod(left.toFloat(), right.toFloat()))
+}
+
+func absUint64(i int64) uint64 {
+	if i < 0 {
+		return uint64(-(i + 1)) + 1
+	}
+	return uint64(i)
+}
+
 //Type implements the BinaryExpr interface
 func (a *Addition) Type(left querypb.Type) querypb.Type {
 	return left
@@ -201,6 +338,31 @@ func (s *Subtraction) Type(left querypb.Type) querypb.Type {
 	return left
 }
 
+//Type implements the BinaryExpr interface
+func (d *IntegerDivision) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (m *Modulo) Type(left querypb.Type) querypb.Type {
+	return left
+}
+
+//Type implements the Expr interface
+func (n *NegateExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	typ, err := n.Inner.Type(env)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case sqltypes.IsSigned(typ), sqltypes.IsUnsigned(typ):
+		return sqltypes.Int64, nil
+	case typ == sqltypes.Null:
+		return typ, nil
+	}
+	return sqltypes.Float64, nil
+}
+
 //Type implements the Expr interface
 func (b *BinaryOp) Type(env ExpressionEnv) (querypb.Type, error) {
 	ltype, err := b.Left.Type(env)
@@ -255,6 +417,21 @@ func (a *Addition) String() string {
 	return "+"
 }
 
+//String implements the BinaryExpr interface
+func (d *IntegerDivision) String() string {
+	return "div"
+}
+
+//String implements the BinaryExpr interface
+func (m *Modulo) String() string {
+	return "%"
+}
+
+//String implements the Expr interface
+func (n *NegateExpr) String() string {
+	return "-" + n.Inner.String()
+}
+
 //String implements the Expr interface
 func (b *BinaryOp) String() string {
 	return b.Left.String() + " " + b.Expr.String() + " " + b.Right.String()
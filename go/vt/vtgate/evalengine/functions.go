@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// CallExpr is a call to one of the scalar functions of the builtinFunctions registry
+	CallExpr struct {
+		Name      string
+		Arguments []Expr
+	}
+
+	// builtin describes a scalar function that can be evaluated at the vtgate
+	builtin struct {
+		// minArgs and maxArgs bound the number of arguments. maxArgs is -1 for variadic functions.
+		minArgs, maxArgs int
+		// nullable is true when the function returns NULL as soon as one of its arguments is NULL.
+		// In that case, call is never invoked with a NULL argument.
+		nullable bool
+		call     func(args []EvalResult) (EvalResult, error)
+		typeOf   func(env ExpressionEnv, args []Expr) (querypb.Type, error)
+	}
+)
+
+var _ Expr = (*CallExpr)(nil)
+
+// builtinFunctions is the registry of the functions supported by the evalengine, keyed by
+// their lowercase name. Functions that depend on the session, such as NOW() or DATABASE(),
+// are deliberately missing: they have to be evaluated by MySQL.
+var builtinFunctions = map[string]builtin{}
+
+func register(fn builtin, names ...string) {
+	for _, name := range names {
+		builtinFunctions[name] = fn
+	}
+}
+
+func init() {
+	register(builtin{minArgs: 1, maxArgs: -1, call: coalesce, typeOf: typeOfAll}, "coalesce")
+	register(builtin{minArgs: 2, maxArgs: 2, call: coalesce, typeOf: typeOfAll}, "ifnull")
+	register(builtin{minArgs: 2, maxArgs: 2, call: nullIf, typeOf: typeOfArg(0)}, "nullif")
+	register(builtin{minArgs: 3, maxArgs: 3, call: ifFunc, typeOf: typeOfIf}, "if")
+
+	registerStringFunctions()
+	registerMathFunctions()
+	registerTimeFunctions()
+}
+
+// SupportsFunction returns true if the named function can be evaluated by the evalengine
+func SupportsFunction(name string) bool {
+	_, ok := builtinFunctions[strings.ToLower(name)]
+	return ok
+}
+
+// NewCallExpr returns an expression calling the named function with the given arguments
+func NewCallExpr(name string, args []Expr) (Expr, error) {
+	name = strings.ToLower(name)
+	fn, ok := builtinFunctions[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported function: %s", name)
+	}
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect parameter count in the call to native function '%s'", name)
+	}
+	return &CallExpr{Name: name, Arguments: args}, nil
+}
+
+// Evaluate implements the Expr interface
+func (c *CallExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	fn, ok := builtinFunctions[c.Name]
+	if !ok {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported function: %s", c.Name)
+	}
+	args := make([]EvalResult, 0, len(c.Arguments))
+	for _, expr := range c.Arguments {
+		arg, err := expr.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if fn.nullable && arg.null() {
+			return newEvalNull(), nil
+		}
+		args = append(args, arg)
+	}
+	result, err := fn.call(args)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if result.textual() && result.collation == collations.Unknown {
+		// the text built from the arguments keeps their collation
+		result.collation = collationOf(args)
+	}
+	return result, nil
+}
+
+// Type implements the Expr interface
+func (c *CallExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	fn, ok := builtinFunctions[c.Name]
+	if !ok {
+		return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported function: %s", c.Name)
+	}
+	return fn.typeOf(env, c.Arguments)
+}
+
+// String implements the Expr interface
+func (c *CallExpr) String() string {
+	args := make([]string, 0, len(c.Arguments))
+	for _, arg := range c.Arguments {
+		args = append(args, arg.String())
+	}
+	return c.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func returns(typ querypb.Type) func(ExpressionEnv, []Expr) (querypb.Type, error) {
+	return func(ExpressionEnv, []Expr) (querypb.Type, error) {
+		return typ, nil
+	}
+}
+
+func typeOfArg(i int) func(ExpressionEnv, []Expr) (querypb.Type, error) {
+	return func(env ExpressionEnv, args []Expr) (querypb.Type, error) {
+		return args[i].Type(env)
+	}
+}
+
+func typeOfAll(env ExpressionEnv, args []Expr) (querypb.Type, error) {
+	return aggregateTypes(env, args)
+}
+
+func typeOfIf(env ExpressionEnv, args []Expr) (querypb.Type, error) {
+	return aggregateTypes(env, args[1:])
+}
+
+func coalesce(args []EvalResult) (EvalResult, error) {
+	for _, arg := range args {
+		if !arg.null() {
+			return arg, nil
+		}
+	}
+	return newEvalNull(), nil
+}
+
+func nullIf(args []EvalResult) (EvalResult, error) {
+	if args[0].null() || args[1].null() {
+		return args[0], nil
+	}
+	cmp, err := compareValues(args[0], args[1])
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if cmp == 0 {
+		return newEvalNull(), nil
+	}
+	return args[0], nil
+}
+
+func ifFunc(args []EvalResult) (EvalResult, error) {
+	if cond, _ := args[0].truthy(); cond {
+		return args[1], nil
+	}
+	return args[2], nil
+}
+
+// textArg returns the value of a string argument
+func textArg(v EvalResult) string {
+	return string(v.toBytes())
+}
+
+// returnsText is the type of the functions returning a string
+var returnsText = returns(sqltypes.VarBinary)
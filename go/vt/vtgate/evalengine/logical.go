@@ -0,0 +1,342 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// LogicalOp is the operator of a LogicalExpr
+	LogicalOp int8
+
+	// LogicalExpr represents AND, OR and XOR, using the three-valued logic of SQL
+	LogicalExpr struct {
+		Op          LogicalOp
+		Left, Right Expr
+	}
+
+	// NotExpr represents NOT
+	NotExpr struct {
+		Inner Expr
+	}
+
+	// IsOp is the operator of an IsExpr
+	IsOp int8
+
+	// IsExpr represents `Inner IS [NOT] NULL|TRUE|FALSE`. It never returns NULL.
+	IsExpr struct {
+		Inner Expr
+		Op    IsOp
+	}
+
+	// CaseExpr represents both forms of CASE: when Base is set, it is compared
+	// with each When, otherwise each When is evaluated as a condition.
+	CaseExpr struct {
+		Base  Expr
+		Whens []WhenThen
+		Else  Expr
+	}
+
+	// WhenThen is a WHEN ... THEN ... branch of a CaseExpr
+	WhenThen struct {
+		When, Then Expr
+	}
+)
+
+// These constants list the logical operators
+const (
+	AndOp LogicalOp = iota
+	OrOp
+	XorOp
+)
+
+// These constants list the IS operators
+const (
+	IsNullOp IsOp = iota
+	IsNotNullOp
+	IsTrueOp
+	IsNotTrueOp
+	IsFalseOp
+	IsNotFalseOp
+)
+
+var _ Expr = (*LogicalExpr)(nil)
+var _ Expr = (*NotExpr)(nil)
+var _ Expr = (*IsExpr)(nil)
+var _ Expr = (*CaseExpr)(nil)
+
+// Evaluate implements the Expr interface
+func (l *LogicalExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := l.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	lval, lnull := left.truthy()
+
+	// AND and OR do not need to evaluate the right side when the left decides the result
+	switch {
+	case l.Op == AndOp && !lval && !lnull:
+		return newEvalBool(false), nil
+	case l.Op == OrOp && lval:
+		return newEvalBool(true), nil
+	}
+
+	right, err := l.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	rval, rnull := right.truthy()
+
+	switch l.Op {
+	case AndOp:
+		if !rval && !rnull {
+			return newEvalBool(false), nil
+		}
+		if lnull || rnull {
+			return newEvalNull(), nil
+		}
+		return newEvalBool(true), nil
+	case OrOp:
+		if rval {
+			return newEvalBool(true), nil
+		}
+		if lnull || rnull {
+			return newEvalNull(), nil
+		}
+		return newEvalBool(false), nil
+	default:
+		if lnull || rnull {
+			return newEvalNull(), nil
+		}
+		return newEvalBool(lval != rval), nil
+	}
+}
+
+// Type implements the Expr interface
+func (l *LogicalExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (l *LogicalExpr) String() string {
+	return l.Left.String() + " " + l.Op.String() + " " + l.Right.String()
+}
+
+// String returns the SQL representation of the operator
+func (op LogicalOp) String() string {
+	switch op {
+	case AndOp:
+		return "and"
+	case OrOp:
+		return "or"
+	}
+	return "xor"
+}
+
+// EvaluateToBool evaluates the expression as the condition of a WHERE clause,
+// where NULL is not true
+func EvaluateToBool(expr Expr, env ExpressionEnv) (bool, error) {
+	v, err := expr.Evaluate(env)
+	if err != nil {
+		return false, err
+	}
+	val, _ := v.truthy()
+	return val, nil
+}
+
+func not(v EvalResult) EvalResult {
+	val, null := v.truthy()
+	if null {
+		return newEvalNull()
+	}
+	return newEvalBool(!val)
+}
+
+// Evaluate implements the Expr interface
+func (n *NotExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	inner, err := n.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return not(inner), nil
+}
+
+// Type implements the Expr interface
+func (n *NotExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (n *NotExpr) String() string {
+	return "not " + n.Inner.String()
+}
+
+// Evaluate implements the Expr interface
+func (i *IsExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	inner, err := i.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	val, null := inner.truthy()
+	switch i.Op {
+	case IsNullOp:
+		return newEvalBool(null), nil
+	case IsNotNullOp:
+		return newEvalBool(!null), nil
+	case IsTrueOp:
+		return newEvalBool(!null && val), nil
+	case IsNotTrueOp:
+		return newEvalBool(null || !val), nil
+	case IsFalseOp:
+		return newEvalBool(!null && !val), nil
+	default:
+		return newEvalBool(null || val), nil
+	}
+}
+
+// Type implements the Expr interface
+func (i *IsExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (i *IsExpr) String() string {
+	return i.Inner.String() + " " + i.Op.String()
+}
+
+// String returns the SQL representation of the operator
+func (op IsOp) String() string {
+	switch op {
+	case IsNullOp:
+		return "is null"
+	case IsNotNullOp:
+		return "is not null"
+	case IsTrueOp:
+		return "is true"
+	case IsNotTrueOp:
+		return "is not true"
+	case IsFalseOp:
+		return "is false"
+	}
+	return "is not false"
+}
+
+// Evaluate implements the Expr interface
+func (c *CaseExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	var base EvalResult
+	if c.Base != nil {
+		var err error
+		base, err = c.Base.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+	}
+	for _, branch := range c.Whens {
+		when, err := branch.When.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		matched := false
+		if c.Base != nil {
+			if !base.null() && !when.null() {
+				cmp, err := compareValues(base, when)
+				if err != nil {
+					return EvalResult{}, err
+				}
+				matched = cmp == 0
+			}
+		} else {
+			matched, _ = when.truthy()
+		}
+		if matched {
+			return branch.Then.Evaluate(env)
+		}
+	}
+	if c.Else == nil {
+		return newEvalNull(), nil
+	}
+	return c.Else.Evaluate(env)
+}
+
+// Type implements the Expr interface. The type of the CASE is the type of its results
+// when they all agree, the widest numeric type when they are all numbers, or a string.
+func (c *CaseExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	results := make([]Expr, 0, len(c.Whens)+1)
+	for _, branch := range c.Whens {
+		results = append(results, branch.Then)
+	}
+	if c.Else != nil {
+		results = append(results, c.Else)
+	}
+	return aggregateTypes(env, results)
+}
+
+// String implements the Expr interface
+func (c *CaseExpr) String() string {
+	var sb strings.Builder
+	sb.WriteString("case")
+	if c.Base != nil {
+		sb.WriteString(" " + c.Base.String())
+	}
+	for _, branch := range c.Whens {
+		sb.WriteString(" when " + branch.When.String() + " then " + branch.Then.String())
+	}
+	if c.Else != nil {
+		sb.WriteString(" else " + c.Else.String())
+	}
+	sb.WriteString(" end")
+	return sb.String()
+}
+
+// aggregateTypes returns the type of an expression that can return the value of any of the given expressions
+func aggregateTypes(env ExpressionEnv, exprs []Expr) (querypb.Type, error) {
+	result := sqltypes.Null
+	for _, expr := range exprs {
+		typ, err := expr.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case typ == sqltypes.Null || typ == result:
+		case result == sqltypes.Null:
+			result = typ
+		case sqltypes.IsNumber(result) && sqltypes.IsNumber(typ):
+			result = widestNumericType(result, typ)
+		default:
+			return sqltypes.VarBinary, nil
+		}
+	}
+	return result, nil
+}
+
+func widestNumericType(t1, t2 querypb.Type) querypb.Type {
+	switch {
+	case sqltypes.IsFloat(t1) || sqltypes.IsFloat(t2):
+		return sqltypes.Float64
+	case t1 == sqltypes.Decimal || t2 == sqltypes.Decimal:
+		return sqltypes.Decimal
+	case sqltypes.IsUnsigned(t1) && sqltypes.IsUnsigned(t2):
+		return sqltypes.Uint64
+	case sqltypes.IsUnsigned(t1) || sqltypes.IsUnsigned(t2):
+		return sqltypes.Decimal
+	}
+	return sqltypes.Int64
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"math"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+func registerMathFunctions() {
+	returnsFloat := returns(sqltypes.Float64)
+	returnsInt := returns(sqltypes.Int64)
+
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: abs, typeOf: typeOfNumericArg}, "abs")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: rounding(math.Ceil), typeOf: typeOfIntegralArg}, "ceil", "ceiling")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: rounding(math.Floor), typeOf: typeOfIntegralArg}, "floor")
+	register(builtin{minArgs: 1, maxArgs: 2, nullable: true, call: roundTo(math.Round), typeOf: typeOfNumericArg}, "round")
+	register(builtin{minArgs: 2, maxArgs: 2, nullable: true, call: roundTo(math.Trunc), typeOf: typeOfNumericArg}, "truncate")
+	register(builtin{minArgs: 2, maxArgs: 2, nullable: true, call: mod, typeOf: typeOfNumericArg}, "mod")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: sign, typeOf: returnsInt}, "sign")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: floatFunc(math.Sqrt, positive), typeOf: returnsFloat}, "sqrt")
+	register(builtin{minArgs: 2, maxArgs: 2, nullable: true, call: pow, typeOf: returnsFloat}, "pow", "power")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: floatFunc(math.Exp, nil), typeOf: returnsFloat}, "exp")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: floatFunc(math.Log, strictlyPositive), typeOf: returnsFloat}, "ln")
+	register(builtin{minArgs: 1, maxArgs: 2, nullable: true, call: log, typeOf: returnsFloat}, "log")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: floatFunc(math.Log2, strictlyPositive), typeOf: returnsFloat}, "log2")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: floatFunc(math.Log10, strictlyPositive), typeOf: returnsFloat}, "log10")
+	register(builtin{minArgs: 0, maxArgs: 0, call: pi, typeOf: returnsFloat}, "pi")
+	register(builtin{minArgs: 2, maxArgs: -1, nullable: true, call: extremum(1), typeOf: typeOfAll}, "greatest")
+	register(builtin{minArgs: 2, maxArgs: -1, nullable: true, call: extremum(-1), typeOf: typeOfAll}, "least")
+}
+
+// typeOfNumericArg is the type of the functions returning a number of the same kind as their first argument
+func typeOfNumericArg(env ExpressionEnv, args []Expr) (querypb.Type, error) {
+	typ, err := args[0].Type(env)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case sqltypes.IsSigned(typ):
+		return sqltypes.Int64, nil
+	case sqltypes.IsUnsigned(typ):
+		return sqltypes.Uint64, nil
+	case typ == sqltypes.Decimal:
+		return sqltypes.Decimal, nil
+	}
+	return sqltypes.Float64, nil
+}
+
+// typeOfIntegralArg is the type of CEIL and FLOOR, which return integers for exact values
+func typeOfIntegralArg(env ExpressionEnv, args []Expr) (querypb.Type, error) {
+	typ, err := typeOfNumericArg(env, args)
+	if err != nil || typ != sqltypes.Decimal {
+		return typ, err
+	}
+	return sqltypes.Int64, nil
+}
+
+func abs(args []EvalResult) (EvalResult, error) {
+	arg := args[0]
+	switch {
+	case sqltypes.IsSigned(arg.typ):
+		if arg.ival == math.MinInt64 {
+			return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT value is out of range in abs(%d)", arg.ival)
+		}
+		if arg.ival < 0 {
+			return newEvalInt64(-arg.ival), nil
+		}
+		return newEvalInt64(arg.ival), nil
+	case sqltypes.IsUnsigned(arg.typ):
+		return newEvalUint64(arg.uval), nil
+	case arg.typ == sqltypes.Decimal:
+		return EvalResult{typ: sqltypes.Decimal, fval: math.Abs(arg.fval), bytes: bytes.TrimPrefix(arg.bytes, []byte{'-'})}, nil
+	}
+	return newEvalFloat(math.Abs(arg.toFloat())), nil
+}
+
+// rounding implements CEIL and FLOOR: integers are returned as is, and decimals become integers
+func rounding(fn func(float64) float64) func([]EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		arg := args[0]
+		switch {
+		case sqltypes.IsSigned(arg.typ), sqltypes.IsUnsigned(arg.typ):
+			return makeNumeric(arg), nil
+		case arg.typ == sqltypes.Decimal:
+			return newEvalInt64(roundToInt64(fn(arg.fval))), nil
+		}
+		return newEvalFloat(fn(arg.toFloat())), nil
+	}
+}
+
+// roundTo implements ROUND and TRUNCATE, which take an optional number of decimals.
+// The number of decimals can be negative to round the integral part of the value.
+func roundTo(fn func(float64) float64) func([]EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		arg := args[0]
+		var decimals int64
+		if len(args) == 2 {
+			decimals = args[1].toInt64()
+		}
+		if decimals > 30 {
+			decimals = 30
+		}
+		switch {
+		case sqltypes.IsSigned(arg.typ), sqltypes.IsUnsigned(arg.typ):
+			if decimals >= 0 {
+				return makeNumeric(arg), nil
+			}
+			pow := math.Pow10(int(-decimals))
+			f := fn(arg.toFloat()/pow) * pow
+			if sqltypes.IsUnsigned(arg.typ) {
+				return newEvalUint64(uint64(f)), nil
+			}
+			return newEvalInt64(roundToInt64(f)), nil
+		case arg.typ == sqltypes.Decimal:
+			if decimals < 0 {
+				pow := math.Pow10(int(-decimals))
+				return newEvalDecimal(fn(arg.fval/pow)*pow, 0), nil
+			}
+			pow := math.Pow10(int(decimals))
+			return newEvalDecimal(fn(arg.fval*pow)/pow, int(decimals)), nil
+		}
+		pow := math.Pow10(int(decimals))
+		return newEvalFloat(fn(arg.toFloat()*pow) / pow), nil
+	}
+}
+
+func mod(args []EvalResult) (EvalResult, error) {
+	return modulo(args[0], args[1]), nil
+}
+
+func sign(args []EvalResult) (EvalResult, error) {
+	arg := makeNumeric(args[0])
+	switch {
+	case arg.typ == sqltypes.Int64 && arg.ival < 0, arg.typ == sqltypes.Float64 && arg.fval < 0:
+		return newEvalInt64(-1), nil
+	case arg.typ == sqltypes.Int64 && arg.ival == 0, arg.typ == sqltypes.Uint64 && arg.uval == 0, arg.typ == sqltypes.Float64 && arg.fval == 0:
+		return newEvalInt64(0), nil
+	}
+	return newEvalInt64(1), nil
+}
+
+func positive(f float64) bool {
+	return f >= 0
+}
+
+func strictlyPositive(f float64) bool {
+	return f > 0
+}
+
+// floatFunc wraps a math function. The result is NULL when the argument is outside
+// of the domain of the function, or when the result is not a finite number.
+func floatFunc(fn func(float64) float64, domain func(float64) bool) func([]EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		f := args[0].toFloat()
+		if domain != nil && !domain(f) {
+			return newEvalNull(), nil
+		}
+		return finiteFloat(fn(f))
+	}
+}
+
+func finiteFloat(f float64) (EvalResult, error) {
+	if math.IsNaN(f) {
+		return newEvalNull(), nil
+	}
+	if math.IsInf(f, 0) {
+		return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "DOUBLE value is out of range")
+	}
+	return newEvalFloat(f), nil
+}
+
+func pow(args []EvalResult) (EvalResult, error) {
+	return finiteFloat(math.Pow(args[0].toFloat(), args[1].toFloat()))
+}
+
+// log implements both LOG(x), the natural logarithm, and LOG(base, x)
+func log(args []EvalResult) (EvalResult, error) {
+	if len(args) == 1 {
+		return floatFunc(math.Log, strictlyPositive)(args)
+	}
+	base, x := args[0].toFloat(), args[1].toFloat()
+	if base <= 1 || x <= 0 {
+		return newEvalNull(), nil
+	}
+	return finiteFloat(math.Log(x) / math.Log(base))
+}
+
+func pi([]EvalResult) (EvalResult, error) {
+	return newEvalFloat(math.Pi), nil
+}
+
+// extremum implements GREATEST and LEAST, keeping the argument for which the
+// comparison with the others has the given sign
+func extremum(want int) func([]EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		result := args[0]
+		for _, arg := range args[1:] {
+			cmp, err := compareValues(arg, result)
+			if err != nil {
+				return EvalResult{}, err
+			}
+			if cmp == want {
+				result = arg
+			}
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// maxStringLength is the longest string the string functions will produce.
+// It matches the default max_allowed_packet of MySQL, which returns NULL for longer results.
+const maxStringLength = 64 * 1024 * 1024
+
+func registerStringFunctions() {
+	returnsInt := returns(sqltypes.Int64)
+
+	register(builtin{minArgs: 1, maxArgs: -1, nullable: true, call: concat, typeOf: returnsText}, "concat")
+	register(builtin{minArgs: 2, maxArgs: -1, call: concatWs, typeOf: returnsText}, "concat_ws")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: length, typeOf: returnsInt}, "length", "octet_length")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: charLength, typeOf: returnsInt}, "char_length", "character_length")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: lower, typeOf: returnsText}, "lower", "lcase")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: upper, typeOf: returnsText}, "upper", "ucase")
+	register(builtin{minArgs: 2, maxArgs: 3, nullable: true, call: substring, typeOf: returnsText}, "substr", "substring", "mid")
+	register(builtin{minArgs: 2, maxArgs: 2, nullable: true, call: left, typeOf: returnsText}, "left")
+	register(builtin{minArgs: 2, maxArgs: 2, nullable: true, call: right, typeOf: returnsText}, "right")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: trim(true, true), typeOf: returnsText}, "trim")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: trim(true, false), typeOf: returnsText}, "ltrim")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: trim(false, true), typeOf: returnsText}, "rtrim")
+	register(builtin{minArgs: 3, maxArgs: 3, nullable: true, call: replace, typeOf: returnsText}, "replace")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: reverse, typeOf: returnsText}, "reverse")
+	register(builtin{minArgs: 2, maxArgs: 2, nullable: true, call: repeat, typeOf: returnsText}, "repeat")
+	register(builtin{minArgs: 3, maxArgs: 3, nullable: true, call: pad(true), typeOf: returnsText}, "lpad")
+	register(builtin{minArgs: 3, maxArgs: 3, nullable: true, call: pad(false), typeOf: returnsText}, "rpad")
+	register(builtin{minArgs: 2, maxArgs: 2, nullable: true, call: instr, typeOf: returnsInt}, "instr")
+	register(builtin{minArgs: 2, maxArgs: 3, nullable: true, call: locate, typeOf: returnsInt}, "locate")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: ascii, typeOf: returnsInt}, "ascii")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: space, typeOf: returnsText}, "space")
+	register(builtin{minArgs: 2, maxArgs: 2, nullable: true, call: strcmp, typeOf: returnsInt}, "strcmp")
+}
+
+func concat(args []EvalResult) (EvalResult, error) {
+	var buf []byte
+	for _, arg := range args {
+		buf = append(buf, arg.toBytes()...)
+	}
+	if buf == nil {
+		buf = []byte{}
+	}
+	return newEvalBytes(buf), nil
+}
+
+// concatWs skips the NULL arguments, and only returns NULL when the separator is NULL
+func concatWs(args []EvalResult) (EvalResult, error) {
+	if args[0].null() {
+		return newEvalNull(), nil
+	}
+	sep := args[0].toBytes()
+	buf := []byte{}
+	first := true
+	for _, arg := range args[1:] {
+		if arg.null() {
+			continue
+		}
+		if !first {
+			buf = append(buf, sep...)
+		}
+		buf = append(buf, arg.toBytes()...)
+		first = false
+	}
+	return newEvalBytes(buf), nil
+}
+
+func length(args []EvalResult) (EvalResult, error) {
+	return newEvalInt64(int64(len(args[0].toBytes()))), nil
+}
+
+func charLength(args []EvalResult) (EvalResult, error) {
+	return newEvalInt64(int64(utf8.RuneCount(args[0].toBytes()))), nil
+}
+
+func lower(args []EvalResult) (EvalResult, error) {
+	return newEvalBytes(bytes.ToLower(args[0].toBytes())), nil
+}
+
+func upper(args []EvalResult) (EvalResult, error) {
+	return newEvalBytes(bytes.ToUpper(args[0].toBytes())), nil
+}
+
+// substring implements SUBSTRING(str, pos[, len]). Positions start at 1, and a negative
+// position counts from the end of the string. Like in MySQL, position 0 returns an empty string.
+func substring(args []EvalResult) (EvalResult, error) {
+	str := []rune(textArg(args[0]))
+	pos := args[1].toInt64()
+	count := int64(len(str))
+	if len(args) == 3 {
+		count = args[2].toInt64()
+	}
+	switch {
+	case pos > 0:
+		pos--
+	case pos < 0:
+		pos += int64(len(str))
+	default:
+		return newEvalBytes([]byte{}), nil
+	}
+	if pos < 0 || pos >= int64(len(str)) || count <= 0 {
+		return newEvalBytes([]byte{}), nil
+	}
+	end := pos + count
+	if end > int64(len(str)) || end < pos {
+		end = int64(len(str))
+	}
+	return newEvalBytes([]byte(string(str[pos:end]))), nil
+}
+
+func left(args []EvalResult) (EvalResult, error) {
+	str := []rune(textArg(args[0]))
+	n := args[1].toInt64()
+	switch {
+	case n <= 0:
+		return newEvalBytes([]byte{}), nil
+	case n > int64(len(str)):
+		n = int64(len(str))
+	}
+	return newEvalBytes([]byte(string(str[:n]))), nil
+}
+
+func right(args []EvalResult) (EvalResult, error) {
+	str := []rune(textArg(args[0]))
+	n := args[1].toInt64()
+	switch {
+	case n <= 0:
+		return newEvalBytes([]byte{}), nil
+	case n > int64(len(str)):
+		n = int64(len(str))
+	}
+	return newEvalBytes([]byte(string(str[int64(len(str))-n:]))), nil
+}
+
+// trim removes the leading and/or trailing spaces; unlike strings.TrimSpace, only
+// the space character is removed, like in MySQL
+func trim(leading, trailing bool) func([]EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		str := args[0].toBytes()
+		if leading {
+			str = bytes.TrimLeft(str, " ")
+		}
+		if trailing {
+			str = bytes.TrimRight(str, " ")
+		}
+		return newEvalBytes(str), nil
+	}
+}
+
+func replace(args []EvalResult) (EvalResult, error) {
+	str, from, to := args[0].toBytes(), args[1].toBytes(), args[2].toBytes()
+	if len(from) == 0 {
+		return newEvalBytes(str), nil
+	}
+	return newEvalBytes(bytes.ReplaceAll(str, from, to)), nil
+}
+
+func reverse(args []EvalResult) (EvalResult, error) {
+	str := []rune(textArg(args[0]))
+	for i, j := 0, len(str)-1; i < j; i, j = i+1, j-1 {
+		str[i], str[j] = str[j], str[i]
+	}
+	return newEvalBytes([]byte(string(str))), nil
+}
+
+func repeat(args []EvalResult) (EvalResult, error) {
+	str := args[0].toBytes()
+	n := args[1].toInt64()
+	if n <= 0 || len(str) == 0 {
+		return newEvalBytes([]byte{}), nil
+	}
+	if n > maxStringLength/int64(len(str)) {
+		return newEvalNull(), nil
+	}
+	return newEvalBytes(bytes.Repeat(str, int(n))), nil
+}
+
+// pad implements LPAD and RPAD. The string is truncated when it is longer than the
+// requested length, and the result is NULL when the padding string is empty.
+func pad(leftPad bool) func([]EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		str := []rune(textArg(args[0]))
+		n := args[1].toInt64()
+		padding := []rune(textArg(args[2]))
+		switch {
+		case n < 0 || n > maxStringLength:
+			return newEvalNull(), nil
+		case n <= int64(len(str)):
+			return newEvalBytes([]byte(string(str[:n]))), nil
+		case len(padding) == 0:
+			return newEvalNull(), nil
+		}
+		fill := make([]rune, 0, n-int64(len(str)))
+		for int64(len(fill)) < n-int64(len(str)) {
+			fill = append(fill, padding[len(fill)%len(padding)])
+		}
+		if leftPad {
+			return newEvalBytes([]byte(string(fill) + string(str))), nil
+		}
+		return newEvalBytes([]byte(string(str) + string(fill))), nil
+	}
+}
+
+// runeIndex returns the position, starting at 1, of substr in str, or 0 when it is missing.
+// The characters are compared with the collation.
+func runeIndex(coll collations.Collation, str, substr string) int64 {
+	if coll.ID() == collations.Binary {
+		i := strings.Index(str, substr)
+		if i < 0 {
+			return 0
+		}
+		return int64(utf8.RuneCountInString(str[:i])) + 1
+	}
+	runes, size := []rune(str), utf8.RuneCountInString(substr)
+	for i := 0; i+size <= len(runes); i++ {
+		if coll.Collate([]byte(string(runes[i:i+size])), []byte(substr)) == 0 {
+			return int64(i) + 1
+		}
+	}
+	return 0
+}
+
+func instr(args []EvalResult) (EvalResult, error) {
+	coll, err := textCollation(args[0], args[1])
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return newEvalInt64(runeIndex(coll, textArg(args[0]), textArg(args[1]))), nil
+}
+
+// locate implements LOCATE(substr, str[, pos]), which has the arguments of INSTR swapped
+// and can start the search at the given position
+func locate(args []EvalResult) (EvalResult, error) {
+	coll, err := textCollation(args[0], args[1])
+	if err != nil {
+		return EvalResult{}, err
+	}
+	substr, str := textArg(args[0]), textArg(args[1])
+	if len(args) == 2 {
+		return newEvalInt64(runeIndex(coll, str, substr)), nil
+	}
+	pos := args[2].toInt64()
+	runes := []rune(str)
+	if pos < 1 || pos > int64(len(runes))+1 {
+		return newEvalInt64(0), nil
+	}
+	idx := runeIndex(coll, string(runes[pos-1:]), substr)
+	if idx == 0 {
+		return newEvalInt64(0), nil
+	}
+	return newEvalInt64(idx + pos - 1), nil
+}
+
+func ascii(args []EvalResult) (EvalResult, error) {
+	str := args[0].toBytes()
+	if len(str) == 0 {
+		return newEvalInt64(0), nil
+	}
+	return newEvalInt64(int64(str[0])), nil
+}
+
+func space(args []EvalResult) (EvalResult, error) {
+	n := args[0].toInt64()
+	switch {
+	case n <= 0:
+		return newEvalBytes([]byte{}), nil
+	case n > maxStringLength:
+		return newEvalNull(), nil
+	}
+	return newEvalBytes(bytes.Repeat([]byte{' '}, int(n))), nil
+}
+
+func strcmp(args []EvalResult) (EvalResult, error) {
+	coll, err := textCollation(args[0], args[1])
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return newEvalInt64(int64(coll.Collate(args[0].toBytes(), args[1].toBytes()))), nil
+}
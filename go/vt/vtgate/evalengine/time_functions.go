@@ -0,0 +1,395 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// IntervalUnit is the unit of an INTERVAL expression
+	IntervalUnit int8
+
+	// DateAddExpr represents DATE_ADD(Date, INTERVAL Interval Unit) and DATE_SUB, as well as
+	// the `Date + INTERVAL ...` form. Like in MySQL, invalid dates produce NULL.
+	DateAddExpr struct {
+		Date, Interval Expr
+		Unit           IntervalUnit
+		Sub            bool
+	}
+)
+
+// These constants list the supported interval units, from the smallest to the largest
+const (
+	IntervalMicrosecond IntervalUnit = iota
+	IntervalSecond
+	IntervalMinute
+	IntervalHour
+	IntervalDay
+	IntervalWeek
+	IntervalMonth
+	IntervalQuarter
+	IntervalYear
+)
+
+var intervalUnitNames = []string{"microsecond", "second", "minute", "hour", "day", "week", "month", "quarter", "year"}
+
+// ParseIntervalUnit returns the unit with the given name. Composite units, such as DAY_HOUR, are not supported.
+func ParseIntervalUnit(name string) (IntervalUnit, bool) {
+	name = strings.ToLower(name)
+	for i, unit := range intervalUnitNames {
+		if unit == name {
+			return IntervalUnit(i), true
+		}
+	}
+	return 0, false
+}
+
+// String returns the SQL representation of the unit
+func (u IntervalUnit) String() string {
+	return intervalUnitNames[u]
+}
+
+const (
+	dateLayout     = "2006-01-02"
+	timeLayout     = "15:04:05"
+	datetimeLayout = dateLayout + " " + timeLayout
+)
+
+// parseTemporal parses a DATE, DATETIME or TIME value from its textual representation.
+// Fractional seconds are accepted after the seconds.
+func parseTemporal(b []byte) (time.Time, querypb.Type, bool) {
+	s := string(bytes.TrimSpace(b))
+	for _, format := range []struct {
+		layout string
+		typ    querypb.Type
+	}{
+		{datetimeLayout, sqltypes.Datetime},
+		{dateLayout + "T" + timeLayout, sqltypes.Datetime},
+		{dateLayout, sqltypes.Date},
+		{timeLayout, sqltypes.Time},
+	} {
+		if t, err := time.Parse(format.layout, s); err == nil {
+			return t, format.typ, true
+		}
+	}
+	return time.Time{}, 0, false
+}
+
+// newEvalTemporal formats the time as a value of the given temporal type.
+// Microseconds are only printed when they are not zero.
+func newEvalTemporal(t time.Time, typ querypb.Type) EvalResult {
+	var layout string
+	switch typ {
+	case sqltypes.Date:
+		layout = dateLayout
+	case sqltypes.Time:
+		layout = timeLayout
+	default:
+		layout = datetimeLayout
+	}
+	if typ != sqltypes.Date && t.Nanosecond() != 0 {
+		layout += ".000000"
+	}
+	return EvalResult{typ: typ, bytes: []byte(t.Format(layout))}
+}
+
+// validYear reports whether the time is in the range of the MySQL temporal types
+func validYear(t time.Time) bool {
+	return t.Year() >= 1 && t.Year() <= 9999
+}
+
+func addMonths(t time.Time, months int64) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	// MySQL clamps the day to the last day of the resulting month instead of overflowing into the next one
+	if last := daysIn(first); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
+
+var _ Expr = (*DateAddExpr)(nil)
+
+// Evaluate implements the Expr interface
+func (d *DateAddExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	date, err := d.Date.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	interval, err := d.Interval.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if date.null() || interval.null() {
+		return newEvalNull(), nil
+	}
+	t, typ, ok := parseTemporal(date.toBytes())
+	if !ok || typ == sqltypes.Time {
+		return newEvalNull(), nil
+	}
+
+	n := interval.toInt64()
+	if d.Sub {
+		n = -n
+	}
+	switch d.Unit {
+	case IntervalMicrosecond:
+		t = t.Add(time.Duration(n) * time.Microsecond)
+	case IntervalSecond:
+		t = t.Add(time.Duration(n) * time.Second)
+	case IntervalMinute:
+		t = t.Add(time.Duration(n) * time.Minute)
+	case IntervalHour:
+		t = t.Add(time.Duration(n) * time.Hour)
+	case IntervalDay:
+		t = t.AddDate(0, 0, int(n))
+	case IntervalWeek:
+		t = t.AddDate(0, 0, 7*int(n))
+	case IntervalMonth:
+		t = addMonths(t, n)
+	case IntervalQuarter:
+		t = addMonths(t, 3*n)
+	case IntervalYear:
+		t = addMonths(t, 12*n)
+	}
+	if !validYear(t) {
+		return newEvalNull(), nil
+	}
+
+	if typ == sqltypes.Datetime || d.Unit < IntervalDay {
+		typ = sqltypes.Datetime
+	}
+	result := newEvalTemporal(t, typ)
+	if date.typ != sqltypes.Date && date.typ != sqltypes.Datetime {
+		// like in MySQL, the result is a string when the input is a string
+		result.typ = sqltypes.VarBinary
+	}
+	return result, nil
+}
+
+// Type implements the Expr interface
+func (d *DateAddExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	typ, err := d.Date.Type(env)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case typ == sqltypes.Date && d.Unit >= IntervalDay:
+		return sqltypes.Date, nil
+	case typ == sqltypes.Date || typ == sqltypes.Datetime:
+		return sqltypes.Datetime, nil
+	}
+	return sqltypes.VarBinary, nil
+}
+
+// String implements the Expr interface
+func (d *DateAddExpr) String() string {
+	name := "date_add"
+	if d.Sub {
+		name = "date_sub"
+	}
+	return name + "(" + d.Date.String() + ", interval " + d.Interval.String() + " " + d.Unit.String() + ")"
+}
+
+func registerTimeFunctions() {
+	returnsInt := returns(sqltypes.Int64)
+	returnsDate := returns(sqltypes.Date)
+
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: datePart(func(t time.Time) int { return t.Year() }), typeOf: returnsInt}, "year")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: datePart(func(t time.Time) int { return int(t.Month()) }), typeOf: returnsInt}, "month")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: datePart(func(t time.Time) int { return t.Day() }), typeOf: returnsInt}, "day", "dayofmonth")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: datePart(func(t time.Time) int { return int(t.Weekday()) + 1 }), typeOf: returnsInt}, "dayofweek")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: datePart(func(t time.Time) int { return (int(t.Weekday()) + 6) % 7 }), typeOf: returnsInt}, "weekday")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: datePart(func(t time.Time) int { return t.YearDay() }), typeOf: returnsInt}, "dayofyear")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: datePart(func(t time.Time) int { return (int(t.Month())-1)/3 + 1 }), typeOf: returnsInt}, "quarter")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: timePart(func(t time.Time) int { return t.Hour() }), typeOf: returnsInt}, "hour")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: timePart(func(t time.Time) int { return t.Minute() }), typeOf: returnsInt}, "minute")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: timePart(func(t time.Time) int { return t.Second() }), typeOf: returnsInt}, "second")
+	register(builtin{minArgs: 2, maxArgs: 2, nullable: true, call: dateDiff, typeOf: returnsInt}, "datediff")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: dateOf, typeOf: returnsDate}, "date")
+	register(builtin{minArgs: 1, maxArgs: 1, nullable: true, call: lastDay, typeOf: returnsDate}, "last_day")
+	register(builtin{minArgs: 2, maxArgs: 2, nullable: true, call: dateFormat, typeOf: returnsText}, "date_format")
+}
+
+// dateArg parses an argument that must contain a date
+func dateArg(v EvalResult) (time.Time, bool) {
+	t, typ, ok := parseTemporal(v.toBytes())
+	return t, ok && typ != sqltypes.Time
+}
+
+func datePart(part func(time.Time) int) func([]EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		t, ok := dateArg(args[0])
+		if !ok {
+			return newEvalNull(), nil
+		}
+		return newEvalInt64(int64(part(t))), nil
+	}
+}
+
+// timePart is like datePart, but also accepts TIME values
+func timePart(part func(time.Time) int) func([]EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		t, _, ok := parseTemporal(args[0].toBytes())
+		if !ok {
+			return newEvalNull(), nil
+		}
+		return newEvalInt64(int64(part(t))), nil
+	}
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// dateDiff returns the number of days between two dates, ignoring their time part
+func dateDiff(args []EvalResult) (EvalResult, error) {
+	t1, ok1 := dateArg(args[0])
+	t2, ok2 := dateArg(args[1])
+	if !ok1 || !ok2 {
+		return newEvalNull(), nil
+	}
+	days := truncateToDay(t1).Sub(truncateToDay(t2)).Hours() / 24
+	return newEvalInt64(roundToInt64(days)), nil
+}
+
+func dateOf(args []EvalResult) (EvalResult, error) {
+	t, ok := dateArg(args[0])
+	if !ok {
+		return newEvalNull(), nil
+	}
+	return newEvalTemporal(t, sqltypes.Date), nil
+}
+
+func lastDay(args []EvalResult) (EvalResult, error) {
+	t, ok := dateArg(args[0])
+	if !ok {
+		return newEvalNull(), nil
+	}
+	return newEvalTemporal(time.Date(t.Year(), t.Month(), daysIn(t), 0, 0, 0, 0, t.Location()), sqltypes.Date), nil
+}
+
+// dateFormat implements DATE_FORMAT for the most common format specifiers.
+// Unknown specifiers are printed without the `%`, like in MySQL.
+func dateFormat(args []EvalResult) (EvalResult, error) {
+	t, _, ok := parseTemporal(args[0].toBytes())
+	if !ok {
+		return newEvalNull(), nil
+	}
+	format := args[1].toBytes()
+	var buf []byte
+	twoDigits := func(i int) {
+		if i < 10 {
+			buf = append(buf, '0')
+		}
+		buf = strconv.AppendInt(buf, int64(i), 10)
+	}
+	hour12 := func() int {
+		if h := t.Hour() % 12; h != 0 {
+			return h
+		}
+		return 12
+	}
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			buf = append(buf, format[i])
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'Y':
+			buf = append(buf, t.Format("2006")...)
+		case 'y':
+			twoDigits(t.Year() % 100)
+		case 'm':
+			twoDigits(int(t.Month()))
+		case 'c':
+			buf = strconv.AppendInt(buf, int64(t.Month()), 10)
+		case 'M':
+			buf = append(buf, t.Month().String()...)
+		case 'b':
+			buf = append(buf, t.Month().String()[:3]...)
+		case 'd':
+			twoDigits(t.Day())
+		case 'e':
+			buf = strconv.AppendInt(buf, int64(t.Day()), 10)
+		case 'D':
+			buf = strconv.AppendInt(buf, int64(t.Day()), 10)
+			buf = append(buf, ordinalSuffix(t.Day())...)
+		case 'j':
+			buf = append(buf, []byte(strconv.Itoa(1000 + t.YearDay()))[1:]...)
+		case 'W':
+			buf = append(buf, t.Weekday().String()...)
+		case 'a':
+			buf = append(buf, t.Weekday().String()[:3]...)
+		case 'w':
+			buf = strconv.AppendInt(buf, int64(t.Weekday()), 10)
+		case 'H':
+			twoDigits(t.Hour())
+		case 'k':
+			buf = strconv.AppendInt(buf, int64(t.Hour()), 10)
+		case 'h', 'I':
+			twoDigits(hour12())
+		case 'l':
+			buf = strconv.AppendInt(buf, int64(hour12()), 10)
+		case 'i':
+			twoDigits(t.Minute())
+		case 's', 'S':
+			twoDigits(t.Second())
+		case 'f':
+			buf = append(buf, t.Format(".000000")[1:]...)
+		case 'p':
+			buf = append(buf, t.Format("PM")...)
+		case 'T':
+			buf = append(buf, t.Format(timeLayout)...)
+		case 'r':
+			buf = append(buf, t.Format("03:04:05 PM")...)
+		default:
+			buf = append(buf, format[i])
+		}
+	}
+	if buf == nil {
+		buf = []byte{}
+	}
+	return newEvalBytes(buf), nil
+}
+
+func ordinalSuffix(day int) string {
+	if day >= 11 && day <= 13 {
+		return "th"
+	}
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
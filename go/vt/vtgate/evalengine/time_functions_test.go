@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestDateAdd(t *testing.T) {
+	tests := []struct {
+		date     string
+		interval int64
+		unit     IntervalUnit
+		sub      bool
+		out      string
+	}{
+		{"2021-01-31", 1, IntervalMonth, false, "2021-02-28"},
+		{"2020-01-31", 1, IntervalMonth, false, "2020-02-29"},
+		{"2021-03-31", 1, IntervalMonth, true, "2021-02-28"},
+		{"2021-11-30", 1, IntervalQuarter, false, "2022-02-28"},
+		{"2020-02-29", 4, IntervalYear, false, "2024-02-29"},
+		{"2020-02-29", 1, IntervalYear, true, "2019-02-28"},
+		{"2021-12-31", 1, IntervalDay, false, "2022-01-01"},
+		{"2021-01-01", 2, IntervalWeek, false, "2021-01-15"},
+		{"2021-01-01", 1, IntervalSecond, true, "2020-12-31 23:59:59"},
+		{"2021-01-01 10:00:00", 90, IntervalMinute, false, "2021-01-01 11:30:00"},
+		{"2021-01-01 10:00:00", 1, IntervalMicrosecond, false, "2021-01-01 10:00:00.000001"},
+		{"2021-01-01 10:00:00", 1, IntervalDay, false, "2021-01-02 10:00:00"},
+		{"9999-12-31", 1, IntervalDay, false, ""},
+		{"10:00:00", 1, IntervalHour, false, ""},
+		{"2021-02-30", 1, IntervalDay, false, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.date, func(t *testing.T) {
+			expr := &DateAddExpr{
+				Date:     NewLiteralString([]byte(tc.date)),
+				Interval: NewLiteralInt(tc.interval),
+				Unit:     tc.unit,
+				Sub:      tc.sub,
+			}
+			result, err := expr.Evaluate(ExpressionEnv{})
+			assert.NoError(t, err)
+			if tc.out == "" {
+				assert.Equal(t, sqltypes.NULL, result.Value())
+				return
+			}
+			assert.Equal(t, sqltypes.NewVarBinary(tc.out), result.Value())
+		})
+	}
+}
+
+func TestDateAddKeepsTemporalType(t *testing.T) {
+	date := &CastExpr{Inner: NewLiteralString([]byte("2021-01-01")), Target: sqltypes.Date}
+
+	expr := &DateAddExpr{Date: date, Interval: NewLiteralInt(1), Unit: IntervalDay}
+	result, err := expr.Evaluate(ExpressionEnv{})
+	assert.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-01-02")), result.Value())
+	typ, err := expr.Type(ExpressionEnv{})
+	assert.NoError(t, err)
+	assert.Equal(t, sqltypes.Date, typ)
+
+	expr = &DateAddExpr{Date: date, Interval: NewLiteralInt(1), Unit: IntervalHour}
+	result, err = expr.Evaluate(ExpressionEnv{})
+	assert.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-01-01 01:00:00")), result.Value())
+	typ, err = expr.Type(ExpressionEnv{})
+	assert.NoError(t, err)
+	assert.Equal(t, sqltypes.Datetime, typ)
+}
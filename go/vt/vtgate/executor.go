@@ -23,6 +23,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"net/http"
@@ -36,6 +37,7 @@ import (
 	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/hack"
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/trace"
@@ -50,6 +52,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
@@ -74,6 +77,8 @@ var (
 
 	queriesProcessedByTable = stats.NewCountersWithMultiLabels("QueriesProcessedByTable", "Queries processed at vtgate by plan type, keyspace and table", []string{"Plan", "Keyspace", "Table"})
 	queriesRoutedByTable    = stats.NewCountersWithMultiLabels("QueriesRoutedByTable", "Queries routed from vtgate to vttablet by plan type, keyspace and table", []string{"Plan", "Keyspace", "Table"})
+
+	planCacheAdminUsers = flag.String("plan_cache_admin_users", "", "List of users who can read the plan cache, with the queries of all users, and reset its stats with SHOW VITESS_PLANS, SHOW VITESS_QUERY_STATS and the tables of "+engine.PlanCacheSchema+", or '%' to allow all users.")
 )
 
 const (
@@ -107,6 +112,15 @@ type Executor struct {
 
 	// allowScatter will fail planning if set to false and a plan contains any scatter queries
 	allowScatter bool
+
+	// sequences is nil if sequence values are not cached.
+	sequences *engine.SequenceCache
+	// results is nil if query results are not cached.
+	results *resultCache
+	// processList is nil if vtgate does not serve the MySQL protocol.
+	processList processList
+	// queryRules is nil if vtgate has no query rules.
+	queryRules *queryRules
 }
 
 var executorOnce sync.Once
@@ -139,6 +153,8 @@ func NewExecutor(
 		streamSize:      streamSize,
 		schemaTracker:   schemaTracker,
 		allowScatter:    !noScatter,
+		sequences:       engine.NewSequenceCache(*sequenceCacheBlockSize),
+		results:         newResultCache(*resultCacheSize, *resultCacheMemory),
 	}
 
 	vschemaacl.Init()
@@ -269,6 +285,9 @@ func (e *Executor) legacyExecute(ctx context.Context, safeSession *SafeSession,
 	case sqlparser.StmtShow:
 		qr, err := e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
 		return sqlparser.StmtShow, qr, err
+	case sqlparser.StmtKill:
+		qr, err := e.handleKill(ctx, sql, logStats)
+		return sqlparser.StmtKill, qr, err
 	case sqlparser.StmtComment:
 		// Effectively should be done through new plan.
 		// There are some statements which are not planned for special comments.
@@ -754,6 +773,14 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 		}, nil
 	case sqlparser.KeywordString(sqlparser.VITESS_TABLETS):
 		return e.showTablets(show)
+	case sqlparser.KeywordString(sqlparser.PROCESSLIST):
+		if qr, ok := e.showProcessList(ctx); ok {
+			return qr, nil
+		}
+	case sqlparser.KeywordString(sqlparser.VITESS_PLANS):
+		return e.showPlanCache(ctx, engine.PlansTable, show, bindVars)
+	case sqlparser.KeywordString(sqlparser.VITESS_QUERY_STATS):
+		return e.showPlanCache(ctx, engine.QueryStatsTable, show, bindVars)
 	case sqlparser.KeywordString(sqlparser.VITESS_REPLICATION_STATUS):
 		return e.showVitessReplicationStatus(ctx, show)
 	case "vitess_target":
@@ -876,6 +903,65 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 	return e.handleOther(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats, ignoreMaxMemoryRows)
 }
 
+// showPlanCache returns the rows of a virtual table of the plan cache,
+// filtered by the LIKE pattern of their query or by the WHERE clause.
+func (e *Executor) showPlanCache(ctx context.Context, table string, show *sqlparser.ShowLegacy, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	result, err := engine.PlanCacheTableResult(table, func(each func(plan *engine.Plan) bool) error {
+		return e.ForEachCachedPlan(ctx, each)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if show.ShowTablesOpt == nil || show.ShowTablesOpt.Filter == nil {
+		return result, nil
+	}
+
+	filter := show.ShowTablesOpt.Filter
+	var matches func(row []sqltypes.Value) (bool, error)
+	if filter.Like != "" {
+		queryRegexp := sqlparser.LikeToRegexp(filter.Like)
+		matches = func(row []sqltypes.Value) (bool, error) {
+			return queryRegexp.MatchString(row[0].ToString()), nil
+		}
+	} else if filter.Filter != nil {
+		predicate, err := sqlparser.ConvertWithColumns(filter.Filter, func(expr sqlparser.Expr) (int, collations.ID, bool) {
+			col, ok := expr.(*sqlparser.ColName)
+			if !ok {
+				return 0, collations.Unknown, false
+			}
+			for i, field := range result.Fields {
+				if col.Name.EqualString(field.Name) {
+					return i, engine.PlanCacheCollation(field), true
+				}
+			}
+			return 0, collations.Unknown, false
+		})
+		if err != nil {
+			return nil, err
+		}
+		env := evalengine.ExpressionEnv{BindVars: bindVars}
+		matches = func(row []sqltypes.Value) (bool, error) {
+			env.Row = row
+			return evalengine.EvaluateToBool(predicate, env)
+		}
+	} else {
+		return result, nil
+	}
+
+	rows := result.Rows[:0]
+	for _, row := range result.Rows {
+		matched, err := matches(row)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			rows = append(rows, row)
+		}
+	}
+	result.Rows = rows
+	return result, nil
+}
+
 // (tablet, servingState, mtst) -> bool
 type tabletFilter func(*topodatapb.Tablet, string, int64) bool
 
@@ -1189,6 +1275,13 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "OLAP does not supported statement type: %s", plan.Type)
 	}
 
+	release, err := e.enforceQueryRules(ctx, plan, vc, comments, bindVars)
+	if err != nil {
+		logStats.Error = err
+		return err
+	}
+	defer release()
+
 	err = e.addNeededBindVars(plan.BindVarNeeds, bindVars, safeSession)
 	if err != nil {
 		return err
@@ -1294,6 +1387,30 @@ func (e *Executor) VSchema() *vindexes.VSchema {
 	return e.vschema
 }
 
+// TransactionMode returns the transaction mode of the sessions that do not set their own.
+func (e *Executor) TransactionMode() vtgatepb.TransactionMode {
+	return e.txConn.mode
+}
+
+// SequenceCache returns the cache of sequence values, or nil if
+// sequence values are not cached.
+func (e *Executor) SequenceCache() *engine.SequenceCache {
+	return e.sequences
+}
+
+// ForEachCachedPlan calls each for the plans of the plan cache, until it returns false.
+// The plans have the queries of all the users, so only the users listed in
+// plan_cache_admin_users can access them.
+func (e *Executor) ForEachCachedPlan(ctx context.Context, each func(plan *engine.Plan) bool) error {
+	if user := callerUsername(ctx); !listedUser(*planCacheAdminUsers, user) {
+		return vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.AccessDeniedError, "User '%s' is not authorized to access the plan cache", user)
+	}
+	e.plans.ForEach(func(value interface{}) bool {
+		return each(value.(*engine.Plan))
+	})
+	return nil
+}
+
 // SaveVSchema updates the vschema and stats
 func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 	e.mu.Lock()
@@ -1325,6 +1442,7 @@ func (e *Executor) ParseDestinationTarget(targetString string) (string, topodata
 type iQueryOption interface {
 	cachePlan() bool
 	getSelectLimit() int
+	getPreparedPlan() *preparedPlan
 }
 
 // getPlan computes the plan for the given query. If one is in
@@ -1339,6 +1457,23 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		return nil, errors.New("vschema not initialized")
 	}
 
+	// The executions of a prepared statement reuse its plan, and only
+	// need the bind variables of its literals.
+	var prepared *preparedPlan
+	if qo != nil {
+		prepared = qo.getPreparedPlan()
+	}
+	if plan, ok := prepared.get(vcursor, qo); ok {
+		vcursor.SetIgnoreMaxMemoryRows(prepared.ignoreMaxMemoryRows)
+		for k, v := range prepared.bindVars {
+			bindVars[k] = v
+		}
+		if logStats != nil {
+			logStats.SQL = comments.Leading + prepared.query + comments.Trailing
+		}
+		return plan, nil
+	}
+
 	stmt, reserved, err := sqlparser.Parse2(sql)
 	if err != nil {
 		return nil, err
@@ -1354,12 +1489,23 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	vcursor.SetIgnoreMaxMemoryRows(ignoreMaxMemoryRows)
 
 	// Normalize if possible and retry.
+	var normalizedVars map[string]*querypb.BindVariable
 	if (e.normalize && sqlparser.CanNormalize(stmt)) || sqlparser.MustRewriteAST(stmt, qo.getSelectLimit() > 0) {
 		parameterize := e.normalize // the public flag is called normalize
-		result, err := sqlparser.PrepareAST(stmt, reservedVars, bindVars, parameterize, vcursor.keyspace, qo.getSelectLimit())
+		normalizedVars = bindVars
+		if prepared != nil {
+			// Keep the literals apart, for the next executions of the statement.
+			normalizedVars = make(map[string]*querypb.BindVariable)
+		}
+		result, err := sqlparser.PrepareAST(stmt, reservedVars, normalizedVars, parameterize, vcursor.keyspace, qo.getSelectLimit())
 		if err != nil {
 			return nil, err
 		}
+		if prepared != nil {
+			for k, v := range normalizedVars {
+				bindVars[k] = v
+			}
+		}
 		statement = result.AST
 		bindVarNeeds = result.BindVarNeeds
 		query = sqlparser.String(statement)
@@ -1376,7 +1522,11 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	_, _ = planHash.Write(hack.StringBytes(query))
 	planKey := hex.EncodeToString(planHash.Sum(nil))
 
+	cachePlan := qo.cachePlan() && sqlparser.CachePlan(statement)
 	if plan, ok := e.plans.Get(planKey); ok {
+		if cachePlan {
+			prepared.save(plan.(*engine.Plan), query, normalizedVars, vcursor, qo)
+		}
 		return plan.(*engine.Plan), nil
 	}
 
@@ -1388,11 +1538,18 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	plan.Warnings = vcursor.warnings
 	vcursor.warnings = nil
 
-	if qo.cachePlan() && sqlparser.CachePlan(statement) {
+	if cachePlan {
 		e.plans.Set(planKey, plan)
 	}
 
-	return e.checkThatPlanIsValid(stmt, plan)
+	plan, err = e.checkThatPlanIsValid(stmt, plan)
+	if err != nil {
+		return nil, err
+	}
+	if cachePlan {
+		prepared.save(plan, query, normalizedVars, vcursor, qo)
+	}
+	return plan, nil
 }
 
 func (e *Executor) debugGetPlan(planKey string) (*engine.Plan, bool) {
@@ -1567,7 +1724,7 @@ func (e *Executor) prepare(ctx context.Context, safeSession *SafeSession, sql st
 	case sqlparser.StmtSelect:
 		return e.handlePrepare(ctx, safeSession, sql, bindVars, logStats)
 	case sqlparser.StmtDDL, sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback, sqlparser.StmtSet, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete,
-		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtExplain, sqlparser.StmtFlush:
+		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtExplain, sqlparser.StmtFlush, sqlparser.StmtKill:
 		return nil, nil
 	case sqlparser.StmtShow:
 		res, err := e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
@@ -1731,6 +1731,57 @@ func TestGetPlanNormalized(t *testing.T) {
 	assertCacheContains(t, r, want)
 }
 
+func TestGetPlanPrepared(t *testing.T) {
+	r, _, _, _ := createLegacyExecutorEnv()
+	r.normalize = true
+	emptyvc, _ := newVCursorImpl(ctx, NewSafeSession(&vtgatepb.Session{TargetString: "@unknown"}), makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil, false)
+	unshardedvc, _ := newVCursorImpl(ctx, NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded + "@unknown"}), makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil, false)
+
+	query := "select * from music_user_map where id = 1 and user_id = :v1"
+	normalized := "select * from music_user_map where id = :vtg1 and user_id = :v1"
+	prepared := &preparedPlan{}
+	getPreparedPlan := func(vc *vcursorImpl, sql string) (*engine.Plan, map[string]*querypb.BindVariable, *LogStats) {
+		t.Helper()
+		bindVars := map[string]*querypb.BindVariable{"v1": sqltypes.StringBindVariable("foo")}
+		logStats := NewLogStats(ctx, "Test", "", nil)
+		plan, err := r.getPlan(vc, sql, makeComments(" /* comment */"), bindVars, &SafeSession{Session: &vtgatepb.Session{}, preparedPlan: prepared}, logStats)
+		require.NoError(t, err)
+		return plan, bindVars, logStats
+	}
+	wantBindVars := map[string]*querypb.BindVariable{
+		"vtg1": sqltypes.Int64BindVariable(1),
+		"v1":   sqltypes.StringBindVariable("foo"),
+	}
+
+	plan1, bindVars, _ := getPreparedPlan(emptyvc, query)
+	assert.Equal(t, wantBindVars, bindVars)
+	assert.True(t, plan1 == prepared.plan, "the plan of the statement was not kept")
+
+	// The next executions do not parse the query anymore.
+	plan2, bindVars, logStats := getPreparedPlan(emptyvc, "syntax")
+	assert.True(t, plan1 == plan2, "getPlan(prepared): plans must be equal: %p %p", plan1, plan2)
+	assert.Equal(t, wantBindVars, bindVars)
+	assert.Equal(t, normalized+" /* comment */", logStats.SQL)
+
+	// The plan is planned again for another target.
+	plan3, _, _ := getPreparedPlan(unshardedvc, query)
+	assert.True(t, plan1 != plan3, "getPlan(prepared, ks): plans must not be equal: %p %p", plan1, plan3)
+	assert.True(t, plan3 == prepared.plan, "the plan of the statement was not kept")
+
+	// Or after a change of the vschema.
+	r.SaveVSchema(&vindexes.VSchema{Keyspaces: r.VSchema().Keyspaces}, nil)
+	updatedvc, _ := newVCursorImpl(ctx, NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded + "@unknown"}), makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil, false)
+	_, err := r.getPlan(updatedvc, "syntax", makeComments(""), map[string]*querypb.BindVariable{}, &SafeSession{Session: &vtgatepb.Session{}, preparedPlan: prepared}, nil)
+	require.EqualError(t, err, "syntax error at position 7 near 'syntax'")
+	plan4, _, _ := getPreparedPlan(updatedvc, query)
+	assert.True(t, plan3 != plan4, "getPlan(prepared, new vschema): plans must not be equal: %p %p", plan3, plan4)
+
+	// The plan is only used by the query of the statement, not by the ones it executes.
+	session := &SafeSession{Session: &vtgatepb.Session{}, preparedPlan: prepared}
+	assert.True(t, session.getPreparedPlan() == prepared)
+	assert.Nil(t, session.getPreparedPlan())
+}
+
 func TestPassthroughDDL(t *testing.T) {
 	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
 	primarySession.TargetString = "TestExecutor"
@@ -2334,6 +2385,79 @@ func TestExecutorShowVitessMigrations(t *testing.T) {
 	assert.Contains(t, sbc2.StringQueries(), "SELECT * FROM _vt.schema_migrations")
 }
 
+// setPlanCacheAdmins sets the -plan_cache_admin_users flag for the test.
+func setPlanCacheAdmins(t *testing.T, users string) {
+	old := *planCacheAdminUsers
+	*planCacheAdminUsers = users
+	t.Cleanup(func() { *planCacheAdminUsers = old })
+}
+
+func TestExecutorPlanCacheStats(t *testing.T) {
+	setPlanCacheAdmins(t, "%")
+	executor, _, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+	for _, query := range []string{
+		"select id from music_user_map where id = 1",
+		"select id from music_user_map where id = 1",
+		"select id from user where id = 1",
+	} {
+		_, err := exec(executor, session, query)
+		require.NoError(t, err)
+		executor.plans.Wait()
+	}
+
+	qr, err := exec(executor, session, "show vitess_plans like '%music_user_map%'")
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 1)
+	assert.Equal(t, `[VARCHAR("select id from music_user_map where id = 1") VARCHAR("SELECT") VARCHAR("TestUnsharded") VARCHAR("music_user_map")]`, fmt.Sprintf("%v", qr.Rows[0][:4]))
+
+	qr, err = exec(executor, session, "show vitess_query_stats where ExecCount > 1")
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 1)
+	assert.Equal(t, `[VARCHAR("select id from music_user_map where id = 1") UINT64(2)]`, fmt.Sprintf("%v", qr.Rows[0][:2]))
+
+	qr, err = exec(executor, session, "select Query, ExecCount, ShardQueries from vitess_schema.query_stats where Query like 'select id from %' order by ExecCount asc, Query desc limit 2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Query", "ExecCount", "ShardQueries"}, []string{qr.Fields[0].Name, qr.Fields[1].Name, qr.Fields[2].Name})
+	assert.Equal(t, `[[VARCHAR("select id from user where id = 1") UINT64(1) UINT64(1)] [VARCHAR("select id from music_user_map where id = 1") UINT64(2) UINT64(2)]]`, fmt.Sprintf("%v", qr.Rows))
+
+	// Truncating the query stats resets them.
+	_, err = exec(executor, session, "truncate table vitess_schema.query_stats")
+	require.NoError(t, err)
+	executor.plans.Wait()
+	qr, err = exec(executor, session, "show vitess_query_stats like 'select id from %'")
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 2)
+	for _, row := range qr.Rows {
+		assert.Equal(t, "UINT64(0)", row[1].String())
+	}
+
+	_, err = exec(executor, session, "truncate table vitess_schema.plans")
+	require.EqualError(t, err, "unsupported: truncate vitess_schema.plans")
+	_, err = exec(executor, session, "select Query from vitess_schema.query_stats order by length(Query)")
+	require.EqualError(t, err, "unsupported: order by length(`Query`) in a select of vitess_schema.query_stats")
+}
+
+func TestExecutorPlanCacheAdmins(t *testing.T) {
+	setPlanCacheAdmins(t, "admin1, admin2")
+	executor, _, _, _ := createExecutorEnv()
+
+	for _, query := range []string{
+		"show vitess_plans",
+		"show vitess_query_stats",
+		"select * from vitess_schema.plans",
+		"truncate table vitess_schema.query_stats",
+	} {
+		session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+		_, err := executor.Execute(callerContext("admin2"), "TestExecutorPlanCacheAdmins", session, query, nil)
+		require.NoError(t, err, query)
+
+		// The plan cache has the queries of all the users.
+		_, err = executor.Execute(callerContext("user1"), "TestExecutorPlanCacheAdmins", session, query, nil)
+		require.EqualError(t, err, "User 'user1' is not authorized to access the plan cache", query)
+	}
+}
+
 func exec(executor *Executor, session *SafeSession, sql string) (*sqltypes.Result, error) {
 	return executor.Execute(context.Background(), "TestExecute", session, sql, nil)
 }
@@ -109,6 +109,13 @@ func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql
 	}
 
 	// 3: Prepare for execution
+	release, err := e.enforceQueryRules(ctx, plan, vcursor, comments, bindVars)
+	if err != nil {
+		logStats.Error = err
+		return 0, nil, err
+	}
+	defer release()
+
 	err = e.addNeededBindVars(plan.BindVarNeeds, bindVars, safeSession)
 	if err != nil {
 		logStats.Error = err
@@ -174,8 +181,10 @@ type currFunc func(*LogStats, *SafeSession) (sqlparser.StatementType, *sqltypes.
 
 func (e *Executor) executePlan(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, execStart time.Time) currFunc {
 	return func(logStats *LogStats, safeSession *SafeSession) (sqlparser.StatementType, *sqltypes.Result, error) {
-		// 4: Execute!
-		qr, err := vcursor.ExecutePrimitive(plan.Instructions, bindVars, true)
+		// 4: Execute, unless the result is cached!
+		qr, err := e.results.execute(vcursor, plan, bindVars, safeSession, func() (*sqltypes.Result, error) {
+			return vcursor.ExecutePrimitive(plan.Instructions, bindVars, true)
+		})
 
 		// 5: Log and add statistics
 		logStats.Keyspace = plan.Instructions.GetKeyspaceName()
@@ -0,0 +1,439 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/mysql/collations"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+// needsEvaluation returns true if some expressions of an aggregating query can only
+// be computed at the vtgate level, on top of the rows produced by the orderedAggregate:
+// the HAVING clause, AVG, and the expressions that are computed from aggregations.
+func (hp *horizonPlanning) needsEvaluation() bool {
+	if hp.sel.Having != nil {
+		return true
+	}
+	for _, e := range hp.qp.SelectExprs {
+		if !e.Aggr {
+			continue
+		}
+		expr, err := e.GetExpr()
+		if err != nil {
+			// the error is reported by planAggregations
+			return false
+		}
+		if !isSupportedAggregation(expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// isJoinPlan returns true if the rows of the plan are joined at the vtgate level.
+// The aggregations of such plans are pushed to the sides of the join,
+// which is why no expression is evaluated on top of them.
+func isJoinPlan(plan logicalPlan) bool {
+	switch plan := plan.(type) {
+	case *joinGen4, *semiJoin:
+		return true
+	case *pulloutSubquery:
+		return isJoinPlan(plan.underlying)
+	}
+	return false
+}
+
+// isSupportedAggregation returns true if the expression is an aggregation
+// that engine.OrderedAggregate can compute.
+func isSupportedAggregation(expr sqlparser.Expr) bool {
+	fExpr, isFunc := expr.(*sqlparser.FuncExpr)
+	if !isFunc {
+		return false
+	}
+	_, found := engine.SupportedAggregates[fExpr.Name.Lowered()]
+	return found
+}
+
+// planEvaluatedAggregations plans an aggregating query that needs an orderedAggregate
+// and some expressions computed on top of its rows. Every aggregation and column used by
+// these expressions is computed by the orderedAggregate, possibly as a hidden column.
+// The HAVING clause is then evaluated by an engine.Filter, the other expressions are
+// appended to the rows by an engine.Projection, and the columns of the SELECT list
+// are finally picked from both by an engine.SimpleProjection.
+func (hp *horizonPlanning) planEvaluatedAggregations(ctx *planningContext, plan logicalPlan) (logicalPlan, error) {
+	oa := newOrderedAggregate(plan)
+	ac := &aggregationColumns{hp: hp, ctx: ctx, oa: oa}
+	eProj := &engine.Projection{}
+
+	// the offsets of the select expressions in the output of the orderedAggregate,
+	// or -1-i for the i-th expression evaluated by the projection
+	selectCols := make([]int, len(hp.qp.SelectExprs))
+	for i, e := range hp.qp.SelectExprs {
+		aliasExpr, err := e.GetAliasedExpr()
+		if err != nil {
+			return nil, err
+		}
+		var offset int
+		switch {
+		case !e.Aggr:
+			offset, err = ac.pushColumn(aliasExpr)
+		case isSupportedAggregation(aliasExpr.Expr):
+			offset, err = ac.pushAggregation(e)
+		default:
+			var expr evalengine.Expr
+			expr, err = ac.convert(aliasExpr.Expr, "unsupported: in scatter query: complex aggregate expression")
+			offset = -1 - len(eProj.Exprs)
+			eProj.Exprs = append(eProj.Exprs, expr)
+			eProj.Cols = append(eProj.Cols, columnName(aliasExpr))
+		}
+		if err != nil {
+			return nil, err
+		}
+		selectCols[i] = offset
+	}
+
+	var predicate evalengine.Expr
+	if hp.sel.Having != nil {
+		var err error
+		predicate, err = ac.convert(hp.sel.Having.Expr, "unsupported: filtering on results of aggregates")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// when the ORDER BY uses aggregations, the rows are sorted on top of the projection,
+	// otherwise the sorting is pushed down along with the sorting needed by the grouping
+	var orderExprs []abstract.OrderBy
+	var orderProj []int
+	if !hp.qp.CanPushDownSorting {
+		for _, order := range hp.qp.OrderExprs {
+			if sqlparser.IsNull(order.Inner.Expr) {
+				continue
+			}
+			idx, err := ac.planOrderExpr(order, selectCols, eProj)
+			if err != nil {
+				return nil, err
+			}
+			orderExprs = append(orderExprs, order)
+			orderProj = append(orderProj, idx)
+		}
+	}
+
+	for _, groupExpr := range hp.qp.GroupByExprs {
+		if _, err := planGroupByGen4(groupExpr, oa, ctx.semTable, false); err != nil {
+			return nil, err
+		}
+	}
+
+	if hp.qp.CanPushDownSorting {
+		if len(hp.qp.OrderExprs) > 0 {
+			if _, err := hp.planOrderBy(ctx, hp.qp.OrderExprs, oa); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := hp.planGroupByUsingOrderBy(ctx, oa); err != nil {
+			return nil, err
+		}
+	} else {
+		var groupOrder []abstract.OrderBy
+		for _, groupExpr := range hp.qp.GroupByExprs {
+			groupOrder = append(groupOrder, abstract.OrderBy{
+				Inner:         &sqlparser.Order{Expr: groupExpr.Inner},
+				WeightStrExpr: groupExpr.WeightStrExpr},
+			)
+		}
+		if len(groupOrder) > 0 {
+			newInput, err := hp.planOrderBy(ctx, groupOrder, oa.input)
+			if err != nil {
+				return nil, err
+			}
+			oa.input = newInput
+		}
+	}
+
+	inputCols, err := outputColumnCount(oa.input)
+	if err != nil {
+		return nil, err
+	}
+	for i, col := range selectCols {
+		if col < 0 {
+			selectCols[i] = inputCols - 1 - col
+		}
+	}
+
+	var result logicalPlan = oa
+	if predicate != nil {
+		result = &filter{
+			logicalPlanCommon: newBuilderCommon(result),
+			efilter:           &engine.Filter{Predicate: predicate},
+		}
+	}
+	if len(eProj.Exprs) > 0 {
+		result = &projection{
+			logicalPlanCommon: newBuilderCommon(result),
+			eProj:             eProj,
+		}
+	}
+
+	if len(orderExprs) > 0 {
+		primitive := &engine.MemorySort{}
+		for i, order := range orderExprs {
+			param := engine.OrderByParams{
+				WeightStringCol: -1,
+				Desc:            order.Inner.Direction == sqlparser.DescOrder,
+			}
+			if orderProj[i] != -1 {
+				param.Col = inputCols + orderProj[i]
+			} else if offset, wsOffset, collation, found := findExprInOrderedAggr(oa, order); found {
+				param.Col, param.WeightStringCol, param.CollationID = offset, wsOffset, collation
+			} else if offset, found := ac.offsetOf(order.WeightStrExpr); found {
+				param.Col, param.CollationID = offset, collationFor(order.WeightStrExpr, ctx.semTable)
+			} else {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] expected to find the order by expression (%s) in orderedAggregate", sqlparser.String(order.Inner))
+			}
+			param.StarColFixedIndex = param.Col
+			primitive.OrderBy = append(primitive.OrderBy, param)
+		}
+		result = &memorySort{
+			resultsBuilder: resultsBuilder{
+				logicalPlanCommon: newBuilderCommon(result),
+				weightStrings:     make(map[*resultColumn]int),
+				truncater:         primitive,
+			},
+			eMemorySort: primitive,
+		}
+	}
+
+	result = &simpleProjection{
+		logicalPlanCommon: newBuilderCommon(result),
+		eSimpleProj:       &engine.SimpleProjection{Cols: selectCols},
+	}
+
+	if hp.qp.NeedsDistinct() {
+		colCollations := make([]collations.ID, len(hp.qp.SelectExprs))
+		for i, e := range hp.qp.SelectExprs {
+			expr, err := e.GetExpr()
+			if err != nil {
+				return nil, err
+			}
+			colCollations[i] = collationFor(expr, ctx.semTable)
+		}
+		result = newDistinct(result, colCollations)
+	}
+	return result, nil
+}
+
+// aggregationColumns pushes the aggregations and the columns that are used by the
+// expressions evaluated on top of an orderedAggregate, and remembers their offsets.
+type aggregationColumns struct {
+	hp      *horizonPlanning
+	ctx     *planningContext
+	oa      *orderedAggregate
+	exprs   []sqlparser.Expr
+	offsets []int
+}
+
+// offsetOf returns the offset of an expression that was already pushed.
+func (ac *aggregationColumns) offsetOf(expr sqlparser.Expr) (int, bool) {
+	for i, e := range ac.exprs {
+		if sqlparser.EqualsExpr(e, expr) {
+			return ac.offsets[i], true
+		}
+	}
+	return 0, false
+}
+
+func (ac *aggregationColumns) add(expr sqlparser.Expr, offset int) {
+	ac.exprs = append(ac.exprs, expr)
+	ac.offsets = append(ac.offsets, offset)
+}
+
+// pushColumn pushes a non-aggregating select expression.
+func (ac *aggregationColumns) pushColumn(aliasExpr *sqlparser.AliasedExpr) (int, error) {
+	offset, _, err := pushProjection(aliasExpr, ac.oa.input, ac.ctx.semTable, true, false)
+	if err != nil {
+		return 0, err
+	}
+	ac.add(aliasExpr.Expr, offset)
+	return offset, nil
+}
+
+// pushAggregation pushes an aggregation, unless it was already pushed.
+func (ac *aggregationColumns) pushAggregation(e abstract.SelectExpr) (int, error) {
+	expr, err := e.GetExpr()
+	if err != nil {
+		return 0, err
+	}
+	if offset, found := ac.offsetOf(expr); found {
+		return offset, nil
+	}
+	offset, err := ac.hp.pushAggregation(ac.ctx, ac.oa.input, ac.oa, e)
+	if err != nil {
+		return 0, err
+	}
+	ac.add(expr, offset)
+	return offset, nil
+}
+
+// convert pushes the aggregations and the columns used by the expression, and converts
+// it to an expression evaluated over the rows of the orderedAggregate. The errMsg is
+// returned if the expression can't be evaluated at the vtgate level.
+func (ac *aggregationColumns) convert(expr sqlparser.Expr, errMsg string) (evalengine.Expr, error) {
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if expr, isExpr := node.(sqlparser.Expr); isExpr {
+			if _, found := ac.offsetOf(expr); found {
+				return false, nil
+			}
+		}
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if !node.IsAggregate() {
+				return true, nil
+			}
+			if node.Name.Lowered() == "avg" {
+				sum, count := avgComponents(node)
+				if _, err := ac.pushAggregation(abstract.SelectExpr{Col: &sqlparser.AliasedExpr{Expr: sum}, Aggr: true}); err != nil {
+					return false, err
+				}
+				_, err := ac.pushAggregation(abstract.SelectExpr{Col: &sqlparser.AliasedExpr{Expr: count}, Aggr: true})
+				return false, err
+			}
+			_, err := ac.pushAggregation(abstract.SelectExpr{Col: &sqlparser.AliasedExpr{Expr: node}, Aggr: true})
+			return false, err
+		case *sqlparser.GroupConcatExpr:
+			return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex aggregate expression")
+		case *sqlparser.ColName:
+			offset, _, err := pushProjection(&sqlparser.AliasedExpr{Expr: node}, ac.oa.input, ac.ctx.semTable, true, true)
+			if err != nil {
+				return false, err
+			}
+			ac.add(node, offset)
+			return false, nil
+		case *sqlparser.ExtractedSubquery, *sqlparser.Subquery:
+			return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, errMsg)
+		}
+		return true, nil
+	}, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	evalExpr, err := sqlparser.ConvertWithColumns(rewriteAvg(expr), func(expr sqlparser.Expr) (int, collations.ID, bool) {
+		offset, found := ac.offsetOf(expr)
+		return offset, aggregationCollation(expr, ac.ctx.semTable), found
+	})
+	if err != nil {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, errMsg)
+	}
+	return evalExpr, nil
+}
+
+// aggregationCollation returns the collation of a column of an orderedAggregate. The MIN
+// and MAX of a text column have the collation of the column. The text values of the other
+// columns have an unknown collation, and vtgate refuses to compare them.
+func aggregationCollation(expr sqlparser.Expr, semTable *semantics.SemTable) collations.ID {
+	fn, isFunc := expr.(*sqlparser.FuncExpr)
+	if !isFunc {
+		return collationFor(expr, semTable)
+	}
+	switch fn.Name.Lowered() {
+	case "min", "max":
+		if len(fn.Exprs) != 1 {
+			break
+		}
+		if arg, isAliased := fn.Exprs[0].(*sqlparser.AliasedExpr); isAliased {
+			return collationFor(arg.Expr, semTable)
+		}
+	}
+	return collations.Unknown
+}
+
+// planOrderExpr pushes what an ORDER BY expression that is sorted on top of the projection needs.
+// It returns the index of the expression in the projection, or -1 if it is a column of the
+// orderedAggregate.
+func (ac *aggregationColumns) planOrderExpr(order abstract.OrderBy, selectCols []int, eProj *engine.Projection) (int, error) {
+	for i, e := range ac.hp.qp.SelectExprs {
+		expr, err := e.GetExpr()
+		if err != nil {
+			return 0, err
+		}
+		if sqlparser.EqualsExpr(expr, order.WeightStrExpr) && selectCols[i] < 0 {
+			return -1 - selectCols[i], nil
+		}
+	}
+	if isSupportedAggregation(order.WeightStrExpr) {
+		_, err := ac.pushAggregation(abstract.SelectExpr{Col: &sqlparser.AliasedExpr{Expr: order.WeightStrExpr}, Aggr: true})
+		return -1, err
+	}
+	if _, found := ac.offsetOf(order.WeightStrExpr); found {
+		return -1, nil
+	}
+	for _, groupExpr := range ac.hp.qp.GroupByExprs {
+		if sqlparser.EqualsExpr(groupExpr.WeightStrExpr, order.WeightStrExpr) {
+			return -1, nil
+		}
+	}
+	expr, err := ac.convert(order.WeightStrExpr, "unsupported: in scatter query: complex order by expression: "+sqlparser.String(order.Inner.Expr))
+	if err != nil {
+		return 0, err
+	}
+	eProj.Exprs = append(eProj.Exprs, expr)
+	eProj.Cols = append(eProj.Cols, sqlparser.String(order.Inner.Expr))
+	return len(eProj.Exprs) - 1, nil
+}
+
+// avgComponents returns the SUM and the COUNT an AVG is computed from,
+// since the averages computed by the shards can't be combined.
+func avgComponents(avg *sqlparser.FuncExpr) (sum, count *sqlparser.FuncExpr) {
+	sum = &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("sum"), Distinct: avg.Distinct, Exprs: avg.Exprs}
+	count = &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("count"), Distinct: avg.Distinct, Exprs: avg.Exprs}
+	return sum, count
+}
+
+// avgScale is the number of decimals of an AVG, as MySQL computes it with
+// the default div_precision_increment.
+const avgScale = "4"
+
+// rewriteAvg returns a copy of the expression where every AVG is replaced by
+// the division of its SUM by its COUNT, which is a DECIMAL like the AVG.
+func rewriteAvg(expr sqlparser.Expr) sqlparser.Expr {
+	return sqlparser.Rewrite(sqlparser.CloneExpr(expr), func(cursor *sqlparser.Cursor) bool {
+		fExpr, isFunc := cursor.Node().(*sqlparser.FuncExpr)
+		if !isFunc || fExpr.Name.Lowered() != "avg" {
+			return true
+		}
+		sum, count := avgComponents(fExpr)
+		cursor.Replace(&sqlparser.ConvertExpr{
+			Expr: &sqlparser.BinaryExpr{Operator: sqlparser.DivOp, Left: sum, Right: count},
+			Type: &sqlparser.ConvertType{Type: "decimal", Scale: sqlparser.NewIntLiteral(avgScale)},
+		})
+		return false
+	}, nil).(sqlparser.Expr)
+}
+
+func columnName(aliasExpr *sqlparser.AliasedExpr) string {
+	if !aliasExpr.As.IsEmpty() {
+		return aliasExpr.As.String()
+	}
+	return sqlparser.String(aliasExpr.Expr)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func TestRewriteAvg(t *testing.T) {
+	stmt, err := sqlparser.Parse("select avg(id) from user")
+	require.NoError(t, err)
+	avg := extract(stmt.(*sqlparser.Select).SelectExprs)[0]
+
+	// The SUM and the COUNT the AVG is computed from are the columns 0 and 1.
+	expr, err := sqlparser.ConvertWithColumns(rewriteAvg(avg), func(e sqlparser.Expr) (int, collations.ID, bool) {
+		switch sqlparser.String(e) {
+		case "sum(id)":
+			return 0, collations.Unknown, true
+		case "count(id)":
+			return 1, collations.Unknown, true
+		}
+		return 0, collations.Unknown, false
+	})
+	require.NoError(t, err)
+
+	env := evalengine.ExpressionEnv{Row: []sqltypes.Value{sqltypes.NewInt64(10), sqltypes.NewInt64(4)}}
+	typ, err := expr.Type(env)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.Decimal, typ)
+	result, err := expr.Evaluate(env)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTrusted(sqltypes.Decimal, []byte("2.5000")), result.Value())
+}
+
+func TestHavingCollation(t *testing.T) {
+	vschema := &vschemaWrapper{
+		v:       loadSchema(t, "schema_test.json"),
+		version: Gen4,
+	}
+	plan, err := TestBuilder("select max(textcol3) from user having max(textcol3) = 'Bob'", vschema, vschema.currentDb())
+	require.NoError(t, err)
+	filter := plan.Instructions.Inputs()[0].(*engine.Filter)
+
+	// textcol3 is utf8mb4_general_ci, so the comparison is case insensitive like in MySQL
+	env := evalengine.ExpressionEnv{Row: []sqltypes.Value{sqltypes.NewVarChar("BOB")}}
+	result, err := filter.Predicate.Evaluate(env)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.NewInt64(1), result.Value())
+
+	// textcol2 has no known collation: the comparison is refused instead of being done byte by byte
+	plan, err = TestBuilder("select max(textcol2) from user having max(textcol2) = 'Bob'", vschema, vschema.currentDb())
+	require.NoError(t, err)
+	filter = plan.Instructions.Inputs()[0].(*engine.Filter)
+	_, err = filter.Predicate.Evaluate(env)
+	require.EqualError(t, err, "unsupported: comparison of text values of an unknown collation in vtgate")
+}
@@ -124,6 +124,7 @@ func BuildFromStmt(query string, stmt sqlparser.Statement, reservedVars *sqlpars
 		Instructions: instruction,
 		BindVarNeeds: bindVarNeeds,
 	}
+	plan.ResultCacheTTL, plan.ResultCacheTables = resultCacheSettings(stmt, vschema)
 	return plan, nil
 }
 
@@ -157,6 +158,9 @@ type selectPlanner func(query string) func(sqlparser.Statement, *sqlparser.Reser
 func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema, enableOnlineDDL, enableDirectDDL bool) (engine.Primitive, error) {
 	switch stmt := stmt.(type) {
 	case *sqlparser.Select:
+		if table, ok := planCacheSelectTable(stmt); ok {
+			return buildPlanCacheSelectPlan(stmt, table)
+		}
 		configuredPlanner, err := getConfiguredPlanner(vschema, buildSelectPlan)
 		if err != nil {
 			return nil, err
@@ -174,6 +178,11 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *
 			return nil, err
 		}
 		return buildRoutePlan(stmt, reservedVars, vschema, configuredPlanner(query))
+	case *sqlparser.TruncateTable:
+		if table, ok := planCacheTable(stmt.Table); ok {
+			return buildPlanCacheTruncatePlan(table)
+		}
+		return buildGeneralDDLPlan(query, stmt, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
 	case sqlparser.DDLStatement:
 		return buildGeneralDDLPlan(query, stmt, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
 	case *sqlparser.AlterMigration:
@@ -196,7 +205,7 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *
 		return buildLoadPlan(query, vschema)
 	case sqlparser.DBDDLStatement:
 		return buildRoutePlan(stmt, reservedVars, vschema, buildDBDDLPlan)
-	case *sqlparser.SetTransaction:
+	case *sqlparser.SetTransaction, *sqlparser.Kill:
 		return nil, ErrPlanNotSupported
 	case *sqlparser.Begin, *sqlparser.Commit, *sqlparser.Rollback, *sqlparser.Savepoint, *sqlparser.SRollback, *sqlparser.Release:
 		// Empty by design. Not executed by a plan
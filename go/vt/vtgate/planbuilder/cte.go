@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strings"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+var errCTEInV3 = vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in V3 planner")
+
+// containsCTE reports whether the statement, or any subquery in it,
+// has a WITH clause, and whether any of those clauses is recursive.
+func containsCTE(node sqlparser.SQLNode) (hasCTE, recursive bool) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if with, ok := node.(*sqlparser.With); ok {
+			hasCTE = true
+			recursive = recursive || with.Recursive
+		}
+		return true, nil
+	}, node)
+	return
+}
+
+type cteScope map[string]*sqlparser.CommonTableExpr
+
+// inlineCTEs rewrites every reference to a non-recursive common table expression
+// into a derived table carrying the CTE's definition, and removes the WITH clauses.
+// After this the rest of the Gen4 planner only has to deal with derived tables:
+// if every table the CTE uses lives on the same shard, the derived table is merged
+// into a single route, otherwise it gets evaluated at the vtgate level.
+func inlineCTEs(stmt sqlparser.SelectStatement) error {
+	return inlineCTEsInScope(stmt, cteScope{})
+}
+
+func inlineCTEsInScope(node sqlparser.SQLNode, scope cteScope) error {
+	var err error
+	sqlparser.Rewrite(node, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch node := cursor.Node().(type) {
+		case sqlparser.SelectStatement:
+			with := node.GetWith()
+			if with == nil {
+				return true
+			}
+			if with.Recursive {
+				err = vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression")
+				return false
+			}
+			// each CTE can reference the ones defined before it, and the ones
+			// defined in the enclosing scopes, but it can shadow both of them
+			inner := make(cteScope, len(scope)+len(with.CTEs))
+			for name, cte := range scope {
+				inner[name] = cte
+			}
+			for _, cte := range with.CTEs {
+				if err = inlineCTEsInScope(cte.Subquery.Select, inner); err != nil {
+					return false
+				}
+				inner[cte.TableID.String()] = cte
+			}
+			node.SetWith(nil)
+			err = inlineCTEsInScope(node, inner)
+			return false
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := node.Expr.(sqlparser.TableName)
+			if !ok || !tableName.Qualifier.IsEmpty() {
+				return true
+			}
+			cte, found := scope[tableName.Name.String()]
+			if !found {
+				return true
+			}
+			node.Expr = &sqlparser.DerivedTable{Select: cloneCTEDefinition(cte.Subquery.Select)}
+			if node.As.IsEmpty() {
+				node.As = cte.TableID
+			}
+			if len(node.Columns) == 0 {
+				node.Columns = sqlparser.CloneColumns(cte.Columns)
+			}
+			// the definition has already been inlined, nothing left to do below this node
+			return false
+		}
+		return true
+	}, nil)
+	return err
+}
+
+// cloneCTEDefinition deep clones the definition of a CTE. The sqlparser clone functions
+// do not copy ColName instances, but the semantic analysis binds columns by pointer,
+// so every reference to the CTE needs its own copy of them.
+func cloneCTEDefinition(sel sqlparser.SelectStatement) sqlparser.SelectStatement {
+	clone := sqlparser.CloneSelectStatement(sel)
+	return sqlparser.Rewrite(clone, func(cursor *sqlparser.Cursor) bool {
+		if col, ok := cursor.Node().(*sqlparser.ColName); ok {
+			newCol := *col
+			cursor.Replace(&newCol)
+		}
+		return true
+	}, nil).(sqlparser.SelectStatement)
+}
+
+// buildRecursiveCTEPlan plans a statement that uses a recursive common table expression.
+// The recursion cannot be evaluated at the vtgate level, so the whole statement is sent
+// as-is to a single unsharded keyspace. All the tables it reads, apart from the CTEs
+// themselves, must therefore live in the same unsharded keyspace.
+func buildRecursiveCTEPlan(stmt sqlparser.SelectStatement, vschema ContextVSchema) (engine.Primitive, error) {
+	cteNames := map[string]bool{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if cte, ok := node.(*sqlparser.CommonTableExpr); ok {
+			cteNames[cte.TableID.String()] = true
+		}
+		return true, nil
+	}, stmt)
+
+	var keyspace *vindexes.Keyspace
+	var tables []string
+	seen := map[string]bool{}
+	var err error
+	sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		node, ok := cursor.Node().(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true
+		}
+		tableName, ok := node.Expr.(sqlparser.TableName)
+		if !ok {
+			return true
+		}
+		if tableName.Qualifier.IsEmpty() && cteNames[tableName.Name.String()] {
+			return true
+		}
+		var vschemaTable *vindexes.Table
+		vschemaTable, _, _, _, err = vschema.FindTable(tableName)
+		if err != nil {
+			return false
+		}
+		if vschemaTable.Keyspace.Sharded && vschemaTable.Type != vindexes.TypeReference {
+			err = vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression on sharded table %s", sqlparser.String(tableName))
+			return false
+		}
+		if keyspace != nil && keyspace.Name != vschemaTable.Keyspace.Name {
+			err = vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression across keyspaces")
+			return false
+		}
+		keyspace = vschemaTable.Keyspace
+		if name := vschemaTable.Name.String(); !seen[name] {
+			seen[name] = true
+			tables = append(tables, name)
+		}
+		// the keyspace qualifier must not be sent down to the tablet
+		node.Expr = sqlparser.TableName{Name: vschemaTable.Name}
+		return true
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyspace == nil {
+		// the query does not read from any table, it can be answered by any shard
+		keyspace, err = vschema.AnyKeyspace()
+		if err != nil {
+			return nil, err
+		}
+	}
+	opcode := engine.SelectUnsharded
+	if keyspace.Sharded {
+		// only reference tables are used, any shard has all the rows
+		opcode = engine.SelectReference
+	}
+
+	route := engine.NewSimpleRoute(opcode, keyspace)
+	route.Query = sqlparser.String(stmt)
+	route.FieldQuery = sqlparser.NewTrackedBuffer(sqlparser.FormatImpossibleQuery).WriteNode(stmt).ParsedQuery().Query
+	route.TableName = strings.Join(tables, ", ")
+	return route, nil
+}
@@ -33,6 +33,23 @@ func buildDeletePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 			return nil, err
 		}
 	}
+	dmlInput, where, err := buildDMLInput(vschema, reservedVars, del.TableExprs, del.Where, del.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if dmlInput != nil {
+		delCopy := *del
+		delCopy.Where = where
+		del = &delCopy
+	}
+	edel, err := buildDelete(del, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+	return withDMLInput(dmlInput, edel), nil
+}
+
+func buildDelete(del *sqlparser.Delete, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (*engine.Delete, error) {
 	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "delete", del, reservedVars, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
 	if err != nil {
 		return nil, err
@@ -81,13 +98,18 @@ func rewriteSingleTbl(del *sqlparser.Delete) (*sqlparser.Delete, error) {
 		return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.UnknownTable, "Unknown table '%s' in MULTI DELETE", del.Targets[0].Name.String())
 	}
 
+	target := tbl.Name
+	if !atExpr.As.IsEmpty() {
+		target = atExpr.As
+	}
 	del.TableExprs = sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: tbl}}
 	del.Targets = nil
 	if del.Where != nil {
 		_ = sqlparser.Rewrite(del.Where, func(cursor *sqlparser.Cursor) bool {
 			switch node := cursor.Node().(type) {
 			case *sqlparser.ColName:
-				if !node.Qualifier.IsEmpty() {
+				// columns of the tables of subqueries are left untouched
+				if !node.Qualifier.IsEmpty() && sqlparser.EqualsTableIdent(node.Qualifier.Name, target) {
 					node.Qualifier = tbl
 				}
 			}
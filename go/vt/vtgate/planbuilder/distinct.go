@@ -17,9 +17,12 @@ limitations under the License.
 package planbuilder
 
 import (
+	"vitess.io/vitess/go/mysql/collations"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
 )
 
 var _ logicalPlan = (*distinct)(nil)
@@ -27,20 +30,96 @@ var _ logicalPlan = (*distinct)(nil)
 // distinct is the logicalPlan for engine.Distinct.
 type distinct struct {
 	logicalPlanCommon
+	colCollations []collations.ID
 }
 
-func newDistinct(source logicalPlan) logicalPlan {
+func newDistinct(source logicalPlan, colCollations []collations.ID) logicalPlan {
 	return &distinct{
 		logicalPlanCommon: newBuilderCommon(source),
+		colCollations:     colCollations,
 	}
 }
 
 func (d *distinct) Primitive() engine.Primitive {
 	return &engine.Distinct{
-		Source: d.input.Primitive(),
+		Source:        d.input.Primitive(),
+		ColCollations: d.colCollations,
 	}
 }
 
+// Wireup implements the logicalPlan interface
+// The collations of the columns are only known once all the select
+// expressions have been pushed, which is why they are computed here.
+func (d *distinct) Wireup(plan logicalPlan, jt *jointab) error {
+	d.colCollations = resultCollations(concatenatedResultColumns(d.input))
+	return d.input.Wireup(plan, jt)
+}
+
+// concatenatedResultColumns returns the result columns of all the sources of a UNION,
+// which can only be compared using a collation they all have in common
+func concatenatedResultColumns(plan logicalPlan) [][]*resultColumn {
+	if c, ok := plan.(*concatenate); ok {
+		return append(concatenatedResultColumns(c.lhs), concatenatedResultColumns(c.rhs)...)
+	}
+	return [][]*resultColumn{plan.ResultColumns()}
+}
+
+// resultCollations returns the collations of the text columns that have the same
+// known collation in all the given lists of result columns, or nil if there is none.
+func resultCollations(resultColumns [][]*resultColumn) []collations.ID {
+	var colls [][]collations.ID
+	for _, rcs := range resultColumns {
+		coll := make([]collations.ID, len(rcs))
+		for i, rc := range rcs {
+			coll[i] = rc.column.compareCollation()
+		}
+		colls = append(colls, coll)
+	}
+	return mergeCollations(colls)
+}
+
+// selectCollations is the Gen4 counterpart of resultCollations: the collations are
+// taken from the select expressions of the statements.
+func selectCollations(semTable *semantics.SemTable, stmts []*sqlparser.Select) []collations.ID {
+	var colls [][]collations.ID
+	for _, sel := range stmts {
+		if sel == nil {
+			return nil
+		}
+		coll := make([]collations.ID, len(sel.SelectExprs))
+		for i, sExpr := range sel.SelectExprs {
+			if aliased, ok := sExpr.(*sqlparser.AliasedExpr); ok {
+				coll[i] = collationFor(aliased.Expr, semTable)
+			}
+		}
+		colls = append(colls, coll)
+	}
+	return mergeCollations(colls)
+}
+
+func mergeCollations(colls [][]collations.ID) []collations.ID {
+	if len(colls) == 0 {
+		return nil
+	}
+	result := colls[0]
+	for _, coll := range colls[1:] {
+		if len(coll) != len(result) {
+			return nil
+		}
+		for i := range result {
+			if coll[i] != result[i] {
+				result[i] = collations.Unknown
+			}
+		}
+	}
+	for _, id := range result {
+		if id != collations.Unknown {
+			return result
+		}
+	}
+	return nil
+}
+
 // Rewrite implements the logicalPlan interface
 func (d *distinct) Rewrite(inputs ...logicalPlan) error {
 	if len(inputs) != 1 {
@@ -23,6 +23,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
@@ -179,6 +180,147 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 	return edml, ksidVindex, ksidCol, nil
 }
 
+// buildDMLInput handles the sharded DMLs whose WHERE clause contains subqueries.
+// The predicates with subqueries are evaluated by a SELECT that returns the primary
+// vindex column of the matching rows, and the columns of the target table used by
+// these predicates. They are replaced in the WHERE clause returned for the DML by a
+// filter on the primary vindex column and on these columns, which are all provided
+// as bind variables by the returned DMLWithInput. The given WHERE clause is not
+// modified. It returns nil if the DML doesn't need an input.
+func buildDMLInput(vschema ContextVSchema, reservedVars *sqlparser.ReservedVars, tableExprs sqlparser.TableExprs, where *sqlparser.Where, limit *sqlparser.Limit) (*engine.DMLWithInput, *sqlparser.Where, error) {
+	if where == nil || !hasSubquery(where) || len(tableExprs) != 1 {
+		return nil, nil, nil
+	}
+	aliased, ok := tableExprs[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, nil, nil
+	}
+	tableName, ok := aliased.Expr.(sqlparser.TableName)
+	if !ok {
+		return nil, nil, nil
+	}
+	// the other cases are reported by buildDMLPlan
+	table, _, _, _, destination, err := vschema.FindTableOrVindex(tableName)
+	if err != nil || table == nil || !table.Keyspace.Sharded || destination != nil {
+		return nil, nil, nil
+	}
+	if limit != nil {
+		return nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML with limit")
+	}
+
+	var ksidCol sqlparser.ColIdent
+	for _, index := range table.Ordered {
+		if _, isSingle := index.Vindex.(vindexes.SingleColumn); isSingle && index.Vindex.IsUnique() {
+			ksidCol = index.Columns[0]
+			break
+		}
+	}
+	if ksidCol.IsEmpty() {
+		return nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RequiresPrimaryKey, vterrors.PrimaryVindexNotSet, table.Name)
+	}
+	qualifier := tableName
+	if !aliased.As.IsEmpty() {
+		qualifier = sqlparser.TableName{Name: aliased.As}
+	}
+
+	var subqueryPreds, otherPreds []sqlparser.Expr
+	for _, pred := range sqlparser.SplitAndExpression(nil, sqlparser.CloneExpr(where.Expr)) {
+		if hasSubquery(pred) {
+			subqueryPreds = append(subqueryPreds, pred)
+			continue
+		}
+		otherPreds = append(otherPreds, pred)
+	}
+
+	columns, err := dmlSubqueryColumns(vschema, tableExprs, subqueryPreds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sel := &sqlparser.Select{
+		SelectExprs: sqlparser.SelectExprs{
+			&sqlparser.AliasedExpr{Expr: sqlparser.NewColNameWithQualifier(ksidCol.String(), qualifier)},
+		},
+		From:  sqlparser.TableExprs{sqlparser.CloneTableExpr(aliased)},
+		Where: sqlparser.CloneRefOfWhere(where),
+	}
+	listVar := reservedVars.ReserveColName(sqlparser.NewColName("dml_vals"))
+	otherPreds = append(otherPreds, &sqlparser.ComparisonExpr{
+		Left:     sqlparser.NewColNameWithQualifier(ksidCol.String(), qualifier),
+		Operator: sqlparser.InOp,
+		Right:    sqlparser.NewListArg(listVar),
+	})
+	vars := map[string]int{}
+	for _, column := range columns {
+		col := sqlparser.NewColNameWithQualifier(column.String(), qualifier)
+		bvName := reservedVars.ReserveColName(col)
+		vars[bvName] = len(sel.SelectExprs)
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: col})
+		otherPreds = append(otherPreds, &sqlparser.ComparisonExpr{
+			Left:     sqlparser.NewColNameWithQualifier(column.String(), qualifier),
+			Operator: sqlparser.NullSafeEqualOp,
+			Right:    sqlparser.NewArgument(bvName),
+		})
+	}
+
+	// the V3 planner cannot plan the subqueries that need an input,
+	// so the input is always planned with Gen4
+	input, err := gen4Planner(sqlparser.String(sel))(sel, reservedVars, vschema)
+	if err != nil {
+		return nil, nil, err
+	}
+	dmlInput := &engine.DMLWithInput{
+		Input:   input,
+		ListVar: listVar,
+		Vars:    vars,
+	}
+	return dmlInput, sqlparser.NewWhere(sqlparser.WhereClause, sqlparser.AndExpressions(otherPreds...)), nil
+}
+
+// dmlSubqueryColumns returns the columns of the target table of a DML used by the given predicates.
+// The value of the predicates only depends on these columns.
+func dmlSubqueryColumns(vschema ContextVSchema, tableExprs sqlparser.TableExprs, preds []sqlparser.Expr) ([]sqlparser.ColIdent, error) {
+	sel := &sqlparser.Select{
+		SelectExprs: sqlparser.SelectExprs{&sqlparser.StarExpr{}},
+		From:        sqlparser.CloneTableExprs(tableExprs),
+		Where:       sqlparser.NewWhere(sqlparser.WhereClause, sqlparser.CloneExpr(sqlparser.AndExpressions(preds...))),
+	}
+	ksName := ""
+	if ks, _ := vschema.DefaultKeyspace(); ks != nil {
+		ksName = ks.Name
+	}
+	semTable, err := semantics.Analyze(sel, ksName, vschema)
+	if err != nil {
+		return nil, err
+	}
+	target := semTable.TableSetFor(sel.From[0].(*sqlparser.AliasedTableExpr))
+
+	var columns []sqlparser.ColIdent
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		col, isCol := node.(*sqlparser.ColName)
+		if !isCol || semTable.RecursiveDeps(col) != target {
+			return true, nil
+		}
+		for _, column := range columns {
+			if column.Equal(col.Name) {
+				return true, nil
+			}
+		}
+		columns = append(columns, col.Name)
+		return true, nil
+	}, sel.Where)
+	return columns, nil
+}
+
+// withDMLInput returns the DML primitive, executed for the rows of the given input if there is one.
+func withDMLInput(input *engine.DMLWithInput, dml engine.Primitive) engine.Primitive {
+	if input == nil {
+		return dml
+	}
+	input.DML = dml
+	return input
+}
+
 func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCol string) string {
 	buf := sqlparser.NewTrackedBuffer(nil)
 	buf.Myprintf("select %s", ksidCol)
@@ -0,0 +1,37 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ logicalPlan = (*filter)(nil)
+
+// filter is the logicalPlan for engine.Filter.
+// It is used for the predicates that can only be evaluated at the vtgate
+// level, such as the HAVING clause of a cross-shard aggregation.
+type filter struct {
+	logicalPlanCommon
+	efilter *engine.Filter
+}
+
+// Primitive implements the logicalPlan interface
+func (f *filter) Primitive() engine.Primitive {
+	f.efilter.Input = f.input.Primitive()
+	return f.efilter
+}
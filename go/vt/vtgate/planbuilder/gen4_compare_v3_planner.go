@@ -123,6 +123,14 @@ func preliminaryChecks(statement sqlparser.Statement) (bool, bool, []string, err
 			}
 		}
 	}
+	if hasCTE, _ := containsCTE(statement); hasCTE {
+		// common table expressions are only supported by Gen4
+		onlyGen4 = true
+	}
+	if containsWindowFunction(statement) {
+		// window functions are only supported by Gen4
+		onlyGen4 = true
+	}
 	return onlyGen4, hasOrderBy, comments, nil
 }
 
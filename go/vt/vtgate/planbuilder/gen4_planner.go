@@ -34,6 +34,15 @@ func gen4Planner(query string) func(sqlparser.Statement, *sqlparser.ReservedVars
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%T not yet supported", stmt)
 		}
 
+		if hasCTE, recursive := containsCTE(selStatement); hasCTE {
+			if recursive {
+				return buildRecursiveCTEPlan(selStatement, vschema)
+			}
+			if err := inlineCTEs(selStatement); err != nil {
+				return nil, err
+			}
+		}
+
 		sel, isSel := selStatement.(*sqlparser.Select)
 		if isSel {
 			// handle dual table for processing at vtgate.
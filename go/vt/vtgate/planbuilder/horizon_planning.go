@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"strings"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
 
@@ -29,6 +30,7 @@ import (
 
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
 type horizonPlanning struct {
@@ -36,6 +38,9 @@ type horizonPlanning struct {
 	qp              *abstract.QueryProjection
 	needsTruncation bool
 	vtgateGrouping  bool
+	// distinctExpr is the expression of the first distinct aggregation,
+	// the input of the orderedAggregate is sorted on it within every group.
+	distinctExpr sqlparser.Expr
 }
 
 func (hp *horizonPlanning) planHorizon(ctx *planningContext, plan logicalPlan) (logicalPlan, error) {
@@ -52,6 +57,24 @@ func (hp *horizonPlanning) planHorizon(ctx *planningContext, plan logicalPlan) (
 		return plan, nil
 	}
 
+	if containsWindowFunction(hp.sel.SelectExprs) {
+		// when all the windows are partitioned by a vindex column, every
+		// partition is computed by a single shard and the query can be sent down
+		pushDown := false
+		if isRoute {
+			var err error
+			pushDown, err = windowsPartitionedByVindex(hp.sel, ctx.semTable)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !pushDown {
+			return hp.planWindows(ctx, plan)
+		}
+		// the select expressions pushed to the route can refer to the named windows
+		sqlparser.GetFirstSelect(rb.Select).Windows = hp.sel.Windows
+	}
+
 	qp, err := abstract.CreateQPFromSelect(hp.sel, ctx.semTable)
 	if err != nil {
 		return nil, err
@@ -62,6 +85,10 @@ func (hp *horizonPlanning) planHorizon(ctx *planningContext, plan logicalPlan) (
 	needAggrOrHaving := hp.qp.NeedsAggregation() || hp.sel.Having != nil
 	canShortcut := isRoute && !needAggrOrHaving && len(hp.qp.OrderExprs) == 0
 
+	if needAggrOrHaving && hp.needsOrderedAggregate(ctx, plan) && !isJoinPlan(plan) && hp.needsEvaluation() {
+		return hp.planEvaluatedAggregations(ctx, plan)
+	}
+
 	if needAggrOrHaving {
 		plan, err = hp.planAggregations(ctx, plan)
 		if err != nil {
@@ -141,7 +168,7 @@ func (hp *horizonPlanning) truncateColumnsIfNeeded(plan logicalPlan) error {
 	switch p := plan.(type) {
 	case *route:
 		p.eroute.SetTruncateColumnCount(hp.sel.GetColumnCount())
-	case *joinGen4:
+	case *joinGen4, *semiJoin:
 		// since this is a join, we can safely add extra columns and not need to truncate them
 	case *orderedAggregate:
 		p.eaggr.SetTruncateColumnCount(hp.sel.GetColumnCount())
@@ -230,6 +257,29 @@ func pushProjection(expr *sqlparser.AliasedExpr, plan logicalPlan, semTable *sem
 		}
 		node.Cols = append(node.Cols, column)
 		return len(node.Cols) - 1, true, nil
+	case *semiJoin:
+		// the semi-join only returns columns of the outer query, which are filtered
+		// after the outer query has been executed: aggregations can't be pushed down
+		if sqlparser.ContainsAggregation(expr.Expr) {
+			return 0, false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard query with aggregates")
+		}
+		passDownReuseCol := reuseCol
+		if !reuseCol {
+			passDownReuseCol = expr.As.IsEmpty()
+		}
+		offset, added, err := pushProjection(expr, node.Left, semTable, inner, passDownReuseCol)
+		if err != nil {
+			return 0, false, err
+		}
+		if reuseCol && !added {
+			for idx, col := range node.Cols {
+				if offset == col {
+					return idx, false, nil
+				}
+			}
+		}
+		node.Cols = append(node.Cols, offset)
+		return len(node.Cols) - 1, true, nil
 	case *pulloutSubquery:
 		// push projection to the outer query
 		return pushProjection(expr, node.underlying, semTable, inner, reuseCol)
@@ -343,21 +393,32 @@ func (hp *horizonPlanning) haveToTruncate(v bool) {
 	hp.needsTruncation = hp.needsTruncation || v
 }
 
+// needsOrderedAggregate returns true if the aggregations can't be pushed down
+// as a whole, and have to be computed by an orderedAggregate at the vtgate level.
+func (hp *horizonPlanning) needsOrderedAggregate(ctx *planningContext, plan logicalPlan) bool {
+	uniqVindex := hasUniqueVindex(ctx.vschema, ctx.semTable, hp.qp.GroupByExprs)
+	_, joinPlan := plan.(*joinGen4)
+	_, semiJoinPlan := plan.(*semiJoin)
+	return !uniqVindex || joinPlan || semiJoinPlan
+}
+
+func newOrderedAggregate(plan logicalPlan) *orderedAggregate {
+	eaggr := &engine.OrderedAggregate{}
+	return &orderedAggregate{
+		resultsBuilder: resultsBuilder{
+			logicalPlanCommon: newBuilderCommon(plan),
+			weightStrings:     make(map[*resultColumn]int),
+			truncater:         eaggr,
+		},
+		eaggr: eaggr,
+	}
+}
+
 func (hp *horizonPlanning) planAggregations(ctx *planningContext, plan logicalPlan) (logicalPlan, error) {
 	newPlan := plan
 	var oa *orderedAggregate
-	uniqVindex := hasUniqueVindex(ctx.vschema, ctx.semTable, hp.qp.GroupByExprs)
-	_, joinPlan := plan.(*joinGen4)
-	if !uniqVindex || joinPlan {
-		eaggr := &engine.OrderedAggregate{}
-		oa = &orderedAggregate{
-			resultsBuilder: resultsBuilder{
-				logicalPlanCommon: newBuilderCommon(plan),
-				weightStrings:     make(map[*resultColumn]int),
-				truncater:         eaggr,
-			},
-			eaggr: eaggr,
-		}
+	if hp.needsOrderedAggregate(ctx, plan) {
+		oa = newOrderedAggregate(plan)
 		newPlan = oa
 		hp.vtgateGrouping = true
 	}
@@ -377,33 +438,9 @@ func (hp *horizonPlanning) planAggregations(ctx *planningContext, plan logicalPl
 			continue
 		}
 
-		fExpr, isFunc := aliasExpr.Expr.(*sqlparser.FuncExpr)
-		if !isFunc {
-			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex aggregate expression")
-		}
-		opcode, found := engine.SupportedAggregates[fExpr.Name.Lowered()]
-		if !found {
-			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex aggregate expression")
-		}
-		handleDistinct, innerAliased, err := hp.needDistinctHandling(ctx, fExpr, opcode, plan)
-		if err != nil {
+		if _, err := hp.pushAggregation(ctx, plan, oa, e); err != nil {
 			return nil, err
 		}
-
-		pushExpr, alias, opcode := hp.createPushExprAndAlias(e, handleDistinct, innerAliased, opcode, oa)
-		offset, _, err := pushProjection(pushExpr, plan, ctx.semTable, true, false)
-		if err != nil {
-			if strings.HasPrefix(err.Error(), "unknown dependencies for") {
-				return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard query with aggregates")
-			}
-			return nil, err
-		}
-		oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, &engine.AggregateParams{
-			Opcode: opcode,
-			Col:    offset,
-			Alias:  alias,
-			Expr:   fExpr,
-		})
 	}
 
 	for _, groupExpr := range hp.qp.GroupByExprs {
@@ -457,6 +494,53 @@ func (hp *horizonPlanning) planAggregations(ctx *planningContext, plan logicalPl
 	return plan, nil
 }
 
+// pushAggregation pushes the expression of an aggregation down to the input
+// of the orderedAggregate, adds the aggregation to it, and returns its offset.
+func (hp *horizonPlanning) pushAggregation(ctx *planningContext, plan logicalPlan, oa *orderedAggregate, e abstract.SelectExpr) (int, error) {
+	aliasExpr, err := e.GetAliasedExpr()
+	if err != nil {
+		return 0, err
+	}
+	fExpr, isFunc := aliasExpr.Expr.(*sqlparser.FuncExpr)
+	if !isFunc {
+		return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex aggregate expression")
+	}
+	opcode, found := engine.SupportedAggregates[fExpr.Name.Lowered()]
+	if !found {
+		return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex aggregate expression")
+	}
+	handleDistinct, innerAliased, err := hp.needDistinctHandling(ctx, fExpr, opcode, plan)
+	if err != nil {
+		return 0, err
+	}
+
+	pushExpr, alias, opcode := hp.createPushExprAndAlias(e, handleDistinct, innerAliased, opcode, oa)
+	offset, _, err := pushProjection(pushExpr, plan, ctx.semTable, true, false)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "unknown dependencies for") {
+			return 0, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard query with aggregates")
+		}
+		return 0, err
+	}
+	aggr := &engine.AggregateParams{
+		Opcode: opcode,
+		Col:    offset,
+		Alias:  alias,
+		Expr:   fExpr,
+	}
+	if handleDistinct {
+		// the input is only sorted on the values of the first distinct expression,
+		// the values of the other ones are deduplicated using a hash table
+		if hp.distinctExpr == nil {
+			hp.distinctExpr = innerAliased.Expr
+		} else if !sqlparser.EqualsExpr(hp.distinctExpr, innerAliased.Expr) {
+			aggr.HashDistinct = true
+		}
+	}
+	oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, aggr)
+	return offset, nil
+}
+
 // createPushExprAndAlias creates the expression that should be pushed down to the leaves,
 // and changes the opcode so it is a distinct one if needed
 func (hp *horizonPlanning) createPushExprAndAlias(
@@ -519,7 +603,7 @@ func planGroupByGen4(groupExpr abstract.GroupBy, plan logicalPlan, semTable *sem
 			sel.GroupBy = append(sel.GroupBy, weightStringFor(groupExpr.WeightStrExpr))
 		}
 		return false, nil
-	case *joinGen4:
+	case *joinGen4, *semiJoin:
 		_, _, added, err := wrapAndPushExpr(groupExpr.Inner, groupExpr.WeightStrExpr, node, semTable)
 		return added, err
 	case *orderedAggregate:
@@ -527,13 +611,15 @@ func planGroupByGen4(groupExpr abstract.GroupBy, plan logicalPlan, semTable *sem
 		if err != nil {
 			return false, err
 		}
+		collation := collationFor(groupExpr.Inner, semTable)
 		if groupExpr.DistinctAggrIndex == 0 {
-			node.eaggr.GroupByKeys = append(node.eaggr.GroupByKeys, &engine.GroupByParams{KeyCol: keyCol, WeightStringCol: wsOffset, Expr: groupExpr.WeightStrExpr})
+			node.eaggr.GroupByKeys = append(node.eaggr.GroupByKeys, &engine.GroupByParams{KeyCol: keyCol, WeightStringCol: wsOffset, Expr: groupExpr.WeightStrExpr, CollationID: collation})
 		} else {
 			if wsOffset != -1 {
 				node.eaggr.Aggregates[groupExpr.DistinctAggrIndex-1].WAssigned = true
 				node.eaggr.Aggregates[groupExpr.DistinctAggrIndex-1].WCol = wsOffset
 			}
+			node.eaggr.Aggregates[groupExpr.DistinctAggrIndex-1].CollationID = collation
 		}
 		colAddedRecursively, err := planGroupByGen4(groupExpr, node.input, semTable, wsOffset != -1)
 		if err != nil {
@@ -614,6 +700,14 @@ func (hp *horizonPlanning) planOrderBy(ctx *planningContext, orderExprs []abstra
 		return plan, nil
 	case *memorySort:
 		return plan, nil
+	case *semiJoin:
+		// the semi-join keeps the order of the rows of the outer query
+		newLeft, err := hp.planOrderBy(ctx, orderExprs, plan.Left)
+		if err != nil {
+			return nil, err
+		}
+		plan.Left = newLeft
+		return plan, nil
 	case *pulloutSubquery:
 		newUnderlyingPlan, err := hp.planOrderBy(ctx, orderExprs, plan.underlying)
 		if err != nil {
@@ -647,7 +741,7 @@ func isSpecialOrderBy(o abstract.OrderBy) bool {
 
 func planOrderByForRoute(orderExprs []abstract.OrderBy, plan *route, semTable *semantics.SemTable, hasStar bool) (logicalPlan, bool, error) {
 	origColCount := plan.Select.GetColumnCount()
-	for _, order := range orderExprs {
+	for i, order := range orderExprs {
 		err := checkOrderExprCanBePlannedInScatter(plan, order, hasStar)
 		if err != nil {
 			return nil, false, err
@@ -665,11 +759,43 @@ func planOrderByForRoute(orderExprs []abstract.OrderBy, plan *route, semTable *s
 			Col:             offset,
 			WeightStringCol: weightStringOffset,
 			Desc:            order.Inner.Direction == sqlparser.DescOrder,
+			CollationID:     collationFor(order.Inner.Expr, semTable),
 		})
+		if i == 0 {
+			plan.eroute.OrderedByVindex = isOrderedByVindex(plan.eroute.Opcode, order.Inner.Expr, semTable)
+		}
 	}
 	return plan, origColCount != plan.Select.GetColumnCount(), nil
 }
 
+// isOrderedByVindex returns true if a route to several shards that is ordered by the expression
+// can read its shards in the order of their key ranges: the expression is the column of the primary
+// vindex of its table, and that vindex keeps the keyspace ids in the order of the values.
+// The column type must be known and not be text, whose collation can order values differently.
+func isOrderedByVindex(opcode engine.RouteOpcode, expr sqlparser.Expr, semTable *semantics.SemTable) bool {
+	switch opcode {
+	case engine.SelectScatter, engine.SelectIN, engine.SelectMultiEqual, engine.SelectRange:
+	default:
+		return false
+	}
+	col, isCol := expr.(*sqlparser.ColName)
+	if !isCol {
+		return false
+	}
+	if typ := semTable.TypeFor(col); typ == nil || sqltypes.IsText(*typ) {
+		return false
+	}
+	tbl, err := semTable.TableInfoForExpr(col)
+	if err != nil || tbl.GetVindexTable() == nil || len(tbl.GetVindexTable().ColumnVindexes) == 0 {
+		return false
+	}
+	primary := tbl.GetVindexTable().ColumnVindexes[0]
+	if _, isOrdered := primary.Vindex.(vindexes.Ordered); !isOrdered {
+		return false
+	}
+	return len(primary.Columns) == 1 && primary.Columns[0].Equal(col.Name)
+}
+
 // checkOrderExprCanBePlannedInScatter verifies that the given order by expression can be planned.
 // It checks if the expression exists in the plan's select list when the query is a scatter.
 func checkOrderExprCanBePlannedInScatter(plan *route, order abstract.OrderBy, hasStar bool) error {
@@ -698,7 +824,7 @@ func wrapAndPushExpr(expr sqlparser.Expr, weightStrExpr sqlparser.Expr, plan log
 	if err != nil {
 		return 0, 0, false, err
 	}
-	if weightStrExpr == nil {
+	if weightStrExpr == nil || collationFor(expr, semTable) != collations.Unknown {
 		return offset, -1, added, nil
 	}
 	if !sqlparser.IsColName(expr) {
@@ -726,6 +852,23 @@ func wrapAndPushExpr(expr sqlparser.Expr, weightStrExpr sqlparser.Expr, plan log
 	return offset, weightStringOffset, added || wAdded, nil
 }
 
+// collationFor returns the collation that vtgate can use to compare the values of a text column,
+// or collations.Unknown if the values must be compared using their weight_string
+func collationFor(expr sqlparser.Expr, semTable *semantics.SemTable) collations.ID {
+	if !sqlparser.IsColName(expr) {
+		return collations.Unknown
+	}
+	qt := semTable.TypeFor(expr)
+	if qt == nil || !sqltypes.IsText(*qt) {
+		return collations.Unknown
+	}
+	collation := semTable.CollationFor(expr)
+	if !collation.IsExact() {
+		return collations.Unknown
+	}
+	return collation
+}
+
 func weightStringFor(expr sqlparser.Expr) sqlparser.Expr {
 	return &sqlparser.FuncExpr{
 		Name: sqlparser.NewColIdent("weight_string"),
@@ -779,7 +922,7 @@ func createMemorySortPlanOnAggregation(plan *orderedAggregate, orderExprs []abst
 	}
 
 	for _, order := range orderExprs {
-		offset, woffset, found := findExprInOrderedAggr(plan, order)
+		offset, woffset, collation, found := findExprInOrderedAggr(plan, order)
 		if !found {
 			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "expected to find the order by expression (%s) in orderedAggregate", sqlparser.String(order.Inner))
 		}
@@ -788,23 +931,24 @@ func createMemorySortPlanOnAggregation(plan *orderedAggregate, orderExprs []abst
 			WeightStringCol:   woffset,
 			Desc:              order.Inner.Direction == sqlparser.DescOrder,
 			StarColFixedIndex: offset,
+			CollationID:       collation,
 		})
 	}
 	return ms, nil
 }
 
-func findExprInOrderedAggr(plan *orderedAggregate, order abstract.OrderBy) (int, int, bool) {
+func findExprInOrderedAggr(plan *orderedAggregate, order abstract.OrderBy) (int, int, collations.ID, bool) {
 	for _, key := range plan.eaggr.GroupByKeys {
 		if sqlparser.EqualsExpr(order.WeightStrExpr, key.Expr) {
-			return key.KeyCol, key.WeightStringCol, true
+			return key.KeyCol, key.WeightStringCol, key.CollationID, true
 		}
 	}
 	for _, aggregate := range plan.eaggr.Aggregates {
 		if sqlparser.EqualsExpr(order.WeightStrExpr, aggregate.Expr) {
-			return aggregate.Col, -1, true
+			return aggregate.Col, -1, collations.Unknown, true
 		}
 	}
-	return 0, 0, false
+	return 0, 0, collations.Unknown, false
 }
 
 func (hp *horizonPlanning) createMemorySortPlan(ctx *planningContext, plan logicalPlan, orderExprs []abstract.OrderBy) (logicalPlan, error) {
@@ -829,6 +973,7 @@ func (hp *horizonPlanning) createMemorySortPlan(ctx *planningContext, plan logic
 			WeightStringCol:   weightStringOffset,
 			Desc:              order.Inner.Direction == sqlparser.DescOrder,
 			StarColFixedIndex: offset,
+			CollationID:       collationFor(order.Inner.Expr, ctx.semTable),
 		})
 	}
 	return ms, nil
@@ -858,7 +1003,7 @@ func (hp *horizonPlanning) planDistinct(ctx *planningContext, plan logicalPlan)
 		}
 
 		return hp.addDistinct(ctx, plan)
-	case *joinGen4, *pulloutSubquery:
+	case *joinGen4, *semiJoin, *pulloutSubquery:
 		return hp.addDistinct(ctx, plan)
 	case *orderedAggregate:
 		return hp.planDistinctOA(p)
@@ -925,6 +1070,7 @@ func (hp *horizonPlanning) addDistinct(ctx *planningContext, plan logicalPlan) (
 		}
 		hp.needsTruncation = hp.needsTruncation || added
 		grpParam.WeightStringCol = wOffset
+		grpParam.CollationID = collationFor(aliasExpr.Expr, ctx.semTable)
 		eaggr.GroupByKeys = append(eaggr.GroupByKeys, grpParam)
 
 		var inner sqlparser.Expr
@@ -64,7 +64,7 @@ func buildInsertPlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 	if ins.Action == sqlparser.ReplaceAct {
 		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
 	}
-	return buildInsertShardedPlan(ins, vschemaTable)
+	return buildInsertShardedPlan(ins, vschemaTable, reservedVars, vschema)
 }
 
 func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
@@ -111,7 +111,7 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (eng
 	return eins, nil
 }
 
-func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
+func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
 	eins := engine.NewSimpleInsert(
 		engine.InsertSharded,
 		table,
@@ -141,8 +141,8 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
-	case *sqlparser.Select, *sqlparser.Union:
-		return nil, errors.New("unsupported: insert into select")
+	case sqlparser.SelectStatement:
+		return buildInsertSelectPlan(ins, insertValues, eins, reservedVars, vschema)
 	case sqlparser.Values:
 		rows = insertValues
 		if hasSubquery(rows) {
@@ -194,6 +194,120 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	return eins, nil
 }
 
+// buildInsertSelectPlan builds an InsertSelect plan for a sharded INSERT...SELECT. The
+// SELECT is planned like any other query, and its rows are routed by the engine.
+func buildInsertSelectPlan(ins *sqlparser.Insert, sel sqlparser.SelectStatement, eins *engine.Insert, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
+	if hasLockingFunc(sel) {
+		return nil, errors.New("unsupported: insert into select")
+	}
+	if len(ins.Columns) == 0 {
+		return nil, errors.New("unsupported: insert into select without a column list")
+	}
+	if eins.Opcode == engine.InsertShardedIgnore {
+		eins.Ignore = true
+	}
+	eins.Opcode = engine.InsertSelect
+	eins.Query = generateQuery(ins)
+
+	// The columns of the insert are matched with the columns of the select by
+	// position. The vindex and auto-increment columns that are not part of the
+	// insert are added at the end of the column list, and are NULL for all rows.
+	// With a star expression, the number of columns of the select is not known.
+	selectExprs := sqlparser.GetFirstSelect(sel).SelectExprs
+	canAddColumns := true
+	for _, expr := range selectExprs {
+		if _, isStar := expr.(*sqlparser.StarExpr); isStar {
+			canAddColumns = false
+		}
+	}
+	if canAddColumns && len(selectExprs) != len(ins.Columns) {
+		return nil, errors.New("column list doesn't match values")
+	}
+	columnOffset := func(col sqlparser.ColIdent) (int, error) {
+		for i, column := range ins.Columns {
+			if col.Equal(column) {
+				return i, nil
+			}
+		}
+		if !canAddColumns {
+			return 0, fmt.Errorf("unsupported: insert into select * without the column %s", col.String())
+		}
+		ins.Columns = append(ins.Columns, col)
+		return len(ins.Columns) - 1, nil
+	}
+
+	eins.VindexValueOffset = make([][]int, len(eins.Table.ColumnVindexes))
+	for vIdx, colVindex := range eins.Table.ColumnVindexes {
+		eins.VindexValueOffset[vIdx] = make([]int, len(colVindex.Columns))
+		for colIdx, col := range colVindex.Columns {
+			offset, err := columnOffset(col)
+			if err != nil {
+				return nil, err
+			}
+			eins.VindexValueOffset[vIdx][colIdx] = offset
+		}
+	}
+	if eins.Table.AutoIncrement != nil {
+		offset, err := columnOffset(eins.Table.AutoIncrement.Column)
+		if err != nil {
+			return nil, err
+		}
+		eins.Generate = &engine.Generate{
+			Keyspace: eins.Table.AutoIncrement.Sequence.Keyspace,
+			Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(eins.Table.AutoIncrement.Sequence.Name)),
+			Offset:   offset,
+		}
+	}
+
+	prefixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	prefixBuf.Myprintf("insert %v%sinto %v%v values ",
+		ins.Comments, ins.Ignore.ToString(),
+		ins.Table, ins.Columns)
+	eins.Prefix = prefixBuf.String()
+	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	suffixBuf.Myprintf("%v", ins.OnDup)
+	eins.Suffix = suffixBuf.String()
+
+	input, err := buildInsertSelectInput(sel, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+	eins.Input = input
+	return eins, nil
+}
+
+// hasLockingFunc returns true if the select uses a locking function,
+// whose locks are tied to the connection that runs it.
+func hasLockingFunc(sel sqlparser.SelectStatement) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if expr, ok := node.(sqlparser.Expr); ok && sqlparser.IsLockingFunc(expr) {
+			found = true
+			return false, nil
+		}
+		return true, nil
+	}, sel)
+	return found
+}
+
+// buildInsertSelectInput plans the SELECT of an INSERT...SELECT with the configured planner.
+func buildInsertSelectInput(sel sqlparser.SelectStatement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
+	var v3planner selectPlanner
+	switch sel.(type) {
+	case *sqlparser.Select:
+		v3planner = buildSelectPlan
+	case *sqlparser.Union:
+		v3planner = buildUnionPlan
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: insert into %T", sel)
+	}
+	configuredPlanner, err := getConfiguredPlanner(vschema, v3planner)
+	if err != nil {
+		return nil, err
+	}
+	return configuredPlanner(sqlparser.String(sel))(sel, reservedVars, vschema)
+}
+
 func populateInsertColumnlist(ins *sqlparser.Insert, table *vindexes.Table) {
 	cols := make(sqlparser.Columns, 0, len(table.Columns))
 	for _, c := range table.Columns {
@@ -17,6 +17,7 @@ limitations under the License.
 package planbuilder
 
 import (
+	"vitess.io/vitess/go/mysql/collations"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -26,7 +27,7 @@ import (
 
 var _ logicalPlan = (*joinGen4)(nil)
 
-// joinGen4 is used to build a Join primitive.
+// joinGen4 is used to build a Join primitive, or a HashJoin primitive when it has join keys.
 // It's used to build an inner join and only used by the Gen4 planner
 type joinGen4 struct {
 	// Left and Right are the nodes for the join.
@@ -34,6 +35,10 @@ type joinGen4 struct {
 	Opcode      engine.JoinOpcode
 	Cols        []int
 	Vars        map[string]int
+
+	// LHSKeys, RHSKeys and Collations are set when the join is executed as a hash join.
+	LHSKeys, RHSKeys []int
+	Collations       []collations.ID
 }
 
 // Order implements the logicalPlan interface
@@ -82,6 +87,17 @@ func (j *joinGen4) SupplyWeightString(colNumber int, alsoAddToGroupBy bool) (wei
 
 // Primitive implements the logicalPlan interface
 func (j *joinGen4) Primitive() engine.Primitive {
+	if len(j.LHSKeys) > 0 {
+		return &engine.HashJoin{
+			Left:       j.Left.Primitive(),
+			Right:      j.Right.Primitive(),
+			Cols:       j.Cols,
+			Opcode:     j.Opcode,
+			LHSKeys:    j.LHSKeys,
+			RHSKeys:    j.RHSKeys,
+			Collations: j.Collations,
+		}
+	}
 	return &engine.Join{
 		Left:   j.Left.Primitive(),
 		Right:  j.Right.Primitive(),
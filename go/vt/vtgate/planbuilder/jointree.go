@@ -28,6 +28,9 @@ type joinTree struct {
 	// arguments that need to be copied from the LHS/RHS
 	vars map[string]int
 
+	// the predicates that were broken into an LHS and an RHS part, using the vars
+	predicates []sqlparser.Expr
+
 	// the children of this plan
 	lhs, rhs queryTree
 
@@ -42,10 +45,11 @@ func (jp *joinTree) tableID() semantics.TableSet {
 
 func (jp *joinTree) clone() queryTree {
 	result := &joinTree{
-		lhs:      jp.lhs.clone(),
-		rhs:      jp.rhs.clone(),
-		leftJoin: jp.leftJoin,
-		vars:     jp.vars,
+		lhs:        jp.lhs.clone(),
+		rhs:        jp.rhs.clone(),
+		leftJoin:   jp.leftJoin,
+		vars:       jp.vars,
+		predicates: append([]sqlparser.Expr{}, jp.predicates...),
 	}
 	return result
 }
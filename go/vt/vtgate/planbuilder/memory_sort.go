@@ -22,6 +22,7 @@ import (
 
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
@@ -109,11 +110,14 @@ func (ms *memorySort) SetLimit(limit *sqlparser.Limit) error {
 // Wireup implements the logicalPlan interface
 // If text columns are detected in the keys, then the function modifies
 // the primitive to pull a corresponding weight_string from mysql and
-// compare those instead. This is because we currently don't have the
-// ability to mimic mysql's collation behavior.
+// compare those instead, unless the collation of the column is known.
 func (ms *memorySort) Wireup(plan logicalPlan, jt *jointab) error {
 	for i, orderby := range ms.eMemorySort.OrderBy {
 		rc := ms.resultColumns[orderby.Col]
+		if collation := rc.column.compareCollation(); collation != collations.Unknown {
+			ms.eMemorySort.OrderBy[i].CollationID = collation
+			continue
+		}
 		// Add a weight_string column if we know that the column is a textual column or if its type is unknown
 		if sqltypes.IsText(rc.column.typ) || rc.column.typ == sqltypes.Null {
 			weightcolNumber, err := ms.input.SupplyWeightString(orderby.Col, orderby.FromGroupBy)
@@ -17,6 +17,7 @@ limitations under the License.
 package planbuilder
 
 import (
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
@@ -61,11 +62,15 @@ func (ms *mergeSort) Primitive() engine.Primitive {
 func (ms *mergeSort) Wireup(plan logicalPlan, jt *jointab) error {
 	// If the route has to do the ordering, and if any columns are Text,
 	// we have to request the corresponding weight_string from mysql
-	// and use that value instead. This is because we cannot mimic
-	// mysql's collation behavior yet.
+	// and use that value instead, unless we know the collation of the
+	// column and can compare the values in vtgate.
 	rb := ms.input.(*route)
 	for i, orderby := range rb.eroute.OrderBy {
 		rc := ms.resultColumns[orderby.Col]
+		if collation := rc.column.compareCollation(); collation != collations.Unknown {
+			rb.eroute.OrderBy[i].CollationID = collation
+			continue
+		}
 		// Add a weight_string column if we know that the column is a textual column or if its type is unknown
 		if sqltypes.IsText(rc.column.typ) || rc.column.typ == sqltypes.Null {
 			var err error
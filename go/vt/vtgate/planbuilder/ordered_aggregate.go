@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -90,7 +91,7 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 		if hasAggregates {
 			return vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard query with aggregates")
 		}
-		pb.plan = newDistinct(pb.plan)
+		pb.plan = newDistinct(pb.plan, nil)
 		return nil
 	}
 
@@ -309,11 +310,14 @@ func (oa *orderedAggregate) needDistinctHandling(pb *primitiveBuilder, funcExpr
 // Wireup implements the logicalPlan interface
 // If text columns are detected in the keys, then the function modifies
 // the primitive to pull a corresponding weight_string from mysql and
-// compare those instead. This is because we currently don't have the
-// ability to mimic mysql's collation behavior.
+// compare those instead, unless the collation of the column is known.
 func (oa *orderedAggregate) Wireup(plan logicalPlan, jt *jointab) error {
 	for i, gbk := range oa.eaggr.GroupByKeys {
 		rc := oa.resultColumns[gbk.KeyCol]
+		if collation := rc.column.compareCollation(); collation != collations.Unknown {
+			oa.eaggr.GroupByKeys[i].CollationID = collation
+			continue
+		}
 		if sqltypes.IsText(rc.column.typ) {
 			weightcolNumber, err := oa.input.SupplyWeightString(gbk.KeyCol, gbk.FromGroupBy)
 			if err != nil {
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// planCacheTable returns the virtual table of the plan cache of vtgate
+// the table name refers to, if it refers to one.
+func planCacheTable(tableName sqlparser.TableName) (string, bool) {
+	if !strings.EqualFold(tableName.Qualifier.String(), engine.PlanCacheSchema) {
+		return "", false
+	}
+	table := strings.ToLower(tableName.Name.String())
+	return table, engine.PlanCacheTableFields(table) != nil
+}
+
+// planCacheSelectTable returns the virtual table of the plan cache
+// the select reads, if it only reads one.
+func planCacheSelectTable(sel *sqlparser.Select) (string, bool) {
+	if len(sel.From) != 1 {
+		return "", false
+	}
+	aliased, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return "", false
+	}
+	tableName, ok := aliased.Expr.(sqlparser.TableName)
+	if !ok {
+		return "", false
+	}
+	return planCacheTable(tableName)
+}
+
+// buildPlanCacheSelectPlan builds the plan of a select of a virtual table of
+// the plan cache. The rows of the table are filtered, sorted and limited in vtgate.
+func buildPlanCacheSelectPlan(sel *sqlparser.Select, table string) (engine.Primitive, error) {
+	if sel.Distinct || sel.GroupBy != nil || sel.Having != nil || sel.Into != nil || sel.Lock != sqlparser.NoLock {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: distinct, group by, having, into or lock in a select of %s.%s", engine.PlanCacheSchema, table)
+	}
+	fields := engine.PlanCacheTableFields(table)
+	columnOffset := func(expr sqlparser.Expr) (int, bool) {
+		col, ok := expr.(*sqlparser.ColName)
+		if !ok {
+			return 0, false
+		}
+		for i, field := range fields {
+			if col.Name.EqualString(field.Name) {
+				return i, true
+			}
+		}
+		return 0, false
+	}
+
+	var prim engine.Primitive = &engine.PlanCacheTable{Table: table}
+	if sel.Where != nil {
+		predicate, err := sqlparser.ConvertWithColumns(sel.Where.Expr, func(expr sqlparser.Expr) (int, collations.ID, bool) {
+			offset, ok := columnOffset(expr)
+			if !ok {
+				return 0, collations.Unknown, false
+			}
+			return offset, engine.PlanCacheCollation(fields[offset]), true
+		})
+		if err != nil {
+			return nil, err
+		}
+		prim = &engine.Filter{Predicate: predicate, Input: prim}
+	}
+
+	if len(sel.OrderBy) > 0 {
+		ms := &engine.MemorySort{Input: prim}
+		for _, order := range sel.OrderBy {
+			offset, ok := columnOffset(order.Expr)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: order by %s in a select of %s.%s", sqlparser.String(order.Expr), engine.PlanCacheSchema, table)
+			}
+			params := engine.OrderByParams{
+				Col:             offset,
+				WeightStringCol: -1,
+				Desc:            order.Direction == sqlparser.DescOrder,
+				CollationID:     engine.PlanCacheCollation(fields[offset]),
+			}
+			ms.OrderBy = append(ms.OrderBy, params)
+		}
+		prim = ms
+	}
+
+	if sel.Limit != nil {
+		limit := &engine.Limit{Input: prim}
+		pv, err := sqlparser.NewPlanValue(sel.Limit.Rowcount)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "unexpected expression in LIMIT")
+		}
+		limit.Count = pv
+		if sel.Limit.Offset != nil {
+			pv, err = sqlparser.NewPlanValue(sel.Limit.Offset)
+			if err != nil {
+				return nil, vterrors.Wrap(err, "unexpected expression in OFFSET")
+			}
+			limit.Offset = pv
+		}
+		prim = limit
+	}
+
+	if _, ok := sel.SelectExprs[0].(*sqlparser.StarExpr); ok && len(sel.SelectExprs) == 1 {
+		return prim, nil
+	}
+	projection := &engine.SimpleProjection{Input: prim}
+	for _, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok || !aliased.As.IsEmpty() {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s in a select of %s.%s", sqlparser.String(expr), engine.PlanCacheSchema, table)
+		}
+		offset, ok := columnOffset(aliased.Expr)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown column '%s' in 'field list'", sqlparser.String(aliased.Expr))
+		}
+		projection.Cols = append(projection.Cols, offset)
+	}
+	return projection, nil
+}
+
+// buildPlanCacheTruncatePlan builds the plan of a truncate of a virtual table
+// of the plan cache. Truncating the query stats resets them.
+func buildPlanCacheTruncatePlan(table string) (engine.Primitive, error) {
+	if table != engine.QueryStatsTable {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: truncate %s.%s", engine.PlanCacheSchema, table)
+	}
+	return &engine.ResetQueryStats{}, nil
+}
@@ -174,6 +174,8 @@ func TestPlan(t *testing.T) {
 	// column names are case-preserved, but treated as
 	// case-insensitive even if they come from the vschema.
 	testFile(t, "aggr_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "cte_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "window_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "dml_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "from_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "filter_cases.txt", testOutputTempDir, vschemaWrapper)
@@ -0,0 +1,38 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ logicalPlan = (*projection)(nil)
+
+// projection is the logicalPlan for engine.Projection.
+// It appends the values of expressions that can only be evaluated at the
+// vtgate level, such as arithmetic over cross-shard aggregates, to the
+// columns of its input.
+type projection struct {
+	logicalPlanCommon
+	eProj *engine.Projection
+}
+
+// Primitive implements the logicalPlan interface
+func (p *projection) Primitive() engine.Primitive {
+	p.eProj.Input = p.input.Primitive()
+	return p.eProj
+}
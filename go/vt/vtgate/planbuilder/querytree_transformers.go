@@ -20,11 +20,13 @@ import (
 	"sort"
 	"strings"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	"vitess.io/vitess/go/vt/vterrors"
@@ -40,6 +42,8 @@ func transformToLogicalPlan(ctx *planningContext, tree queryTree) (logicalPlan,
 		return transformDerivedPlan(ctx, n)
 	case *subqueryTree:
 		return transformSubqueryTree(ctx, n)
+	case *semiJoinTree:
+		return transformSemiJoinTree(ctx, n)
 	case *concatenateTree:
 		return transformConcatenatePlan(ctx, n)
 	case *vindexTree:
@@ -107,6 +111,37 @@ func transformSubqueryTree(ctx *planningContext, n *subqueryTree) (logicalPlan,
 	return plan, err
 }
 
+func transformSemiJoinTree(ctx *planningContext, n *semiJoinTree) (logicalPlan, error) {
+	lhs, err := transformToLogicalPlan(ctx, n.outer)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := transformToLogicalPlan(ctx, n.inner)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err = planHorizon(ctx, rhs, n.extracted.Subquery.Select)
+	if err != nil {
+		return nil, err
+	}
+	opcode := engine.Semi
+	if n.anti {
+		opcode = engine.Anti
+	}
+	sj := &semiJoin{
+		Left:   lhs,
+		Right:  rhs,
+		Opcode: opcode,
+		Cols:   n.columns,
+	}
+	if n.batched {
+		sj.ListVars = n.vars
+	} else {
+		sj.Vars = n.vars
+	}
+	return sj, nil
+}
+
 func transformDerivedPlan(ctx *planningContext, n *derivedTree) (logicalPlan, error) {
 	// transforming the inner part of the derived table into a logical plan
 	// so that we can do horizon planning on the inner. If the logical plan
@@ -187,7 +222,7 @@ func transformConcatenatePlan(ctx *planningContext, n *concatenateTree) (logical
 		result = &concatenateGen4{sources: sources}
 	}
 	if n.distinct {
-		return newDistinct(result), nil
+		return newDistinct(result, selectCollations(ctx.semTable, n.selectStmts)), nil
 	}
 	return result, nil
 }
@@ -359,10 +394,10 @@ func transformRoutePlan(ctx *planningContext, n *routeTree) (*route, error) {
 		where = &sqlparser.Where{Expr: predicates, Type: sqlparser.WhereClause}
 	}
 
-	var singleColumn vindexes.SingleColumn
+	var vindex vindexes.Vindex
 	var values []sqltypes.PlanValue
 	if n.selectedVindex() != nil {
-		singleColumn = n.selected.foundVindex.(vindexes.SingleColumn)
+		vindex = n.selected.foundVindex
 		values = n.selected.values
 	}
 
@@ -399,7 +434,7 @@ func transformRoutePlan(ctx *planningContext, n *routeTree) (*route, error) {
 			Opcode:              n.routeOpCode,
 			TableName:           strings.Join(tableNames, ", "),
 			Keyspace:            n.keyspace,
-			Vindex:              singleColumn,
+			Vindex:              vindex,
 			Values:              values,
 			SysTableTableName:   n.SysTableTableName,
 			SysTableTableSchema: n.SysTableTableSchema,
@@ -415,7 +450,15 @@ func transformJoinPlan(ctx *planningContext, n *joinTree) (logicalPlan, error) {
 	if err != nil {
 		return nil, err
 	}
-	rhs, err := transformToLogicalPlan(ctx, n.rhs)
+	hashRHS, keys, err := planHashJoin(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	rhsTree := n.rhs
+	if hashRHS != nil {
+		rhsTree = hashRHS
+	}
+	rhs, err := transformToLogicalPlan(ctx, rhsTree)
 	if err != nil {
 		return nil, err
 	}
@@ -423,6 +466,17 @@ func transformJoinPlan(ctx *planningContext, n *joinTree) (logicalPlan, error) {
 	if n.leftJoin {
 		opCode = engine.LeftJoin
 	}
+	if keys != nil {
+		return &joinGen4{
+			Left:       lhs,
+			Right:      rhs,
+			Cols:       n.columns,
+			Opcode:     opCode,
+			LHSKeys:    keys.lhs,
+			RHSKeys:    keys.rhs,
+			Collations: keys.collations,
+		}, nil
+	}
 	return &joinGen4{
 		Left:   lhs,
 		Right:  rhs,
@@ -432,6 +486,144 @@ func transformJoinPlan(ctx *planningContext, n *joinTree) (logicalPlan, error) {
 	}, nil
 }
 
+// hashJoinKeys are the offsets of the columns a hash join compares, and the collations used to compare them
+type hashJoinKeys struct {
+	lhs, rhs   []int
+	collations []collations.ID
+}
+
+// singleRowCost is the cost of the routes that go to a single shard, and are not expected
+// to return many rows, such as the SelectEqualUnique and SelectUnsharded routes
+const singleRowCost = 1
+
+// planHashJoin checks if the join should be executed as a hash join instead of a nested loop.
+// The nested loop sends the RHS query once per LHS row, with the join predicates using the values
+// of the row. When all the join predicates are equalities between columns of comparable types,
+// they don't make the RHS route any cheaper, and the LHS is expected to return at least as many
+// rows as the RHS, the RHS query is sent only once, without them, and the rows of both sides are
+// joined by vtgate.
+// It returns the RHS route to use and the keys to join on, or nil if the nested loop should be kept.
+func planHashJoin(ctx *planningContext, n *joinTree) (*routeTree, *hashJoinKeys, error) {
+	rhs, ok := n.rhs.(*routeTree)
+	if !ok || len(n.predicates) == 0 {
+		return nil, nil, nil
+	}
+	switch rhs.routeOpCode {
+	case engine.SelectDBA, engine.SelectNext, engine.SelectNone:
+		return nil, nil, nil
+	}
+
+	keys := &hashJoinKeys{}
+	var rhsColumns []*sqlparser.ColName
+	for _, predicate := range n.predicates {
+		lhsCol, rhsCol := hashJoinColumns(ctx, predicate, n.lhs.tableID(), rhs.tableID())
+		if lhsCol == nil {
+			return nil, nil, nil
+		}
+		collation, ok := hashJoinCollation(ctx, lhsCol, rhsCol)
+		if !ok {
+			return nil, nil, nil
+		}
+		offset, found := n.vars[lhsCol.CompliantName()]
+		if !found {
+			return nil, nil, nil
+		}
+		keys.lhs = append(keys.lhs, offset)
+		keys.collations = append(keys.collations, collation)
+		rhsColumns = append(rhsColumns, rhsCol)
+	}
+
+	newRHS := rhs.clone().(*routeTree)
+	newRHS.predicates = nil
+	for _, predicate := range rhs.predicates {
+		if !usesJoinVars(predicate, n.vars) {
+			newRHS.predicates = append(newRHS.predicates, predicate)
+		}
+	}
+	if len(rhs.predicates)-len(newRHS.predicates) != len(n.predicates) {
+		// the join vars are used by other predicates of the RHS
+		return nil, nil, nil
+	}
+	err := newRHS.resetRoutingSelections(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if newRHS.cost() > rhs.cost() {
+		// the join predicates are used to route the RHS query
+		return nil, nil, nil
+	}
+	if lhsCost := n.lhs.cost(); lhsCost <= singleRowCost || lhsCost < newRHS.cost() {
+		// the LHS is expected to return fewer rows than the RHS without the join
+		// predicates: the nested loop sends a few filtered RHS queries instead of
+		// loading the whole RHS in memory
+		return nil, nil, nil
+	}
+
+	keys.rhs, err = newRHS.pushOutputColumns(rhsColumns, ctx.semTable)
+	if err != nil {
+		return nil, nil, err
+	}
+	return newRHS, keys, nil
+}
+
+// hashJoinColumns returns the LHS and RHS columns compared by an equality join predicate,
+// or nils if the predicate is anything else.
+func hashJoinColumns(ctx *planningContext, predicate sqlparser.Expr, lhs, rhs semantics.TableSet) (*sqlparser.ColName, *sqlparser.ColName) {
+	cmp, ok := predicate.(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualOp {
+		return nil, nil
+	}
+	left, ok := cmp.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	right, ok := cmp.Right.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	switch {
+	case ctx.semTable.RecursiveDeps(left).IsSolvedBy(lhs) && ctx.semTable.RecursiveDeps(right).IsSolvedBy(rhs):
+		return left, right
+	case ctx.semTable.RecursiveDeps(right).IsSolvedBy(lhs) && ctx.semTable.RecursiveDeps(left).IsSolvedBy(rhs):
+		return right, left
+	}
+	return nil, nil
+}
+
+// hashJoinCollation returns the collation to use to compare the two columns in a hash join.
+// The types of both columns must be known: either both numeric, or both text with the same collation.
+func hashJoinCollation(ctx *planningContext, lhsCol, rhsCol *sqlparser.ColName) (collations.ID, bool) {
+	lhsType, rhsType := ctx.semTable.TypeFor(lhsCol), ctx.semTable.TypeFor(rhsCol)
+	if lhsType == nil || rhsType == nil {
+		return collations.Unknown, false
+	}
+	switch {
+	case sqltypes.IsNumber(*lhsType) && sqltypes.IsNumber(*rhsType):
+		return collations.Unknown, true
+	case sqltypes.IsText(*lhsType) && sqltypes.IsText(*rhsType):
+		collation := ctx.semTable.CollationFor(lhsCol)
+		if collation == collations.Unknown || collation != ctx.semTable.CollationFor(rhsCol) {
+			return collations.Unknown, false
+		}
+		return collation, true
+	}
+	return collations.Unknown, false
+}
+
+// usesJoinVars returns true if the expression uses one of the join vars
+func usesJoinVars(expr sqlparser.Expr, vars map[string]int) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if arg, ok := node.(sqlparser.Argument); ok {
+			if _, exists := vars[string(arg)]; exists {
+				found = true
+			}
+		}
+		return !found, nil
+	}, expr)
+	return found
+}
+
 func relToTableExpr(t relation) (sqlparser.TableExpr, error) {
 	switch t := t.(type) {
 	case *routeTable:
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// resultCacheSettings returns how long the results of the query can be
+// cached by vtgate, and the tables whose changes invalidate them.
+// Results are cached for the duration of the CACHE_TTL directive of the
+// query if it has one. Otherwise, they are cached if all the tables read
+// by the query have a result cache ttl in the vschema, for the shortest one.
+func resultCacheSettings(stmt sqlparser.Statement, vschema ContextVSchema) (time.Duration, []string) {
+	sel, ok := stmt.(sqlparser.SelectStatement)
+	if !ok {
+		return 0, nil
+	}
+	// The results of locking reads and of the selects that set the session
+	// state, like the found rows, cannot be served from a cache.
+	switch sel := sel.(type) {
+	case *sqlparser.Select:
+		if sel.Lock != sqlparser.NoLock || sel.SQLCalcFoundRows || sel.Into != nil {
+			return 0, nil
+		}
+	case *sqlparser.Union:
+		if sel.Lock != sqlparser.NoLock || sel.Into != nil {
+			return 0, nil
+		}
+	}
+
+	allCached := true
+	nonDeterministic := false
+	var tablesTTL time.Duration
+	names := make(map[string]bool)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if isNonDeterministic(node) {
+			nonDeterministic = true
+			return false, nil
+		}
+		aliased, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		tableName, ok := aliased.Expr.(sqlparser.TableName)
+		if !ok {
+			return true, nil
+		}
+		if tableName.Name.String() == "dual" {
+			return true, nil
+		}
+		table, _, _, _, err := vschema.FindTable(tableName)
+		if err != nil || table == nil || table.Keyspace == nil {
+			allCached = false
+			return true, nil
+		}
+		names[table.Keyspace.Name+"."+table.Name.String()] = true
+		if table.ResultCacheTTL <= 0 {
+			allCached = false
+		} else if tablesTTL == 0 || table.ResultCacheTTL < tablesTTL {
+			tablesTTL = table.ResultCacheTTL
+		}
+		return true, nil
+	}, sel)
+
+	// The results of the queries that read the time, random values or the
+	// session state would be wrong when served from the cache.
+	if nonDeterministic {
+		return 0, nil
+	}
+
+	ttl := sqlparser.CacheTTLDirective(stmt)
+	if ttl == 0 && allCached {
+		ttl = tablesTTL
+	}
+	if ttl == 0 {
+		return 0, nil
+	}
+	tables := make([]string, 0, len(names))
+	for name := range names {
+		tables = append(tables, name)
+	}
+	sort.Strings(tables)
+	return ttl, tables
+}
+
+// nonDeterministicFuncs are the functions whose result can change between
+// two executions of a query on the same data.
+var nonDeterministicFuncs = map[string]bool{
+	"connection_id":     true,
+	"curdate":           true,
+	"current_date":      true,
+	"current_time":      true,
+	"current_timestamp": true,
+	"curtime":           true,
+	"found_rows":        true,
+	"get_lock":          true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+	"last_insert_id":    true,
+	"localtime":         true,
+	"localtimestamp":    true,
+	"now":               true,
+	"rand":              true,
+	"random_bytes":      true,
+	"release_all_locks": true,
+	"release_lock":      true,
+	"row_count":         true,
+	"sleep":             true,
+	"sysdate":           true,
+	"utc_date":          true,
+	"utc_time":          true,
+	"utc_timestamp":     true,
+	"uuid":              true,
+	"uuid_short":        true,
+}
+
+// isNonDeterministic returns true if the node is a call of a non-deterministic
+// function, or the argument that replaced one when the query was rewritten.
+func isNonDeterministic(node sqlparser.SQLNode) bool {
+	switch node := node.(type) {
+	case *sqlparser.CurTimeFuncExpr:
+		return true
+	case *sqlparser.FuncExpr:
+		name := node.Name.Lowered()
+		// unix_timestamp() returns the current time, unix_timestamp(date) does not.
+		return nonDeterministicFuncs[name] || (name == "unix_timestamp" && len(node.Exprs) == 0)
+	case sqlparser.Argument:
+		switch string(node) {
+		case sqlparser.LastInsertIDName, sqlparser.FoundRowsName, sqlparser.RowCountName:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestResultCacheSettings(t *testing.T) {
+	vschema := &vschemaWrapper{
+		v: loadSchema(t, "schema_test.json"),
+	}
+	vschema.v.Keyspaces["main"].Tables["unsharded_a"].ResultCacheTTL = 10 * time.Second
+	vschema.v.Keyspaces["main"].Tables["unsharded_b"].ResultCacheTTL = 5 * time.Second
+
+	testcases := []struct {
+		query      string
+		wantTTL    time.Duration
+		wantTables []string
+	}{{
+		query:      "select * from unsharded_a",
+		wantTTL:    10 * time.Second,
+		wantTables: []string{"main.unsharded_a"},
+	}, {
+		query:      "select * from unsharded_a join unsharded_b where unsharded_a.id = unsharded_b.id",
+		wantTTL:    5 * time.Second,
+		wantTables: []string{"main.unsharded_a", "main.unsharded_b"},
+	}, {
+		query:      "select * from unsharded_a union select * from unsharded_b",
+		wantTTL:    5 * time.Second,
+		wantTables: []string{"main.unsharded_a", "main.unsharded_b"},
+	}, {
+		query: "select * from unsharded_a join unsharded",
+	}, {
+		query:      "select /*vt+ CACHE_TTL=1s */ * from unsharded_a join unsharded",
+		wantTTL:    time.Second,
+		wantTables: []string{"main.unsharded", "main.unsharded_a"},
+	}, {
+		query:      "select /*vt+ CACHE_TTL=2s */ * from user",
+		wantTTL:    2 * time.Second,
+		wantTables: []string{"user.user"},
+	}, {
+		query: "select * from unsharded_a for update",
+	}, {
+		query: "select sql_calc_found_rows * from unsharded_a",
+	}, {
+		query: "select 1 from dual",
+	}, {
+		query:      "select * from unsharded_a where id < unix_timestamp('2021-01-01')",
+		wantTTL:    10 * time.Second,
+		wantTables: []string{"main.unsharded_a"},
+	}, {
+		query: "select now(), id from unsharded_a",
+	}, {
+		query: "select * from unsharded_a where id > rand()",
+	}, {
+		query: "select /*vt+ CACHE_TTL=1s */ uuid() from unsharded_a",
+	}, {
+		query: "select * from unsharded_a where id = (select max(id) from unsharded_b where id < unix_timestamp())",
+	}, {
+		query: "select last_insert_id() from unsharded_a",
+	}, {
+		query: "select * from unsharded_a where id = :__lastInsertId",
+	}, {
+		query: "update unsharded_a set id = 1",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tcase.query)
+			require.NoError(t, err)
+			ttl, tables := resultCacheSettings(stmt, vschema)
+			assert.Equal(t, tcase.wantTTL, ttl)
+			assert.Equal(t, tcase.wantTables, tables)
+		})
+	}
+}
@@ -133,6 +133,7 @@ func optimizeSubQuery(ctx *planningContext, op *abstract.SubQuery) (queryTree, e
 		return nil, err
 	}
 	var unmerged []*subqueryTree
+	var correlated []*semiJoinTree
 
 	// first loop over the subqueries and try to merge them into the outer plan
 	for _, inner := range op.Inner {
@@ -153,7 +154,12 @@ func optimizeSubQuery(ctx *planningContext, op *abstract.SubQuery) (queryTree, e
 
 		if merged == nil {
 			if len(preds) > 0 {
-				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+				correlated = append(correlated, &semiJoinTree{
+					extracted:  inner.ExtractedSubquery,
+					inner:      treeInner,
+					predicates: preds,
+				})
+				continue
 			}
 			unmerged = append(unmerged, &subqueryTree{
 				extracted: inner.ExtractedSubquery,
@@ -164,6 +170,16 @@ func optimizeSubQuery(ctx *planningContext, op *abstract.SubQuery) (queryTree, e
 		}
 	}
 
+	// the correlated subqueries that could not be merged are
+	// evaluated for every row of the outer query using a semi-join
+	for _, tree := range correlated {
+		err := planSemiJoin(ctx, outerTree, tree)
+		if err != nil {
+			return nil, err
+		}
+		outerTree = tree
+	}
+
 	/*
 		build a tree of the unmerged subqueries
 		rt: route, sqt: subqueryTree
@@ -180,6 +196,304 @@ func optimizeSubQuery(ctx *planningContext, op *abstract.SubQuery) (queryTree, e
 	return outerTree, nil
 }
 
+// planSemiJoin turns the correlated subquery of the given semiJoinTree into a query that
+// can be executed on its own for every row of the outer query. The columns of the outer query
+// used by the subquery are replaced by bind variables, and IN and scalar comparisons are
+// rewritten into predicates of the subquery so that only its existence has to be checked.
+func planSemiJoin(ctx *planningContext, outer queryTree, tree *semiJoinTree) error {
+	extracted := tree.extracted
+	sel, isSel := extracted.Subquery.Select.(*sqlparser.Select)
+	if !isSel {
+		return semantics.Gen4NotSupportedF("UNION in subquery")
+	}
+
+	found, negated, err := removeSubqueryPredicate(ctx, outer, extracted)
+	if err != nil {
+		return err
+	}
+	if !found {
+		// the subquery is not a filter of the outer query, e.g. it is used in the SELECT list
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+	}
+
+	var columns []*sqlparser.ColName
+	var bvNames []string
+	replaceOuterColumns := func(node sqlparser.SQLNode) sqlparser.SQLNode {
+		return sqlparser.Rewrite(node, func(cursor *sqlparser.Cursor) bool {
+			col, isCol := cursor.Node().(*sqlparser.ColName)
+			if !isCol || !ctx.semTable.RecursiveDeps(col).IsSolvedBy(outer.tableID()) {
+				return true
+			}
+			bvName := col.CompliantName()
+			cursor.Replace(sqlparser.NewArgument(bvName))
+			for _, name := range bvNames {
+				if name == bvName {
+					return false
+				}
+			}
+			columns = append(columns, col)
+			bvNames = append(bvNames, bvName)
+			return false
+		}, nil)
+	}
+
+	var predicates []sqlparser.Expr
+	for _, pred := range tree.predicates {
+		predicates = append(predicates, replaceOuterColumns(pred).(sqlparser.Expr))
+	}
+	replaceOuterColumns(sel)
+
+	opcode := engine.PulloutOpcode(extracted.OpCode)
+	switch opcode {
+	case engine.PulloutExists:
+		tree.anti = negated
+	case engine.PulloutIn, engine.PulloutNotIn:
+		if !isSimpleProjection(sel) {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+		}
+		notIn := (opcode == engine.PulloutNotIn) != negated
+		other := replaceOuterColumns(sqlparser.CloneExpr(extracted.OtherSide)).(sqlparser.Expr)
+		pred, err := inSubqueryPredicate(other, sel.SelectExprs, notIn)
+		if err != nil {
+			return err
+		}
+		predicates = append(predicates, pred)
+		tree.anti = notIn
+	case engine.PulloutValue:
+		cmp, isCmp := extracted.Original.(*sqlparser.ComparisonExpr)
+		if !isCmp || negated || len(sel.SelectExprs) != 1 || len(sel.GroupBy) != 0 {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+		}
+		// only an aggregation without grouping is guaranteed to return a single row,
+		// in that case the comparison can be checked by the HAVING clause of the subquery
+		aliased, isAliased := sel.SelectExprs[0].(*sqlparser.AliasedExpr)
+		if !isAliased || !sqlparser.ContainsAggregation(aliased.Expr) {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+		}
+		other := replaceOuterColumns(sqlparser.CloneExpr(extracted.OtherSide)).(sqlparser.Expr)
+		sel.AddHaving(&sqlparser.ComparisonExpr{
+			Left:     other,
+			Operator: cmp.Operator,
+			Right:    aliased.Expr,
+		})
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+	}
+
+	if opcode != engine.PulloutValue && isSimpleProjection(sel) {
+		if batched, columns := batchSemiJoinPredicates(predicates, bvNames); batched != nil {
+			// the subquery returns the values that matched the outer rows of a whole batch
+			predicates = batched
+			sel.SelectExprs = columns
+			sel.OrderBy = nil
+			sel.MakeDistinct()
+			tree.batched = true
+		}
+	}
+
+	// we only need to know if the subquery returns any row
+	if !tree.batched && sel.Limit == nil {
+		sel.SetLimit(&sqlparser.Limit{Rowcount: sqlparser.NewIntLiteral("1")})
+	}
+
+	inner, err := pushJoinPredicate(ctx, predicates, tree.inner)
+	if err != nil {
+		return err
+	}
+	offsets, err := outer.pushOutputColumns(columns, ctx.semTable)
+	if err != nil {
+		return err
+	}
+	tree.vars = map[string]int{}
+	for i, bvName := range bvNames {
+		tree.vars[bvName] = offsets[i]
+	}
+	tree.inner = inner
+	tree.outer = outer
+	return nil
+}
+
+// batchSemiJoinPredicates rewrites the correlated predicates of a semi-join so that the subquery
+// can be executed once for a whole batch of outer rows. This is only possible when every outer
+// column is compared for equality with an expression of the subquery: `inner = :outer` becomes
+// `inner in ::outer`, and the subquery returns the inner expressions, ordered by the names of
+// their bind variables, so that the outer rows can be matched back.
+// It returns nil if the predicates can't be batched.
+func batchSemiJoinPredicates(predicates []sqlparser.Expr, bvNames []string) ([]sqlparser.Expr, sqlparser.SelectExprs) {
+	isOuter := func(expr sqlparser.Expr) bool {
+		arg, isArg := expr.(sqlparser.Argument)
+		if !isArg {
+			return false
+		}
+		for _, name := range bvNames {
+			if string(arg) == name {
+				return true
+			}
+		}
+		return false
+	}
+	usesOuter := func(expr sqlparser.Expr) bool {
+		found := false
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if expr, isExpr := node.(sqlparser.Expr); isExpr && isOuter(expr) {
+				found = true
+			}
+			return !found, nil
+		}, expr)
+		return found
+	}
+
+	var batched []sqlparser.Expr
+	inner := map[string]sqlparser.Expr{}
+	for _, pred := range sqlparser.SplitAndExpression(nil, sqlparser.AndExpressions(predicates...)) {
+		if !usesOuter(pred) {
+			batched = append(batched, pred)
+			continue
+		}
+		cmp, isCmp := pred.(*sqlparser.ComparisonExpr)
+		if !isCmp || cmp.Operator != sqlparser.EqualOp {
+			return nil, nil
+		}
+		outer, expr := cmp.Right, cmp.Left
+		if isOuter(expr) {
+			outer, expr = expr, outer
+		}
+		if !isOuter(outer) || usesOuter(expr) {
+			return nil, nil
+		}
+		bvName := string(outer.(sqlparser.Argument))
+		if _, exists := inner[bvName]; exists {
+			return nil, nil
+		}
+		inner[bvName] = expr
+		batched = append(batched, &sqlparser.ComparisonExpr{
+			Left:     expr,
+			Operator: sqlparser.InOp,
+			Right:    sqlparser.NewListArg(bvName),
+		})
+	}
+	if len(inner) != len(bvNames) {
+		return nil, nil
+	}
+
+	names := append([]string(nil), bvNames...)
+	sort.Strings(names)
+	var columns sqlparser.SelectExprs
+	for _, name := range names {
+		columns = append(columns, &sqlparser.AliasedExpr{Expr: inner[name]})
+	}
+	return batched, columns
+}
+
+// isSimpleProjection returns true if the rows returned by the select are its select expressions
+// evaluated on the rows matching the WHERE clause, so that filtering on them can be done in the WHERE clause
+func isSimpleProjection(sel *sqlparser.Select) bool {
+	if len(sel.GroupBy) > 0 || sel.Having != nil || sel.Limit != nil {
+		return false
+	}
+	for _, expr := range sel.SelectExprs {
+		aliased, isAliased := expr.(*sqlparser.AliasedExpr)
+		if !isAliased || sqlparser.ContainsAggregation(aliased.Expr) {
+			return false
+		}
+	}
+	return true
+}
+
+// inSubqueryPredicate returns the predicate that is added to a subquery so that
+// `other IN (subquery)` becomes true when the subquery returns any row.
+// For NOT IN, the predicate matches the rows that make the comparison false or NULL.
+func inSubqueryPredicate(other sqlparser.Expr, selectExprs sqlparser.SelectExprs, notIn bool) (sqlparser.Expr, error) {
+	left := sqlparser.ValTuple{other}
+	if tuple, isTuple := other.(sqlparser.ValTuple); isTuple {
+		left = tuple
+	}
+	if len(left) != len(selectExprs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Operand should contain %d column(s)", len(left))
+	}
+	if notIn && len(left) > 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+	}
+	var predicates []sqlparser.Expr
+	for i, expr := range selectExprs {
+		inner := expr.(*sqlparser.AliasedExpr).Expr
+		var pred sqlparser.Expr = &sqlparser.ComparisonExpr{
+			Left:     inner,
+			Operator: sqlparser.EqualOp,
+			Right:    left[i],
+		}
+		if notIn {
+			pred = &sqlparser.OrExpr{
+				Left: &sqlparser.OrExpr{
+					Left:  pred,
+					Right: &sqlparser.IsExpr{Left: inner, Right: sqlparser.IsNullOp},
+				},
+				Right: &sqlparser.IsExpr{Left: left[i], Right: sqlparser.IsNullOp},
+			}
+		}
+		predicates = append(predicates, pred)
+	}
+	return sqlparser.AndExpressions(predicates...), nil
+}
+
+// removeSubqueryPredicate removes the predicate using the result of the subquery from the outer query.
+// It returns false if the subquery result is not used as a predicate of a route,
+// and whether the predicate was negated.
+func removeSubqueryPredicate(ctx *planningContext, tree queryTree, extracted *sqlparser.ExtractedSubquery) (found bool, negated bool, err error) {
+	isSubqueryPredicate := func(expr sqlparser.Expr) bool {
+		switch expr := expr.(type) {
+		case *sqlparser.ExtractedSubquery:
+			if expr == extracted {
+				found = true
+				return true
+			}
+		case *sqlparser.NotExpr:
+			if expr.Expr == sqlparser.Expr(extracted) {
+				found, negated = true, true
+				return true
+			}
+		}
+		return false
+	}
+	removeFrom := func(predicates []sqlparser.Expr) []sqlparser.Expr {
+		var result []sqlparser.Expr
+		for _, pred := range predicates {
+			if !isSubqueryPredicate(pred) {
+				result = append(result, pred)
+			}
+		}
+		return result
+	}
+
+	switch tree := tree.(type) {
+	case *routeTree:
+		tree.predicates = removeFrom(tree.predicates)
+		_ = visitRelations(tree.tables, func(tbl relation) (bool, error) {
+			switch tbl := tbl.(type) {
+			case *routeTable:
+				tbl.qtable.Predicates = removeFrom(tbl.qtable.Predicates)
+			case *derivedTable:
+				return false, nil
+			}
+			return true, nil
+		})
+		if found {
+			// the predicate might have been used to route the query
+			err = tree.resetRoutingSelections(ctx)
+		}
+		return found, negated, err
+	case *joinTree:
+		found, negated, err = removeSubqueryPredicate(ctx, tree.lhs, extracted)
+		if !found && err == nil {
+			return removeSubqueryPredicate(ctx, tree.rhs, extracted)
+		}
+		return found, negated, err
+	case *semiJoinTree:
+		return removeSubqueryPredicate(ctx, tree.outer, extracted)
+	}
+	return false, false, nil
+}
+
 func tryMergeSubQuery(ctx *planningContext, outer, subq queryTree, subQueryInner *abstract.SubQueryInner, joinPredicates []sqlparser.Expr, merger mergeFunc) (queryTree, error) {
 	var merged queryTree
 	var err error
@@ -372,6 +686,7 @@ func stripDownQuery(from, to sqlparser.SelectStatement) error {
 		toNode.Distinct = node.Distinct
 		toNode.GroupBy = node.GroupBy
 		toNode.Having = node.Having
+		toNode.Windows = node.Windows
 		toNode.OrderBy = node.OrderBy
 		toNode.Comments = node.Comments
 		toNode.SelectExprs = node.SelectExprs
@@ -409,6 +724,22 @@ func pushJoinPredicate(ctx *planningContext, exprs []sqlparser.Expr, tree queryT
 		return pushJoinPredicateOnJoin(ctx, exprs, node)
 	case *derivedTree:
 		return pushJoinPredicateOnDerived(ctx, exprs, node)
+	case *subqueryTree:
+		plan := node.clone().(*subqueryTree)
+		outer, err := pushJoinPredicate(ctx, exprs, plan.outer)
+		if err != nil {
+			return nil, err
+		}
+		plan.outer = outer
+		return plan, nil
+	case *semiJoinTree:
+		plan := node.clone().(*semiJoinTree)
+		outer, err := pushJoinPredicate(ctx, exprs, plan.outer)
+		if err != nil {
+			return nil, err
+		}
+		plan.outer = outer
+		return plan, nil
 	case *vindexTree:
 		// vindexFunc cannot accept predicates from the other side of a join
 		return node, nil
@@ -486,6 +817,7 @@ func pushJoinPredicateOnJoin(ctx *planningContext, exprs []sqlparser.Expr, node
 		lhsColumns = append(lhsColumns, cols...)
 		lhsVarsName = append(lhsVarsName, bvName...)
 		rhsPreds = append(rhsPreds, predicate)
+		node.predicates = append(node.predicates, expr)
 	}
 
 	if lhsColumns != nil && lhsVarsName != nil {
@@ -507,10 +839,11 @@ func pushJoinPredicateOnJoin(ctx *planningContext, exprs []sqlparser.Expr, node
 		return nil, err
 	}
 	return &joinTree{
-		lhs:      lhsPlan,
-		rhs:      rhsPlan,
-		leftJoin: node.leftJoin,
-		vars:     node.vars,
+		lhs:        lhsPlan,
+		rhs:        rhsPlan,
+		leftJoin:   node.leftJoin,
+		vars:       node.vars,
+		predicates: node.predicates,
 	}, nil
 }
 
@@ -950,7 +1283,7 @@ func tryMerge(ctx *planningContext, a, b queryTree, joinPredicates []sqlparser.E
 			return nil, nil
 		}
 		fallthrough
-	case engine.SelectScatter, engine.SelectIN:
+	case engine.SelectScatter, engine.SelectIN, engine.SelectRange:
 		if len(joinPredicates) == 0 {
 			// If we are doing two Scatters, we have to make sure that the
 			// joins are on the correct vindex to allow them to be merged
@@ -958,7 +1291,7 @@ func tryMerge(ctx *planningContext, a, b queryTree, joinPredicates []sqlparser.E
 			return nil, nil
 		}
 		if !sameKeyspace {
-			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+			return nil, nil
 		}
 
 		canMerge := canMergeOnFilters(ctx, aRoute, bRoute, joinPredicates)
@@ -39,7 +39,8 @@ For easy reference, opcodes are:
 	SelectDBA         7
 	SelectReference   8
 	SelectNone        9
-	NumRouteOpcodes   10
+	SelectRange       10
+	NumRouteOpcodes   11
 */
 
 func TestJoinCanMerge(t *testing.T) {
@@ -55,6 +56,8 @@ func TestJoinCanMerge(t *testing.T) {
 		{true, true, true, true, true, true, true, true, true, true},
 		{false, false, false, false, false, false, false, false, true, false},
 	}
+	testcases[engine.SelectRange][engine.SelectReference] = true
+	testcases[engine.SelectReference][engine.SelectRange] = true
 
 	ks := &vindexes.Keyspace{}
 	for left, vals := range testcases {
@@ -92,6 +95,8 @@ func TestSubqueryCanMerge(t *testing.T) {
 		{true, true, true, true, true, true, true, true, true, true},
 		{false, false, false, false, false, false, false, false, true, false},
 	}
+	testcases[engine.SelectRange][engine.SelectReference] = true
+	testcases[engine.SelectReference][engine.SelectRange] = true
 
 	ks := &vindexes.Keyspace{}
 	lRoute := &route{}
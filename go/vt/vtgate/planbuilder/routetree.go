@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"strings"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
@@ -138,6 +139,8 @@ func (rp *routeTree) cost() int {
 		return 10
 	case engine.SelectMultiEqual:
 		return 10
+	case engine.SelectRange:
+		return 15
 	case engine.SelectScatter:
 		return 20
 	}
@@ -220,6 +223,12 @@ func (rp *routeTree) searchForNewVindexes(ctx *planningContext, predicates []sql
 				return false, err
 			}
 			newVindexFound = newVindexFound || found
+		case *sqlparser.RangeCond:
+			found, err := rp.planRangeCond(ctx, node)
+			if err != nil {
+				return false, err
+			}
+			newVindexFound = newVindexFound || found
 		}
 	}
 	return newVindexFound, nil
@@ -261,6 +270,12 @@ func (rp *routeTree) planComparison(ctx *planningContext, node *sqlparser.Compar
 			return false, false, err
 		}
 		return found, false, nil
+	case sqlparser.LessThanOp, sqlparser.LessEqualOp, sqlparser.GreaterThanOp, sqlparser.GreaterEqualOp:
+		found, err := rp.planRangeOp(ctx, node)
+		if err != nil {
+			return false, false, err
+		}
+		return found, false, nil
 	}
 	return false, false, nil
 }
@@ -416,6 +431,115 @@ func (rp *routeTree) planLikeOp(ctx *planningContext, node *sqlparser.Comparison
 	return rp.haveMatchingVindex(ctx, node, vdValue, column, *val, selectEqual, vdx), err
 }
 
+// planRangeOp plans a comparison such as 'col > 10' as a range that is open on one side.
+func (rp *routeTree) planRangeOp(ctx *planningContext, node *sqlparser.ComparisonExpr) (bool, error) {
+	column, ok := node.Left.(*sqlparser.ColName)
+	other := node.Right
+	isStart := node.Operator == sqlparser.GreaterThanOp || node.Operator == sqlparser.GreaterEqualOp
+	if !ok {
+		column, ok = node.Right.(*sqlparser.ColName)
+		if !ok {
+			// either the LHS or RHS have to be a column to be useful for the vindex
+			return false, nil
+		}
+		other = node.Left
+		isStart = !isStart
+	}
+	if isStart {
+		return rp.planRange(ctx, node, column, other, nil)
+	}
+	return rp.planRange(ctx, node, column, nil, other)
+}
+
+func (rp *routeTree) planRangeCond(ctx *planningContext, node *sqlparser.RangeCond) (bool, error) {
+	column, ok := node.Left.(*sqlparser.ColName)
+	if !ok || node.Operator != sqlparser.BetweenOp {
+		return false, nil
+	}
+	return rp.planRange(ctx, node, column, node.From, node.To)
+}
+
+// planRange adds an option to route the query to the keyspace range of the values going
+// from start to end, for every ordered vindex of the column. A nil start or end leaves the
+// range open on that side, until an other predicate on the column closes it. The bounds of
+// the range are always included, which can only route the query to more shards than needed.
+func (rp *routeTree) planRange(ctx *planningContext, node sqlparser.Expr, column *sqlparser.ColName, start, end sqlparser.Expr) (bool, error) {
+	bounds := []sqlparser.Expr{start, end}
+	values := make([]sqltypes.PlanValue, len(bounds))
+	for i, bound := range bounds {
+		if bound == nil {
+			continue
+		}
+		val, err := rp.makePlanValue(ctx, bound)
+		if err != nil || val == nil {
+			return false, err
+		}
+		values[i] = *val
+	}
+
+	newVindexFound := false
+	for _, v := range rp.vindexPreds {
+		if !ctx.semTable.DirectDeps(column).IsSolvedBy(v.tableID) ||
+			len(v.colVindex.Columns) != 1 || !column.Name.Equal(v.colVindex.Columns[0]) {
+			continue
+		}
+		vindex, isOrdered := v.colVindex.Vindex.(vindexes.Ordered)
+		if !isOrdered || !sameOrderAsColumn(ctx, vindex, column) {
+			continue
+		}
+		newOptions := []*vindexOption{newRangeOption(vindex, values, bounds, []sqlparser.Expr{node})}
+		for _, option := range v.options {
+			if option.opcode != engine.SelectRange {
+				continue
+			}
+			// close the ranges open on the side bounded by this predicate
+			for i, bound := range bounds {
+				other := 1 - i
+				if bound == nil || option.valueExprs[i] != nil || bounds[other] != nil {
+					continue
+				}
+				closedValues := make([]sqltypes.PlanValue, len(bounds))
+				closedValues[i], closedValues[other] = values[i], option.values[other]
+				closedBounds := make([]sqlparser.Expr, len(bounds))
+				closedBounds[i], closedBounds[other] = bound, option.valueExprs[other]
+				predicates := append([]sqlparser.Expr{node}, option.predicates...)
+				newOptions = append(newOptions, newRangeOption(vindex, closedValues, closedBounds, predicates))
+			}
+		}
+		v.options = append(v.options, newOptions...)
+		newVindexFound = true
+	}
+	return newVindexFound, nil
+}
+
+// sameOrderAsColumn returns true if the ordered vindex sorts the values of the column like
+// MySQL does. The binary vindex sorts the values by their bytes, which only matches the
+// order of the binary strings: a text column with any other collation, such as a _ci one,
+// would have rows of the range in shards that are not part of the keyspace range.
+func sameOrderAsColumn(ctx *planningContext, vindex vindexes.Ordered, column *sqlparser.ColName) bool {
+	if _, isBinary := vindex.(*vindexes.Binary); !isBinary {
+		// the other ordered vindexes compare the values as numbers or dates
+		return true
+	}
+	typ := ctx.semTable.TypeFor(column)
+	if typ == nil {
+		return false
+	}
+	return sqltypes.IsBinary(*typ) || (sqltypes.IsText(*typ) && ctx.semTable.CollationFor(column) == collations.Binary)
+}
+
+func newRangeOption(vindex vindexes.Ordered, values []sqltypes.PlanValue, bounds, predicates []sqlparser.Expr) *vindexOption {
+	return &vindexOption{
+		values:      values,
+		valueExprs:  bounds,
+		predicates:  predicates,
+		opcode:      engine.SelectRange,
+		foundVindex: vindex,
+		cost:        costFor(vindex, engine.SelectRange),
+		ready:       true,
+	}
+}
+
 func (rp *routeTree) planIsExpr(ctx *planningContext, node *sqlparser.IsExpr) (bool, error) {
 	// we only handle IS NULL correct. IsExpr can contain other expressions as well
 	if node.Right != sqlparser.IsNullOp {
@@ -522,12 +646,20 @@ func (rp *routeTree) haveMatchingVindex(
 					})
 					newVindexFound = true
 				} else {
+					if !canUseMultiColumn(v.colVindex, opcode, vfunc) {
+						continue
+					}
 					// let's first see if we can improve any of the existing options
 					for _, option := range v.options {
+						if len(option.predicates) < cols {
+							// partial options only use the first columns of the vindex
+							continue
+						}
 						if option.predicates[idx] == nil {
 							option.values[idx] = value
 							option.predicates[idx] = node
 							option.valueExprs[idx] = valueExpr
+							newVindexFound = v.addPartialOption(option, idx) || newVindexFound
 						}
 						if allNotNil(option.predicates) {
 							option.opcode = opcode(v.colVindex)
@@ -547,6 +679,7 @@ func (rp *routeTree) haveMatchingVindex(
 					newOption.predicates[idx] = node
 					newOption.valueExprs[idx] = valueExpr
 					v.options = append(v.options, newOption)
+					newVindexFound = v.addPartialOption(newOption, idx) || newVindexFound
 				}
 			}
 		}
@@ -554,6 +687,46 @@ func (rp *routeTree) haveMatchingVindex(
 	return newVindexFound
 }
 
+// canUseMultiColumn returns false if the predicate can't be used to route with a multi-column vindex,
+// which only maps the values of its columns compared for equality.
+func canUseMultiColumn(colVindex *vindexes.ColumnVindex, opcode func(*vindexes.ColumnVindex) engine.RouteOpcode, vfunc func(*vindexes.ColumnVindex) vindexes.Vindex) bool {
+	if _, isMultiCol := colVindex.Vindex.(vindexes.MultiColumn); !isMultiCol {
+		return true
+	}
+	switch opcode(colVindex) {
+	case engine.SelectEqualUnique, engine.SelectEqual:
+		return vfunc(colVindex) == colVindex.Vindex
+	}
+	return false
+}
+
+// addPartialOption adds an option routing to the keyspace range of the values of the first
+// columns of a multi-column vindex that supports it, if setting the value of the column at
+// idx gave the option a longer prefix of columns with values.
+func (vpp *vindexPlusPredicates) addPartialOption(option *vindexOption, idx int) bool {
+	multiCol, isMultiCol := vpp.colVindex.Vindex.(vindexes.MultiColumn)
+	if !isMultiCol || !multiCol.PartialVindex() {
+		return false
+	}
+	prefix := 0
+	for prefix < len(option.predicates) && option.predicates[prefix] != nil {
+		prefix++
+	}
+	if idx >= prefix || prefix == len(option.predicates) {
+		return false
+	}
+	vpp.options = append(vpp.options, &vindexOption{
+		values:      append([]sqltypes.PlanValue(nil), option.values[:prefix]...),
+		valueExprs:  append([]sqlparser.Expr(nil), option.valueExprs[:prefix]...),
+		predicates:  append([]sqlparser.Expr(nil), option.predicates[:prefix]...),
+		opcode:      engine.SelectEqual,
+		foundVindex: multiCol,
+		cost:        costFor(multiCol, engine.SelectEqual),
+		ready:       true,
+	})
+	return true
+}
+
 // pickBestAvailableVindex goes over the available vindexes for this route and picks the best one available.
 func (rp *routeTree) pickBestAvailableVindex() {
 	for _, v := range rp.vindexPreds {
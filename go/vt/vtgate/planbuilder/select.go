@@ -36,6 +36,12 @@ import (
 func buildSelectPlan(query string) func(sqlparser.Statement, *sqlparser.ReservedVars, ContextVSchema) (engine.Primitive, error) {
 	return func(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
 		sel := stmt.(*sqlparser.Select)
+		if hasCTE, _ := containsCTE(sel); hasCTE {
+			return nil, errCTEInV3
+		}
+		if containsWindowFunction(sel.SelectExprs) {
+			return nil, errWindowInV3
+		}
 
 		p, err := handleDualSelects(sel, vschema)
 		if err != nil {
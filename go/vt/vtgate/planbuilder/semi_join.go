@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+var _ logicalPlan = (*semiJoin)(nil)
+
+// semiJoin is the logicalPlan for engine.SemiJoin.
+// It is used by the Gen4 planner for correlated subqueries
+// that cannot be merged with the outer query.
+type semiJoin struct {
+	// Left is the outer query, Right the subquery.
+	Left, Right logicalPlan
+	Opcode      engine.SemiJoinOpcode
+	Cols        []int
+	Vars        map[string]int
+	ListVars    map[string]int
+}
+
+// Order implements the logicalPlan interface
+func (sj *semiJoin) Order() int {
+	panic("implement me")
+}
+
+// ResultColumns implements the logicalPlan interface
+func (sj *semiJoin) ResultColumns() []*resultColumn {
+	panic("implement me")
+}
+
+// Reorder implements the logicalPlan interface
+func (sj *semiJoin) Reorder(i int) {
+	panic("implement me")
+}
+
+// Wireup implements the logicalPlan interface
+func (sj *semiJoin) Wireup(lp logicalPlan, jt *jointab) error {
+	panic("implement me")
+}
+
+// WireupGen4 implements the logicalPlan interface
+func (sj *semiJoin) WireupGen4(semTable *semantics.SemTable) error {
+	err := sj.Left.WireupGen4(semTable)
+	if err != nil {
+		return err
+	}
+	return sj.Right.WireupGen4(semTable)
+}
+
+// SupplyVar implements the logicalPlan interface
+func (sj *semiJoin) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	panic("implement me")
+}
+
+// SupplyCol implements the logicalPlan interface
+func (sj *semiJoin) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	panic("implement me")
+}
+
+// SupplyWeightString implements the logicalPlan interface
+func (sj *semiJoin) SupplyWeightString(colNumber int, alsoAddToGroupBy bool) (weightcolNumber int, err error) {
+	panic("implement me")
+}
+
+// Primitive implements the logicalPlan interface
+func (sj *semiJoin) Primitive() engine.Primitive {
+	return &engine.SemiJoin{
+		Opcode:   sj.Opcode,
+		Left:     sj.Left.Primitive(),
+		Right:    sj.Right.Primitive(),
+		Cols:     sj.Cols,
+		Vars:     sj.Vars,
+		ListVars: sj.ListVars,
+	}
+}
+
+// Inputs implements the logicalPlan interface
+func (sj *semiJoin) Inputs() []logicalPlan {
+	return []logicalPlan{sj.Left, sj.Right}
+}
+
+// Rewrite implements the logicalPlan interface
+func (sj *semiJoin) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 2 {
+		return vterrors.New(vtrpcpb.Code_INTERNAL, "wrong number of children")
+	}
+	sj.Left = inputs[0]
+	sj.Right = inputs[1]
+	return nil
+}
+
+// ContainsTables implements the logicalPlan interface
+func (sj *semiJoin) ContainsTables() semantics.TableSet {
+	return sj.Left.ContainsTables().Merge(sj.Right.ContainsTables())
+}
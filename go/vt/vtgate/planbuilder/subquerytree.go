@@ -51,3 +51,65 @@ func (s *subqueryTree) clone() queryTree {
 func (s *subqueryTree) pushOutputColumns([]*sqlparser.ColName, *semantics.SemTable) ([]int, error) {
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] should not try to push output columns on subquery")
 }
+
+// semiJoinTree is used for correlated subqueries that could not be merged
+// with the outer query. The subquery is executed for every row of the outer
+// query, using the outer columns it needs as bind variables.
+type semiJoinTree struct {
+	// columns needed to feed other plans
+	columns []int
+
+	// arguments that need to be copied from the outer side
+	vars map[string]int
+
+	outer, inner queryTree
+
+	// anti is set for NOT EXISTS: the outer rows are kept when the subquery returns nothing
+	anti bool
+
+	// batched is set when vars are list bind variables and the subquery
+	// returns the values that matched them, see batchSemiJoinPredicates
+	batched bool
+
+	extracted *sqlparser.ExtractedSubquery
+
+	// predicates are the predicates of the subquery that depend on the outer query
+	predicates []sqlparser.Expr
+}
+
+var _ queryTree = (*semiJoinTree)(nil)
+
+func (s *semiJoinTree) tableID() semantics.TableSet {
+	return s.inner.tableID().Merge(s.outer.tableID())
+}
+
+func (s *semiJoinTree) cost() int {
+	return s.inner.cost() + s.outer.cost()
+}
+
+func (s *semiJoinTree) clone() queryTree {
+	result := &semiJoinTree{
+		columns:    append([]int(nil), s.columns...),
+		vars:       s.vars,
+		outer:      s.outer.clone(),
+		inner:      s.inner.clone(),
+		anti:       s.anti,
+		batched:    s.batched,
+		extracted:  s.extracted,
+		predicates: s.predicates,
+	}
+	return result
+}
+
+func (s *semiJoinTree) pushOutputColumns(columns []*sqlparser.ColName, semTable *semantics.SemTable) ([]int, error) {
+	offsets, err := s.outer.pushOutputColumns(columns, semTable)
+	if err != nil {
+		return nil, err
+	}
+	outputColumns := make([]int, len(offsets))
+	for i, offset := range offsets {
+		outputColumns[i] = len(s.columns)
+		s.columns = append(s.columns, offset)
+	}
+	return outputColumns, nil
+}
@@ -22,6 +22,8 @@ import (
 	"strconv"
 	"strings"
 
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 
@@ -97,9 +99,10 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTable *vinde
 
 	for _, col := range vschemaTable.Columns {
 		if _, err := t.mergeColumn(col.Name, &column{
-			origin: rb,
-			st:     st,
-			typ:    col.Type,
+			origin:    rb,
+			st:        st,
+			typ:       col.Type,
+			collation: collations.LookupID(col.CollationName),
 		}); err != nil {
 			return err
 		}
@@ -523,9 +526,19 @@ type column struct {
 	st        *symtab
 	vindex    vindexes.SingleColumn
 	typ       querypb.Type
+	collation collations.ID
 	colNumber int
 }
 
+// compareCollation returns the collation that vtgate can use to compare the values
+// of a text column, or collations.Unknown if it must compare their weight strings.
+func (c *column) compareCollation() collations.ID {
+	if sqltypes.IsText(c.typ) && c.collation.IsExact() {
+		return c.collation
+	}
+	return collations.Unknown
+}
+
 // Origin returns the route that originates the column.
 func (c *column) Origin() logicalPlan {
 	// If it's a route, we have to resolve it.
@@ -32,6 +32,9 @@ import (
 func buildUnionPlan(string) func(sqlparser.Statement, *sqlparser.ReservedVars, ContextVSchema) (engine.Primitive, error) {
 	return func(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
 		union := stmt.(*sqlparser.Union)
+		if hasCTE, _ := containsCTE(union); hasCTE {
+			return nil, errCTEInV3
+		}
 		// For unions, create a pb with anonymous scope.
 		pb := newPrimitiveBuilder(vschema, newJointab(reservedVars))
 		if err := pb.processUnion(union, reservedVars, nil); err != nil {
@@ -73,7 +76,7 @@ func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, reservedVars *s
 		}
 
 		if union.Distinct {
-			pb.plan = newDistinct(pb.plan)
+			pb.plan = newDistinct(pb.plan, nil)
 		}
 	}
 	pb.st.Outer = outer
@@ -29,7 +29,24 @@ import (
 // buildUpdatePlan builds the instructions for an UPDATE statement.
 func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
 	upd := stmt.(*sqlparser.Update)
-	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "update", stmt, reservedVars, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
+	dmlInput, where, err := buildDMLInput(vschema, reservedVars, upd.TableExprs, upd.Where, upd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if dmlInput != nil {
+		updCopy := *upd
+		updCopy.Where = where
+		upd = &updCopy
+	}
+	eupd, err := buildUpdate(upd, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+	return withDMLInput(dmlInput, eupd), nil
+}
+
+func buildUpdate(upd *sqlparser.Update, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (*engine.Update, error) {
+	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "update", upd, reservedVars, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +58,16 @@ func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 		return eupd, nil
 	}
 
+	if isPrimaryVindexChanging(upd.Exprs, eupd.Table) {
+		rowMove, primaryVindex, err := buildRowMove(upd, eupd.Table)
+		if err != nil {
+			return nil, err
+		}
+		eupd.RowMove = rowMove
+		eupd.KsidVindex = primaryVindex
+		return eupd, nil
+	}
+
 	cvv, ovq, err := buildChangedVindexesValues(upd, eupd.Table, ksidCol)
 	if err != nil {
 		return nil, err
@@ -59,7 +86,7 @@ func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table, ksidCol string) (map[string]*engine.VindexValues, string, error) {
 	changedVindexes := make(map[string]*engine.VindexValues)
 	buf, offset := initialQuery(ksidCol, table)
-	for i, vindex := range table.ColumnVindexes {
+	for _, vindex := range table.ColumnVindexes {
 		vindexValueMap := make(map[string]sqltypes.PlanValue)
 		first := true
 		for _, vcol := range vindex.Columns {
@@ -94,9 +121,6 @@ func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table,
 		if update.Limit != nil && len(update.OrderBy) == 0 {
 			return nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Need to provide order by clause when using limit. Invalid update on vindex: %v", vindex.Name)
 		}
-		if i == 0 {
-			return nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can't update primary vindex columns. Invalid update on vindex: %v", vindex.Name)
-		}
 		if _, ok := vindex.Vindex.(vindexes.Lookup); !ok {
 			return nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update lookup vindexes. Invalid update on vindex: %v", vindex.Name)
 		}
@@ -117,6 +141,59 @@ func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table,
 	return changedVindexes, buf.String(), nil
 }
 
+// isPrimaryVindexChanging returns true if any of the update
+// expressions modify a column of the primary vindex.
+func isPrimaryVindexChanging(setClauses sqlparser.UpdateExprs, table *vindexes.Table) bool {
+	if len(table.ColumnVindexes) == 0 {
+		return false
+	}
+	for _, assignment := range setClauses {
+		for _, vcol := range table.ColumnVindexes[0].Columns {
+			if vcol.Equal(assignment.Name.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// buildRowMove builds the instructions for an update that changes the primary vindex.
+// The updated rows are read by vtgate and moved to their new shard, so the new values
+// of all the updated columns must be known: only values are supported in the set clause.
+func buildRowMove(update *sqlparser.Update, table *vindexes.Table) (*engine.RowMove, vindexes.SingleColumn, error) {
+	primary := table.ColumnVindexes[0]
+	primaryVindex, ok := primary.Vindex.(vindexes.SingleColumn)
+	if !ok {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can't update multi-column primary vindex columns. Invalid update on vindex: %v", primary.Name)
+	}
+	if update.Limit != nil && len(update.OrderBy) == 0 {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Need to provide order by clause when using limit. Invalid update on vindex: %v", primary.Name)
+	}
+
+	values := make(map[string]sqltypes.PlanValue, len(update.Exprs))
+	for _, assignment := range update.Exprs {
+		name := assignment.Name.Name.Lowered()
+		if _, exists := values[name]; exists {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "column has duplicate set values: '%v'", assignment.Name.Name)
+		}
+		pv, err := extractValueFromUpdate(assignment)
+		if err != nil {
+			return nil, nil, err
+		}
+		values[name] = pv
+	}
+
+	selectBuf := sqlparser.NewTrackedBuffer(nil)
+	selectBuf.Myprintf("select * from %v%v%v%v for update", table.Name, update.Where, update.OrderBy, update.Limit)
+	deleteBuf := sqlparser.NewTrackedBuffer(nil)
+	deleteBuf.Myprintf("delete from %v%v%v%v", table.Name, update.Where, update.OrderBy, update.Limit)
+	return &engine.RowMove{
+		SelectQuery: selectBuf.String(),
+		DeleteQuery: deleteBuf.String(),
+		Values:      values,
+	}, primaryVindex, nil
+}
+
 func initialQuery(ksidCol string, table *vindexes.Table) (*sqlparser.TrackedBuffer, int) {
 	buf := sqlparser.NewTrackedBuffer(nil)
 	buf.Myprintf("select %s", ksidCol)
@@ -0,0 +1,450 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+var errWindowInV3 = vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions in V3 planner")
+
+var _ logicalPlan = (*window)(nil)
+
+// window is the logicalPlan for engine.Window.
+// It computes the window functions of a query at the vtgate level,
+// on top of an input that is sorted by the partition and the order of the window.
+type window struct {
+	logicalPlanCommon
+	eWindow *engine.Window
+}
+
+// Primitive implements the logicalPlan interface
+func (w *window) Primitive() engine.Primitive {
+	w.eWindow.Input = w.input.Primitive()
+	return w.eWindow
+}
+
+// Rewrite implements the logicalPlan interface
+func (w *window) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 1 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "window: wrong number of inputs")
+	}
+	w.input = inputs[0]
+	return nil
+}
+
+// Inputs implements the logicalPlan interface
+func (w *window) Inputs() []logicalPlan {
+	return []logicalPlan{w.input}
+}
+
+// containsWindowFunction reports whether the node has a window function in it.
+func containsWindowFunction(node sqlparser.SQLNode) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if _, ok := node.(*sqlparser.WindowFuncExpr); ok {
+			found = true
+			return false, nil
+		}
+		// window functions in subqueries are planned with their own select
+		_, isSubq := node.(*sqlparser.Subquery)
+		return !isSubq, nil
+	}, node)
+	return found
+}
+
+// windowSpecsOf returns the resolved window specification of every window function
+// in the select expressions, with the named windows replaced by their definition.
+func windowSpecsOf(sel *sqlparser.Select) ([]*sqlparser.WindowSpecification, error) {
+	var specs []*sqlparser.WindowSpecification
+	var err error
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, _ error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery:
+			return false, nil
+		case *sqlparser.WindowFuncExpr:
+			var spec *sqlparser.WindowSpecification
+			spec, err = resolveOverClause(node.Over, sel.Windows)
+			if err != nil {
+				return false, err
+			}
+			specs = append(specs, spec)
+		}
+		return true, nil
+	}, sel.SelectExprs)
+	return specs, err
+}
+
+func resolveOverClause(over *sqlparser.OverClause, defs sqlparser.WindowDefinitions) (*sqlparser.WindowSpecification, error) {
+	if over.WindowSpec != nil {
+		return resolveWindowSpec(over.WindowSpec, defs, 0)
+	}
+	return resolveWindowSpec(&sqlparser.WindowSpecification{Name: over.WindowName}, defs, 0)
+}
+
+// resolveWindowSpec merges a window specification with the named window it refers to, if any.
+// As in MySQL, the referencing window can only add an ORDER BY if the named window has none,
+// and a frame clause, but never a PARTITION BY.
+func resolveWindowSpec(spec *sqlparser.WindowSpecification, defs sqlparser.WindowDefinitions, depth int) (*sqlparser.WindowSpecification, error) {
+	if spec.Name.IsEmpty() {
+		return spec, nil
+	}
+	if depth > len(defs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "There is a circularity in the window dependency graph")
+	}
+	var named *sqlparser.WindowSpecification
+	for _, def := range defs {
+		if def.Name.Equal(spec.Name) {
+			named = def.WindowSpec
+			break
+		}
+	}
+	if named == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window name '%s' is not defined", spec.Name.String())
+	}
+	base, err := resolveWindowSpec(named, defs, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(spec.PartitionClause) > 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window '<unnamed window>' cannot inherit '%s' since both contain a PARTITION BY clause", spec.Name.String())
+	}
+	if len(spec.OrderClause) > 0 && len(base.OrderClause) > 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window '<unnamed window>' cannot inherit '%s' since both contain an ORDER BY clause", spec.Name.String())
+	}
+	resolved := &sqlparser.WindowSpecification{
+		PartitionClause: base.PartitionClause,
+		OrderClause:     base.OrderClause,
+		FrameClause:     base.FrameClause,
+	}
+	if len(spec.OrderClause) > 0 {
+		resolved.OrderClause = spec.OrderClause
+	}
+	if spec.FrameClause != nil {
+		resolved.FrameClause = spec.FrameClause
+	}
+	return resolved, nil
+}
+
+// windowsPartitionedByVindex returns true if every window of the query is partitioned
+// by the primary vindex column of one of its tables. All the rows of a partition then
+// live in the same shard, and the window functions can be computed by MySQL.
+func windowsPartitionedByVindex(sel *sqlparser.Select, semTable *semantics.SemTable) (bool, error) {
+	specs, err := windowSpecsOf(sel)
+	if err != nil {
+		return false, err
+	}
+	for _, spec := range specs {
+		if !partitionedByVindex(spec, semTable) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func partitionedByVindex(spec *sqlparser.WindowSpecification, semTable *semantics.SemTable) bool {
+	for _, expr := range spec.PartitionClause {
+		col, isCol := expr.(*sqlparser.ColName)
+		if !isCol {
+			continue
+		}
+		ti, err := semTable.TableInfoForExpr(col)
+		if err != nil {
+			continue
+		}
+		vschemaTable := ti.GetVindexTable()
+		if vschemaTable == nil || len(vschemaTable.ColumnVindexes) == 0 {
+			continue
+		}
+		primary := vschemaTable.ColumnVindexes[0]
+		if len(primary.Columns) == 1 && primary.Columns[0].Equal(col.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// planWindows plans a query with window functions that cannot be sent to a single shard.
+// The rows are fetched from the shards sorted by the partition and the order of the window,
+// and the window functions are computed at the vtgate level by an engine.Window.
+// Its output is then sorted by the ORDER BY of the query, and projected to the select list.
+func (hp *horizonPlanning) planWindows(ctx *planningContext, plan logicalPlan) (logicalPlan, error) {
+	sel := hp.sel
+	if sel.Distinct || sel.GroupBy != nil || sel.Having != nil || sqlparser.ContainsAggregation(sel.SelectExprs) {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions with aggregation or distinct in a cross-shard query")
+	}
+
+	specs, err := windowSpecsOf(sel)
+	if err != nil {
+		return nil, err
+	}
+	spec := specs[0]
+	for _, other := range specs[1:] {
+		if !sqlparser.EqualsRefOfWindowSpecification(spec, other) {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: different windows in a cross-shard query")
+		}
+	}
+	if spec.FrameClause != nil {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window frame clause in a cross-shard query")
+	}
+
+	// the sorting needed by the window is planned below, and HasStar is only
+	// used to check the order by expressions against the select list
+	hp.qp = &abstract.QueryProjection{}
+
+	eWindow := &engine.Window{}
+	// the offsets of the select expressions in the input of the window,
+	// or -1 for the window functions that are appended after the input columns
+	selectCols := make([]int, len(sel.SelectExprs))
+	for i, expr := range sel.SelectExprs {
+		aliasedExpr, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s in a cross-shard query with window functions", sqlparser.String(expr))
+		}
+		if windowFunc, isWindow := aliasedExpr.Expr.(*sqlparser.WindowFuncExpr); isWindow {
+			params, err := planWindowFunction(windowFunc, aliasedExpr, plan, ctx.semTable)
+			if err != nil {
+				return nil, err
+			}
+			selectCols[i] = -1
+			eWindow.Functions = append(eWindow.Functions, params)
+			continue
+		}
+		if containsWindowFunction(aliasedExpr.Expr) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window function inside an expression in a cross-shard query: %s", sqlparser.String(aliasedExpr))
+		}
+		offset, _, err := pushProjection(aliasedExpr, plan, ctx.semTable, true, false)
+		if err != nil {
+			return nil, err
+		}
+		selectCols[i] = offset
+	}
+
+	var orderExprs []abstract.OrderBy
+	for _, expr := range spec.PartitionClause {
+		keyCol, wsCol, _, err := wrapAndPushExpr(expr, expr, plan, ctx.semTable)
+		if err != nil {
+			return nil, err
+		}
+		eWindow.PartitionBy = append(eWindow.PartitionBy, &engine.GroupByParams{KeyCol: keyCol, WeightStringCol: wsCol, Expr: expr, CollationID: collationFor(expr, ctx.semTable)})
+		orderExprs = append(orderExprs, abstract.OrderBy{
+			Inner:         &sqlparser.Order{Expr: expr, Direction: sqlparser.AscOrder},
+			WeightStrExpr: expr,
+		})
+	}
+	for _, order := range spec.OrderClause {
+		col, wsCol, _, err := wrapAndPushExpr(order.Expr, order.Expr, plan, ctx.semTable)
+		if err != nil {
+			return nil, err
+		}
+		eWindow.OrderBy = append(eWindow.OrderBy, engine.OrderByParams{
+			Col:             col,
+			WeightStringCol: wsCol,
+			Desc:            order.Direction == sqlparser.DescOrder,
+			CollationID:     collationFor(order.Expr, ctx.semTable),
+		})
+		orderExprs = append(orderExprs, abstract.OrderBy{Inner: order, WeightStrExpr: order.Expr})
+	}
+
+	// the columns needed by the ORDER BY of the query are pushed now,
+	// since the ordering below can put a memory sort on top of the plan
+	var finalOrder []engine.OrderByParams
+	var finalOrderWindows []int
+	for _, order := range sel.OrderBy {
+		if sqlparser.IsNull(order.Expr) {
+			continue
+		}
+		if idx := windowFuncIndex(order.Expr, sel.SelectExprs); idx != -1 {
+			finalOrder = append(finalOrder, engine.OrderByParams{WeightStringCol: -1, Desc: order.Direction == sqlparser.DescOrder})
+			finalOrderWindows = append(finalOrderWindows, idx)
+			continue
+		}
+		col, wsCol, _, err := wrapAndPushExpr(order.Expr, order.Expr, plan, ctx.semTable)
+		if err != nil {
+			return nil, err
+		}
+		finalOrder = append(finalOrder, engine.OrderByParams{Col: col, WeightStringCol: wsCol, Desc: order.Direction == sqlparser.DescOrder, CollationID: collationFor(order.Expr, ctx.semTable)})
+		finalOrderWindows = append(finalOrderWindows, -1)
+	}
+
+	if len(orderExprs) > 0 {
+		plan, err = hp.planOrderBy(ctx, orderExprs, plan)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	inputCols, err := outputColumnCount(plan)
+	if err != nil {
+		return nil, err
+	}
+	windowIdx := 0
+	for i, col := range selectCols {
+		if col == -1 {
+			selectCols[i] = inputCols + windowIdx
+			windowIdx++
+		}
+	}
+	for i, idx := range finalOrderWindows {
+		if idx != -1 {
+			finalOrder[i].Col = inputCols + idx
+		}
+	}
+
+	plan = &window{
+		logicalPlanCommon: newBuilderCommon(plan),
+		eWindow:           eWindow,
+	}
+
+	if len(finalOrder) > 0 {
+		primitive := &engine.MemorySort{OrderBy: finalOrder}
+		plan = &memorySort{
+			resultsBuilder: resultsBuilder{
+				logicalPlanCommon: newBuilderCommon(plan),
+				weightStrings:     make(map[*resultColumn]int),
+				truncater:         primitive,
+			},
+			eMemorySort: primitive,
+		}
+	}
+
+	return &simpleProjection{
+		logicalPlanCommon: newBuilderCommon(plan),
+		eSimpleProj:       &engine.SimpleProjection{Cols: selectCols},
+	}, nil
+}
+
+// planWindowFunction pushes the column the window function is computed over to the plan,
+// and returns the parameters of the function for the engine.Window primitive.
+func planWindowFunction(windowFunc *sqlparser.WindowFuncExpr, aliasedExpr *sqlparser.AliasedExpr, plan logicalPlan, semTable *semantics.SemTable) (*engine.WindowFunctionParams, error) {
+	name := windowFunc.Name.Lowered()
+	opcode, supported := engine.SupportedWindowFunctions[name]
+	if !supported {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window function %s in a cross-shard query", windowFunc.Name.String())
+	}
+
+	// the number of expressions the function is computed over, followed by
+	// the minimum and maximum number of constant arguments it takes
+	var hasCol bool
+	var minArgs, maxArgs int
+	switch opcode {
+	case engine.WindowNtile:
+		minArgs, maxArgs = 1, 1
+	case engine.WindowLag, engine.WindowLead:
+		hasCol, minArgs, maxArgs = true, 0, 2
+	case engine.WindowNthValue:
+		hasCol, minArgs, maxArgs = true, 1, 1
+	case engine.WindowFirstValue, engine.WindowLastValue, engine.WindowCount, engine.WindowSum, engine.WindowAvg, engine.WindowMin, engine.WindowMax:
+		hasCol = true
+	}
+
+	exprs := windowFunc.Exprs
+	wantCols := 0
+	if hasCol {
+		wantCols = 1
+	}
+	if len(exprs) < wantCols+minArgs || len(exprs) > wantCols+maxArgs {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect parameter count in the call to native function '%s'", windowFunc.Name.String())
+	}
+
+	params := &engine.WindowFunctionParams{
+		Opcode: opcode,
+		Col:    -1,
+		Alias:  sqlparser.String(aliasedExpr.Expr),
+	}
+	if !aliasedExpr.As.IsEmpty() {
+		params.Alias = aliasedExpr.As.String()
+	}
+
+	if hasCol {
+		switch expr := exprs[0].(type) {
+		case *sqlparser.StarExpr:
+			if opcode != engine.WindowCount {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error: '*' is only valid in COUNT(*)")
+			}
+		case *sqlparser.AliasedExpr:
+			offset, _, err := pushProjection(&sqlparser.AliasedExpr{Expr: expr.Expr}, plan, semTable, true, true)
+			if err != nil {
+				return nil, err
+			}
+			params.Col = offset
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s in a cross-shard query", sqlparser.String(windowFunc))
+		}
+		exprs = exprs[1:]
+	}
+
+	for _, expr := range exprs {
+		aliasedArg, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s in a cross-shard query", sqlparser.String(windowFunc))
+		}
+		arg, err := sqlparser.Convert(aliasedArg.Expr)
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: non constant argument %s for window function %s in a cross-shard query", sqlparser.String(aliasedArg.Expr), windowFunc.Name.String())
+		}
+		params.Args = append(params.Args, arg)
+	}
+	return params, nil
+}
+
+// windowFuncIndex returns the index of the window function the ORDER BY expression refers to,
+// either directly or through the alias of a select expression, or -1 if it does not refer to one.
+func windowFuncIndex(expr sqlparser.Expr, selectExprs sqlparser.SelectExprs) int {
+	col, isCol := expr.(*sqlparser.ColName)
+	idx := 0
+	for _, selectExpr := range selectExprs {
+		aliasedExpr, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
+		}
+		windowFunc, isWindow := aliasedExpr.Expr.(*sqlparser.WindowFuncExpr)
+		if !isWindow {
+			continue
+		}
+		if sqlparser.EqualsExpr(expr, windowFunc) {
+			return idx
+		}
+		if isCol && col.Qualifier.IsEmpty() && !aliasedExpr.As.IsEmpty() && col.Name.Equal(aliasedExpr.As) {
+			return idx
+		}
+		idx++
+	}
+	return -1
+}
+
+// outputColumnCount returns the number of columns the plan produces.
+func outputColumnCount(plan logicalPlan) (int, error) {
+	switch node := plan.(type) {
+	case *route:
+		return node.Select.GetColumnCount(), nil
+	case *joinGen4:
+		return len(node.Cols), nil
+	case *semiJoin:
+		return len(node.Cols), nil
+	case *pulloutSubquery:
+		return outputColumnCount(node.underlying)
+	case *memorySort:
+		return outputColumnCount(node.input)
+	}
+	return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions on top of %T", plan)
+}
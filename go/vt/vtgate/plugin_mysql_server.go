@@ -86,20 +86,38 @@ type vtgateHandler struct {
 	mu sync.Mutex
 
 	vtg         *VTGate
-	connections map[*mysql.Conn]bool
+	connections map[*mysql.Conn]*mysqlConnection
+}
+
+// mysqlConnection is the state of a MySQL connection of vtgate
+// shown by SHOW PROCESSLIST, and the query KILL cancels.
+type mysqlConnection struct {
+	mu sync.Mutex
+	// user, caller and host are set by the first query, once the
+	// connection is authenticated.
+	user   string
+	caller string
+	host   string
+	target string
+	// query is the query being executed, empty if the connection is idle.
+	query string
+	// since is when the query started, or when the connection became idle.
+	since time.Time
+	// cancel cancels the context of query.
+	cancel context.CancelFunc
 }
 
 func newVtgateHandler(vtg *VTGate) *vtgateHandler {
 	return &vtgateHandler{
 		vtg:         vtg,
-		connections: make(map[*mysql.Conn]bool),
+		connections: make(map[*mysql.Conn]*mysqlConnection),
 	}
 }
 
 func (vh *vtgateHandler) NewConnection(c *mysql.Conn) {
 	vh.mu.Lock()
 	defer vh.mu.Unlock()
-	vh.connections[c] = true
+	vh.connections[c] = &mysqlConnection{since: time.Now()}
 }
 
 func (vh *vtgateHandler) numConnections() int {
@@ -108,6 +126,95 @@ func (vh *vtgateHandler) numConnections() int {
 	return len(vh.connections)
 }
 
+// startQuery records the query the connection executes, and returns the
+// context to execute it with, which KILL cancels. The returned function
+// must be called once the query is done.
+func (vh *vtgateHandler) startQuery(ctx context.Context, c *mysql.Conn, query string, session *vtgatepb.Session) (context.Context, func()) {
+	vh.mu.Lock()
+	mc := vh.connections[c]
+	vh.mu.Unlock()
+	if mc == nil {
+		return ctx, func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	mc.mu.Lock()
+	if mc.user == "" {
+		mc.user, mc.caller, mc.host = c.User, c.User, c.RemoteAddr().String()
+		if c.UserData != nil {
+			if im := c.UserData.Get(); im != nil {
+				mc.caller = im.Username
+			}
+		}
+	}
+	mc.target = session.TargetString
+	mc.query = query
+	mc.since = time.Now()
+	mc.cancel = cancel
+	mc.mu.Unlock()
+
+	return ctx, func() {
+		mc.mu.Lock()
+		mc.target = session.TargetString
+		mc.query = ""
+		mc.since = time.Now()
+		mc.cancel = nil
+		mc.mu.Unlock()
+		cancel()
+	}
+}
+
+// processes implements processList.
+func (vh *vtgateHandler) processes() []*processInfo {
+	vh.mu.Lock()
+	defer vh.mu.Unlock()
+	now := time.Now()
+	processes := make([]*processInfo, 0, len(vh.connections))
+	for c, mc := range vh.connections {
+		mc.mu.Lock()
+		process := &processInfo{
+			ID:     c.ConnectionID,
+			User:   mc.user,
+			Caller: mc.caller,
+			Host:   mc.host,
+			DB:     mc.target,
+			Query:  mc.query,
+			Time:   now.Sub(mc.since),
+		}
+		mc.mu.Unlock()
+		if process.User == "" {
+			process.User = "unauthenticated user"
+		}
+		processes = append(processes, process)
+	}
+	return processes
+}
+
+// kill implements processList. Canceling the context of the query aborts
+// the shard queries it executes: the tablets kill the MySQL queries of the
+// requests whose context is canceled. Closing the connection makes the
+// listener call ConnectionClosed, which rolls back the session and releases
+// its reserved connections on the tablets.
+func (vh *vtgateHandler) kill(id uint32, connection bool) error {
+	vh.mu.Lock()
+	defer vh.mu.Unlock()
+	for c, mc := range vh.connections {
+		if c.ConnectionID != id {
+			continue
+		}
+		mc.mu.Lock()
+		if mc.cancel != nil {
+			mc.cancel()
+		}
+		mc.mu.Unlock()
+		if connection {
+			c.Close()
+		}
+		return nil
+	}
+	return errNoSuchThread(uint64(id))
+}
+
 func (vh *vtgateHandler) ComResetConnection(c *mysql.Conn) {
 	ctx := context.Background()
 	session := vh.session(c)
@@ -215,6 +322,9 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 		}
 	}()
 
+	ctx, done := vh.startQuery(ctx, c, query, session)
+	defer done()
+
 	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
 		err := vh.vtg.StreamExecute(ctx, session, query, make(map[string]*querypb.BindVariable), callback)
 		return mysql.NewSQLErrorFromError(err)
@@ -276,6 +386,9 @@ func (vh *vtgateHandler) ComPrepare(c *mysql.Conn, query string, bindVars map[st
 		}
 	}()
 
+	ctx, done := vh.startQuery(ctx, c, query, session)
+	defer done()
+
 	session, fld, err := vh.vtg.Prepare(ctx, session, query, bindVars)
 	err = mysql.NewSQLErrorFromError(err)
 	if err != nil {
@@ -318,11 +431,21 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 		}
 	}()
 
+	ctx, done := vh.startQuery(ctx, c, prepare.PrepareStmt, session)
+	defer done()
+
+	// The plan of the statement is kept with it, for its next executions.
+	prepared, ok := prepare.HandlerData.(*preparedPlan)
+	if !ok {
+		prepared = &preparedPlan{}
+		prepare.HandlerData = prepared
+	}
+
 	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
-		err := vh.vtg.StreamExecute(ctx, session, prepare.PrepareStmt, prepare.BindVars, callback)
+		err := vh.vtg.streamExecute(ctx, session, prepare.PrepareStmt, prepare.BindVars, prepared, callback)
 		return mysql.NewSQLErrorFromError(err)
 	}
-	_, qr, err := vh.vtg.Execute(ctx, session, prepare.PrepareStmt, prepare.BindVars)
+	_, qr, err := vh.vtg.execute(ctx, session, prepare.PrepareStmt, prepare.BindVars, prepared)
 	if err != nil {
 		err = mysql.NewSQLErrorFromError(err)
 		return err
@@ -423,6 +546,7 @@ func initMySQLProtocol() {
 	// Create a Listener.
 	var err error
 	vtgateHandle = newVtgateHandler(rpcVTGate)
+	rpcVTGate.executor.setProcessList(vtgateHandle)
 	if *mysqlServerPort >= 0 {
 		mysqlListener, err = mysql.NewListener(*mysqlTCPVersion, net.JoinHostPort(*mysqlServerBindAddress, fmt.Sprintf("%v", *mysqlServerPort)), authServer, vtgateHandle, *mysqlConnReadTimeout, *mysqlConnWriteTimeout, *mysqlProxyProtocol)
 		if err != nil {
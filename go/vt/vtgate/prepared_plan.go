@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	preparedPlanHits   = stats.NewCounter("PreparedPlanCacheHits", "Number of executions of prepared statements that reused the plan of the statement")
+	preparedPlanMisses = stats.NewCounter("PreparedPlanCacheMisses", "Number of executions of prepared statements that had to plan the statement")
+)
+
+// preparedPlan is the plan of a statement prepared through the mysql
+// protocol. It is kept with the statement, so that its executions only
+// need to bind their parameters instead of parsing, normalizing and
+// looking up the statement in the plan cache.
+type preparedPlan struct {
+	plan *engine.Plan
+	// query is the normalized query, for the logs.
+	query string
+	// bindVars are the bind variables created by the normalization
+	// of the literals of the statement.
+	bindVars            map[string]*querypb.BindVariable
+	ignoreMaxMemoryRows bool
+
+	// The plan is only valid for the vschema, the planning key, the planner
+	// and the select limit of the session it was built with.
+	vschema       *vindexes.VSchema
+	planPrefixKey string
+	planner       planbuilder.PlannerVersion
+	selectLimit   int
+}
+
+// get returns the plan if it is still valid for the vcursor.
+func (pp *preparedPlan) get(vcursor *vcursorImpl, qo iQueryOption) (*engine.Plan, bool) {
+	if pp == nil {
+		return nil, false
+	}
+	if pp.plan == nil ||
+		pp.vschema != vcursor.vschema ||
+		pp.planPrefixKey != vcursor.planPrefixKey() ||
+		pp.planner != vcursor.Planner() ||
+		pp.selectLimit != qo.getSelectLimit() {
+		preparedPlanMisses.Add(1)
+		return nil, false
+	}
+	preparedPlanHits.Add(1)
+	return pp.plan, true
+}
+
+// save keeps the plan built by the vcursor for the next executions.
+func (pp *preparedPlan) save(plan *engine.Plan, query string, bindVars map[string]*querypb.BindVariable, vcursor *vcursorImpl, qo iQueryOption) {
+	if pp == nil {
+		return
+	}
+	pp.plan = plan
+	pp.query = query
+	pp.bindVars = bindVars
+	pp.ignoreMaxMemoryRows = vcursor.ignoreMaxMemoryRows
+	pp.vschema = vcursor.vschema
+	pp.planPrefixKey = vcursor.planPrefixKey()
+	pp.planner = vcursor.Planner()
+	pp.selectLimit = qo.getSelectLimit()
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var processListAdminUsers = flag.String("processlist_admin_users", "", "List of users who can see and kill the connections of all users with SHOW PROCESSLIST and KILL, or '%' to allow all users. The other users can only see and kill their own connections.")
+
+// processListAdmin returns true if the user can see and kill the connections
+// of all users, like a MySQL user with the PROCESS and CONNECTION_ADMIN privileges.
+func processListAdmin(user string) bool {
+	return listedUser(*processListAdminUsers, user)
+}
+
+// listedUser returns true if the user is in the comma-separated list of
+// users, or if the list is '%'.
+func listedUser(users, user string) bool {
+	if users == "%" {
+		return true
+	}
+	for _, listed := range strings.Split(users, ",") {
+		if strings.TrimSpace(listed) == user {
+			return user != ""
+		}
+	}
+	return false
+}
+
+// processList gives the executor access to the MySQL connections of vtgate,
+// for SHOW PROCESSLIST and KILL.
+type processList interface {
+	// processes returns the connections, with the query they execute.
+	processes() []*processInfo
+	// kill cancels the context of the query the connection executes,
+	// which aborts its shard queries. If connection is set, it also closes
+	// the connection, which rolls back its session.
+	kill(id uint32, connection bool) error
+}
+
+// processInfo is a row of SHOW PROCESSLIST.
+type processInfo struct {
+	ID   uint32
+	User string
+	// Caller is the username of the immediate caller ID of the connection,
+	// which owns it.
+	Caller string
+	Host   string
+	// DB is the target of the session of the connection.
+	DB string
+	// Query is the query the connection executes, empty if it is idle.
+	Query string
+	// Time is how long the connection has been executing its query, or idle.
+	Time time.Duration
+}
+
+// setProcessList sets the MySQL connections of vtgate.
+func (e *Executor) setProcessList(pl processList) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.processList = pl
+}
+
+func (e *Executor) getProcessList() processList {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.processList
+}
+
+func (e *Executor) handleKill(ctx context.Context, sql string, logStats *LogStats) (*sqltypes.Result, error) {
+	execStart := time.Now()
+	defer func() { logStats.ExecuteTime = time.Since(execStart) }()
+
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	kill, ok := stmt.(*sqlparser.Kill)
+	if !ok {
+		// This code is unreachable.
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unrecognized KILL statement: %v", sql)
+	}
+	pl := e.getProcessList()
+	if pl == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "KILL is only supported on the MySQL protocol")
+	}
+	if kill.ProcesslistID > math.MaxUint32 {
+		return nil, errNoSuchThread(kill.ProcesslistID)
+	}
+	id := uint32(kill.ProcesslistID)
+	// Like in MySQL, only the admins can kill the connections of other users.
+	if caller := callerUsername(ctx); !processListAdmin(caller) {
+		owned := false
+		for _, process := range pl.processes() {
+			if process.ID == id {
+				if process.Caller != caller {
+					return nil, vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.KillDeniedError, "You are not owner of thread %d", id)
+				}
+				owned = true
+			}
+		}
+		if !owned {
+			return nil, errNoSuchThread(kill.ProcesslistID)
+		}
+	}
+	if err := pl.kill(id, kill.Type == sqlparser.ConnectionType); err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// showProcessList returns the MySQL connections of vtgate, ordered by id.
+// Like in MySQL, only the admins see the connections of other users.
+func (e *Executor) showProcessList(ctx context.Context) (*sqltypes.Result, bool) {
+	pl := e.getProcessList()
+	if pl == nil {
+		return nil, false
+	}
+	processes := pl.processes()
+	if caller := callerUsername(ctx); !processListAdmin(caller) {
+		own := processes[:0]
+		for _, process := range processes {
+			if process.Caller == caller {
+				own = append(own, process)
+			}
+		}
+		processes = own
+	}
+	sort.Slice(processes, func(i, j int) bool {
+		return processes[i].ID < processes[j].ID
+	})
+
+	result := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "Id", Type: sqltypes.Uint64, Charset: mysql.CharacterSetBinary, Flags: uint32(querypb.MySqlFlag_NOT_NULL_FLAG | querypb.MySqlFlag_UNSIGNED_FLAG)},
+			{Name: "User", Type: sqltypes.VarChar, Charset: mysql.CharacterSetUtf8, Flags: uint32(querypb.MySqlFlag_NOT_NULL_FLAG)},
+			{Name: "Host", Type: sqltypes.VarChar, Charset: mysql.CharacterSetUtf8, Flags: uint32(querypb.MySqlFlag_NOT_NULL_FLAG)},
+			{Name: "db", Type: sqltypes.VarChar, Charset: mysql.CharacterSetUtf8},
+			{Name: "Command", Type: sqltypes.VarChar, Charset: mysql.CharacterSetUtf8, Flags: uint32(querypb.MySqlFlag_NOT_NULL_FLAG)},
+			{Name: "Time", Type: sqltypes.Int64, Charset: mysql.CharacterSetBinary, Flags: uint32(querypb.MySqlFlag_NOT_NULL_FLAG)},
+			{Name: "State", Type: sqltypes.VarChar, Charset: mysql.CharacterSetUtf8},
+			{Name: "Info", Type: sqltypes.VarChar, Charset: mysql.CharacterSetUtf8},
+		},
+	}
+	for _, process := range processes {
+		command, info := "Sleep", sqltypes.NULL
+		if process.Query != "" {
+			command, info = "Query", sqltypes.NewVarChar(process.Query)
+		}
+		db := sqltypes.NULL
+		if process.DB != "" {
+			db = sqltypes.NewVarChar(process.DB)
+		}
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.NewUint64(uint64(process.ID)),
+			sqltypes.NewVarChar(process.User),
+			sqltypes.NewVarChar(process.Host),
+			db,
+			sqltypes.NewVarChar(command),
+			sqltypes.NewInt64(int64(process.Time / time.Second)),
+			sqltypes.NULL,
+			info,
+		})
+	}
+	return result, true
+}
+
+func callerUsername(ctx context.Context) string {
+	return callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
+}
+
+func errNoSuchThread(id uint64) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.NoSuchThread, "Unknown thread id: %d", id)
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// setProcessListAdmins sets the -processlist_admin_users flag for the test.
+func setProcessListAdmins(t *testing.T, users string) {
+	old := *processListAdminUsers
+	*processListAdminUsers = users
+	t.Cleanup(func() { *processListAdminUsers = old })
+}
+
+func callerContext(user string) context.Context {
+	return callerid.NewContext(context.Background(), &vtrpcpb.CallerID{}, &querypb.VTGateCallerID{Username: user})
+}
+
+type fakeProcessList struct {
+	list   []*processInfo
+	killed []string
+}
+
+func (pl *fakeProcessList) processes() []*processInfo {
+	return pl.list
+}
+
+func (pl *fakeProcessList) kill(id uint32, connection bool) error {
+	for _, process := range pl.list {
+		if process.ID == id {
+			pl.killed = append(pl.killed, fmt.Sprintf("%d %v", id, connection))
+			return nil
+		}
+	}
+	return errNoSuchThread(uint64(id))
+}
+
+func TestExecutorKill(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	setProcessListAdmins(t, "%")
+
+	_, err := executorExec(executor, "kill 5", nil)
+	require.EqualError(t, err, "KILL is only supported on the MySQL protocol")
+
+	pl := &fakeProcessList{list: []*processInfo{{ID: 5}, {ID: 7}}}
+	executor.setProcessList(pl)
+
+	_, err = executorExec(executor, "kill 5", nil)
+	require.NoError(t, err)
+	_, err = executorExec(executor, "kill query 7", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"5 true", "7 false"}, pl.killed)
+
+	_, err = executorExec(executor, "kill connection 8", nil)
+	require.EqualError(t, err, "Unknown thread id: 8")
+	_, err = executorExec(executor, "kill 4294967296", nil)
+	require.EqualError(t, err, "Unknown thread id: 4294967296")
+	sqlErr, ok := mysql.NewSQLErrorFromError(err).(*mysql.SQLError)
+	require.True(t, ok)
+	assert.Equal(t, mysql.ERNoSuchThread, sqlErr.Number())
+}
+
+func TestExecutorShowProcessList(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	setProcessListAdmins(t, "%")
+	executor.setProcessList(&fakeProcessList{list: []*processInfo{{
+		ID:    7,
+		User:  "user1",
+		Host:  "localhost:1234",
+		DB:    "TestExecutor",
+		Query: "select sleep(10)",
+		Time:  3 * time.Second,
+	}, {
+		ID:   2,
+		User: "user2",
+		Host: "localhost:5678",
+		Time: time.Second,
+	}}})
+
+	qr, err := executorExec(executor, "show processlist", nil)
+	require.NoError(t, err)
+	require.Len(t, qr.Fields, 8)
+	assert.Equal(t, `[[UINT64(2) VARCHAR("user2") VARCHAR("localhost:5678") NULL VARCHAR("Sleep") INT64(1) NULL NULL] `+
+		`[UINT64(7) VARCHAR("user1") VARCHAR("localhost:1234") VARCHAR("TestExecutor") VARCHAR("Query") INT64(3) NULL VARCHAR("select sleep(10)")]]`,
+		fmt.Sprintf("%v", qr.Rows))
+}
+
+func TestExecutorProcessListPrivileges(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	setProcessListAdmins(t, "admin")
+	pl := &fakeProcessList{list: []*processInfo{
+		{ID: 1, User: "user1", Caller: "user1"},
+		{ID: 2, User: "user2", Caller: "user2"},
+	}}
+	executor.setProcessList(pl)
+
+	exec := func(user, sql string) (*sqltypes.Result, error) {
+		return executor.Execute(callerContext(user), "TestExecutorProcessListPrivileges", NewSafeSession(primarySession), sql, nil)
+	}
+
+	// A user only sees and kills their own connections.
+	qr, err := exec("user1", "show processlist")
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 1)
+	assert.Equal(t, "user1", qr.Rows[0][1].ToString())
+	_, err = exec("user1", "kill 2")
+	require.EqualError(t, err, "You are not owner of thread 2")
+	sqlErr, ok := mysql.NewSQLErrorFromError(err).(*mysql.SQLError)
+	require.True(t, ok)
+	assert.Equal(t, mysql.ERKillDenied, sqlErr.Number())
+	_, err = exec("user1", "kill 3")
+	require.EqualError(t, err, "Unknown thread id: 3")
+	_, err = exec("user1", "kill query 1")
+	require.NoError(t, err)
+
+	// An admin sees and kills the connections of all users.
+	qr, err = exec("admin", "show processlist")
+	require.NoError(t, err)
+	assert.Len(t, qr.Rows, 2)
+	_, err = exec("admin", "kill 2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1 false", "2 true"}, pl.killed)
+}
+
+func TestVtgateHandlerKill(t *testing.T) {
+	vh := newVtgateHandler(nil)
+	c := &mysql.Conn{ConnectionID: 3}
+	vh.NewConnection(c)
+	// The connection has no socket to read the host from.
+	vh.connections[c].user, vh.connections[c].host = "user1", "localhost:1234"
+
+	ctx, done := vh.startQuery(context.Background(), c, "select sleep(10)", &vtgatepb.Session{TargetString: "ks"})
+	processes := vh.processes()
+	require.Len(t, processes, 1)
+	assert.Equal(t, uint32(3), processes[0].ID)
+	assert.Equal(t, "user1", processes[0].User)
+	assert.Equal(t, "ks", processes[0].DB)
+	assert.Equal(t, "select sleep(10)", processes[0].Query)
+
+	require.EqualError(t, vh.kill(4, false), "Unknown thread id: 4")
+	require.NoError(t, ctx.Err())
+	require.NoError(t, vh.kill(3, false))
+	assert.Equal(t, context.Canceled, ctx.Err())
+
+	done()
+	assert.Empty(t, vh.processes()[0].Query)
+	// Killing an idle connection is a no-op.
+	require.NoError(t, vh.kill(3, false))
+}
+
+func TestVtgateHandlerKillAbortsShardQuery(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	sbclookup.ExecuteStarted = make(chan struct{})
+	vh := newVtgateHandler(nil)
+	c := &mysql.Conn{ConnectionID: 3}
+	vh.NewConnection(c)
+	vh.connections[c].user, vh.connections[c].host = "user1", "localhost:1234"
+
+	sql := "select id from music_user_map where id = 1"
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+	ctx, done := vh.startQuery(context.Background(), c, sql, session)
+	defer done()
+	errCh := make(chan error)
+	go func() {
+		_, err := executor.Execute(ctx, "TestVtgateHandlerKillAbortsShardQuery", NewSafeSession(session), sql, nil)
+		errCh <- err
+	}()
+
+	// KILL QUERY cancels the context of the shard query, which is still running.
+	<-sbclookup.ExecuteStarted
+	require.NoError(t, vh.kill(3, false))
+	err := <-errCh
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "context canceled")
+}
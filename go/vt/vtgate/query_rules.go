@@ -0,0 +1,395 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callinfo"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	queryRuleHits = stats.NewCountersWithMultiLabels("VtgateQueryRuleHits", "Queries the query rules of vtgate fired on", []string{"Rule", "Action"})
+
+	// sleepDuringQueryRulesFailure is how long to sleep before watching
+	// the query rules again after an error.
+	sleepDuringQueryRulesFailure = 30 * time.Second
+)
+
+// singleShardVariants are the opcodes of the primitives that send their
+// query to a single shard. The fan-out of the other primitives of sharded
+// keyspaces is estimated as all the shards of their keyspace.
+var singleShardVariants = map[string]bool{
+	engine.SelectUnsharded.String():   true,
+	engine.SelectEqualUnique.String(): true,
+	engine.SelectNext.String():        true,
+	engine.SelectDBA.String():         true,
+	engine.SelectReference.String():   true,
+	engine.Unsharded.String():         true,
+	engine.Equal.String():             true,
+	engine.ByDestination.String():     true,
+}
+
+// queryRule is a query rule of vtgate. It is a query rule of vttablet,
+// with conditions on the plan of the query in vtgate. The query
+// condition of the rule matches the normalized query.
+type queryRule struct {
+	*rules.Rule
+
+	// Any matched opcode will make this condition true (OR).
+	opcodes []string
+
+	// Any matched keyspace will make this condition true (OR).
+	keyspaces []string
+
+	// minShards is the estimated number of shards the
+	// query must be sent to for the rule to fire.
+	minShards int
+}
+
+// queryRules are the query rules of vtgate. Only the action of
+// the first rule that fires on a query is performed.
+type queryRules struct {
+	rules []*queryRule
+}
+
+// queryRuleFacts are what the query rules of vtgate match on.
+type queryRuleFacts struct {
+	ip, user       string
+	marginComments sqlparser.MarginComments
+	// query is the normalized query, and bindVars its bind variables,
+	// including the normalized values.
+	query     string
+	bindVars  map[string]*querypb.BindVariable
+	opcodes   []string
+	keyspaces []string
+	// shards returns the estimated number of shards the query is sent to.
+	shards func() int
+}
+
+// parseQueryRules parses query rules in the JSON format of the query rules
+// of vttablet. The conditions on the plan of vttablet are replaced with
+// Opcodes, Keyspaces and MinShards, and the REWRITE action is not supported.
+func parseQueryRules(data []byte) (*queryRules, error) {
+	var rulesInfo []map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&rulesInfo); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+	}
+	qrs := &queryRules{}
+	for _, ruleInfo := range rulesInfo {
+		qr, err := buildQueryRule(ruleInfo)
+		if err != nil {
+			return nil, err
+		}
+		qrs.rules = append(qrs.rules, qr)
+	}
+	return qrs, nil
+}
+
+func buildQueryRule(ruleInfo map[string]interface{}) (*queryRule, error) {
+	qr := &queryRule{}
+	for k, v := range ruleInfo {
+		switch k {
+		case "Plans", "TableNames":
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s is not supported by the query rules of vtgate", k)
+		case "Opcodes", "Keyspaces":
+			lv, ok := v.([]interface{})
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want list for %s", k)
+			}
+			for _, elem := range lv {
+				sv, ok := elem.(string)
+				if !ok {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
+				}
+				if k == "Opcodes" {
+					qr.opcodes = append(qr.opcodes, sv)
+				} else {
+					qr.keyspaces = append(qr.keyspaces, sv)
+				}
+			}
+		case "MinShards":
+			nv, ok := v.(json.Number)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for MinShards")
+			}
+			n, err := nv.Int64()
+			if err != nil || n < 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want non-negative integer for MinShards: %s", nv)
+			}
+			qr.minShards = int(n)
+		default:
+			continue
+		}
+		delete(ruleInfo, k)
+	}
+
+	var err error
+	qr.Rule, err = rules.BuildQueryRule(ruleInfo)
+	if err != nil {
+		return nil, err
+	}
+	if qr.Action() == rules.QRRewrite {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Action REWRITE is not supported by the query rules of vtgate")
+	}
+	return qr, nil
+}
+
+// matches returns true if all the conditions of the rule match the query.
+func (qr *queryRule) matches(facts *queryRuleFacts) bool {
+	if !qr.MatchesQuery(facts.query) {
+		return false
+	}
+	if !anyMatch(qr.opcodes, facts.opcodes) || !anyMatch(qr.keyspaces, facts.keyspaces) {
+		return false
+	}
+	if qr.GetAction(facts.ip, facts.user, facts.bindVars, facts.marginComments) == rules.QRContinue {
+		return false
+	}
+	return qr.minShards == 0 || facts.shards() >= qr.minShards
+}
+
+func anyMatch(conds, values []string) bool {
+	if conds == nil {
+		return true
+	}
+	for _, cond := range conds {
+		for _, value := range values {
+			if cond == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getRule returns the first rule that fires on the query, or nil if none does.
+func (qrs *queryRules) getRule(facts *queryRuleFacts) *queryRule {
+	if qrs == nil {
+		return nil
+	}
+	for _, qr := range qrs.rules {
+		if qr.matches(facts) {
+			return qr
+		}
+	}
+	return nil
+}
+
+// setQueryRules sets the query rules of vtgate.
+func (e *Executor) setQueryRules(qrs *queryRules) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.queryRules = qrs
+}
+
+func (e *Executor) getQueryRules() *queryRules {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.queryRules
+}
+
+// enforceQueryRules performs the action of the query rule of vtgate that fires
+// on the plan, if any, before the query is sent to any shard. Like the query
+// rules of vttablet, the rules also fire on the queries of lookup vindexes.
+// The returned function must be called once the query is done.
+func (e *Executor) enforceQueryRules(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, marginComments sqlparser.MarginComments, bindVars map[string]*querypb.BindVariable) (func(), error) {
+	qrs := e.getQueryRules()
+	if qrs == nil || len(qrs.rules) == 0 {
+		return func() {}, nil
+	}
+
+	facts := &queryRuleFacts{
+		marginComments: marginComments,
+		query:          plan.Original,
+		bindVars:       bindVars,
+	}
+	if ci, ok := callinfo.FromContext(ctx); ok {
+		facts.ip = ci.RemoteAddr()
+		facts.user = ci.Username()
+	}
+	singleShardPrimitives := 0
+	var allShardsPrimitives []engine.PrimitiveDescription
+	var visit func(pd engine.PrimitiveDescription)
+	visit = func(pd engine.PrimitiveDescription) {
+		if pd.Keyspace != nil {
+			facts.opcodes = append(facts.opcodes, pd.Variant)
+			facts.keyspaces = append(facts.keyspaces, pd.Keyspace.Name)
+			switch {
+			case pd.Variant == engine.SelectNone.String():
+			case !pd.Keyspace.Sharded || singleShardVariants[pd.Variant]:
+				singleShardPrimitives++
+			default:
+				allShardsPrimitives = append(allShardsPrimitives, pd)
+			}
+		}
+		for _, input := range pd.Inputs {
+			visit(input)
+		}
+	}
+	visit(engine.PrimitiveToPlanDescription(plan.Instructions))
+	facts.shards = func() int {
+		shards := singleShardPrimitives
+		for _, pd := range allShardsPrimitives {
+			rss, _, err := e.resolver.resolver.GetAllShards(ctx, pd.Keyspace.Name, vcursor.TabletType())
+			if err != nil {
+				shards++
+				continue
+			}
+			shards += len(rss)
+		}
+		return shards
+	}
+
+	qr := qrs.getRule(facts)
+	if qr == nil {
+		return func() {}, nil
+	}
+	queryRuleHits.Add([]string{qr.Name, qr.Action().String()}, 1)
+	switch qr.Action() {
+	case rules.QRFail:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", qr.Description)
+	case rules.QRFailRetry:
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", qr.Description)
+	}
+	return qr.Enforce(ctx)
+}
+
+// queryRulesWatcher keeps the query rules of vtgate in sync with a file in topo.
+type queryRulesWatcher struct {
+	executor *Executor
+	conn     topo.Conn
+	filePath string
+
+	// mu protects the following variables.
+	mu sync.Mutex
+	// cancel is the function to call to cancel the current watch, if any.
+	cancel func()
+	// stopped is set when stop() is called.
+	stopped bool
+}
+
+func newQueryRulesWatcher(ctx context.Context, executor *Executor, ts *topo.Server, cell, filePath string) (*queryRulesWatcher, error) {
+	conn, err := ts.ConnForCell(ctx, cell)
+	if err != nil {
+		return nil, err
+	}
+	return &queryRulesWatcher{
+		executor: executor,
+		conn:     conn,
+		filePath: filePath,
+	}, nil
+}
+
+func (qw *queryRulesWatcher) start() {
+	go func() {
+		for {
+			if err := qw.oneWatch(); err != nil {
+				log.Warningf("Background watch of the query rules of vtgate failed: %v", err)
+			}
+
+			qw.mu.Lock()
+			stopped := qw.stopped
+			qw.mu.Unlock()
+			if stopped {
+				return
+			}
+
+			log.Warningf("Sleeping for %v before watching the query rules again", sleepDuringQueryRulesFailure)
+			time.Sleep(sleepDuringQueryRulesFailure)
+		}
+	}()
+}
+
+func (qw *queryRulesWatcher) stop() {
+	qw.mu.Lock()
+	defer qw.mu.Unlock()
+	if qw.cancel != nil {
+		qw.cancel()
+	}
+	qw.stopped = true
+}
+
+func (qw *queryRulesWatcher) apply(wd *topo.WatchData) error {
+	qrs, err := parseQueryRules(wd.Contents)
+	if err != nil {
+		return fmt.Errorf("error parsing query rules: %v, original data '%s' version %v", err, wd.Contents, wd.Version)
+	}
+	qw.executor.setQueryRules(qrs)
+	log.Infof("Query rules version %v fetched from topo and applied to vtgate", wd.Version)
+	return nil
+}
+
+func (qw *queryRulesWatcher) oneWatch() error {
+	defer func() {
+		qw.mu.Lock()
+		qw.cancel = nil
+		qw.mu.Unlock()
+	}()
+
+	current, wdChannel, cancel := qw.conn.Watch(context.Background(), qw.filePath)
+	if current.Err != nil {
+		return current.Err
+	}
+
+	qw.mu.Lock()
+	if qw.stopped {
+		qw.mu.Unlock()
+		cancel()
+		for range wdChannel {
+		}
+		return topo.NewError(topo.Interrupted, "watch")
+	}
+	qw.cancel = cancel
+	qw.mu.Unlock()
+
+	if err := qw.apply(current); err != nil {
+		cancel()
+		for range wdChannel {
+		}
+		return err
+	}
+	for wd := range wdChannel {
+		if wd.Err != nil {
+			return wd.Err
+		}
+		if err := qw.apply(wd); err != nil {
+			cancel()
+			for range wdChannel {
+			}
+			return err
+		}
+	}
+	return fmt.Errorf("watch terminated with no error")
+}
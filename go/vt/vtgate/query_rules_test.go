@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callinfo"
+	"vitess.io/vitess/go/vt/callinfo/fakecallinfo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestParseQueryRules(t *testing.T) {
+	qrs, err := parseQueryRules([]byte(`[{"Name":"r1","Description":"no scatter","Opcodes":["SelectScatter","Scatter"],"Keyspaces":["ks"],"MinShards":4,"User":"u1","Query":"select .*","Action":"THROTTLE","MaxQPS":10}]`))
+	require.NoError(t, err)
+	require.Len(t, qrs.rules, 1)
+	qr := qrs.rules[0]
+	assert.Equal(t, "r1", qr.Name)
+	assert.Equal(t, "no scatter", qr.Description)
+	assert.Equal(t, []string{"SelectScatter", "Scatter"}, qr.opcodes)
+	assert.Equal(t, []string{"ks"}, qr.keyspaces)
+	assert.Equal(t, 4, qr.minShards)
+	assert.Equal(t, rules.QRThrottle, qr.Action())
+	assert.True(t, qr.MatchesQuery("select 1"))
+	assert.False(t, qr.MatchesQuery("update t set a = 1"))
+
+	testcases := []struct {
+		in, err string
+	}{{
+		in:  `{}`,
+		err: "json: cannot unmarshal object into Go value of type []map[string]interface {}",
+	}, {
+		in:  `[{"Opcodes":"Scatter"}]`,
+		err: "want list for Opcodes",
+	}, {
+		in:  `[{"Keyspaces":[1]}]`,
+		err: "want string for Keyspaces",
+	}, {
+		in:  `[{"MinShards":-1}]`,
+		err: "want non-negative integer for MinShards: -1",
+	}, {
+		in:  `[{"Plans":["Select"]}]`,
+		err: "Plans is not supported by the query rules of vtgate",
+	}, {
+		in:  `[{"Action":"REWRITE","Limit":10}]`,
+		err: "Action REWRITE is not supported by the query rules of vtgate",
+	}, {
+		in:  `[{"Action":"THROTTLE"}]`,
+		err: "MaxQPS must be positive for Action THROTTLE",
+	}, {
+		in:  `[{"Unknown":1}]`,
+		err: "unrecognized tag Unknown",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			_, err := parseQueryRules([]byte(tc.in))
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestExecutorQueryRules(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+	qrs, err := parseQueryRules([]byte(`[` +
+		`{"Name":"scatter","Description":"no scatter","Opcodes":["SelectScatter"],"MinShards":2},` +
+		`{"Name":"extra","Description":"no user_extra for u1","Query":"select .* from user_extra .*","User":"u1","Action":"FAIL_RETRY"},` +
+		`{"Name":"unsharded","Description":"throttle unsharded","Keyspaces":["TestUnsharded"],"Action":"THROTTLE","MaxQPS":0.001,"Burst":1}]`))
+	require.NoError(t, err)
+	executor.setQueryRules(qrs)
+	ctx := context.Background()
+	hitsBefore := queryRuleHits.Counts()
+	// primarySession is shared with the other tests, so use a fresh session for each query.
+	exec := func(sql string) error {
+		_, err := executorExecSession(executor, sql, nil, &vtgatepb.Session{TargetString: "@primary"})
+		return err
+	}
+
+	// The scatter query fails before any shard is contacted.
+	err = exec("select id from user")
+	require.EqualError(t, err, "disallowed due to rule: no scatter")
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	assert.EqualValues(t, 0, sbc1.ExecCount.Get()+sbc2.ExecCount.Get())
+	err = exec("select id from user where id = 1")
+	require.NoError(t, err)
+
+	// The rule matches the normalized query and the user.
+	u1Ctx := callinfo.NewContext(ctx, &fakecallinfo.FakeCallInfo{User: "u1"})
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+	_, err = executor.Execute(u1Ctx, "TestExecutorQueryRules", session, "select id from user_extra where user_id = 1", nil)
+	require.EqualError(t, err, "disallowed due to rule: no user_extra for u1")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+	err = exec("select id from user_extra where user_id = 1")
+	require.NoError(t, err)
+
+	err = exec("select id from TestUnsharded.music")
+	require.NoError(t, err)
+	err = exec("select id from TestUnsharded.music")
+	require.EqualError(t, err, "throttled due to rule: throttle unsharded")
+	assert.EqualValues(t, 1, sbclookup.ExecCount.Get())
+
+	hits := queryRuleHits.Counts()
+	assert.EqualValues(t, 1, hits["scatter.FAIL"]-hitsBefore["scatter.FAIL"])
+	assert.EqualValues(t, 1, hits["extra.FAIL_RETRY"]-hitsBefore["extra.FAIL_RETRY"])
+	assert.EqualValues(t, 2, hits["unsharded.THROTTLE"]-hitsBefore["unsharded.THROTTLE"])
+
+	// Only the queries sent to enough shards fire the rule.
+	qrs, err = parseQueryRules([]byte(`[{"Description":"no scatter","Opcodes":["SelectScatter"],"MinShards":9}]`))
+	require.NoError(t, err)
+	executor.setQueryRules(qrs)
+	err = exec("select id from user")
+	require.NoError(t, err)
+
+	// The rule matches the bind variables of the query.
+	qrs, err = parseQueryRules([]byte(`[{"Description":"no id 5","BindVarConds":[{"Name":"id","OnAbsent":false,"OnMismatch":false,"Operator":"==","Value":5}]}]`))
+	require.NoError(t, err)
+	executor.setQueryRules(qrs)
+	_, err = executorExecSession(executor, "select id from user where id = :id", map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(5)}, &vtgatepb.Session{TargetString: "@primary"})
+	require.EqualError(t, err, "disallowed due to rule: no id 5")
+	_, err = executorExecSession(executor, "select id from user where id = :id", map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)}, &vtgatepb.Session{TargetString: "@primary"})
+	require.NoError(t, err)
+}
+
+func TestQueryRulesWatcher(t *testing.T) {
+	ctx := context.Background()
+	saved := sleepDuringQueryRulesFailure
+	sleepDuringQueryRulesFailure = 10 * time.Millisecond
+	defer func() { sleepDuringQueryRulesFailure = saved }()
+
+	executor, _, _, _ := createExecutorEnv()
+	ts := memorytopo.NewServer("cell1")
+	qw, err := newQueryRulesWatcher(ctx, executor, ts, "global", "/vtgate/query_rules")
+	require.NoError(t, err)
+	qw.start()
+	defer qw.stop()
+
+	conn, err := ts.ConnForCell(ctx, "global")
+	require.NoError(t, err)
+	_, err = conn.Create(ctx, "/vtgate/query_rules", []byte(`[{"Name":"r1","Action":"FAIL"}]`))
+	require.NoError(t, err)
+	waitForQueryRules := func(name string) {
+		t.Helper()
+		for i := 0; i < 500; i++ {
+			if qrs := executor.getQueryRules(); qrs != nil && len(qrs.rules) == 1 && qrs.rules[0].Name == name {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("query rule %s was not applied", name)
+	}
+	waitForQueryRules("r1")
+
+	_, err = conn.Update(ctx, "/vtgate/query_rules", []byte(`[{"Name":"r2","Action":"FAIL"}]`), nil)
+	require.NoError(t, err)
+	waitForQueryRules("r2")
+}
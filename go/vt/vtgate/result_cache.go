@@ -0,0 +1,267 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/hack"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	resultCacheHits          = stats.NewCounter("ResultCacheHits", "Number of queries served from the result cache")
+	resultCacheMisses        = stats.NewCounter("ResultCacheMisses", "Number of cacheable queries that were not in the result cache")
+	resultCacheInvalidations = stats.NewCountersWithSingleLabel("ResultCacheInvalidations", "Number of invalidations of the result cache caused by changes of tables", "Keyspace")
+)
+
+// resultCacheRetryDelay is the delay before restarting a vstream used to
+// invalidate the result cache.
+var resultCacheRetryDelay = 5 * time.Second
+
+// watchKeyspaceFunc streams the changes of a keyspace.
+type watchKeyspaceFunc func(ctx context.Context, keyspace string, send func(events []*binlogdatapb.VEvent) error) error
+
+// resultCache caches the results of the selects whose plan has a
+// ResultCacheTTL, until the TTL expires or until one of the tables they
+// read changes, if the changes of their keyspaces are watched.
+type resultCache struct {
+	results cache.Cache
+
+	mu sync.Mutex
+	// generations are incremented when a table changes, by keyspace.table,
+	// and when all the tables of a keyspace may have changed, by keyspace.
+	generations map[string]uint64
+	// watch is set if the changes of the keyspaces are watched.
+	watch    watchKeyspaceFunc
+	watchCtx context.Context
+	// watched are the keyspaces whose changes are watched.
+	watched map[string]bool
+}
+
+// cachedResult is a result in the result cache.
+type cachedResult struct {
+	result  *sqltypes.Result
+	expires time.Time
+	// generations are the generations of the tables of the plan when the
+	// query was sent.
+	generations []uint64
+}
+
+// CachedSize returns the memory used by the result, for the cache.
+func (cr *cachedResult) CachedSize(alloc bool) int64 {
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	size += hack.RuntimeAllocSize(int64(cap(cr.generations)) * int64(8))
+	return size + cr.result.CachedSize(true)
+}
+
+// newResultCache returns a result cache using the given memory,
+// or nil if the memory is 0.
+func newResultCache(maxEntries, maxMemoryUsage int64) *resultCache {
+	if maxEntries <= 0 || maxMemoryUsage <= 0 {
+		return nil
+	}
+	return &resultCache{
+		results: cache.NewDefaultCacheImpl(&cache.Config{
+			MaxEntries:     maxEntries,
+			MaxMemoryUsage: maxMemoryUsage,
+			LFU:            true,
+		}),
+		generations: make(map[string]uint64),
+		watched:     make(map[string]bool),
+	}
+}
+
+// watchKeyspaces invalidates the cached results when the tables they read
+// change. The changes of a keyspace are watched from its first cached result.
+func (rc *resultCache) watchKeyspaces(ctx context.Context, watch watchKeyspaceFunc) {
+	if rc == nil {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.watch = watch
+	rc.watchCtx = ctx
+}
+
+// execute returns the cached result of the plan for the bind variables
+// if there is one, and runs the query and caches its result otherwise.
+func (rc *resultCache) execute(vcursor *vcursorImpl, plan *engine.Plan, bindVars map[string]*querypb.BindVariable, safeSession *SafeSession, run func() (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	if rc == nil || plan.ResultCacheTTL <= 0 || plan.Type != sqlparser.StmtSelect ||
+		safeSession.InTransaction() || safeSession.InReservedConn() {
+		return run()
+	}
+
+	// The results are cached per user: the tablets check the table ACLs of
+	// the user, which a cached result would skip.
+	user := callerid.GetUsername(callerid.ImmediateCallerIDFromContext(vcursor.ctx))
+	key := resultCacheKey(user, vcursor.planPrefixKey(), plan.Original, bindVars)
+	generations := rc.tableGenerations(plan.ResultCacheTables)
+	if val, ok := rc.results.Get(key); ok {
+		cached := val.(*cachedResult)
+		if time.Now().Before(cached.expires) && generationsEqual(cached.generations, generations) {
+			resultCacheHits.Add(1)
+			// The callers may change the result, which is shared by all the sessions.
+			return cached.result.Copy(), nil
+		}
+		rc.results.Delete(key)
+	}
+	resultCacheMisses.Add(1)
+
+	expires := time.Now().Add(plan.ResultCacheTTL)
+	qr, err := run()
+	if err != nil {
+		return nil, err
+	}
+	rc.results.Set(key, &cachedResult{
+		result:      qr.Copy(),
+		expires:     expires,
+		generations: generations,
+	})
+	rc.startWatching(plan.ResultCacheTables)
+	return qr, nil
+}
+
+// tableGenerations returns the current generations of the tables.
+func (rc *resultCache) tableGenerations(tables []string) []uint64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	generations := make([]uint64, len(tables))
+	for i, table := range tables {
+		generations[i] = rc.generations[table] + rc.generations[tableKeyspace(table)]
+	}
+	return generations
+}
+
+// invalidate invalidates the cached results of a table, as keyspace.table,
+// or of all the tables of a keyspace.
+func (rc *resultCache) invalidate(name string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.generations[name]++
+	resultCacheInvalidations.Add(tableKeyspace(name), 1)
+}
+
+// startWatching starts watching the keyspaces of the tables,
+// if the keyspaces are watched and they are not already.
+func (rc *resultCache) startWatching(tables []string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.watch == nil {
+		return
+	}
+	for _, table := range tables {
+		keyspace := tableKeyspace(table)
+		if rc.watched[keyspace] {
+			continue
+		}
+		rc.watched[keyspace] = true
+		go rc.watchKeyspace(rc.watchCtx, rc.watch, keyspace)
+	}
+}
+
+// watchKeyspace invalidates the cached results of the tables of the
+// keyspace when they change, until the context is done.
+func (rc *resultCache) watchKeyspace(ctx context.Context, watch watchKeyspaceFunc, keyspace string) {
+	for {
+		err := watch(ctx, keyspace, func(events []*binlogdatapb.VEvent) error {
+			for _, event := range events {
+				switch event.Type {
+				case binlogdatapb.VEventType_ROW:
+					// The table names of the events of vtgate vstreams are qualified by their keyspace.
+					rc.invalidate(event.RowEvent.TableName)
+				case binlogdatapb.VEventType_DDL:
+					rc.invalidate(keyspace)
+				}
+			}
+			return nil
+		})
+		// The tables may have changed while the keyspace was not watched.
+		rc.invalidate(keyspace)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warningf("Result cache: the vstream of keyspace %s stopped, restarting it in %v: %v", keyspace, resultCacheRetryDelay, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resultCacheRetryDelay):
+		}
+	}
+}
+
+// resultCacheKey returns the key of the results of a query of a user for the bind variables.
+func resultCacheKey(user, planPrefixKey, query string, bindVars map[string]*querypb.BindVariable) string {
+	names := make([]string, 0, len(bindVars))
+	for name := range bindVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	_, _ = hash.Write(hack.StringBytes(user))
+	_, _ = hash.Write([]byte{0})
+	_, _ = hash.Write([]byte(planPrefixKey))
+	_, _ = hash.Write([]byte{':'})
+	_, _ = hash.Write(hack.StringBytes(query))
+	for _, name := range names {
+		bv, _ := bindVars[name].MarshalVT()
+		_, _ = hash.Write([]byte{0})
+		_, _ = hash.Write(hack.StringBytes(name))
+		_, _ = hash.Write([]byte{0})
+		_, _ = hash.Write(bv)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// tableKeyspace returns the keyspace of a keyspace.table name.
+func tableKeyspace(name string) string {
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		return name[:i]
+	}
+	return name
+}
+
+func generationsEqual(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestResultCache(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	executor.results = newResultCache(100, 10*1024*1024)
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+
+	// exec runs the query and returns the number of queries it sent to the tablet.
+	exec := func(sql string, session *vtgatepb.Session) int64 {
+		t.Helper()
+		before := sbclookup.ExecCount.Get()
+		_, err := executorExecSession(executor, sql, nil, session)
+		require.NoError(t, err)
+		executor.results.results.Wait()
+		return sbclookup.ExecCount.Get() - before
+	}
+
+	query := "select /*vt+ CACHE_TTL=1m */ id from music_user_map where id = 1"
+	assert.EqualValues(t, 1, exec(query, session))
+	assert.EqualValues(t, 0, exec(query, session), "the result was not cached")
+	// The cache is keyed by the bind variables created by the normalization.
+	assert.EqualValues(t, 1, exec("select /*vt+ CACHE_TTL=1m */ id from music_user_map where id = 2", session))
+	assert.EqualValues(t, 0, exec("select /*vt+ CACHE_TTL=1m */ id from music_user_map where id = 2", session))
+
+	// Queries without TTL are not cached.
+	assert.EqualValues(t, 1, exec("select id from music_user_map where id = 1", session))
+	assert.EqualValues(t, 1, exec("select id from music_user_map where id = 1", session))
+
+	// Transactions read their own writes.
+	assert.EqualValues(t, 1, exec(query, &vtgatepb.Session{TargetString: "@primary", InTransaction: true}))
+
+	// A change of a table, or of its keyspace, invalidates its results.
+	executor.results.invalidate(KsTestUnsharded + ".music_user_map")
+	assert.EqualValues(t, 1, exec(query, session))
+	assert.EqualValues(t, 0, exec(query, session))
+	executor.results.invalidate(KsTestUnsharded + ".user_msgs")
+	assert.EqualValues(t, 0, exec(query, session))
+	executor.results.invalidate(KsTestUnsharded)
+	assert.EqualValues(t, 1, exec(query, session))
+
+	// Results expire with their TTL.
+	query = "select /*vt+ CACHE_TTL=10ms */ id from music_user_map where id = 1"
+	assert.EqualValues(t, 1, exec(query, session))
+	assert.EqualValues(t, 0, exec(query, session))
+	time.Sleep(20 * time.Millisecond)
+	assert.EqualValues(t, 1, exec(query, session))
+}
+
+func TestResultCacheCopiesResults(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	executor.results = newResultCache(100, 10*1024*1024)
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+	query := "select /*vt+ CACHE_TTL=1m */ id from music_user_map where id = 1"
+
+	qr, err := executorExecSession(executor, query, nil, session)
+	require.NoError(t, err)
+	executor.results.results.Wait()
+	require.NotEmpty(t, qr.Rows)
+	want := qr.Copy()
+
+	// Changing the results served to a session does not change the cached result.
+	for i := 0; i < 2; i++ {
+		before := sbclookup.ExecCount.Get()
+		qr, err = executorExecSession(executor, query, nil, session)
+		require.NoError(t, err)
+		assert.EqualValues(t, before, sbclookup.ExecCount.Get(), "the result was not cached")
+		assert.Equal(t, want, qr)
+		qr.Rows[0][0] = sqltypes.NewVarChar("changed")
+		qr.Fields[0].Name = "changed"
+	}
+
+	// The results of the queries calling non-deterministic functions are not cached.
+	before := sbclookup.ExecCount.Get()
+	for i := 0; i < 2; i++ {
+		_, err := executorExecSession(executor, "select /*vt+ CACHE_TTL=1m */ id, now() from music_user_map where id = 1", nil, session)
+		require.NoError(t, err)
+		executor.results.results.Wait()
+	}
+	assert.EqualValues(t, before+2, sbclookup.ExecCount.Get())
+}
+
+func TestResultCachePerUser(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	executor.results = newResultCache(100, 10*1024*1024)
+
+	// exec runs the query as the user and returns the number of queries it sent to the tablet.
+	exec := func(user string) int64 {
+		t.Helper()
+		before := sbclookup.ExecCount.Get()
+		ctx := callerid.NewContext(context.Background(), &vtrpcpb.CallerID{}, &querypb.VTGateCallerID{Username: user})
+		session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+		_, err := executor.Execute(ctx, "TestResultCachePerUser", session, "select /*vt+ CACHE_TTL=1m */ id from music_user_map where id = 1", nil)
+		require.NoError(t, err)
+		executor.results.results.Wait()
+		return sbclookup.ExecCount.Get() - before
+	}
+
+	// The result of a user is not served to another user, whose table
+	// ACLs are checked by the tablet.
+	assert.EqualValues(t, 1, exec("redUser"))
+	assert.EqualValues(t, 0, exec("redUser"))
+	assert.EqualValues(t, 1, exec("blueUser"))
+	assert.EqualValues(t, 0, exec("blueUser"))
+}
+
+func TestResultCacheWatchKeyspaces(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	executor.results = newResultCache(100, 10*1024*1024)
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watched := make(chan string, 1)
+	events := make(chan *binlogdatapb.VEvent)
+	executor.results.watchKeyspaces(ctx, func(ctx context.Context, keyspace string, send func(events []*binlogdatapb.VEvent) error) error {
+		watched <- keyspace
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case event := <-events:
+				if err := send([]*binlogdatapb.VEvent{event}); err != nil {
+					return err
+				}
+				// Tell the test the event was handled.
+				events <- nil
+			}
+		}
+	})
+	sendEvent := func(event *binlogdatapb.VEvent) {
+		events <- event
+		<-events
+	}
+	exec := func(sql string) int64 {
+		t.Helper()
+		before := sbclookup.ExecCount.Get()
+		_, err := executorExecSession(executor, sql, nil, session)
+		require.NoError(t, err)
+		executor.results.results.Wait()
+		return sbclookup.ExecCount.Get() - before
+	}
+
+	query := "select /*vt+ CACHE_TTL=1m */ id from music_user_map where id = 1"
+	assert.EqualValues(t, 1, exec(query))
+	assert.Equal(t, KsTestUnsharded, <-watched)
+	assert.EqualValues(t, 0, exec(query))
+
+	sendEvent(&binlogdatapb.VEvent{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: KsTestUnsharded + ".user_msgs"},
+	})
+	assert.EqualValues(t, 0, exec(query))
+
+	sendEvent(&binlogdatapb.VEvent{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: KsTestUnsharded + ".music_user_map"},
+	})
+	assert.EqualValues(t, 1, exec(query))
+	assert.EqualValues(t, 0, exec(query))
+
+	sendEvent(&binlogdatapb.VEvent{Type: binlogdatapb.VEventType_DDL})
+	assert.EqualValues(t, 1, exec(query))
+
+	// The keyspace is only watched once.
+	select {
+	case keyspace := <-watched:
+		t.Errorf("keyspace %s watched again", keyspace)
+	default:
+	}
+}
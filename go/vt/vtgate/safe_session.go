@@ -44,6 +44,9 @@ type SafeSession struct {
 	// this is a signal that found_rows has already been handles by the primitives,
 	// and doesn't have to be updated by the executor
 	foundRowsHandled bool
+
+	// preparedPlan is the plan of the prepared statement being executed.
+	preparedPlan *preparedPlan
 	*vtgatepb.Session
 }
 
@@ -569,3 +572,19 @@ func (session *SafeSession) getSelectLimit() int {
 
 	return int(session.Options.SqlSelectLimit)
 }
+
+// getPreparedPlan returns the plan of the prepared statement being executed.
+// It is only returned once, so that the queries executed on behalf of the
+// statement, like the ones of lookup vindexes, are planned on their own.
+func (session *SafeSession) getPreparedPlan() *preparedPlan {
+	if session == nil {
+		return nil
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	pp := session.preparedPlan
+	session.preparedPlan = nil
+	return pp
+}
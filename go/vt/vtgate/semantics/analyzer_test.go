@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql/collations"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -410,6 +411,32 @@ func TestUnknownColumnMap2(t *testing.T) {
 	}
 }
 
+func TestCollationFor(t *testing.T) {
+	tcases := []struct {
+		query     string
+		collation collations.ID
+	}{{
+		query:     "select name from t2",
+		collation: collations.Utf8mb4GeneralCi,
+	}, {
+		query:     "select uid from t2",
+		collation: collations.Unknown,
+	}, {
+		query:     "select x.name from (select name from t2) as x",
+		collation: collations.Utf8mb4GeneralCi,
+	}, {
+		query:     "select 'name' from t2",
+		collation: collations.Unknown,
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			parse, semTable := parseAndAnalyze(t, tcase.query, "d")
+			expr := extract(parse.(*sqlparser.Select), 0)
+			assert.Equal(t, tcase.collation, semTable.CollationFor(expr))
+		})
+	}
+}
+
 func TestUnknownPredicate(t *testing.T) {
 	query := "select 1 from a, b where col = 1"
 	authoritativeTblA := &vindexes.Table{
@@ -1366,8 +1393,9 @@ func fakeSchemaInfo() *FakeSI {
 		Name: sqlparser.NewColIdent("uid"),
 		Type: querypb.Type_INT64,
 	}, {
-		Name: sqlparser.NewColIdent("name"),
-		Type: querypb.Type_VARCHAR,
+		Name:          sqlparser.NewColIdent("name"),
+		Type:          querypb.Type_VARCHAR,
+		CollationName: "utf8mb4_general_ci",
 	}}
 
 	si := &FakeSI{
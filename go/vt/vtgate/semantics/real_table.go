@@ -19,6 +19,7 @@ package semantics
 import (
 	"strings"
 
+	"vitess.io/vitess/go/mysql/collations"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -40,7 +41,7 @@ func (r *RealTable) dependencies(colName string, org originable) (dependencies,
 	ts := org.tableSetFor(r.ASTNode)
 	for _, info := range r.getColumns() {
 		if strings.EqualFold(info.Name, colName) {
-			return createCertain(ts, ts, &info.Type), nil
+			return createCertain(ts, ts, &Type{Type: info.Type, Collation: info.Collation}), nil
 		}
 	}
 
@@ -103,8 +104,9 @@ func vindexTableToColumnInfo(tbl *vindexes.Table) []ColumnInfo {
 	cols := make([]ColumnInfo, 0, len(tbl.Columns))
 	for _, col := range tbl.Columns {
 		cols = append(cols, ColumnInfo{
-			Name: col.Name.String(),
-			Type: col.Type,
+			Name:      col.Name.String(),
+			Type:      col.Type,
+			Collation: collations.LookupID(col.CollationName),
 		})
 		nameMap[col.Name.String()] = nil
 	}
@@ -91,6 +91,10 @@ func (s *scoper) down(cursor *sqlparser.Cursor) error {
 		}
 		wScope.tables = []TableInfo{createVTableInfoForExpressions(node, s.currentScope().tables, s.org)}
 	case sqlparser.OrderBy:
+		if isParentWindowSpec(cursor) {
+			// the ORDER BY of a window only sees the tables in the FROM clause
+			break
+		}
 		err := s.createSpecialScopePostProjection(cursor.Parent())
 		if err != nil {
 			return err
@@ -135,7 +139,12 @@ func keepIntLiteral(e sqlparser.Expr) *sqlparser.Literal {
 func (s *scoper) up(cursor *sqlparser.Cursor) error {
 	node := cursor.Node()
 	switch node := node.(type) {
-	case *sqlparser.Select, sqlparser.OrderBy, sqlparser.GroupBy:
+	case *sqlparser.Select, sqlparser.GroupBy:
+		s.popScope()
+	case sqlparser.OrderBy:
+		if isParentWindowSpec(cursor) {
+			break
+		}
 		s.popScope()
 	case *sqlparser.Where:
 		if node.Type != sqlparser.HavingClause {
@@ -159,6 +168,11 @@ func (s *scoper) up(cursor *sqlparser.Cursor) error {
 	return nil
 }
 
+func isParentWindowSpec(cursor *sqlparser.Cursor) bool {
+	_, isWindowSpec := cursor.Parent().(*sqlparser.WindowSpecification)
+	return isWindowSpec
+}
+
 func ValidAsMapKey(s sqlparser.SQLNode) bool {
 	return reflect.TypeOf(s).Comparable()
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package semantics
 
 import (
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -58,8 +59,15 @@ type (
 
 	// ColumnInfo contains information about columns
 	ColumnInfo struct {
-		Name string
-		Type querypb.Type
+		Name      string
+		Type      querypb.Type
+		Collation collations.ID
+	}
+
+	// Type is the type of an expression, and the collation of its values when it is a text expression
+	Type struct {
+		Type      querypb.Type
+		Collation collations.ID
 	}
 
 	// ExprDependencies stores the tables that an expression depends on as a map
@@ -81,7 +89,7 @@ type (
 		// It does not recurse inside derived tables and the like to find the original dependencies
 		Direct ExprDependencies
 
-		exprTypes   map[sqlparser.Expr]querypb.Type
+		exprTypes   map[sqlparser.Expr]Type
 		selectScope map[*sqlparser.Select]*scope
 		Comments    sqlparser.Comments
 		SubqueryMap map[*sqlparser.Select][]*sqlparser.ExtractedSubquery
@@ -200,11 +208,17 @@ func (st *SemTable) AddExprs(tbl *sqlparser.AliasedTableExpr, cols sqlparser.Sel
 func (st *SemTable) TypeFor(e sqlparser.Expr) *querypb.Type {
 	typ, found := st.exprTypes[e]
 	if found {
-		return &typ
+		return &typ.Type
 	}
 	return nil
 }
 
+// CollationFor returns the collation of a text expression in the query,
+// or collations.Unknown if the expression is not text or its collation is not known
+func (st *SemTable) CollationFor(e sqlparser.Expr) collations.ID {
+	return st.exprTypes[e].Collation
+}
+
 // dependencies return the table dependencies of the expression. This method finds table dependencies recursively
 func (d ExprDependencies) dependencies(expr sqlparser.Expr) (deps TableSet) {
 	if ValidAsMapKey(expr) {
@@ -18,7 +18,6 @@ package semantics
 
 import (
 	"vitess.io/vitess/go/sqltypes"
-	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 )
@@ -26,12 +25,12 @@ import (
 // typer is responsible for setting the type for expressions
 // it does it's work after visiting the children (up), since the children types is often needed to type a node.
 type typer struct {
-	exprTypes map[sqlparser.Expr]querypb.Type
+	exprTypes map[sqlparser.Expr]Type
 }
 
 func newTyper() *typer {
 	return &typer{
-		exprTypes: map[sqlparser.Expr]querypb.Type{},
+		exprTypes: map[sqlparser.Expr]Type{},
 	}
 }
 
@@ -40,24 +39,24 @@ func (t *typer) up(cursor *sqlparser.Cursor) error {
 	case *sqlparser.Literal:
 		switch node.Type {
 		case sqlparser.IntVal:
-			t.exprTypes[node] = sqltypes.Int32
+			t.exprTypes[node] = Type{Type: sqltypes.Int32}
 		case sqlparser.StrVal:
-			t.exprTypes[node] = sqltypes.VarChar
+			t.exprTypes[node] = Type{Type: sqltypes.VarChar}
 		case sqlparser.FloatVal:
-			t.exprTypes[node] = sqltypes.Decimal
+			t.exprTypes[node] = Type{Type: sqltypes.Decimal}
 		}
 	case *sqlparser.FuncExpr:
 		code, ok := engine.SupportedAggregates[node.Name.Lowered()]
 		if ok {
 			typ, ok := engine.OpcodeType[code]
 			if ok {
-				t.exprTypes[node] = typ
+				t.exprTypes[node] = Type{Type: typ}
 			}
 		}
 	}
 	return nil
 }
 
-func (t *typer) setTypeFor(node *sqlparser.ColName, typ querypb.Type) {
+func (t *typer) setTypeFor(node *sqlparser.ColName, typ Type) {
 	t.exprTypes[node] = typ
 }
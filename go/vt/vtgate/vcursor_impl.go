@@ -71,6 +71,9 @@ type iExecute interface {
 	// TODO: remove when resolver is gone
 	ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error)
 	VSchema() *vindexes.VSchema
+	TransactionMode() vtgatepb.TransactionMode
+	SequenceCache() *engine.SequenceCache
+	ForEachCachedPlan(ctx context.Context, each func(plan *engine.Plan) bool) error
 }
 
 //VSchemaOperator is an interface to Vschema Operations
@@ -173,6 +176,21 @@ func (vc *vcursorImpl) ExceedsMaxMemoryRows(numRows int) bool {
 	return !vc.ignoreMaxMemoryRows && numRows > *maxMemoryRows
 }
 
+// SpillDir returns the spill_dir flag value.
+func (vc *vcursorImpl) SpillDir() string {
+	return *spillDir
+}
+
+// RecordSpill adds the bytes written to the spill directory to the query's log stats.
+// Returns an error once they exceed the max_spill_bytes flag value.
+func (vc *vcursorImpl) RecordSpill(numBytes int64) error {
+	spilled := atomic.AddUint64(&vc.logStats.SpilledBytes, uint64(numBytes))
+	if spilled > uint64(*maxSpillBytes) {
+		return vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.NetPacketTooLarge, "spilled bytes exceeded allowed limit of %d", *maxSpillBytes)
+	}
+	return nil
+}
+
 // SetIgnoreMaxMemoryRows sets the ignoreMaxMemoryRows value.
 func (vc *vcursorImpl) SetIgnoreMaxMemoryRows(ignoreMaxMemoryRows bool) {
 	vc.ignoreMaxMemoryRows = ignoreMaxMemoryRows
@@ -546,6 +564,11 @@ func (vc *vcursorImpl) InReservedConn() bool {
 	return vc.safeSession.InReservedConn()
 }
 
+// InTransaction implements the SessionActions interface
+func (vc *vcursorImpl) InTransaction() bool {
+	return vc.safeSession.InTransaction()
+}
+
 func (vc *vcursorImpl) ShardSession() []*srvtopo.ResolvedShard {
 	ss := vc.safeSession.GetShardSessions()
 	if len(ss) == 0 {
@@ -648,6 +671,14 @@ func (vc *vcursorImpl) SetTransactionMode(mode vtgatepb.TransactionMode) {
 	vc.safeSession.TransactionMode = mode
 }
 
+// GetTransactionMode implements the SessionActions interface
+func (vc *vcursorImpl) GetTransactionMode() vtgatepb.TransactionMode {
+	if vc.safeSession.TransactionMode != vtgatepb.TransactionMode_UNSPECIFIED {
+		return vc.safeSession.TransactionMode
+	}
+	return vc.executor.TransactionMode()
+}
+
 // SetWorkload implements the SessionActions interface
 func (vc *vcursorImpl) SetWorkload(workload querypb.ExecuteOptions_Workload) {
 	vc.safeSession.GetOrCreateOptions().Workload = workload
@@ -715,6 +746,16 @@ func (vc *vcursorImpl) GetWarnings() []*querypb.QueryWarning {
 	return vc.safeSession.GetWarnings()
 }
 
+// SequenceCache implements the VCursor interface
+func (vc *vcursorImpl) SequenceCache() *engine.SequenceCache {
+	return vc.executor.SequenceCache()
+}
+
+// ForEachCachedPlan implements the VCursor interface
+func (vc *vcursorImpl) ForEachCachedPlan(each func(plan *engine.Plan) bool) error {
+	return vc.executor.ForEachCachedPlan(vc.ctx, each)
+}
+
 // GetDBDDLPluginName implements the VCursor interface
 func (vc *vcursorImpl) GetDBDDLPluginName() string {
 	return *dbDDLPlugin
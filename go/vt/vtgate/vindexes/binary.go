@@ -27,6 +27,7 @@ import (
 var (
 	_ SingleColumn = (*Binary)(nil)
 	_ Reversible   = (*Binary)(nil)
+	_ Ordered      = (*Binary)(nil)
 )
 
 // Binary is a vindex that converts binary bits to a keyspace id.
@@ -77,6 +78,20 @@ func (vind *Binary) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination
 	return out, nil
 }
 
+// RangeMap returns the keyspace range of the ids from start to end. The ids are
+// ordered by their bytes, which is only the order of MySQL for binary strings:
+// the planner doesn't use it for the columns of any other collation.
+func (*Binary) RangeMap(_ VCursor, start, end sqltypes.Value) (key.Destination, error) {
+	var startKsid, endKsid []byte
+	if !start.IsNull() {
+		startKsid = start.ToBytes()
+	}
+	if !end.IsNull() {
+		endKsid = end.ToBytes()
+	}
+	return keyRangeDestination(startKsid, endKsid), nil
+}
+
 // ReverseMap returns the associated ids for the ksids.
 func (*Binary) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
 	var reverseIds = make([]sqltypes.Value, len(ksids))
@@ -26,6 +26,8 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var binOnlyVindex SingleColumn
@@ -96,3 +98,15 @@ func TestBinaryReverseMap(t *testing.T) {
 		t.Errorf("ReverseMap(): %v, want %s", err, wantErr)
 	}
 }
+
+func TestBinaryKeyRangeMap(t *testing.T) {
+	got, err := binOnlyVindex.(Ordered).RangeMap(nil, sqltypes.NewVarBinary("a"), sqltypes.NewVarBinary("b"))
+	require.NoError(t, err)
+	want := key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("a"), End: []byte("b\x00")}}
+	assert.Equal(t, want, got)
+
+	got, err = binOnlyVindex.(Ordered).RangeMap(nil, sqltypes.NewVarBinary("a"), sqltypes.NULL)
+	require.NoError(t, err)
+	want = key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("a")}}
+	assert.Equal(t, want, got)
+}
@@ -26,6 +26,7 @@ import (
 
 var (
 	_ SingleColumn = (*BinaryMD5)(nil)
+	_ Hashing      = (*BinaryMD5)(nil)
 )
 
 // BinaryMD5 is a vindex that hashes binary bits to a keyspace id.
@@ -81,6 +82,11 @@ func vMD5Hash(source []byte) []byte {
 	return sum[:]
 }
 
+// Hash returns the keyspace id of the id.
+func (vind *BinaryMD5) Hash(id sqltypes.Value) ([]byte, error) {
+	return vMD5Hash(id.ToBytes()), nil
+}
+
 func init() {
 	Register("binary_md5", NewBinaryMD5)
 }
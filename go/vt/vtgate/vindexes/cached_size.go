@@ -93,10 +93,12 @@ func (cached *Column) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
+	// field CollationName string
+	size += hack.RuntimeAllocSize(int64(len(cached.CollationName)))
 	return size
 }
 func (cached *ColumnVindex) CachedSize(alloc bool) int64 {
@@ -256,6 +258,31 @@ func (cached *LookupUnique) CachedSize(alloc bool) int64 {
 	size += cached.lkp.CachedSize(false)
 	return size
 }
+func (cached *MultiCol) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	// field columnVindex []vitess.io/vitess/go/vt/vtgate/vindexes.Hashing
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.columnVindex)) * int64(16))
+		for _, elem := range cached.columnVindex {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field columnBytes []int
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.columnBytes)) * int64(8))
+	}
+	return size
+}
 func (cached *Null) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -280,6 +307,18 @@ func (cached *Numeric) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.name)))
 	return size
 }
+func (cached *NumericRange) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field rangeVindex vitess.io/vitess/go/vt/vtgate/vindexes.rangeVindex
+	size += cached.rangeVindex.CachedSize(false)
+	return size
+}
 
 //go:nocheckptr
 func (cached *NumericStaticMap) CachedSize(alloc bool) int64 {
@@ -363,7 +402,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(176)
+		size += int64(184)
 	}
 	// field Type string
 	size += hack.RuntimeAllocSize(int64(len(cached.Type)))
@@ -396,7 +435,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	size += cached.AutoIncrement.CachedSize(true)
 	// field Columns []vitess.io/vitess/go/vt/vtgate/vindexes.Column
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(44))
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(64))
 		for _, elem := range cached.Columns {
 			size += elem.CachedSize(false)
 		}
@@ -405,6 +444,18 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(cap(cached.Pinned)))
 	return size
 }
+func (cached *TimeRange) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field rangeVindex vitess.io/vitess/go/vt/vtgate/vindexes.rangeVindex
+	size += cached.rangeVindex.CachedSize(false)
+	return size
+}
 func (cached *UnicodeLooseMD5) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -513,3 +564,34 @@ func (cached *prefixCFC) CachedSize(alloc bool) int64 {
 	size += cached.CFC.CachedSize(true)
 	return size
 }
+func (cached *rangeVindex) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	// field ranges []vitess.io/vitess/go/vt/vtgate/vindexes.valueRange
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ranges)) * int64(32))
+		for _, elem := range cached.ranges {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
+func (cached *valueRange) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field prefix []byte
+	size += hack.RuntimeAllocSize(int64(cap(cached.prefix)))
+	return size
+}
@@ -34,6 +34,7 @@ import (
 var (
 	_ SingleColumn = (*Hash)(nil)
 	_ Reversible   = (*Hash)(nil)
+	_ Hashing      = (*Hash)(nil)
 )
 
 // Hash defines vindex that hashes an int64 to a KeyspaceId
@@ -74,28 +75,37 @@ func (vind *Hash) NeedsVCursor() bool {
 func (vind *Hash) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
 	out := make([]key.Destination, len(ids))
 	for i, id := range ids {
-		var num uint64
-		var err error
-
-		if id.IsSigned() {
-			// This is ToUint64 with no check on negative values.
-			str := id.ToString()
-			var ival int64
-			ival, err = strconv.ParseInt(str, 10, 64)
-			num = uint64(ival)
-		} else {
-			num, err = evalengine.ToUint64(id)
-		}
-
+		ksid, err := vind.Hash(id)
 		if err != nil {
 			out[i] = key.DestinationNone{}
 			continue
 		}
-		out[i] = key.DestinationKeyspaceID(vhash(num))
+		out[i] = key.DestinationKeyspaceID(ksid)
 	}
 	return out, nil
 }
 
+// Hash returns the keyspace id of the id.
+func (vind *Hash) Hash(id sqltypes.Value) ([]byte, error) {
+	var num uint64
+	var err error
+
+	if id.IsSigned() {
+		// This is ToUint64 with no check on negative values.
+		str := id.ToString()
+		var ival int64
+		ival, err = strconv.ParseInt(str, 10, 64)
+		num = uint64(ival)
+	} else {
+		num, err = evalengine.ToUint64(id)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return vhash(num), nil
+}
+
 // Verify returns true if ids maps to ksids.
 func (vind *Hash) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
 	out := make([]bool, len(ids))
@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+var (
+	_ MultiColumn = (*MultiCol)(nil)
+)
+
+const (
+	paramColumnCount  = "column_count"
+	paramColumnVindex = "column_vindex"
+	paramColumnBytes  = "column_bytes"
+
+	// multiColKeyspaceIDSize is the number of bytes of the keyspace ids of a MultiCol vindex.
+	multiColKeyspaceIDSize = 8
+	defaultColumnVindex    = "hash"
+)
+
+func init() {
+	Register("multicol", NewMultiCol)
+}
+
+// MultiCol is a multi-column unique vindex. Every column is hashed by its own
+// Hashing vindex, and the first bytes of every hash are concatenated to produce
+// an 8 byte keyspace id. The rows sharing the same values for the first columns
+// are stored in the keyspace range of their prefix, which allows the values of
+// a prefix of the columns to be mapped to a keyspace range.
+type MultiCol struct {
+	name         string
+	columnVindex []Hashing
+	columnBytes  []int
+}
+
+// NewMultiCol creates a MultiCol vindex.
+// The supplied map requires a column_count argument, the number of columns of the vindex.
+// The optional column_vindex argument is a comma separated list of the vindex types hashing
+// every column, "hash" being used for the columns that are not listed.
+// The optional column_bytes argument is a comma separated list of the number of bytes of the
+// keyspace id taken from the hash of every column, which must add up to 8. By default, the
+// bytes are evenly distributed between the columns, the first column getting the remainder.
+func NewMultiCol(name string, m map[string]string) (Vindex, error) {
+	columnCount, err := strconv.Atoi(m[paramColumnCount])
+	if err != nil || columnCount < 1 || columnCount > multiColKeyspaceIDSize {
+		return nil, fmt.Errorf("multicol: %s must be a number between 1 and %d: %q", paramColumnCount, multiColKeyspaceIDSize, m[paramColumnCount])
+	}
+
+	vindexTypes, err := parseColumnVindexes(m[paramColumnVindex], columnCount)
+	if err != nil {
+		return nil, fmt.Errorf("multicol: %v", err)
+	}
+	columnVindex := make([]Hashing, columnCount)
+	for i, vindexType := range vindexTypes {
+		vindex, err := CreateVindex(vindexType, fmt.Sprintf("%s_%d", name, i), nil)
+		if err != nil {
+			return nil, fmt.Errorf("multicol: %v", err)
+		}
+		hashing, ok := vindex.(Hashing)
+		if !ok {
+			return nil, fmt.Errorf("multicol: vindex type %s cannot be used to hash a column", vindexType)
+		}
+		columnVindex[i] = hashing
+	}
+
+	columnBytes, err := parseColumnBytes(m[paramColumnBytes], columnCount)
+	if err != nil {
+		return nil, fmt.Errorf("multicol: %v", err)
+	}
+
+	return &MultiCol{
+		name:         name,
+		columnVindex: columnVindex,
+		columnBytes:  columnBytes,
+	}, nil
+}
+
+func parseColumnVindexes(param string, columnCount int) ([]string, error) {
+	vindexTypes := make([]string, columnCount)
+	var parts []string
+	if param != "" {
+		parts = strings.Split(param, ",")
+	}
+	if len(parts) > columnCount {
+		return nil, fmt.Errorf("%s lists %d vindexes for %d columns", paramColumnVindex, len(parts), columnCount)
+	}
+	for i := range vindexTypes {
+		vindexTypes[i] = defaultColumnVindex
+		if i < len(parts) && strings.TrimSpace(parts[i]) != "" {
+			vindexTypes[i] = strings.TrimSpace(parts[i])
+		}
+	}
+	return vindexTypes, nil
+}
+
+func parseColumnBytes(param string, columnCount int) ([]int, error) {
+	columnBytes := make([]int, columnCount)
+	if param == "" {
+		for i := range columnBytes {
+			columnBytes[i] = multiColKeyspaceIDSize / columnCount
+		}
+		columnBytes[0] += multiColKeyspaceIDSize % columnCount
+		return columnBytes, nil
+	}
+
+	parts := strings.Split(param, ",")
+	if len(parts) != columnCount {
+		return nil, fmt.Errorf("%s lists %d widths for %d columns", paramColumnBytes, len(parts), columnCount)
+	}
+	total := 0
+	for i, part := range parts {
+		width, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || width < 1 {
+			return nil, fmt.Errorf("invalid width in %s: %q", paramColumnBytes, part)
+		}
+		columnBytes[i] = width
+		total += width
+	}
+	if total != multiColKeyspaceIDSize {
+		return nil, fmt.Errorf("%s must add up to %d bytes: %s", paramColumnBytes, multiColKeyspaceIDSize, param)
+	}
+	return columnBytes, nil
+}
+
+// String returns the name of the vindex.
+func (m *MultiCol) String() string {
+	return m.name
+}
+
+// Cost returns the cost of this index as 1.
+func (m *MultiCol) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (m *MultiCol) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (m *MultiCol) NeedsVCursor() bool {
+	return false
+}
+
+// PartialVindex returns true since the values of a prefix of the columns
+// are mapped to the keyspace range of their prefix.
+func (m *MultiCol) PartialVindex() bool {
+	return true
+}
+
+// Map satisfies MultiColumn.
+func (m *MultiCol) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(rowsColValues))
+	for _, colValues := range rowsColValues {
+		ksid, err := m.keyspaceID(colValues)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case ksid == nil:
+			out = append(out, key.DestinationNone{})
+		case len(colValues) < len(m.columnVindex):
+			out = append(out, NewKeyRangeFromPrefix(ksid))
+		default:
+			out = append(out, key.DestinationKeyspaceID(ksid))
+		}
+	}
+	return out, nil
+}
+
+// Verify satisfies MultiColumn.
+func (m *MultiCol) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(rowsColValues))
+	for i, colValues := range rowsColValues {
+		if len(colValues) != len(m.columnVindex) {
+			return nil, fmt.Errorf("%s.Verify: wrong number of column values: got %d, expected %d", m.name, len(colValues), len(m.columnVindex))
+		}
+		ksid, err := m.keyspaceID(colValues)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = ksid != nil && bytes.Equal(ksid, ksids[i])
+	}
+	return out, nil
+}
+
+// keyspaceID returns the keyspace id of the column values, or its prefix if only
+// the values of the first columns are given. It returns nil if a value can't be
+// hashed by the vindex of its column.
+func (m *MultiCol) keyspaceID(colValues []sqltypes.Value) ([]byte, error) {
+	if len(colValues) == 0 || len(colValues) > len(m.columnVindex) {
+		return nil, fmt.Errorf("%s: wrong number of column values: got %d, expected between 1 and %d", m.name, len(colValues), len(m.columnVindex))
+	}
+	ksid := make([]byte, 0, multiColKeyspaceIDSize)
+	for i, value := range colValues {
+		hash, err := m.columnVindex[i].Hash(value)
+		if err != nil {
+			return nil, nil
+		}
+		ksid = append(ksid, hash[:m.columnBytes[i]]...)
+	}
+	return ksid, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func createMultiCol(t *testing.T, params map[string]string) MultiColumn {
+	t.Helper()
+	vindex, err := CreateVindex("multicol", "mc", params)
+	require.NoError(t, err)
+	return vindex.(MultiColumn)
+}
+
+func TestMultiColMisc(t *testing.T) {
+	vindex := createMultiCol(t, map[string]string{"column_count": "2"})
+	assert.Equal(t, 1, vindex.Cost())
+	assert.Equal(t, "mc", vindex.String())
+	assert.True(t, vindex.IsUnique())
+	assert.False(t, vindex.NeedsVCursor())
+	assert.True(t, vindex.PartialVindex())
+}
+
+func TestMultiColMap(t *testing.T) {
+	vindex := createMultiCol(t, map[string]string{
+		"column_count":  "3",
+		"column_vindex": "hash,xxhash,unicode_loose_md5",
+		"column_bytes":  "1,3,4",
+	})
+	got, err := vindex.Map(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewVarChar("abc"), sqltypes.NewVarChar("Test"),
+	}, {
+		sqltypes.NewInt64(1), sqltypes.NewVarChar("abc"), sqltypes.NewVarChar("test"),
+	}, {
+		sqltypes.NewInt64(1), sqltypes.NewVarChar("abc"),
+	}, {
+		sqltypes.NewInt64(1),
+	}, {
+		// Invalid value for the hash vindex.
+		sqltypes.NewVarChar("abc"), sqltypes.NewVarChar("abc"),
+	}})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceID("\x16\x99\x09\x77\x0b\x5e\xdb\xb4"),
+		// unicode_loose_md5 ignores the case
+		key.DestinationKeyspaceID("\x16\x99\x09\x77\x0b\x5e\xdb\xb4"),
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x16\x99\x09\x77"), End: []byte("\x16\x99\x09\x78")}},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x16"), End: []byte("\x17")}},
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+
+	_, err = vindex.Map(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(3), sqltypes.NewInt64(4),
+	}})
+	require.EqualError(t, err, "mc: wrong number of column values: got 4, expected between 1 and 3")
+}
+
+func TestMultiColDefaultColumnBytes(t *testing.T) {
+	vindex := createMultiCol(t, map[string]string{"column_count": "3"})
+	got, err := vindex.Map(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}})
+	require.NoError(t, err)
+	// the first column gets 4 bytes of its hash, the others 2 bytes
+	assert.Equal(t, []key.Destination{key.DestinationKeyspaceID("\x16\x6b\x40\xb4\x16\x6b\x16\x6b")}, got)
+}
+
+func TestMultiColVerify(t *testing.T) {
+	vindex := createMultiCol(t, map[string]string{"column_count": "2"})
+	got, err := vindex.Verify(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2),
+	}}, [][]byte{
+		[]byte("\x16\x6b\x40\xb4\x16\x6b\x40\xb4"), []byte("\x16\x6b\x40\xb4\x16\x6b\x40\xb4"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, got)
+
+	_, err = vindex.Verify(nil, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, [][]byte{nil})
+	require.EqualError(t, err, "mc.Verify: wrong number of column values: got 1, expected 2")
+}
+
+func TestMultiColInvalidParams(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{},
+		err:    `multicol: column_count must be a number between 1 and 8: ""`,
+	}, {
+		params: map[string]string{"column_count": "9"},
+		err:    `multicol: column_count must be a number between 1 and 8: "9"`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash,hash,hash"},
+		err:    "multicol: column_vindex lists 3 vindexes for 2 columns",
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash,lookup"},
+		err:    "multicol: vindex type lookup cannot be used to hash a column",
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash,nope"},
+		err:    `multicol: vindexType "nope" not found`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "4"},
+		err:    "multicol: column_bytes lists 1 widths for 2 columns",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "4,0"},
+		err:    `multicol: invalid width in column_bytes: "0"`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "4,5"},
+		err:    "multicol: column_bytes must add up to 8 bytes: 4,5",
+	}}
+	for _, tc := range testcases {
+		_, err := CreateVindex("multicol", "mc", tc.params)
+		assert.EqualError(t, err, tc.err)
+	}
+}
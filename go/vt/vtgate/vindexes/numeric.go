@@ -30,6 +30,7 @@ import (
 var (
 	_ SingleColumn = (*Numeric)(nil)
 	_ Reversible   = (*Numeric)(nil)
+	_ Ordered      = (*Numeric)(nil)
 )
 
 // Numeric defines a bit-pattern mapping of a uint64 to the KeyspaceId.
@@ -107,6 +108,20 @@ func (*Numeric) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error)
 	return reverseIds, nil
 }
 
+// RangeMap returns the keyspace range of the ids from start to end.
+func (*Numeric) RangeMap(_ VCursor, start, end sqltypes.Value) (key.Destination, error) {
+	var startKsid, endKsid []byte
+	if num, err := evalengine.ToUint64(start); err == nil {
+		startKsid = make([]byte, 8)
+		binary.BigEndian.PutUint64(startKsid, num)
+	}
+	if num, err := evalengine.ToUint64(end); err == nil {
+		endKsid = make([]byte, 8)
+		binary.BigEndian.PutUint64(endKsid, num)
+	}
+	return keyRangeDestination(startKsid, endKsid), nil
+}
+
 func init() {
 	Register("numeric", NewNumeric)
 }
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var (
+	_ SingleColumn = (*NumericRange)(nil)
+	_ Reversible   = (*NumericRange)(nil)
+	_ Ordered      = (*NumericRange)(nil)
+)
+
+// NumericRange maps contiguous ranges of uint64 values to the shards covering the
+// keyspace id prefix of their range, as listed by the `ranges` param, e.g.
+// "0=40,1000000=80" stores the values from 0 to 999999 in the shard covering 0x40
+// and the values from 1000000 in the shard covering 0x80. It's Unique and Reversible.
+type NumericRange struct {
+	rangeVindex
+}
+
+// NewNumericRange creates a NumericRange vindex.
+func NewNumericRange(name string, params map[string]string) (Vindex, error) {
+	vind, err := newRangeVindex(name, params, parseNumericRangeStart)
+	if err != nil {
+		return nil, err
+	}
+	vind.toNumber = evalengine.ToUint64
+	vind.fromNumber = sqltypes.NewUint64
+	return &NumericRange{rangeVindex: *vind}, nil
+}
+
+func parseNumericRangeStart(s string) (uint64, error) {
+	return strconv.ParseUint(s, 10, 64)
+}
+
+func formatNumericRangeStart(num uint64) string {
+	return strconv.FormatUint(num, 10)
+}
+
+func init() {
+	Register("numeric_range", NewNumericRange)
+}
@@ -25,6 +25,8 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var numeric SingleColumn
@@ -105,3 +107,38 @@ func TestNumericReverseMapBadData(t *testing.T) {
 		t.Errorf("numeric.Map: %v, want %v", err, want)
 	}
 }
+
+func TestNumericKeyRangeMap(t *testing.T) {
+	testcases := []struct {
+		start, end sqltypes.Value
+		want       key.Destination
+	}{{
+		start: sqltypes.NewInt64(1),
+		end:   sqltypes.NewInt64(256),
+		want: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: []byte("\x00\x00\x00\x00\x00\x00\x00\x01"),
+			End:   []byte("\x00\x00\x00\x00\x00\x00\x01\x00\x00"),
+		}},
+	}, {
+		start: sqltypes.NULL,
+		end:   sqltypes.NewInt64(1),
+		want: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			End: []byte("\x00\x00\x00\x00\x00\x00\x00\x01\x00"),
+		}},
+	}, {
+		start: sqltypes.NewInt64(1),
+		end:   sqltypes.NewVarChar("abc"),
+		want: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: []byte("\x00\x00\x00\x00\x00\x00\x00\x01"),
+		}},
+	}, {
+		start: sqltypes.NewInt64(2),
+		end:   sqltypes.NewInt64(1),
+		want:  key.DestinationNone{},
+	}}
+	for _, tc := range testcases {
+		got, err := numeric.(Ordered).RangeMap(nil, tc.start, tc.end)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got)
+	}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+// rangesParam is the vindex param listing the ranges of a range vindex, as a comma
+// separated list of <start>=<keyspace id prefix in hex>, e.g. "0=40,1000000=80".
+const rangesParam = "ranges"
+
+// valueRange is a contiguous range of values. It starts at start (inclusive) and
+// ends at the start of the next range (exclusive), the last range being unbounded.
+type valueRange struct {
+	start  uint64
+	prefix []byte
+}
+
+// rangeVindex maps the values of contiguous ranges to keyspace ids starting
+// with the prefix of their range, followed by the big endian representation
+// of the value. As long as the prefixes don't decrease from one range to the
+// next, the keyspace ids preserve the order of the values, and every range is
+// stored in the shards covering its prefix.
+// Values below the start of the first range don't map to any keyspace id.
+type rangeVindex struct {
+	name   string
+	ranges []valueRange
+
+	// toNumber converts a value to the number it is sorted by,
+	// and fromNumber converts it back to a value.
+	toNumber   func(sqltypes.Value) (uint64, error)
+	fromNumber func(uint64) sqltypes.Value
+}
+
+// String returns the name of the vindex.
+func (vind *rangeVindex) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this vindex as 1.
+func (*rangeVindex) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (*rangeVindex) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (*rangeVindex) NeedsVCursor() bool {
+	return false
+}
+
+// Verify returns true if ids and ksids match.
+func (vind *rangeVindex) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i := range ids {
+		num, err := vind.toNumber(ids[i])
+		if err != nil {
+			return nil, err
+		}
+		ksid, ok := vind.keyspaceID(num)
+		out[i] = ok && bytes.Equal(ksid, ksids[i])
+	}
+	return out, nil
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *rangeVindex) Map(_ VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(ids))
+	for _, id := range ids {
+		num, err := vind.toNumber(id)
+		if err != nil {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		ksid, ok := vind.keyspaceID(num)
+		if !ok {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		out = append(out, key.DestinationKeyspaceID(ksid))
+	}
+	return out, nil
+}
+
+// ReverseMap returns the associated ids for the ksids.
+func (vind *rangeVindex) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
+	prefixLen := len(vind.ranges[0].prefix)
+	reverseIds := make([]sqltypes.Value, len(ksids))
+	for i, ksid := range ksids {
+		if len(ksid) != prefixLen+8 {
+			return nil, fmt.Errorf("%s.ReverseMap: length of keyspaceId is not %d: %d", vind.name, prefixLen+8, len(ksid))
+		}
+		reverseIds[i] = vind.fromNumber(binary.BigEndian.Uint64(ksid[prefixLen:]))
+	}
+	return reverseIds, nil
+}
+
+// RangeMap returns the keyspace range of the ids from start to end.
+func (vind *rangeVindex) RangeMap(_ VCursor, start, end sqltypes.Value) (key.Destination, error) {
+	var startKsid, endKsid []byte
+	if num, err := vind.toNumber(start); err == nil {
+		// ids below the first range don't have a keyspace id, and leave the range open
+		startKsid, _ = vind.keyspaceID(num)
+	}
+	if num, err := vind.toNumber(end); err == nil {
+		var ok bool
+		endKsid, ok = vind.keyspaceID(num)
+		if !ok {
+			return key.DestinationNone{}, nil
+		}
+	}
+	return keyRangeDestination(startKsid, endKsid), nil
+}
+
+// keyspaceID returns the keyspace id of the number, or false if it is below the first range.
+func (vind *rangeVindex) keyspaceID(num uint64) ([]byte, bool) {
+	i := findRange(vind.ranges, num)
+	if i < 0 {
+		return nil, false
+	}
+	prefix := vind.ranges[i].prefix
+	ksid := make([]byte, len(prefix)+8)
+	copy(ksid, prefix)
+	binary.BigEndian.PutUint64(ksid[len(prefix):], num)
+	return ksid, true
+}
+
+// findRange returns the index of the range containing num, or -1 if there is none.
+func findRange(ranges []valueRange, num uint64) int {
+	return sort.Search(len(ranges), func(i int) bool { return ranges[i].start > num }) - 1
+}
+
+// newRangeVindex creates a rangeVindex from the ranges param, parsing the start
+// of every range with parseStart.
+func newRangeVindex(name string, params map[string]string, parseStart func(string) (uint64, error)) (*rangeVindex, error) {
+	ranges, err := parseRanges(params[rangesParam], parseStart)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return &rangeVindex{name: name, ranges: ranges}, nil
+}
+
+func parseRanges(param string, parseStart func(string) (uint64, error)) ([]valueRange, error) {
+	if param == "" {
+		return nil, fmt.Errorf("missing `%s` param", rangesParam)
+	}
+	var ranges []valueRange
+	for _, part := range strings.Split(param, ",") {
+		startStr, prefixStr, found := cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid range %q: expected <start>=<keyspace id>", part)
+		}
+		start, err := parseStart(strings.TrimSpace(startStr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid start of range %q: %v", part, err)
+		}
+		prefix, err := hex.DecodeString(strings.TrimSpace(prefixStr))
+		if err != nil || len(prefix) == 0 {
+			return nil, fmt.Errorf("invalid keyspace id of range %q: expected a non-empty hex string", part)
+		}
+		if len(ranges) > 0 {
+			prev := ranges[len(ranges)-1]
+			if start <= prev.start {
+				return nil, fmt.Errorf("ranges must be listed by increasing start: %q", part)
+			}
+			if len(prefix) != len(prev.prefix) {
+				return nil, fmt.Errorf("keyspace ids of the ranges must all have the same length: %q", part)
+			}
+			if bytes.Compare(prefix, prev.prefix) < 0 {
+				return nil, fmt.Errorf("keyspace ids of the ranges must not decrease: %q", part)
+			}
+		}
+		ranges = append(ranges, valueRange{start: start, prefix: prefix})
+	}
+	return ranges, nil
+}
+
+func formatRanges(ranges []valueRange, formatStart func(uint64) string) string {
+	parts := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		parts = append(parts, fmt.Sprintf("%s=%s", formatStart(r.start), hex.EncodeToString(r.prefix)))
+	}
+	return strings.Join(parts, ",")
+}
+
+// cut slices s around the first instance of sep.
+func cut(s, sep string) (before, after string, found bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+// AddRange returns a copy of the params of a numeric_range or time_range vindex
+// with a new range starting at start. If keyspaceID is empty, the new range is split
+// from the range containing start and keeps its keyspace id prefix, which doesn't
+// change the keyspace id of any value. A keyspace id is only accepted for a range
+// below the first one: these values don't have a keyspace id yet, so they can't
+// have any row. Moving the values of an existing range to another keyspace id
+// would leave their rows in the wrong shards.
+func AddRange(vindexType string, params map[string]string, start, keyspaceID string) (map[string]string, error) {
+	var parseStart func(string) (uint64, error)
+	var formatStart func(uint64) string
+	switch vindexType {
+	case "numeric_range":
+		parseStart, formatStart = parseNumericRangeStart, formatNumericRangeStart
+	case "time_range":
+		parseStart, formatStart = parseTimeRangeStart, formatTimeRangeStart
+	default:
+		return nil, fmt.Errorf("vindex type %s does not have ranges", vindexType)
+	}
+
+	ranges, err := parseRanges(params[rangesParam], parseStart)
+	if err != nil {
+		return nil, err
+	}
+	num, err := parseStart(start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start %q: %v", start, err)
+	}
+	i := findRange(ranges, num)
+	if i >= 0 && ranges[i].start == num {
+		return nil, fmt.Errorf("a range already starts at %s", start)
+	}
+	newRange := valueRange{start: num}
+	if keyspaceID == "" {
+		if i < 0 {
+			return nil, fmt.Errorf("%s is below the first range, a keyspace id is needed to add a range", start)
+		}
+		newRange.prefix = ranges[i].prefix
+	} else {
+		if i >= 0 {
+			return nil, fmt.Errorf("%s is in the range starting at %s, its values can't be moved to another keyspace id", start, formatStart(ranges[i].start))
+		}
+		newRange.prefix, err = hex.DecodeString(keyspaceID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyspace id %q: %v", keyspaceID, err)
+		}
+	}
+	ranges = append(ranges[:i+1], append([]valueRange{newRange}, ranges[i+1:]...)...)
+
+	newParams := make(map[string]string, len(params))
+	for k, v := range params {
+		newParams[k] = v
+	}
+	newParams[rangesParam] = formatRanges(ranges, formatStart)
+	// validate the new ranges
+	if _, err := parseRanges(newParams[rangesParam], parseStart); err != nil {
+		return nil, err
+	}
+	return newParams, nil
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func createRangeVindex(t *testing.T, vindexType, ranges string) SingleColumn {
+	t.Helper()
+	vindex, err := CreateVindex(vindexType, "rv", map[string]string{"ranges": ranges})
+	require.NoError(t, err)
+	return vindex.(SingleColumn)
+}
+
+func TestNumericRangeInfo(t *testing.T) {
+	vindex := createRangeVindex(t, "numeric_range", "0=40")
+	assert.Equal(t, 1, vindex.Cost())
+	assert.Equal(t, "rv", vindex.String())
+	assert.True(t, vindex.IsUnique())
+	assert.False(t, vindex.NeedsVCursor())
+}
+
+func TestNumericRangeMap(t *testing.T) {
+	vindex := createRangeVindex(t, "numeric_range", "10=40,100=40,1000=80")
+	got, err := vindex.Map(nil, []sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(10),
+		sqltypes.NewInt64(999),
+		sqltypes.NewInt64(1000),
+		sqltypes.NewUint64(1 << 40),
+		sqltypes.NewVarChar("abc"),
+		sqltypes.NULL,
+	})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationNone{},
+		key.DestinationKeyspaceID("\x40\x00\x00\x00\x00\x00\x00\x00\x0a"),
+		key.DestinationKeyspaceID("\x40\x00\x00\x00\x00\x00\x00\x03\xe7"),
+		key.DestinationKeyspaceID("\x80\x00\x00\x00\x00\x00\x00\x03\xe8"),
+		key.DestinationKeyspaceID("\x80\x00\x00\x01\x00\x00\x00\x00\x00"),
+		key.DestinationNone{},
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestNumericRangeVerify(t *testing.T) {
+	vindex := createRangeVindex(t, "numeric_range", "10=40,1000=80")
+	got, err := vindex.Verify(nil,
+		[]sqltypes.Value{sqltypes.NewInt64(10), sqltypes.NewInt64(1000), sqltypes.NewInt64(1)},
+		[][]byte{[]byte("\x40\x00\x00\x00\x00\x00\x00\x00\x0a"), []byte("\x40\x00\x00\x00\x00\x00\x00\x03\xe8"), []byte("\x40\x00\x00\x00\x00\x00\x00\x00\x01")})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, got)
+
+	_, err = vindex.Verify(nil, []sqltypes.Value{sqltypes.NewVarBinary("aa")}, [][]byte{nil})
+	require.EqualError(t, err, "could not parse value: 'aa'")
+}
+
+func TestNumericRangeReverseMap(t *testing.T) {
+	vindex := createRangeVindex(t, "numeric_range", "10=40,1000=80").(Reversible)
+	got, err := vindex.ReverseMap(nil, [][]byte{[]byte("\x80\x00\x00\x00\x00\x00\x00\x03\xe8")})
+	require.NoError(t, err)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewUint64(1000)}, got)
+
+	_, err = vindex.ReverseMap(nil, [][]byte{[]byte("\x80")})
+	require.EqualError(t, err, "rv.ReverseMap: length of keyspaceId is not 9: 1")
+}
+
+func TestTimeRangeMap(t *testing.T) {
+	vindex := createRangeVindex(t, "time_range", "2020-01-01=40,2021-01-01=80")
+	got, err := vindex.Map(nil, []sqltypes.Value{
+		sqltypes.NewVarChar("2019-12-31 23:59:59"),
+		sqltypes.MakeTrusted(sqltypes.Date, []byte("2020-01-01")),
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-01-01 00:00:00")),
+		sqltypes.NewInt64(1609459200),
+		sqltypes.NewVarChar("yesterday"),
+	})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationNone{},
+		key.DestinationKeyspaceID("\x40\x00\x00\x00\x00\x5e\x0b\xe1\x00"),
+		key.DestinationKeyspaceID("\x80\x00\x00\x00\x00\x5f\xee\x66\x00"),
+		key.DestinationKeyspaceID("\x80\x00\x00\x00\x00\x5f\xee\x66\x00"),
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+
+	values, err := vindex.(Reversible).ReverseMap(nil, [][]byte{[]byte("\x80\x00\x00\x00\x00\x5f\xee\x66\x00")})
+	require.NoError(t, err)
+	assert.Equal(t, []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-01-01 00:00:00"))}, values)
+}
+
+func TestRangeVindexInvalidRanges(t *testing.T) {
+	testcases := []struct {
+		vindexType, ranges, err string
+	}{{
+		vindexType: "numeric_range",
+		ranges:     "",
+		err:        "rv: missing `ranges` param",
+	}, {
+		vindexType: "numeric_range",
+		ranges:     "10",
+		err:        `rv: invalid range "10": expected <start>=<keyspace id>`,
+	}, {
+		vindexType: "numeric_range",
+		ranges:     "10=4g",
+		err:        `rv: invalid keyspace id of range "10=4g": expected a non-empty hex string`,
+	}, {
+		vindexType: "numeric_range",
+		ranges:     "10=40,10=80",
+		err:        `rv: ranges must be listed by increasing start: "10=80"`,
+	}, {
+		vindexType: "numeric_range",
+		ranges:     "10=80,20=40",
+		err:        `rv: keyspace ids of the ranges must not decrease: "20=40"`,
+	}, {
+		vindexType: "numeric_range",
+		ranges:     "10=80,20=8000",
+		err:        `rv: keyspace ids of the ranges must all have the same length: "20=8000"`,
+	}, {
+		vindexType: "time_range",
+		ranges:     "1969-12-31=40",
+		err:        `rv: invalid start of range "1969-12-31=40": time before 1970-01-01 is not supported: '1969-12-31'`,
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.ranges, func(t *testing.T) {
+			_, err := CreateVindex(tc.vindexType, "rv", map[string]string{"ranges": tc.ranges})
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestAddRange(t *testing.T) {
+	testcases := []struct {
+		vindexType, ranges, start, keyspaceID string
+		want, err                             string
+	}{{
+		vindexType: "numeric_range",
+		ranges:     "10=40,1000=80",
+		start:      "100",
+		want:       "10=40,100=40,1000=80",
+	}, {
+		vindexType: "numeric_range",
+		ranges:     "10=40,1000=80",
+		start:      "5000",
+		keyspaceID: "c0",
+		err:        "5000 is in the range starting at 1000, its values can't be moved to another keyspace id",
+	}, {
+		vindexType: "numeric_range",
+		ranges:     "10=40,1000=80",
+		start:      "5",
+		keyspaceID: "40",
+		want:       "5=40,10=40,1000=80",
+	}, {
+		vindexType: "numeric_range",
+		ranges:     "10=40,1000=80",
+		start:      "0",
+		keyspaceID: "00",
+		want:       "0=00,10=40,1000=80",
+	}, {
+		vindexType: "numeric_range",
+		ranges:     "10=40,1000=80",
+		start:      "0",
+		err:        "0 is below the first range, a keyspace id is needed to add a range",
+	}, {
+		vindexType: "numeric_range",
+		ranges:     "10=40,1000=80",
+		start:      "1000",
+		err:        "a range already starts at 1000",
+	}, {
+		vindexType: "numeric_range",
+		ranges:     "10=40,1000=80",
+		start:      "0",
+		keyspaceID: "c0",
+		err:        `keyspace ids of the ranges must not decrease: "10=40"`,
+	}, {
+		vindexType: "time_range",
+		ranges:     "2020-01-01=40,2021-01-01=80",
+		start:      "2022-01-01",
+		keyspaceID: "c0",
+		err:        "2022-01-01 is in the range starting at 2021-01-01, its values can't be moved to another keyspace id",
+	}, {
+		vindexType: "time_range",
+		ranges:     "2020-01-01=40,2021-01-01=80",
+		start:      "2019-01-01",
+		keyspaceID: "00",
+		want:       "2019-01-01=00,2020-01-01=40,2021-01-01=80",
+	}, {
+		vindexType: "time_range",
+		ranges:     "2020-01-01=40",
+		start:      "2020-07-01 12:00:00",
+		want:       "2020-01-01=40,2020-07-01 12:00:00=40",
+	}, {
+		vindexType: "hash",
+		err:        "vindex type hash does not have ranges",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.vindexType+" "+tc.start, func(t *testing.T) {
+			params := map[string]string{"ranges": tc.ranges, "other": "value"}
+			got, err := AddRange(tc.vindexType, params, tc.start, tc.keyspaceID)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"ranges": tc.want, "other": "value"}, got)
+			assert.Equal(t, tc.ranges, params["ranges"])
+		})
+	}
+}
+
+func TestRangeVindexKeyRangeMap(t *testing.T) {
+	vindex := createRangeVindex(t, "numeric_range", "10=40,1000=80").(Ordered)
+	testcases := []struct {
+		start, end sqltypes.Value
+		want       key.Destination
+	}{{
+		start: sqltypes.NewInt64(10),
+		end:   sqltypes.NewInt64(999),
+		want: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: []byte("\x40\x00\x00\x00\x00\x00\x00\x00\x0a"),
+			End:   []byte("\x40\x00\x00\x00\x00\x00\x00\x03\xe7\x00"),
+		}},
+	}, {
+		start: sqltypes.NewInt64(1),
+		end:   sqltypes.NewInt64(2000),
+		want: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			End: []byte("\x80\x00\x00\x00\x00\x00\x00\x07\xd0\x00"),
+		}},
+	}, {
+		start: sqltypes.NewInt64(1000),
+		end:   sqltypes.NULL,
+		want: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: []byte("\x80\x00\x00\x00\x00\x00\x00\x03\xe8"),
+		}},
+	}, {
+		start: sqltypes.NULL,
+		end:   sqltypes.NewInt64(5),
+		want:  key.DestinationNone{},
+	}}
+	for _, tc := range testcases {
+		got, err := vindex.RangeMap(nil, tc.start, tc.end)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got)
+	}
+}
@@ -153,3 +153,8 @@ func (rv *RegionJSON) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value,
 func (rv *RegionJSON) NeedsVCursor() bool {
 	return false
 }
+
+// PartialVindex satisfies the MultiColumn interface.
+func (rv *RegionJSON) PartialVindex() bool {
+	return false
+}
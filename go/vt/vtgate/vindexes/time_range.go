@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var (
+	_ SingleColumn = (*TimeRange)(nil)
+	_ Reversible   = (*TimeRange)(nil)
+	_ Ordered      = (*TimeRange)(nil)
+)
+
+// timeRangeDatetime is the layout of the datetimes returned by the vindex.
+const timeRangeDatetime = "2006-01-02 15:04:05"
+
+// timeRangeLayouts are the accepted layouts of the dates and datetimes, all in UTC.
+var timeRangeLayouts = []string{
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02T15:04:05.999999",
+	"2006-01-02",
+}
+
+// TimeRange is similar to NumericRange, for DATE, DATETIME and TIMESTAMP values.
+// The ranges start at dates or datetimes, e.g. "2020-01-01=40,2021-01-01=80", and
+// the values are sorted by their number of seconds since the epoch. Integral values
+// are considered to be such a number of seconds. It's Unique and Reversible.
+type TimeRange struct {
+	rangeVindex
+}
+
+// NewTimeRange creates a TimeRange vindex.
+func NewTimeRange(name string, params map[string]string) (Vindex, error) {
+	vind, err := newRangeVindex(name, params, parseTimeRangeStart)
+	if err != nil {
+		return nil, err
+	}
+	vind.toNumber = timeRangeSeconds
+	vind.fromNumber = func(num uint64) sqltypes.Value {
+		return sqltypes.MakeTrusted(sqltypes.Datetime, []byte(time.Unix(int64(num), 0).UTC().Format(timeRangeDatetime)))
+	}
+	return &TimeRange{rangeVindex: *vind}, nil
+}
+
+// timeRangeSeconds returns the number of seconds since the epoch of a time value.
+func timeRangeSeconds(v sqltypes.Value) (uint64, error) {
+	if v.IsIntegral() {
+		return evalengine.ToUint64(v)
+	}
+	if !v.IsQuoted() {
+		return 0, fmt.Errorf("could not parse value: '%s'", v.ToString())
+	}
+	return parseTimeRangeStart(v.ToString())
+}
+
+func parseTimeRangeStart(s string) (uint64, error) {
+	for _, layout := range timeRangeLayouts {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+		if t.Unix() < 0 {
+			return 0, fmt.Errorf("time before 1970-01-01 is not supported: '%s'", s)
+		}
+		return uint64(t.Unix()), nil
+	}
+	return 0, fmt.Errorf("could not parse time: '%s'", s)
+}
+
+func formatTimeRangeStart(num uint64) string {
+	t := time.Unix(int64(num), 0).UTC()
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+		return t.Format(timeRangeLayouts[2])
+	}
+	return t.Format(timeRangeDatetime)
+}
+
+func init() {
+	Register("time_range", NewTimeRange)
+}
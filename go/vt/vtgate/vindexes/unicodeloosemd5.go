@@ -26,6 +26,7 @@ import (
 
 var (
 	_ SingleColumn = (*UnicodeLooseMD5)(nil)
+	_ Hashing      = (*UnicodeLooseMD5)(nil)
 )
 
 // UnicodeLooseMD5 is a vindex that normalizes and hashes unicode strings
@@ -88,6 +89,11 @@ func (vind *UnicodeLooseMD5) Map(cursor VCursor, ids []sqltypes.Value) ([]key.De
 	return out, nil
 }
 
+// Hash returns the keyspace id of the id.
+func (vind *UnicodeLooseMD5) Hash(id sqltypes.Value) ([]byte, error) {
+	return unicodeHash(vMD5Hash, id)
+}
+
 func init() {
 	Register("unicode_loose_md5", NewUnicodeLooseMD5)
 }
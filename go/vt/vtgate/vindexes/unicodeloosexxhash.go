@@ -26,6 +26,7 @@ import (
 
 var (
 	_ SingleColumn = (*UnicodeLooseXXHash)(nil)
+	_ Hashing      = (*UnicodeLooseXXHash)(nil)
 )
 
 // UnicodeLooseXXHash is a vindex that normalizes and hashes unicode strings
@@ -88,6 +89,11 @@ func (vind *UnicodeLooseXXHash) Map(cursor VCursor, ids []sqltypes.Value) ([]key
 	return out, nil
 }
 
+// Hash returns the keyspace id of the id.
+func (vind *UnicodeLooseXXHash) Hash(id sqltypes.Value) ([]byte, error) {
+	return unicodeHash(vXXHash, id)
+}
+
 func init() {
 	Register("unicode_loose_xxhash", NewUnicodeLooseXXHash)
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package vindexes
 
 import (
+	"bytes"
 	"fmt"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -25,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -89,6 +91,16 @@ type MultiColumn interface {
 	Vindex
 	Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error)
 	Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error)
+	// PartialVindex returns true if the vindex can map the values of a prefix
+	// of its columns, in which case Map returns a keyspace range.
+	PartialVindex() bool
+}
+
+// A Hashing vindex is one that computes the keyspace id of an id from the id alone.
+// It's being used to compute the bytes of every column of a multicol vindex.
+type Hashing interface {
+	SingleColumn
+	Hash(id sqltypes.Value) ([]byte, error)
 }
 
 // A Reversible vindex is one that can perform a
@@ -109,6 +121,17 @@ type Prefixable interface {
 	PrefixVindex() SingleColumn
 }
 
+// An Ordered vindex is one that maps ids to keyspace ids in the same order,
+// which allows it to map a range of ids to a keyspace range. It's being used
+// to reduce the fan out for range predicates such as 'BETWEEN', '<' and '>'.
+type Ordered interface {
+	SingleColumn
+	// RangeMap returns the keyspace range that contains the keyspace ids of the ids
+	// from start to end, both included. A NULL start or end leaves the range open on
+	// that side. Ids that can't be compared by the vindex also leave the range open.
+	RangeMap(vcursor VCursor, start, end sqltypes.Value) (key.Destination, error)
+}
+
 // A Lookup vindex is one that needs to lookup
 // a previously stored map to compute the keyspace
 // id from an id. This means that the creation of
@@ -190,6 +213,19 @@ func Verify(vindex Vindex, vcursor VCursor, rowsColValues [][]sqltypes.Value, ks
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex '%T' does not have Verify function", vindex)
 }
 
+// keyRangeDestination returns the keyspace range going from the start keyspace id
+// to the end keyspace id, both included. A nil start or end leaves the range open.
+func keyRangeDestination(start, end []byte) key.Destination {
+	if end != nil {
+		if start != nil && bytes.Compare(start, end) > 0 {
+			return key.DestinationNone{}
+		}
+		// the smallest keyspace id greater than end
+		end = append(end[:len(end):len(end)], 0)
+	}
+	return key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: start, End: end}}
+}
+
 func firstColsOnly(rowsColValues [][]sqltypes.Value) []sqltypes.Value {
 	firstCols := make([]sqltypes.Value, 0, len(rowsColValues))
 	for _, val := range rowsColValues {
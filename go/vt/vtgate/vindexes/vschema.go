@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"time"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -93,6 +94,7 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	ResultCacheTTL          time.Duration        `json:"result_cache_ttl,omitempty"`
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -114,16 +116,20 @@ type ColumnVindex struct {
 type Column struct {
 	Name sqlparser.ColIdent `json:"name"`
 	Type querypb.Type       `json:"type"`
+	// CollationName is the MySQL collation of a text column, if it was specified.
+	CollationName string `json:"collation_name"`
 }
 
 // MarshalJSON returns a JSON representation of Column.
 func (col *Column) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		Name string `json:"name"`
-		Type string `json:"type,omitempty"`
+		Name          string `json:"name"`
+		Type          string `json:"type,omitempty"`
+		CollationName string `json:"collation_name,omitempty"`
 	}{
-		Name: col.Name.String(),
-		Type: querypb.Type_name[int32(col.Type)],
+		Name:          col.Name.String(),
+		Type:          querypb.Type_name[int32(col.Type)],
+		CollationName: col.CollationName,
 	})
 }
 
@@ -262,6 +268,13 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			}
 			t.Pinned = decoded
 		}
+		if table.ResultCacheTtl != "" {
+			ttl, err := time.ParseDuration(table.ResultCacheTtl)
+			if err != nil || ttl <= 0 {
+				return fmt.Errorf("invalid result cache ttl %q for table: %s", table.ResultCacheTtl, tname)
+			}
+			t.ResultCacheTTL = ttl
+		}
 
 		// If keyspace is sharded, then any table that's not a reference or pinned must have vindexes.
 		if keyspace.Sharded && t.Type != TypeReference && table.Pinned == "" && len(table.ColumnVindexes) == 0 {
@@ -276,7 +289,7 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 				return fmt.Errorf("duplicate column name '%v' for table: %s", name, tname)
 			}
 			colNames[name.Lowered()] = true
-			t.Columns = append(t.Columns, Column{Name: name, Type: col.Type})
+			t.Columns = append(t.Columns, Column{Name: name, Type: col.Type, CollationName: col.CollationName})
 		}
 
 		// Initialize ColumnVindexes.
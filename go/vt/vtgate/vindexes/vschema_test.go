@@ -22,6 +22,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 
@@ -372,6 +373,38 @@ func TestVSchemaColumnListAuthoritative(t *testing.T) {
 	}
 }
 
+func TestVSchemaColumnCollations(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						Columns: []*vschemapb.Column{{
+							Name: "c1",
+							Type: sqltypes.VarChar,
+						}, {
+							Name:          "c2",
+							Type:          sqltypes.VarChar,
+							CollationName: "utf8mb4_general_ci",
+						}},
+					},
+				},
+			},
+		},
+	}
+	got := BuildVSchema(&good)
+	require.NoError(t, got.Keyspaces["unsharded"].Error)
+	want := []Column{{
+		Name: sqlparser.NewColIdent("c1"),
+		Type: sqltypes.VarChar,
+	}, {
+		Name:          sqlparser.NewColIdent("c2"),
+		Type:          sqltypes.VarChar,
+		CollationName: "utf8mb4_general_ci",
+	}}
+	assert.Equal(t, want, got.Keyspaces["unsharded"].Tables["t1"].Columns)
+}
+
 func TestVSchemaColumnsFail(t *testing.T) {
 	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -451,6 +484,29 @@ func TestVSchemaPinned(t *testing.T) {
 	}
 }
 
+func TestVSchemaResultCacheTTL(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ResultCacheTtl: "5s",
+					},
+					"t2": {},
+				},
+			},
+		},
+	}
+	got := BuildVSchema(&good)
+	require.NoError(t, got.Keyspaces["unsharded"].Error)
+	assert.Equal(t, 5*time.Second, got.Keyspaces["unsharded"].Tables["t1"].ResultCacheTTL)
+	assert.Zero(t, got.Keyspaces["unsharded"].Tables["t2"].ResultCacheTTL)
+
+	good.Keyspaces["unsharded"].Tables["t1"].ResultCacheTtl = "5"
+	got = BuildVSchema(&good)
+	require.EqualError(t, got.Keyspaces["unsharded"].Error, `invalid result cache ttl "5" for table: t1`)
+}
+
 func TestShardedVSchemaOwned(t *testing.T) {
 	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
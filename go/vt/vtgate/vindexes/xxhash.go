@@ -28,6 +28,7 @@ import (
 
 var (
 	_ SingleColumn = (*XXHash)(nil)
+	_ Hashing      = (*XXHash)(nil)
 )
 
 // XXHash defines vindex that hashes any sql types to a KeyspaceId
@@ -81,6 +82,11 @@ func (vind *XXHash) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]b
 	return out, nil
 }
 
+// Hash returns the keyspace id of the id.
+func (vind *XXHash) Hash(id sqltypes.Value) ([]byte, error) {
+	return vXXHash(id.ToBytes()), nil
+}
+
 func init() {
 	Register("xxhash", NewXXHash)
 }
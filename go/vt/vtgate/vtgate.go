@@ -66,6 +66,8 @@ var (
 	_                    = flag.Bool("disable_local_gateway", false, "deprecated: if specified, this process will not route any queries to local tablets in the local cell")
 	maxMemoryRows        = flag.Int("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
 	warnMemoryRows       = flag.Int("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
+	spillDir             = flag.String("spill_dir", "", "Directory in which in-memory sorts, distincts and distinct aggregations write their rows to temporary files once they exceed max_memory_rows. Outside of transactions, their input is streamed so that it can be spilled as well. Spilling to disk is disabled if empty.")
+	maxSpillBytes        = flag.Int64("max_spill_bytes", 1<<30, "Maximum number of bytes a single query can write to spill_dir.")
 	defaultDDLStrategy   = flag.String("ddl_strategy", string(schema.DDLStrategyDirect), "Set default strategy for DDL statements. Override with @@ddl_strategy session variable")
 	dbDDLPlugin          = flag.String("dbddl_plugin", "fail", "controls how to handle CREATE/DROP DATABASE. use it if you are using your own database provisioning service")
 	noScatter            = flag.Bool("no_scatter", false, "when set to true, the planner will fail instead of producing a plan that includes scatter queries")
@@ -95,6 +97,18 @@ var (
 
 	enableSchemaChangeSignal = flag.Bool("schema_change_signal", false, "Enable the schema tracker")
 	schemaChangeUser         = flag.String("schema_change_signal_user", "", "User to be used to send down query to vttablet to retrieve schema changes")
+
+	// sequenceCacheBlockSize is also the maximum number of values of each sequence lost when vtgate restarts.
+	sequenceCacheBlockSize = flag.Int64("sequence_cache_block_size", 0, "Number of values vtgate reserves at a time from each sequence table, so that inserts generating sequence values do not need a round trip to the sequence tablet. At most this number of values of each sequence are wasted when vtgate restarts. Values are not cached by vtgate if lower than 2.")
+
+	// flags of the cache of the results of the selects annotated with CACHE_TTL, or reading tables with a result_cache_ttl
+	resultCacheSize         = flag.Int64("result_cache_size", 1000, "result cache size, expected number of query results to be cached.")
+	resultCacheMemory       = flag.Int64("result_cache_memory", 0, "result cache size in bytes, maximum amount of memory used by the cached query results. The result cache is disabled if 0.")
+	resultCacheInvalidation = flag.Bool("result_cache_invalidation", false, "Invalidate the cached results of the queries when the tables they read change, by streaming the changes of their keyspaces from the primaries. Otherwise results are only invalidated by their TTL.")
+
+	// flags of the query rules of vtgate, enforced before the queries are sent to the shards
+	queryRulesCell = flag.String("vtgate_query_rules_cell", "global", "topo cell of the query rules file of vtgate.")
+	queryRulesPath = flag.String("vtgate_query_rules_path", "", "path of the query rules file of vtgate in topo, in the JSON format of the query rules of vttablet. Query rules are disabled in vtgate if empty.")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -217,6 +231,25 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 	}
 
 	executor := NewExecutor(ctx, serv, cell, resolver, *normalizeQueries, *warnShardedOnly, *streamBufferSize, cacheCfg, si, *noScatter)
+	if *resultCacheInvalidation {
+		executor.results.watchKeyspaces(ctx, func(ctx context.Context, keyspace string, send func(events []*binlogdatapb.VEvent) error) error {
+			vgtid := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: keyspace, Gtid: "current"}}}
+			return vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, nil, nil, send)
+		})
+	}
+
+	if *queryRulesPath != "" {
+		ts, err := serv.GetTopoServer()
+		if err != nil {
+			log.Fatalf("Unable to get the topo server for the query rules: %v", err)
+		}
+		qw, err := newQueryRulesWatcher(ctx, executor, ts, *queryRulesCell, *queryRulesPath)
+		if err != nil {
+			log.Fatalf("Unable to watch the query rules: %v", err)
+		}
+		qw.start()
+		servenv.OnTerm(qw.stop)
+	}
 
 	// connect the schema tracker with the vschema manager
 	if *enableSchemaChangeSignal {
@@ -358,17 +391,25 @@ func (vtg *VTGate) Gateway() Gateway {
 
 // Execute executes a non-streaming query. This is a V3 function.
 func (vtg *VTGate) Execute(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable) (newSession *vtgatepb.Session, qr *sqltypes.Result, err error) {
+	return vtg.execute(ctx, session, sql, bindVariables, nil)
+}
+
+// execute executes a non-streaming query, with the plan of the prepared
+// statement it comes from, if any.
+func (vtg *VTGate) execute(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable, prepared *preparedPlan) (newSession *vtgatepb.Session, qr *sqltypes.Result, err error) {
 	// In this context, we don't care if we can't fully parse destination
 	destKeyspace, destTabletType, _, _ := vtg.executor.ParseDestinationTarget(session.TargetString)
 	statsKey := []string{"Execute", destKeyspace, topoproto.TabletTypeLString(destTabletType)}
 	defer vtg.timings.Record(statsKey, time.Now())
 
+	safeSession := NewSafeSession(session)
+	safeSession.preparedPlan = prepared
 	if bvErr := sqltypes.ValidateBindVariables(bindVariables); bvErr != nil {
 		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", bvErr)
 		goto handleError
 	}
 
-	qr, err = vtg.executor.Execute(ctx, "Execute", NewSafeSession(session), sql, bindVariables)
+	qr, err = vtg.executor.Execute(ctx, "Execute", safeSession, sql, bindVariables)
 	if err == nil {
 		vtg.rowsReturned.Add(statsKey, int64(len(qr.Rows)))
 		vtg.rowsAffected.Add(statsKey, int64(qr.RowsAffected))
@@ -417,6 +458,12 @@ func (vtg *VTGate) ExecuteBatch(ctx context.Context, session *vtgatepb.Session,
 // Note we guarantee the callback will not be called concurrently
 // by multiple go routines.
 func (vtg *VTGate) StreamExecute(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error) error {
+	return vtg.streamExecute(ctx, session, sql, bindVariables, nil, callback)
+}
+
+// streamExecute executes a streaming query, with the plan of the prepared
+// statement it comes from, if any.
+func (vtg *VTGate) streamExecute(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable, prepared *preparedPlan, callback func(*sqltypes.Result) error) error {
 	// In this context, we don't care if we can't fully parse destination
 	destKeyspace, destTabletType, _, _ := vtg.executor.ParseDestinationTarget(session.TargetString)
 	statsKey := []string{"StreamExecute", destKeyspace, topoproto.TabletTypeLString(destTabletType)}
@@ -427,10 +474,12 @@ func (vtg *VTGate) StreamExecute(ctx context.Context, session *vtgatepb.Session,
 	if bvErr := sqltypes.ValidateBindVariables(bindVariables); bvErr != nil {
 		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", bvErr)
 	} else {
+		safeSession := NewSafeSession(session)
+		safeSession.preparedPlan = prepared
 		err = vtg.executor.StreamExecute(
 			ctx,
 			"StreamExecute",
-			NewSafeSession(session),
+			safeSession,
 			sql,
 			bindVariables,
 			&querypb.Target{
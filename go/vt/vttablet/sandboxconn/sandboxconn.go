@@ -116,6 +116,11 @@ type SandboxConn struct {
 	EphemeralShardErr error
 
 	NotServing bool
+
+	// ExecuteStarted, if not nil, makes Execute send on it once the query
+	// is recorded, then wait until its context is done, like a query that
+	// runs until it is killed.
+	ExecuteStarted chan struct{}
 }
 
 var _ queryservice.QueryService = (*SandboxConn)(nil) // compile-time interface check
@@ -170,6 +175,11 @@ func (sbc *SandboxConn) Execute(ctx context.Context, target *querypb.Target, que
 		BindVariables: bv,
 	})
 	sbc.Options = append(sbc.Options, options)
+	if sbc.ExecuteStarted != nil {
+		sbc.ExecuteStarted <- struct{}{}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
 	if err := sbc.getError(); err != nil {
 		return nil, err
 	}
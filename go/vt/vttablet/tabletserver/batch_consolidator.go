@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// BatchConsolidator merges the concurrent point lookups of a table by its primary
+// key that only differ by the key into batches, so that MySQL executes a single
+// query with an IN clause for each batch instead of one query for each lookup.
+type BatchConsolidator struct {
+	window  time.Duration
+	maxSize int
+
+	mu       sync.Mutex
+	inflight map[string]*lookupBatch
+	// executing counts the lookups of each batched query that are being
+	// executed, alone or as a batch.
+	executing map[string]int
+
+	// batches counts the batched queries sent to MySQL by table,
+	// and batchedQueries counts the lookups they served.
+	batches        *stats.CountersWithSingleLabel
+	batchedQueries *stats.CountersWithSingleLabel
+}
+
+// lookupBatch is a batch of point lookups. Its keys and lookups are
+// protected by BatchConsolidator.mu until the batch is executed.
+type lookupBatch struct {
+	keys    []sqltypes.Value
+	seen    map[string]bool
+	lookups int
+	// full is closed when the batch reaches its max size.
+	full chan struct{}
+	// done is closed once rows and err are set.
+	done chan struct{}
+	rows map[string][][]sqltypes.Value
+	err  error
+}
+
+// NewBatchConsolidator returns a BatchConsolidator that waits up to window for
+// lookups to join a batch, and batches up to maxSize keys at once.
+func NewBatchConsolidator(env tabletenv.Env, window time.Duration, maxSize int) *BatchConsolidator {
+	return &BatchConsolidator{
+		window:         window,
+		maxSize:        maxSize,
+		inflight:       make(map[string]*lookupBatch),
+		executing:      make(map[string]int),
+		batches:        env.Exporter().NewCountersWithSingleLabel("ConsolidatorBatches", "Number of batched point lookups sent to MySQL", "Table"),
+		batchedQueries: env.Exporter().NewCountersWithSingleLabel("ConsolidatorBatchedQueries", "Number of point lookups merged into batches", "Table"),
+	}
+}
+
+// Consolidate returns the rows of the point lookup of key on table. The lookups
+// that have the same batched query, whose SQL is passed as `query`, share a batch.
+// A lookup calls `exec` to execute its own query right away if no other lookup of
+// the same query is being executed. Otherwise, the first lookup of a batch waits for
+// the batching window, or until the batch is full, before it executes the batch: it
+// calls `execBatch` with the keys of the batch, which must return their rows with the
+// key as their last column. If no other lookup joined the batch, it calls `exec`.
+func (bc *BatchConsolidator) Consolidate(logStats *tabletenv.LogStats, table, query string, key sqltypes.Value, exec func() (*sqltypes.Result, error), execBatch func(keys []sqltypes.Value) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	key, keyString := canonicalKey(key)
+
+	bc.mu.Lock()
+	if b, ok := bc.inflight[query]; ok {
+		b.lookups++
+		if !b.seen[keyString] {
+			b.seen[keyString] = true
+			b.keys = append(b.keys, key)
+			if len(b.keys) >= bc.maxSize {
+				delete(bc.inflight, query)
+				close(b.full)
+			}
+		}
+		bc.mu.Unlock()
+
+		logStats.QuerySources |= tabletenv.QuerySourceConsolidator
+		<-b.done
+		if b.err != nil {
+			return nil, b.err
+		}
+		return &sqltypes.Result{Rows: b.rows[keyString]}, nil
+	}
+	bc.executing[query]++
+	if bc.executing[query] == 1 {
+		// There is nothing to wait for: the lookups that arrive while
+		// this one is executed are batched together instead.
+		bc.mu.Unlock()
+		defer bc.doneExecuting(query)
+		return exec()
+	}
+	b := &lookupBatch{
+		keys:    []sqltypes.Value{key},
+		seen:    map[string]bool{keyString: true},
+		lookups: 1,
+		full:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	if bc.maxSize <= 1 {
+		close(b.full)
+	} else {
+		bc.inflight[query] = b
+	}
+	bc.mu.Unlock()
+	defer bc.doneExecuting(query)
+
+	timer := time.NewTimer(bc.window)
+	select {
+	case <-timer.C:
+	case <-b.full:
+		timer.Stop()
+	}
+	bc.mu.Lock()
+	if bc.inflight[query] == b {
+		delete(bc.inflight, query)
+	}
+	keys, lookups := b.keys, b.lookups
+	bc.mu.Unlock()
+	defer close(b.done)
+
+	if lookups == 1 {
+		return exec()
+	}
+	bc.batches.Add(table, 1)
+	bc.batchedQueries.Add(table, int64(lookups))
+	result, err := execBatch(keys)
+	if err != nil {
+		b.err = err
+		return nil, err
+	}
+	b.rows = make(map[string][][]sqltypes.Value, len(keys))
+	for _, row := range result.Rows {
+		last := len(row) - 1
+		_, k := canonicalKey(row[last])
+		b.rows[k] = append(b.rows[k], row[:last])
+	}
+	return &sqltypes.Result{Rows: b.rows[keyString]}, nil
+}
+
+func (bc *BatchConsolidator) doneExecuting(query string) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	if bc.executing[query]--; bc.executing[query] == 0 {
+		delete(bc.executing, query)
+	}
+}
+
+// canonicalKey returns the integral key in the form MySQL returns it, e.g. 7
+// for 007 or +7, along with its string, so that the rows of a batch can be
+// matched with the keys of its lookups.
+func canonicalKey(key sqltypes.Value) (sqltypes.Value, string) {
+	var s string
+	switch {
+	case key.IsSigned():
+		v, err := key.ToInt64()
+		if err != nil {
+			return key, key.ToString()
+		}
+		s = strconv.FormatInt(v, 10)
+	case key.IsUnsigned():
+		v, err := key.ToUint64()
+		if err != nil {
+			return key, key.ToString()
+		}
+		s = strconv.FormatUint(v, 10)
+	default:
+		return key, key.ToString()
+	}
+	return sqltypes.MakeTrusted(key.Type(), []byte(s)), s
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+const batchTestQuery = "select balance, id from acct where id in ::#batchKeys"
+
+func newTestBatchConsolidator(t *testing.T, window time.Duration, maxSize int) *BatchConsolidator {
+	return NewBatchConsolidator(tabletenv.NewEnv(tabletenv.NewDefaultConfig(), t.Name()), window, maxSize)
+}
+
+// waitLookups waits until n lookups joined the batch of query.
+func waitLookups(t *testing.T, bc *BatchConsolidator, query string, n int) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		bc.mu.Lock()
+		b := bc.inflight[query]
+		joined := b != nil && b.lookups == n
+		bc.mu.Unlock()
+		if joined {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("%d lookups did not join the batch", n)
+}
+
+// blockLookups starts a lookup of query that is executed until release is called,
+// so that the next lookups of query are batched.
+func blockLookups(t *testing.T, bc *BatchConsolidator, query string) (release func()) {
+	t.Helper()
+	unblock, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		logStats := tabletenv.NewLogStats(context.Background(), t.Name())
+		exec := func() (*sqltypes.Result, error) {
+			<-unblock
+			return &sqltypes.Result{}, nil
+		}
+		execBatch := func(keys []sqltypes.Value) (*sqltypes.Result, error) {
+			return nil, fmt.Errorf("unexpected batch of %v", keys)
+		}
+		if _, err := bc.Consolidate(logStats, "acct", query, sqltypes.NewInt64(0), exec, execBatch); err != nil {
+			t.Errorf("Consolidate() failed: %v", err)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		bc.mu.Lock()
+		executing := bc.executing[query]
+		bc.mu.Unlock()
+		if executing == 1 {
+			return func() {
+				close(unblock)
+				<-done
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("the lookup was not executed")
+	return nil
+}
+
+func TestBatchConsolidator(t *testing.T) {
+	bc := newTestBatchConsolidator(t, 10*time.Second, 3)
+	batchesBefore, batchedQueriesBefore := bc.batches.Counts()["acct"], bc.batchedQueries.Counts()["acct"]
+	release := blockLookups(t, bc, batchTestQuery)
+
+	var batchKeys []sqltypes.Value
+	exec := func() (*sqltypes.Result, error) {
+		t.Errorf("the lookup was not batched")
+		return nil, nil
+	}
+	execBatch := func(keys []sqltypes.Value) (*sqltypes.Result, error) {
+		batchKeys = keys
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("balance|id", "int64|int64"),
+			"10|1",
+			"20|2",
+			"21|2",
+		), nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*sqltypes.Result, 4)
+	lookup := func(i int, key int64) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logStats := tabletenv.NewLogStats(context.Background(), "TestBatchConsolidator")
+			result, err := bc.Consolidate(logStats, "acct", batchTestQuery, sqltypes.NewInt64(key), exec, execBatch)
+			if err != nil {
+				t.Errorf("Consolidate() failed: %v", err)
+				return
+			}
+			results[i] = result
+		}()
+	}
+	// The batch is executed once it is full, with the distinct keys of its lookups.
+	for i, key := range []int64{1, 2, 2, 3} {
+		lookup(i, key)
+		if i < 3 {
+			waitLookups(t, bc, batchTestQuery, i+1)
+		}
+	}
+	wg.Wait()
+	release()
+
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(3)}, batchKeys)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(10)}}, results[0].Rows)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(20)}, {sqltypes.NewInt64(21)}}, results[1].Rows)
+	assert.Equal(t, results[1].Rows, results[2].Rows)
+	assert.Empty(t, results[3].Rows)
+	assert.EqualValues(t, 1, bc.batches.Counts()["acct"]-batchesBefore)
+	assert.EqualValues(t, 4, bc.batchedQueries.Counts()["acct"]-batchedQueriesBefore)
+	assert.Empty(t, bc.inflight)
+	assert.Empty(t, bc.executing)
+}
+
+func TestBatchConsolidatorSingleLookup(t *testing.T) {
+	bc := newTestBatchConsolidator(t, 10*time.Second, 100)
+	batchesBefore := bc.batches.Counts()["acct"]
+
+	// A lookup executes its own query without waiting for the batching
+	// window when no other lookup of its query is executed.
+	want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("balance", "int64"), "10")
+	exec := func() (*sqltypes.Result, error) {
+		return want, nil
+	}
+	execBatch := func(keys []sqltypes.Value) (*sqltypes.Result, error) {
+		return nil, fmt.Errorf("unexpected batch of %v", keys)
+	}
+	logStats := tabletenv.NewLogStats(context.Background(), "TestBatchConsolidatorSingleLookup")
+	start := time.Now()
+	got, err := bc.Consolidate(logStats, "acct", batchTestQuery, sqltypes.NewInt64(1), exec, execBatch)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Zero(t, logStats.QuerySources&tabletenv.QuerySourceConsolidator)
+	assert.Equal(t, batchesBefore, bc.batches.Counts()["acct"])
+	assert.Empty(t, bc.executing)
+
+	// A lookup that no other lookup joined executes its own query too.
+	bc.window = time.Millisecond
+	release := blockLookups(t, bc, batchTestQuery)
+	defer release()
+	got, err = bc.Consolidate(logStats, "acct", batchTestQuery, sqltypes.NewInt64(1), exec, execBatch)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Equal(t, batchesBefore, bc.batches.Counts()["acct"])
+}
+
+func TestBatchConsolidatorNonCanonicalKey(t *testing.T) {
+	bc := newTestBatchConsolidator(t, 10*time.Second, 2)
+	release := blockLookups(t, bc, batchTestQuery)
+	defer release()
+
+	exec := func() (*sqltypes.Result, error) {
+		return nil, errors.New("the lookup was not batched")
+	}
+	var batchKeys []sqltypes.Value
+	execBatch := func(keys []sqltypes.Value) (*sqltypes.Result, error) {
+		batchKeys = keys
+		// MySQL returns the keys in their canonical form.
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("balance|id", "int64|int64"),
+			"10|1",
+			"70|7",
+		), nil
+	}
+	leaderResult := make(chan *sqltypes.Result)
+	go func() {
+		logStats := tabletenv.NewLogStats(context.Background(), "TestBatchConsolidatorNonCanonicalKey")
+		result, err := bc.Consolidate(logStats, "acct", batchTestQuery, sqltypes.NewInt64(1), exec, execBatch)
+		if err != nil {
+			t.Errorf("Consolidate() failed: %v", err)
+		}
+		leaderResult <- result
+	}()
+	waitLookups(t, bc, batchTestQuery, 1)
+	logStats := tabletenv.NewLogStats(context.Background(), "TestBatchConsolidatorNonCanonicalKey")
+	got, err := bc.Consolidate(logStats, "acct", batchTestQuery, sqltypes.MakeTrusted(sqltypes.Int64, []byte("007")), exec, execBatch)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(70)}}, got.Rows)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(10)}}, (<-leaderResult).Rows)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(7)}, batchKeys)
+}
+
+func TestBatchConsolidatorError(t *testing.T) {
+	bc := newTestBatchConsolidator(t, 10*time.Second, 2)
+	release := blockLookups(t, bc, batchTestQuery)
+	defer release()
+
+	exec := func() (*sqltypes.Result, error) {
+		return nil, errors.New("the lookup was not batched")
+	}
+	execBatch := func(keys []sqltypes.Value) (*sqltypes.Result, error) {
+		return nil, errors.New("batch failed")
+	}
+	leaderErr := make(chan error)
+	go func() {
+		logStats := tabletenv.NewLogStats(context.Background(), "TestBatchConsolidatorError")
+		_, err := bc.Consolidate(logStats, "acct", batchTestQuery, sqltypes.NewInt64(1), exec, execBatch)
+		leaderErr <- err
+	}()
+	waitLookups(t, bc, batchTestQuery, 1)
+	logStats := tabletenv.NewLogStats(context.Background(), "TestBatchConsolidatorError")
+	_, err := bc.Consolidate(logStats, "acct", batchTestQuery, sqltypes.NewInt64(2), exec, execBatch)
+	assert.EqualError(t, err, "batch failed")
+	assert.NotZero(t, logStats.QuerySources&tabletenv.QuerySourceConsolidator)
+	assert.EqualError(t, <-leaderErr, "batch failed")
+}
@@ -57,6 +57,11 @@ type DBConn struct {
 	// err will be set if a query is killed through a Kill.
 	errmu sync.Mutex
 	err   error
+
+	// priorityDone is set if the connection was handed out by a
+	// priority.Scheduler. It must be called when the connection
+	// is returned to the pool.
+	priorityDone func()
 }
 
 // NewDBConn creates a new DBConn. It triggers a CheckMySQL if creation fails.
@@ -316,6 +321,10 @@ func (dbc *DBConn) IsClosed() bool {
 
 // Recycle returns the DBConn to the pool.
 func (dbc *DBConn) Recycle() {
+	if done := dbc.priorityDone; done != nil {
+		dbc.priorityDone = nil
+		defer done()
+	}
 	switch {
 	case dbc.pool == nil:
 		dbc.Close()
@@ -331,6 +340,10 @@ func (dbc *DBConn) Taint() {
 	if dbc.pool == nil {
 		return
 	}
+	if done := dbc.priorityDone; done != nil {
+		dbc.priorityDone = nil
+		defer done()
+	}
 	dbc.pool.Put(nil)
 	dbc.pool = nil
 }
@@ -34,6 +34,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/priority"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -61,6 +62,8 @@ type Pool struct {
 	waiterCount        sync2.AtomicInt64
 	dbaPool            *dbconnpool.ConnectionPool
 	appDebugParams     dbconfigs.Connector
+	// scheduler is nil if the requests are not prioritized.
+	scheduler *priority.Scheduler
 }
 
 // NewPool creates a new Pool. The name is used
@@ -93,6 +96,12 @@ func NewPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig) *Pool
 	return cp
 }
 
+// Prioritize puts a priority.Scheduler in front of the pool if there are
+// priority classes. It must be called before Open.
+func (cp *Pool) Prioritize() {
+	cp.scheduler = priority.NewScheduler(cp.env, cp.name, cp.capacity)
+}
+
 func (cp *Pool) pool() (p *pools.ResourcePool) {
 	cp.mu.Lock()
 	p = cp.connections
@@ -181,11 +190,23 @@ func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 		ctx, cancel = context.WithTimeout(ctx, cp.timeout)
 		defer cancel()
 	}
+	var done func()
+	if cp.scheduler != nil {
+		var err error
+		if done, err = cp.scheduler.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
 	r, err := p.Get(ctx)
 	if err != nil {
+		if done != nil {
+			done()
+		}
 		return nil, err
 	}
-	return r.(*DBConn), nil
+	conn := r.(*DBConn)
+	conn.priorityDone = done
+	return conn, nil
 }
 
 // Put puts a connection into the pool.
@@ -212,6 +233,9 @@ func (cp *Pool) SetCapacity(capacity int) (err error) {
 		}
 	}
 	cp.capacity = capacity
+	if cp.scheduler != nil {
+		cp.scheduler.SetCapacity(capacity)
+	}
 	return nil
 }
 
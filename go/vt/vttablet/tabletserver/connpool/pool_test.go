@@ -282,6 +282,47 @@ func TestConnPoolStateWhilePoolIsOpen(t *testing.T) {
 	}
 }
 
+func TestConnPoolPrioritized(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	config := tabletenv.NewDefaultConfig()
+	config.Priority.Classes = []tabletenv.PriorityClassConfig{{
+		Name:   "oltp",
+		Weight: 4,
+	}, {
+		Name:                "batch",
+		Weight:              1,
+		MaxConcurrency:      1,
+		QueueTimeoutSeconds: 0.01,
+	}}
+	config.Priority.Users = map[string]string{"report": "batch"}
+	connPool := NewPool(tabletenv.NewEnv(config, "PoolPriorityTest"), "TestPool", tabletenv.ConnPoolConfig{
+		Size:               2,
+		IdleTimeoutSeconds: 10,
+	})
+	connPool.Prioritize()
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+
+	batchCtx := callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("report"))
+	batchConn, err := connPool.Get(batchCtx)
+	require.NoError(t, err)
+	_, err = connPool.Get(batchCtx)
+	assert.EqualError(t, err, "TestPool: queue timeout of priority class batch exceeded (10ms)")
+
+	// The other classes still get the connections left.
+	oltpConn, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+	oltpConn.Recycle()
+
+	// Taint gives the slot of the connection back, like Recycle.
+	batchConn.Taint()
+	batchConn.Close()
+	batchConn, err = connPool.Get(batchCtx)
+	require.NoError(t, err)
+	batchConn.Recycle()
+}
+
 func newPool() *Pool {
 	return NewPool(tabletenv.NewEnv(nil, "PoolTest"), "TestPool", tabletenv.ConnPoolConfig{
 		Size:               100,
@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"strings"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
@@ -55,10 +56,74 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 		plan.NextCount = v
 		plan.FieldQuery = nil
 		plan.FullQuery = nil
+		return plan, nil
 	}
+	plan.Batch = analyzeBatch(sel, plan.Table)
 	return plan, nil
 }
 
+// analyzeBatch returns the BatchQuery of the select if it is a point lookup
+// of a table by its integral primary key, or nil if it is not.
+func analyzeBatch(sel *sqlparser.Select, table *schema.Table) *BatchQuery {
+	if table == nil || table.Type != schema.NoType || len(table.PKColumns) != 1 || table.PKColumns[0] >= len(table.Fields) {
+		return nil
+	}
+	if sel.Distinct || sel.SQLCalcFoundRows || sel.GroupBy != nil || sel.Having != nil || sel.Windows != nil ||
+		sel.OrderBy != nil || sel.Limit != nil || sel.Lock != sqlparser.NoLock || sel.Into != nil || sel.With != nil {
+		return nil
+	}
+	if sel.Where == nil {
+		return nil
+	}
+	comp, ok := sel.Where.Expr.(*sqlparser.ComparisonExpr)
+	if !ok || comp.Operator != sqlparser.EqualOp {
+		return nil
+	}
+	col, ok := comp.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	pk := table.Fields[table.PKColumns[0]]
+	if !col.Name.EqualString(pk.Name) || !sqltypes.IsIntegral(pk.Type) {
+		return nil
+	}
+	key, err := sqlparser.NewPlanValue(comp.Right)
+	if err != nil || key.IsList() || (key.Key == "" && !key.Value.IsIntegral()) {
+		return nil
+	}
+	// The select expressions must yield the same rows for every key,
+	// so they may not contain bind variables, subqueries or aggregates.
+	batchable := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node.(type) {
+		case sqlparser.Argument, sqlparser.ListArg, *sqlparser.Subquery:
+			batchable = false
+		default:
+			if sqlparser.IsAggregation(node) {
+				batchable = false
+			}
+		}
+		return batchable, nil
+	}, sel.SelectExprs)
+	if !batchable {
+		return nil
+	}
+
+	batch := &sqlparser.Select{
+		SelectExprs: append(append(sqlparser.SelectExprs{}, sel.SelectExprs...), &sqlparser.AliasedExpr{Expr: col}),
+		From:        sel.From,
+		Where: sqlparser.NewWhere(sqlparser.WhereClause, &sqlparser.ComparisonExpr{
+			Operator: sqlparser.InOp,
+			Left:     col,
+			Right:    sqlparser.ListArg(BatchKeys),
+		}),
+	}
+	return &BatchQuery{
+		Query: GenerateFullQuery(batch),
+		Key:   key,
+	}
+}
+
 // analyzeUpdate code is almost identical to analyzeDelete.
 func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan *Plan, err error) {
 	plan = &Plan{
@@ -72,6 +137,7 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 		buf.Myprintf("%v", upd.Where)
 		plan.WhereClause = buf.ParsedQuery()
 	}
+	plan.RowKeys = analyzeRowKeys(upd.Where, plan.Table)
 
 	// Situations when we pass-through:
 	// PassthroughDMLs flag is set.
@@ -101,6 +167,7 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 		buf.Myprintf("%v", del.Where)
 		plan.WhereClause = buf.ParsedQuery()
 	}
+	plan.RowKeys = analyzeRowKeys(del.Where, plan.Table)
 
 	if PassthroughDMLs || plan.Table == nil || del.Limit != nil {
 		plan.FullQuery = GenerateFullQuery(del)
@@ -113,6 +180,73 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 	return plan, nil
 }
 
+// analyzeRowKeys returns the RowKeys of a DML if its where clause restricts
+// all the columns of the primary key or of a unique key of the table to
+// values or, for at most one of the columns, to an IN list. It returns nil
+// if the DML may change other rows.
+func analyzeRowKeys(where *sqlparser.Where, table *schema.Table) *RowKeys {
+	if where == nil || table == nil || table.Type != schema.NoType {
+		return nil
+	}
+	values := make(map[string]sqltypes.PlanValue)
+	for _, expr := range sqlparser.SplitAndExpression(nil, where.Expr) {
+		comp, ok := expr.(*sqlparser.ComparisonExpr)
+		if !ok || (comp.Operator != sqlparser.EqualOp && comp.Operator != sqlparser.InOp) {
+			continue
+		}
+		col, ok := comp.Left.(*sqlparser.ColName)
+		if !ok {
+			continue
+		}
+		value, err := sqlparser.NewPlanValue(comp.Right)
+		if err != nil || value.IsList() != (comp.Operator == sqlparser.InOp) {
+			continue
+		}
+		if _, ok := values[col.Name.Lowered()]; !ok {
+			values[col.Name.Lowered()] = value
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	for _, key := range append([][]int{table.PKColumns}, table.UniqueKeys...) {
+		if rowKeys := matchRowKeys(key, values, table); rowKeys != nil {
+			return rowKeys
+		}
+	}
+	return nil
+}
+
+// matchRowKeys returns the RowKeys of the key columns if values has a value
+// for each of them, or nil if it has not.
+func matchRowKeys(key []int, values map[string]sqltypes.PlanValue, table *schema.Table) *RowKeys {
+	if len(key) == 0 {
+		return nil
+	}
+	rowKeys := &RowKeys{}
+	lists := 0
+	for _, index := range key {
+		if index >= len(table.Fields) {
+			return nil
+		}
+		col := sqlparser.NewColIdent(table.Fields[index].Name)
+		value, ok := values[col.Lowered()]
+		if !ok {
+			return nil
+		}
+		if value.IsList() {
+			lists++
+		}
+		rowKeys.Columns = append(rowKeys.Columns, col)
+		rowKeys.Values = append(rowKeys.Values, value)
+	}
+	if lists > 1 {
+		return nil
+	}
+	return rowKeys
+}
+
 func analyzeInsert(ins *sqlparser.Insert, tables map[string]*schema.Table) (plan *Plan, err error) {
 	plan = &Plan{
 		PlanID:    PlanInsert,
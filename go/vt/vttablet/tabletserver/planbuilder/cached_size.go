@@ -23,6 +23,20 @@ type cachedObject interface {
 	CachedSize(alloc bool) int64
 }
 
+func (cached *BatchQuery) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(104)
+	}
+	// field Query *vitess.io/vitess/go/vt/sqlparser.ParsedQuery
+	size += cached.Query.CachedSize(true)
+	// field Key vitess.io/vitess/go/sqltypes.PlanValue
+	size += cached.Key.CachedSize(false)
+	return size
+}
 func (cached *Permission) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -41,7 +55,7 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(176)
+		size += int64(208)
 	}
 	// field Table *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.Table
 	size += cached.Table.CachedSize(true)
@@ -64,5 +78,35 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	if cc, ok := cached.FullStmt.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
+	// field PriorityClass string
+	size += hack.RuntimeAllocSize(int64(len(cached.PriorityClass)))
+	// field Batch *vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder.BatchQuery
+	size += cached.Batch.CachedSize(true)
+	// field RowKeys *vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder.RowKeys
+	size += cached.RowKeys.CachedSize(true)
+	return size
+}
+func (cached *RowKeys) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Columns []vitess.io/vitess/go/vt/sqlparser.ColIdent
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Values []vitess.io/vitess/go/sqltypes.PlanValue
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Values)) * int64(88))
+		for _, elem := range cached.Values {
+			size += elem.CachedSize(false)
+		}
+	}
 	return size
 }
@@ -171,6 +171,44 @@ type Plan struct {
 
 	// FullStmt can be used when the query does not operate on tables
 	FullStmt sqlparser.Statement
+
+	// PriorityClass is the priority class the query is assigned to
+	// by its comment directive, if any.
+	PriorityClass string
+
+	// Batch is set for the point lookups that the consolidator
+	// may batch with the lookups of other keys.
+	Batch *BatchQuery
+
+	// RowKeys is set for the DMLs that only change the rows of known
+	// unique keys. It is used by the hot row protection to serialize
+	// the transactions that change the same rows.
+	RowKeys *RowKeys
+}
+
+// RowKeys are the values of the columns of the primary key or of a unique
+// key of the rows a DML changes.
+type RowKeys struct {
+	Columns []sqlparser.ColIdent
+
+	// Values holds the value of each of the Columns. At most
+	// one of them is a list.
+	Values []sqltypes.PlanValue
+}
+
+// BatchKeys is the name of the list bind variable
+// of the keys of a BatchQuery.
+const BatchKeys = "#batchKeys"
+
+// BatchQuery is the batched form of a select of a single table
+// by its integral primary key.
+type BatchQuery struct {
+	// Query selects the rows of all the keys of the BatchKeys bind
+	// variable. Its last column is the primary key.
+	Query *sqlparser.ParsedQuery
+
+	// Key is the primary key the select looks up.
+	Key sqltypes.PlanValue
 }
 
 // TableName returns the table name for the plan.
@@ -249,6 +287,7 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		return nil, err
 	}
 	plan.Permissions = BuildPermissions(statement)
+	plan.PriorityClass = sqlparser.PriorityClassDirective(statement)
 	return plan, nil
 }
 
@@ -267,9 +306,10 @@ func BuildStreaming(sql string, tables map[string]*schema.Table, isReservedConn
 	}
 
 	plan := &Plan{
-		PlanID:      PlanSelectStream,
-		FullQuery:   GenerateFullQuery(statement),
-		Permissions: BuildPermissions(statement),
+		PlanID:        PlanSelectStream,
+		FullQuery:     GenerateFullQuery(statement),
+		Permissions:   BuildPermissions(statement),
+		PriorityClass: sqlparser.PriorityClassDirective(statement),
 	}
 
 	switch stmt := statement.(type) {
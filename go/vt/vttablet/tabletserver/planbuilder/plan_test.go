@@ -46,6 +46,9 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		FullQuery   *sqlparser.ParsedQuery `json:",omitempty"`
 		NextCount   string                 `json:",omitempty"`
 		WhereClause *sqlparser.ParsedQuery `json:",omitempty"`
+		BatchQuery  *sqlparser.ParsedQuery `json:",omitempty"`
+		BatchKey    string                 `json:",omitempty"`
+		RowKeys     *RowKeys               `json:",omitempty"`
 	}{
 		PlanID:      p.PlanID,
 		TableName:   p.TableName(),
@@ -53,11 +56,17 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		FieldQuery:  p.FieldQuery,
 		FullQuery:   p.FullQuery,
 		WhereClause: p.WhereClause,
+		RowKeys:     p.RowKeys,
 	}
 	if !p.NextCount.IsNull() {
 		b, _ := p.NextCount.MarshalJSON()
 		mplan.NextCount = string(b)
 	}
+	if p.Batch != nil {
+		mplan.BatchQuery = p.Batch.Query
+		b, _ := p.Batch.Key.MarshalJSON()
+		mplan.BatchKey = string(b)
+	}
 	return json.Marshal(&mplan)
 }
 
@@ -0,0 +1,307 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package priority provides the priority classes of the queries and
+// transactions of vttablet. See the Scheduler struct for details.
+package priority
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+type contextKey int
+
+const classKey contextKey = 0
+
+// NewContext returns a context assigning its requests to the priority class.
+// It takes precedence over the class of the caller of the request.
+func NewContext(ctx context.Context, class string) context.Context {
+	return context.WithValue(ctx, classKey, class)
+}
+
+// FromContext returns the priority class the context assigns its requests
+// to, if any.
+func FromContext(ctx context.Context) (string, bool) {
+	class, ok := ctx.Value(classKey).(string)
+	return class, ok
+}
+
+// Scheduler hands out the connections of a pool to the requests of the
+// priority classes with weighted fair queuing.
+//
+// Requests get a connection right away while the pool has some available.
+// Otherwise they queue, and each connection returned to the pool goes to
+// the queued request with the lowest virtual start time. The virtual times
+// of the requests of a class advance by the inverse of its weight, so that
+// the classes get the connections in proportion to their weights while
+// they queue, and a class that was idle does not get credit for it.
+//
+// A class may not use more connections than its max concurrency at once,
+// and its requests fail if they queue longer than its queue timeout or if
+// their context is done. The class of a request is the class of its context
+// (see NewContext), else the class of its caller, else the default class.
+type Scheduler struct {
+	// Immutable fields.
+	name         string
+	classes      []*class
+	byName       map[string]*class
+	defaultClass *class
+	users        map[string]*class
+
+	// waits records how long the queued requests of each class waited.
+	// rejections counts the requests of each class that failed to get a
+	// connection because their queue timeout was exceeded or their
+	// context was done.
+	waits      *servenv.TimingsWrapper
+	rejections *stats.CountersWithSingleLabel
+	inUse      *stats.GaugesWithSingleLabel
+	queued     *stats.GaugesWithSingleLabel
+
+	mu       sync.Mutex
+	capacity int
+	used     int
+	// vtime is the virtual start time of the last request
+	// that got a connection.
+	vtime float64
+}
+
+// class is a priority class. Its mutable fields are protected by
+// Scheduler.mu.
+type class struct {
+	name           string
+	weight         float64
+	maxConcurrency int
+	queueTimeout   time.Duration
+
+	used int
+	// finish is the virtual finish time of the last request of the class.
+	finish float64
+	queue  []*waiter
+}
+
+// waiter is a queued request.
+type waiter struct {
+	start float64
+	// ready is closed when the request got a connection.
+	ready chan struct{}
+}
+
+// NewScheduler returns a Scheduler for a pool of the given capacity, or nil
+// if there are no priority classes. The name of the pool prefixes its stats.
+func NewScheduler(env tabletenv.Env, name string, capacity int) *Scheduler {
+	config := env.Config().Priority
+	if len(config.Classes) == 0 {
+		return nil
+	}
+	s := &Scheduler{
+		name:     name,
+		byName:   make(map[string]*class),
+		users:    make(map[string]*class),
+		capacity: capacity,
+		waits: env.Exporter().NewTimings(
+			name+"PriorityWaits",
+			"Time the requests of each priority class queued for a connection",
+			"class"),
+		rejections: env.Exporter().NewCountersWithSingleLabel(
+			name+"PriorityRejections",
+			"Number of requests of each priority class rejected because they exceeded their queue timeout or their context was done",
+			"class"),
+		inUse: env.Exporter().NewGaugesWithSingleLabel(
+			name+"PriorityInUse",
+			"Number of connections in use by each priority class",
+			"class"),
+		queued: env.Exporter().NewGaugesWithSingleLabel(
+			name+"PriorityQueued",
+			"Number of requests of each priority class queued for a connection",
+			"class"),
+	}
+	for _, cc := range config.Classes {
+		c := &class{
+			name:           cc.Name,
+			weight:         float64(cc.Weight),
+			maxConcurrency: cc.MaxConcurrency,
+			queueTimeout:   cc.QueueTimeoutSeconds.Get(),
+		}
+		s.classes = append(s.classes, c)
+		s.byName[c.name] = c
+	}
+	s.defaultClass = s.classes[0]
+	if c, ok := s.byName[config.DefaultClass]; ok {
+		s.defaultClass = c
+	}
+	for user, name := range config.Users {
+		if c, ok := s.byName[name]; ok {
+			s.users[user] = c
+		}
+	}
+	return s
+}
+
+// classOf returns the class of the request. Unknown classes
+// of the context fall back to the class of the caller.
+func (s *Scheduler) classOf(ctx context.Context) *class {
+	if name, ok := FromContext(ctx); ok {
+		if c, ok := s.byName[name]; ok {
+			return c
+		}
+	}
+	if ef := callerid.EffectiveCallerIDFromContext(ctx); ef != nil {
+		if c, ok := s.users[callerid.GetPrincipal(ef)]; ok {
+			return c
+		}
+	}
+	if im := callerid.ImmediateCallerIDFromContext(ctx); im != nil {
+		if c, ok := s.users[callerid.GetUsername(im)]; ok {
+			return c
+		}
+	}
+	return s.defaultClass
+}
+
+// Wait blocks until the request may get a connection from the pool.
+// If err is nil, done must be called once the connection is returned
+// to the pool, to hand it to the next queued request.
+func (s *Scheduler) Wait(ctx context.Context) (done func(), err error) {
+	c := s.classOf(ctx)
+
+	s.mu.Lock()
+	start := s.vtime
+	if c.finish > start {
+		start = c.finish
+	}
+	c.finish = start + 1/c.weight
+	if len(c.queue) == 0 && s.availableLocked(c) {
+		s.acquireLocked(c, start)
+		s.mu.Unlock()
+		return s.doneFunc(c), nil
+	}
+	w := &waiter{start: start, ready: make(chan struct{})}
+	c.queue = append(c.queue, w)
+	s.queued.Add(c.name, 1)
+	s.mu.Unlock()
+
+	waitStart := time.Now()
+	var timeout <-chan time.Time
+	if c.queueTimeout != 0 {
+		timer := time.NewTimer(c.queueTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-w.ready:
+		s.waits.Record(c.name, waitStart)
+		return s.doneFunc(c), nil
+	case <-ctx.Done():
+		err = pools.ErrTimeout
+	case <-timeout:
+		err = vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "%s: queue timeout of priority class %s exceeded (%v)", s.name, c.name, c.queueTimeout)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-w.ready:
+		// The request got a connection while it gave up.
+		s.releaseLocked(c)
+	default:
+		s.removeLocked(c, w)
+	}
+	s.rejections.Add(c.name, 1)
+	return nil, err
+}
+
+// SetCapacity changes the capacity of the pool.
+func (s *Scheduler) SetCapacity(capacity int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.capacity = capacity
+	s.dispatchLocked()
+}
+
+func (s *Scheduler) doneFunc(c *class) func() {
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.releaseLocked(c)
+	}
+}
+
+// availableLocked returns true if the class may get a connection.
+// The method has the suffix "Locked" to clarify that "s.mu" must be locked.
+func (s *Scheduler) availableLocked(c *class) bool {
+	return s.used < s.capacity && (c.maxConcurrency == 0 || c.used < c.maxConcurrency)
+}
+
+func (s *Scheduler) acquireLocked(c *class, start float64) {
+	s.used++
+	c.used++
+	if start > s.vtime {
+		s.vtime = start
+	}
+	s.inUse.Set(c.name, int64(c.used))
+}
+
+func (s *Scheduler) releaseLocked(c *class) {
+	s.used--
+	c.used--
+	s.inUse.Set(c.name, int64(c.used))
+	s.dispatchLocked()
+}
+
+// dispatchLocked hands the available connections to the queued requests
+// with the lowest virtual start times.
+func (s *Scheduler) dispatchLocked() {
+	for s.used < s.capacity {
+		var next *class
+		for _, c := range s.classes {
+			if len(c.queue) == 0 || !s.availableLocked(c) {
+				continue
+			}
+			if next == nil || c.queue[0].start < next.queue[0].start {
+				next = c
+			}
+		}
+		if next == nil {
+			return
+		}
+		w := next.queue[0]
+		next.queue = next.queue[1:]
+		s.queued.Add(next.name, -1)
+		s.acquireLocked(next, w.start)
+		close(w.ready)
+	}
+}
+
+func (s *Scheduler) removeLocked(c *class, w *waiter) {
+	for i, qw := range c.queue {
+		if qw == w {
+			c.queue = append(c.queue[:i], c.queue[i+1:]...)
+			s.queued.Add(c.name, -1)
+			return
+		}
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func newScheduler(t *testing.T, capacity int, priority tabletenv.PriorityConfig) *Scheduler {
+	config := tabletenv.NewDefaultConfig()
+	config.Priority = priority
+	return NewScheduler(tabletenv.NewEnv(config, t.Name()), "TestPool", capacity)
+}
+
+var testClasses = []tabletenv.PriorityClassConfig{{
+	Name:   "oltp",
+	Weight: 3,
+}, {
+	Name:           "batch",
+	Weight:         1,
+	MaxConcurrency: 1,
+}}
+
+// waitQueued waits until n requests are queued.
+func waitQueued(t *testing.T, s *Scheduler, n int) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		s.mu.Lock()
+		queued := 0
+		for _, c := range s.classes {
+			queued += len(c.queue)
+		}
+		s.mu.Unlock()
+		if queued == n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("%d requests were not queued", n)
+}
+
+func TestNewScheduler(t *testing.T) {
+	assert.Nil(t, newScheduler(t, 1, tabletenv.PriorityConfig{}))
+}
+
+func TestSchedulerWeightedFairQueuing(t *testing.T) {
+	s := newScheduler(t, 1, tabletenv.PriorityConfig{Classes: []tabletenv.PriorityClassConfig{{
+		Name:   "oltp",
+		Weight: 3,
+	}, {
+		Name:   "batch",
+		Weight: 1,
+	}}})
+	oltpCtx := NewContext(context.Background(), "oltp")
+	batchCtx := NewContext(context.Background(), "batch")
+
+	done, err := s.Wait(oltpCtx)
+	require.NoError(t, err)
+
+	type grant struct {
+		class string
+		done  func()
+	}
+	grants := make(chan grant)
+	queue := func(ctx context.Context, class string) {
+		go func() {
+			done, err := s.Wait(ctx)
+			if err != nil {
+				t.Errorf("Wait() failed: %v", err)
+				return
+			}
+			grants <- grant{class: class, done: done}
+		}()
+	}
+	queued := 0
+	for i := 0; i < 4; i++ {
+		queue(batchCtx, "batch")
+		queued++
+		waitQueued(t, s, queued)
+	}
+	for i := 0; i < 6; i++ {
+		queue(oltpCtx, "oltp")
+		queued++
+		waitQueued(t, s, queued)
+	}
+
+	// While both classes queue, oltp gets three connections for each one of batch.
+	done()
+	var got []string
+	for i := 0; i < queued; i++ {
+		g := <-grants
+		got = append(got, g.class)
+		g.done()
+	}
+	want := []string{"batch", "oltp", "oltp", "oltp", "batch", "oltp", "oltp", "oltp", "batch", "batch"}
+	assert.Equal(t, want, got)
+	assert.EqualValues(t, 10, s.waits.Counts()["All"])
+}
+
+func TestSchedulerMaxConcurrency(t *testing.T) {
+	s := newScheduler(t, 3, tabletenv.PriorityConfig{Classes: testClasses})
+	batchCtx := NewContext(context.Background(), "batch")
+
+	batchDone, err := s.Wait(batchCtx)
+	require.NoError(t, err)
+
+	// The second batch request queues while oltp requests still get connections.
+	granted := make(chan func())
+	go func() {
+		done, err := s.Wait(batchCtx)
+		if err != nil {
+			t.Errorf("Wait() failed: %v", err)
+			return
+		}
+		granted <- done
+	}()
+	waitQueued(t, s, 1)
+	for i := 0; i < 2; i++ {
+		done, err := s.Wait(context.Background())
+		require.NoError(t, err)
+		defer done()
+	}
+	assert.EqualValues(t, map[string]int64{"oltp": 2, "batch": 1}, s.inUse.Counts())
+
+	batchDone()
+	done := <-granted
+	assert.EqualValues(t, 1, s.inUse.Counts()["batch"])
+	done()
+	assert.EqualValues(t, 0, s.inUse.Counts()["batch"])
+}
+
+func TestSchedulerRejections(t *testing.T) {
+	s := newScheduler(t, 1, tabletenv.PriorityConfig{Classes: []tabletenv.PriorityClassConfig{{
+		Name:   "oltp",
+		Weight: 1,
+	}, {
+		Name:                "batch",
+		Weight:              1,
+		QueueTimeoutSeconds: 0.01,
+	}}})
+	done, err := s.Wait(context.Background())
+	require.NoError(t, err)
+	defer done()
+
+	_, err = s.Wait(NewContext(context.Background(), "batch"))
+	assert.EqualError(t, err, "TestPool: queue timeout of priority class batch exceeded (10ms)")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.Wait(ctx)
+	assert.Equal(t, pools.ErrTimeout, err)
+
+	assert.Equal(t, map[string]int64{"oltp": 1, "batch": 1}, s.rejections.Counts())
+	assert.EqualValues(t, 0, s.queued.Counts()["oltp"]+s.queued.Counts()["batch"])
+}
+
+func TestSchedulerSetCapacity(t *testing.T) {
+	s := newScheduler(t, 1, tabletenv.PriorityConfig{Classes: testClasses})
+	done, err := s.Wait(context.Background())
+	require.NoError(t, err)
+	defer done()
+
+	granted := make(chan func())
+	go func() {
+		done, err := s.Wait(context.Background())
+		if err != nil {
+			t.Errorf("Wait() failed: %v", err)
+			return
+		}
+		granted <- done
+	}()
+	waitQueued(t, s, 1)
+	s.SetCapacity(2)
+	(<-granted)()
+}
+
+func TestSchedulerClassOf(t *testing.T) {
+	s := newScheduler(t, 1, tabletenv.PriorityConfig{
+		Classes:      testClasses,
+		DefaultClass: "batch",
+		Users: map[string]string{
+			"app":    "oltp",
+			"report": "batch",
+		},
+	})
+	principalCtx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("app", "", ""), callerid.NewImmediateCallerID("report"))
+	testcases := []struct {
+		ctx  context.Context
+		want string
+	}{{
+		ctx:  context.Background(),
+		want: "batch",
+	}, {
+		ctx:  callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("app")),
+		want: "oltp",
+	}, {
+		ctx:  principalCtx,
+		want: "oltp",
+	}, {
+		ctx:  NewContext(principalCtx, "batch"),
+		want: "batch",
+	}, {
+		ctx:  NewContext(principalCtx, "unknown"),
+		want: "oltp",
+	}}
+	for _, tc := range testcases {
+		assert.Equal(t, tc.want, s.classOf(tc.ctx).name)
+	}
+}
@@ -125,6 +125,7 @@ type QueryEngine struct {
 	// Services
 	consolidator       *sync2.Consolidator
 	streamConsolidator *StreamConsolidator
+	batchConsolidator  *BatchConsolidator
 	// txSerializer protects vttablet from applications which try to concurrently
 	// UPDATE (or DELETE) a "hot" row (or range of rows).
 	// Such queries would be serialized by MySQL anyway. This serializer prevents
@@ -176,6 +177,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	}
 
 	qe.conns = connpool.NewPool(env, "ConnPool", config.OltpReadPool)
+	qe.conns.Prioritize()
 	qe.streamConns = connpool.NewPool(env, "StreamConnPool", config.OlapReadPool)
 	qe.consolidatorMode.Set(config.Consolidator)
 	qe.enableQueryPlanFieldCaching = config.CacheResultFields
@@ -183,6 +185,9 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	if config.ConsolidatorStreamTotalSize > 0 && config.ConsolidatorStreamQuerySize > 0 {
 		qe.streamConsolidator = NewStreamConsolidator(config.ConsolidatorStreamTotalSize, config.ConsolidatorStreamQuerySize, returnStreamResult)
 	}
+	if config.ConsolidatorBatchWindowSeconds > 0 {
+		qe.batchConsolidator = NewBatchConsolidator(env, config.ConsolidatorBatchWindowSeconds.Get(), config.ConsolidatorBatchMaxSize)
+	}
 	qe.txSerializer = txserializer.New(env)
 
 	qe.strictTableACL = config.StrictTableACL
@@ -312,6 +317,9 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	}
 	plan := &TabletPlan{Plan: splan, Original: sql}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
+	if plan.FullQuery != nil {
+		plan.Rules.RewritePlan(statement, planbuilder.GenerateLimitQuery)
+	}
 	plan.buildAuthorized()
 	if plan.PlanID.IsSelect() {
 		if !skipQueryPlanCache && qe.enableQueryPlanFieldCaching && plan.FieldQuery != nil {
@@ -350,6 +358,15 @@ func (qe *QueryEngine) GetStreamPlan(sql string, isReservedConn bool) (*TabletPl
 	}
 	plan := &TabletPlan{Plan: splan, Original: sql}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
+	if plan.Rules.HasRewrite() {
+		statement, err := sqlparser.Parse(sql)
+		if err != nil {
+			return nil, err
+		}
+		plan.Rules.RewritePlan(statement, func(sel sqlparser.SelectStatement) *sqlparser.ParsedQuery {
+			return planbuilder.GenerateFullQuery(sel)
+		})
+	}
 	plan.buildAuthorized()
 	return plan, nil
 }
@@ -39,6 +39,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	p "vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/priority"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
@@ -59,6 +60,8 @@ type QueryExecutor struct {
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
 	tabletType     topodatapb.TabletType
+	// rule is the query rule that fired on the query, if any.
+	rule *rules.Rule
 }
 
 const streamRowsSize = 256
@@ -126,6 +129,12 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
+	release, err := qre.enforceRule()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	qre.setPriorityClass()
 
 	switch qre.plan.PlanID {
 	case p.PlanNextval:
@@ -278,6 +287,12 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	release, err := qre.enforceRule()
+	if err != nil {
+		return err
+	}
+	defer release()
+	qre.setPriorityClass()
 
 	sql, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
 	if err != nil {
@@ -355,6 +370,11 @@ func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	release, err := qre.enforceRule()
+	if err != nil {
+		return err
+	}
+	defer release()
 
 	done, err := qre.tsv.messager.Subscribe(qre.ctx, qre.plan.TableName().String(), func(r *sqltypes.Result) error {
 		select {
@@ -387,12 +407,15 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars, qre.marginComments)
-	switch action {
-	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
-	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+	qre.rule = qre.plan.Rules.GetRule(remoteAddr, username, qre.bindVars, qre.marginComments)
+	if qre.rule != nil {
+		qre.tsv.Stats().QueryRuleHits.Add([]string{qre.rule.Name, qre.rule.Action().String()}, 1)
+		switch qre.rule.Action() {
+		case rules.QRFail:
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", qre.rule.Description)
+		case rules.QRFailRetry:
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", qre.rule.Description)
+		}
 	}
 
 	// Skip ACL check for queries against the dummy dual table
@@ -430,6 +453,23 @@ func (qre *QueryExecutor) checkPermissions() error {
 	return nil
 }
 
+// enforceRule performs the action of the query rule that fired on the query,
+// if any. The returned function must be called once the query is done.
+func (qre *QueryExecutor) enforceRule() (func(), error) {
+	if qre.rule == nil {
+		return func() {}, nil
+	}
+	return qre.rule.Enforce(qre.ctx)
+}
+
+// setPriorityClass assigns the connections of the query to the priority
+// class of its comment directive, if any.
+func (qre *QueryExecutor) setPriorityClass() {
+	if qre.plan.PriorityClass != "" {
+		qre.ctx = priority.NewContext(qre.ctx, qre.plan.PriorityClass)
+	}
+}
+
 func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName string, callerID *querypb.VTGateCallerID) error {
 	statsKey := []string{tableName, authorized.GroupName, qre.plan.PlanID.String(), callerID.Username}
 	if !authorized.IsMember(callerID) {
@@ -701,14 +741,7 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 		q, original := qre.tsv.qe.consolidator.Create(sqlWithoutComments)
 		if original {
 			defer q.Broadcast()
-			conn, err := qre.getConn()
-
-			if err != nil {
-				q.Err = err
-			} else {
-				defer conn.Recycle()
-				q.Result, q.Err = qre.execDBConn(conn, sql, false)
-			}
+			q.Result, q.Err = qre.qFetchBatch(sql)
 		} else {
 			logStats.QuerySources |= tabletenv.QuerySourceConsolidator
 			startTime := time.Now()
@@ -732,6 +765,45 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 	return res, nil
 }
 
+// qFetchBatch executes a point lookup in a batch with the concurrent lookups
+// of other keys if the batch consolidator is enabled, and sql otherwise.
+func (qre *QueryExecutor) qFetchBatch(sql string) (*sqltypes.Result, error) {
+	consolidator, batch := qre.tsv.qe.batchConsolidator, qre.plan.Batch
+	// Rewrite rules only apply to the query of the lookup.
+	if consolidator == nil || batch == nil || (qre.rule != nil && qre.rule.Action() == rules.QRRewrite) {
+		return qre.execConn(sql)
+	}
+	key, err := batch.Key.ResolveValue(qre.bindVars)
+	if err != nil || !key.IsIntegral() {
+		return qre.execConn(sql)
+	}
+	exec := func() (*sqltypes.Result, error) {
+		return qre.execConn(sql)
+	}
+	execBatch := func(keys []sqltypes.Value) (*sqltypes.Result, error) {
+		bv := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+		for _, k := range keys {
+			bv.Values = append(bv.Values, sqltypes.ValueToProto(k))
+		}
+		batchSQL, err := batch.Query.GenerateQuery(map[string]*querypb.BindVariable{p.BatchKeys: bv}, nil)
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s", err)
+		}
+		return qre.execConn(batchSQL)
+	}
+	return consolidator.Consolidate(qre.logStats, qre.plan.TableName().String(), batch.Query.Query, key, exec, execBatch)
+}
+
+// execConn executes sql on a connection of the query pool.
+func (qre *QueryExecutor) execConn(sql string) (*sqltypes.Result, error) {
+	conn, err := qre.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+	return qre.execDBConn(conn, sql, false)
+}
+
 // txFetch fetches from a TxConnection.
 func (qre *QueryExecutor) txFetch(conn *StatefulConnection, record bool) (*sqltypes.Result, error) {
 	sql, _, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
@@ -750,7 +822,16 @@ func (qre *QueryExecutor) txFetch(conn *StatefulConnection, record bool) (*sqlty
 }
 
 func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable) (string, string, error) {
-	query, err := parsedQuery.GenerateQuery(bindVars, nil)
+	var query string
+	var rewritten bool
+	var err error
+	if qre.rule != nil && parsedQuery == qre.plan.FullQuery {
+		// The query of the plan was rewritten by the rule when the plan was built.
+		query, rewritten, err = qre.rule.GenerateRewrittenQuery(bindVars)
+	}
+	if !rewritten {
+		query, err = parsedQuery.GenerateQuery(bindVars, nil)
+	}
 	if err != nil {
 		return "", "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s", err)
 	}
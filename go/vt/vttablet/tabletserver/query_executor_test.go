@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -42,6 +43,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/priority"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
@@ -1221,8 +1223,125 @@ func TestQueryExecutorDenyListQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorQueryRuleActions(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table where name = 1 limit 1000"
+	rewrittenQuery := "select /*+ MAX_EXECUTION_TIME(2000) */ * from test_table where `name` = 1 limit 10"
+	expected := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(rewrittenQuery, expected)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	rewriteRule := rules.NewQueryRule("rewrite selects", "rewrite", rules.QRRewrite)
+	rewriteRule.SetRewrite(2*time.Second, 10)
+	rewriteRule.AddTableCond("test_table")
+	rewriteRule.SetUserCond("u1")
+	throttleRule := rules.NewQueryRule("throttle selects", "throttle", rules.QRThrottle)
+	throttleRule.SetThrottle(0.001, 1)
+	throttleRule.AddTableCond("test_table")
+
+	rulesName := "queryRuleActions"
+	qrs := rules.New()
+	qrs.Add(rewriteRule)
+	qrs.Add(throttleRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+	hitsBefore := tsv.stats.QueryRuleHits.Counts()
+
+	// The rewrite rule fires for u1, and the throttle rule for the others.
+	u1Ctx := callinfo.NewContext(ctx, &fakecallinfo.FakeCallInfo{User: "u1"})
+	for i := 0; i < 2; i++ {
+		_, err := newTestQueryExecutor(u1Ctx, tsv, query, 0).Execute()
+		require.NoError(t, err)
+	}
+
+	u2Ctx := callinfo.NewContext(ctx, &fakecallinfo.FakeCallInfo{User: "u2"})
+	db.AddQuery("select * from test_table where `name` = 1 limit 1000", expected)
+	_, err := newTestQueryExecutor(u2Ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	_, err = newTestQueryExecutor(u2Ctx, tsv, query, 0).Execute()
+	require.EqualError(t, err, "throttled due to rule: throttle selects")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+
+	hits := tsv.stats.QueryRuleHits.Counts()
+	assert.EqualValues(t, 2, hits["rewrite.REWRITE"]-hitsBefore["rewrite.REWRITE"])
+	assert.EqualValues(t, 2, hits["throttle.THROTTLE"]-hitsBefore["throttle.THROTTLE"])
+}
+
 type executorFlags int64
 
+func TestQueryExecutorPriorityClass(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("select * from t limit 10001", &sqltypes.Result{})
+	db.AddQuery("select * from t where 1 != 1", &sqltypes.Result{})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, priorityClasses, db)
+	defer tsv.StopService()
+
+	// The batch class uses its only connection.
+	conn, err := tsv.qe.conns.Get(priority.NewContext(ctx, "batch"))
+	require.NoError(t, err)
+	defer conn.Recycle()
+
+	_, err = newTestQueryExecutor(ctx, tsv, "select /*vt+ PRIORITY_CLASS=batch */ * from t", 0).Execute()
+	assert.EqualError(t, err, "ConnPool: queue timeout of priority class batch exceeded (10ms)")
+	_, err = newTestQueryExecutor(ctx, tsv, "select * from t", 0).Execute()
+	require.NoError(t, err)
+}
+
+func TestQueryExecutorBatchConsolidation(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	fields := sqltypes.MakeTestFields("addr", "int32")
+	db.AddQuery("select addr from test_table where 1 != 1", &sqltypes.Result{Fields: fields})
+	db.AddQuery("select addr, pk from test_table where pk in (1, 2)", sqltypes.MakeTestResult(sqltypes.MakeTestFields("addr|pk", "int32|int32"),
+		"10|1",
+		"20|2",
+	))
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, batchConsolidation, db)
+	defer tsv.StopService()
+
+	lookup := func(pk int64) *QueryExecutor {
+		qre := newTestQueryExecutor(ctx, tsv, "select addr from test_table where pk = :pk", 0)
+		qre.bindVars["pk"] = sqltypes.Int64BindVariable(pk)
+		return qre
+	}
+	// The lookups of both keys are sent to MySQL as a single query
+	// because another lookup is being executed.
+	release := blockLookups(t, tsv.qe.batchConsolidator, "select addr, pk from test_table where pk in ::#batchKeys")
+	defer release()
+	leader := lookup(1)
+	leaderResult := make(chan *sqltypes.Result)
+	go func() {
+		result, err := leader.Execute()
+		if err != nil {
+			t.Errorf("Execute() failed: %v", err)
+		}
+		leaderResult <- result
+	}()
+	waitLookups(t, tsv.qe.batchConsolidator, "select addr, pk from test_table where pk in ::#batchKeys", 1)
+	follower := lookup(2)
+	got, err := follower.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(fields, "20"), got)
+	assert.NotZero(t, follower.logStats.QuerySources&tabletenv.QuerySourceConsolidator)
+	assert.Equal(t, sqltypes.MakeTestResult(fields, "10"), <-leaderResult)
+	assert.Equal(t, 1, db.GetQueryCalledNum("select addr, pk from test_table where pk in (1, 2)"))
+}
+
 const (
 	noFlags              executorFlags = 0
 	enableStrictTableACL               = 1 << iota
@@ -1231,6 +1350,8 @@ const (
 	shortTwopcAge
 	smallResultSize
 	disableOnlineDDL
+	priorityClasses
+	batchConsolidation
 )
 
 // newTestQueryExecutor uses a package level variable testTabletServer defined in tabletserver_test.go
@@ -1266,6 +1387,21 @@ func newTestTabletServer(ctx context.Context, flags executorFlags, db *fakesqldb
 	if flags&smallResultSize > 0 {
 		config.Oltp.MaxRows = 2
 	}
+	if flags&priorityClasses > 0 {
+		config.Priority.Classes = []tabletenv.PriorityClassConfig{{
+			Name:   "oltp",
+			Weight: 4,
+		}, {
+			Name:                "batch",
+			Weight:              1,
+			MaxConcurrency:      1,
+			QueueTimeoutSeconds: 0.01,
+		}}
+	}
+	if flags&batchConsolidation > 0 {
+		config.ConsolidatorBatchWindowSeconds = 10
+		config.ConsolidatorBatchMaxSize = 2
+	}
 	dbconfigs := newDBConfigs(db)
 	config.DB = dbconfigs
 	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), &topodatapb.TabletAlias{})
@@ -45,7 +45,7 @@ func (cached *Rule) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(240)
+		size += int64(296)
 	}
 	// field Description string
 	size += hack.RuntimeAllocSize(int64(len(cached.Description)))
@@ -79,6 +79,10 @@ func (cached *Rule) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(false)
 		}
 	}
+	// field state *vitess.io/vitess/go/vt/vttablet/tabletserver/rules.ruleState
+	if cached.state != nil {
+		size += hack.RuntimeAllocSize(int64(48))
+	}
 	return size
 }
 func (cached *Rules) CachedSize(alloc bool) int64 {
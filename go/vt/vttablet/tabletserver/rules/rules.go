@@ -18,11 +18,16 @@ package rules
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
@@ -173,12 +178,56 @@ func (qrs *Rules) GetAction(
 	bindVars map[string]*querypb.BindVariable,
 	marginComments sqlparser.MarginComments,
 ) (action Action, desc string) {
+	if qr := qrs.GetRule(ip, user, bindVars, marginComments); qr != nil {
+		return qr.act, qr.Description
+	}
+	return QRContinue, ""
+}
+
+// GetRule runs the input against the rules engine and returns the rule whose
+// action is performed, or nil if no rule fires. Only the action of that rule
+// is performed: the first FAIL or FAIL_RETRY rule that fires, so that the rules
+// listed before it cannot keep it from blocking the query, or else the first
+// rule that fires.
+func (qrs *Rules) GetRule(
+	ip,
+	user string,
+	bindVars map[string]*querypb.BindVariable,
+	marginComments sqlparser.MarginComments,
+) *Rule {
+	var first *Rule
 	for _, qr := range qrs.rules {
-		if act := qr.GetAction(ip, user, bindVars, marginComments); act != QRContinue {
-			return act, qr.Description
+		switch qr.GetAction(ip, user, bindVars, marginComments) {
+		case QRContinue:
+		case QRFail, QRFailRetry:
+			return qr
+		default:
+			if first == nil {
+				first = qr
+			}
 		}
 	}
-	return QRContinue, ""
+	return first
+}
+
+// RewritePlan rewrites the select of the plan the rules were filtered into
+// with their REWRITE rules. It is called once per plan, when the rules are
+// filtered into it, so that the query is not parsed again on every execution.
+// generate generates the query of the plan from its select.
+func (qrs *Rules) RewritePlan(stmt sqlparser.Statement, generate func(sqlparser.SelectStatement) *sqlparser.ParsedQuery) {
+	for _, qr := range qrs.rules {
+		qr.rewritePlan(stmt, generate)
+	}
+}
+
+// HasRewrite returns true if one of the rules is a REWRITE rule.
+func (qrs *Rules) HasRewrite() bool {
+	for _, qr := range qrs.rules {
+		if qr.act == QRRewrite {
+			return true
+		}
+	}
+	return false
 }
 
 //-----------------------------------------------
@@ -211,6 +260,41 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// delay is how long a DELAY rule holds the query back.
+	delay time.Duration
+
+	// maxQPS and burst are the rate and size of the token bucket of a THROTTLE rule.
+	maxQPS float64
+	burst  int
+
+	// maxConcurrency is how many queries a CONCURRENCY_LIMIT rule lets execute at once.
+	maxConcurrency int
+
+	// maxExecutionTime and limit are the MAX_EXECUTION_TIME hint and the LIMIT
+	// a REWRITE rule adds to selects. Zero values are not added.
+	maxExecutionTime time.Duration
+	limit            int64
+
+	// rewritten is the query of the plan the REWRITE rule was filtered into,
+	// rewritten by the rule. If the row count of the LIMIT of the query is a
+	// bind variable, rewrittenLimitArg is its name, and the row count is capped
+	// by the rule when the query is generated.
+	rewritten         *sqlparser.ParsedQuery
+	rewrittenLimitArg string
+
+	// state is shared by the copies of the rule, so that the rules
+	// filtered into the query plans throttle and limit together.
+	state *ruleState
+}
+
+// ruleState is the token bucket of a THROTTLE rule and the number of
+// queries a CONCURRENCY_LIMIT rule lets execute.
+type ruleState struct {
+	mu          sync.Mutex
+	tokens      float64
+	last        time.Time
+	concurrency int
 }
 
 type namedRegexp struct {
@@ -233,8 +317,7 @@ func (nr namedRegexp) Equal(other namedRegexp) bool {
 
 // NewQueryRule creates a new Rule.
 func NewQueryRule(description, name string, act Action) (qr *Rule) {
-	// We ignore act because there's only one action right now
-	return &Rule{Description: description, Name: name, act: act}
+	return &Rule{Description: description, Name: name, act: act, state: &ruleState{}}
 }
 
 // Equal returns true if other is equal to this Rule, otherwise false.
@@ -252,20 +335,33 @@ func (qr *Rule) Equal(other *Rule) bool {
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.delay == other.delay &&
+		qr.maxQPS == other.maxQPS &&
+		qr.burst == other.burst &&
+		qr.maxConcurrency == other.maxConcurrency &&
+		qr.maxExecutionTime == other.maxExecutionTime &&
+		qr.limit == other.limit)
 }
 
 // Copy performs a deep copy of a Rule.
 func (qr *Rule) Copy() (newqr *Rule) {
 	newqr = &Rule{
-		Description:     qr.Description,
-		Name:            qr.Name,
-		requestIP:       qr.requestIP,
-		user:            qr.user,
-		query:           qr.query,
-		leadingComment:  qr.leadingComment,
-		trailingComment: qr.trailingComment,
-		act:             qr.act,
+		Description:      qr.Description,
+		Name:             qr.Name,
+		requestIP:        qr.requestIP,
+		user:             qr.user,
+		query:            qr.query,
+		leadingComment:   qr.leadingComment,
+		trailingComment:  qr.trailingComment,
+		act:              qr.act,
+		delay:            qr.delay,
+		maxQPS:           qr.maxQPS,
+		burst:            qr.burst,
+		maxConcurrency:   qr.maxConcurrency,
+		maxExecutionTime: qr.maxExecutionTime,
+		limit:            qr.limit,
+		state:            qr.state,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -314,6 +410,24 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.delay != 0 {
+		safeEncode(b, `,"Delay":`, qr.delay.String())
+	}
+	if qr.maxQPS != 0 {
+		safeEncode(b, `,"MaxQPS":`, qr.maxQPS)
+	}
+	if qr.burst != 0 {
+		safeEncode(b, `,"Burst":`, qr.burst)
+	}
+	if qr.maxConcurrency != 0 {
+		safeEncode(b, `,"MaxConcurrency":`, qr.maxConcurrency)
+	}
+	if qr.maxExecutionTime != 0 {
+		safeEncode(b, `,"MaxExecutionTime":`, qr.maxExecutionTime.String())
+	}
+	if qr.limit != 0 {
+		safeEncode(b, `,"Limit":`, qr.limit)
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
@@ -369,6 +483,180 @@ func (qr *Rule) SetTrailingCommentCond(pattern string) (err error) {
 	return
 }
 
+// SetDelay sets how long a DELAY rule holds the query back.
+func (qr *Rule) SetDelay(delay time.Duration) {
+	qr.delay = delay
+}
+
+// SetThrottle sets the token bucket of a THROTTLE rule: it lets through
+// maxQPS queries per second, and bursts of up to burst queries.
+// A burst of 0 lets through one second worth of queries.
+func (qr *Rule) SetThrottle(maxQPS float64, burst int) {
+	qr.maxQPS = maxQPS
+	qr.burst = burst
+}
+
+// SetMaxConcurrency sets how many queries a CONCURRENCY_LIMIT rule
+// lets execute at once.
+func (qr *Rule) SetMaxConcurrency(maxConcurrency int) {
+	qr.maxConcurrency = maxConcurrency
+}
+
+// SetRewrite sets the MAX_EXECUTION_TIME hint and the LIMIT a REWRITE
+// rule adds to selects. Zero values are not added.
+func (qr *Rule) SetRewrite(maxExecutionTime time.Duration, limit int64) {
+	qr.maxExecutionTime = maxExecutionTime
+	qr.limit = limit
+}
+
+// Action returns the action of the rule.
+func (qr *Rule) Action() Action {
+	return qr.act
+}
+
+// Enforce performs the DELAY, THROTTLE or CONCURRENCY_LIMIT action of the
+// rule, for a query it fired on, before the query executes. It fails the query
+// if the rule is over its limit. The returned function must be called once the
+// query is done. REWRITE is performed by Rewrite.
+func (qr *Rule) Enforce(ctx context.Context) (done func(), err error) {
+	done = func() {}
+	switch qr.act {
+	case QRDelay:
+		timer := time.NewTimer(qr.delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return nil, vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "context ended while delayed due to rule: %s: %v", qr.Description, ctx.Err())
+		}
+	case QRThrottle:
+		if !qr.takeToken(time.Now()) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "throttled due to rule: %s", qr.Description)
+		}
+	case QRConcurrencyLimit:
+		qr.state.mu.Lock()
+		defer qr.state.mu.Unlock()
+		if qr.state.concurrency >= qr.maxConcurrency {
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "too many concurrent queries due to rule: %s", qr.Description)
+		}
+		qr.state.concurrency++
+		done = func() {
+			qr.state.mu.Lock()
+			defer qr.state.mu.Unlock()
+			qr.state.concurrency--
+		}
+	}
+	return done, nil
+}
+
+// takeToken takes a token from the token bucket of a THROTTLE rule.
+// It returns false if the bucket is empty.
+func (qr *Rule) takeToken(now time.Time) bool {
+	burst := float64(qr.burst)
+	if burst <= 0 {
+		burst = math.Max(1, math.Ceil(qr.maxQPS))
+	}
+	qr.state.mu.Lock()
+	defer qr.state.mu.Unlock()
+	if qr.state.last.IsZero() {
+		qr.state.tokens = burst
+	} else {
+		qr.state.tokens = math.Min(burst, qr.state.tokens+now.Sub(qr.state.last).Seconds()*qr.maxQPS)
+	}
+	qr.state.last = now
+	if qr.state.tokens < 1 {
+		return false
+	}
+	qr.state.tokens--
+	return true
+}
+
+// rewriteLimitArg is the bind variable of the row count of the LIMIT of a
+// select rewritten by a REWRITE rule, when the original row count is a bind variable.
+const rewriteLimitArg = "#ruleLimit"
+
+// rewritePlan adds the MAX_EXECUTION_TIME hint and the LIMIT of a REWRITE rule
+// to a copy of a select, and keeps the query generated from it for
+// GenerateRewrittenQuery. The LIMIT only replaces a larger one. Other
+// statements are not rewritten.
+func (qr *Rule) rewritePlan(stmt sqlparser.Statement, generate func(sqlparser.SelectStatement) *sqlparser.ParsedQuery) {
+	if qr.act != QRRewrite {
+		return
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return
+	}
+	sel = sqlparser.CloneRefOfSelect(sel)
+	qr.rewrittenLimitArg = ""
+	if qr.maxExecutionTime > 0 {
+		hint := fmt.Sprintf("/*+ MAX_EXECUTION_TIME(%d) */", qr.maxExecutionTime.Milliseconds())
+		sel.Comments = append(sel.Comments, hint)
+	}
+	if qr.limit > 0 {
+		if sel.Limit == nil {
+			sel.Limit = &sqlparser.Limit{}
+		}
+		if arg, ok := sel.Limit.Rowcount.(sqlparser.Argument); ok {
+			// The row count is only known when the query is generated.
+			qr.rewrittenLimitArg = string(arg)
+			sel.Limit.Rowcount = sqlparser.NewArgument(rewriteLimitArg)
+		} else if !limitAtMost(sel.Limit.Rowcount, qr.limit) {
+			sel.Limit.Rowcount = sqlparser.NewIntLiteral(strconv.FormatInt(qr.limit, 10))
+		}
+	}
+	qr.rewritten = generate(sel)
+}
+
+// GenerateRewrittenQuery generates the query of the plan the rule was
+// filtered into, as rewritten by the rule, for the bind variables.
+// It returns false if the rule did not rewrite the query.
+func (qr *Rule) GenerateRewrittenQuery(bindVars map[string]*querypb.BindVariable) (string, bool, error) {
+	if qr.rewritten == nil {
+		return "", false, nil
+	}
+	var extras map[string]sqlparser.Encodable
+	if qr.rewrittenLimitArg != "" {
+		bv, _, err := sqlparser.FetchBindVar(":"+qr.rewrittenLimitArg, bindVars)
+		if err != nil {
+			return "", true, err
+		}
+		value, err := sqltypes.BindVariableToValue(bv)
+		if err != nil {
+			return "", true, err
+		}
+		rowcount, err := evalengine.ToInt64(value)
+		if err != nil {
+			return "", true, err
+		}
+		if rowcount > qr.limit {
+			rowcount = qr.limit
+		}
+		extras = map[string]sqlparser.Encodable{rewriteLimitArg: limitRowcount(rowcount)}
+	}
+	query, err := qr.rewritten.GenerateQuery(bindVars, extras)
+	return query, true, err
+}
+
+// limitRowcount is the row count of the LIMIT of a rewritten select.
+type limitRowcount int64
+
+// EncodeSQL is part of the sqlparser.Encodable interface.
+func (rc limitRowcount) EncodeSQL(buf *strings.Builder) {
+	buf.WriteString(strconv.FormatInt(int64(rc), 10))
+}
+
+// limitAtMost returns true if the row count of a LIMIT is a number
+// not larger than limit.
+func limitAtMost(rowcount sqlparser.Expr, limit int64) bool {
+	lit, ok := rowcount.(*sqlparser.Literal)
+	if !ok || lit.Type != sqlparser.IntVal {
+		return false
+	}
+	n, err := strconv.ParseInt(lit.Val, 10, 64)
+	return err == nil && n <= limit
+}
+
 // makeExact forces a full string match for the regex instead of substring
 func makeExact(pattern string) string {
 	return fmt.Sprintf("^%s$", pattern)
@@ -455,6 +743,11 @@ func (qr *Rule) FilterByPlan(query string, planid planbuilder.PlanType, tableNam
 	return newqr
 }
 
+// MatchesQuery returns true if the query condition of the rule matches the query.
+func (qr *Rule) MatchesQuery(query string) bool {
+	return reMatch(qr.query.Regexp, query)
+}
+
 // GetAction returns the action for a single rule.
 func (qr *Rule) GetAction(
 	ip,
@@ -533,21 +826,42 @@ const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	QRDelay
+	QRThrottle
+	QRConcurrencyLimit
+	QRRewrite
 )
 
+var actionNames = map[Action]string{
+	QRFail:             "FAIL",
+	QRFailRetry:        "FAIL_RETRY",
+	QRDelay:            "DELAY",
+	QRThrottle:         "THROTTLE",
+	QRConcurrencyLimit: "CONCURRENCY_LIMIT",
+	QRRewrite:          "REWRITE",
+}
+
+// ActionByName returns the Action of a name, as found in the JSON of rules.
+func ActionByName(name string) (Action, bool) {
+	for act, actName := range actionNames {
+		if actName == name {
+			return act, true
+		}
+	}
+	return QRContinue, false
+}
+
+// String returns the name of the action, as found in the JSON of rules.
+func (act Action) String() string {
+	if str, ok := actionNames[act]; ok {
+		return str
+	}
+	return "INVALID"
+}
+
 // MarshalJSON marshals to JSON.
 func (act Action) MarshalJSON() ([]byte, error) {
-	// If we add more actions, we'll need to use a map.
-	var str string
-	switch act {
-	case QRFail:
-		str = "FAIL"
-	case QRFailRetry:
-		str = "FAIL_RETRY"
-	default:
-		str = "INVALID"
-	}
-	return json.Marshal(str)
+	return json.Marshal(act.String())
 }
 
 // BindVarCond represents a bind var condition.
@@ -832,13 +1146,19 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	for k, v := range ruleInfo {
 		var sv string
 		var lv []interface{}
+		var nv json.Number
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment", "Delay", "MaxExecutionTime":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
 			}
+		case "MaxQPS", "Burst", "MaxConcurrency", "Limit":
+			nv, ok = v.(json.Number)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for %s", k)
+			}
 		case "Plans", "BindVarConds", "TableNames":
 			lv, ok = v.([]interface{})
 			if !ok {
@@ -909,19 +1229,70 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				}
 			}
 		case "Action":
-			switch sv {
-			case "FAIL":
-				qr.act = QRFail
-			case "FAIL_RETRY":
-				qr.act = QRFailRetry
-			default:
+			act, ok := ActionByName(sv)
+			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+			qr.act = act
+		case "Delay":
+			qr.delay, err = time.ParseDuration(sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want duration for Delay: %s", sv)
+			}
+		case "MaxExecutionTime":
+			qr.maxExecutionTime, err = time.ParseDuration(sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want duration for MaxExecutionTime: %s", sv)
+			}
+		case "MaxQPS":
+			qr.maxQPS, err = nv.Float64()
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for MaxQPS: %s", nv)
+			}
+		case "Burst", "MaxConcurrency", "Limit":
+			n, err := nv.Int64()
+			if err != nil || n < 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want non-negative integer for %s: %s", k, nv)
+			}
+			switch k {
+			case "Burst":
+				qr.burst = int(n)
+			case "MaxConcurrency":
+				qr.maxConcurrency = int(n)
+			case "Limit":
+				qr.limit = n
+			}
 		}
 	}
+	if err := qr.ValidateAction(); err != nil {
+		return nil, err
+	}
 	return qr, nil
 }
 
+// ValidateAction returns an error if the action of the rule misses its parameters.
+func (qr *Rule) ValidateAction() error {
+	switch qr.act {
+	case QRDelay:
+		if qr.delay <= 0 {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Delay must be positive for Action DELAY")
+		}
+	case QRThrottle:
+		if qr.maxQPS <= 0 {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxQPS must be positive for Action THROTTLE")
+		}
+	case QRConcurrencyLimit:
+		if qr.maxConcurrency <= 0 {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxConcurrency must be positive for Action CONCURRENCY_LIMIT")
+		}
+	case QRRewrite:
+		if qr.maxExecutionTime <= 0 && qr.limit <= 0 {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxExecutionTime or Limit must be set for Action REWRITE")
+		}
+	}
+	return nil
+}
+
 func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch bool, op Operator, value interface{}, err error) {
 	bvcinfo, ok := bvc.(map[string]interface{})
 	if !ok {
@@ -18,13 +18,16 @@ package rules
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"reflect"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -544,6 +547,24 @@ func TestAction(t *testing.T) {
 	assert.Equalf(t, desc, "rule 5", "want rule 5, got %s", desc)
 }
 
+func TestGetRuleFailFirst(t *testing.T) {
+	qrs := New()
+	delay := NewQueryRule("delay", "r1", QRDelay)
+	delay.SetDelay(time.Second)
+	qrs.Add(delay)
+	throttle := NewQueryRule("throttle", "r2", QRThrottle)
+	throttle.SetThrottle(1, 1)
+	qrs.Add(throttle)
+	fail := NewQueryRule("fail", "r3", QRFail)
+	fail.SetUserCond("banned")
+	qrs.Add(fail)
+
+	// A FAIL rule blocks the query even if a rule listed before it fires.
+	assert.Equal(t, fail, qrs.GetRule("", "banned", nil, sqlparser.MarginComments{}))
+	// Otherwise, the first rule that fires is performed.
+	assert.Equal(t, delay, qrs.GetRule("", "user", nil, sqlparser.MarginComments{}))
+}
+
 func TestImport(t *testing.T) {
 	var qrs = New()
 	jsondata := `[{
@@ -692,6 +713,15 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"Delay": 1 }]`, "want string for Delay"},
+	{`[{"Delay": "1" }]`, "want duration for Delay: 1"},
+	{`[{"MaxQPS": "1" }]`, "want number for MaxQPS"},
+	{`[{"MaxConcurrency": -1 }]`, "want non-negative integer for MaxConcurrency: -1"},
+	{`[{"Limit": 1.5 }]`, "want non-negative integer for Limit: 1.5"},
+	{`[{"Action": "DELAY" }]`, "Delay must be positive for Action DELAY"},
+	{`[{"Action": "THROTTLE", "Burst": 2 }]`, "MaxQPS must be positive for Action THROTTLE"},
+	{`[{"Action": "CONCURRENCY_LIMIT" }]`, "MaxConcurrency must be positive for Action CONCURRENCY_LIMIT"},
+	{`[{"Action": "REWRITE" }]`, "MaxExecutionTime or Limit must be set for Action REWRITE"},
 }
 
 func TestInvalidJSON(t *testing.T) {
@@ -770,3 +800,144 @@ func marshalled(in interface{}) string {
 	}
 	return string(b)
 }
+
+func TestActionsJSON(t *testing.T) {
+	input := `[{"Name":"r1","Action":"DELAY","Delay":"100ms"},` +
+		`{"Name":"r2","Action":"THROTTLE","MaxQPS":0.5,"Burst":2},` +
+		`{"Name":"r3","Action":"CONCURRENCY_LIMIT","MaxConcurrency":3},` +
+		`{"Name":"r4","Action":"REWRITE","MaxExecutionTime":"1s","Limit":10}]`
+	qrs := New()
+	require.NoError(t, qrs.UnmarshalJSON([]byte(input)))
+
+	want := New()
+	qr := NewQueryRule("", "r1", QRDelay)
+	qr.SetDelay(100 * time.Millisecond)
+	want.Add(qr)
+	qr = NewQueryRule("", "r2", QRThrottle)
+	qr.SetThrottle(0.5, 2)
+	want.Add(qr)
+	qr = NewQueryRule("", "r3", QRConcurrencyLimit)
+	qr.SetMaxConcurrency(3)
+	want.Add(qr)
+	qr = NewQueryRule("", "r4", QRRewrite)
+	qr.SetRewrite(time.Second, 10)
+	want.Add(qr)
+	assert.True(t, want.Equal(qrs))
+
+	out, err := qrs.MarshalJSON()
+	require.NoError(t, err)
+	got := New()
+	require.NoError(t, got.UnmarshalJSON(out))
+	assert.True(t, want.Equal(got), "%s", out)
+}
+
+func TestEnforce(t *testing.T) {
+	ctx := context.Background()
+
+	delay := NewQueryRule("delay", "r1", QRDelay)
+	delay.SetDelay(10 * time.Millisecond)
+	start := time.Now()
+	done, err := delay.Enforce(ctx)
+	require.NoError(t, err)
+	done()
+	assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	delay.SetDelay(time.Hour)
+	_, err = delay.Enforce(cancelledCtx)
+	assert.EqualError(t, err, "context ended while delayed due to rule: delay: context canceled")
+
+	limit := NewQueryRule("limit", "r2", QRConcurrencyLimit)
+	limit.SetMaxConcurrency(1)
+	// The copies of a rule share its limit.
+	limitCopy := limit.FilterByPlan("select 1", planbuilder.PlanSelect, "")
+	done, err = limit.Enforce(ctx)
+	require.NoError(t, err)
+	_, err = limitCopy.Enforce(ctx)
+	assert.EqualError(t, err, "too many concurrent queries due to rule: limit")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	done()
+	done, err = limitCopy.Enforce(ctx)
+	require.NoError(t, err)
+	done()
+}
+
+func TestThrottle(t *testing.T) {
+	qr := NewQueryRule("throttle", "r1", QRThrottle)
+	qr.SetThrottle(2, 0)
+	now := time.Now()
+	// The bucket starts full, with one second worth of queries.
+	assert.True(t, qr.takeToken(now))
+	assert.True(t, qr.takeToken(now))
+	assert.False(t, qr.takeToken(now))
+	assert.False(t, qr.takeToken(now.Add(400*time.Millisecond)))
+	assert.True(t, qr.takeToken(now.Add(500*time.Millisecond)))
+	// The bucket does not fill beyond the burst.
+	assert.True(t, qr.takeToken(now.Add(time.Hour)))
+	assert.True(t, qr.takeToken(now.Add(time.Hour)))
+	assert.False(t, qr.takeToken(now.Add(time.Hour)))
+
+	_, err := qr.Enforce(context.Background())
+	assert.EqualError(t, err, "throttled due to rule: throttle")
+}
+
+func TestRewrite(t *testing.T) {
+	testcases := []struct {
+		in       string
+		bindVars map[string]*querypb.BindVariable
+		out      string
+	}{{
+		in:  "select a from t",
+		out: "select /*+ MAX_EXECUTION_TIME(1500) */ a from t limit 10",
+	}, {
+		in:  "select a from t limit 5",
+		out: "select /*+ MAX_EXECUTION_TIME(1500) */ a from t limit 5",
+	}, {
+		in:  "select /* comment */ a from t limit 2, 10001",
+		out: "select /* comment */ /*+ MAX_EXECUTION_TIME(1500) */ a from t limit 2, 10",
+	}, {
+		in:       "select a from t where a = :vtg1 limit :vtg1",
+		bindVars: map[string]*querypb.BindVariable{"vtg1": sqltypes.Int64BindVariable(5)},
+		out:      "select /*+ MAX_EXECUTION_TIME(1500) */ a from t where a = 5 limit 5",
+	}, {
+		in:       "select a from t where a = :vtg1 limit :vtg1",
+		bindVars: map[string]*querypb.BindVariable{"vtg1": sqltypes.Int64BindVariable(100)},
+		out:      "select /*+ MAX_EXECUTION_TIME(1500) */ a from t where a = 100 limit 10",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			qr := NewQueryRule("rewrite", "r1", QRRewrite)
+			qr.SetRewrite(1500*time.Millisecond, 10)
+			stmt, err := sqlparser.Parse(tc.in)
+			require.NoError(t, err)
+			qr.rewritePlan(stmt, planbuilder.GenerateLimitQuery)
+			got, ok, err := qr.GenerateRewrittenQuery(tc.bindVars)
+			require.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, tc.out, got)
+			// The statement of the plan is not changed.
+			assert.Equal(t, tc.in, sqlparser.String(stmt))
+		})
+	}
+
+	// Without a LIMIT, the select plans are limited by the max rows.
+	qr := NewQueryRule("rewrite", "r1", QRRewrite)
+	qr.SetRewrite(1500*time.Millisecond, 0)
+	stmt, err := sqlparser.Parse("select a from t")
+	require.NoError(t, err)
+	qr.rewritePlan(stmt, planbuilder.GenerateLimitQuery)
+	got, ok, err := qr.GenerateRewrittenQuery(map[string]*querypb.BindVariable{"#maxLimit": sqltypes.Int64BindVariable(10001)})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "select /*+ MAX_EXECUTION_TIME(1500) */ a from t limit 10001", got)
+
+	// Only selects are rewritten.
+	qr = NewQueryRule("rewrite", "r1", QRRewrite)
+	qr.SetRewrite(0, 10)
+	stmt, err = sqlparser.Parse("update t set a = 1")
+	require.NoError(t, err)
+	qr.rewritePlan(stmt, planbuilder.GenerateLimitQuery)
+	_, ok, err = qr.GenerateRewrittenQuery(nil)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
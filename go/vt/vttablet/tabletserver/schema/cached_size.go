@@ -52,7 +52,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(136)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.TableIdent
 	size += cached.Name.CachedSize(false)
@@ -67,6 +67,15 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.PKColumns)) * int64(8))
 	}
+	// field UniqueKeys [][]int
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.UniqueKeys)) * int64(24))
+		for _, elem := range cached.UniqueKeys {
+			{
+				size += hack.RuntimeAllocSize(int64(cap(elem)) * int64(8))
+			}
+		}
+	}
 	// field SequenceInfo *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.SequenceInfo
 	size += cached.SequenceInfo.CachedSize(true)
 	// field MessageInfo *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.MessageInfo
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
 	"sync"
 	"time"
@@ -432,12 +433,16 @@ func (se *Engine) mysqlTime(ctx context.Context, conn *connpool.DBConn) (int64,
 	return t, nil
 }
 
-// populatePrimaryKeys populates the PKColumns for the specified tables.
+// populatePrimaryKeys populates the PKColumns and UniqueKeys for the specified tables.
 func (se *Engine) populatePrimaryKeys(ctx context.Context, conn *connpool.DBConn, tables map[string]*Table) error {
-	pkData, err := conn.Exec(ctx, mysql.BaseShowPrimary, maxTableCount, false)
+	// The query returns a row for every column of every primary and unique key,
+	// so there can be many more rows than tables.
+	pkData, err := conn.Exec(ctx, mysql.BaseShowPrimary, math.MaxInt32, false)
 	if err != nil {
 		return vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "could not get table primary key info: %v", err)
 	}
+	// The columns of a key are listed in consecutive rows.
+	var lastTable, lastKey string
 	for _, row := range pkData.Rows {
 		tableName := row[0].ToString()
 		table, ok := tables[tableName]
@@ -449,7 +454,20 @@ func (se *Engine) populatePrimaryKeys(ctx context.Context, conn *connpool.DBConn
 		if index < 0 {
 			return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "column %v is listed as primary key, but not present in table %v", colName, tableName)
 		}
-		table.PKColumns = append(table.PKColumns, index)
+		keyName := "PRIMARY"
+		if len(row) > 2 {
+			keyName = row[2].ToString()
+		}
+		if keyName == "PRIMARY" {
+			table.PKColumns = append(table.PKColumns, index)
+			continue
+		}
+		if tableName != lastTable || keyName != lastKey || len(table.UniqueKeys) == 0 {
+			table.UniqueKeys = append(table.UniqueKeys, nil)
+			lastTable, lastKey = tableName, keyName
+		}
+		last := len(table.UniqueKeys) - 1
+		table.UniqueKeys[last] = append(table.UniqueKeys[last], index)
 	}
 	return nil
 }
@@ -141,6 +141,9 @@ func TestOpenAndReload(t *testing.T) {
 			mysql.ShowPrimaryRow("test_table_02", "pk"),
 			mysql.ShowPrimaryRow("test_table_03", "pk1"),
 			mysql.ShowPrimaryRow("test_table_03", "pk2"),
+			mysql.ShowUniqueKeyRow("test_table_03", "uk_pk2_val", "pk2"),
+			mysql.ShowUniqueKeyRow("test_table_03", "uk_pk2_val", "val"),
+			mysql.ShowUniqueKeyRow("test_table_03", "uk_val", "val"),
 			mysql.ShowPrimaryRow("test_table_04", "pk"),
 			mysql.ShowPrimaryRow("seq", "id"),
 		},
@@ -182,6 +185,7 @@ func TestOpenAndReload(t *testing.T) {
 			Type: sqltypes.Int32,
 		}},
 		PKColumns:     []int{0, 1},
+		UniqueKeys:    [][]int{{1, 2}, {2}},
 		FileSize:      128,
 		AllocatedSize: 256,
 	}
@@ -45,7 +45,9 @@ type Table struct {
 	Name      sqlparser.TableIdent
 	Fields    []*querypb.Field
 	PKColumns []int
-	Type      int
+	// UniqueKeys contains the columns of the unique secondary keys.
+	UniqueKeys [][]int
+	Type       int
 
 	// SequenceInfo contains info for sequence tables.
 	SequenceInfo *SequenceInfo
@@ -64,13 +64,16 @@ type StatefulConnectionPool struct {
 func NewStatefulConnPool(env tabletenv.Env) *StatefulConnectionPool {
 	config := env.Config()
 
-	return &StatefulConnectionPool{
+	sf := &StatefulConnectionPool{
 		env:           env,
 		conns:         connpool.NewPool(env, "TransactionPool", config.TxPool),
 		foundRowsPool: connpool.NewPool(env, "FoundRowsPool", config.TxPool),
 		active:        pools.NewNumbered(),
 		lastID:        sync2.NewAtomicInt64(time.Now().UnixNano()),
 	}
+	sf.conns.Prioritize()
+	sf.foundRowsPool.Prioritize()
+	return sf
 }
 
 // Open makes the TxPool operational. This also starts the transaction killer
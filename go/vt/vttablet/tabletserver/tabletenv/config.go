@@ -20,6 +20,8 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/encoding/prototext"
@@ -75,6 +77,7 @@ var (
 	enableHotRowProtectionDryRun bool
 	enableConsolidator           bool
 	enableConsolidatorReplicas   bool
+	consolidatorBatchWindow      time.Duration
 	enableHeartbeat              bool
 	heartbeatInterval            time.Duration
 	healthCheckInterval          time.Duration
@@ -82,6 +85,7 @@ var (
 	unhealthyThreshold           time.Duration
 	transitionGracePeriod        time.Duration
 	enableReplicationReporter    bool
+	priorityClasses              []string
 )
 
 func init() {
@@ -140,6 +144,7 @@ func init() {
 	flag.IntVar(&currentConfig.HotRowProtection.MaxQueueSize, "hot_row_protection_max_queue_size", defaultConfig.HotRowProtection.MaxQueueSize, "Maximum number of BeginExecute RPCs which will be queued for the same row (range).")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxGlobalQueueSize, "hot_row_protection_max_global_queue_size", defaultConfig.HotRowProtection.MaxGlobalQueueSize, "Global queue limit across all row (ranges). Useful to prevent that the queue can grow unbounded.")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxConcurrency, "hot_row_protection_concurrent_transactions", defaultConfig.HotRowProtection.MaxConcurrency, "Number of concurrent transactions let through to the txpool/MySQL for the same hot row. Should be > 1 to have enough 'ready' transactions in MySQL and benefit from a pipelining effect.")
+	SecondsVar(&currentConfig.HotRowProtection.HotKeyTTLSeconds, "hot_row_protection_hot_key_ttl", defaultConfig.HotRowProtection.HotKeyTTLSeconds, "How long (in seconds) a row stays hot after a transaction timed out waiting for its lock in MySQL. The transactions for a hot row are let through to the txpool/MySQL one at a time. 0 disables the detection of hot rows.")
 
 	flag.BoolVar(&currentConfig.EnableTransactionLimit, "enable_transaction_limit", defaultConfig.EnableTransactionLimit, "If true, limit on number of transactions open at the same time will be enforced for all users. User trying to open a new transaction after exhausting their limit will receive an error immediately, regardless of whether there are available slots or not.")
 	flag.BoolVar(&currentConfig.EnableTransactionLimitDryRun, "enable_transaction_limit_dry_run", defaultConfig.EnableTransactionLimitDryRun, "If true, limit on number of transactions open at the same time will be tracked for all users, but not enforced.")
@@ -149,6 +154,10 @@ func init() {
 	flag.BoolVar(&currentConfig.TransactionLimitByComponent, "transaction_limit_by_component", defaultConfig.TransactionLimitByComponent, "Include CallerID.component when considering who the user is for the purpose of transaction limit.")
 	flag.BoolVar(&currentConfig.TransactionLimitBySubcomponent, "transaction_limit_by_subcomponent", defaultConfig.TransactionLimitBySubcomponent, "Include CallerID.subcomponent when considering who the user is for the purpose of transaction limit.")
 
+	flagutil.StringListVar(&priorityClasses, "priority_classes", nil, "Comma-separated list of the priority classes of the queries and transactions, of the form name:weight[:max_concurrency[:queue_timeout]]. The classes share the query and transaction pools with weighted fair queuing: when requests queue for a connection, each class gets connections in proportion to its weight. A class may not use more than max_concurrency connections of each pool at once if it is not 0, and its requests fail if they queue longer than queue_timeout if it is not 0. Prioritization is disabled if empty.")
+	flag.StringVar(&currentConfig.Priority.DefaultClass, "priority_default_class", defaultConfig.Priority.DefaultClass, "Priority class of the requests that are not assigned to any class by -priority_class_by_user or a PRIORITY_CLASS query comment directive. The first class of -priority_classes if empty.")
+	flag.Var((*flagutil.StringMapValue)(&currentConfig.Priority.Users), "priority_class_by_user", "Comma-separated list of user:class pairs assigning the requests of the users to priority classes. The users are matched against CallerID.principal, then VTGateCallerID.username.")
+
 	flag.BoolVar(&enableHeartbeat, "heartbeat_enable", false, "If true, vttablet records (if master) or checks (if replica) the current time of a replication heartbeat in the table _vt.heartbeat. The result is used to inform the serving state of the vttablet via healthchecks.")
 	flag.DurationVar(&heartbeatInterval, "heartbeat_interval", 1*time.Second, "How frequently to read and write replication heartbeat.")
 	flagutil.DualFormatBoolVar(&currentConfig.EnableLagThrottler, "enable_lag_throttler", defaultConfig.EnableLagThrottler, "If true, vttablet will run a throttler service, and will implicitly enable heartbeats")
@@ -156,6 +165,8 @@ func init() {
 	flag.BoolVar(&currentConfig.EnforceStrictTransTables, "enforce_strict_trans_tables", defaultConfig.EnforceStrictTransTables, "If true, vttablet requires MySQL to run with STRICT_TRANS_TABLES or STRICT_ALL_TABLES on. It is recommended to not turn this flag off. Otherwise MySQL may alter your supplied values before saving them to the database.")
 	flagutil.DualFormatBoolVar(&enableConsolidator, "enable_consolidator", true, "This option enables the query consolidator.")
 	flagutil.DualFormatBoolVar(&enableConsolidatorReplicas, "enable_consolidator_replicas", false, "This option enables the query consolidator only on replicas.")
+	flag.DurationVar(&consolidatorBatchWindow, "consolidator_batch_window", 0, "If not 0, the query consolidator batches the concurrent point lookups of a table by its integral primary key that only differ by the key: while such a lookup is executed, the next one waits up to this long for other ones to join it, then the batch is sent to MySQL as a single query with an IN clause and its rows are split back between the lookups. Requires -enable_consolidator and -enable_query_plan_field_caching.")
+	flag.IntVar(&currentConfig.ConsolidatorBatchMaxSize, "consolidator_batch_max_size", defaultConfig.ConsolidatorBatchMaxSize, "Maximum number of keys of a batch of point lookups. A batch is sent to MySQL before the end of -consolidator_batch_window once it is full.")
 	flagutil.DualFormatBoolVar(&currentConfig.CacheResultFields, "enable_query_plan_field_caching", defaultConfig.CacheResultFields, "This option fetches & caches fields (columns) when storing query plans")
 
 	flag.DurationVar(&healthCheckInterval, "health_check_interval", 20*time.Second, "Interval between health checks")
@@ -193,6 +204,8 @@ func Init() {
 		currentConfig.Consolidator = Disable
 	}
 
+	currentConfig.ConsolidatorBatchWindowSeconds.Set(consolidatorBatchWindow)
+
 	if heartbeatInterval == 0 {
 		heartbeatInterval = time.Duration(defaultConfig.ReplicationTracker.HeartbeatIntervalSeconds*1000) * time.Millisecond
 	}
@@ -215,6 +228,17 @@ func Init() {
 	currentConfig.Healthcheck.UnhealthyThresholdSeconds.Set(unhealthyThreshold)
 	currentConfig.GracePeriods.TransitionSeconds.Set(transitionGracePeriod)
 
+	if len(priorityClasses) != 0 {
+		currentConfig.Priority.Classes = nil
+		for _, pc := range priorityClasses {
+			class, err := parsePriorityClass(pc)
+			if err != nil {
+				log.Exitf("Invalid priority_classes value %v: %v", pc, err)
+			}
+			currentConfig.Priority.Classes = append(currentConfig.Priority.Classes, class)
+		}
+	}
+
 	switch *streamlog.QueryLogFormat {
 	case streamlog.QueryLogFormatText:
 	case streamlog.QueryLogFormatJSON:
@@ -241,6 +265,7 @@ type TabletConfig struct {
 
 	Oltp             OltpConfig             `json:"oltp,omitempty"`
 	HotRowProtection HotRowProtectionConfig `json:"hotRowProtection,omitempty"`
+	Priority         PriorityConfig         `json:"priority,omitempty"`
 
 	Healthcheck  HealthcheckConfig  `json:"healthcheck,omitempty"`
 	GracePeriods GracePeriodsConfig `json:"gracePeriods,omitempty"`
@@ -254,6 +279,8 @@ type TabletConfig struct {
 	StreamBufferSize                        int     `json:"streamBufferSize,omitempty"`
 	ConsolidatorStreamTotalSize             int64   `json:"consolidatorStreamTotalSize,omitempty"`
 	ConsolidatorStreamQuerySize             int64   `json:"consolidatorStreamQuerySize,omitempty"`
+	ConsolidatorBatchWindowSeconds          Seconds `json:"consolidatorBatchWindowSeconds,omitempty"`
+	ConsolidatorBatchMaxSize                int     `json:"consolidatorBatchMaxSize,omitempty"`
 	QueryCacheSize                          int     `json:"queryCacheSize,omitempty"`
 	QueryCacheMemory                        int64   `json:"queryCacheMemory,omitempty"`
 	QueryCacheLFU                           bool    `json:"queryCacheLFU,omitempty"`
@@ -312,6 +339,62 @@ type HotRowProtectionConfig struct {
 	MaxQueueSize       int    `json:"maxQueueSize,omitempty"`
 	MaxGlobalQueueSize int    `json:"maxGlobalQueueSize,omitempty"`
 	MaxConcurrency     int    `json:"maxConcurrency,omitempty"`
+	// HotKeyTTLSeconds is how long a row stays hot after MySQL timed out
+	// waiting for one of its locks.
+	HotKeyTTLSeconds Seconds `json:"hotKeyTTLSeconds,omitempty"`
+}
+
+// PriorityConfig contains the config for the priority classes of the queries
+// and transactions. Prioritization is disabled if there are no classes.
+type PriorityConfig struct {
+	Classes []PriorityClassConfig `json:"classes,omitempty"`
+	// DefaultClass is the class of the requests that are not assigned to
+	// any class. The first class is the default if empty.
+	DefaultClass string `json:"defaultClass,omitempty"`
+	// Users maps the principals of the effective callers and the
+	// usernames of the immediate callers to their class.
+	Users map[string]string `json:"users,omitempty"`
+}
+
+// PriorityClassConfig contains the config for a priority class.
+type PriorityClassConfig struct {
+	Name string `json:"name,omitempty"`
+	// Weight is the share of the connections the class gets when
+	// requests queue for them, relative to the other classes.
+	Weight int `json:"weight,omitempty"`
+	// MaxConcurrency is the number of connections of each pool the
+	// class may use at once. There is no ceiling if 0.
+	MaxConcurrency int `json:"maxConcurrency,omitempty"`
+	// QueueTimeoutSeconds is how long the requests of the class may queue.
+	// They queue until their context is done if 0.
+	QueueTimeoutSeconds Seconds `json:"queueTimeoutSeconds,omitempty"`
+}
+
+// parsePriorityClass parses a priority class of the form
+// name:weight[:max_concurrency[:queue_timeout]].
+func parsePriorityClass(s string) (PriorityClassConfig, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 4 {
+		return PriorityClassConfig{}, fmt.Errorf("want name:weight[:max_concurrency[:queue_timeout]]")
+	}
+	class := PriorityClassConfig{Name: parts[0]}
+	var err error
+	if class.Weight, err = strconv.Atoi(parts[1]); err != nil {
+		return PriorityClassConfig{}, fmt.Errorf("invalid weight: %v", err)
+	}
+	if len(parts) > 2 {
+		if class.MaxConcurrency, err = strconv.Atoi(parts[2]); err != nil {
+			return PriorityClassConfig{}, fmt.Errorf("invalid max_concurrency: %v", err)
+		}
+	}
+	if len(parts) > 3 {
+		timeout, err := time.ParseDuration(parts[3])
+		if err != nil {
+			return PriorityClassConfig{}, fmt.Errorf("invalid queue_timeout: %v", err)
+		}
+		class.QueueTimeoutSeconds.Set(timeout)
+	}
+	return class, nil
 }
 
 // HealthcheckConfig contains the config for healthcheck.
@@ -383,7 +466,13 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
-	return nil
+	if v := c.HotRowProtection.HotKeyTTLSeconds; v < 0 {
+		return fmt.Errorf("-hot_row_protection_hot_key_ttl must be >= 0 (specified value: %v)", v)
+	}
+	if v := c.ConsolidatorBatchMaxSize; c.ConsolidatorBatchWindowSeconds > 0 && v <= 0 {
+		return fmt.Errorf("-consolidator_batch_max_size must be > 0 (specified value: %v)", v)
+	}
+	return c.verifyPriorityConfig()
 }
 
 // verifyTransactionLimitConfig checks TransactionLimitConfig for sanity
@@ -416,6 +505,38 @@ func (c *TabletConfig) verifyTransactionLimitConfig() error {
 	return nil
 }
 
+// verifyPriorityConfig checks PriorityConfig for sanity
+func (c *TabletConfig) verifyPriorityConfig() error {
+	classes := make(map[string]bool)
+	for _, class := range c.Priority.Classes {
+		if class.Name == "" {
+			return errors.New("priority class names must not be empty")
+		}
+		if classes[class.Name] {
+			return fmt.Errorf("duplicate priority class: %v", class.Name)
+		}
+		classes[class.Name] = true
+		if class.Weight <= 0 {
+			return fmt.Errorf("weight of priority class %v must be > 0 (specified value: %v)", class.Name, class.Weight)
+		}
+		if class.MaxConcurrency < 0 {
+			return fmt.Errorf("max concurrency of priority class %v must be >= 0 (specified value: %v)", class.Name, class.MaxConcurrency)
+		}
+		if class.QueueTimeoutSeconds < 0 {
+			return fmt.Errorf("queue timeout of priority class %v must be >= 0 (specified value: %v)", class.Name, class.QueueTimeoutSeconds.Get())
+		}
+	}
+	if class := c.Priority.DefaultClass; class != "" && !classes[class] {
+		return fmt.Errorf("-priority_default_class is not in -priority_classes: %v", class)
+	}
+	for user, class := range c.Priority.Users {
+		if !classes[class] {
+			return fmt.Errorf("priority class of user %v is not in -priority_classes: %v", user, class)
+		}
+	}
+	return nil
+}
+
 // Some of these values are for documentation purposes.
 // They actually get overwritten during Init.
 var defaultConfig = TabletConfig{
@@ -455,11 +576,13 @@ var defaultConfig = TabletConfig{
 		MaxGlobalQueueSize: 1000,
 		// Allow more than 1 transaction for the same hot row through to have enough
 		// of them ready in MySQL and profit from a pipelining effect.
-		MaxConcurrency: 5,
+		MaxConcurrency:   5,
+		HotKeyTTLSeconds: 60,
 	},
 	Consolidator:                Enable,
 	ConsolidatorStreamTotalSize: 128 * 1024 * 1024,
 	ConsolidatorStreamQuerySize: 2 * 1024 * 1024,
+	ConsolidatorBatchMaxSize:    100,
 	// The value for StreamBufferSize was chosen after trying out a few of
 	// them. Too small buffers force too many packets to be sent. Too big
 	// buffers force the clients to read them in multiple chunks and make
@@ -78,6 +78,7 @@ oltpReadPool:
   prefillParallelism: 30
   size: 16
   timeoutSeconds: 10
+priority: {}
 replicationTracker: {}
 txPool: {}
 `
@@ -111,6 +112,7 @@ func TestDefaultConfig(t *testing.T) {
 	require.NoError(t, err)
 	want := `cacheResultFields: true
 consolidator: enable
+consolidatorBatchMaxSize: 100
 consolidatorStreamQuerySize: 2097152
 consolidatorStreamTotalSize: 134217728
 gracePeriods: {}
@@ -119,6 +121,7 @@ healthcheck:
   intervalSeconds: 20
   unhealthyThresholdSeconds: 7200
 hotRowProtection:
+  hotKeyTTLSeconds: 60
   maxConcurrency: 5
   maxGlobalQueueSize: 1000
   maxQueueSize: 20
@@ -135,6 +138,7 @@ oltpReadPool:
   idleTimeoutSeconds: 1800
   maxWaiters: 5000
   size: 16
+priority: {}
 queryCacheLFU: true
 queryCacheMemory: 33554432
 queryCacheSize: 5000
@@ -196,8 +200,10 @@ func TestFlags(t *testing.T) {
 			MaxQueueSize:       20,
 			MaxGlobalQueueSize: 1000,
 			MaxConcurrency:     5,
+			HotKeyTTLSeconds:   60,
 		},
 		StreamBufferSize:                        32768,
+		ConsolidatorBatchMaxSize:                100,
 		QueryCacheSize:                          int(cache.DefaultConfig.MaxEntries),
 		QueryCacheMemory:                        cache.DefaultConfig.MaxMemoryUsage,
 		QueryCacheLFU:                           cache.DefaultConfig.LFU,
@@ -332,4 +338,117 @@ func TestFlags(t *testing.T) {
 	Init()
 	want.GracePeriods.TransitionSeconds = 4
 	assert.Equal(t, want, currentConfig)
+
+	priorityClasses = []string{"oltp:4", "batch:1:8:30s"}
+	Init()
+	want.Priority.Classes = []PriorityClassConfig{{
+		Name:   "oltp",
+		Weight: 4,
+	}, {
+		Name:                "batch",
+		Weight:              1,
+		MaxConcurrency:      8,
+		QueueTimeoutSeconds: 30,
+	}}
+	assert.Equal(t, want, currentConfig)
+
+	consolidatorBatchWindow = 2 * time.Millisecond
+	Init()
+	want.ConsolidatorBatchWindowSeconds = 0.002
+	assert.Equal(t, want, currentConfig)
+}
+
+func TestVerifyConsolidatorBatch(t *testing.T) {
+	config := NewDefaultConfig()
+	config.ConsolidatorBatchMaxSize = 0
+	assert.NoError(t, config.Verify())
+
+	config.ConsolidatorBatchWindowSeconds = 0.001
+	assert.EqualError(t, config.Verify(), "-consolidator_batch_max_size must be > 0 (specified value: 0)")
+}
+
+func TestVerifyHotKeyTTL(t *testing.T) {
+	config := NewDefaultConfig()
+	config.HotRowProtection.HotKeyTTLSeconds = 0
+	assert.NoError(t, config.Verify())
+
+	config.HotRowProtection.HotKeyTTLSeconds = -1
+	assert.EqualError(t, config.Verify(), "-hot_row_protection_hot_key_ttl must be >= 0 (specified value: -1)")
+}
+
+func TestParsePriorityClass(t *testing.T) {
+	testcases := []struct {
+		in   string
+		want PriorityClassConfig
+		err  string
+	}{{
+		in:   "oltp:4",
+		want: PriorityClassConfig{Name: "oltp", Weight: 4},
+	}, {
+		in:   "batch:1:8:1m",
+		want: PriorityClassConfig{Name: "batch", Weight: 1, MaxConcurrency: 8, QueueTimeoutSeconds: 60},
+	}, {
+		in:  "oltp",
+		err: "want name:weight[:max_concurrency[:queue_timeout]]",
+	}, {
+		in:  "oltp:a",
+		err: `invalid weight: strconv.Atoi: parsing "a": invalid syntax`,
+	}, {
+		in:  "oltp:1:a",
+		err: `invalid max_concurrency: strconv.Atoi: parsing "a": invalid syntax`,
+	}, {
+		in:  "oltp:1:0:1",
+		err: `invalid queue_timeout: time: missing unit in duration "1"`,
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := parsePriorityClass(tc.in)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestVerifyPriorityConfig(t *testing.T) {
+	classes := []PriorityClassConfig{{Name: "oltp", Weight: 4}, {Name: "batch", Weight: 1}}
+	testcases := []struct {
+		priority PriorityConfig
+		err      string
+	}{{
+		priority: PriorityConfig{},
+	}, {
+		priority: PriorityConfig{Classes: classes, DefaultClass: "batch", Users: map[string]string{"app": "oltp"}},
+	}, {
+		priority: PriorityConfig{Classes: []PriorityClassConfig{{Weight: 1}}},
+		err:      "priority class names must not be empty",
+	}, {
+		priority: PriorityConfig{Classes: []PriorityClassConfig{{Name: "oltp", Weight: 1}, {Name: "oltp", Weight: 1}}},
+		err:      "duplicate priority class: oltp",
+	}, {
+		priority: PriorityConfig{Classes: []PriorityClassConfig{{Name: "oltp"}}},
+		err:      "weight of priority class oltp must be > 0 (specified value: 0)",
+	}, {
+		priority: PriorityConfig{Classes: []PriorityClassConfig{{Name: "oltp", Weight: 1, MaxConcurrency: -1}}},
+		err:      "max concurrency of priority class oltp must be >= 0 (specified value: -1)",
+	}, {
+		priority: PriorityConfig{Classes: classes, DefaultClass: "adhoc"},
+		err:      "-priority_default_class is not in -priority_classes: adhoc",
+	}, {
+		priority: PriorityConfig{Classes: classes, Users: map[string]string{"app": "adhoc"}},
+		err:      "priority class of user app is not in -priority_classes: adhoc",
+	}}
+	for _, tc := range testcases {
+		config := NewDefaultConfig()
+		config.Priority = tc.priority
+		err := config.Verify()
+		if tc.err == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		assert.EqualError(t, err, tc.err)
+	}
 }
@@ -745,6 +745,9 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			}
 			result, err = qre.Execute()
 			if err != nil {
+				if transactionID != 0 && tsv.enableHotRowProtection {
+					tsv.detectHotRows(err, plan, bindVariables)
+				}
 				return err
 			}
 			result = result.StripMetadata(sqltypes.IncludeFieldsOrDefault(options))
@@ -952,14 +955,14 @@ func (tsv *TabletServer) beginWaitForSameRangeTransactions(ctx context.Context,
 		"", "waitForSameRangeTransactions", nil,
 		target, options, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
-			k, table := tsv.computeTxSerializerKey(ctx, logStats, sql, bindVariables)
-			if k == "" {
+			keys, table := tsv.computeTxSerializerKeys(ctx, logStats, sql, bindVariables)
+			if len(keys) == 0 {
 				// Query is not subject to tx serialization/hot row protection.
 				return nil
 			}
 
 			startTime := time.Now()
-			done, waited, waitErr := tsv.qe.txSerializer.Wait(ctx, k, table)
+			done, waited, waitErr := tsv.qe.txSerializer.WaitKeys(ctx, keys, table)
 			txDone = done
 			if waited {
 				tsv.stats.WaitTimings.Record("TxSerializer", startTime)
@@ -970,43 +973,122 @@ func (tsv *TabletServer) beginWaitForSameRangeTransactions(ctx context.Context,
 	return txDone, err
 }
 
-// computeTxSerializerKey returns a unique string ("key") used to determine
-// whether two queries would update the same row (range).
+// maxTxSerializerKeys is the max number of rows a DML can target to be
+// serialized by each of its rows. A DML which targets more rows is serialized
+// by its WHERE clause instead.
+const maxTxSerializerKeys = 20
+
+// computeTxSerializerKeys returns the unique strings ("keys") used to determine
+// whether two queries would update the same rows (range).
 // Additionally, it returns the table name (needed for updating stats vars).
-// It returns an empty string as key if the row (range) cannot be parsed from
-// the query and bind variables or the table name is empty.
-func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *tabletenv.LogStats, sql string, bindVariables map[string]*querypb.BindVariable) (string, string) {
+// It returns no keys if the row (range) cannot be parsed from the query and
+// bind variables or the table name is empty.
+func (tsv *TabletServer) computeTxSerializerKeys(ctx context.Context, logStats *tabletenv.LogStats, sql string, bindVariables map[string]*querypb.BindVariable) ([]string, string) {
 	// Strip trailing comments so we don't pollute the query cache.
 	sql, _ = sqlparser.SplitMarginComments(sql)
 	plan, err := tsv.qe.GetPlan(ctx, logStats, sql, false /* skipQueryPlanCache */, false /* isReservedConn */)
 	if err != nil {
 		logComputeRowSerializerKey.Errorf("failed to get plan for query: %v err: %v", sql, err)
-		return "", ""
+		return nil, ""
 	}
+	return txSerializerKeys(plan, bindVariables)
+}
 
+// txSerializerKeys returns the keys of the rows (range) a DML plan targets
+// and the table name. If the DML targets rows by the values of a unique key,
+// there is a key for each row. Otherwise, the key is the WHERE clause.
+func txSerializerKeys(plan *TabletPlan, bindVariables map[string]*querypb.BindVariable) ([]string, string) {
 	switch plan.PlanID {
 	// Serialize only UPDATE or DELETE queries.
 	case planbuilder.PlanUpdate, planbuilder.PlanUpdateLimit,
 		planbuilder.PlanDelete, planbuilder.PlanDeleteLimit:
 	default:
-		return "", ""
+		return nil, ""
 	}
 
 	tableName := plan.TableName()
 	if tableName.IsEmpty() || plan.WhereClause == nil {
 		// Do not serialize any queries without table name or where clause
-		return "", ""
+		return nil, ""
+	}
+
+	if keys := rowKeysToTxSerializerKeys(tableName, plan.RowKeys, bindVariables); keys != nil {
+		return keys, tableName.String()
 	}
 
 	where, err := plan.WhereClause.GenerateQuery(bindVariables, nil)
 	if err != nil {
-		logComputeRowSerializerKey.Errorf("failed to substitute bind vars in where clause: %v query: %v bind vars: %v", err, sql, bindVariables)
-		return "", ""
+		logComputeRowSerializerKey.Errorf("failed to substitute bind vars in where clause: %v query: %v bind vars: %v", err, plan.Original, bindVariables)
+		return nil, ""
 	}
 
 	// Example: table1 where id = 1 and sub_id = 2
 	key := fmt.Sprintf("%s%s", tableName, where)
-	return key, tableName.String()
+	return []string{key}, tableName.String()
+}
+
+// rowKeysToTxSerializerKeys returns a key for each row a DML targets by the
+// values of a unique key. It returns nil if the values cannot be resolved or
+// there are too many rows.
+func rowKeysToTxSerializerKeys(tableName sqlparser.TableIdent, rowKeys *planbuilder.RowKeys, bindVariables map[string]*querypb.BindVariable) []string {
+	if rowKeys == nil {
+		return nil
+	}
+	// Resolve the values. At most one of the columns has a list of values.
+	values := make([]sqltypes.Value, len(rowKeys.Values))
+	listIndex := -1
+	var list []sqltypes.Value
+	for i, pv := range rowKeys.Values {
+		var err error
+		if pv.IsList() {
+			listIndex = i
+			list, err = pv.ResolveList(bindVariables)
+		} else {
+			values[i], err = pv.ResolveValue(bindVariables)
+		}
+		if err != nil {
+			return nil
+		}
+	}
+	if listIndex == -1 {
+		list = []sqltypes.Value{{}}
+	}
+	if len(list) == 0 || len(list) > maxTxSerializerKeys {
+		return nil
+	}
+
+	keys := make([]string, 0, len(list))
+	for _, v := range list {
+		if listIndex != -1 {
+			values[listIndex] = v
+		}
+		// Example: table1 where id = 1 and sub_id = 2
+		buf := &strings.Builder{}
+		fmt.Fprintf(buf, "%s where ", tableName)
+		for i, col := range rowKeys.Columns {
+			if i > 0 {
+				buf.WriteString(" and ")
+			}
+			fmt.Fprintf(buf, "%s = ", sqlparser.String(col))
+			values[i].EncodeSQL(buf)
+		}
+		keys = append(keys, buf.String())
+	}
+	return keys
+}
+
+// detectHotRows marks the rows targeted by a DML as hot if the DML timed out
+// waiting for one of their locks in MySQL.
+func (tsv *TabletServer) detectHotRows(err error, plan *TabletPlan, bindVariables map[string]*querypb.BindVariable) {
+	sqlErr, ok := err.(*mysql.SQLError)
+	if !ok || sqlErr.Number() != mysql.ERLockWaitTimeout {
+		return
+	}
+	keys, table := txSerializerKeys(plan, bindVariables)
+	if len(keys) == 0 {
+		return
+	}
+	tsv.qe.txSerializer.MarkHot(keys, table)
 }
 
 // BeginExecuteBatch combines Begin and ExecuteBatch.
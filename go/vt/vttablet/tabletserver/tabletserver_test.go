@@ -980,7 +980,7 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 	db.SetBeforeFunc("update test_table set name_string = 'tx1' where pk = 1 and `name` = 1 limit 10001",
 		func() {
 			close(tx1Started)
-			if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1 and `name` = 1", 2); err != nil {
+			if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1", 2); err != nil {
 				t.Fatal(err)
 			}
 		})
@@ -1067,6 +1067,77 @@ func TestDMLQueryWithoutWhereClause(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestComputeTxSerializerKeys(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	pks := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	var pkList []string
+	for i := 0; i <= maxTxSerializerKeys; i++ {
+		pks.Values = append(pks.Values, sqltypes.ValueToProto(sqltypes.NewInt64(int64(i))))
+		pkList = append(pkList, fmt.Sprint(i))
+	}
+	testcases := []struct {
+		sql      string
+		bindVars map[string]*querypb.BindVariable
+		keys     []string
+	}{{
+		sql:      "update test_table set name_string = 'a' where pk = :pk and `name` = :name",
+		bindVars: map[string]*querypb.BindVariable{"pk": sqltypes.Int64BindVariable(1), "name": sqltypes.Int64BindVariable(2)},
+		keys:     []string{"test_table where pk = 1"},
+	}, {
+		sql:  "delete from test_table where pk in (1, 2) and `name` = 2",
+		keys: []string{"test_table where pk = 1", "test_table where pk = 2"},
+	}, {
+		// A DML which targets too many rows is serialized by its WHERE clause.
+		sql:      "delete from test_table where pk in ::pks",
+		bindVars: map[string]*querypb.BindVariable{"pks": pks},
+		keys:     []string{"test_table where pk in (" + strings.Join(pkList, ", ") + ")"},
+	}, {
+		sql:  "update test_table set name_string = 'a' where `name` = 2",
+		keys: []string{"test_table where `name` = 2"},
+	}, {
+		sql: "update test_table set name_string = 'a'",
+	}, {
+		sql: "select * from test_table where pk = 1",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.sql, func(t *testing.T) {
+			logStats := tabletenv.NewLogStats(ctx, "TestComputeTxSerializerKeys")
+			keys, table := tsv.computeTxSerializerKeys(ctx, logStats, tc.sql, tc.bindVars)
+			assert.Equal(t, tc.keys, keys)
+			if tc.keys != nil {
+				assert.Equal(t, "test_table", table)
+			}
+		})
+	}
+}
+
+func TestHotRowDetection(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.Mode = tabletenv.Enable
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}
+	db.AddRejectedQuery("update test_table set name_string = 'a' where pk in (1, 2) limit 10001",
+		mysql.NewSQLError(mysql.ERLockWaitTimeout, mysql.SSUnknownSQLState, "Lock wait timeout exceeded; try restarting transaction"))
+	db.AddRejectedQuery("update test_table set name_string = 'a' where pk = 3 limit 10001",
+		mysql.NewSQLError(mysql.ERDupEntry, mysql.SSUnknownSQLState, "Duplicate entry"))
+
+	_, txID, _, err := tsv.BeginExecute(ctx, &target, nil, "update test_table set name_string = 'a' where pk in (1, 2)", nil, 0, nil)
+	require.Error(t, err)
+	_, err = tsv.Execute(ctx, &target, "update test_table set name_string = 'a' where pk = 3", nil, txID, 0, nil)
+	require.Error(t, err)
+	_, err = tsv.Rollback(ctx, &target, txID)
+	require.NoError(t, err)
+
+	// Only the rows of the statement which timed out waiting for a lock are hot.
+	assert.Equal(t, map[string]int64{"test_table where pk = 1": 1, "test_table where pk = 2": 1}, tsv.qe.txSerializer.HotKeys())
+}
+
 // TestSerializeTransactionsSameRow_ExecuteBatchAsTransaction tests the same as
 // TestSerializeTransactionsSameRow but for the ExecuteBatch method with
 // asTransaction=true (i.e. vttablet wraps the query in a BEGIN/Query/COMMIT
@@ -1119,7 +1190,7 @@ func TestSerializeTransactionsSameRow_ExecuteBatchAsTransaction(t *testing.T) {
 	db.SetBeforeFunc("update test_table set name_string = 'tx1' where pk = 1 and `name` = 1 limit 10001",
 		func() {
 			close(tx1Started)
-			if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1 and `name` = 1", 2); err != nil {
+			if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1", 2); err != nil {
 				t.Fatal(err)
 			}
 		})
@@ -1296,7 +1367,7 @@ func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 	// transactions via db.SetBeforeFunc() for the same reason as mentioned
 	// in TestSerializeTransactionsSameRow: The MySQL C client does not seem
 	// to allow more than connection attempt at a time.
-	err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1 and `name` = 1", 3)
+	err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1", 3)
 	require.NoError(t, err)
 	close(allQueriesPending)
 
@@ -1390,7 +1461,7 @@ func TestSerializeTransactionsSameRow_TooManyPendingRequests(t *testing.T) {
 
 		<-tx1Started
 		_, _, _, err := tsv.BeginExecute(ctx, &target, nil, q2, bvTx2, 0, nil)
-		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: too many queued transactions (1 >= 1) for the same row (table + WHERE clause: 'test_table where pk = 1 and `name` = 1')" {
+		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: too many queued transactions (1 >= 1) for the same row (row key: 'test_table where pk = 1')" {
 			t.Errorf("tx2 should have failed because there are too many pending requests: %v", err)
 		}
 		// No commit necessary because the Begin failed.
@@ -1479,7 +1550,7 @@ func TestSerializeTransactionsSameRow_TooManyPendingRequests_ExecuteBatchAsTrans
 			Sql:           q2,
 			BindVariables: bvTx2,
 		}}, true /*asTransaction*/, 0 /*connID*/, nil /*options*/)
-		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: too many queued transactions (1 >= 1) for the same row (table + WHERE clause: 'test_table where pk = 1 and `name` = 1')" {
+		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: too many queued transactions (1 >= 1) for the same row (row key: 'test_table where pk = 1')" {
 			t.Errorf("tx2 should have failed because there are too many pending requests: %v results: %+v", err, results)
 		}
 	}()
@@ -1581,7 +1652,7 @@ func TestSerializeTransactionsSameRow_RequestCanceled(t *testing.T) {
 		defer wg.Done()
 
 		// Wait until tx1 and tx2 are pending to make the test deterministic.
-		if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1 and `name` = 1", 2); err != nil {
+		if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1", 2); err != nil {
 			t.Error(err)
 		}
 
@@ -1596,7 +1667,7 @@ func TestSerializeTransactionsSameRow_RequestCanceled(t *testing.T) {
 	}()
 
 	// Wait until tx1, 2 and 3 are pending.
-	err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1 and `name` = 1", 3)
+	err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1", 3)
 	require.NoError(t, err)
 	// Now unblock tx2 and cancel it.
 	cancelTx2()
@@ -21,6 +21,8 @@ package txserializer
 import (
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -38,7 +40,8 @@ import (
 )
 
 // TxSerializer serializes incoming transactions which target the same row range
-// i.e. table name and WHERE clause are identical.
+// i.e. their row keys are identical. The row key of a DML is the values of a
+// unique key it targets, or else its table name and WHERE clause.
 // Additional transactions are queued and woken up in arrival order.
 //
 // This implementation has some parallels to the sync2.Consolidator class.
@@ -52,6 +55,10 @@ import (
 //   limited to avoid that queued transactions can consume the full capacity
 //   of vttablet. This is important if the capaciy is finite. For example, the
 //   number of RPCs in flight could be limited by the RPC subsystem.
+//
+// Rows for which MySQL reported lock wait timeouts are considered hot for a
+// while (see MarkHot()). The transactions for a hot row are let through one
+// at a time instead of "concurrentTransactions" at a time.
 type TxSerializer struct {
 	env tabletenv.Env
 	*sync2.ConsolidatorCache
@@ -61,6 +68,7 @@ type TxSerializer struct {
 	maxQueueSize           int
 	maxGlobalQueueSize     int
 	concurrentTransactions int
+	hotKeyTTL              time.Duration
 
 	// waits stores how many times a transaction was queued because another
 	// transaction was already in flight for the same row (range).
@@ -80,6 +88,10 @@ type TxSerializer struct {
 	waits, waitsDryRun, queueExceeded, queueExceededDryRun *stats.CountersWithSingleLabel
 	globalQueueExceeded, globalQueueExceededDryRun         *stats.Counter
 
+	// lockWaitTimeouts counts per table how many statements timed out waiting
+	// for a row lock in MySQL.
+	lockWaitTimeouts *stats.CountersWithSingleLabel
+
 	log                          *logutil.ThrottledLogger
 	logDryRun                    *logutil.ThrottledLogger
 	logWaitsDryRun               *logutil.ThrottledLogger
@@ -89,18 +101,35 @@ type TxSerializer struct {
 	mu         sync.Mutex
 	queues     map[string]*queue
 	globalSize int
+	// hotKeys has the rows (keys) which are currently hot.
+	hotKeys map[string]*hotKey
+}
+
+// hotKey represents a row (range) for which MySQL recently reported lock wait
+// timeouts.
+type hotKey struct {
+	table string
+	// timeouts is the number of lock wait timeouts reported for the row.
+	timeouts int64
+	// expires is when the row stops being hot, unless there are more timeouts.
+	expires time.Time
 }
 
+// maxHotKeys limits the number of rows which are considered hot at the same
+// time.
+const maxHotKeys = 1000
+
 // New returns a TxSerializer object.
 func New(env tabletenv.Env) *TxSerializer {
 	config := env.Config()
-	return &TxSerializer{
+	txs := &TxSerializer{
 		env:                    env,
 		ConsolidatorCache:      sync2.NewConsolidatorCache(1000),
 		dryRun:                 config.HotRowProtection.Mode == tabletenv.Dryrun,
 		maxQueueSize:           config.HotRowProtection.MaxQueueSize,
 		maxGlobalQueueSize:     config.HotRowProtection.MaxGlobalQueueSize,
 		concurrentTransactions: config.HotRowProtection.MaxConcurrency,
+		hotKeyTTL:              config.HotRowProtection.HotKeyTTLSeconds.Get(),
 		waits: env.Exporter().NewCountersWithSingleLabel(
 			"TxSerializerWaits",
 			"Number of times a transaction was queued because another transaction was already in flight for the same row range",
@@ -123,14 +152,24 @@ func New(env tabletenv.Env) *TxSerializer {
 		globalQueueExceededDryRun: env.Exporter().NewCounter(
 			"TxSerializerGlobalQueueExceededDryRun",
 			"Dry-run stats for TxSerializerGlobalQueueExceeded"),
+		lockWaitTimeouts: env.Exporter().NewCountersWithSingleLabel(
+			"TxSerializerLockWaitTimeouts",
+			"Number of statements which timed out waiting for a row lock in MySQL",
+			"table_name"),
 		log:                          logutil.NewThrottledLogger("HotRowProtection", 5*time.Second),
 		logDryRun:                    logutil.NewThrottledLogger("HotRowProtection DryRun", 5*time.Second),
 		logWaitsDryRun:               logutil.NewThrottledLogger("HotRowProtection Waits DryRun", 5*time.Second),
 		logQueueExceededDryRun:       logutil.NewThrottledLogger("HotRowProtection QueueExceeded DryRun", 5*time.Second),
 		logGlobalQueueExceededDryRun: logutil.NewThrottledLogger("HotRowProtection GlobalQueueExceeded DryRun", 5*time.Second),
 		queues:                       make(map[string]*queue),
+		hotKeys:                      make(map[string]*hotKey),
 	}
-
+	env.Exporter().NewGaugesFuncWithMultiLabels(
+		"TxSerializerHotKeys",
+		"Number of lock wait timeouts of the rows which are currently hot",
+		[]string{"table_name", "key"},
+		txs.hotKeyCounts)
+	return txs
 }
 
 // DoneFunc is returned by Wait() and must be called by the caller.
@@ -158,6 +197,126 @@ func (txs *TxSerializer) Wait(ctx context.Context, key, table string) (done Done
 	return func() { txs.unlock(key) }, waited, nil
 }
 
+// WaitKeys is the same as Wait() for a transaction which targets several rows.
+// It queues the transaction for each of the keys in sorted order. Because all
+// transactions acquire the keys in the same order, two transactions can never
+// wait for each other.
+// "done" releases the keys in the reverse order.
+func (txs *TxSerializer) WaitKeys(ctx context.Context, keys []string, table string) (done DoneFunc, waited bool, err error) {
+	keys = sortedKeys(keys)
+	dones := make([]DoneFunc, 0, len(keys))
+	release := func() {
+		for i := len(dones) - 1; i >= 0; i-- {
+			dones[i]()
+		}
+	}
+	for _, key := range keys {
+		d, w, err := txs.Wait(ctx, key, table)
+		waited = waited || w
+		if err != nil {
+			release()
+			return nil, waited, err
+		}
+		dones = append(dones, d)
+	}
+	return release, waited, nil
+}
+
+// sortedKeys returns a sorted copy of keys without duplicates.
+func sortedKeys(keys []string) []string {
+	sorted := append([]string(nil), keys...)
+	sort.Strings(sorted)
+	unique := sorted[:0]
+	for i, key := range sorted {
+		if i == 0 || key != sorted[i-1] {
+			unique = append(unique, key)
+		}
+	}
+	return unique
+}
+
+// MarkHot records that a statement timed out in MySQL while it was waiting
+// for the lock of one of the rows (keys). Until the rows cool down again, the
+// transactions for them are let through one at a time.
+func (txs *TxSerializer) MarkHot(keys []string, table string) {
+	txs.lockWaitTimeouts.Add(table, 1)
+	if txs.hotKeyTTL == 0 {
+		return
+	}
+
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	now := time.Now()
+	for _, key := range keys {
+		hk, ok := txs.hotKeys[key]
+		if !ok {
+			if len(txs.hotKeys) >= maxHotKeys {
+				txs.expireHotKeysLocked(now)
+			}
+			if len(txs.hotKeys) >= maxHotKeys {
+				txs.log.Warningf("Not marking row (range) '%v' as hot because there are too many hot rows (%d)", key, len(txs.hotKeys))
+				continue
+			}
+			hk = &hotKey{table: table}
+			txs.hotKeys[key] = hk
+			txs.log.Infof("Row (range) '%v' is hot because a transaction timed out waiting for its lock.", key)
+		}
+		hk.timeouts++
+		hk.expires = now.Add(txs.hotKeyTTL)
+	}
+}
+
+// isHotLocked returns true if the row (range) is currently hot.
+func (txs *TxSerializer) isHotLocked(key string) bool {
+	hk, ok := txs.hotKeys[key]
+	if !ok {
+		return false
+	}
+	if !time.Now().Before(hk.expires) {
+		delete(txs.hotKeys, key)
+		return false
+	}
+	return true
+}
+
+func (txs *TxSerializer) expireHotKeysLocked(now time.Time) {
+	for key, hk := range txs.hotKeys {
+		if !now.Before(hk.expires) {
+			delete(txs.hotKeys, key)
+		}
+	}
+}
+
+// hotKeyCounts returns the number of lock wait timeouts of each hot row by
+// table name and key.
+func (txs *TxSerializer) hotKeyCounts() map[string]int64 {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	txs.expireHotKeysLocked(time.Now())
+	counts := make(map[string]int64, len(txs.hotKeys))
+	for key, hk := range txs.hotKeys {
+		// Dots separate the labels.
+		counts[hk.table+"."+strings.ReplaceAll(key, ".", "_")] = hk.timeouts
+	}
+	return counts
+}
+
+// HotKeys returns the number of lock wait timeouts of each row (key) which
+// is currently hot.
+func (txs *TxSerializer) HotKeys() map[string]int64 {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	txs.expireHotKeysLocked(time.Now())
+	hotKeys := make(map[string]int64, len(txs.hotKeys))
+	for key, hk := range txs.hotKeys {
+		hotKeys[key] = hk.timeouts
+	}
+	return hotKeys
+}
+
 // lockLocked queues this transaction. It will unblock immediately if this
 // transaction is the first in the queue or when it acquired a slot.
 // The method has the suffix "Locked" to clarify that "txs.mu" must be locked.
@@ -184,11 +343,11 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 	if q.size >= txs.maxQueueSize {
 		if txs.dryRun {
 			txs.queueExceededDryRun.Add(table, 1)
-			txs.logQueueExceededDryRun.Warningf("Would have rejected BeginExecute RPC because there are too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, txs.maxQueueSize, key)
+			txs.logQueueExceededDryRun.Warningf("Would have rejected BeginExecute RPC because there are too many queued transactions (%d >= %d) for the same row (row key: '%v')", q.size, txs.maxQueueSize, key)
 		} else {
 			txs.queueExceeded.Add(table, 1)
 			return false, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED,
-				"hot row protection: too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, txs.maxQueueSize, key)
+				"hot row protection: too many queued transactions (%d >= %d) for the same row (row key: '%v')", q.size, txs.maxQueueSize, key)
 		}
 	}
 
@@ -197,7 +356,13 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 		// first time.
 
 		// As an optimization, we deferred the creation of the channel until now.
-		q.availableSlots = make(chan struct{}, txs.concurrentTransactions)
+		// Transactions for a row which MySQL already reported as contended are
+		// let through one at a time.
+		concurrentTransactions := txs.concurrentTransactions
+		if txs.isHotLocked(key) {
+			concurrentTransactions = 1
+		}
+		q.availableSlots = make(chan struct{}, concurrentTransactions)
 		q.availableSlots <- struct{}{}
 
 		// Include first transaction in the count at /debug/hotrows. (It was not
@@ -327,11 +492,25 @@ func (txs *TxSerializer) ServeHTTP(response http.ResponseWriter, request *http.R
 	response.Header().Set("Content-Type", "text/plain")
 	if items == nil {
 		response.Write([]byte("empty\n"))
+	} else {
+		response.Write([]byte(fmt.Sprintf("Length: %d\n", len(items))))
+		for _, v := range items {
+			response.Write([]byte(fmt.Sprintf("%v: %s\n", v.Count, v.Query)))
+		}
+	}
+
+	hotKeys := txs.HotKeys()
+	if len(hotKeys) == 0 {
 		return
 	}
-	response.Write([]byte(fmt.Sprintf("Length: %d\n", len(items))))
-	for _, v := range items {
-		response.Write([]byte(fmt.Sprintf("%v: %s\n", v.Count, v.Query)))
+	keys := make([]string, 0, len(hotKeys))
+	for key := range hotKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	response.Write([]byte(fmt.Sprintf("\nHot rows (lock wait timeouts): %d\n", len(keys))))
+	for _, key := range keys {
+		response.Write([]byte(fmt.Sprintf("%v: %s\n", hotKeys[key], key)))
 	}
 }
 
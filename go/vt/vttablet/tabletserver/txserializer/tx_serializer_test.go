@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -41,6 +42,7 @@ func resetVariables(txs *TxSerializer) {
 	txs.queueExceededDryRun.ResetAll()
 	txs.globalQueueExceeded.Reset()
 	txs.globalQueueExceededDryRun.Reset()
+	txs.lockWaitTimeouts.ResetAll()
 }
 
 func TestTxSerializer_NoHotRow(t *testing.T) {
@@ -148,7 +150,7 @@ func TestTxSerializer(t *testing.T) {
 	if got, want := vterrors.Code(err3), vtrpcpb.Code_RESOURCE_EXHAUSTED; got != want {
 		t.Errorf("wrong error code: got = %v, want = %v", got, want)
 	}
-	if got, want := err3.Error(), "hot row protection: too many queued transactions (2 >= 2) for the same row (table + WHERE clause: 't1 where1')"; got != want {
+	if got, want := err3.Error(), "hot row protection: too many queued transactions (2 >= 2) for the same row (row key: 't1 where1')"; got != want {
 		t.Errorf("transaction rejected with wrong error: got = %v, want = %v", got, want)
 	}
 
@@ -510,6 +512,201 @@ func TestTxSerializerGlobalQueueOverflow(t *testing.T) {
 	done2()
 }
 
+// TestTxSerializerWaitKeys runs two transactions which target overlapping
+// rows. Both acquire the rows in the same order and tx2 waits for tx1 before
+// it acquires any of the rows which tx1 does not target.
+func TestTxSerializerWaitKeys(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxQueueSize = 2
+	config.HotRowProtection.MaxGlobalQueueSize = 10
+	config.HotRowProtection.MaxConcurrency = 1
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	// tx1.
+	done1, waited1, err1 := txs.WaitKeys(context.Background(), []string{"t1 where3", "t1 where2", "t1 where3"}, "t1")
+	if err1 != nil {
+		t.Fatal(err1)
+	}
+	if waited1 {
+		t.Errorf("tx1 must never wait: %v", waited1)
+	}
+	if got, want := txs.Pending("t1 where3"), 1; got != want {
+		t.Errorf("duplicate keys must be queued once: got = %v, want = %v", got, want)
+	}
+
+	// tx2 (gets queued for "t1 where2" and must wait).
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		done2, waited2, err2 := txs.WaitKeys(context.Background(), []string{"t1 where4", "t1 where2", "t1 where1"}, "t1")
+		if err2 != nil {
+			t.Error(err2)
+			return
+		}
+		if !waited2 {
+			t.Errorf("tx2 must wait: %v", waited2)
+		}
+		done2()
+	}()
+	if err := waitForPending(txs, "t1 where2", 2); err != nil {
+		t.Error(err)
+	}
+	if got, want := txs.Pending("t1 where1"), 1; got != want {
+		t.Errorf("tx2 must hold the keys before the one it waits for: got = %v, want = %v", got, want)
+	}
+	if got, want := txs.Pending("t1 where4"), 0; got != want {
+		t.Errorf("tx2 must not hold the keys after the one it waits for: got = %v, want = %v", got, want)
+	}
+
+	done1()
+	// tx2 must have been unblocked.
+	wg.Wait()
+
+	if len(txs.queues) != 0 {
+		t.Errorf("queue objects were not deleted after the last transaction: %v", txs.queues)
+	}
+}
+
+// TestTxSerializerWaitKeysCancel checks that a transaction which gives up
+// waiting for one of its rows releases the rows it already acquired.
+func TestTxSerializerWaitKeysCancel(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxQueueSize = 2
+	config.HotRowProtection.MaxGlobalQueueSize = 10
+	config.HotRowProtection.MaxConcurrency = 1
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	done1, _, err1 := txs.Wait(context.Background(), "t1 where2", "t1")
+	if err1 != nil {
+		t.Fatal(err1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error)
+	go func() {
+		_, _, err := txs.WaitKeys(ctx, []string{"t1 where1", "t1 where2"}, "t1")
+		errCh <- err
+	}()
+	if err := waitForPending(txs, "t1 where2", 2); err != nil {
+		t.Error(err)
+	}
+	cancel()
+	if err := <-errCh; err != context.Canceled {
+		t.Errorf("tx2 should have failed with error: %v but got: %v", context.Canceled, err)
+	}
+	if got, want := txs.Pending("t1 where1"), 0; got != want {
+		t.Errorf("tx2 must release the keys it acquired: got = %v, want = %v", got, want)
+	}
+
+	done1()
+	if len(txs.queues) != 0 {
+		t.Errorf("queue objects were not deleted after the last transaction: %v", txs.queues)
+	}
+}
+
+// TestTxSerializerHotKeys checks that the transactions for a row for which
+// MySQL reported a lock wait timeout are let through one at a time.
+func TestTxSerializerHotKeys(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxQueueSize = 3
+	config.HotRowProtection.MaxGlobalQueueSize = 3
+	config.HotRowProtection.MaxConcurrency = 2
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	txs.MarkHot([]string{"t1 where1"}, "t1")
+	txs.MarkHot([]string{"t1 where1", "t1 where1.5"}, "t1")
+	if got, want := txs.lockWaitTimeouts.Counts()["t1"], int64(2); got != want {
+		t.Errorf("variable not incremented: got = %v, want = %v", got, want)
+	}
+	if got, want := txs.HotKeys(), map[string]int64{"t1 where1": 2, "t1 where1.5": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong hot keys: got = %v, want = %v", got, want)
+	}
+	if got, want := txs.hotKeyCounts(), map[string]int64{"t1.t1 where1": 2, "t1.t1 where1_5": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong TxSerializerHotKeys variable: got = %v, want = %v", got, want)
+	}
+
+	// tx1.
+	done1, waited1, err1 := txs.Wait(context.Background(), "t1 where1", "t1")
+	if err1 != nil {
+		t.Fatal(err1)
+	}
+	if waited1 {
+		t.Errorf("tx1 must never wait: %v", waited1)
+	}
+
+	// tx2 must wait although MaxConcurrency would let it through.
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		done2, waited2, err2 := txs.Wait(context.Background(), "t1 where1", "t1")
+		if err2 != nil {
+			t.Error(err2)
+			return
+		}
+		if !waited2 {
+			t.Errorf("tx2 must wait: %v", waited2)
+		}
+		done2()
+	}()
+	if err := waitForPending(txs, "t1 where1", 2); err != nil {
+		t.Error(err)
+	}
+	done1()
+	wg.Wait()
+	if got, want := txs.waits.Counts()["t1"], int64(1); got != want {
+		t.Errorf("variable not incremented: got = %v, want = %v", got, want)
+	}
+
+	req, err := http.NewRequest("GET", "/path-is-ignored-in-test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	txs.ServeHTTP(rr, req)
+	want := `Length: 1
+2: t1 where1
+
+Hot rows (lock wait timeouts): 2
+2: t1 where1
+1: t1 where1.5
+`
+	if got := rr.Body.String(); got != want {
+		t.Errorf("wrong content: got = \n%v\n want = \n%v", got, want)
+	}
+
+	// The rows cool down after the TTL.
+	txs.mu.Lock()
+	for _, hk := range txs.hotKeys {
+		hk.expires = time.Now()
+	}
+	txs.mu.Unlock()
+	if got := txs.HotKeys(); len(got) != 0 {
+		t.Errorf("hot keys did not expire: %v", got)
+	}
+}
+
+func TestTxSerializerHotKeysDisabled(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.HotKeyTTLSeconds = 0
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	txs.MarkHot([]string{"t1 where1"}, "t1")
+	if got, want := txs.lockWaitTimeouts.Counts()["t1"], int64(1); got != want {
+		t.Errorf("variable not incremented: got = %v, want = %v", got, want)
+	}
+	if got := txs.HotKeys(); len(got) != 0 {
+		t.Errorf("no row must be hot: %v", got)
+	}
+}
+
 func TestTxSerializerPending(t *testing.T) {
 	config := tabletenv.NewDefaultConfig()
 	config.HotRowProtection.MaxQueueSize = 1
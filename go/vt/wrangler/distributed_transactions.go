@@ -0,0 +1,329 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/dtids"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	distributedTransactionsResolved       = stats.NewCountersWithSingleLabel("DistributedTransactionsResolved", "Number of abandoned distributed transactions concluded by the resolver", "Keyspace")
+	distributedTransactionResolveFailures = stats.NewCountersWithSingleLabel("DistributedTransactionResolveFailures", "Number of failed attempts of the resolver to conclude an abandoned distributed transaction", "Keyspace")
+	unresolvedDistributedTransactions     = stats.NewGaugesWithSingleLabel("UnresolvedDistributedTransactions", "Number of abandoned distributed transactions found by the last run of the resolver", "Keyspace")
+)
+
+// sqlReadDistributedTransactions reads the metadata of the distributed
+// transactions created before a time, like TwoPC.ReadAllTransactions.
+const sqlReadDistributedTransactions = `select t.dtid, t.state, t.time_created, p.keyspace, p.shard
+	from _vt.dt_state t
+	join _vt.dt_participant p on t.dtid = p.dtid
+	where t.time_created < %d
+	order by t.dtid, p.id`
+
+// ListDistributedTransactions returns the unresolved distributed transactions
+// whose metadata is stored in the shards of the keyspace, and that were created
+// more than abandonAge ago. Shards whose tablets do not have 2PC enabled are skipped.
+func (wr *Wrangler) ListDistributedTransactions(ctx context.Context, keyspace string, abandonAge time.Duration) ([]*querypb.TransactionMetadata, error) {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(sqlReadDistributedTransactions, time.Now().Add(-abandonAge).UnixNano())
+	var transactions []*querypb.TransactionMetadata
+	for _, shard := range shards {
+		si, err := wr.ts.GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return nil, err
+		}
+		if si.PrimaryAlias == nil {
+			return nil, fmt.Errorf("shard %s/%s has no primary", keyspace, shard)
+		}
+		ti, err := wr.ts.GetTablet(ctx, si.PrimaryAlias)
+		if err != nil {
+			return nil, err
+		}
+		p3qr, err := wr.tmc.ExecuteFetchAsApp(ctx, ti.Tablet, true, []byte(query), 10000)
+		if err != nil {
+			if sqlErr, ok := mysql.NewSQLErrorFromError(err).(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERNoSuchTable {
+				// The 2PC metadata tables are only created when 2PC is enabled.
+				continue
+			}
+			return nil, err
+		}
+		shardTransactions, err := parseDistributedTransactions(sqltypes.Proto3ToResult(p3qr))
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, shardTransactions...)
+	}
+	return transactions, nil
+}
+
+// parseDistributedTransactions builds the metadata of the transactions
+// from the rows returned by sqlReadDistributedTransactions.
+func parseDistributedTransactions(qr *sqltypes.Result) ([]*querypb.TransactionMetadata, error) {
+	var transactions []*querypb.TransactionMetadata
+	var cur *querypb.TransactionMetadata
+	for _, row := range qr.Rows {
+		dtid := row[0].ToString()
+		if cur == nil || cur.Dtid != dtid {
+			st, err := evalengine.ToInt64(row[1])
+			if err != nil {
+				return nil, fmt.Errorf("error parsing state for dtid %s: %v", dtid, err)
+			}
+			// A failure in time parsing will show up as a very old time,
+			// which is harmless.
+			tm, _ := evalengine.ToInt64(row[2])
+			cur = &querypb.TransactionMetadata{
+				Dtid:        dtid,
+				State:       querypb.TransactionState(st),
+				TimeCreated: tm,
+			}
+			transactions = append(transactions, cur)
+		}
+		cur.Participants = append(cur.Participants, &querypb.Target{
+			Keyspace:   row[3].ToString(),
+			Shard:      row[4].ToString(),
+			TabletType: topodatapb.TabletType_PRIMARY,
+		})
+	}
+	return transactions, nil
+}
+
+// ReadDistributedTransaction returns the metadata of a distributed transaction,
+// read from the primary of the shard storing it. The Dtid of the metadata is
+// empty if the transaction is already resolved.
+func (wr *Wrangler) ReadDistributedTransaction(ctx context.Context, dtid string) (*querypb.TransactionMetadata, error) {
+	mmShard, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return nil, err
+	}
+	qs, err := wr.primaryQueryService(ctx, mmShard.Target)
+	if err != nil {
+		return nil, err
+	}
+	defer qs.Close(ctx)
+	return qs.ReadTransaction(ctx, mmShard.Target, dtid)
+}
+
+// ConcludeDistributedTransaction completes a distributed transaction the way
+// vtgate resolves it: a transaction that was still being prepared is rolled
+// back, and the decision to commit or roll back is applied to all its participants.
+func (wr *Wrangler) ConcludeDistributedTransaction(ctx context.Context, dtid string) error {
+	return wr.resolveDistributedTransaction(ctx, dtid, false /* rollbackOnly */)
+}
+
+// RollbackDistributedTransaction rolls back a distributed transaction.
+// It fails if the decision to commit the transaction was already made.
+func (wr *Wrangler) RollbackDistributedTransaction(ctx context.Context, dtid string) error {
+	return wr.resolveDistributedTransaction(ctx, dtid, true /* rollbackOnly */)
+}
+
+func (wr *Wrangler) resolveDistributedTransaction(ctx context.Context, dtid string, rollbackOnly bool) error {
+	mmShard, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return err
+	}
+	mm, err := wr.primaryQueryService(ctx, mmShard.Target)
+	if err != nil {
+		return err
+	}
+	defer mm.Close(ctx)
+
+	transaction, err := mm.ReadTransaction(ctx, mmShard.Target, dtid)
+	if err != nil {
+		return err
+	}
+	if transaction == nil || transaction.Dtid == "" {
+		// It was already resolved.
+		return nil
+	}
+	switch transaction.State {
+	case querypb.TransactionState_PREPARE:
+		if err := mm.SetRollback(ctx, mmShard.Target, dtid, mmShard.TransactionId); err != nil {
+			return err
+		}
+		fallthrough
+	case querypb.TransactionState_ROLLBACK:
+		err = wr.runOnParticipants(ctx, transaction.Participants, func(qs queryservice.QueryService, target *querypb.Target) error {
+			return qs.RollbackPrepared(ctx, target, dtid, 0)
+		})
+	case querypb.TransactionState_COMMIT:
+		if rollbackOnly {
+			return fmt.Errorf("distributed transaction %s cannot be rolled back: the decision to commit it was already made", dtid)
+		}
+		err = wr.runOnParticipants(ctx, transaction.Participants, func(qs queryservice.QueryService, target *querypb.Target) error {
+			return qs.CommitPrepared(ctx, target, dtid)
+		})
+	default:
+		return fmt.Errorf("invalid state for distributed transaction %s: %v", dtid, transaction.State)
+	}
+	if err != nil {
+		return err
+	}
+	return mm.ConcludeTransaction(ctx, mmShard.Target, dtid)
+}
+
+// ResolveAbandonedDistributedTransactions concludes the distributed transactions
+// whose metadata is stored in the keyspace, and that were abandoned by their
+// vtgate for more than abandonAge. Failures are counted in the
+// DistributedTransactionResolveFailures stats.
+func (wr *Wrangler) ResolveAbandonedDistributedTransactions(ctx context.Context, keyspace string, abandonAge time.Duration) error {
+	transactions, err := wr.ListDistributedTransactions(ctx, keyspace, abandonAge)
+	if err != nil {
+		distributedTransactionResolveFailures.Add(keyspace, 1)
+		return err
+	}
+	unresolvedDistributedTransactions.Set(keyspace, int64(len(transactions)))
+	rec := concurrency.AllErrorRecorder{}
+	for _, transaction := range transactions {
+		if err := wr.ConcludeDistributedTransaction(ctx, transaction.Dtid); err != nil {
+			distributedTransactionResolveFailures.Add(keyspace, 1)
+			rec.RecordError(fmt.Errorf("could not resolve distributed transaction %s: %v", transaction.Dtid, err))
+			continue
+		}
+		distributedTransactionsResolved.Add(keyspace, 1)
+		wr.Logger().Infof("Resolved abandoned distributed transaction %s (%v)", transaction.Dtid, transaction.State)
+	}
+	return rec.Error()
+}
+
+// ClearDistributedTransactionFailures resets the DistributedTransactionResolveFailures stats.
+func ClearDistributedTransactionFailures() {
+	distributedTransactionResolveFailures.ResetAll()
+}
+
+// runOnParticipants runs the action on the primaries of all the participants in parallel.
+func (wr *Wrangler) runOnParticipants(ctx context.Context, participants []*querypb.Target, action func(queryservice.QueryService, *querypb.Target) error) error {
+	rec := concurrency.AllErrorRecorder{}
+	var wg sync.WaitGroup
+	for _, target := range participants {
+		wg.Add(1)
+		go func(target *querypb.Target) {
+			defer wg.Done()
+			qs, err := wr.primaryQueryService(ctx, target)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+			defer qs.Close(ctx)
+			if err := action(qs, target); err != nil {
+				rec.RecordError(fmt.Errorf("%s/%s: %v", target.Keyspace, target.Shard, err))
+			}
+		}(target)
+	}
+	wg.Wait()
+	return rec.Error()
+}
+
+// primaryQueryService dials the query service of the primary of the target's shard.
+func (wr *Wrangler) primaryQueryService(ctx context.Context, target *querypb.Target) (queryservice.QueryService, error) {
+	si, err := wr.ts.GetShard(ctx, target.Keyspace, target.Shard)
+	if err != nil {
+		return nil, err
+	}
+	if si.PrimaryAlias == nil {
+		return nil, fmt.Errorf("shard %s/%s has no primary", target.Keyspace, target.Shard)
+	}
+	ti, err := wr.ts.GetTablet(ctx, si.PrimaryAlias)
+	if err != nil {
+		return nil, err
+	}
+	return tabletconn.GetDialer()(ti.Tablet, grpcclient.FailFast(false))
+}
+
+func init() {
+	grpcvtctldserver.RegisterDistributedTransactionManagerFactory(func(ts *topo.Server, tmc tmclient.TabletManagerClient) grpcvtctldserver.DistributedTransactionManager {
+		return &distributedTransactionManager{wr: New(logutil.NewMemoryLogger(), ts, tmc)}
+	})
+}
+
+// distributedTransactionManager implements the DistributedTransaction RPCs of the vtctld server.
+type distributedTransactionManager struct {
+	wr *Wrangler
+}
+
+// DistributedTransactionClearFailures is part of the grpcvtctldserver.DistributedTransactionManager interface.
+func (dtm *distributedTransactionManager) DistributedTransactionClearFailures(ctx context.Context, req *vtctldatapb.DistributedTransactionClearFailuresRequest) (*vtctldatapb.DistributedTransactionClearFailuresResponse, error) {
+	ClearDistributedTransactionFailures()
+	return &vtctldatapb.DistributedTransactionClearFailuresResponse{}, nil
+}
+
+// DistributedTransactionConclude is part of the grpcvtctldserver.DistributedTransactionManager interface.
+func (dtm *distributedTransactionManager) DistributedTransactionConclude(ctx context.Context, req *vtctldatapb.DistributedTransactionConcludeRequest) (*vtctldatapb.DistributedTransactionConcludeResponse, error) {
+	if err := dtm.wr.ConcludeDistributedTransaction(ctx, req.Dtid); err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.DistributedTransactionConcludeResponse{}, nil
+}
+
+// DistributedTransactionList is part of the grpcvtctldserver.DistributedTransactionManager interface.
+func (dtm *distributedTransactionManager) DistributedTransactionList(ctx context.Context, req *vtctldatapb.DistributedTransactionListRequest) (*vtctldatapb.DistributedTransactionListResponse, error) {
+	abandonAge, _, err := protoutil.DurationFromProto(req.AbandonAge)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := dtm.wr.ListDistributedTransactions(ctx, req.Keyspace, abandonAge)
+	if err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.DistributedTransactionListResponse{Transactions: transactions}, nil
+}
+
+// DistributedTransactionRead is part of the grpcvtctldserver.DistributedTransactionManager interface.
+func (dtm *distributedTransactionManager) DistributedTransactionRead(ctx context.Context, req *vtctldatapb.DistributedTransactionReadRequest) (*vtctldatapb.DistributedTransactionReadResponse, error) {
+	transaction, err := dtm.wr.ReadDistributedTransaction(ctx, req.Dtid)
+	if err != nil {
+		return nil, err
+	}
+	if transaction == nil || transaction.Dtid == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "distributed transaction %s not found: it is resolved or does not exist", req.Dtid)
+	}
+	return &vtctldatapb.DistributedTransactionReadResponse{Transaction: transaction}, nil
+}
+
+// DistributedTransactionRollback is part of the grpcvtctldserver.DistributedTransactionManager interface.
+func (dtm *distributedTransactionManager) DistributedTransactionRollback(ctx context.Context, req *vtctldatapb.DistributedTransactionRollbackRequest) (*vtctldatapb.DistributedTransactionRollbackResponse, error) {
+	if err := dtm.wr.RollbackDistributedTransaction(ctx, req.Dtid); err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.DistributedTransactionRollbackResponse{}, nil
+}
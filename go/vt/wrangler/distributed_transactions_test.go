@@ -0,0 +1,308 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/queryservice/fakes"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// dtEnv has to be a global for RegisterDialer to work.
+var dtEnv *testDTEnv
+
+func init() {
+	tabletconn.RegisterDialer("DistributedTransactionTest", func(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (queryservice.QueryService, error) {
+		return &testDTQueryService{QueryService: fakes.ErrorQueryService, shard: tablet.Shard}, nil
+	})
+}
+
+type testDTEnv struct {
+	wr  *Wrangler
+	tmc *testDTTMClient
+
+	mu sync.Mutex
+	// transactions are the transactions of the metadata shards, by dtid.
+	transactions map[string]*querypb.TransactionMetadata
+	// calls are the calls received by the query services, as shard:call.
+	calls []string
+}
+
+func newTestDTEnv(t *testing.T, shards ...string) *testDTEnv {
+	flag.Set("tablet_protocol", "DistributedTransactionTest")
+	ts := memorytopo.NewServer("zone1")
+	env := &testDTEnv{
+		tmc:          &testDTTMClient{results: make(map[string]*sqltypes.Result), errors: make(map[string]error)},
+		transactions: make(map[string]*querypb.TransactionMetadata),
+	}
+	env.wr = New(logutil.NewConsoleLogger(), ts, env.tmc)
+	for i, shard := range shards {
+		tablet := &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: uint32(100 + i)},
+			Keyspace: "ks",
+			Shard:    shard,
+			Type:     topodatapb.TabletType_PRIMARY,
+		}
+		require.NoError(t, env.wr.InitTablet(context.Background(), tablet, false, true, false))
+		_, err := ts.UpdateShardFields(context.Background(), "ks", shard, func(si *topo.ShardInfo) error {
+			si.PrimaryAlias = tablet.Alias
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	dtEnv = env
+	return env
+}
+
+func (env *testDTEnv) record(shard, call string) {
+	env.mu.Lock()
+	defer env.mu.Unlock()
+	env.calls = append(env.calls, shard+":"+call)
+}
+
+func (env *testDTEnv) popCalls() []string {
+	env.mu.Lock()
+	defer env.mu.Unlock()
+	calls := env.calls
+	env.calls = nil
+	// Participants are called in parallel.
+	sort.Strings(calls)
+	return calls
+}
+
+type testDTTMClient struct {
+	tmclient.TabletManagerClient
+	// results and errors are by shard.
+	results map[string]*sqltypes.Result
+	errors  map[string]error
+}
+
+func (tmc *testDTTMClient) ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int) (*querypb.QueryResult, error) {
+	if !strings.Contains(string(query), "from _vt.dt_state t") {
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+	if err := tmc.errors[tablet.Shard]; err != nil {
+		return nil, err
+	}
+	return sqltypes.ResultToProto3(tmc.results[tablet.Shard]), nil
+}
+
+type testDTQueryService struct {
+	queryservice.QueryService
+	shard string
+}
+
+func (qs *testDTQueryService) ReadTransaction(ctx context.Context, target *querypb.Target, dtid string) (*querypb.TransactionMetadata, error) {
+	dtEnv.record(qs.shard, "ReadTransaction")
+	dtEnv.mu.Lock()
+	defer dtEnv.mu.Unlock()
+	if transaction, ok := dtEnv.transactions[dtid]; ok {
+		return transaction, nil
+	}
+	return &querypb.TransactionMetadata{}, nil
+}
+
+func (qs *testDTQueryService) SetRollback(ctx context.Context, target *querypb.Target, dtid string, transactionID int64) error {
+	dtEnv.record(qs.shard, fmt.Sprintf("SetRollback(%d)", transactionID))
+	return nil
+}
+
+func (qs *testDTQueryService) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) error {
+	dtEnv.record(qs.shard, "CommitPrepared")
+	return nil
+}
+
+func (qs *testDTQueryService) RollbackPrepared(ctx context.Context, target *querypb.Target, dtid string, originalID int64) error {
+	dtEnv.record(qs.shard, "RollbackPrepared")
+	return nil
+}
+
+func (qs *testDTQueryService) ConcludeTransaction(ctx context.Context, target *querypb.Target, dtid string) error {
+	dtEnv.record(qs.shard, "ConcludeTransaction")
+	dtEnv.mu.Lock()
+	defer dtEnv.mu.Unlock()
+	delete(dtEnv.transactions, dtid)
+	return nil
+}
+
+func (qs *testDTQueryService) Close(ctx context.Context) error {
+	return nil
+}
+
+func dtParticipants(shards ...string) []*querypb.Target {
+	var targets []*querypb.Target
+	for _, shard := range shards {
+		targets = append(targets, &querypb.Target{Keyspace: "ks", Shard: shard, TabletType: topodatapb.TabletType_PRIMARY})
+	}
+	return targets
+}
+
+func TestListDistributedTransactions(t *testing.T) {
+	env := newTestDTEnv(t, "-80", "80-")
+	fields := sqltypes.MakeTestFields("dtid|state|time_created|keyspace|shard", "varbinary|int64|int64|varchar|varchar")
+	env.tmc.results["-80"] = sqltypes.MakeTestResult(fields,
+		"ks:-80:1|1|10|ks|-80",
+		"ks:-80:1|1|10|ks|80-",
+		"ks:-80:2|2|20|ks|-80",
+	)
+	// 2PC is not enabled on 80-.
+	env.tmc.errors["80-"] = mysql.NewSQLError(mysql.ERNoSuchTable, mysql.SSUnknownTable, "Table '_vt.dt_state' doesn't exist")
+
+	transactions, err := env.wr.ListDistributedTransactions(context.Background(), "ks", time.Minute)
+	require.NoError(t, err)
+	want := []*querypb.TransactionMetadata{{
+		Dtid:         "ks:-80:1",
+		State:        querypb.TransactionState_PREPARE,
+		TimeCreated:  10,
+		Participants: dtParticipants("-80", "80-"),
+	}, {
+		Dtid:         "ks:-80:2",
+		State:        querypb.TransactionState_COMMIT,
+		TimeCreated:  20,
+		Participants: dtParticipants("-80"),
+	}}
+	assert.Equal(t, fmt.Sprint(want), fmt.Sprint(transactions))
+
+	env.tmc.errors["80-"] = fmt.Errorf("tablet is down")
+	_, err = env.wr.ListDistributedTransactions(context.Background(), "ks", time.Minute)
+	require.EqualError(t, err, "tablet is down")
+}
+
+func TestConcludeDistributedTransaction(t *testing.T) {
+	testcases := []struct {
+		name         string
+		state        querypb.TransactionState
+		rollbackOnly bool
+		wantCalls    []string
+		wantErr      string
+	}{{
+		name:  "prepare",
+		state: querypb.TransactionState_PREPARE,
+		wantCalls: []string{
+			"-80:ConcludeTransaction",
+			"-80:ReadTransaction",
+			"-80:RollbackPrepared",
+			"-80:SetRollback(1)",
+			"80-:RollbackPrepared",
+		},
+	}, {
+		name:  "commit",
+		state: querypb.TransactionState_COMMIT,
+		wantCalls: []string{
+			"-80:CommitPrepared",
+			"-80:ConcludeTransaction",
+			"-80:ReadTransaction",
+			"80-:CommitPrepared",
+		},
+	}, {
+		name:         "rollback",
+		state:        querypb.TransactionState_ROLLBACK,
+		rollbackOnly: true,
+		wantCalls: []string{
+			"-80:ConcludeTransaction",
+			"-80:ReadTransaction",
+			"-80:RollbackPrepared",
+			"80-:RollbackPrepared",
+		},
+	}, {
+		name:         "rollback of committed transaction",
+		state:        querypb.TransactionState_COMMIT,
+		rollbackOnly: true,
+		wantCalls:    []string{"-80:ReadTransaction"},
+		wantErr:      "distributed transaction ks:-80:1 cannot be rolled back: the decision to commit it was already made",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			env := newTestDTEnv(t, "-80", "80-")
+			env.transactions["ks:-80:1"] = &querypb.TransactionMetadata{
+				Dtid:         "ks:-80:1",
+				State:        tcase.state,
+				Participants: dtParticipants("-80", "80-"),
+			}
+			var err error
+			if tcase.rollbackOnly {
+				err = env.wr.RollbackDistributedTransaction(context.Background(), "ks:-80:1")
+			} else {
+				err = env.wr.ConcludeDistributedTransaction(context.Background(), "ks:-80:1")
+			}
+			if tcase.wantErr != "" {
+				require.EqualError(t, err, tcase.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tcase.wantCalls, env.popCalls())
+
+			// The transaction is now resolved, or was left as is.
+			err = env.wr.ConcludeDistributedTransaction(context.Background(), "ks:-80:1")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestResolveAbandonedDistributedTransactions(t *testing.T) {
+	env := newTestDTEnv(t, "0")
+	fields := sqltypes.MakeTestFields("dtid|state|time_created|keyspace|shard", "varbinary|int64|int64|varchar|varchar")
+	env.tmc.results["0"] = sqltypes.MakeTestResult(fields,
+		"ks:0:1|2|10|ks|0",
+		"ks:0:2|2|10|ks|0",
+	)
+	env.transactions["ks:0:1"] = &querypb.TransactionMetadata{
+		Dtid:         "ks:0:1",
+		State:        querypb.TransactionState_COMMIT,
+		Participants: dtParticipants("0"),
+	}
+	// ks:0:2 has an invalid state.
+	env.transactions["ks:0:2"] = &querypb.TransactionMetadata{
+		Dtid:         "ks:0:2",
+		State:        querypb.TransactionState_UNKNOWN,
+		Participants: dtParticipants("0"),
+	}
+	resolved := distributedTransactionsResolved.Counts()["ks"]
+	ClearDistributedTransactionFailures()
+
+	err := env.wr.ResolveAbandonedDistributedTransactions(context.Background(), "ks", time.Minute)
+	require.EqualError(t, err, "could not resolve distributed transaction ks:0:2: invalid state for distributed transaction ks:0:2: UNKNOWN")
+	assert.EqualValues(t, 1, distributedTransactionsResolved.Counts()["ks"]-resolved)
+	assert.EqualValues(t, 1, distributedTransactionResolveFailures.Counts()["ks"])
+	assert.EqualValues(t, 2, unresolvedDistributedTransactions.Counts()["ks"])
+	assert.NotContains(t, env.transactions, "ks:0:1")
+
+	ClearDistributedTransactionFailures()
+	assert.EqualValues(t, 0, distributedTransactionResolveFailures.Counts()["ks"])
+}
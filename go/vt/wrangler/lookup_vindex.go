@@ -0,0 +1,332 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vttimepb "vitess.io/vitess/go/vt/proto/vttime"
+)
+
+// lookupVindex is a lookup vindex created by CreateLookupVindex, along with
+// the workflow backfilling its lookup table.
+type lookupVindex struct {
+	sourceKeyspace string
+	name           string
+	// sourceVSchema is the vschema of the keyspace of the vindex,
+	// and vindex points to the vindex in it.
+	sourceVSchema *vschemapb.Keyspace
+	vindex        *vschemapb.Vindex
+
+	targetKeyspace string
+	targetTable    string
+	workflow       string
+}
+
+// getLookupVindex fetches the lookup vindex named keyspace.vindex from the vschema.
+func (wr *Wrangler) getLookupVindex(ctx context.Context, qualifiedVindexName string) (*lookupVindex, error) {
+	splits := strings.Split(qualifiedVindexName, ".")
+	if len(splits) != 2 {
+		return nil, fmt.Errorf("vindex name should be of the form keyspace.vindex: %s", qualifiedVindexName)
+	}
+	lv := &lookupVindex{
+		sourceKeyspace: splits[0],
+		name:           splits[1],
+	}
+	var err error
+	lv.sourceVSchema, err = wr.ts.GetVSchema(ctx, lv.sourceKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	lv.vindex = lv.sourceVSchema.Vindexes[lv.name]
+	if lv.vindex == nil {
+		return nil, fmt.Errorf("vindex %s not found in vschema", qualifiedVindexName)
+	}
+	qualifiedTableName := lv.vindex.Params["table"]
+	splits = strings.Split(qualifiedTableName, ".")
+	if len(splits) != 2 {
+		return nil, fmt.Errorf("table name in vindex should be of the form keyspace.table: %s", qualifiedTableName)
+	}
+	lv.targetKeyspace, lv.targetTable = splits[0], splits[1]
+	lv.workflow = lv.targetTable + "_vdx"
+	return lv, nil
+}
+
+// LookupVindexStatus is the status of a lookup vindex and of the workflow backfilling it.
+type LookupVindexStatus struct {
+	// Vindex is the name of the vindex, as keyspace.vindex.
+	Vindex string
+	// Table is the lookup table of the vindex, as keyspace.table.
+	Table string
+	// Workflow is the name of the workflow backfilling the lookup table.
+	Workflow string
+	// WriteOnly is true until the vindex is externalized.
+	WriteOnly bool
+	// RowsCopied is the number of rows copied by all the streams of the workflow.
+	RowsCopied int64
+	// MaxVReplicationLag is the maximum vreplication lag across all the streams, in seconds.
+	MaxVReplicationLag int64
+	// Streams are the streams of the workflow. Their ShardStatuses are empty
+	// once the workflow of an owned vindex is removed by its externalization.
+	Streams *ReplicationStatusResult
+}
+
+// LookupVindexShow returns the status of a lookup vindex and of the workflow backfilling it.
+func (wr *Wrangler) LookupVindexShow(ctx context.Context, qualifiedVindexName string) (*LookupVindexStatus, error) {
+	lv, err := wr.getLookupVindex(ctx, qualifiedVindexName)
+	if err != nil {
+		return nil, err
+	}
+	streams, err := wr.getStreams(ctx, lv.workflow, lv.targetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	status := &LookupVindexStatus{
+		Vindex:             qualifiedVindexName,
+		Table:              lv.vindex.Params["table"],
+		Workflow:           lv.workflow,
+		WriteOnly:          lv.vindex.Params["write_only"] == "true",
+		MaxVReplicationLag: streams.MaxVReplicationLag,
+		Streams:            streams,
+	}
+	for _, shardStatus := range streams.ShardStatuses {
+		for _, stream := range shardStatus.PrimaryReplicationStatuses {
+			status.RowsCopied += stream.RowsCopied
+		}
+	}
+	return status, nil
+}
+
+// LookupVindexVerify compares the lookup table of a lookup vindex with the rows
+// of its owner table. The streams backfilling the lookup table are synchronized
+// with the owner table for the comparison, like VDiff does, unless they were
+// stopped after their copy phase: the lookup table is then maintained by vtgate,
+// and the tables are compared as of the current positions of their primaries.
+func (wr *Wrangler) LookupVindexVerify(ctx context.Context, qualifiedVindexName, sourceCell, targetCell, tabletTypesStr string,
+	filteredReplicationWaitTime time.Duration, format string, maxRows int64, debug, onlyPks bool) (*DiffReport, error) {
+	lv, err := wr.getLookupVindex(ctx, qualifiedVindexName)
+	if err != nil {
+		return nil, err
+	}
+	streams, err := wr.ShowWorkflow(ctx, lv.workflow, lv.targetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	df, err := wr.newVDiff(ctx, lv.targetKeyspace, lv.workflow, sourceCell, targetCell, tabletTypesStr, lv.targetTable)
+	if err != nil {
+		return nil, err
+	}
+	df.skipSync = stoppedAfterCopy(streams)
+	diffReports, err := df.diffTables(ctx, wr, filteredReplicationWaitTime, maxRows, debug, onlyPks)
+	if err != nil {
+		return nil, err
+	}
+	wr.printDiffReports(diffReports, format, debug)
+	dr := diffReports[lv.targetTable]
+	if dr == nil {
+		return nil, fmt.Errorf("the lookup table %s of vindex %s was not compared", lv.vindex.Params["table"], qualifiedVindexName)
+	}
+	return dr, nil
+}
+
+// LookupVindexExternalize verifies that the lookup table of a lookup vindex matches
+// its owner table, and externalizes the vindex if it does.
+func (wr *Wrangler) LookupVindexExternalize(ctx context.Context, qualifiedVindexName, sourceCell, targetCell, tabletTypesStr string,
+	filteredReplicationWaitTime time.Duration) error {
+	dr, err := wr.LookupVindexVerify(ctx, qualifiedVindexName, sourceCell, targetCell, tabletTypesStr, filteredReplicationWaitTime, "", math.MaxInt64, false, true)
+	if err != nil {
+		return err
+	}
+	if dr.MismatchedRows != 0 || dr.ExtraRowsSource != 0 || dr.ExtraRowsTarget != 0 {
+		return fmt.Errorf("the lookup table of vindex %s does not match its owner table: %d mismatched rows, %d missing rows, %d extra rows",
+			qualifiedVindexName, dr.MismatchedRows, dr.ExtraRowsSource, dr.ExtraRowsTarget)
+	}
+	return wr.ExternalizeVindex(ctx, qualifiedVindexName)
+}
+
+// stoppedAfterCopy returns true if all the streams of the workflow were stopped after their copy phase.
+func stoppedAfterCopy(streams *ReplicationStatusResult) bool {
+	for _, shardStatus := range streams.ShardStatuses {
+		for _, stream := range shardStatus.PrimaryReplicationStatuses {
+			if stream.State != binlogplayer.BlpStopped || !strings.Contains(stream.Message, "Stopped after copy") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func init() {
+	grpcvtctldserver.RegisterLookupVindexManagerFactory(func(ts *topo.Server, tmc tmclient.TabletManagerClient) grpcvtctldserver.LookupVindexManager {
+		return &lookupVindexManager{wr: New(logutil.NewMemoryLogger(), ts, tmc)}
+	})
+}
+
+// lookupVindexManager implements the LookupVindex RPCs of the vtctld server.
+type lookupVindexManager struct {
+	wr *Wrangler
+}
+
+// LookupVindexCreate is part of the grpcvtctldserver.LookupVindexManager interface.
+func (lvm *lookupVindexManager) LookupVindexCreate(ctx context.Context, req *vtctldatapb.LookupVindexCreateRequest) (*vtctldatapb.LookupVindexCreateResponse, error) {
+	err := lvm.wr.CreateLookupVindex(ctx, req.Keyspace, req.Vindex, strings.Join(req.Cells, ","), tabletTypesString(req.TabletTypes, ""), req.ContinueAfterCopyWithOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.LookupVindexCreateResponse{}, nil
+}
+
+// LookupVindexShow is part of the grpcvtctldserver.LookupVindexManager interface.
+func (lvm *lookupVindexManager) LookupVindexShow(ctx context.Context, req *vtctldatapb.LookupVindexShowRequest) (*vtctldatapb.LookupVindexShowResponse, error) {
+	status, err := lvm.wr.LookupVindexShow(ctx, req.Keyspace+"."+req.Name)
+	if err != nil {
+		return nil, err
+	}
+	resp := &vtctldatapb.LookupVindexShowResponse{
+		Table:              status.Table,
+		Workflow:           status.Workflow,
+		WriteOnly:          status.WriteOnly,
+		RowsCopied:         status.RowsCopied,
+		MaxVReplicationLag: status.MaxVReplicationLag,
+	}
+	for _, shardStatus := range status.Streams.ShardStatuses {
+		for _, stream := range shardStatus.PrimaryReplicationStatuses {
+			resp.Streams = append(resp.Streams, &vtctldatapb.LookupVindexShowResponse_Stream{
+				Shard:      stream.Shard,
+				Id:         stream.ID,
+				State:      stream.State,
+				RowsCopied: stream.RowsCopied,
+				Message:    stream.Message,
+			})
+		}
+	}
+	sort.Slice(resp.Streams, func(i, j int) bool {
+		if resp.Streams[i].Shard != resp.Streams[j].Shard {
+			return resp.Streams[i].Shard < resp.Streams[j].Shard
+		}
+		return resp.Streams[i].Id < resp.Streams[j].Id
+	})
+	return resp, nil
+}
+
+// LookupVindexVerify is part of the grpcvtctldserver.LookupVindexManager interface.
+func (lvm *lookupVindexManager) LookupVindexVerify(ctx context.Context, req *vtctldatapb.LookupVindexVerifyRequest) (*vtctldatapb.LookupVindexVerifyResponse, error) {
+	filteredReplicationWaitTime, err := filteredReplicationWaitTimeFromProto(req.FilteredReplicationWaitTime)
+	if err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = math.MaxInt64
+	}
+	dr, err := lvm.wr.LookupVindexVerify(ctx, req.Keyspace+"."+req.Name, req.SourceCell, req.TargetCell, tabletTypesString(req.TabletTypes, defaultLookupVindexTabletTypes),
+		filteredReplicationWaitTime, "", limit, req.DebugQuery, req.OnlyPks)
+	if err != nil {
+		return nil, err
+	}
+	resp := &vtctldatapb.LookupVindexVerifyResponse{
+		ProcessedRows:   int64(dr.ProcessedRows),
+		MatchingRows:    int64(dr.MatchingRows),
+		MismatchedRows:  int64(dr.MismatchedRows),
+		ExtraRowsSource: int64(dr.ExtraRowsSource),
+		ExtraRowsTarget: int64(dr.ExtraRowsTarget),
+	}
+	for _, rd := range dr.ExtraRowsSourceSample {
+		resp.ExtraRowsSourceSample = append(resp.ExtraRowsSourceSample, rowDiffToProto(rd))
+	}
+	for _, rd := range dr.ExtraRowsTargetSample {
+		resp.ExtraRowsTargetSample = append(resp.ExtraRowsTargetSample, rowDiffToProto(rd))
+	}
+	for _, mismatch := range dr.MismatchedRowsSample {
+		resp.MismatchedRowsSample = append(resp.MismatchedRowsSample, &vtctldatapb.LookupVindexVerifyResponse_Mismatch{
+			Source: rowDiffToProto(mismatch.Source),
+			Target: rowDiffToProto(mismatch.Target),
+		})
+	}
+	return resp, nil
+}
+
+// LookupVindexExternalize is part of the grpcvtctldserver.LookupVindexManager interface.
+func (lvm *lookupVindexManager) LookupVindexExternalize(ctx context.Context, req *vtctldatapb.LookupVindexExternalizeRequest) (*vtctldatapb.LookupVindexExternalizeResponse, error) {
+	filteredReplicationWaitTime, err := filteredReplicationWaitTimeFromProto(req.FilteredReplicationWaitTime)
+	if err != nil {
+		return nil, err
+	}
+	err = lvm.wr.LookupVindexExternalize(ctx, req.Keyspace+"."+req.Name, req.SourceCell, req.TargetCell, tabletTypesString(req.TabletTypes, defaultLookupVindexTabletTypes),
+		filteredReplicationWaitTime)
+	if err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.LookupVindexExternalizeResponse{}, nil
+}
+
+// defaultLookupVindexTabletTypes are the tablet types the tables of a lookup
+// vindex are compared from by default.
+const defaultLookupVindexTabletTypes = "primary,replica,rdonly"
+
+// tabletTypesString returns the tablet types in the form the wrangler parses
+// them, or def if there are none.
+func tabletTypesString(tabletTypes []topodatapb.TabletType, def string) string {
+	if len(tabletTypes) == 0 {
+		return def
+	}
+	strs := make([]string, len(tabletTypes))
+	for i, tabletType := range tabletTypes {
+		strs[i] = topoproto.TabletTypeLString(tabletType)
+	}
+	return strings.Join(strs, ",")
+}
+
+func filteredReplicationWaitTimeFromProto(d *vttimepb.Duration) (time.Duration, error) {
+	filteredReplicationWaitTime, ok, err := protoutil.DurationFromProto(d)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		filteredReplicationWaitTime = 30 * time.Second
+	}
+	return filteredReplicationWaitTime, nil
+}
+
+func rowDiffToProto(rd *RowDiff) *vtctldatapb.LookupVindexVerifyResponse_RowDiff {
+	if rd == nil {
+		return nil
+	}
+	row := make(map[string]*querypb.Value, len(rd.Row))
+	for col, value := range rd.Row {
+		row[col] = sqltypes.ValueToProto(value)
+	}
+	return &vtctldatapb.LookupVindexVerifyResponse_RowDiff{Row: row, Query: rd.Query}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestLookupVindexShow(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"-80", "80-"})
+	defer env.close()
+
+	err := env.topoServ.SaveVSchema(context.Background(), ms.SourceKeyspace, &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"owned": {
+				Type: "lookup_unique",
+				Params: map[string]string{
+					"table":      "targetks.lkp",
+					"from":       "c1",
+					"to":         "c2",
+					"write_only": "true",
+				},
+				Owner: "t1",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: "sourceks",
+		Shard:    "0",
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "lkp",
+				Filter: "select c1 as c1, keyspace_id() as c2 from t1 group by c1, c2",
+			}},
+		},
+	}
+	fields := sqltypes.MakeTestFields(
+		"id|source|pos|stop_pos|max_replication_lag|state|db_name|time_updated|transaction_timestamp|message|tags|rows_copied",
+		"int64|varchar|varchar|varchar|int64|varchar|varchar|int64|int64|varchar|varchar|int64",
+	)
+	streamsQuery := "select id, source, pos, stop_pos, max_replication_lag, state, db_name, time_updated, transaction_timestamp, message, tags, rows_copied from _vt.vreplication where db_name = 'vt_targetks' and workflow = 'lkp_vdx'"
+	copyStateQuery := "select table_name, lastpk from _vt.copy_state where vrepl_id = 1"
+	copyStateFields := sqltypes.MakeTestFields("table_name|lastpk", "varchar|varchar")
+
+	// streams are the state, message and rows_copied of the streams of -80 and 80-.
+	testcases := []struct {
+		name           string
+		streams        []string
+		copyState      bool
+		wantRowsCopied int64
+		wantStopped    bool
+	}{{
+		name:           "copying",
+		streams:        []string{"Running||10", "Running||15"},
+		copyState:      true,
+		wantRowsCopied: 25,
+	}, {
+		name:           "stopped after copy",
+		streams:        []string{"Stopped|Stopped after copy|20", "Stopped|Stopped after copy|30"},
+		wantRowsCopied: 50,
+		wantStopped:    true,
+	}, {
+		name:           "one stream stopped after copy",
+		streams:        []string{"Stopped|Stopped after copy|20", "Running||30"},
+		wantRowsCopied: 50,
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			expectStreams := func() {
+				for i, tabletID := range []int{200, 210} {
+					stream := strings.Split(tcase.streams[i], "|")
+					env.tmc.expectVRQuery(tabletID, streamsQuery, sqltypes.MakeTestResult(fields,
+						fmt.Sprintf("1|%v|MySQL56/14b68925-696a-11ea-aee7-fec597a91f5e:1-3||0|%s|vt_targetks|0|0|%s||%s", bls, stream[0], stream[1], stream[2]),
+					))
+					copyState := sqltypes.MakeTestResult(copyStateFields)
+					if tcase.copyState {
+						copyState = sqltypes.MakeTestResult(copyStateFields, "lkp|pk1")
+					}
+					env.tmc.expectVRQuery(tabletID, copyStateQuery, copyState)
+				}
+			}
+
+			expectStreams()
+			status, err := env.wr.LookupVindexShow(context.Background(), "sourceks.owned")
+			require.NoError(t, err)
+			env.tmc.verifyQueries(t)
+			assert.Equal(t, "sourceks.owned", status.Vindex)
+			assert.Equal(t, "targetks.lkp", status.Table)
+			assert.Equal(t, "lkp_vdx", status.Workflow)
+			assert.True(t, status.WriteOnly)
+			assert.Equal(t, tcase.wantRowsCopied, status.RowsCopied)
+			assert.Len(t, status.Streams.ShardStatuses, 2)
+			assert.Equal(t, tcase.wantStopped, stoppedAfterCopy(status.Streams))
+
+			// The LookupVindexShow RPC returns the streams ordered by shard.
+			expectStreams()
+			lvm := &lookupVindexManager{wr: env.wr}
+			resp, err := lvm.LookupVindexShow(context.Background(), &vtctldatapb.LookupVindexShowRequest{Keyspace: "sourceks", Name: "owned"})
+			require.NoError(t, err)
+			env.tmc.verifyQueries(t)
+			assert.Equal(t, "targetks.lkp", resp.Table)
+			assert.Equal(t, "lkp_vdx", resp.Workflow)
+			assert.True(t, resp.WriteOnly)
+			assert.Equal(t, tcase.wantRowsCopied, resp.RowsCopied)
+			require.Len(t, resp.Streams, 2)
+			for i, shard := range []string{"-80", "80-"} {
+				stream := strings.Split(tcase.streams[i], "|")
+				assert.Equal(t, shard, resp.Streams[i].Shard)
+				assert.Equal(t, stream[1], resp.Streams[i].Message)
+				assert.Equal(t, stream[2], fmt.Sprint(resp.Streams[i].RowsCopied))
+			}
+		})
+	}
+}
@@ -659,26 +659,12 @@ func generateColDef(lines []string, sourceVindexCol, vindexFromCol string) (stri
 
 // ExternalizeVindex externalizes a lookup vindex that's finished backfilling or has caught up.
 func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName string) error {
-	splits := strings.Split(qualifiedVindexName, ".")
-	if len(splits) != 2 {
-		return fmt.Errorf("vindex name should be of the form keyspace.vindex: %s", qualifiedVindexName)
-	}
-	sourceKeyspace, vindexName := splits[0], splits[1]
-	sourceVSchema, err := wr.ts.GetVSchema(ctx, sourceKeyspace)
+	lv, err := wr.getLookupVindex(ctx, qualifiedVindexName)
 	if err != nil {
 		return err
 	}
-	sourceVindex := sourceVSchema.Vindexes[vindexName]
-	if sourceVindex == nil {
-		return fmt.Errorf("vindex %s not found in vschema", qualifiedVindexName)
-	}
-	qualifiedTableName := sourceVindex.Params["table"]
-	splits = strings.Split(qualifiedTableName, ".")
-	if len(splits) != 2 {
-		return fmt.Errorf("table name in vindex should be of the form keyspace.table: %s", qualifiedTableName)
-	}
-	targetKeyspace, targetTableName := splits[0], splits[1]
-	workflow := targetTableName + "_vdx"
+	sourceKeyspace, sourceVSchema, sourceVindex := lv.sourceKeyspace, lv.sourceVSchema, lv.vindex
+	targetKeyspace, workflow := lv.targetKeyspace, lv.workflow
 	targetShards, err := wr.ts.GetServingShards(ctx, targetKeyspace)
 	if err != nil {
 		return err
@@ -45,6 +45,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/tabletconn"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 
@@ -97,6 +98,12 @@ type vdiff struct {
 	workflow       string
 	targetKeyspace string
 	tables         []string
+
+	// skipSync is set when the streams of the workflow were stopped after
+	// their copy phase, and the target tables are kept up to date outside
+	// of vreplication. The tables are then compared as of the current primary
+	// positions, and the streams are left untouched.
+	skipSync bool
 }
 
 // compareColInfo contains the metadata for a column of the table being diffed
@@ -152,6 +159,57 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 	filteredReplicationWaitTime time.Duration, format string, maxRows int64, tables string, debug, onlyPks bool) (map[string]*DiffReport, error) {
 	log.Infof("Starting VDiff for %s.%s, sourceCell %s, targetCell %s, tabletTypes %s, timeout %s",
 		targetKeyspace, workflowName, sourceCell, targetCell, tabletTypesStr, filteredReplicationWaitTime.String())
+	df, err := wr.newVDiff(ctx, targetKeyspace, workflowName, sourceCell, targetCell, tabletTypesStr, tables)
+	if err != nil {
+		return nil, err
+	}
+	diffReports, err := df.diffTables(ctx, wr, filteredReplicationWaitTime, maxRows, debug, onlyPks)
+	if err != nil {
+		return nil, err
+	}
+	wr.printDiffReports(diffReports, format, debug)
+	return diffReports, nil
+}
+
+// printDiffReports logs the diff reports in the requested format.
+func (wr *Wrangler) printDiffReports(diffReports map[string]*DiffReport, format string, debug bool) {
+	jsonOutput := ""
+	if format == "json" {
+		json, err := json.MarshalIndent(diffReports, "", "")
+		if err != nil {
+			wr.Logger().Printf("Error converting report to json: %v", err.Error())
+		}
+		jsonOutput += fmt.Sprintf("%s", json)
+		wr.logger.Printf("%s", jsonOutput)
+	} else {
+		for table, dr := range diffReports {
+			wr.Logger().Printf("Summary for table %v:\n", table)
+			wr.Logger().Printf("\tProcessedRows: %v\n", dr.ProcessedRows)
+			wr.Logger().Printf("\tMatchingRows: %v\n", dr.MatchingRows)
+			wr.Logger().Printf("\tMismatchedRows: %v\n", dr.MismatchedRows)
+			wr.Logger().Printf("\tExtraRowsSource: %v\n", dr.ExtraRowsSource)
+			wr.Logger().Printf("\tExtraRowsTarget: %v\n", dr.ExtraRowsTarget)
+			for i, rs := range dr.ExtraRowsSourceSample {
+				wr.Logger().Printf("\tSample extra row in source %v:\n", i)
+				formatSampleRow(wr.Logger(), rs, debug)
+			}
+			for i, rs := range dr.ExtraRowsTargetSample {
+				wr.Logger().Printf("\tSample extra row in target %v:\n", i)
+				formatSampleRow(wr.Logger(), rs, debug)
+			}
+			for i, rs := range dr.MismatchedRowsSample {
+				wr.Logger().Printf("\tSample rows with mismatch %v:\n", i)
+				wr.Logger().Printf("\t\tSource row:\n")
+				formatSampleRow(wr.Logger(), rs.Source, debug)
+				wr.Logger().Printf("\t\tTarget row:\n")
+				formatSampleRow(wr.Logger(), rs.Target, debug)
+			}
+		}
+	}
+}
+
+// newVDiff builds the vdiff of the tables of a workflow, and selects the tablets it streams from.
+func (wr *Wrangler) newVDiff(ctx context.Context, targetKeyspace, workflowName, sourceCell, targetCell, tabletTypesStr, tables string) (*vdiff, error) {
 	// Assign defaults to sourceCell and targetCell if not specified.
 	if sourceCell == "" && targetCell == "" {
 		cells, err := wr.ts.GetCellInfoNames(ctx)
@@ -227,11 +285,18 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 	if err := df.selectTablets(ctx, ts); err != nil {
 		return nil, vterrors.Wrap(err, "selectTablets")
 	}
-	defer func(ctx context.Context) {
-		if err := df.restartTargets(ctx); err != nil {
-			wr.Logger().Errorf("Could not restart workflow %s: %v, please restart it manually", workflowName, err)
-		}
-	}(ctx)
+	return df, nil
+}
+
+// diffTables performs the diffs of all the tables of the vdiff.
+func (df *vdiff) diffTables(ctx context.Context, wr *Wrangler, filteredReplicationWaitTime time.Duration, maxRows int64, debug, onlyPks bool) (map[string]*DiffReport, error) {
+	if !df.skipSync {
+		defer func(ctx context.Context) {
+			if err := df.restartTargets(ctx); err != nil {
+				wr.Logger().Errorf("Could not restart workflow %s: %v, please restart it manually", df.workflow, err)
+			}
+		}(ctx)
+	}
 
 	// Perform the diffs.
 	// We need a cancelable context to abort all running streams
@@ -242,7 +307,6 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 	// TODO(sougou): parallelize
 	rowsToCompare := maxRows
 	diffReports := make(map[string]*DiffReport)
-	jsonOutput := ""
 	for table, td := range df.differs {
 		// Skip internal operation tables for vdiff
 		if schema.IsInternalOperationTableName(table) {
@@ -259,38 +323,6 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 		dr.TableName = table
 		diffReports[table] = dr
 	}
-	if format == "json" {
-		json, err := json.MarshalIndent(diffReports, "", "")
-		if err != nil {
-			wr.Logger().Printf("Error converting report to json: %v", err.Error())
-		}
-		jsonOutput += fmt.Sprintf("%s", json)
-		wr.logger.Printf("%s", jsonOutput)
-	} else {
-		for table, dr := range diffReports {
-			wr.Logger().Printf("Summary for table %v:\n", table)
-			wr.Logger().Printf("\tProcessedRows: %v\n", dr.ProcessedRows)
-			wr.Logger().Printf("\tMatchingRows: %v\n", dr.MatchingRows)
-			wr.Logger().Printf("\tMismatchedRows: %v\n", dr.MismatchedRows)
-			wr.Logger().Printf("\tExtraRowsSource: %v\n", dr.ExtraRowsSource)
-			wr.Logger().Printf("\tExtraRowsTarget: %v\n", dr.ExtraRowsTarget)
-			for i, rs := range dr.ExtraRowsSourceSample {
-				wr.Logger().Printf("\tSample extra row in source %v:\n", i)
-				formatSampleRow(wr.Logger(), rs, debug)
-			}
-			for i, rs := range dr.ExtraRowsTargetSample {
-				wr.Logger().Printf("\tSample extra row in target %v:\n", i)
-				formatSampleRow(wr.Logger(), rs, debug)
-			}
-			for i, rs := range dr.MismatchedRowsSample {
-				wr.Logger().Printf("\tSample rows with mismatch %v:\n", i)
-				wr.Logger().Printf("\t\tSource row:\n")
-				formatSampleRow(wr.Logger(), rs.Source, debug)
-				wr.Logger().Printf("\t\tTarget row:\n")
-				formatSampleRow(wr.Logger(), rs.Target, debug)
-			}
-		}
-	}
 	return diffReports, nil
 }
 
@@ -313,6 +345,10 @@ func (df *vdiff) diffTable(ctx context.Context, wr *Wrangler, table string, td *
 		}
 	}()
 
+	if df.skipSync {
+		return df.startUnsyncedQueryStreams(ctx, td, filteredReplicationWaitTime)
+	}
+
 	defer func() {
 		log.Errorf("restarting targets for workflow %s in keyspace %s", df.workflow, df.targetKeyspace)
 		if err := df.restartTargets(ctx); err != nil {
@@ -339,6 +375,24 @@ func (df *vdiff) diffTable(ctx context.Context, wr *Wrangler, table string, td *
 	return nil
 }
 
+// startUnsyncedQueryStreams starts the query streams of the sources and targets
+// as of the current positions of their primaries, without synchronizing the streams.
+func (df *vdiff) startUnsyncedQueryStreams(ctx context.Context, td *tableDiffer, filteredReplicationWaitTime time.Duration) error {
+	if err := df.recordPrimaryPositions(ctx, df.sources); err != nil {
+		return vterrors.Wrap(err, "recordPrimaryPositions(sources)")
+	}
+	if err := df.startQueryStreams(ctx, df.ts.sourceKeyspace, df.sources, td.sourceExpression, filteredReplicationWaitTime); err != nil {
+		return vterrors.Wrap(err, "startQueryStreams(sources)")
+	}
+	if err := df.recordPrimaryPositions(ctx, df.targets); err != nil {
+		return vterrors.Wrap(err, "recordPrimaryPositions(targets)")
+	}
+	if err := df.startQueryStreams(ctx, df.ts.targetKeyspace, df.targets, td.targetExpression, filteredReplicationWaitTime); err != nil {
+		return vterrors.Wrap(err, "startQueryStreams(targets)")
+	}
+	return nil
+}
+
 // buildVDiffPlan builds all the differs.
 func (df *vdiff) buildVDiffPlan(ctx context.Context, filter *binlogdatapb.Filter, schm *tabletmanagerdatapb.SchemaDefinition, tablesToInclude []string) error {
 	df.differs = make(map[string]*tableDiffer)
@@ -435,6 +489,8 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 	targetSelect := &sqlparser.Select{}
 	// aggregates contains the list if Aggregate functions, if any.
 	var aggregates []*engine.AggregateParams
+	// ksidMapper computes the keyspace_id() column of the source, if any.
+	var ksidMapper *keyspaceIDMapper
 	for _, selExpr := range sel.SelectExprs {
 		switch selExpr := selExpr.(type) {
 		case *sqlparser.StarExpr:
@@ -455,6 +511,20 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 					return nil, fmt.Errorf("expression needs an alias: %v", sqlparser.String(selExpr))
 				}
 			}
+			if expr, ok := selExpr.Expr.(*sqlparser.FuncExpr); ok && expr.Name.EqualString("keyspace_id") {
+				// keyspace_id() is not understood by mysql: the first column of the primary
+				// vindex is selected in its place, and ksidMapper computes the keyspace id.
+				if ksidMapper != nil {
+					return nil, fmt.Errorf("keyspace_id() can be selected only once: %v", sqlparser.String(statement))
+				}
+				ksidMapper, err = df.newKeyspaceIDMapper(sel, len(sourceSelect.SelectExprs))
+				if err != nil {
+					return nil, err
+				}
+				sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: ksidMapper.vindexColumns[0], As: selExpr.As})
+				targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: targetCol})
+				continue
+			}
 			// If the input was "select a as b", then source will use "a" and target will use "b".
 			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, selExpr)
 			targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: targetCol})
@@ -496,6 +566,16 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 		}
 	}
 
+	groupBy := sel.GroupBy
+	if ksidMapper != nil {
+		// The other columns of the primary vindex are selected at the end of the source rows.
+		for _, col := range ksidMapper.vindexColumns[1:] {
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: col})
+			ksidMapper.vindexCols = append(ksidMapper.vindexCols, len(sourceSelect.SelectExprs)-1)
+		}
+		groupBy = ksidMapper.rewriteGroupBy(groupBy, targetSelect.SelectExprs[ksidMapper.ksidCol].(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name)
+	}
+
 	sourceSelect.From = sel.From
 	// The target table name should the one that matched the rule.
 	// It can be different from the source table.
@@ -511,10 +591,14 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 	if err != nil {
 		return nil, err
 	}
+	if ksidMapper != nil && td.compareCols[ksidMapper.ksidCol].isPK {
+		// The source rows are sorted before their keyspace id is computed.
+		return nil, fmt.Errorf("keyspace_id() cannot be part of the primary key of table %v", table.Name)
+	}
 	// Remove in_keyrange. It's not understood by mysql.
 	sourceSelect.Where = removeKeyrange(sel.Where)
 	// The source should also perform the group by.
-	sourceSelect.GroupBy = sel.GroupBy
+	sourceSelect.GroupBy = groupBy
 	sourceSelect.OrderBy = orderby
 
 	// The target should perform the order by, but not the group by.
@@ -525,6 +609,10 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 
 	td.sourcePrimitive = newMergeSorter(df.sources, td.comparePKs)
 	td.targetPrimitive = newMergeSorter(df.targets, td.comparePKs)
+	if ksidMapper != nil {
+		ksidMapper.Primitive = td.sourcePrimitive
+		td.sourcePrimitive = ksidMapper
+	}
 	// If there were aggregate expressions, we have to re-aggregate
 	// the results, which engine.OrderedAggregate can do.
 	if len(aggregates) != 0 {
@@ -538,6 +626,103 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 	return td, nil
 }
 
+// keyspaceIDMapper computes the keyspace_id() column of the source rows with the
+// primary vindex of the source table. The source query selects the first column
+// of the vindex in place of keyspace_id(), and its other columns at the end of
+// the rows. Only TryStreamExecute is used by vdiff.
+type keyspaceIDMapper struct {
+	engine.Primitive
+
+	vindex        vindexes.Vindex
+	vindexColumns []*sqlparser.ColName
+	// ksidCol is the index of the keyspace_id() column in the rows.
+	ksidCol int
+	// vindexCols are the indexes of the vindex columns in the rows.
+	vindexCols []int
+}
+
+// newKeyspaceIDMapper creates the keyspaceIDMapper of a keyspace_id() column selected
+// from the source table of the filter.
+func (df *vdiff) newKeyspaceIDMapper(sel *sqlparser.Select, ksidCol int) (*keyspaceIDMapper, error) {
+	if len(sel.From) != 1 {
+		return nil, fmt.Errorf("keyspace_id() needs a single source table: %v", sqlparser.String(sel))
+	}
+	from, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, fmt.Errorf("keyspace_id() needs a single source table: %v", sqlparser.String(sel))
+	}
+	tableName := sqlparser.GetTableName(from.Expr).String()
+	if df.ts == nil || df.ts.sourceKSSchema == nil {
+		// Unreachable.
+		return nil, fmt.Errorf("no vschema found to compute keyspace_id() for table %s", tableName)
+	}
+	table := df.ts.sourceKSSchema.Tables[tableName]
+	if table == nil || len(table.ColumnVindexes) == 0 {
+		return nil, fmt.Errorf("table %s has no primary vindex to compute keyspace_id()", tableName)
+	}
+	primary := table.ColumnVindexes[0]
+	if primary.Vindex.NeedsVCursor() {
+		return nil, fmt.Errorf("primary vindex %s of table %s cannot compute keyspace_id() outside of vtgate", primary.Name, tableName)
+	}
+	km := &keyspaceIDMapper{
+		vindex:     primary.Vindex,
+		ksidCol:    ksidCol,
+		vindexCols: []int{ksidCol},
+	}
+	for _, col := range primary.Columns {
+		km.vindexColumns = append(km.vindexColumns, &sqlparser.ColName{Name: col})
+	}
+	return km, nil
+}
+
+// rewriteGroupBy replaces the keyspace_id() column of the group by with the vindex columns.
+func (km *keyspaceIDMapper) rewriteGroupBy(groupBy sqlparser.GroupBy, ksidName sqlparser.ColIdent) sqlparser.GroupBy {
+	var rewritten sqlparser.GroupBy
+	for _, expr := range groupBy {
+		if col, ok := expr.(*sqlparser.ColName); ok && col.Qualifier.IsEmpty() && col.Name.Equal(ksidName) {
+			for _, vindexCol := range km.vindexColumns {
+				rewritten = append(rewritten, vindexCol)
+			}
+			continue
+		}
+		rewritten = append(rewritten, expr)
+	}
+	return rewritten
+}
+
+// TryStreamExecute streams the rows of the input with their keyspace id.
+func (km *keyspaceIDMapper) TryStreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return vcursor.StreamExecutePrimitive(km.Primitive, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			fields := make([]*querypb.Field, len(qr.Fields))
+			copy(fields, qr.Fields)
+			fields[km.ksidCol] = &querypb.Field{Name: fields[km.ksidCol].Name, Type: sqltypes.VarBinary}
+			qr.Fields = fields
+		}
+		if len(qr.Rows) == 0 {
+			return callback(qr)
+		}
+		rowsColValues := make([][]sqltypes.Value, len(qr.Rows))
+		for i, row := range qr.Rows {
+			for _, col := range km.vindexCols {
+				rowsColValues[i] = append(rowsColValues[i], row[col])
+			}
+		}
+		destinations, err := vindexes.Map(km.vindex, nil, rowsColValues)
+		if err != nil {
+			return err
+		}
+		for i, destination := range destinations {
+			ksid, ok := destination.(key.DestinationKeyspaceID)
+			if !ok {
+				return fmt.Errorf("could not map %v to a keyspace id with vindex %s", rowsColValues[i], km.vindex)
+			}
+			qr.Rows[i][km.ksidCol] = sqltypes.MakeTrusted(sqltypes.VarBinary, ksid)
+		}
+		return callback(qr)
+	})
+}
+
 func pkColsToGroupByParams(pkCols []int) []*engine.GroupByParams {
 	var res []*engine.GroupByParams
 	for _, col := range pkCols {
@@ -771,9 +956,13 @@ func (df *vdiff) syncTargets(ctx context.Context, filteredReplicationWaitTime ti
 	if err != nil {
 		return err
 	}
+	return df.recordPrimaryPositions(ctx, df.targets)
+}
 
-	err = df.forAll(df.targets, func(shard string, target *shardStreamer) error {
-		pos, err := df.ts.wr.tmc.MasterPosition(ctx, target.primary.Tablet)
+// recordPrimaryPositions records the current positions of the primaries of the participants.
+func (df *vdiff) recordPrimaryPositions(ctx context.Context, participants map[string]*shardStreamer) error {
+	return df.forAll(participants, func(shard string, participant *shardStreamer) error {
+		pos, err := df.ts.wr.tmc.MasterPosition(ctx, participant.primary.Tablet)
 		if err != nil {
 			return err
 		}
@@ -781,10 +970,9 @@ func (df *vdiff) syncTargets(ctx context.Context, filteredReplicationWaitTime ti
 		if err != nil {
 			return err
 		}
-		target.position = mpos
+		participant.position = mpos
 		return nil
 	})
-	return err
 }
 
 // restartTargets restarts the stopped target vreplication streams.
@@ -1193,6 +1381,10 @@ func isFuncKeyrange(expr sqlparser.Expr) bool {
 	return ok && funcExpr.Name.EqualString("in_keyrange")
 }
 
+// wrapWeightString returns the WEIGHT_STRING() of the expression, which the source and the
+// target are compared with. The text values are not compared in Go with their collation,
+// because the default collation of MySQL 8.0 (utf8mb4_0900_ai_ci) is only approximated
+// by the collations package.
 func wrapWeightString(expr sqlparser.SelectExpr) *sqlparser.AliasedExpr {
 	return &sqlparser.AliasedExpr{
 		Expr: &sqlparser.FuncExpr{
@@ -24,6 +24,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	"context"
 
@@ -33,6 +34,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 )
 
 func TestVDiffPlanSuccess(t *testing.T) {
@@ -1376,3 +1378,138 @@ func TestVDiffNullWeightString(t *testing.T) {
 		})
 	}
 }
+
+func TestVDiffKeyspaceID(t *testing.T) {
+	testcases := []struct {
+		name     string
+		skipSync bool
+	}{{
+		name: "synced",
+	}, {
+		name:     "stopped after copy",
+		skipSync: true,
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "select c1 as c1, keyspace_id() as keyspace_id from t group by c1, keyspace_id", nil)
+			defer env.close()
+
+			err := env.topoServ.SaveVSchema(context.Background(), "source", &vschemapb.Keyspace{
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {Type: "hash"},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Column: "id"}},
+					},
+				},
+			})
+			require.NoError(t, err)
+			env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+				TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+					Name:              "t1",
+					Columns:           []string{"c1", "keyspace_id"},
+					PrimaryKeyColumns: []string{"c1"},
+					Fields:            sqltypes.MakeTestFields("c1|keyspace_id", "int64|varbinary"),
+				}},
+			}
+
+			sourceFields := sqltypes.MakeTestFields("c1|keyspace_id", "int64|int64")
+			env.tablets[101].setResults(
+				"select c1 as c1, id as keyspace_id from t group by c1, id order by c1 asc",
+				vdiffSourceGtid,
+				sqltypes.MakeTestStreamingResults(sourceFields,
+					"1|1",
+					"2|2",
+					"3|1",
+				),
+			)
+			targetFields := sqltypes.MakeTestFields("c1|keyspace_id", "int64|varbinary")
+			env.tablets[201].setResults(
+				"select c1, keyspace_id from t1 order by c1 asc",
+				vdiffTargetPrimaryPosition,
+				[]*sqltypes.Result{{
+					Fields: targetFields,
+				}, {
+					Rows: [][]sqltypes.Value{
+						{sqltypes.NewInt64(1), sqltypes.NewVarBinary("\x16k@\xb4J\xbaK\xd6")},
+						{sqltypes.NewInt64(2), sqltypes.NewVarBinary("\x06\xe7\xea\"Βp\x8f")},
+						{sqltypes.NewInt64(3), sqltypes.NewVarBinary("\x06\xe7\xea\"Βp\x8f")},
+					},
+				}},
+			)
+
+			if tcase.skipSync {
+				// The streams must be left untouched.
+				delete(env.tmc.vrQueries[200], "update _vt.vreplication set state='Stopped', message='for vdiff' where db_name='vt_target' and workflow='vdiffTest'")
+				delete(env.tmc.vrQueries[200], "update _vt.vreplication set state='Running', message='', stop_pos='' where db_name='vt_target' and workflow='vdiffTest'")
+				env.tmc.pos[100] = vdiffStopPosition
+			}
+
+			df, err := env.wr.newVDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", "")
+			require.NoError(t, err)
+			df.skipSync = tcase.skipSync
+			diffReports, err := df.diffTables(context.Background(), env.wr, 30*time.Second, 100, false, false)
+			require.NoError(t, err)
+			dr := diffReports["t1"]
+			require.NotNil(t, dr)
+			assert.Equal(t, 3, dr.ProcessedRows)
+			assert.Equal(t, 2, dr.MatchingRows)
+			assert.Equal(t, 1, dr.MismatchedRows)
+			require.Len(t, dr.MismatchedRowsSample, 1)
+			assert.Equal(t, sqltypes.NewVarBinary("\x16k@\xb4J\xbaK\xd6"), dr.MismatchedRowsSample[0].Source.Row["keyspace_id"])
+		})
+	}
+}
+
+func TestVDiffPlanKeyspaceIDFailure(t *testing.T) {
+	ksschema, err := vindexes.BuildKeyspaceSchema(&vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Column: "id"}},
+			},
+		},
+	}, "source")
+	require.NoError(t, err)
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "keyspace_id"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|keyspace_id", "int64|varbinary"),
+		}, {
+			Name:              "t2",
+			Columns:           []string{"c1", "keyspace_id"},
+			PrimaryKeyColumns: []string{"keyspace_id"},
+			Fields:            sqltypes.MakeTestFields("c1|keyspace_id", "int64|varbinary"),
+		}},
+	}
+
+	testcases := []struct {
+		input *binlogdatapb.Rule
+		err   string
+	}{{
+		input: &binlogdatapb.Rule{
+			Match:  "t1",
+			Filter: "select c1, keyspace_id() as keyspace_id from nope",
+		},
+		err: "table nope has no primary vindex to compute keyspace_id()",
+	}, {
+		input: &binlogdatapb.Rule{
+			Match:  "t2",
+			Filter: "select c1, keyspace_id() as keyspace_id from t",
+		},
+		err: "keyspace_id() cannot be part of the primary key of table t2",
+	}}
+	for _, tcase := range testcases {
+		filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{tcase.input}}
+		df := &vdiff{ts: &trafficSwitcher{sourceKSSchema: ksschema}}
+		err := df.buildVDiffPlan(context.Background(), filter, schm, nil)
+		assert.EqualError(t, err, tcase.err, tcase.input)
+	}
+}
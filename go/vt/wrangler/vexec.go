@@ -394,6 +394,8 @@ type ReplicationStatus struct {
 	TransactionTimestamp int64
 	// TimeUpdated represents the time_updated column from the _vt.vreplication table.
 	TimeUpdated int64
+	// RowsCopied represents the rows_copied column from the _vt.vreplication table.
+	RowsCopied int64
 	// Message represents the message column from the _vt.vreplication table.
 	Message string
 	// Tags contain the tags specified for this stream
@@ -405,7 +407,7 @@ type ReplicationStatus struct {
 
 func (wr *Wrangler) getReplicationStatusFromRow(ctx context.Context, row []sqltypes.Value, primary *topo.TabletInfo) (*ReplicationStatus, string, error) {
 	var err error
-	var id, timeUpdated, transactionTimestamp int64
+	var id, timeUpdated, transactionTimestamp, rowsCopied int64
 	var state, dbName, pos, stopPos, message, tags string
 	var bls binlogdatapb.BinlogSource
 	var mpos mysql.Position
@@ -440,6 +442,10 @@ func (wr *Wrangler) getReplicationStatusFromRow(ctx context.Context, row []sqlty
 	}
 	message = row[9].ToString()
 	tags = row[10].ToString()
+	rowsCopied, err = evalengine.ToInt64(row[11])
+	if err != nil {
+		return nil, "", err
+	}
 	status := &ReplicationStatus{
 		Shard:                primary.Shard,
 		Tablet:               primary.AliasString(),
@@ -451,6 +457,7 @@ func (wr *Wrangler) getReplicationStatusFromRow(ctx context.Context, row []sqlty
 		DBName:               dbName,
 		TransactionTimestamp: transactionTimestamp,
 		TimeUpdated:          timeUpdated,
+		RowsCopied:           rowsCopied,
 		Message:              message,
 		Tags:                 tags,
 	}
@@ -468,7 +475,7 @@ func (wr *Wrangler) getStreams(ctx context.Context, workflow, keyspace string) (
 	rsr.ShardStatuses = make(map[string]*ShardReplicationStatus)
 	rsr.Workflow = workflow
 	var results map[*topo.TabletInfo]*querypb.QueryResult
-	query := "select id, source, pos, stop_pos, max_replication_lag, state, db_name, time_updated, transaction_timestamp, message, tags from _vt.vreplication"
+	query := "select id, source, pos, stop_pos, max_replication_lag, state, db_name, time_updated, transaction_timestamp, message, tags, rows_copied from _vt.vreplication"
 	results, err := wr.runVexec(ctx, workflow, keyspace, query, false)
 	if err != nil {
 		return nil, err
@@ -235,6 +235,7 @@ func TestWorkflowListStreams(t *testing.T) {
 					"DBName": "vt_target",
 					"TransactionTimestamp": 0,
 					"TimeUpdated": 1234,
+					"RowsCopied": 10,
 					"Message": "",
 					"Tags": "",
 					"CopyState": [
@@ -271,6 +272,7 @@ func TestWorkflowListStreams(t *testing.T) {
 					"DBName": "vt_target",
 					"TransactionTimestamp": 0,
 					"TimeUpdated": 1234,
+					"RowsCopied": 10,
 					"Message": "",
 					"Tags": "",
 					"CopyState": [
@@ -25017,6 +25017,9 @@ export namespace vschema {
 
         /** Table column_list_authoritative */
         column_list_authoritative?: (boolean|null);
+
+        /** Table result_cache_ttl */
+        result_cache_ttl?: (string|null);
     }
 
     /** Represents a Table. */
@@ -25046,6 +25049,9 @@ export namespace vschema {
         /** Table column_list_authoritative. */
         public column_list_authoritative: boolean;
 
+        /** Table result_cache_ttl. */
+        public result_cache_ttl: string;
+
         /**
          * Creates a new Table instance using the specified properties.
          * @param [properties] Properties to set
@@ -25323,6 +25329,9 @@ export namespace vschema {
 
         /** Column type */
         type?: (query.Type|null);
+
+        /** Column collation_name */
+        collation_name?: (string|null);
     }
 
     /** Represents a Column. */
@@ -25340,6 +25349,9 @@ export namespace vschema {
         /** Column type. */
         public type: query.Type;
 
+        /** Column collation_name. */
+        public collation_name: string;
+
         /**
          * Creates a new Column instance using the specified properties.
          * @param [properties] Properties to set
@@ -27222,6 +27234,222 @@ export namespace vtctldata {
         public toJSON(): { [k: string]: any };
     }
 
+    /** Properties of an AddVindexRangeRequest. */
+    interface IAddVindexRangeRequest {
+
+        /** AddVindexRangeRequest keyspace */
+        keyspace?: (string|null);
+
+        /** AddVindexRangeRequest vindex */
+        vindex?: (string|null);
+
+        /** AddVindexRangeRequest start */
+        start?: (string|null);
+
+        /** AddVindexRangeRequest keyspace_id */
+        keyspace_id?: (string|null);
+
+        /** AddVindexRangeRequest skip_rebuild */
+        skip_rebuild?: (boolean|null);
+
+        /** AddVindexRangeRequest dry_run */
+        dry_run?: (boolean|null);
+
+        /** AddVindexRangeRequest cells */
+        cells?: (string[]|null);
+    }
+
+    /** Represents an AddVindexRangeRequest. */
+    class AddVindexRangeRequest implements IAddVindexRangeRequest {
+
+        /**
+         * Constructs a new AddVindexRangeRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IAddVindexRangeRequest);
+
+        /** AddVindexRangeRequest keyspace. */
+        public keyspace: string;
+
+        /** AddVindexRangeRequest vindex. */
+        public vindex: string;
+
+        /** AddVindexRangeRequest start. */
+        public start: string;
+
+        /** AddVindexRangeRequest keyspace_id. */
+        public keyspace_id: string;
+
+        /** AddVindexRangeRequest skip_rebuild. */
+        public skip_rebuild: boolean;
+
+        /** AddVindexRangeRequest dry_run. */
+        public dry_run: boolean;
+
+        /** AddVindexRangeRequest cells. */
+        public cells: string[];
+
+        /**
+         * Creates a new AddVindexRangeRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns AddVindexRangeRequest instance
+         */
+        public static create(properties?: vtctldata.IAddVindexRangeRequest): vtctldata.AddVindexRangeRequest;
+
+        /**
+         * Encodes the specified AddVindexRangeRequest message. Does not implicitly {@link vtctldata.AddVindexRangeRequest.verify|verify} messages.
+         * @param message AddVindexRangeRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IAddVindexRangeRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified AddVindexRangeRequest message, length delimited. Does not implicitly {@link vtctldata.AddVindexRangeRequest.verify|verify} messages.
+         * @param message AddVindexRangeRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IAddVindexRangeRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes an AddVindexRangeRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns AddVindexRangeRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.AddVindexRangeRequest;
+
+        /**
+         * Decodes an AddVindexRangeRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns AddVindexRangeRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.AddVindexRangeRequest;
+
+        /**
+         * Verifies an AddVindexRangeRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates an AddVindexRangeRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns AddVindexRangeRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.AddVindexRangeRequest;
+
+        /**
+         * Creates a plain object from an AddVindexRangeRequest message. Also converts values to other types if specified.
+         * @param message AddVindexRangeRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.AddVindexRangeRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this AddVindexRangeRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of an AddVindexRangeResponse. */
+    interface IAddVindexRangeResponse {
+
+        /** AddVindexRangeResponse vindex */
+        vindex?: (vschema.IVindex|null);
+    }
+
+    /** Represents an AddVindexRangeResponse. */
+    class AddVindexRangeResponse implements IAddVindexRangeResponse {
+
+        /**
+         * Constructs a new AddVindexRangeResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IAddVindexRangeResponse);
+
+        /** AddVindexRangeResponse vindex. */
+        public vindex?: (vschema.IVindex|null);
+
+        /**
+         * Creates a new AddVindexRangeResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns AddVindexRangeResponse instance
+         */
+        public static create(properties?: vtctldata.IAddVindexRangeResponse): vtctldata.AddVindexRangeResponse;
+
+        /**
+         * Encodes the specified AddVindexRangeResponse message. Does not implicitly {@link vtctldata.AddVindexRangeResponse.verify|verify} messages.
+         * @param message AddVindexRangeResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IAddVindexRangeResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified AddVindexRangeResponse message, length delimited. Does not implicitly {@link vtctldata.AddVindexRangeResponse.verify|verify} messages.
+         * @param message AddVindexRangeResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IAddVindexRangeResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes an AddVindexRangeResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns AddVindexRangeResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.AddVindexRangeResponse;
+
+        /**
+         * Decodes an AddVindexRangeResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns AddVindexRangeResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.AddVindexRangeResponse;
+
+        /**
+         * Verifies an AddVindexRangeResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates an AddVindexRangeResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns AddVindexRangeResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.AddVindexRangeResponse;
+
+        /**
+         * Creates a plain object from an AddVindexRangeResponse message. Also converts values to other types if specified.
+         * @param message AddVindexRangeResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.AddVindexRangeResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this AddVindexRangeResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
     /** Properties of an ApplyRoutingRulesRequest. */
     interface IApplyRoutingRulesRequest {
 
@@ -29334,3837 +29562,5899 @@ export namespace vtctldata {
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of an EmergencyReparentShardRequest. */
-    interface IEmergencyReparentShardRequest {
-
-        /** EmergencyReparentShardRequest keyspace */
-        keyspace?: (string|null);
-
-        /** EmergencyReparentShardRequest shard */
-        shard?: (string|null);
-
-        /** EmergencyReparentShardRequest new_primary */
-        new_primary?: (topodata.ITabletAlias|null);
-
-        /** EmergencyReparentShardRequest ignore_replicas */
-        ignore_replicas?: (topodata.ITabletAlias[]|null);
-
-        /** EmergencyReparentShardRequest wait_replicas_timeout */
-        wait_replicas_timeout?: (vttime.IDuration|null);
+    /** Properties of a DistributedTransactionClearFailuresRequest. */
+    interface IDistributedTransactionClearFailuresRequest {
     }
 
-    /** Represents an EmergencyReparentShardRequest. */
-    class EmergencyReparentShardRequest implements IEmergencyReparentShardRequest {
+    /** Represents a DistributedTransactionClearFailuresRequest. */
+    class DistributedTransactionClearFailuresRequest implements IDistributedTransactionClearFailuresRequest {
 
         /**
-         * Constructs a new EmergencyReparentShardRequest.
+         * Constructs a new DistributedTransactionClearFailuresRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IEmergencyReparentShardRequest);
-
-        /** EmergencyReparentShardRequest keyspace. */
-        public keyspace: string;
-
-        /** EmergencyReparentShardRequest shard. */
-        public shard: string;
-
-        /** EmergencyReparentShardRequest new_primary. */
-        public new_primary?: (topodata.ITabletAlias|null);
-
-        /** EmergencyReparentShardRequest ignore_replicas. */
-        public ignore_replicas: topodata.ITabletAlias[];
-
-        /** EmergencyReparentShardRequest wait_replicas_timeout. */
-        public wait_replicas_timeout?: (vttime.IDuration|null);
+        constructor(properties?: vtctldata.IDistributedTransactionClearFailuresRequest);
 
         /**
-         * Creates a new EmergencyReparentShardRequest instance using the specified properties.
+         * Creates a new DistributedTransactionClearFailuresRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns EmergencyReparentShardRequest instance
+         * @returns DistributedTransactionClearFailuresRequest instance
          */
-        public static create(properties?: vtctldata.IEmergencyReparentShardRequest): vtctldata.EmergencyReparentShardRequest;
+        public static create(properties?: vtctldata.IDistributedTransactionClearFailuresRequest): vtctldata.DistributedTransactionClearFailuresRequest;
 
         /**
-         * Encodes the specified EmergencyReparentShardRequest message. Does not implicitly {@link vtctldata.EmergencyReparentShardRequest.verify|verify} messages.
-         * @param message EmergencyReparentShardRequest message or plain object to encode
+         * Encodes the specified DistributedTransactionClearFailuresRequest message. Does not implicitly {@link vtctldata.DistributedTransactionClearFailuresRequest.verify|verify} messages.
+         * @param message DistributedTransactionClearFailuresRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IEmergencyReparentShardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IDistributedTransactionClearFailuresRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified EmergencyReparentShardRequest message, length delimited. Does not implicitly {@link vtctldata.EmergencyReparentShardRequest.verify|verify} messages.
-         * @param message EmergencyReparentShardRequest message or plain object to encode
+         * Encodes the specified DistributedTransactionClearFailuresRequest message, length delimited. Does not implicitly {@link vtctldata.DistributedTransactionClearFailuresRequest.verify|verify} messages.
+         * @param message DistributedTransactionClearFailuresRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IEmergencyReparentShardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IDistributedTransactionClearFailuresRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes an EmergencyReparentShardRequest message from the specified reader or buffer.
+         * Decodes a DistributedTransactionClearFailuresRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns EmergencyReparentShardRequest
+         * @returns DistributedTransactionClearFailuresRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.EmergencyReparentShardRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.DistributedTransactionClearFailuresRequest;
 
         /**
-         * Decodes an EmergencyReparentShardRequest message from the specified reader or buffer, length delimited.
+         * Decodes a DistributedTransactionClearFailuresRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns EmergencyReparentShardRequest
+         * @returns DistributedTransactionClearFailuresRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.EmergencyReparentShardRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.DistributedTransactionClearFailuresRequest;
 
         /**
-         * Verifies an EmergencyReparentShardRequest message.
+         * Verifies a DistributedTransactionClearFailuresRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates an EmergencyReparentShardRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a DistributedTransactionClearFailuresRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns EmergencyReparentShardRequest
+         * @returns DistributedTransactionClearFailuresRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.EmergencyReparentShardRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.DistributedTransactionClearFailuresRequest;
 
         /**
-         * Creates a plain object from an EmergencyReparentShardRequest message. Also converts values to other types if specified.
-         * @param message EmergencyReparentShardRequest
+         * Creates a plain object from a DistributedTransactionClearFailuresRequest message. Also converts values to other types if specified.
+         * @param message DistributedTransactionClearFailuresRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.EmergencyReparentShardRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.DistributedTransactionClearFailuresRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this EmergencyReparentShardRequest to JSON.
+         * Converts this DistributedTransactionClearFailuresRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
-
-    /** Properties of an EmergencyReparentShardResponse. */
-    interface IEmergencyReparentShardResponse {
-
-        /** EmergencyReparentShardResponse keyspace */
-        keyspace?: (string|null);
-
-        /** EmergencyReparentShardResponse shard */
-        shard?: (string|null);
-
-        /** EmergencyReparentShardResponse promoted_primary */
-        promoted_primary?: (topodata.ITabletAlias|null);
-
-        /** EmergencyReparentShardResponse events */
-        events?: (logutil.IEvent[]|null);
+    /** Properties of a DistributedTransactionClearFailuresResponse. */
+    interface IDistributedTransactionClearFailuresResponse {
     }
 
-    /** Represents an EmergencyReparentShardResponse. */
-    class EmergencyReparentShardResponse implements IEmergencyReparentShardResponse {
+    /** Represents a DistributedTransactionClearFailuresResponse. */
+    class DistributedTransactionClearFailuresResponse implements IDistributedTransactionClearFailuresResponse {
 
         /**
-         * Constructs a new EmergencyReparentShardResponse.
+         * Constructs a new DistributedTransactionClearFailuresResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IEmergencyReparentShardResponse);
-
-        /** EmergencyReparentShardResponse keyspace. */
-        public keyspace: string;
-
-        /** EmergencyReparentShardResponse shard. */
-        public shard: string;
-
-        /** EmergencyReparentShardResponse promoted_primary. */
-        public promoted_primary?: (topodata.ITabletAlias|null);
-
-        /** EmergencyReparentShardResponse events. */
-        public events: logutil.IEvent[];
+        constructor(properties?: vtctldata.IDistributedTransactionClearFailuresResponse);
 
         /**
-         * Creates a new EmergencyReparentShardResponse instance using the specified properties.
+         * Creates a new DistributedTransactionClearFailuresResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns EmergencyReparentShardResponse instance
+         * @returns DistributedTransactionClearFailuresResponse instance
          */
-        public static create(properties?: vtctldata.IEmergencyReparentShardResponse): vtctldata.EmergencyReparentShardResponse;
+        public static create(properties?: vtctldata.IDistributedTransactionClearFailuresResponse): vtctldata.DistributedTransactionClearFailuresResponse;
 
         /**
-         * Encodes the specified EmergencyReparentShardResponse message. Does not implicitly {@link vtctldata.EmergencyReparentShardResponse.verify|verify} messages.
-         * @param message EmergencyReparentShardResponse message or plain object to encode
+         * Encodes the specified DistributedTransactionClearFailuresResponse message. Does not implicitly {@link vtctldata.DistributedTransactionClearFailuresResponse.verify|verify} messages.
+         * @param message DistributedTransactionClearFailuresResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IEmergencyReparentShardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IDistributedTransactionClearFailuresResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified EmergencyReparentShardResponse message, length delimited. Does not implicitly {@link vtctldata.EmergencyReparentShardResponse.verify|verify} messages.
-         * @param message EmergencyReparentShardResponse message or plain object to encode
+         * Encodes the specified DistributedTransactionClearFailuresResponse message, length delimited. Does not implicitly {@link vtctldata.DistributedTransactionClearFailuresResponse.verify|verify} messages.
+         * @param message DistributedTransactionClearFailuresResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IEmergencyReparentShardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IDistributedTransactionClearFailuresResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes an EmergencyReparentShardResponse message from the specified reader or buffer.
+         * Decodes a DistributedTransactionClearFailuresResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns EmergencyReparentShardResponse
+         * @returns DistributedTransactionClearFailuresResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.EmergencyReparentShardResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.DistributedTransactionClearFailuresResponse;
 
         /**
-         * Decodes an EmergencyReparentShardResponse message from the specified reader or buffer, length delimited.
+         * Decodes a DistributedTransactionClearFailuresResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns EmergencyReparentShardResponse
+         * @returns DistributedTransactionClearFailuresResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.EmergencyReparentShardResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.DistributedTransactionClearFailuresResponse;
 
         /**
-         * Verifies an EmergencyReparentShardResponse message.
+         * Verifies a DistributedTransactionClearFailuresResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates an EmergencyReparentShardResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a DistributedTransactionClearFailuresResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns EmergencyReparentShardResponse
+         * @returns DistributedTransactionClearFailuresResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.EmergencyReparentShardResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.DistributedTransactionClearFailuresResponse;
 
         /**
-         * Creates a plain object from an EmergencyReparentShardResponse message. Also converts values to other types if specified.
-         * @param message EmergencyReparentShardResponse
+         * Creates a plain object from a DistributedTransactionClearFailuresResponse message. Also converts values to other types if specified.
+         * @param message DistributedTransactionClearFailuresResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.EmergencyReparentShardResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.DistributedTransactionClearFailuresResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this EmergencyReparentShardResponse to JSON.
+         * Converts this DistributedTransactionClearFailuresResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
+    /** Properties of a DistributedTransactionConcludeRequest. */
+    interface IDistributedTransactionConcludeRequest {
 
-    /** Properties of a FindAllShardsInKeyspaceRequest. */
-    interface IFindAllShardsInKeyspaceRequest {
+        /** DistributedTransactionConcludeRequest dtid */
+        dtid?: (string|null);
+    }
 
-        /** FindAllShardsInKeyspaceRequest keyspace */
-        keyspace?: (string|null);
-    }
-
-    /** Represents a FindAllShardsInKeyspaceRequest. */
-    class FindAllShardsInKeyspaceRequest implements IFindAllShardsInKeyspaceRequest {
+    /** Represents a DistributedTransactionConcludeRequest. */
+    class DistributedTransactionConcludeRequest implements IDistributedTransactionConcludeRequest {
 
         /**
-         * Constructs a new FindAllShardsInKeyspaceRequest.
+         * Constructs a new DistributedTransactionConcludeRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IFindAllShardsInKeyspaceRequest);
+        constructor(properties?: vtctldata.IDistributedTransactionConcludeRequest);
 
-        /** FindAllShardsInKeyspaceRequest keyspace. */
-        public keyspace: string;
+        /** DistributedTransactionConcludeRequest dtid. */
+        public dtid: string;
 
         /**
-         * Creates a new FindAllShardsInKeyspaceRequest instance using the specified properties.
+         * Creates a new DistributedTransactionConcludeRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns FindAllShardsInKeyspaceRequest instance
+         * @returns DistributedTransactionConcludeRequest instance
          */
-        public static create(properties?: vtctldata.IFindAllShardsInKeyspaceRequest): vtctldata.FindAllShardsInKeyspaceRequest;
+        public static create(properties?: vtctldata.IDistributedTransactionConcludeRequest): vtctldata.DistributedTransactionConcludeRequest;
 
         /**
-         * Encodes the specified FindAllShardsInKeyspaceRequest message. Does not implicitly {@link vtctldata.FindAllShardsInKeyspaceRequest.verify|verify} messages.
-         * @param message FindAllShardsInKeyspaceRequest message or plain object to encode
+         * Encodes the specified DistributedTransactionConcludeRequest message. Does not implicitly {@link vtctldata.DistributedTransactionConcludeRequest.verify|verify} messages.
+         * @param message DistributedTransactionConcludeRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IFindAllShardsInKeyspaceRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IDistributedTransactionConcludeRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified FindAllShardsInKeyspaceRequest message, length delimited. Does not implicitly {@link vtctldata.FindAllShardsInKeyspaceRequest.verify|verify} messages.
-         * @param message FindAllShardsInKeyspaceRequest message or plain object to encode
+         * Encodes the specified DistributedTransactionConcludeRequest message, length delimited. Does not implicitly {@link vtctldata.DistributedTransactionConcludeRequest.verify|verify} messages.
+         * @param message DistributedTransactionConcludeRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IFindAllShardsInKeyspaceRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IDistributedTransactionConcludeRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a FindAllShardsInKeyspaceRequest message from the specified reader or buffer.
+         * Decodes a DistributedTransactionConcludeRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns FindAllShardsInKeyspaceRequest
+         * @returns DistributedTransactionConcludeRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.FindAllShardsInKeyspaceRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.DistributedTransactionConcludeRequest;
 
         /**
-         * Decodes a FindAllShardsInKeyspaceRequest message from the specified reader or buffer, length delimited.
+         * Decodes a DistributedTransactionConcludeRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns FindAllShardsInKeyspaceRequest
+         * @returns DistributedTransactionConcludeRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.FindAllShardsInKeyspaceRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.DistributedTransactionConcludeRequest;
 
         /**
-         * Verifies a FindAllShardsInKeyspaceRequest message.
+         * Verifies a DistributedTransactionConcludeRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a FindAllShardsInKeyspaceRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a DistributedTransactionConcludeRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns FindAllShardsInKeyspaceRequest
+         * @returns DistributedTransactionConcludeRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.FindAllShardsInKeyspaceRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.DistributedTransactionConcludeRequest;
 
         /**
-         * Creates a plain object from a FindAllShardsInKeyspaceRequest message. Also converts values to other types if specified.
-         * @param message FindAllShardsInKeyspaceRequest
+         * Creates a plain object from a DistributedTransactionConcludeRequest message. Also converts values to other types if specified.
+         * @param message DistributedTransactionConcludeRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.FindAllShardsInKeyspaceRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.DistributedTransactionConcludeRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this FindAllShardsInKeyspaceRequest to JSON.
+         * Converts this DistributedTransactionConcludeRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
-
-    /** Properties of a FindAllShardsInKeyspaceResponse. */
-    interface IFindAllShardsInKeyspaceResponse {
-
-        /** FindAllShardsInKeyspaceResponse shards */
-        shards?: ({ [k: string]: vtctldata.IShard }|null);
+    /** Properties of a DistributedTransactionConcludeResponse. */
+    interface IDistributedTransactionConcludeResponse {
     }
 
-    /** Represents a FindAllShardsInKeyspaceResponse. */
-    class FindAllShardsInKeyspaceResponse implements IFindAllShardsInKeyspaceResponse {
+    /** Represents a DistributedTransactionConcludeResponse. */
+    class DistributedTransactionConcludeResponse implements IDistributedTransactionConcludeResponse {
 
         /**
-         * Constructs a new FindAllShardsInKeyspaceResponse.
+         * Constructs a new DistributedTransactionConcludeResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IFindAllShardsInKeyspaceResponse);
-
-        /** FindAllShardsInKeyspaceResponse shards. */
-        public shards: { [k: string]: vtctldata.IShard };
+        constructor(properties?: vtctldata.IDistributedTransactionConcludeResponse);
 
         /**
-         * Creates a new FindAllShardsInKeyspaceResponse instance using the specified properties.
+         * Creates a new DistributedTransactionConcludeResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns FindAllShardsInKeyspaceResponse instance
+         * @returns DistributedTransactionConcludeResponse instance
          */
-        public static create(properties?: vtctldata.IFindAllShardsInKeyspaceResponse): vtctldata.FindAllShardsInKeyspaceResponse;
+        public static create(properties?: vtctldata.IDistributedTransactionConcludeResponse): vtctldata.DistributedTransactionConcludeResponse;
 
         /**
-         * Encodes the specified FindAllShardsInKeyspaceResponse message. Does not implicitly {@link vtctldata.FindAllShardsInKeyspaceResponse.verify|verify} messages.
-         * @param message FindAllShardsInKeyspaceResponse message or plain object to encode
+         * Encodes the specified DistributedTransactionConcludeResponse message. Does not implicitly {@link vtctldata.DistributedTransactionConcludeResponse.verify|verify} messages.
+         * @param message DistributedTransactionConcludeResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IFindAllShardsInKeyspaceResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IDistributedTransactionConcludeResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified FindAllShardsInKeyspaceResponse message, length delimited. Does not implicitly {@link vtctldata.FindAllShardsInKeyspaceResponse.verify|verify} messages.
-         * @param message FindAllShardsInKeyspaceResponse message or plain object to encode
+         * Encodes the specified DistributedTransactionConcludeResponse message, length delimited. Does not implicitly {@link vtctldata.DistributedTransactionConcludeResponse.verify|verify} messages.
+         * @param message DistributedTransactionConcludeResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IFindAllShardsInKeyspaceResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IDistributedTransactionConcludeResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a FindAllShardsInKeyspaceResponse message from the specified reader or buffer.
+         * Decodes a DistributedTransactionConcludeResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns FindAllShardsInKeyspaceResponse
+         * @returns DistributedTransactionConcludeResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.FindAllShardsInKeyspaceResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.DistributedTransactionConcludeResponse;
 
         /**
-         * Decodes a FindAllShardsInKeyspaceResponse message from the specified reader or buffer, length delimited.
+         * Decodes a DistributedTransactionConcludeResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns FindAllShardsInKeyspaceResponse
+         * @returns DistributedTransactionConcludeResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.FindAllShardsInKeyspaceResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.DistributedTransactionConcludeResponse;
 
         /**
-         * Verifies a FindAllShardsInKeyspaceResponse message.
+         * Verifies a DistributedTransactionConcludeResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a FindAllShardsInKeyspaceResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a DistributedTransactionConcludeResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns FindAllShardsInKeyspaceResponse
+         * @returns DistributedTransactionConcludeResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.FindAllShardsInKeyspaceResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.DistributedTransactionConcludeResponse;
 
         /**
-         * Creates a plain object from a FindAllShardsInKeyspaceResponse message. Also converts values to other types if specified.
-         * @param message FindAllShardsInKeyspaceResponse
+         * Creates a plain object from a DistributedTransactionConcludeResponse message. Also converts values to other types if specified.
+         * @param message DistributedTransactionConcludeResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.FindAllShardsInKeyspaceResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.DistributedTransactionConcludeResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this FindAllShardsInKeyspaceResponse to JSON.
+         * Converts this DistributedTransactionConcludeResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
+    /** Properties of a DistributedTransactionListRequest. */
+    interface IDistributedTransactionListRequest {
 
-    /** Properties of a GetBackupsRequest. */
-    interface IGetBackupsRequest {
-
-        /** GetBackupsRequest keyspace */
+        /** DistributedTransactionListRequest keyspace */
         keyspace?: (string|null);
 
-        /** GetBackupsRequest shard */
-        shard?: (string|null);
-
-        /** GetBackupsRequest limit */
-        limit?: (number|null);
-
-        /** GetBackupsRequest detailed */
-        detailed?: (boolean|null);
-
-        /** GetBackupsRequest detailed_limit */
-        detailed_limit?: (number|null);
+        /** DistributedTransactionListRequest abandon_age */
+        abandon_age?: (vttime.IDuration|null);
     }
 
-    /** Represents a GetBackupsRequest. */
-    class GetBackupsRequest implements IGetBackupsRequest {
+    /** Represents a DistributedTransactionListRequest. */
+    class DistributedTransactionListRequest implements IDistributedTransactionListRequest {
 
         /**
-         * Constructs a new GetBackupsRequest.
+         * Constructs a new DistributedTransactionListRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetBackupsRequest);
+        constructor(properties?: vtctldata.IDistributedTransactionListRequest);
 
-        /** GetBackupsRequest keyspace. */
+        /** DistributedTransactionListRequest keyspace. */
         public keyspace: string;
 
-        /** GetBackupsRequest shard. */
-        public shard: string;
-
-        /** GetBackupsRequest limit. */
-        public limit: number;
-
-        /** GetBackupsRequest detailed. */
-        public detailed: boolean;
-
-        /** GetBackupsRequest detailed_limit. */
-        public detailed_limit: number;
+        /** DistributedTransactionListRequest abandon_age. */
+        public abandon_age?: (vttime.IDuration|null);
 
         /**
-         * Creates a new GetBackupsRequest instance using the specified properties.
+         * Creates a new DistributedTransactionListRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetBackupsRequest instance
+         * @returns DistributedTransactionListRequest instance
          */
-        public static create(properties?: vtctldata.IGetBackupsRequest): vtctldata.GetBackupsRequest;
+        public static create(properties?: vtctldata.IDistributedTransactionListRequest): vtctldata.DistributedTransactionListRequest;
 
         /**
-         * Encodes the specified GetBackupsRequest message. Does not implicitly {@link vtctldata.GetBackupsRequest.verify|verify} messages.
-         * @param message GetBackupsRequest message or plain object to encode
+         * Encodes the specified DistributedTransactionListRequest message. Does not implicitly {@link vtctldata.DistributedTransactionListRequest.verify|verify} messages.
+         * @param message DistributedTransactionListRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetBackupsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IDistributedTransactionListRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetBackupsRequest message, length delimited. Does not implicitly {@link vtctldata.GetBackupsRequest.verify|verify} messages.
-         * @param message GetBackupsRequest message or plain object to encode
+         * Encodes the specified DistributedTransactionListRequest message, length delimited. Does not implicitly {@link vtctldata.DistributedTransactionListRequest.verify|verify} messages.
+         * @param message DistributedTransactionListRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetBackupsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IDistributedTransactionListRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetBackupsRequest message from the specified reader or buffer.
+         * Decodes a DistributedTransactionListRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetBackupsRequest
+         * @returns DistributedTransactionListRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetBackupsRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.DistributedTransactionListRequest;
 
         /**
-         * Decodes a GetBackupsRequest message from the specified reader or buffer, length delimited.
+         * Decodes a DistributedTransactionListRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetBackupsRequest
+         * @returns DistributedTransactionListRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetBackupsRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.DistributedTransactionListRequest;
 
         /**
-         * Verifies a GetBackupsRequest message.
+         * Verifies a DistributedTransactionListRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetBackupsRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a DistributedTransactionListRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetBackupsRequest
+         * @returns DistributedTransactionListRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetBackupsRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.DistributedTransactionListRequest;
 
         /**
-         * Creates a plain object from a GetBackupsRequest message. Also converts values to other types if specified.
-         * @param message GetBackupsRequest
+         * Creates a plain object from a DistributedTransactionListRequest message. Also converts values to other types if specified.
+         * @param message DistributedTransactionListRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetBackupsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.DistributedTransactionListRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetBackupsRequest to JSON.
+         * Converts this DistributedTransactionListRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
+    /** Properties of a DistributedTransactionListResponse. */
+    interface IDistributedTransactionListResponse {
 
-    /** Properties of a GetBackupsResponse. */
-    interface IGetBackupsResponse {
-
-        /** GetBackupsResponse backups */
-        backups?: (mysqlctl.IBackupInfo[]|null);
+        /** DistributedTransactionListResponse transactions */
+        transactions?: (query.ITransactionMetadata[]|null);
     }
 
-    /** Represents a GetBackupsResponse. */
-    class GetBackupsResponse implements IGetBackupsResponse {
+    /** Represents a DistributedTransactionListResponse. */
+    class DistributedTransactionListResponse implements IDistributedTransactionListResponse {
 
         /**
-         * Constructs a new GetBackupsResponse.
+         * Constructs a new DistributedTransactionListResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetBackupsResponse);
+        constructor(properties?: vtctldata.IDistributedTransactionListResponse);
 
-        /** GetBackupsResponse backups. */
-        public backups: mysqlctl.IBackupInfo[];
+        /** DistributedTransactionListResponse transactions. */
+        public transactions: query.ITransactionMetadata[];
 
         /**
-         * Creates a new GetBackupsResponse instance using the specified properties.
+         * Creates a new DistributedTransactionListResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetBackupsResponse instance
+         * @returns DistributedTransactionListResponse instance
          */
-        public static create(properties?: vtctldata.IGetBackupsResponse): vtctldata.GetBackupsResponse;
+        public static create(properties?: vtctldata.IDistributedTransactionListResponse): vtctldata.DistributedTransactionListResponse;
 
         /**
-         * Encodes the specified GetBackupsResponse message. Does not implicitly {@link vtctldata.GetBackupsResponse.verify|verify} messages.
-         * @param message GetBackupsResponse message or plain object to encode
+         * Encodes the specified DistributedTransactionListResponse message. Does not implicitly {@link vtctldata.DistributedTransactionListResponse.verify|verify} messages.
+         * @param message DistributedTransactionListResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetBackupsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IDistributedTransactionListResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetBackupsResponse message, length delimited. Does not implicitly {@link vtctldata.GetBackupsResponse.verify|verify} messages.
-         * @param message GetBackupsResponse message or plain object to encode
+         * Encodes the specified DistributedTransactionListResponse message, length delimited. Does not implicitly {@link vtctldata.DistributedTransactionListResponse.verify|verify} messages.
+         * @param message DistributedTransactionListResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetBackupsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IDistributedTransactionListResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetBackupsResponse message from the specified reader or buffer.
+         * Decodes a DistributedTransactionListResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetBackupsResponse
+         * @returns DistributedTransactionListResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetBackupsResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.DistributedTransactionListResponse;
 
         /**
-         * Decodes a GetBackupsResponse message from the specified reader or buffer, length delimited.
+         * Decodes a DistributedTransactionListResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetBackupsResponse
+         * @returns DistributedTransactionListResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetBackupsResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.DistributedTransactionListResponse;
 
         /**
-         * Verifies a GetBackupsResponse message.
+         * Verifies a DistributedTransactionListResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetBackupsResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a DistributedTransactionListResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetBackupsResponse
+         * @returns DistributedTransactionListResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetBackupsResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.DistributedTransactionListResponse;
 
         /**
-         * Creates a plain object from a GetBackupsResponse message. Also converts values to other types if specified.
-         * @param message GetBackupsResponse
+         * Creates a plain object from a DistributedTransactionListResponse message. Also converts values to other types if specified.
+         * @param message DistributedTransactionListResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetBackupsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.DistributedTransactionListResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetBackupsResponse to JSON.
+         * Converts this DistributedTransactionListResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
+    /** Properties of a DistributedTransactionReadRequest. */
+    interface IDistributedTransactionReadRequest {
 
-    /** Properties of a GetCellInfoRequest. */
-    interface IGetCellInfoRequest {
-
-        /** GetCellInfoRequest cell */
-        cell?: (string|null);
+        /** DistributedTransactionReadRequest dtid */
+        dtid?: (string|null);
     }
 
-    /** Represents a GetCellInfoRequest. */
-    class GetCellInfoRequest implements IGetCellInfoRequest {
+    /** Represents a DistributedTransactionReadRequest. */
+    class DistributedTransactionReadRequest implements IDistributedTransactionReadRequest {
 
         /**
-         * Constructs a new GetCellInfoRequest.
+         * Constructs a new DistributedTransactionReadRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetCellInfoRequest);
+        constructor(properties?: vtctldata.IDistributedTransactionReadRequest);
 
-        /** GetCellInfoRequest cell. */
-        public cell: string;
+        /** DistributedTransactionReadRequest dtid. */
+        public dtid: string;
 
         /**
-         * Creates a new GetCellInfoRequest instance using the specified properties.
+         * Creates a new DistributedTransactionReadRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetCellInfoRequest instance
+         * @returns DistributedTransactionReadRequest instance
          */
-        public static create(properties?: vtctldata.IGetCellInfoRequest): vtctldata.GetCellInfoRequest;
+        public static create(properties?: vtctldata.IDistributedTransactionReadRequest): vtctldata.DistributedTransactionReadRequest;
 
         /**
-         * Encodes the specified GetCellInfoRequest message. Does not implicitly {@link vtctldata.GetCellInfoRequest.verify|verify} messages.
-         * @param message GetCellInfoRequest message or plain object to encode
+         * Encodes the specified DistributedTransactionReadRequest message. Does not implicitly {@link vtctldata.DistributedTransactionReadRequest.verify|verify} messages.
+         * @param message DistributedTransactionReadRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetCellInfoRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IDistributedTransactionReadRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetCellInfoRequest message, length delimited. Does not implicitly {@link vtctldata.GetCellInfoRequest.verify|verify} messages.
-         * @param message GetCellInfoRequest message or plain object to encode
+         * Encodes the specified DistributedTransactionReadRequest message, length delimited. Does not implicitly {@link vtctldata.DistributedTransactionReadRequest.verify|verify} messages.
+         * @param message DistributedTransactionReadRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetCellInfoRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IDistributedTransactionReadRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetCellInfoRequest message from the specified reader or buffer.
+         * Decodes a DistributedTransactionReadRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetCellInfoRequest
+         * @returns DistributedTransactionReadRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetCellInfoRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.DistributedTransactionReadRequest;
 
         /**
-         * Decodes a GetCellInfoRequest message from the specified reader or buffer, length delimited.
+         * Decodes a DistributedTransactionReadRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetCellInfoRequest
+         * @returns DistributedTransactionReadRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetCellInfoRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.DistributedTransactionReadRequest;
 
         /**
-         * Verifies a GetCellInfoRequest message.
+         * Verifies a DistributedTransactionReadRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetCellInfoRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a DistributedTransactionReadRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetCellInfoRequest
+         * @returns DistributedTransactionReadRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetCellInfoRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.DistributedTransactionReadRequest;
 
         /**
-         * Creates a plain object from a GetCellInfoRequest message. Also converts values to other types if specified.
-         * @param message GetCellInfoRequest
+         * Creates a plain object from a DistributedTransactionReadRequest message. Also converts values to other types if specified.
+         * @param message DistributedTransactionReadRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetCellInfoRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.DistributedTransactionReadRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetCellInfoRequest to JSON.
+         * Converts this DistributedTransactionReadRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
+    /** Properties of a DistributedTransactionReadResponse. */
+    interface IDistributedTransactionReadResponse {
 
-    /** Properties of a GetCellInfoResponse. */
-    interface IGetCellInfoResponse {
-
-        /** GetCellInfoResponse cell_info */
-        cell_info?: (topodata.ICellInfo|null);
+        /** DistributedTransactionReadResponse transaction */
+        transaction?: (query.ITransactionMetadata|null);
     }
 
-    /** Represents a GetCellInfoResponse. */
-    class GetCellInfoResponse implements IGetCellInfoResponse {
+    /** Represents a DistributedTransactionReadResponse. */
+    class DistributedTransactionReadResponse implements IDistributedTransactionReadResponse {
 
         /**
-         * Constructs a new GetCellInfoResponse.
+         * Constructs a new DistributedTransactionReadResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetCellInfoResponse);
+        constructor(properties?: vtctldata.IDistributedTransactionReadResponse);
 
-        /** GetCellInfoResponse cell_info. */
-        public cell_info?: (topodata.ICellInfo|null);
+        /** DistributedTransactionReadResponse transaction. */
+        public transaction?: (query.ITransactionMetadata|null);
 
         /**
-         * Creates a new GetCellInfoResponse instance using the specified properties.
+         * Creates a new DistributedTransactionReadResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetCellInfoResponse instance
+         * @returns DistributedTransactionReadResponse instance
          */
-        public static create(properties?: vtctldata.IGetCellInfoResponse): vtctldata.GetCellInfoResponse;
+        public static create(properties?: vtctldata.IDistributedTransactionReadResponse): vtctldata.DistributedTransactionReadResponse;
 
         /**
-         * Encodes the specified GetCellInfoResponse message. Does not implicitly {@link vtctldata.GetCellInfoResponse.verify|verify} messages.
-         * @param message GetCellInfoResponse message or plain object to encode
+         * Encodes the specified DistributedTransactionReadResponse message. Does not implicitly {@link vtctldata.DistributedTransactionReadResponse.verify|verify} messages.
+         * @param message DistributedTransactionReadResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetCellInfoResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IDistributedTransactionReadResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetCellInfoResponse message, length delimited. Does not implicitly {@link vtctldata.GetCellInfoResponse.verify|verify} messages.
-         * @param message GetCellInfoResponse message or plain object to encode
+         * Encodes the specified DistributedTransactionReadResponse message, length delimited. Does not implicitly {@link vtctldata.DistributedTransactionReadResponse.verify|verify} messages.
+         * @param message DistributedTransactionReadResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetCellInfoResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IDistributedTransactionReadResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetCellInfoResponse message from the specified reader or buffer.
+         * Decodes a DistributedTransactionReadResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetCellInfoResponse
+         * @returns DistributedTransactionReadResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetCellInfoResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.DistributedTransactionReadResponse;
 
         /**
-         * Decodes a GetCellInfoResponse message from the specified reader or buffer, length delimited.
+         * Decodes a DistributedTransactionReadResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetCellInfoResponse
+         * @returns DistributedTransactionReadResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetCellInfoResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.DistributedTransactionReadResponse;
 
         /**
-         * Verifies a GetCellInfoResponse message.
+         * Verifies a DistributedTransactionReadResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetCellInfoResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a DistributedTransactionReadResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetCellInfoResponse
+         * @returns DistributedTransactionReadResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetCellInfoResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.DistributedTransactionReadResponse;
 
         /**
-         * Creates a plain object from a GetCellInfoResponse message. Also converts values to other types if specified.
-         * @param message GetCellInfoResponse
+         * Creates a plain object from a DistributedTransactionReadResponse message. Also converts values to other types if specified.
+         * @param message DistributedTransactionReadResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetCellInfoResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.DistributedTransactionReadResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetCellInfoResponse to JSON.
+         * Converts this DistributedTransactionReadResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
+    /** Properties of a DistributedTransactionRollbackRequest. */
+    interface IDistributedTransactionRollbackRequest {
 
-    /** Properties of a GetCellInfoNamesRequest. */
-    interface IGetCellInfoNamesRequest {
+        /** DistributedTransactionRollbackRequest dtid */
+        dtid?: (string|null);
     }
 
-    /** Represents a GetCellInfoNamesRequest. */
-    class GetCellInfoNamesRequest implements IGetCellInfoNamesRequest {
+    /** Represents a DistributedTransactionRollbackRequest. */
+    class DistributedTransactionRollbackRequest implements IDistributedTransactionRollbackRequest {
 
         /**
-         * Constructs a new GetCellInfoNamesRequest.
+         * Constructs a new DistributedTransactionRollbackRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetCellInfoNamesRequest);
+        constructor(properties?: vtctldata.IDistributedTransactionRollbackRequest);
+
+        /** DistributedTransactionRollbackRequest dtid. */
+        public dtid: string;
 
         /**
-         * Creates a new GetCellInfoNamesRequest instance using the specified properties.
+         * Creates a new DistributedTransactionRollbackRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetCellInfoNamesRequest instance
+         * @returns DistributedTransactionRollbackRequest instance
          */
-        public static create(properties?: vtctldata.IGetCellInfoNamesRequest): vtctldata.GetCellInfoNamesRequest;
+        public static create(properties?: vtctldata.IDistributedTransactionRollbackRequest): vtctldata.DistributedTransactionRollbackRequest;
 
         /**
-         * Encodes the specified GetCellInfoNamesRequest message. Does not implicitly {@link vtctldata.GetCellInfoNamesRequest.verify|verify} messages.
-         * @param message GetCellInfoNamesRequest message or plain object to encode
+         * Encodes the specified DistributedTransactionRollbackRequest message. Does not implicitly {@link vtctldata.DistributedTransactionRollbackRequest.verify|verify} messages.
+         * @param message DistributedTransactionRollbackRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetCellInfoNamesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IDistributedTransactionRollbackRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetCellInfoNamesRequest message, length delimited. Does not implicitly {@link vtctldata.GetCellInfoNamesRequest.verify|verify} messages.
-         * @param message GetCellInfoNamesRequest message or plain object to encode
+         * Encodes the specified DistributedTransactionRollbackRequest message, length delimited. Does not implicitly {@link vtctldata.DistributedTransactionRollbackRequest.verify|verify} messages.
+         * @param message DistributedTransactionRollbackRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetCellInfoNamesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IDistributedTransactionRollbackRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetCellInfoNamesRequest message from the specified reader or buffer.
+         * Decodes a DistributedTransactionRollbackRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetCellInfoNamesRequest
+         * @returns DistributedTransactionRollbackRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetCellInfoNamesRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.DistributedTransactionRollbackRequest;
 
         /**
-         * Decodes a GetCellInfoNamesRequest message from the specified reader or buffer, length delimited.
+         * Decodes a DistributedTransactionRollbackRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetCellInfoNamesRequest
+         * @returns DistributedTransactionRollbackRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetCellInfoNamesRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.DistributedTransactionRollbackRequest;
 
         /**
-         * Verifies a GetCellInfoNamesRequest message.
+         * Verifies a DistributedTransactionRollbackRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetCellInfoNamesRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a DistributedTransactionRollbackRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetCellInfoNamesRequest
+         * @returns DistributedTransactionRollbackRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetCellInfoNamesRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.DistributedTransactionRollbackRequest;
 
         /**
-         * Creates a plain object from a GetCellInfoNamesRequest message. Also converts values to other types if specified.
-         * @param message GetCellInfoNamesRequest
+         * Creates a plain object from a DistributedTransactionRollbackRequest message. Also converts values to other types if specified.
+         * @param message DistributedTransactionRollbackRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetCellInfoNamesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.DistributedTransactionRollbackRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetCellInfoNamesRequest to JSON.
+         * Converts this DistributedTransactionRollbackRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
-
-    /** Properties of a GetCellInfoNamesResponse. */
-    interface IGetCellInfoNamesResponse {
-
-        /** GetCellInfoNamesResponse names */
-        names?: (string[]|null);
+    /** Properties of a DistributedTransactionRollbackResponse. */
+    interface IDistributedTransactionRollbackResponse {
     }
 
-    /** Represents a GetCellInfoNamesResponse. */
-    class GetCellInfoNamesResponse implements IGetCellInfoNamesResponse {
+    /** Represents a DistributedTransactionRollbackResponse. */
+    class DistributedTransactionRollbackResponse implements IDistributedTransactionRollbackResponse {
 
         /**
-         * Constructs a new GetCellInfoNamesResponse.
+         * Constructs a new DistributedTransactionRollbackResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetCellInfoNamesResponse);
-
-        /** GetCellInfoNamesResponse names. */
-        public names: string[];
+        constructor(properties?: vtctldata.IDistributedTransactionRollbackResponse);
 
         /**
-         * Creates a new GetCellInfoNamesResponse instance using the specified properties.
+         * Creates a new DistributedTransactionRollbackResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetCellInfoNamesResponse instance
+         * @returns DistributedTransactionRollbackResponse instance
          */
-        public static create(properties?: vtctldata.IGetCellInfoNamesResponse): vtctldata.GetCellInfoNamesResponse;
+        public static create(properties?: vtctldata.IDistributedTransactionRollbackResponse): vtctldata.DistributedTransactionRollbackResponse;
 
         /**
-         * Encodes the specified GetCellInfoNamesResponse message. Does not implicitly {@link vtctldata.GetCellInfoNamesResponse.verify|verify} messages.
-         * @param message GetCellInfoNamesResponse message or plain object to encode
+         * Encodes the specified DistributedTransactionRollbackResponse message. Does not implicitly {@link vtctldata.DistributedTransactionRollbackResponse.verify|verify} messages.
+         * @param message DistributedTransactionRollbackResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetCellInfoNamesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IDistributedTransactionRollbackResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetCellInfoNamesResponse message, length delimited. Does not implicitly {@link vtctldata.GetCellInfoNamesResponse.verify|verify} messages.
-         * @param message GetCellInfoNamesResponse message or plain object to encode
+         * Encodes the specified DistributedTransactionRollbackResponse message, length delimited. Does not implicitly {@link vtctldata.DistributedTransactionRollbackResponse.verify|verify} messages.
+         * @param message DistributedTransactionRollbackResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetCellInfoNamesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IDistributedTransactionRollbackResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetCellInfoNamesResponse message from the specified reader or buffer.
+         * Decodes a DistributedTransactionRollbackResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetCellInfoNamesResponse
+         * @returns DistributedTransactionRollbackResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetCellInfoNamesResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.DistributedTransactionRollbackResponse;
 
         /**
-         * Decodes a GetCellInfoNamesResponse message from the specified reader or buffer, length delimited.
+         * Decodes a DistributedTransactionRollbackResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetCellInfoNamesResponse
+         * @returns DistributedTransactionRollbackResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetCellInfoNamesResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.DistributedTransactionRollbackResponse;
 
         /**
-         * Verifies a GetCellInfoNamesResponse message.
+         * Verifies a DistributedTransactionRollbackResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetCellInfoNamesResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a DistributedTransactionRollbackResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetCellInfoNamesResponse
+         * @returns DistributedTransactionRollbackResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetCellInfoNamesResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.DistributedTransactionRollbackResponse;
 
         /**
-         * Creates a plain object from a GetCellInfoNamesResponse message. Also converts values to other types if specified.
-         * @param message GetCellInfoNamesResponse
+         * Creates a plain object from a DistributedTransactionRollbackResponse message. Also converts values to other types if specified.
+         * @param message DistributedTransactionRollbackResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetCellInfoNamesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.DistributedTransactionRollbackResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetCellInfoNamesResponse to JSON.
+         * Converts this DistributedTransactionRollbackResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetCellsAliasesRequest. */
-    interface IGetCellsAliasesRequest {
+    /** Properties of an EmergencyReparentShardRequest. */
+    interface IEmergencyReparentShardRequest {
+
+        /** EmergencyReparentShardRequest keyspace */
+        keyspace?: (string|null);
+
+        /** EmergencyReparentShardRequest shard */
+        shard?: (string|null);
+
+        /** EmergencyReparentShardRequest new_primary */
+        new_primary?: (topodata.ITabletAlias|null);
+
+        /** EmergencyReparentShardRequest ignore_replicas */
+        ignore_replicas?: (topodata.ITabletAlias[]|null);
+
+        /** EmergencyReparentShardRequest wait_replicas_timeout */
+        wait_replicas_timeout?: (vttime.IDuration|null);
     }
 
-    /** Represents a GetCellsAliasesRequest. */
-    class GetCellsAliasesRequest implements IGetCellsAliasesRequest {
+    /** Represents an EmergencyReparentShardRequest. */
+    class EmergencyReparentShardRequest implements IEmergencyReparentShardRequest {
 
         /**
-         * Constructs a new GetCellsAliasesRequest.
+         * Constructs a new EmergencyReparentShardRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetCellsAliasesRequest);
+        constructor(properties?: vtctldata.IEmergencyReparentShardRequest);
+
+        /** EmergencyReparentShardRequest keyspace. */
+        public keyspace: string;
+
+        /** EmergencyReparentShardRequest shard. */
+        public shard: string;
+
+        /** EmergencyReparentShardRequest new_primary. */
+        public new_primary?: (topodata.ITabletAlias|null);
+
+        /** EmergencyReparentShardRequest ignore_replicas. */
+        public ignore_replicas: topodata.ITabletAlias[];
+
+        /** EmergencyReparentShardRequest wait_replicas_timeout. */
+        public wait_replicas_timeout?: (vttime.IDuration|null);
 
         /**
-         * Creates a new GetCellsAliasesRequest instance using the specified properties.
+         * Creates a new EmergencyReparentShardRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetCellsAliasesRequest instance
+         * @returns EmergencyReparentShardRequest instance
          */
-        public static create(properties?: vtctldata.IGetCellsAliasesRequest): vtctldata.GetCellsAliasesRequest;
+        public static create(properties?: vtctldata.IEmergencyReparentShardRequest): vtctldata.EmergencyReparentShardRequest;
 
         /**
-         * Encodes the specified GetCellsAliasesRequest message. Does not implicitly {@link vtctldata.GetCellsAliasesRequest.verify|verify} messages.
-         * @param message GetCellsAliasesRequest message or plain object to encode
+         * Encodes the specified EmergencyReparentShardRequest message. Does not implicitly {@link vtctldata.EmergencyReparentShardRequest.verify|verify} messages.
+         * @param message EmergencyReparentShardRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetCellsAliasesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IEmergencyReparentShardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetCellsAliasesRequest message, length delimited. Does not implicitly {@link vtctldata.GetCellsAliasesRequest.verify|verify} messages.
-         * @param message GetCellsAliasesRequest message or plain object to encode
+         * Encodes the specified EmergencyReparentShardRequest message, length delimited. Does not implicitly {@link vtctldata.EmergencyReparentShardRequest.verify|verify} messages.
+         * @param message EmergencyReparentShardRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetCellsAliasesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IEmergencyReparentShardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetCellsAliasesRequest message from the specified reader or buffer.
+         * Decodes an EmergencyReparentShardRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetCellsAliasesRequest
+         * @returns EmergencyReparentShardRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetCellsAliasesRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.EmergencyReparentShardRequest;
 
         /**
-         * Decodes a GetCellsAliasesRequest message from the specified reader or buffer, length delimited.
+         * Decodes an EmergencyReparentShardRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetCellsAliasesRequest
+         * @returns EmergencyReparentShardRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetCellsAliasesRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.EmergencyReparentShardRequest;
 
         /**
-         * Verifies a GetCellsAliasesRequest message.
+         * Verifies an EmergencyReparentShardRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetCellsAliasesRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates an EmergencyReparentShardRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetCellsAliasesRequest
+         * @returns EmergencyReparentShardRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetCellsAliasesRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.EmergencyReparentShardRequest;
 
         /**
-         * Creates a plain object from a GetCellsAliasesRequest message. Also converts values to other types if specified.
-         * @param message GetCellsAliasesRequest
+         * Creates a plain object from an EmergencyReparentShardRequest message. Also converts values to other types if specified.
+         * @param message EmergencyReparentShardRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetCellsAliasesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.EmergencyReparentShardRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetCellsAliasesRequest to JSON.
+         * Converts this EmergencyReparentShardRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetCellsAliasesResponse. */
-    interface IGetCellsAliasesResponse {
+    /** Properties of an EmergencyReparentShardResponse. */
+    interface IEmergencyReparentShardResponse {
 
-        /** GetCellsAliasesResponse aliases */
-        aliases?: ({ [k: string]: topodata.ICellsAlias }|null);
+        /** EmergencyReparentShardResponse keyspace */
+        keyspace?: (string|null);
+
+        /** EmergencyReparentShardResponse shard */
+        shard?: (string|null);
+
+        /** EmergencyReparentShardResponse promoted_primary */
+        promoted_primary?: (topodata.ITabletAlias|null);
+
+        /** EmergencyReparentShardResponse events */
+        events?: (logutil.IEvent[]|null);
     }
 
-    /** Represents a GetCellsAliasesResponse. */
-    class GetCellsAliasesResponse implements IGetCellsAliasesResponse {
+    /** Represents an EmergencyReparentShardResponse. */
+    class EmergencyReparentShardResponse implements IEmergencyReparentShardResponse {
 
         /**
-         * Constructs a new GetCellsAliasesResponse.
+         * Constructs a new EmergencyReparentShardResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetCellsAliasesResponse);
+        constructor(properties?: vtctldata.IEmergencyReparentShardResponse);
 
-        /** GetCellsAliasesResponse aliases. */
-        public aliases: { [k: string]: topodata.ICellsAlias };
+        /** EmergencyReparentShardResponse keyspace. */
+        public keyspace: string;
+
+        /** EmergencyReparentShardResponse shard. */
+        public shard: string;
+
+        /** EmergencyReparentShardResponse promoted_primary. */
+        public promoted_primary?: (topodata.ITabletAlias|null);
+
+        /** EmergencyReparentShardResponse events. */
+        public events: logutil.IEvent[];
 
         /**
-         * Creates a new GetCellsAliasesResponse instance using the specified properties.
+         * Creates a new EmergencyReparentShardResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetCellsAliasesResponse instance
+         * @returns EmergencyReparentShardResponse instance
          */
-        public static create(properties?: vtctldata.IGetCellsAliasesResponse): vtctldata.GetCellsAliasesResponse;
+        public static create(properties?: vtctldata.IEmergencyReparentShardResponse): vtctldata.EmergencyReparentShardResponse;
 
         /**
-         * Encodes the specified GetCellsAliasesResponse message. Does not implicitly {@link vtctldata.GetCellsAliasesResponse.verify|verify} messages.
-         * @param message GetCellsAliasesResponse message or plain object to encode
+         * Encodes the specified EmergencyReparentShardResponse message. Does not implicitly {@link vtctldata.EmergencyReparentShardResponse.verify|verify} messages.
+         * @param message EmergencyReparentShardResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetCellsAliasesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IEmergencyReparentShardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetCellsAliasesResponse message, length delimited. Does not implicitly {@link vtctldata.GetCellsAliasesResponse.verify|verify} messages.
-         * @param message GetCellsAliasesResponse message or plain object to encode
+         * Encodes the specified EmergencyReparentShardResponse message, length delimited. Does not implicitly {@link vtctldata.EmergencyReparentShardResponse.verify|verify} messages.
+         * @param message EmergencyReparentShardResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetCellsAliasesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IEmergencyReparentShardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetCellsAliasesResponse message from the specified reader or buffer.
+         * Decodes an EmergencyReparentShardResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetCellsAliasesResponse
+         * @returns EmergencyReparentShardResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetCellsAliasesResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.EmergencyReparentShardResponse;
 
         /**
-         * Decodes a GetCellsAliasesResponse message from the specified reader or buffer, length delimited.
+         * Decodes an EmergencyReparentShardResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetCellsAliasesResponse
+         * @returns EmergencyReparentShardResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetCellsAliasesResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.EmergencyReparentShardResponse;
 
         /**
-         * Verifies a GetCellsAliasesResponse message.
+         * Verifies an EmergencyReparentShardResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetCellsAliasesResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates an EmergencyReparentShardResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetCellsAliasesResponse
+         * @returns EmergencyReparentShardResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetCellsAliasesResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.EmergencyReparentShardResponse;
 
         /**
-         * Creates a plain object from a GetCellsAliasesResponse message. Also converts values to other types if specified.
-         * @param message GetCellsAliasesResponse
+         * Creates a plain object from an EmergencyReparentShardResponse message. Also converts values to other types if specified.
+         * @param message EmergencyReparentShardResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetCellsAliasesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.EmergencyReparentShardResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetCellsAliasesResponse to JSON.
+         * Converts this EmergencyReparentShardResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetKeyspacesRequest. */
-    interface IGetKeyspacesRequest {
+    /** Properties of a FindAllShardsInKeyspaceRequest. */
+    interface IFindAllShardsInKeyspaceRequest {
+
+        /** FindAllShardsInKeyspaceRequest keyspace */
+        keyspace?: (string|null);
     }
 
-    /** Represents a GetKeyspacesRequest. */
-    class GetKeyspacesRequest implements IGetKeyspacesRequest {
+    /** Represents a FindAllShardsInKeyspaceRequest. */
+    class FindAllShardsInKeyspaceRequest implements IFindAllShardsInKeyspaceRequest {
 
         /**
-         * Constructs a new GetKeyspacesRequest.
+         * Constructs a new FindAllShardsInKeyspaceRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetKeyspacesRequest);
+        constructor(properties?: vtctldata.IFindAllShardsInKeyspaceRequest);
+
+        /** FindAllShardsInKeyspaceRequest keyspace. */
+        public keyspace: string;
 
         /**
-         * Creates a new GetKeyspacesRequest instance using the specified properties.
+         * Creates a new FindAllShardsInKeyspaceRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetKeyspacesRequest instance
+         * @returns FindAllShardsInKeyspaceRequest instance
          */
-        public static create(properties?: vtctldata.IGetKeyspacesRequest): vtctldata.GetKeyspacesRequest;
+        public static create(properties?: vtctldata.IFindAllShardsInKeyspaceRequest): vtctldata.FindAllShardsInKeyspaceRequest;
 
         /**
-         * Encodes the specified GetKeyspacesRequest message. Does not implicitly {@link vtctldata.GetKeyspacesRequest.verify|verify} messages.
-         * @param message GetKeyspacesRequest message or plain object to encode
+         * Encodes the specified FindAllShardsInKeyspaceRequest message. Does not implicitly {@link vtctldata.FindAllShardsInKeyspaceRequest.verify|verify} messages.
+         * @param message FindAllShardsInKeyspaceRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetKeyspacesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IFindAllShardsInKeyspaceRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetKeyspacesRequest message, length delimited. Does not implicitly {@link vtctldata.GetKeyspacesRequest.verify|verify} messages.
-         * @param message GetKeyspacesRequest message or plain object to encode
+         * Encodes the specified FindAllShardsInKeyspaceRequest message, length delimited. Does not implicitly {@link vtctldata.FindAllShardsInKeyspaceRequest.verify|verify} messages.
+         * @param message FindAllShardsInKeyspaceRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetKeyspacesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IFindAllShardsInKeyspaceRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetKeyspacesRequest message from the specified reader or buffer.
+         * Decodes a FindAllShardsInKeyspaceRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetKeyspacesRequest
+         * @returns FindAllShardsInKeyspaceRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetKeyspacesRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.FindAllShardsInKeyspaceRequest;
 
         /**
-         * Decodes a GetKeyspacesRequest message from the specified reader or buffer, length delimited.
+         * Decodes a FindAllShardsInKeyspaceRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetKeyspacesRequest
+         * @returns FindAllShardsInKeyspaceRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetKeyspacesRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.FindAllShardsInKeyspaceRequest;
 
         /**
-         * Verifies a GetKeyspacesRequest message.
+         * Verifies a FindAllShardsInKeyspaceRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetKeyspacesRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a FindAllShardsInKeyspaceRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetKeyspacesRequest
+         * @returns FindAllShardsInKeyspaceRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetKeyspacesRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.FindAllShardsInKeyspaceRequest;
 
         /**
-         * Creates a plain object from a GetKeyspacesRequest message. Also converts values to other types if specified.
-         * @param message GetKeyspacesRequest
+         * Creates a plain object from a FindAllShardsInKeyspaceRequest message. Also converts values to other types if specified.
+         * @param message FindAllShardsInKeyspaceRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetKeyspacesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.FindAllShardsInKeyspaceRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetKeyspacesRequest to JSON.
+         * Converts this FindAllShardsInKeyspaceRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetKeyspacesResponse. */
-    interface IGetKeyspacesResponse {
+    /** Properties of a FindAllShardsInKeyspaceResponse. */
+    interface IFindAllShardsInKeyspaceResponse {
 
-        /** GetKeyspacesResponse keyspaces */
-        keyspaces?: (vtctldata.IKeyspace[]|null);
+        /** FindAllShardsInKeyspaceResponse shards */
+        shards?: ({ [k: string]: vtctldata.IShard }|null);
     }
 
-    /** Represents a GetKeyspacesResponse. */
-    class GetKeyspacesResponse implements IGetKeyspacesResponse {
+    /** Represents a FindAllShardsInKeyspaceResponse. */
+    class FindAllShardsInKeyspaceResponse implements IFindAllShardsInKeyspaceResponse {
 
         /**
-         * Constructs a new GetKeyspacesResponse.
+         * Constructs a new FindAllShardsInKeyspaceResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetKeyspacesResponse);
+        constructor(properties?: vtctldata.IFindAllShardsInKeyspaceResponse);
 
-        /** GetKeyspacesResponse keyspaces. */
-        public keyspaces: vtctldata.IKeyspace[];
+        /** FindAllShardsInKeyspaceResponse shards. */
+        public shards: { [k: string]: vtctldata.IShard };
 
         /**
-         * Creates a new GetKeyspacesResponse instance using the specified properties.
+         * Creates a new FindAllShardsInKeyspaceResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetKeyspacesResponse instance
+         * @returns FindAllShardsInKeyspaceResponse instance
          */
-        public static create(properties?: vtctldata.IGetKeyspacesResponse): vtctldata.GetKeyspacesResponse;
+        public static create(properties?: vtctldata.IFindAllShardsInKeyspaceResponse): vtctldata.FindAllShardsInKeyspaceResponse;
 
         /**
-         * Encodes the specified GetKeyspacesResponse message. Does not implicitly {@link vtctldata.GetKeyspacesResponse.verify|verify} messages.
-         * @param message GetKeyspacesResponse message or plain object to encode
+         * Encodes the specified FindAllShardsInKeyspaceResponse message. Does not implicitly {@link vtctldata.FindAllShardsInKeyspaceResponse.verify|verify} messages.
+         * @param message FindAllShardsInKeyspaceResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetKeyspacesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IFindAllShardsInKeyspaceResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetKeyspacesResponse message, length delimited. Does not implicitly {@link vtctldata.GetKeyspacesResponse.verify|verify} messages.
-         * @param message GetKeyspacesResponse message or plain object to encode
+         * Encodes the specified FindAllShardsInKeyspaceResponse message, length delimited. Does not implicitly {@link vtctldata.FindAllShardsInKeyspaceResponse.verify|verify} messages.
+         * @param message FindAllShardsInKeyspaceResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetKeyspacesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IFindAllShardsInKeyspaceResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetKeyspacesResponse message from the specified reader or buffer.
+         * Decodes a FindAllShardsInKeyspaceResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetKeyspacesResponse
+         * @returns FindAllShardsInKeyspaceResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetKeyspacesResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.FindAllShardsInKeyspaceResponse;
 
         /**
-         * Decodes a GetKeyspacesResponse message from the specified reader or buffer, length delimited.
+         * Decodes a FindAllShardsInKeyspaceResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetKeyspacesResponse
+         * @returns FindAllShardsInKeyspaceResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetKeyspacesResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.FindAllShardsInKeyspaceResponse;
 
         /**
-         * Verifies a GetKeyspacesResponse message.
+         * Verifies a FindAllShardsInKeyspaceResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetKeyspacesResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a FindAllShardsInKeyspaceResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetKeyspacesResponse
+         * @returns FindAllShardsInKeyspaceResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetKeyspacesResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.FindAllShardsInKeyspaceResponse;
 
         /**
-         * Creates a plain object from a GetKeyspacesResponse message. Also converts values to other types if specified.
-         * @param message GetKeyspacesResponse
+         * Creates a plain object from a FindAllShardsInKeyspaceResponse message. Also converts values to other types if specified.
+         * @param message FindAllShardsInKeyspaceResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetKeyspacesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.FindAllShardsInKeyspaceResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetKeyspacesResponse to JSON.
+         * Converts this FindAllShardsInKeyspaceResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetKeyspaceRequest. */
-    interface IGetKeyspaceRequest {
+    /** Properties of a GetBackupsRequest. */
+    interface IGetBackupsRequest {
 
-        /** GetKeyspaceRequest keyspace */
+        /** GetBackupsRequest keyspace */
         keyspace?: (string|null);
+
+        /** GetBackupsRequest shard */
+        shard?: (string|null);
+
+        /** GetBackupsRequest limit */
+        limit?: (number|null);
+
+        /** GetBackupsRequest detailed */
+        detailed?: (boolean|null);
+
+        /** GetBackupsRequest detailed_limit */
+        detailed_limit?: (number|null);
     }
 
-    /** Represents a GetKeyspaceRequest. */
-    class GetKeyspaceRequest implements IGetKeyspaceRequest {
+    /** Represents a GetBackupsRequest. */
+    class GetBackupsRequest implements IGetBackupsRequest {
 
         /**
-         * Constructs a new GetKeyspaceRequest.
+         * Constructs a new GetBackupsRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetKeyspaceRequest);
+        constructor(properties?: vtctldata.IGetBackupsRequest);
 
-        /** GetKeyspaceRequest keyspace. */
+        /** GetBackupsRequest keyspace. */
         public keyspace: string;
 
+        /** GetBackupsRequest shard. */
+        public shard: string;
+
+        /** GetBackupsRequest limit. */
+        public limit: number;
+
+        /** GetBackupsRequest detailed. */
+        public detailed: boolean;
+
+        /** GetBackupsRequest detailed_limit. */
+        public detailed_limit: number;
+
         /**
-         * Creates a new GetKeyspaceRequest instance using the specified properties.
+         * Creates a new GetBackupsRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetKeyspaceRequest instance
+         * @returns GetBackupsRequest instance
          */
-        public static create(properties?: vtctldata.IGetKeyspaceRequest): vtctldata.GetKeyspaceRequest;
+        public static create(properties?: vtctldata.IGetBackupsRequest): vtctldata.GetBackupsRequest;
 
         /**
-         * Encodes the specified GetKeyspaceRequest message. Does not implicitly {@link vtctldata.GetKeyspaceRequest.verify|verify} messages.
-         * @param message GetKeyspaceRequest message or plain object to encode
+         * Encodes the specified GetBackupsRequest message. Does not implicitly {@link vtctldata.GetBackupsRequest.verify|verify} messages.
+         * @param message GetBackupsRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetKeyspaceRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetBackupsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetKeyspaceRequest message, length delimited. Does not implicitly {@link vtctldata.GetKeyspaceRequest.verify|verify} messages.
-         * @param message GetKeyspaceRequest message or plain object to encode
+         * Encodes the specified GetBackupsRequest message, length delimited. Does not implicitly {@link vtctldata.GetBackupsRequest.verify|verify} messages.
+         * @param message GetBackupsRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetKeyspaceRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetBackupsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetKeyspaceRequest message from the specified reader or buffer.
+         * Decodes a GetBackupsRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetKeyspaceRequest
+         * @returns GetBackupsRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetKeyspaceRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetBackupsRequest;
 
         /**
-         * Decodes a GetKeyspaceRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetBackupsRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetKeyspaceRequest
+         * @returns GetBackupsRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetKeyspaceRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetBackupsRequest;
 
         /**
-         * Verifies a GetKeyspaceRequest message.
+         * Verifies a GetBackupsRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetKeyspaceRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetBackupsRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetKeyspaceRequest
+         * @returns GetBackupsRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetKeyspaceRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetBackupsRequest;
 
         /**
-         * Creates a plain object from a GetKeyspaceRequest message. Also converts values to other types if specified.
-         * @param message GetKeyspaceRequest
+         * Creates a plain object from a GetBackupsRequest message. Also converts values to other types if specified.
+         * @param message GetBackupsRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetKeyspaceRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetBackupsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetKeyspaceRequest to JSON.
+         * Converts this GetBackupsRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetKeyspaceResponse. */
-    interface IGetKeyspaceResponse {
+    /** Properties of a GetBackupsResponse. */
+    interface IGetBackupsResponse {
 
-        /** GetKeyspaceResponse keyspace */
-        keyspace?: (vtctldata.IKeyspace|null);
+        /** GetBackupsResponse backups */
+        backups?: (mysqlctl.IBackupInfo[]|null);
     }
 
-    /** Represents a GetKeyspaceResponse. */
-    class GetKeyspaceResponse implements IGetKeyspaceResponse {
+    /** Represents a GetBackupsResponse. */
+    class GetBackupsResponse implements IGetBackupsResponse {
 
         /**
-         * Constructs a new GetKeyspaceResponse.
+         * Constructs a new GetBackupsResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetKeyspaceResponse);
+        constructor(properties?: vtctldata.IGetBackupsResponse);
 
-        /** GetKeyspaceResponse keyspace. */
-        public keyspace?: (vtctldata.IKeyspace|null);
+        /** GetBackupsResponse backups. */
+        public backups: mysqlctl.IBackupInfo[];
 
         /**
-         * Creates a new GetKeyspaceResponse instance using the specified properties.
+         * Creates a new GetBackupsResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetKeyspaceResponse instance
+         * @returns GetBackupsResponse instance
          */
-        public static create(properties?: vtctldata.IGetKeyspaceResponse): vtctldata.GetKeyspaceResponse;
+        public static create(properties?: vtctldata.IGetBackupsResponse): vtctldata.GetBackupsResponse;
 
         /**
-         * Encodes the specified GetKeyspaceResponse message. Does not implicitly {@link vtctldata.GetKeyspaceResponse.verify|verify} messages.
-         * @param message GetKeyspaceResponse message or plain object to encode
+         * Encodes the specified GetBackupsResponse message. Does not implicitly {@link vtctldata.GetBackupsResponse.verify|verify} messages.
+         * @param message GetBackupsResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetKeyspaceResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetBackupsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetKeyspaceResponse message, length delimited. Does not implicitly {@link vtctldata.GetKeyspaceResponse.verify|verify} messages.
-         * @param message GetKeyspaceResponse message or plain object to encode
+         * Encodes the specified GetBackupsResponse message, length delimited. Does not implicitly {@link vtctldata.GetBackupsResponse.verify|verify} messages.
+         * @param message GetBackupsResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetKeyspaceResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetBackupsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetKeyspaceResponse message from the specified reader or buffer.
+         * Decodes a GetBackupsResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetKeyspaceResponse
+         * @returns GetBackupsResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetKeyspaceResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetBackupsResponse;
 
         /**
-         * Decodes a GetKeyspaceResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetBackupsResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetKeyspaceResponse
+         * @returns GetBackupsResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetKeyspaceResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetBackupsResponse;
 
         /**
-         * Verifies a GetKeyspaceResponse message.
+         * Verifies a GetBackupsResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetKeyspaceResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetBackupsResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetKeyspaceResponse
+         * @returns GetBackupsResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetKeyspaceResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetBackupsResponse;
 
         /**
-         * Creates a plain object from a GetKeyspaceResponse message. Also converts values to other types if specified.
-         * @param message GetKeyspaceResponse
+         * Creates a plain object from a GetBackupsResponse message. Also converts values to other types if specified.
+         * @param message GetBackupsResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetKeyspaceResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetBackupsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetKeyspaceResponse to JSON.
+         * Converts this GetBackupsResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetRoutingRulesRequest. */
-    interface IGetRoutingRulesRequest {
+    /** Properties of a GetCellInfoRequest. */
+    interface IGetCellInfoRequest {
+
+        /** GetCellInfoRequest cell */
+        cell?: (string|null);
     }
 
-    /** Represents a GetRoutingRulesRequest. */
-    class GetRoutingRulesRequest implements IGetRoutingRulesRequest {
+    /** Represents a GetCellInfoRequest. */
+    class GetCellInfoRequest implements IGetCellInfoRequest {
 
         /**
-         * Constructs a new GetRoutingRulesRequest.
+         * Constructs a new GetCellInfoRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetRoutingRulesRequest);
+        constructor(properties?: vtctldata.IGetCellInfoRequest);
+
+        /** GetCellInfoRequest cell. */
+        public cell: string;
 
         /**
-         * Creates a new GetRoutingRulesRequest instance using the specified properties.
+         * Creates a new GetCellInfoRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetRoutingRulesRequest instance
+         * @returns GetCellInfoRequest instance
          */
-        public static create(properties?: vtctldata.IGetRoutingRulesRequest): vtctldata.GetRoutingRulesRequest;
+        public static create(properties?: vtctldata.IGetCellInfoRequest): vtctldata.GetCellInfoRequest;
 
         /**
-         * Encodes the specified GetRoutingRulesRequest message. Does not implicitly {@link vtctldata.GetRoutingRulesRequest.verify|verify} messages.
-         * @param message GetRoutingRulesRequest message or plain object to encode
+         * Encodes the specified GetCellInfoRequest message. Does not implicitly {@link vtctldata.GetCellInfoRequest.verify|verify} messages.
+         * @param message GetCellInfoRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetRoutingRulesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetCellInfoRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetRoutingRulesRequest message, length delimited. Does not implicitly {@link vtctldata.GetRoutingRulesRequest.verify|verify} messages.
-         * @param message GetRoutingRulesRequest message or plain object to encode
+         * Encodes the specified GetCellInfoRequest message, length delimited. Does not implicitly {@link vtctldata.GetCellInfoRequest.verify|verify} messages.
+         * @param message GetCellInfoRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetRoutingRulesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetCellInfoRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetRoutingRulesRequest message from the specified reader or buffer.
+         * Decodes a GetCellInfoRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetRoutingRulesRequest
+         * @returns GetCellInfoRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetRoutingRulesRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetCellInfoRequest;
 
         /**
-         * Decodes a GetRoutingRulesRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetCellInfoRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetRoutingRulesRequest
+         * @returns GetCellInfoRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetRoutingRulesRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetCellInfoRequest;
 
         /**
-         * Verifies a GetRoutingRulesRequest message.
+         * Verifies a GetCellInfoRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetRoutingRulesRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetCellInfoRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetRoutingRulesRequest
+         * @returns GetCellInfoRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetRoutingRulesRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetCellInfoRequest;
 
         /**
-         * Creates a plain object from a GetRoutingRulesRequest message. Also converts values to other types if specified.
-         * @param message GetRoutingRulesRequest
+         * Creates a plain object from a GetCellInfoRequest message. Also converts values to other types if specified.
+         * @param message GetCellInfoRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetRoutingRulesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetCellInfoRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetRoutingRulesRequest to JSON.
+         * Converts this GetCellInfoRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetRoutingRulesResponse. */
-    interface IGetRoutingRulesResponse {
+    /** Properties of a GetCellInfoResponse. */
+    interface IGetCellInfoResponse {
 
-        /** GetRoutingRulesResponse routing_rules */
-        routing_rules?: (vschema.IRoutingRules|null);
+        /** GetCellInfoResponse cell_info */
+        cell_info?: (topodata.ICellInfo|null);
     }
 
-    /** Represents a GetRoutingRulesResponse. */
-    class GetRoutingRulesResponse implements IGetRoutingRulesResponse {
+    /** Represents a GetCellInfoResponse. */
+    class GetCellInfoResponse implements IGetCellInfoResponse {
 
         /**
-         * Constructs a new GetRoutingRulesResponse.
+         * Constructs a new GetCellInfoResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetRoutingRulesResponse);
+        constructor(properties?: vtctldata.IGetCellInfoResponse);
 
-        /** GetRoutingRulesResponse routing_rules. */
-        public routing_rules?: (vschema.IRoutingRules|null);
+        /** GetCellInfoResponse cell_info. */
+        public cell_info?: (topodata.ICellInfo|null);
 
         /**
-         * Creates a new GetRoutingRulesResponse instance using the specified properties.
+         * Creates a new GetCellInfoResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetRoutingRulesResponse instance
+         * @returns GetCellInfoResponse instance
          */
-        public static create(properties?: vtctldata.IGetRoutingRulesResponse): vtctldata.GetRoutingRulesResponse;
+        public static create(properties?: vtctldata.IGetCellInfoResponse): vtctldata.GetCellInfoResponse;
 
         /**
-         * Encodes the specified GetRoutingRulesResponse message. Does not implicitly {@link vtctldata.GetRoutingRulesResponse.verify|verify} messages.
-         * @param message GetRoutingRulesResponse message or plain object to encode
+         * Encodes the specified GetCellInfoResponse message. Does not implicitly {@link vtctldata.GetCellInfoResponse.verify|verify} messages.
+         * @param message GetCellInfoResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetRoutingRulesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetCellInfoResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetRoutingRulesResponse message, length delimited. Does not implicitly {@link vtctldata.GetRoutingRulesResponse.verify|verify} messages.
-         * @param message GetRoutingRulesResponse message or plain object to encode
+         * Encodes the specified GetCellInfoResponse message, length delimited. Does not implicitly {@link vtctldata.GetCellInfoResponse.verify|verify} messages.
+         * @param message GetCellInfoResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetRoutingRulesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetCellInfoResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetRoutingRulesResponse message from the specified reader or buffer.
+         * Decodes a GetCellInfoResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetRoutingRulesResponse
+         * @returns GetCellInfoResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetRoutingRulesResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetCellInfoResponse;
 
         /**
-         * Decodes a GetRoutingRulesResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetCellInfoResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetRoutingRulesResponse
+         * @returns GetCellInfoResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetRoutingRulesResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetCellInfoResponse;
 
         /**
-         * Verifies a GetRoutingRulesResponse message.
+         * Verifies a GetCellInfoResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetRoutingRulesResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetCellInfoResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetRoutingRulesResponse
+         * @returns GetCellInfoResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetRoutingRulesResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetCellInfoResponse;
 
         /**
-         * Creates a plain object from a GetRoutingRulesResponse message. Also converts values to other types if specified.
-         * @param message GetRoutingRulesResponse
+         * Creates a plain object from a GetCellInfoResponse message. Also converts values to other types if specified.
+         * @param message GetCellInfoResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetRoutingRulesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetCellInfoResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetRoutingRulesResponse to JSON.
+         * Converts this GetCellInfoResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetSchemaRequest. */
-    interface IGetSchemaRequest {
-
-        /** GetSchemaRequest tablet_alias */
-        tablet_alias?: (topodata.ITabletAlias|null);
-
-        /** GetSchemaRequest tables */
-        tables?: (string[]|null);
-
-        /** GetSchemaRequest exclude_tables */
-        exclude_tables?: (string[]|null);
-
-        /** GetSchemaRequest include_views */
-        include_views?: (boolean|null);
-
-        /** GetSchemaRequest table_names_only */
-        table_names_only?: (boolean|null);
-
-        /** GetSchemaRequest table_sizes_only */
-        table_sizes_only?: (boolean|null);
+    /** Properties of a GetCellInfoNamesRequest. */
+    interface IGetCellInfoNamesRequest {
     }
 
-    /** Represents a GetSchemaRequest. */
-    class GetSchemaRequest implements IGetSchemaRequest {
+    /** Represents a GetCellInfoNamesRequest. */
+    class GetCellInfoNamesRequest implements IGetCellInfoNamesRequest {
 
         /**
-         * Constructs a new GetSchemaRequest.
+         * Constructs a new GetCellInfoNamesRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetSchemaRequest);
-
-        /** GetSchemaRequest tablet_alias. */
-        public tablet_alias?: (topodata.ITabletAlias|null);
-
-        /** GetSchemaRequest tables. */
-        public tables: string[];
-
-        /** GetSchemaRequest exclude_tables. */
-        public exclude_tables: string[];
-
-        /** GetSchemaRequest include_views. */
-        public include_views: boolean;
-
-        /** GetSchemaRequest table_names_only. */
-        public table_names_only: boolean;
-
-        /** GetSchemaRequest table_sizes_only. */
-        public table_sizes_only: boolean;
+        constructor(properties?: vtctldata.IGetCellInfoNamesRequest);
 
         /**
-         * Creates a new GetSchemaRequest instance using the specified properties.
+         * Creates a new GetCellInfoNamesRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetSchemaRequest instance
+         * @returns GetCellInfoNamesRequest instance
          */
-        public static create(properties?: vtctldata.IGetSchemaRequest): vtctldata.GetSchemaRequest;
+        public static create(properties?: vtctldata.IGetCellInfoNamesRequest): vtctldata.GetCellInfoNamesRequest;
 
         /**
-         * Encodes the specified GetSchemaRequest message. Does not implicitly {@link vtctldata.GetSchemaRequest.verify|verify} messages.
-         * @param message GetSchemaRequest message or plain object to encode
+         * Encodes the specified GetCellInfoNamesRequest message. Does not implicitly {@link vtctldata.GetCellInfoNamesRequest.verify|verify} messages.
+         * @param message GetCellInfoNamesRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetSchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetCellInfoNamesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetSchemaRequest message, length delimited. Does not implicitly {@link vtctldata.GetSchemaRequest.verify|verify} messages.
-         * @param message GetSchemaRequest message or plain object to encode
+         * Encodes the specified GetCellInfoNamesRequest message, length delimited. Does not implicitly {@link vtctldata.GetCellInfoNamesRequest.verify|verify} messages.
+         * @param message GetCellInfoNamesRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetSchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetCellInfoNamesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetSchemaRequest message from the specified reader or buffer.
+         * Decodes a GetCellInfoNamesRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetSchemaRequest
+         * @returns GetCellInfoNamesRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSchemaRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetCellInfoNamesRequest;
 
         /**
-         * Decodes a GetSchemaRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetCellInfoNamesRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetSchemaRequest
+         * @returns GetCellInfoNamesRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSchemaRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetCellInfoNamesRequest;
 
         /**
-         * Verifies a GetSchemaRequest message.
+         * Verifies a GetCellInfoNamesRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetSchemaRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetCellInfoNamesRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetSchemaRequest
+         * @returns GetCellInfoNamesRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetSchemaRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetCellInfoNamesRequest;
 
         /**
-         * Creates a plain object from a GetSchemaRequest message. Also converts values to other types if specified.
-         * @param message GetSchemaRequest
+         * Creates a plain object from a GetCellInfoNamesRequest message. Also converts values to other types if specified.
+         * @param message GetCellInfoNamesRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetSchemaRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetCellInfoNamesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetSchemaRequest to JSON.
+         * Converts this GetCellInfoNamesRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetSchemaResponse. */
-    interface IGetSchemaResponse {
+    /** Properties of a GetCellInfoNamesResponse. */
+    interface IGetCellInfoNamesResponse {
 
-        /** GetSchemaResponse schema */
-        schema?: (tabletmanagerdata.ISchemaDefinition|null);
+        /** GetCellInfoNamesResponse names */
+        names?: (string[]|null);
     }
 
-    /** Represents a GetSchemaResponse. */
-    class GetSchemaResponse implements IGetSchemaResponse {
+    /** Represents a GetCellInfoNamesResponse. */
+    class GetCellInfoNamesResponse implements IGetCellInfoNamesResponse {
 
         /**
-         * Constructs a new GetSchemaResponse.
+         * Constructs a new GetCellInfoNamesResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetSchemaResponse);
+        constructor(properties?: vtctldata.IGetCellInfoNamesResponse);
 
-        /** GetSchemaResponse schema. */
-        public schema?: (tabletmanagerdata.ISchemaDefinition|null);
+        /** GetCellInfoNamesResponse names. */
+        public names: string[];
 
         /**
-         * Creates a new GetSchemaResponse instance using the specified properties.
+         * Creates a new GetCellInfoNamesResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetSchemaResponse instance
+         * @returns GetCellInfoNamesResponse instance
          */
-        public static create(properties?: vtctldata.IGetSchemaResponse): vtctldata.GetSchemaResponse;
+        public static create(properties?: vtctldata.IGetCellInfoNamesResponse): vtctldata.GetCellInfoNamesResponse;
 
         /**
-         * Encodes the specified GetSchemaResponse message. Does not implicitly {@link vtctldata.GetSchemaResponse.verify|verify} messages.
-         * @param message GetSchemaResponse message or plain object to encode
+         * Encodes the specified GetCellInfoNamesResponse message. Does not implicitly {@link vtctldata.GetCellInfoNamesResponse.verify|verify} messages.
+         * @param message GetCellInfoNamesResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetSchemaResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetCellInfoNamesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetSchemaResponse message, length delimited. Does not implicitly {@link vtctldata.GetSchemaResponse.verify|verify} messages.
-         * @param message GetSchemaResponse message or plain object to encode
+         * Encodes the specified GetCellInfoNamesResponse message, length delimited. Does not implicitly {@link vtctldata.GetCellInfoNamesResponse.verify|verify} messages.
+         * @param message GetCellInfoNamesResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetSchemaResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetCellInfoNamesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetSchemaResponse message from the specified reader or buffer.
+         * Decodes a GetCellInfoNamesResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetSchemaResponse
+         * @returns GetCellInfoNamesResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSchemaResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetCellInfoNamesResponse;
 
         /**
-         * Decodes a GetSchemaResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetCellInfoNamesResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetSchemaResponse
+         * @returns GetCellInfoNamesResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSchemaResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetCellInfoNamesResponse;
 
         /**
-         * Verifies a GetSchemaResponse message.
+         * Verifies a GetCellInfoNamesResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetSchemaResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetCellInfoNamesResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetSchemaResponse
+         * @returns GetCellInfoNamesResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetSchemaResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetCellInfoNamesResponse;
 
         /**
-         * Creates a plain object from a GetSchemaResponse message. Also converts values to other types if specified.
-         * @param message GetSchemaResponse
+         * Creates a plain object from a GetCellInfoNamesResponse message. Also converts values to other types if specified.
+         * @param message GetCellInfoNamesResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetSchemaResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetCellInfoNamesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetSchemaResponse to JSON.
+         * Converts this GetCellInfoNamesResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetShardRequest. */
-    interface IGetShardRequest {
-
-        /** GetShardRequest keyspace */
-        keyspace?: (string|null);
-
-        /** GetShardRequest shard_name */
-        shard_name?: (string|null);
+    /** Properties of a GetCellsAliasesRequest. */
+    interface IGetCellsAliasesRequest {
     }
 
-    /** Represents a GetShardRequest. */
-    class GetShardRequest implements IGetShardRequest {
+    /** Represents a GetCellsAliasesRequest. */
+    class GetCellsAliasesRequest implements IGetCellsAliasesRequest {
 
         /**
-         * Constructs a new GetShardRequest.
+         * Constructs a new GetCellsAliasesRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetShardRequest);
-
-        /** GetShardRequest keyspace. */
-        public keyspace: string;
-
-        /** GetShardRequest shard_name. */
-        public shard_name: string;
+        constructor(properties?: vtctldata.IGetCellsAliasesRequest);
 
         /**
-         * Creates a new GetShardRequest instance using the specified properties.
+         * Creates a new GetCellsAliasesRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetShardRequest instance
+         * @returns GetCellsAliasesRequest instance
          */
-        public static create(properties?: vtctldata.IGetShardRequest): vtctldata.GetShardRequest;
+        public static create(properties?: vtctldata.IGetCellsAliasesRequest): vtctldata.GetCellsAliasesRequest;
 
         /**
-         * Encodes the specified GetShardRequest message. Does not implicitly {@link vtctldata.GetShardRequest.verify|verify} messages.
-         * @param message GetShardRequest message or plain object to encode
+         * Encodes the specified GetCellsAliasesRequest message. Does not implicitly {@link vtctldata.GetCellsAliasesRequest.verify|verify} messages.
+         * @param message GetCellsAliasesRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetShardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetCellsAliasesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetShardRequest message, length delimited. Does not implicitly {@link vtctldata.GetShardRequest.verify|verify} messages.
-         * @param message GetShardRequest message or plain object to encode
+         * Encodes the specified GetCellsAliasesRequest message, length delimited. Does not implicitly {@link vtctldata.GetCellsAliasesRequest.verify|verify} messages.
+         * @param message GetCellsAliasesRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetShardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetCellsAliasesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetShardRequest message from the specified reader or buffer.
+         * Decodes a GetCellsAliasesRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetShardRequest
+         * @returns GetCellsAliasesRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetShardRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetCellsAliasesRequest;
 
         /**
-         * Decodes a GetShardRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetCellsAliasesRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetShardRequest
+         * @returns GetCellsAliasesRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetShardRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetCellsAliasesRequest;
 
         /**
-         * Verifies a GetShardRequest message.
+         * Verifies a GetCellsAliasesRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetShardRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetCellsAliasesRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetShardRequest
+         * @returns GetCellsAliasesRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetShardRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetCellsAliasesRequest;
 
         /**
-         * Creates a plain object from a GetShardRequest message. Also converts values to other types if specified.
-         * @param message GetShardRequest
+         * Creates a plain object from a GetCellsAliasesRequest message. Also converts values to other types if specified.
+         * @param message GetCellsAliasesRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetShardRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetCellsAliasesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetShardRequest to JSON.
+         * Converts this GetCellsAliasesRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetShardResponse. */
-    interface IGetShardResponse {
+    /** Properties of a GetCellsAliasesResponse. */
+    interface IGetCellsAliasesResponse {
 
-        /** GetShardResponse shard */
-        shard?: (vtctldata.IShard|null);
+        /** GetCellsAliasesResponse aliases */
+        aliases?: ({ [k: string]: topodata.ICellsAlias }|null);
     }
 
-    /** Represents a GetShardResponse. */
-    class GetShardResponse implements IGetShardResponse {
+    /** Represents a GetCellsAliasesResponse. */
+    class GetCellsAliasesResponse implements IGetCellsAliasesResponse {
 
         /**
-         * Constructs a new GetShardResponse.
+         * Constructs a new GetCellsAliasesResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetShardResponse);
+        constructor(properties?: vtctldata.IGetCellsAliasesResponse);
 
-        /** GetShardResponse shard. */
-        public shard?: (vtctldata.IShard|null);
+        /** GetCellsAliasesResponse aliases. */
+        public aliases: { [k: string]: topodata.ICellsAlias };
 
         /**
-         * Creates a new GetShardResponse instance using the specified properties.
+         * Creates a new GetCellsAliasesResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetShardResponse instance
+         * @returns GetCellsAliasesResponse instance
          */
-        public static create(properties?: vtctldata.IGetShardResponse): vtctldata.GetShardResponse;
+        public static create(properties?: vtctldata.IGetCellsAliasesResponse): vtctldata.GetCellsAliasesResponse;
 
         /**
-         * Encodes the specified GetShardResponse message. Does not implicitly {@link vtctldata.GetShardResponse.verify|verify} messages.
-         * @param message GetShardResponse message or plain object to encode
+         * Encodes the specified GetCellsAliasesResponse message. Does not implicitly {@link vtctldata.GetCellsAliasesResponse.verify|verify} messages.
+         * @param message GetCellsAliasesResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetShardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetCellsAliasesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetShardResponse message, length delimited. Does not implicitly {@link vtctldata.GetShardResponse.verify|verify} messages.
-         * @param message GetShardResponse message or plain object to encode
+         * Encodes the specified GetCellsAliasesResponse message, length delimited. Does not implicitly {@link vtctldata.GetCellsAliasesResponse.verify|verify} messages.
+         * @param message GetCellsAliasesResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetShardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetCellsAliasesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetShardResponse message from the specified reader or buffer.
+         * Decodes a GetCellsAliasesResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetShardResponse
+         * @returns GetCellsAliasesResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetShardResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetCellsAliasesResponse;
 
         /**
-         * Decodes a GetShardResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetCellsAliasesResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetShardResponse
+         * @returns GetCellsAliasesResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetShardResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetCellsAliasesResponse;
 
         /**
-         * Verifies a GetShardResponse message.
+         * Verifies a GetCellsAliasesResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetShardResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetCellsAliasesResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetShardResponse
+         * @returns GetCellsAliasesResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetShardResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetCellsAliasesResponse;
 
         /**
-         * Creates a plain object from a GetShardResponse message. Also converts values to other types if specified.
-         * @param message GetShardResponse
+         * Creates a plain object from a GetCellsAliasesResponse message. Also converts values to other types if specified.
+         * @param message GetCellsAliasesResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetShardResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetCellsAliasesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetShardResponse to JSON.
+         * Converts this GetCellsAliasesResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetSrvKeyspaceNamesRequest. */
-    interface IGetSrvKeyspaceNamesRequest {
-
-        /** GetSrvKeyspaceNamesRequest cells */
-        cells?: (string[]|null);
+    /** Properties of a GetKeyspacesRequest. */
+    interface IGetKeyspacesRequest {
     }
 
-    /** Represents a GetSrvKeyspaceNamesRequest. */
-    class GetSrvKeyspaceNamesRequest implements IGetSrvKeyspaceNamesRequest {
+    /** Represents a GetKeyspacesRequest. */
+    class GetKeyspacesRequest implements IGetKeyspacesRequest {
 
         /**
-         * Constructs a new GetSrvKeyspaceNamesRequest.
+         * Constructs a new GetKeyspacesRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetSrvKeyspaceNamesRequest);
-
-        /** GetSrvKeyspaceNamesRequest cells. */
-        public cells: string[];
+        constructor(properties?: vtctldata.IGetKeyspacesRequest);
 
         /**
-         * Creates a new GetSrvKeyspaceNamesRequest instance using the specified properties.
+         * Creates a new GetKeyspacesRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetSrvKeyspaceNamesRequest instance
+         * @returns GetKeyspacesRequest instance
          */
-        public static create(properties?: vtctldata.IGetSrvKeyspaceNamesRequest): vtctldata.GetSrvKeyspaceNamesRequest;
+        public static create(properties?: vtctldata.IGetKeyspacesRequest): vtctldata.GetKeyspacesRequest;
 
         /**
-         * Encodes the specified GetSrvKeyspaceNamesRequest message. Does not implicitly {@link vtctldata.GetSrvKeyspaceNamesRequest.verify|verify} messages.
-         * @param message GetSrvKeyspaceNamesRequest message or plain object to encode
+         * Encodes the specified GetKeyspacesRequest message. Does not implicitly {@link vtctldata.GetKeyspacesRequest.verify|verify} messages.
+         * @param message GetKeyspacesRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetSrvKeyspaceNamesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetKeyspacesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetSrvKeyspaceNamesRequest message, length delimited. Does not implicitly {@link vtctldata.GetSrvKeyspaceNamesRequest.verify|verify} messages.
-         * @param message GetSrvKeyspaceNamesRequest message or plain object to encode
+         * Encodes the specified GetKeyspacesRequest message, length delimited. Does not implicitly {@link vtctldata.GetKeyspacesRequest.verify|verify} messages.
+         * @param message GetKeyspacesRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetSrvKeyspaceNamesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetKeyspacesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetSrvKeyspaceNamesRequest message from the specified reader or buffer.
+         * Decodes a GetKeyspacesRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetSrvKeyspaceNamesRequest
+         * @returns GetKeyspacesRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvKeyspaceNamesRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetKeyspacesRequest;
 
         /**
-         * Decodes a GetSrvKeyspaceNamesRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetKeyspacesRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetSrvKeyspaceNamesRequest
+         * @returns GetKeyspacesRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvKeyspaceNamesRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetKeyspacesRequest;
 
         /**
-         * Verifies a GetSrvKeyspaceNamesRequest message.
+         * Verifies a GetKeyspacesRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetSrvKeyspaceNamesRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetKeyspacesRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetSrvKeyspaceNamesRequest
+         * @returns GetKeyspacesRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvKeyspaceNamesRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetKeyspacesRequest;
 
         /**
-         * Creates a plain object from a GetSrvKeyspaceNamesRequest message. Also converts values to other types if specified.
-         * @param message GetSrvKeyspaceNamesRequest
+         * Creates a plain object from a GetKeyspacesRequest message. Also converts values to other types if specified.
+         * @param message GetKeyspacesRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetSrvKeyspaceNamesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetKeyspacesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetSrvKeyspaceNamesRequest to JSON.
+         * Converts this GetKeyspacesRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetSrvKeyspaceNamesResponse. */
-    interface IGetSrvKeyspaceNamesResponse {
+    /** Properties of a GetKeyspacesResponse. */
+    interface IGetKeyspacesResponse {
 
-        /** GetSrvKeyspaceNamesResponse names */
-        names?: ({ [k: string]: vtctldata.GetSrvKeyspaceNamesResponse.INameList }|null);
+        /** GetKeyspacesResponse keyspaces */
+        keyspaces?: (vtctldata.IKeyspace[]|null);
     }
 
-    /** Represents a GetSrvKeyspaceNamesResponse. */
-    class GetSrvKeyspaceNamesResponse implements IGetSrvKeyspaceNamesResponse {
+    /** Represents a GetKeyspacesResponse. */
+    class GetKeyspacesResponse implements IGetKeyspacesResponse {
 
         /**
-         * Constructs a new GetSrvKeyspaceNamesResponse.
+         * Constructs a new GetKeyspacesResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetSrvKeyspaceNamesResponse);
+        constructor(properties?: vtctldata.IGetKeyspacesResponse);
 
-        /** GetSrvKeyspaceNamesResponse names. */
-        public names: { [k: string]: vtctldata.GetSrvKeyspaceNamesResponse.INameList };
+        /** GetKeyspacesResponse keyspaces. */
+        public keyspaces: vtctldata.IKeyspace[];
 
         /**
-         * Creates a new GetSrvKeyspaceNamesResponse instance using the specified properties.
+         * Creates a new GetKeyspacesResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetSrvKeyspaceNamesResponse instance
+         * @returns GetKeyspacesResponse instance
          */
-        public static create(properties?: vtctldata.IGetSrvKeyspaceNamesResponse): vtctldata.GetSrvKeyspaceNamesResponse;
+        public static create(properties?: vtctldata.IGetKeyspacesResponse): vtctldata.GetKeyspacesResponse;
 
         /**
-         * Encodes the specified GetSrvKeyspaceNamesResponse message. Does not implicitly {@link vtctldata.GetSrvKeyspaceNamesResponse.verify|verify} messages.
-         * @param message GetSrvKeyspaceNamesResponse message or plain object to encode
+         * Encodes the specified GetKeyspacesResponse message. Does not implicitly {@link vtctldata.GetKeyspacesResponse.verify|verify} messages.
+         * @param message GetKeyspacesResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetSrvKeyspaceNamesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetKeyspacesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetSrvKeyspaceNamesResponse message, length delimited. Does not implicitly {@link vtctldata.GetSrvKeyspaceNamesResponse.verify|verify} messages.
-         * @param message GetSrvKeyspaceNamesResponse message or plain object to encode
+         * Encodes the specified GetKeyspacesResponse message, length delimited. Does not implicitly {@link vtctldata.GetKeyspacesResponse.verify|verify} messages.
+         * @param message GetKeyspacesResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetSrvKeyspaceNamesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetKeyspacesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetSrvKeyspaceNamesResponse message from the specified reader or buffer.
+         * Decodes a GetKeyspacesResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetSrvKeyspaceNamesResponse
+         * @returns GetKeyspacesResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvKeyspaceNamesResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetKeyspacesResponse;
 
         /**
-         * Decodes a GetSrvKeyspaceNamesResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetKeyspacesResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetSrvKeyspaceNamesResponse
+         * @returns GetKeyspacesResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvKeyspaceNamesResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetKeyspacesResponse;
 
         /**
-         * Verifies a GetSrvKeyspaceNamesResponse message.
+         * Verifies a GetKeyspacesResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetSrvKeyspaceNamesResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetKeyspacesResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetSrvKeyspaceNamesResponse
+         * @returns GetKeyspacesResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvKeyspaceNamesResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetKeyspacesResponse;
 
         /**
-         * Creates a plain object from a GetSrvKeyspaceNamesResponse message. Also converts values to other types if specified.
-         * @param message GetSrvKeyspaceNamesResponse
+         * Creates a plain object from a GetKeyspacesResponse message. Also converts values to other types if specified.
+         * @param message GetKeyspacesResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetSrvKeyspaceNamesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetKeyspacesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetSrvKeyspaceNamesResponse to JSON.
+         * Converts this GetKeyspacesResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    namespace GetSrvKeyspaceNamesResponse {
-
-        /** Properties of a NameList. */
-        interface INameList {
-
-            /** NameList names */
-            names?: (string[]|null);
-        }
-
-        /** Represents a NameList. */
-        class NameList implements INameList {
-
-            /**
-             * Constructs a new NameList.
-             * @param [properties] Properties to set
-             */
-            constructor(properties?: vtctldata.GetSrvKeyspaceNamesResponse.INameList);
-
-            /** NameList names. */
-            public names: string[];
-
-            /**
-             * Creates a new NameList instance using the specified properties.
-             * @param [properties] Properties to set
-             * @returns NameList instance
-             */
-            public static create(properties?: vtctldata.GetSrvKeyspaceNamesResponse.INameList): vtctldata.GetSrvKeyspaceNamesResponse.NameList;
-
-            /**
-             * Encodes the specified NameList message. Does not implicitly {@link vtctldata.GetSrvKeyspaceNamesResponse.NameList.verify|verify} messages.
-             * @param message NameList message or plain object to encode
-             * @param [writer] Writer to encode to
-             * @returns Writer
-             */
-            public static encode(message: vtctldata.GetSrvKeyspaceNamesResponse.INameList, writer?: $protobuf.Writer): $protobuf.Writer;
-
-            /**
-             * Encodes the specified NameList message, length delimited. Does not implicitly {@link vtctldata.GetSrvKeyspaceNamesResponse.NameList.verify|verify} messages.
-             * @param message NameList message or plain object to encode
-             * @param [writer] Writer to encode to
-             * @returns Writer
-             */
-            public static encodeDelimited(message: vtctldata.GetSrvKeyspaceNamesResponse.INameList, writer?: $protobuf.Writer): $protobuf.Writer;
-
-            /**
-             * Decodes a NameList message from the specified reader or buffer.
-             * @param reader Reader or buffer to decode from
-             * @param [length] Message length if known beforehand
-             * @returns NameList
-             * @throws {Error} If the payload is not a reader or valid buffer
-             * @throws {$protobuf.util.ProtocolError} If required fields are missing
-             */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvKeyspaceNamesResponse.NameList;
-
-            /**
-             * Decodes a NameList message from the specified reader or buffer, length delimited.
-             * @param reader Reader or buffer to decode from
-             * @returns NameList
-             * @throws {Error} If the payload is not a reader or valid buffer
-             * @throws {$protobuf.util.ProtocolError} If required fields are missing
-             */
-            public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvKeyspaceNamesResponse.NameList;
-
-            /**
-             * Verifies a NameList message.
-             * @param message Plain object to verify
-             * @returns `null` if valid, otherwise the reason why it is not
-             */
-            public static verify(message: { [k: string]: any }): (string|null);
-
-            /**
-             * Creates a NameList message from a plain object. Also converts values to their respective internal types.
-             * @param object Plain object
-             * @returns NameList
-             */
-            public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvKeyspaceNamesResponse.NameList;
-
-            /**
-             * Creates a plain object from a NameList message. Also converts values to other types if specified.
-             * @param message NameList
-             * @param [options] Conversion options
-             * @returns Plain object
-             */
-            public static toObject(message: vtctldata.GetSrvKeyspaceNamesResponse.NameList, options?: $protobuf.IConversionOptions): { [k: string]: any };
-
-            /**
-             * Converts this NameList to JSON.
-             * @returns JSON object
-             */
-            public toJSON(): { [k: string]: any };
-        }
-    }
-
-    /** Properties of a GetSrvKeyspacesRequest. */
-    interface IGetSrvKeyspacesRequest {
+    /** Properties of a GetKeyspaceRequest. */
+    interface IGetKeyspaceRequest {
 
-        /** GetSrvKeyspacesRequest keyspace */
+        /** GetKeyspaceRequest keyspace */
         keyspace?: (string|null);
-
-        /** GetSrvKeyspacesRequest cells */
-        cells?: (string[]|null);
     }
 
-    /** Represents a GetSrvKeyspacesRequest. */
-    class GetSrvKeyspacesRequest implements IGetSrvKeyspacesRequest {
+    /** Represents a GetKeyspaceRequest. */
+    class GetKeyspaceRequest implements IGetKeyspaceRequest {
 
         /**
-         * Constructs a new GetSrvKeyspacesRequest.
+         * Constructs a new GetKeyspaceRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetSrvKeyspacesRequest);
+        constructor(properties?: vtctldata.IGetKeyspaceRequest);
 
-        /** GetSrvKeyspacesRequest keyspace. */
+        /** GetKeyspaceRequest keyspace. */
         public keyspace: string;
 
-        /** GetSrvKeyspacesRequest cells. */
-        public cells: string[];
-
         /**
-         * Creates a new GetSrvKeyspacesRequest instance using the specified properties.
+         * Creates a new GetKeyspaceRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetSrvKeyspacesRequest instance
+         * @returns GetKeyspaceRequest instance
          */
-        public static create(properties?: vtctldata.IGetSrvKeyspacesRequest): vtctldata.GetSrvKeyspacesRequest;
+        public static create(properties?: vtctldata.IGetKeyspaceRequest): vtctldata.GetKeyspaceRequest;
 
         /**
-         * Encodes the specified GetSrvKeyspacesRequest message. Does not implicitly {@link vtctldata.GetSrvKeyspacesRequest.verify|verify} messages.
-         * @param message GetSrvKeyspacesRequest message or plain object to encode
+         * Encodes the specified GetKeyspaceRequest message. Does not implicitly {@link vtctldata.GetKeyspaceRequest.verify|verify} messages.
+         * @param message GetKeyspaceRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetSrvKeyspacesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetKeyspaceRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetSrvKeyspacesRequest message, length delimited. Does not implicitly {@link vtctldata.GetSrvKeyspacesRequest.verify|verify} messages.
-         * @param message GetSrvKeyspacesRequest message or plain object to encode
+         * Encodes the specified GetKeyspaceRequest message, length delimited. Does not implicitly {@link vtctldata.GetKeyspaceRequest.verify|verify} messages.
+         * @param message GetKeyspaceRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetSrvKeyspacesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetKeyspaceRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetSrvKeyspacesRequest message from the specified reader or buffer.
+         * Decodes a GetKeyspaceRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetSrvKeyspacesRequest
+         * @returns GetKeyspaceRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvKeyspacesRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetKeyspaceRequest;
 
         /**
-         * Decodes a GetSrvKeyspacesRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetKeyspaceRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetSrvKeyspacesRequest
+         * @returns GetKeyspaceRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvKeyspacesRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetKeyspaceRequest;
 
         /**
-         * Verifies a GetSrvKeyspacesRequest message.
+         * Verifies a GetKeyspaceRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetSrvKeyspacesRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetKeyspaceRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetSrvKeyspacesRequest
+         * @returns GetKeyspaceRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvKeyspacesRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetKeyspaceRequest;
 
         /**
-         * Creates a plain object from a GetSrvKeyspacesRequest message. Also converts values to other types if specified.
-         * @param message GetSrvKeyspacesRequest
+         * Creates a plain object from a GetKeyspaceRequest message. Also converts values to other types if specified.
+         * @param message GetKeyspaceRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetSrvKeyspacesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetKeyspaceRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetSrvKeyspacesRequest to JSON.
+         * Converts this GetKeyspaceRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetSrvKeyspacesResponse. */
-    interface IGetSrvKeyspacesResponse {
+    /** Properties of a GetKeyspaceResponse. */
+    interface IGetKeyspaceResponse {
 
-        /** GetSrvKeyspacesResponse srv_keyspaces */
-        srv_keyspaces?: ({ [k: string]: topodata.ISrvKeyspace }|null);
+        /** GetKeyspaceResponse keyspace */
+        keyspace?: (vtctldata.IKeyspace|null);
     }
 
-    /** Represents a GetSrvKeyspacesResponse. */
-    class GetSrvKeyspacesResponse implements IGetSrvKeyspacesResponse {
+    /** Represents a GetKeyspaceResponse. */
+    class GetKeyspaceResponse implements IGetKeyspaceResponse {
 
         /**
-         * Constructs a new GetSrvKeyspacesResponse.
+         * Constructs a new GetKeyspaceResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetSrvKeyspacesResponse);
+        constructor(properties?: vtctldata.IGetKeyspaceResponse);
 
-        /** GetSrvKeyspacesResponse srv_keyspaces. */
-        public srv_keyspaces: { [k: string]: topodata.ISrvKeyspace };
+        /** GetKeyspaceResponse keyspace. */
+        public keyspace?: (vtctldata.IKeyspace|null);
 
         /**
-         * Creates a new GetSrvKeyspacesResponse instance using the specified properties.
+         * Creates a new GetKeyspaceResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetSrvKeyspacesResponse instance
+         * @returns GetKeyspaceResponse instance
          */
-        public static create(properties?: vtctldata.IGetSrvKeyspacesResponse): vtctldata.GetSrvKeyspacesResponse;
+        public static create(properties?: vtctldata.IGetKeyspaceResponse): vtctldata.GetKeyspaceResponse;
 
         /**
-         * Encodes the specified GetSrvKeyspacesResponse message. Does not implicitly {@link vtctldata.GetSrvKeyspacesResponse.verify|verify} messages.
-         * @param message GetSrvKeyspacesResponse message or plain object to encode
+         * Encodes the specified GetKeyspaceResponse message. Does not implicitly {@link vtctldata.GetKeyspaceResponse.verify|verify} messages.
+         * @param message GetKeyspaceResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetSrvKeyspacesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetKeyspaceResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetSrvKeyspacesResponse message, length delimited. Does not implicitly {@link vtctldata.GetSrvKeyspacesResponse.verify|verify} messages.
-         * @param message GetSrvKeyspacesResponse message or plain object to encode
+         * Encodes the specified GetKeyspaceResponse message, length delimited. Does not implicitly {@link vtctldata.GetKeyspaceResponse.verify|verify} messages.
+         * @param message GetKeyspaceResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetSrvKeyspacesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetKeyspaceResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetSrvKeyspacesResponse message from the specified reader or buffer.
+         * Decodes a GetKeyspaceResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetSrvKeyspacesResponse
+         * @returns GetKeyspaceResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvKeyspacesResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetKeyspaceResponse;
 
         /**
-         * Decodes a GetSrvKeyspacesResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetKeyspaceResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetSrvKeyspacesResponse
+         * @returns GetKeyspaceResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvKeyspacesResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetKeyspaceResponse;
 
         /**
-         * Verifies a GetSrvKeyspacesResponse message.
+         * Verifies a GetKeyspaceResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetSrvKeyspacesResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetKeyspaceResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetSrvKeyspacesResponse
+         * @returns GetKeyspaceResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvKeyspacesResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetKeyspaceResponse;
 
         /**
-         * Creates a plain object from a GetSrvKeyspacesResponse message. Also converts values to other types if specified.
-         * @param message GetSrvKeyspacesResponse
+         * Creates a plain object from a GetKeyspaceResponse message. Also converts values to other types if specified.
+         * @param message GetKeyspaceResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetSrvKeyspacesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetKeyspaceResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetSrvKeyspacesResponse to JSON.
+         * Converts this GetKeyspaceResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetSrvVSchemaRequest. */
-    interface IGetSrvVSchemaRequest {
-
-        /** GetSrvVSchemaRequest cell */
-        cell?: (string|null);
+    /** Properties of a GetRoutingRulesRequest. */
+    interface IGetRoutingRulesRequest {
     }
 
-    /** Represents a GetSrvVSchemaRequest. */
-    class GetSrvVSchemaRequest implements IGetSrvVSchemaRequest {
+    /** Represents a GetRoutingRulesRequest. */
+    class GetRoutingRulesRequest implements IGetRoutingRulesRequest {
 
         /**
-         * Constructs a new GetSrvVSchemaRequest.
+         * Constructs a new GetRoutingRulesRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetSrvVSchemaRequest);
-
-        /** GetSrvVSchemaRequest cell. */
-        public cell: string;
+        constructor(properties?: vtctldata.IGetRoutingRulesRequest);
 
         /**
-         * Creates a new GetSrvVSchemaRequest instance using the specified properties.
+         * Creates a new GetRoutingRulesRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetSrvVSchemaRequest instance
+         * @returns GetRoutingRulesRequest instance
          */
-        public static create(properties?: vtctldata.IGetSrvVSchemaRequest): vtctldata.GetSrvVSchemaRequest;
+        public static create(properties?: vtctldata.IGetRoutingRulesRequest): vtctldata.GetRoutingRulesRequest;
 
         /**
-         * Encodes the specified GetSrvVSchemaRequest message. Does not implicitly {@link vtctldata.GetSrvVSchemaRequest.verify|verify} messages.
-         * @param message GetSrvVSchemaRequest message or plain object to encode
+         * Encodes the specified GetRoutingRulesRequest message. Does not implicitly {@link vtctldata.GetRoutingRulesRequest.verify|verify} messages.
+         * @param message GetRoutingRulesRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetSrvVSchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetRoutingRulesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetSrvVSchemaRequest message, length delimited. Does not implicitly {@link vtctldata.GetSrvVSchemaRequest.verify|verify} messages.
-         * @param message GetSrvVSchemaRequest message or plain object to encode
+         * Encodes the specified GetRoutingRulesRequest message, length delimited. Does not implicitly {@link vtctldata.GetRoutingRulesRequest.verify|verify} messages.
+         * @param message GetRoutingRulesRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetSrvVSchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetRoutingRulesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetSrvVSchemaRequest message from the specified reader or buffer.
+         * Decodes a GetRoutingRulesRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetSrvVSchemaRequest
+         * @returns GetRoutingRulesRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvVSchemaRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetRoutingRulesRequest;
 
         /**
-         * Decodes a GetSrvVSchemaRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetRoutingRulesRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetSrvVSchemaRequest
+         * @returns GetRoutingRulesRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvVSchemaRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetRoutingRulesRequest;
 
         /**
-         * Verifies a GetSrvVSchemaRequest message.
+         * Verifies a GetRoutingRulesRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetSrvVSchemaRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetRoutingRulesRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetSrvVSchemaRequest
+         * @returns GetRoutingRulesRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvVSchemaRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetRoutingRulesRequest;
 
         /**
-         * Creates a plain object from a GetSrvVSchemaRequest message. Also converts values to other types if specified.
-         * @param message GetSrvVSchemaRequest
+         * Creates a plain object from a GetRoutingRulesRequest message. Also converts values to other types if specified.
+         * @param message GetRoutingRulesRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetSrvVSchemaRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetRoutingRulesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetSrvVSchemaRequest to JSON.
+         * Converts this GetRoutingRulesRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetSrvVSchemaResponse. */
-    interface IGetSrvVSchemaResponse {
+    /** Properties of a GetRoutingRulesResponse. */
+    interface IGetRoutingRulesResponse {
 
-        /** GetSrvVSchemaResponse srv_v_schema */
-        srv_v_schema?: (vschema.ISrvVSchema|null);
+        /** GetRoutingRulesResponse routing_rules */
+        routing_rules?: (vschema.IRoutingRules|null);
     }
 
-    /** Represents a GetSrvVSchemaResponse. */
-    class GetSrvVSchemaResponse implements IGetSrvVSchemaResponse {
+    /** Represents a GetRoutingRulesResponse. */
+    class GetRoutingRulesResponse implements IGetRoutingRulesResponse {
 
         /**
-         * Constructs a new GetSrvVSchemaResponse.
+         * Constructs a new GetRoutingRulesResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetSrvVSchemaResponse);
+        constructor(properties?: vtctldata.IGetRoutingRulesResponse);
 
-        /** GetSrvVSchemaResponse srv_v_schema. */
-        public srv_v_schema?: (vschema.ISrvVSchema|null);
+        /** GetRoutingRulesResponse routing_rules. */
+        public routing_rules?: (vschema.IRoutingRules|null);
 
         /**
-         * Creates a new GetSrvVSchemaResponse instance using the specified properties.
+         * Creates a new GetRoutingRulesResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetSrvVSchemaResponse instance
+         * @returns GetRoutingRulesResponse instance
          */
-        public static create(properties?: vtctldata.IGetSrvVSchemaResponse): vtctldata.GetSrvVSchemaResponse;
+        public static create(properties?: vtctldata.IGetRoutingRulesResponse): vtctldata.GetRoutingRulesResponse;
 
         /**
-         * Encodes the specified GetSrvVSchemaResponse message. Does not implicitly {@link vtctldata.GetSrvVSchemaResponse.verify|verify} messages.
-         * @param message GetSrvVSchemaResponse message or plain object to encode
+         * Encodes the specified GetRoutingRulesResponse message. Does not implicitly {@link vtctldata.GetRoutingRulesResponse.verify|verify} messages.
+         * @param message GetRoutingRulesResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetSrvVSchemaResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetRoutingRulesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetSrvVSchemaResponse message, length delimited. Does not implicitly {@link vtctldata.GetSrvVSchemaResponse.verify|verify} messages.
-         * @param message GetSrvVSchemaResponse message or plain object to encode
+         * Encodes the specified GetRoutingRulesResponse message, length delimited. Does not implicitly {@link vtctldata.GetRoutingRulesResponse.verify|verify} messages.
+         * @param message GetRoutingRulesResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetSrvVSchemaResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetRoutingRulesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetSrvVSchemaResponse message from the specified reader or buffer.
+         * Decodes a GetRoutingRulesResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetSrvVSchemaResponse
+         * @returns GetRoutingRulesResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvVSchemaResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetRoutingRulesResponse;
 
         /**
-         * Decodes a GetSrvVSchemaResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetRoutingRulesResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetSrvVSchemaResponse
+         * @returns GetRoutingRulesResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvVSchemaResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetRoutingRulesResponse;
 
         /**
-         * Verifies a GetSrvVSchemaResponse message.
+         * Verifies a GetRoutingRulesResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetSrvVSchemaResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetRoutingRulesResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetSrvVSchemaResponse
+         * @returns GetRoutingRulesResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvVSchemaResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetRoutingRulesResponse;
 
         /**
-         * Creates a plain object from a GetSrvVSchemaResponse message. Also converts values to other types if specified.
-         * @param message GetSrvVSchemaResponse
+         * Creates a plain object from a GetRoutingRulesResponse message. Also converts values to other types if specified.
+         * @param message GetRoutingRulesResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetSrvVSchemaResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetRoutingRulesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetSrvVSchemaResponse to JSON.
+         * Converts this GetRoutingRulesResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetSrvVSchemasRequest. */
-    interface IGetSrvVSchemasRequest {
+    /** Properties of a GetSchemaRequest. */
+    interface IGetSchemaRequest {
 
-        /** GetSrvVSchemasRequest cells */
-        cells?: (string[]|null);
+        /** GetSchemaRequest tablet_alias */
+        tablet_alias?: (topodata.ITabletAlias|null);
+
+        /** GetSchemaRequest tables */
+        tables?: (string[]|null);
+
+        /** GetSchemaRequest exclude_tables */
+        exclude_tables?: (string[]|null);
+
+        /** GetSchemaRequest include_views */
+        include_views?: (boolean|null);
+
+        /** GetSchemaRequest table_names_only */
+        table_names_only?: (boolean|null);
+
+        /** GetSchemaRequest table_sizes_only */
+        table_sizes_only?: (boolean|null);
     }
 
-    /** Represents a GetSrvVSchemasRequest. */
-    class GetSrvVSchemasRequest implements IGetSrvVSchemasRequest {
+    /** Represents a GetSchemaRequest. */
+    class GetSchemaRequest implements IGetSchemaRequest {
 
         /**
-         * Constructs a new GetSrvVSchemasRequest.
+         * Constructs a new GetSchemaRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetSrvVSchemasRequest);
+        constructor(properties?: vtctldata.IGetSchemaRequest);
 
-        /** GetSrvVSchemasRequest cells. */
-        public cells: string[];
+        /** GetSchemaRequest tablet_alias. */
+        public tablet_alias?: (topodata.ITabletAlias|null);
+
+        /** GetSchemaRequest tables. */
+        public tables: string[];
+
+        /** GetSchemaRequest exclude_tables. */
+        public exclude_tables: string[];
+
+        /** GetSchemaRequest include_views. */
+        public include_views: boolean;
+
+        /** GetSchemaRequest table_names_only. */
+        public table_names_only: boolean;
+
+        /** GetSchemaRequest table_sizes_only. */
+        public table_sizes_only: boolean;
 
         /**
-         * Creates a new GetSrvVSchemasRequest instance using the specified properties.
+         * Creates a new GetSchemaRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetSrvVSchemasRequest instance
+         * @returns GetSchemaRequest instance
          */
-        public static create(properties?: vtctldata.IGetSrvVSchemasRequest): vtctldata.GetSrvVSchemasRequest;
+        public static create(properties?: vtctldata.IGetSchemaRequest): vtctldata.GetSchemaRequest;
 
         /**
-         * Encodes the specified GetSrvVSchemasRequest message. Does not implicitly {@link vtctldata.GetSrvVSchemasRequest.verify|verify} messages.
-         * @param message GetSrvVSchemasRequest message or plain object to encode
+         * Encodes the specified GetSchemaRequest message. Does not implicitly {@link vtctldata.GetSchemaRequest.verify|verify} messages.
+         * @param message GetSchemaRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetSrvVSchemasRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetSchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetSrvVSchemasRequest message, length delimited. Does not implicitly {@link vtctldata.GetSrvVSchemasRequest.verify|verify} messages.
-         * @param message GetSrvVSchemasRequest message or plain object to encode
+         * Encodes the specified GetSchemaRequest message, length delimited. Does not implicitly {@link vtctldata.GetSchemaRequest.verify|verify} messages.
+         * @param message GetSchemaRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetSrvVSchemasRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetSchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetSrvVSchemasRequest message from the specified reader or buffer.
+         * Decodes a GetSchemaRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetSrvVSchemasRequest
+         * @returns GetSchemaRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvVSchemasRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSchemaRequest;
 
         /**
-         * Decodes a GetSrvVSchemasRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetSchemaRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetSrvVSchemasRequest
+         * @returns GetSchemaRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvVSchemasRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSchemaRequest;
 
         /**
-         * Verifies a GetSrvVSchemasRequest message.
+         * Verifies a GetSchemaRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetSrvVSchemasRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetSchemaRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetSrvVSchemasRequest
+         * @returns GetSchemaRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvVSchemasRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetSchemaRequest;
 
         /**
-         * Creates a plain object from a GetSrvVSchemasRequest message. Also converts values to other types if specified.
-         * @param message GetSrvVSchemasRequest
+         * Creates a plain object from a GetSchemaRequest message. Also converts values to other types if specified.
+         * @param message GetSchemaRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetSrvVSchemasRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetSchemaRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetSrvVSchemasRequest to JSON.
+         * Converts this GetSchemaRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetSrvVSchemasResponse. */
-    interface IGetSrvVSchemasResponse {
+    /** Properties of a GetSchemaResponse. */
+    interface IGetSchemaResponse {
 
-        /** GetSrvVSchemasResponse srv_v_schemas */
-        srv_v_schemas?: ({ [k: string]: vschema.ISrvVSchema }|null);
+        /** GetSchemaResponse schema */
+        schema?: (tabletmanagerdata.ISchemaDefinition|null);
     }
 
-    /** Represents a GetSrvVSchemasResponse. */
-    class GetSrvVSchemasResponse implements IGetSrvVSchemasResponse {
+    /** Represents a GetSchemaResponse. */
+    class GetSchemaResponse implements IGetSchemaResponse {
 
         /**
-         * Constructs a new GetSrvVSchemasResponse.
+         * Constructs a new GetSchemaResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetSrvVSchemasResponse);
+        constructor(properties?: vtctldata.IGetSchemaResponse);
 
-        /** GetSrvVSchemasResponse srv_v_schemas. */
-        public srv_v_schemas: { [k: string]: vschema.ISrvVSchema };
+        /** GetSchemaResponse schema. */
+        public schema?: (tabletmanagerdata.ISchemaDefinition|null);
 
         /**
-         * Creates a new GetSrvVSchemasResponse instance using the specified properties.
+         * Creates a new GetSchemaResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetSrvVSchemasResponse instance
+         * @returns GetSchemaResponse instance
          */
-        public static create(properties?: vtctldata.IGetSrvVSchemasResponse): vtctldata.GetSrvVSchemasResponse;
+        public static create(properties?: vtctldata.IGetSchemaResponse): vtctldata.GetSchemaResponse;
 
         /**
-         * Encodes the specified GetSrvVSchemasResponse message. Does not implicitly {@link vtctldata.GetSrvVSchemasResponse.verify|verify} messages.
-         * @param message GetSrvVSchemasResponse message or plain object to encode
+         * Encodes the specified GetSchemaResponse message. Does not implicitly {@link vtctldata.GetSchemaResponse.verify|verify} messages.
+         * @param message GetSchemaResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetSrvVSchemasResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetSchemaResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetSrvVSchemasResponse message, length delimited. Does not implicitly {@link vtctldata.GetSrvVSchemasResponse.verify|verify} messages.
-         * @param message GetSrvVSchemasResponse message or plain object to encode
+         * Encodes the specified GetSchemaResponse message, length delimited. Does not implicitly {@link vtctldata.GetSchemaResponse.verify|verify} messages.
+         * @param message GetSchemaResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetSrvVSchemasResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetSchemaResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetSrvVSchemasResponse message from the specified reader or buffer.
+         * Decodes a GetSchemaResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetSrvVSchemasResponse
+         * @returns GetSchemaResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvVSchemasResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSchemaResponse;
 
         /**
-         * Decodes a GetSrvVSchemasResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetSchemaResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetSrvVSchemasResponse
+         * @returns GetSchemaResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvVSchemasResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSchemaResponse;
 
         /**
-         * Verifies a GetSrvVSchemasResponse message.
+         * Verifies a GetSchemaResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetSrvVSchemasResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetSchemaResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetSrvVSchemasResponse
+         * @returns GetSchemaResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvVSchemasResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetSchemaResponse;
 
         /**
-         * Creates a plain object from a GetSrvVSchemasResponse message. Also converts values to other types if specified.
-         * @param message GetSrvVSchemasResponse
+         * Creates a plain object from a GetSchemaResponse message. Also converts values to other types if specified.
+         * @param message GetSchemaResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetSrvVSchemasResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetSchemaResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetSrvVSchemasResponse to JSON.
+         * Converts this GetSchemaResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetTabletRequest. */
-    interface IGetTabletRequest {
+    /** Properties of a GetShardRequest. */
+    interface IGetShardRequest {
 
-        /** GetTabletRequest tablet_alias */
-        tablet_alias?: (topodata.ITabletAlias|null);
+        /** GetShardRequest keyspace */
+        keyspace?: (string|null);
+
+        /** GetShardRequest shard_name */
+        shard_name?: (string|null);
     }
 
-    /** Represents a GetTabletRequest. */
-    class GetTabletRequest implements IGetTabletRequest {
+    /** Represents a GetShardRequest. */
+    class GetShardRequest implements IGetShardRequest {
 
         /**
-         * Constructs a new GetTabletRequest.
+         * Constructs a new GetShardRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetTabletRequest);
+        constructor(properties?: vtctldata.IGetShardRequest);
 
-        /** GetTabletRequest tablet_alias. */
-        public tablet_alias?: (topodata.ITabletAlias|null);
+        /** GetShardRequest keyspace. */
+        public keyspace: string;
+
+        /** GetShardRequest shard_name. */
+        public shard_name: string;
 
         /**
-         * Creates a new GetTabletRequest instance using the specified properties.
+         * Creates a new GetShardRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetTabletRequest instance
+         * @returns GetShardRequest instance
          */
-        public static create(properties?: vtctldata.IGetTabletRequest): vtctldata.GetTabletRequest;
+        public static create(properties?: vtctldata.IGetShardRequest): vtctldata.GetShardRequest;
 
         /**
-         * Encodes the specified GetTabletRequest message. Does not implicitly {@link vtctldata.GetTabletRequest.verify|verify} messages.
-         * @param message GetTabletRequest message or plain object to encode
+         * Encodes the specified GetShardRequest message. Does not implicitly {@link vtctldata.GetShardRequest.verify|verify} messages.
+         * @param message GetShardRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetTabletRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetShardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetTabletRequest message, length delimited. Does not implicitly {@link vtctldata.GetTabletRequest.verify|verify} messages.
-         * @param message GetTabletRequest message or plain object to encode
+         * Encodes the specified GetShardRequest message, length delimited. Does not implicitly {@link vtctldata.GetShardRequest.verify|verify} messages.
+         * @param message GetShardRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetTabletRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetShardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetTabletRequest message from the specified reader or buffer.
+         * Decodes a GetShardRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetTabletRequest
+         * @returns GetShardRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetTabletRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetShardRequest;
 
         /**
-         * Decodes a GetTabletRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetShardRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetTabletRequest
+         * @returns GetShardRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetTabletRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetShardRequest;
 
         /**
-         * Verifies a GetTabletRequest message.
+         * Verifies a GetShardRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetTabletRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetShardRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetTabletRequest
+         * @returns GetShardRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetTabletRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetShardRequest;
 
         /**
-         * Creates a plain object from a GetTabletRequest message. Also converts values to other types if specified.
-         * @param message GetTabletRequest
+         * Creates a plain object from a GetShardRequest message. Also converts values to other types if specified.
+         * @param message GetShardRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetTabletRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetShardRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetTabletRequest to JSON.
+         * Converts this GetShardRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetTabletResponse. */
-    interface IGetTabletResponse {
+    /** Properties of a GetShardResponse. */
+    interface IGetShardResponse {
 
-        /** GetTabletResponse tablet */
-        tablet?: (topodata.ITablet|null);
+        /** GetShardResponse shard */
+        shard?: (vtctldata.IShard|null);
     }
 
-    /** Represents a GetTabletResponse. */
-    class GetTabletResponse implements IGetTabletResponse {
+    /** Represents a GetShardResponse. */
+    class GetShardResponse implements IGetShardResponse {
 
         /**
-         * Constructs a new GetTabletResponse.
+         * Constructs a new GetShardResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetTabletResponse);
+        constructor(properties?: vtctldata.IGetShardResponse);
 
-        /** GetTabletResponse tablet. */
-        public tablet?: (topodata.ITablet|null);
+        /** GetShardResponse shard. */
+        public shard?: (vtctldata.IShard|null);
 
         /**
-         * Creates a new GetTabletResponse instance using the specified properties.
+         * Creates a new GetShardResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetTabletResponse instance
+         * @returns GetShardResponse instance
          */
-        public static create(properties?: vtctldata.IGetTabletResponse): vtctldata.GetTabletResponse;
+        public static create(properties?: vtctldata.IGetShardResponse): vtctldata.GetShardResponse;
 
         /**
-         * Encodes the specified GetTabletResponse message. Does not implicitly {@link vtctldata.GetTabletResponse.verify|verify} messages.
-         * @param message GetTabletResponse message or plain object to encode
+         * Encodes the specified GetShardResponse message. Does not implicitly {@link vtctldata.GetShardResponse.verify|verify} messages.
+         * @param message GetShardResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetTabletResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetShardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetTabletResponse message, length delimited. Does not implicitly {@link vtctldata.GetTabletResponse.verify|verify} messages.
-         * @param message GetTabletResponse message or plain object to encode
+         * Encodes the specified GetShardResponse message, length delimited. Does not implicitly {@link vtctldata.GetShardResponse.verify|verify} messages.
+         * @param message GetShardResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetTabletResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetShardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetTabletResponse message from the specified reader or buffer.
+         * Decodes a GetShardResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetTabletResponse
+         * @returns GetShardResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetTabletResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetShardResponse;
 
         /**
-         * Decodes a GetTabletResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetShardResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetTabletResponse
+         * @returns GetShardResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetTabletResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetShardResponse;
 
         /**
-         * Verifies a GetTabletResponse message.
+         * Verifies a GetShardResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetTabletResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetShardResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetTabletResponse
+         * @returns GetShardResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetTabletResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetShardResponse;
 
         /**
-         * Creates a plain object from a GetTabletResponse message. Also converts values to other types if specified.
-         * @param message GetTabletResponse
+         * Creates a plain object from a GetShardResponse message. Also converts values to other types if specified.
+         * @param message GetShardResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetTabletResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetShardResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetTabletResponse to JSON.
+         * Converts this GetShardResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetTabletsRequest. */
-    interface IGetTabletsRequest {
-
-        /** GetTabletsRequest keyspace */
-        keyspace?: (string|null);
-
-        /** GetTabletsRequest shard */
-        shard?: (string|null);
+    /** Properties of a GetSrvKeyspaceNamesRequest. */
+    interface IGetSrvKeyspaceNamesRequest {
 
-        /** GetTabletsRequest cells */
+        /** GetSrvKeyspaceNamesRequest cells */
         cells?: (string[]|null);
-
-        /** GetTabletsRequest strict */
-        strict?: (boolean|null);
-
-        /** GetTabletsRequest tablet_aliases */
-        tablet_aliases?: (topodata.ITabletAlias[]|null);
     }
 
-    /** Represents a GetTabletsRequest. */
-    class GetTabletsRequest implements IGetTabletsRequest {
+    /** Represents a GetSrvKeyspaceNamesRequest. */
+    class GetSrvKeyspaceNamesRequest implements IGetSrvKeyspaceNamesRequest {
 
         /**
-         * Constructs a new GetTabletsRequest.
+         * Constructs a new GetSrvKeyspaceNamesRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetTabletsRequest);
-
-        /** GetTabletsRequest keyspace. */
-        public keyspace: string;
-
-        /** GetTabletsRequest shard. */
-        public shard: string;
+        constructor(properties?: vtctldata.IGetSrvKeyspaceNamesRequest);
 
-        /** GetTabletsRequest cells. */
+        /** GetSrvKeyspaceNamesRequest cells. */
         public cells: string[];
 
-        /** GetTabletsRequest strict. */
-        public strict: boolean;
-
-        /** GetTabletsRequest tablet_aliases. */
-        public tablet_aliases: topodata.ITabletAlias[];
-
         /**
-         * Creates a new GetTabletsRequest instance using the specified properties.
+         * Creates a new GetSrvKeyspaceNamesRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetTabletsRequest instance
+         * @returns GetSrvKeyspaceNamesRequest instance
          */
-        public static create(properties?: vtctldata.IGetTabletsRequest): vtctldata.GetTabletsRequest;
+        public static create(properties?: vtctldata.IGetSrvKeyspaceNamesRequest): vtctldata.GetSrvKeyspaceNamesRequest;
 
         /**
-         * Encodes the specified GetTabletsRequest message. Does not implicitly {@link vtctldata.GetTabletsRequest.verify|verify} messages.
-         * @param message GetTabletsRequest message or plain object to encode
+         * Encodes the specified GetSrvKeyspaceNamesRequest message. Does not implicitly {@link vtctldata.GetSrvKeyspaceNamesRequest.verify|verify} messages.
+         * @param message GetSrvKeyspaceNamesRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetTabletsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetSrvKeyspaceNamesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetTabletsRequest message, length delimited. Does not implicitly {@link vtctldata.GetTabletsRequest.verify|verify} messages.
-         * @param message GetTabletsRequest message or plain object to encode
+         * Encodes the specified GetSrvKeyspaceNamesRequest message, length delimited. Does not implicitly {@link vtctldata.GetSrvKeyspaceNamesRequest.verify|verify} messages.
+         * @param message GetSrvKeyspaceNamesRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetTabletsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetSrvKeyspaceNamesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetTabletsRequest message from the specified reader or buffer.
+         * Decodes a GetSrvKeyspaceNamesRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetTabletsRequest
+         * @returns GetSrvKeyspaceNamesRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetTabletsRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvKeyspaceNamesRequest;
 
         /**
-         * Decodes a GetTabletsRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetSrvKeyspaceNamesRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetTabletsRequest
+         * @returns GetSrvKeyspaceNamesRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetTabletsRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvKeyspaceNamesRequest;
 
         /**
-         * Verifies a GetTabletsRequest message.
+         * Verifies a GetSrvKeyspaceNamesRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetTabletsRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetSrvKeyspaceNamesRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetTabletsRequest
+         * @returns GetSrvKeyspaceNamesRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetTabletsRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvKeyspaceNamesRequest;
 
         /**
-         * Creates a plain object from a GetTabletsRequest message. Also converts values to other types if specified.
-         * @param message GetTabletsRequest
+         * Creates a plain object from a GetSrvKeyspaceNamesRequest message. Also converts values to other types if specified.
+         * @param message GetSrvKeyspaceNamesRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetTabletsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetSrvKeyspaceNamesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetTabletsRequest to JSON.
+         * Converts this GetSrvKeyspaceNamesRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetTabletsResponse. */
-    interface IGetTabletsResponse {
+    /** Properties of a GetSrvKeyspaceNamesResponse. */
+    interface IGetSrvKeyspaceNamesResponse {
 
-        /** GetTabletsResponse tablets */
-        tablets?: (topodata.ITablet[]|null);
+        /** GetSrvKeyspaceNamesResponse names */
+        names?: ({ [k: string]: vtctldata.GetSrvKeyspaceNamesResponse.INameList }|null);
     }
 
-    /** Represents a GetTabletsResponse. */
-    class GetTabletsResponse implements IGetTabletsResponse {
+    /** Represents a GetSrvKeyspaceNamesResponse. */
+    class GetSrvKeyspaceNamesResponse implements IGetSrvKeyspaceNamesResponse {
 
         /**
-         * Constructs a new GetTabletsResponse.
+         * Constructs a new GetSrvKeyspaceNamesResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetTabletsResponse);
+        constructor(properties?: vtctldata.IGetSrvKeyspaceNamesResponse);
 
-        /** GetTabletsResponse tablets. */
-        public tablets: topodata.ITablet[];
+        /** GetSrvKeyspaceNamesResponse names. */
+        public names: { [k: string]: vtctldata.GetSrvKeyspaceNamesResponse.INameList };
 
         /**
-         * Creates a new GetTabletsResponse instance using the specified properties.
+         * Creates a new GetSrvKeyspaceNamesResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetTabletsResponse instance
+         * @returns GetSrvKeyspaceNamesResponse instance
          */
-        public static create(properties?: vtctldata.IGetTabletsResponse): vtctldata.GetTabletsResponse;
+        public static create(properties?: vtctldata.IGetSrvKeyspaceNamesResponse): vtctldata.GetSrvKeyspaceNamesResponse;
 
         /**
-         * Encodes the specified GetTabletsResponse message. Does not implicitly {@link vtctldata.GetTabletsResponse.verify|verify} messages.
-         * @param message GetTabletsResponse message or plain object to encode
+         * Encodes the specified GetSrvKeyspaceNamesResponse message. Does not implicitly {@link vtctldata.GetSrvKeyspaceNamesResponse.verify|verify} messages.
+         * @param message GetSrvKeyspaceNamesResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetTabletsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetSrvKeyspaceNamesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetTabletsResponse message, length delimited. Does not implicitly {@link vtctldata.GetTabletsResponse.verify|verify} messages.
-         * @param message GetTabletsResponse message or plain object to encode
+         * Encodes the specified GetSrvKeyspaceNamesResponse message, length delimited. Does not implicitly {@link vtctldata.GetSrvKeyspaceNamesResponse.verify|verify} messages.
+         * @param message GetSrvKeyspaceNamesResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetTabletsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetSrvKeyspaceNamesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetTabletsResponse message from the specified reader or buffer.
+         * Decodes a GetSrvKeyspaceNamesResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetTabletsResponse
+         * @returns GetSrvKeyspaceNamesResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetTabletsResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvKeyspaceNamesResponse;
 
         /**
-         * Decodes a GetTabletsResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetSrvKeyspaceNamesResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetTabletsResponse
+         * @returns GetSrvKeyspaceNamesResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetTabletsResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvKeyspaceNamesResponse;
 
         /**
-         * Verifies a GetTabletsResponse message.
+         * Verifies a GetSrvKeyspaceNamesResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetTabletsResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetSrvKeyspaceNamesResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetTabletsResponse
+         * @returns GetSrvKeyspaceNamesResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetTabletsResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvKeyspaceNamesResponse;
 
         /**
-         * Creates a plain object from a GetTabletsResponse message. Also converts values to other types if specified.
-         * @param message GetTabletsResponse
+         * Creates a plain object from a GetSrvKeyspaceNamesResponse message. Also converts values to other types if specified.
+         * @param message GetSrvKeyspaceNamesResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetTabletsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetSrvKeyspaceNamesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetTabletsResponse to JSON.
+         * Converts this GetSrvKeyspaceNamesResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetVSchemaRequest. */
-    interface IGetVSchemaRequest {
+    namespace GetSrvKeyspaceNamesResponse {
 
-        /** GetVSchemaRequest keyspace */
+        /** Properties of a NameList. */
+        interface INameList {
+
+            /** NameList names */
+            names?: (string[]|null);
+        }
+
+        /** Represents a NameList. */
+        class NameList implements INameList {
+
+            /**
+             * Constructs a new NameList.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: vtctldata.GetSrvKeyspaceNamesResponse.INameList);
+
+            /** NameList names. */
+            public names: string[];
+
+            /**
+             * Creates a new NameList instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns NameList instance
+             */
+            public static create(properties?: vtctldata.GetSrvKeyspaceNamesResponse.INameList): vtctldata.GetSrvKeyspaceNamesResponse.NameList;
+
+            /**
+             * Encodes the specified NameList message. Does not implicitly {@link vtctldata.GetSrvKeyspaceNamesResponse.NameList.verify|verify} messages.
+             * @param message NameList message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: vtctldata.GetSrvKeyspaceNamesResponse.INameList, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Encodes the specified NameList message, length delimited. Does not implicitly {@link vtctldata.GetSrvKeyspaceNamesResponse.NameList.verify|verify} messages.
+             * @param message NameList message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encodeDelimited(message: vtctldata.GetSrvKeyspaceNamesResponse.INameList, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a NameList message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns NameList
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvKeyspaceNamesResponse.NameList;
+
+            /**
+             * Decodes a NameList message from the specified reader or buffer, length delimited.
+             * @param reader Reader or buffer to decode from
+             * @returns NameList
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvKeyspaceNamesResponse.NameList;
+
+            /**
+             * Verifies a NameList message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+
+            /**
+             * Creates a NameList message from a plain object. Also converts values to their respective internal types.
+             * @param object Plain object
+             * @returns NameList
+             */
+            public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvKeyspaceNamesResponse.NameList;
+
+            /**
+             * Creates a plain object from a NameList message. Also converts values to other types if specified.
+             * @param message NameList
+             * @param [options] Conversion options
+             * @returns Plain object
+             */
+            public static toObject(message: vtctldata.GetSrvKeyspaceNamesResponse.NameList, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+            /**
+             * Converts this NameList to JSON.
+             * @returns JSON object
+             */
+            public toJSON(): { [k: string]: any };
+        }
+    }
+
+    /** Properties of a GetSrvKeyspacesRequest. */
+    interface IGetSrvKeyspacesRequest {
+
+        /** GetSrvKeyspacesRequest keyspace */
         keyspace?: (string|null);
+
+        /** GetSrvKeyspacesRequest cells */
+        cells?: (string[]|null);
     }
 
-    /** Represents a GetVSchemaRequest. */
-    class GetVSchemaRequest implements IGetVSchemaRequest {
+    /** Represents a GetSrvKeyspacesRequest. */
+    class GetSrvKeyspacesRequest implements IGetSrvKeyspacesRequest {
 
         /**
-         * Constructs a new GetVSchemaRequest.
+         * Constructs a new GetSrvKeyspacesRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetVSchemaRequest);
+        constructor(properties?: vtctldata.IGetSrvKeyspacesRequest);
 
-        /** GetVSchemaRequest keyspace. */
+        /** GetSrvKeyspacesRequest keyspace. */
         public keyspace: string;
 
+        /** GetSrvKeyspacesRequest cells. */
+        public cells: string[];
+
         /**
-         * Creates a new GetVSchemaRequest instance using the specified properties.
+         * Creates a new GetSrvKeyspacesRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetVSchemaRequest instance
+         * @returns GetSrvKeyspacesRequest instance
          */
-        public static create(properties?: vtctldata.IGetVSchemaRequest): vtctldata.GetVSchemaRequest;
+        public static create(properties?: vtctldata.IGetSrvKeyspacesRequest): vtctldata.GetSrvKeyspacesRequest;
 
         /**
-         * Encodes the specified GetVSchemaRequest message. Does not implicitly {@link vtctldata.GetVSchemaRequest.verify|verify} messages.
-         * @param message GetVSchemaRequest message or plain object to encode
+         * Encodes the specified GetSrvKeyspacesRequest message. Does not implicitly {@link vtctldata.GetSrvKeyspacesRequest.verify|verify} messages.
+         * @param message GetSrvKeyspacesRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetVSchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetSrvKeyspacesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetVSchemaRequest message, length delimited. Does not implicitly {@link vtctldata.GetVSchemaRequest.verify|verify} messages.
-         * @param message GetVSchemaRequest message or plain object to encode
+         * Encodes the specified GetSrvKeyspacesRequest message, length delimited. Does not implicitly {@link vtctldata.GetSrvKeyspacesRequest.verify|verify} messages.
+         * @param message GetSrvKeyspacesRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetVSchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetSrvKeyspacesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetVSchemaRequest message from the specified reader or buffer.
+         * Decodes a GetSrvKeyspacesRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetVSchemaRequest
+         * @returns GetSrvKeyspacesRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetVSchemaRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvKeyspacesRequest;
 
         /**
-         * Decodes a GetVSchemaRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetSrvKeyspacesRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetVSchemaRequest
+         * @returns GetSrvKeyspacesRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetVSchemaRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvKeyspacesRequest;
 
         /**
-         * Verifies a GetVSchemaRequest message.
+         * Verifies a GetSrvKeyspacesRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetVSchemaRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetSrvKeyspacesRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetVSchemaRequest
+         * @returns GetSrvKeyspacesRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetVSchemaRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvKeyspacesRequest;
 
         /**
-         * Creates a plain object from a GetVSchemaRequest message. Also converts values to other types if specified.
-         * @param message GetVSchemaRequest
+         * Creates a plain object from a GetSrvKeyspacesRequest message. Also converts values to other types if specified.
+         * @param message GetSrvKeyspacesRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetVSchemaRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetSrvKeyspacesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetVSchemaRequest to JSON.
+         * Converts this GetSrvKeyspacesRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetVSchemaResponse. */
-    interface IGetVSchemaResponse {
+    /** Properties of a GetSrvKeyspacesResponse. */
+    interface IGetSrvKeyspacesResponse {
 
-        /** GetVSchemaResponse v_schema */
-        v_schema?: (vschema.IKeyspace|null);
+        /** GetSrvKeyspacesResponse srv_keyspaces */
+        srv_keyspaces?: ({ [k: string]: topodata.ISrvKeyspace }|null);
     }
 
-    /** Represents a GetVSchemaResponse. */
-    class GetVSchemaResponse implements IGetVSchemaResponse {
+    /** Represents a GetSrvKeyspacesResponse. */
+    class GetSrvKeyspacesResponse implements IGetSrvKeyspacesResponse {
 
         /**
-         * Constructs a new GetVSchemaResponse.
+         * Constructs a new GetSrvKeyspacesResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetVSchemaResponse);
+        constructor(properties?: vtctldata.IGetSrvKeyspacesResponse);
 
-        /** GetVSchemaResponse v_schema. */
-        public v_schema?: (vschema.IKeyspace|null);
+        /** GetSrvKeyspacesResponse srv_keyspaces. */
+        public srv_keyspaces: { [k: string]: topodata.ISrvKeyspace };
 
         /**
-         * Creates a new GetVSchemaResponse instance using the specified properties.
+         * Creates a new GetSrvKeyspacesResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetVSchemaResponse instance
+         * @returns GetSrvKeyspacesResponse instance
          */
-        public static create(properties?: vtctldata.IGetVSchemaResponse): vtctldata.GetVSchemaResponse;
+        public static create(properties?: vtctldata.IGetSrvKeyspacesResponse): vtctldata.GetSrvKeyspacesResponse;
 
         /**
-         * Encodes the specified GetVSchemaResponse message. Does not implicitly {@link vtctldata.GetVSchemaResponse.verify|verify} messages.
-         * @param message GetVSchemaResponse message or plain object to encode
-         * @param [writer] Writer to encode to
+         * Encodes the specified GetSrvKeyspacesResponse message. Does not implicitly {@link vtctldata.GetSrvKeyspacesResponse.verify|verify} messages.
+         * @param message GetSrvKeyspacesResponse message or plain object to encode
+         * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetVSchemaResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetSrvKeyspacesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetVSchemaResponse message, length delimited. Does not implicitly {@link vtctldata.GetVSchemaResponse.verify|verify} messages.
-         * @param message GetVSchemaResponse message or plain object to encode
+         * Encodes the specified GetSrvKeyspacesResponse message, length delimited. Does not implicitly {@link vtctldata.GetSrvKeyspacesResponse.verify|verify} messages.
+         * @param message GetSrvKeyspacesResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetVSchemaResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetSrvKeyspacesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetVSchemaResponse message from the specified reader or buffer.
+         * Decodes a GetSrvKeyspacesResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetVSchemaResponse
+         * @returns GetSrvKeyspacesResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetVSchemaResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvKeyspacesResponse;
 
         /**
-         * Decodes a GetVSchemaResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetSrvKeyspacesResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetVSchemaResponse
+         * @returns GetSrvKeyspacesResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetVSchemaResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvKeyspacesResponse;
 
         /**
-         * Verifies a GetVSchemaResponse message.
+         * Verifies a GetSrvKeyspacesResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetVSchemaResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetSrvKeyspacesResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetVSchemaResponse
+         * @returns GetSrvKeyspacesResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetVSchemaResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvKeyspacesResponse;
 
         /**
-         * Creates a plain object from a GetVSchemaResponse message. Also converts values to other types if specified.
-         * @param message GetVSchemaResponse
+         * Creates a plain object from a GetSrvKeyspacesResponse message. Also converts values to other types if specified.
+         * @param message GetSrvKeyspacesResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetVSchemaResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetSrvKeyspacesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetVSchemaResponse to JSON.
+         * Converts this GetSrvKeyspacesResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetWorkflowsRequest. */
-    interface IGetWorkflowsRequest {
-
-        /** GetWorkflowsRequest keyspace */
-        keyspace?: (string|null);
+    /** Properties of a GetSrvVSchemaRequest. */
+    interface IGetSrvVSchemaRequest {
 
-        /** GetWorkflowsRequest active_only */
-        active_only?: (boolean|null);
+        /** GetSrvVSchemaRequest cell */
+        cell?: (string|null);
     }
 
-    /** Represents a GetWorkflowsRequest. */
-    class GetWorkflowsRequest implements IGetWorkflowsRequest {
+    /** Represents a GetSrvVSchemaRequest. */
+    class GetSrvVSchemaRequest implements IGetSrvVSchemaRequest {
 
         /**
-         * Constructs a new GetWorkflowsRequest.
+         * Constructs a new GetSrvVSchemaRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetWorkflowsRequest);
-
-        /** GetWorkflowsRequest keyspace. */
-        public keyspace: string;
+        constructor(properties?: vtctldata.IGetSrvVSchemaRequest);
 
-        /** GetWorkflowsRequest active_only. */
-        public active_only: boolean;
+        /** GetSrvVSchemaRequest cell. */
+        public cell: string;
 
         /**
-         * Creates a new GetWorkflowsRequest instance using the specified properties.
+         * Creates a new GetSrvVSchemaRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetWorkflowsRequest instance
+         * @returns GetSrvVSchemaRequest instance
          */
-        public static create(properties?: vtctldata.IGetWorkflowsRequest): vtctldata.GetWorkflowsRequest;
+        public static create(properties?: vtctldata.IGetSrvVSchemaRequest): vtctldata.GetSrvVSchemaRequest;
 
         /**
-         * Encodes the specified GetWorkflowsRequest message. Does not implicitly {@link vtctldata.GetWorkflowsRequest.verify|verify} messages.
-         * @param message GetWorkflowsRequest message or plain object to encode
+         * Encodes the specified GetSrvVSchemaRequest message. Does not implicitly {@link vtctldata.GetSrvVSchemaRequest.verify|verify} messages.
+         * @param message GetSrvVSchemaRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetWorkflowsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetSrvVSchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetWorkflowsRequest message, length delimited. Does not implicitly {@link vtctldata.GetWorkflowsRequest.verify|verify} messages.
-         * @param message GetWorkflowsRequest message or plain object to encode
+         * Encodes the specified GetSrvVSchemaRequest message, length delimited. Does not implicitly {@link vtctldata.GetSrvVSchemaRequest.verify|verify} messages.
+         * @param message GetSrvVSchemaRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetWorkflowsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetSrvVSchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetWorkflowsRequest message from the specified reader or buffer.
+         * Decodes a GetSrvVSchemaRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetWorkflowsRequest
+         * @returns GetSrvVSchemaRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetWorkflowsRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvVSchemaRequest;
 
         /**
-         * Decodes a GetWorkflowsRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetSrvVSchemaRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetWorkflowsRequest
+         * @returns GetSrvVSchemaRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetWorkflowsRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvVSchemaRequest;
 
         /**
-         * Verifies a GetWorkflowsRequest message.
+         * Verifies a GetSrvVSchemaRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetWorkflowsRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetSrvVSchemaRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetWorkflowsRequest
+         * @returns GetSrvVSchemaRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetWorkflowsRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvVSchemaRequest;
 
         /**
-         * Creates a plain object from a GetWorkflowsRequest message. Also converts values to other types if specified.
-         * @param message GetWorkflowsRequest
+         * Creates a plain object from a GetSrvVSchemaRequest message. Also converts values to other types if specified.
+         * @param message GetSrvVSchemaRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetWorkflowsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetSrvVSchemaRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetWorkflowsRequest to JSON.
+         * Converts this GetSrvVSchemaRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of a GetWorkflowsResponse. */
-    interface IGetWorkflowsResponse {
+    /** Properties of a GetSrvVSchemaResponse. */
+    interface IGetSrvVSchemaResponse {
 
-        /** GetWorkflowsResponse workflows */
-        workflows?: (vtctldata.IWorkflow[]|null);
+        /** GetSrvVSchemaResponse srv_v_schema */
+        srv_v_schema?: (vschema.ISrvVSchema|null);
     }
 
-    /** Represents a GetWorkflowsResponse. */
-    class GetWorkflowsResponse implements IGetWorkflowsResponse {
+    /** Represents a GetSrvVSchemaResponse. */
+    class GetSrvVSchemaResponse implements IGetSrvVSchemaResponse {
 
         /**
-         * Constructs a new GetWorkflowsResponse.
+         * Constructs a new GetSrvVSchemaResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IGetWorkflowsResponse);
+        constructor(properties?: vtctldata.IGetSrvVSchemaResponse);
 
-        /** GetWorkflowsResponse workflows. */
-        public workflows: vtctldata.IWorkflow[];
+        /** GetSrvVSchemaResponse srv_v_schema. */
+        public srv_v_schema?: (vschema.ISrvVSchema|null);
 
         /**
-         * Creates a new GetWorkflowsResponse instance using the specified properties.
+         * Creates a new GetSrvVSchemaResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns GetWorkflowsResponse instance
+         * @returns GetSrvVSchemaResponse instance
          */
-        public static create(properties?: vtctldata.IGetWorkflowsResponse): vtctldata.GetWorkflowsResponse;
+        public static create(properties?: vtctldata.IGetSrvVSchemaResponse): vtctldata.GetSrvVSchemaResponse;
 
         /**
-         * Encodes the specified GetWorkflowsResponse message. Does not implicitly {@link vtctldata.GetWorkflowsResponse.verify|verify} messages.
-         * @param message GetWorkflowsResponse message or plain object to encode
+         * Encodes the specified GetSrvVSchemaResponse message. Does not implicitly {@link vtctldata.GetSrvVSchemaResponse.verify|verify} messages.
+         * @param message GetSrvVSchemaResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IGetWorkflowsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetSrvVSchemaResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified GetWorkflowsResponse message, length delimited. Does not implicitly {@link vtctldata.GetWorkflowsResponse.verify|verify} messages.
-         * @param message GetWorkflowsResponse message or plain object to encode
+         * Encodes the specified GetSrvVSchemaResponse message, length delimited. Does not implicitly {@link vtctldata.GetSrvVSchemaResponse.verify|verify} messages.
+         * @param message GetSrvVSchemaResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IGetWorkflowsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetSrvVSchemaResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes a GetWorkflowsResponse message from the specified reader or buffer.
+         * Decodes a GetSrvVSchemaResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns GetWorkflowsResponse
+         * @returns GetSrvVSchemaResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetWorkflowsResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvVSchemaResponse;
 
         /**
-         * Decodes a GetWorkflowsResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetSrvVSchemaResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns GetWorkflowsResponse
+         * @returns GetSrvVSchemaResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetWorkflowsResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvVSchemaResponse;
 
         /**
-         * Verifies a GetWorkflowsResponse message.
+         * Verifies a GetSrvVSchemaResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates a GetWorkflowsResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetSrvVSchemaResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns GetWorkflowsResponse
+         * @returns GetSrvVSchemaResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.GetWorkflowsResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvVSchemaResponse;
 
         /**
-         * Creates a plain object from a GetWorkflowsResponse message. Also converts values to other types if specified.
-         * @param message GetWorkflowsResponse
+         * Creates a plain object from a GetSrvVSchemaResponse message. Also converts values to other types if specified.
+         * @param message GetSrvVSchemaResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.GetWorkflowsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetSrvVSchemaResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this GetWorkflowsResponse to JSON.
+         * Converts this GetSrvVSchemaResponse to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of an InitShardPrimaryRequest. */
-    interface IInitShardPrimaryRequest {
-
-        /** InitShardPrimaryRequest keyspace */
-        keyspace?: (string|null);
-
-        /** InitShardPrimaryRequest shard */
-        shard?: (string|null);
-
-        /** InitShardPrimaryRequest primary_elect_tablet_alias */
-        primary_elect_tablet_alias?: (topodata.ITabletAlias|null);
-
-        /** InitShardPrimaryRequest force */
-        force?: (boolean|null);
+    /** Properties of a GetSrvVSchemasRequest. */
+    interface IGetSrvVSchemasRequest {
 
-        /** InitShardPrimaryRequest wait_replicas_timeout */
-        wait_replicas_timeout?: (vttime.IDuration|null);
+        /** GetSrvVSchemasRequest cells */
+        cells?: (string[]|null);
     }
 
-    /** Represents an InitShardPrimaryRequest. */
-    class InitShardPrimaryRequest implements IInitShardPrimaryRequest {
+    /** Represents a GetSrvVSchemasRequest. */
+    class GetSrvVSchemasRequest implements IGetSrvVSchemasRequest {
 
         /**
-         * Constructs a new InitShardPrimaryRequest.
+         * Constructs a new GetSrvVSchemasRequest.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IInitShardPrimaryRequest);
-
-        /** InitShardPrimaryRequest keyspace. */
-        public keyspace: string;
-
-        /** InitShardPrimaryRequest shard. */
-        public shard: string;
-
-        /** InitShardPrimaryRequest primary_elect_tablet_alias. */
-        public primary_elect_tablet_alias?: (topodata.ITabletAlias|null);
-
-        /** InitShardPrimaryRequest force. */
-        public force: boolean;
+        constructor(properties?: vtctldata.IGetSrvVSchemasRequest);
 
-        /** InitShardPrimaryRequest wait_replicas_timeout. */
-        public wait_replicas_timeout?: (vttime.IDuration|null);
+        /** GetSrvVSchemasRequest cells. */
+        public cells: string[];
 
         /**
-         * Creates a new InitShardPrimaryRequest instance using the specified properties.
+         * Creates a new GetSrvVSchemasRequest instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns InitShardPrimaryRequest instance
+         * @returns GetSrvVSchemasRequest instance
          */
-        public static create(properties?: vtctldata.IInitShardPrimaryRequest): vtctldata.InitShardPrimaryRequest;
+        public static create(properties?: vtctldata.IGetSrvVSchemasRequest): vtctldata.GetSrvVSchemasRequest;
 
         /**
-         * Encodes the specified InitShardPrimaryRequest message. Does not implicitly {@link vtctldata.InitShardPrimaryRequest.verify|verify} messages.
-         * @param message InitShardPrimaryRequest message or plain object to encode
+         * Encodes the specified GetSrvVSchemasRequest message. Does not implicitly {@link vtctldata.GetSrvVSchemasRequest.verify|verify} messages.
+         * @param message GetSrvVSchemasRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IInitShardPrimaryRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetSrvVSchemasRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified InitShardPrimaryRequest message, length delimited. Does not implicitly {@link vtctldata.InitShardPrimaryRequest.verify|verify} messages.
-         * @param message InitShardPrimaryRequest message or plain object to encode
+         * Encodes the specified GetSrvVSchemasRequest message, length delimited. Does not implicitly {@link vtctldata.GetSrvVSchemasRequest.verify|verify} messages.
+         * @param message GetSrvVSchemasRequest message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IInitShardPrimaryRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetSrvVSchemasRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes an InitShardPrimaryRequest message from the specified reader or buffer.
+         * Decodes a GetSrvVSchemasRequest message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns InitShardPrimaryRequest
+         * @returns GetSrvVSchemasRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.InitShardPrimaryRequest;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvVSchemasRequest;
 
         /**
-         * Decodes an InitShardPrimaryRequest message from the specified reader or buffer, length delimited.
+         * Decodes a GetSrvVSchemasRequest message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns InitShardPrimaryRequest
+         * @returns GetSrvVSchemasRequest
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.InitShardPrimaryRequest;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvVSchemasRequest;
 
         /**
-         * Verifies an InitShardPrimaryRequest message.
+         * Verifies a GetSrvVSchemasRequest message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates an InitShardPrimaryRequest message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetSrvVSchemasRequest message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns InitShardPrimaryRequest
+         * @returns GetSrvVSchemasRequest
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.InitShardPrimaryRequest;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvVSchemasRequest;
 
         /**
-         * Creates a plain object from an InitShardPrimaryRequest message. Also converts values to other types if specified.
-         * @param message InitShardPrimaryRequest
+         * Creates a plain object from a GetSrvVSchemasRequest message. Also converts values to other types if specified.
+         * @param message GetSrvVSchemasRequest
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.InitShardPrimaryRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetSrvVSchemasRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this InitShardPrimaryRequest to JSON.
+         * Converts this GetSrvVSchemasRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
-    /** Properties of an InitShardPrimaryResponse. */
-    interface IInitShardPrimaryResponse {
+    /** Properties of a GetSrvVSchemasResponse. */
+    interface IGetSrvVSchemasResponse {
 
-        /** InitShardPrimaryResponse events */
-        events?: (logutil.IEvent[]|null);
+        /** GetSrvVSchemasResponse srv_v_schemas */
+        srv_v_schemas?: ({ [k: string]: vschema.ISrvVSchema }|null);
     }
 
-    /** Represents an InitShardPrimaryResponse. */
-    class InitShardPrimaryResponse implements IInitShardPrimaryResponse {
+    /** Represents a GetSrvVSchemasResponse. */
+    class GetSrvVSchemasResponse implements IGetSrvVSchemasResponse {
 
         /**
-         * Constructs a new InitShardPrimaryResponse.
+         * Constructs a new GetSrvVSchemasResponse.
          * @param [properties] Properties to set
          */
-        constructor(properties?: vtctldata.IInitShardPrimaryResponse);
+        constructor(properties?: vtctldata.IGetSrvVSchemasResponse);
 
-        /** InitShardPrimaryResponse events. */
-        public events: logutil.IEvent[];
+        /** GetSrvVSchemasResponse srv_v_schemas. */
+        public srv_v_schemas: { [k: string]: vschema.ISrvVSchema };
 
         /**
-         * Creates a new InitShardPrimaryResponse instance using the specified properties.
+         * Creates a new GetSrvVSchemasResponse instance using the specified properties.
          * @param [properties] Properties to set
-         * @returns InitShardPrimaryResponse instance
+         * @returns GetSrvVSchemasResponse instance
          */
-        public static create(properties?: vtctldata.IInitShardPrimaryResponse): vtctldata.InitShardPrimaryResponse;
+        public static create(properties?: vtctldata.IGetSrvVSchemasResponse): vtctldata.GetSrvVSchemasResponse;
 
         /**
-         * Encodes the specified InitShardPrimaryResponse message. Does not implicitly {@link vtctldata.InitShardPrimaryResponse.verify|verify} messages.
-         * @param message InitShardPrimaryResponse message or plain object to encode
+         * Encodes the specified GetSrvVSchemasResponse message. Does not implicitly {@link vtctldata.GetSrvVSchemasResponse.verify|verify} messages.
+         * @param message GetSrvVSchemasResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encode(message: vtctldata.IInitShardPrimaryResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encode(message: vtctldata.IGetSrvVSchemasResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Encodes the specified InitShardPrimaryResponse message, length delimited. Does not implicitly {@link vtctldata.InitShardPrimaryResponse.verify|verify} messages.
-         * @param message InitShardPrimaryResponse message or plain object to encode
+         * Encodes the specified GetSrvVSchemasResponse message, length delimited. Does not implicitly {@link vtctldata.GetSrvVSchemasResponse.verify|verify} messages.
+         * @param message GetSrvVSchemasResponse message or plain object to encode
          * @param [writer] Writer to encode to
          * @returns Writer
          */
-        public static encodeDelimited(message: vtctldata.IInitShardPrimaryResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+        public static encodeDelimited(message: vtctldata.IGetSrvVSchemasResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
         /**
-         * Decodes an InitShardPrimaryResponse message from the specified reader or buffer.
+         * Decodes a GetSrvVSchemasResponse message from the specified reader or buffer.
          * @param reader Reader or buffer to decode from
          * @param [length] Message length if known beforehand
-         * @returns InitShardPrimaryResponse
+         * @returns GetSrvVSchemasResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.InitShardPrimaryResponse;
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetSrvVSchemasResponse;
 
         /**
-         * Decodes an InitShardPrimaryResponse message from the specified reader or buffer, length delimited.
+         * Decodes a GetSrvVSchemasResponse message from the specified reader or buffer, length delimited.
          * @param reader Reader or buffer to decode from
-         * @returns InitShardPrimaryResponse
+         * @returns GetSrvVSchemasResponse
          * @throws {Error} If the payload is not a reader or valid buffer
          * @throws {$protobuf.util.ProtocolError} If required fields are missing
          */
-        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.InitShardPrimaryResponse;
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetSrvVSchemasResponse;
 
         /**
-         * Verifies an InitShardPrimaryResponse message.
+         * Verifies a GetSrvVSchemasResponse message.
          * @param message Plain object to verify
          * @returns `null` if valid, otherwise the reason why it is not
          */
         public static verify(message: { [k: string]: any }): (string|null);
 
         /**
-         * Creates an InitShardPrimaryResponse message from a plain object. Also converts values to their respective internal types.
+         * Creates a GetSrvVSchemasResponse message from a plain object. Also converts values to their respective internal types.
          * @param object Plain object
-         * @returns InitShardPrimaryResponse
+         * @returns GetSrvVSchemasResponse
          */
-        public static fromObject(object: { [k: string]: any }): vtctldata.InitShardPrimaryResponse;
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetSrvVSchemasResponse;
 
         /**
-         * Creates a plain object from an InitShardPrimaryResponse message. Also converts values to other types if specified.
-         * @param message InitShardPrimaryResponse
+         * Creates a plain object from a GetSrvVSchemasResponse message. Also converts values to other types if specified.
+         * @param message GetSrvVSchemasResponse
          * @param [options] Conversion options
          * @returns Plain object
          */
-        public static toObject(message: vtctldata.InitShardPrimaryResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+        public static toObject(message: vtctldata.GetSrvVSchemasResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
         /**
-         * Converts this InitShardPrimaryResponse to JSON.
+         * Converts this GetSrvVSchemasResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a GetTabletRequest. */
+    interface IGetTabletRequest {
+
+        /** GetTabletRequest tablet_alias */
+        tablet_alias?: (topodata.ITabletAlias|null);
+    }
+
+    /** Represents a GetTabletRequest. */
+    class GetTabletRequest implements IGetTabletRequest {
+
+        /**
+         * Constructs a new GetTabletRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IGetTabletRequest);
+
+        /** GetTabletRequest tablet_alias. */
+        public tablet_alias?: (topodata.ITabletAlias|null);
+
+        /**
+         * Creates a new GetTabletRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetTabletRequest instance
+         */
+        public static create(properties?: vtctldata.IGetTabletRequest): vtctldata.GetTabletRequest;
+
+        /**
+         * Encodes the specified GetTabletRequest message. Does not implicitly {@link vtctldata.GetTabletRequest.verify|verify} messages.
+         * @param message GetTabletRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IGetTabletRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetTabletRequest message, length delimited. Does not implicitly {@link vtctldata.GetTabletRequest.verify|verify} messages.
+         * @param message GetTabletRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IGetTabletRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetTabletRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetTabletRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetTabletRequest;
+
+        /**
+         * Decodes a GetTabletRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetTabletRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetTabletRequest;
+
+        /**
+         * Verifies a GetTabletRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetTabletRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetTabletRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetTabletRequest;
+
+        /**
+         * Creates a plain object from a GetTabletRequest message. Also converts values to other types if specified.
+         * @param message GetTabletRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.GetTabletRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetTabletRequest to JSON.
          * @returns JSON object
          */
         public toJSON(): { [k: string]: any };
     }
 
+    /** Properties of a GetTabletResponse. */
+    interface IGetTabletResponse {
+
+        /** GetTabletResponse tablet */
+        tablet?: (topodata.ITablet|null);
+    }
+
+    /** Represents a GetTabletResponse. */
+    class GetTabletResponse implements IGetTabletResponse {
+
+        /**
+         * Constructs a new GetTabletResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IGetTabletResponse);
+
+        /** GetTabletResponse tablet. */
+        public tablet?: (topodata.ITablet|null);
+
+        /**
+         * Creates a new GetTabletResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetTabletResponse instance
+         */
+        public static create(properties?: vtctldata.IGetTabletResponse): vtctldata.GetTabletResponse;
+
+        /**
+         * Encodes the specified GetTabletResponse message. Does not implicitly {@link vtctldata.GetTabletResponse.verify|verify} messages.
+         * @param message GetTabletResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IGetTabletResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetTabletResponse message, length delimited. Does not implicitly {@link vtctldata.GetTabletResponse.verify|verify} messages.
+         * @param message GetTabletResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IGetTabletResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetTabletResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetTabletResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetTabletResponse;
+
+        /**
+         * Decodes a GetTabletResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetTabletResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetTabletResponse;
+
+        /**
+         * Verifies a GetTabletResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetTabletResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetTabletResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetTabletResponse;
+
+        /**
+         * Creates a plain object from a GetTabletResponse message. Also converts values to other types if specified.
+         * @param message GetTabletResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.GetTabletResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetTabletResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a GetTabletsRequest. */
+    interface IGetTabletsRequest {
+
+        /** GetTabletsRequest keyspace */
+        keyspace?: (string|null);
+
+        /** GetTabletsRequest shard */
+        shard?: (string|null);
+
+        /** GetTabletsRequest cells */
+        cells?: (string[]|null);
+
+        /** GetTabletsRequest strict */
+        strict?: (boolean|null);
+
+        /** GetTabletsRequest tablet_aliases */
+        tablet_aliases?: (topodata.ITabletAlias[]|null);
+    }
+
+    /** Represents a GetTabletsRequest. */
+    class GetTabletsRequest implements IGetTabletsRequest {
+
+        /**
+         * Constructs a new GetTabletsRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IGetTabletsRequest);
+
+        /** GetTabletsRequest keyspace. */
+        public keyspace: string;
+
+        /** GetTabletsRequest shard. */
+        public shard: string;
+
+        /** GetTabletsRequest cells. */
+        public cells: string[];
+
+        /** GetTabletsRequest strict. */
+        public strict: boolean;
+
+        /** GetTabletsRequest tablet_aliases. */
+        public tablet_aliases: topodata.ITabletAlias[];
+
+        /**
+         * Creates a new GetTabletsRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetTabletsRequest instance
+         */
+        public static create(properties?: vtctldata.IGetTabletsRequest): vtctldata.GetTabletsRequest;
+
+        /**
+         * Encodes the specified GetTabletsRequest message. Does not implicitly {@link vtctldata.GetTabletsRequest.verify|verify} messages.
+         * @param message GetTabletsRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IGetTabletsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetTabletsRequest message, length delimited. Does not implicitly {@link vtctldata.GetTabletsRequest.verify|verify} messages.
+         * @param message GetTabletsRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IGetTabletsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetTabletsRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetTabletsRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetTabletsRequest;
+
+        /**
+         * Decodes a GetTabletsRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetTabletsRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetTabletsRequest;
+
+        /**
+         * Verifies a GetTabletsRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetTabletsRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetTabletsRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetTabletsRequest;
+
+        /**
+         * Creates a plain object from a GetTabletsRequest message. Also converts values to other types if specified.
+         * @param message GetTabletsRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.GetTabletsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetTabletsRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a GetTabletsResponse. */
+    interface IGetTabletsResponse {
+
+        /** GetTabletsResponse tablets */
+        tablets?: (topodata.ITablet[]|null);
+    }
+
+    /** Represents a GetTabletsResponse. */
+    class GetTabletsResponse implements IGetTabletsResponse {
+
+        /**
+         * Constructs a new GetTabletsResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IGetTabletsResponse);
+
+        /** GetTabletsResponse tablets. */
+        public tablets: topodata.ITablet[];
+
+        /**
+         * Creates a new GetTabletsResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetTabletsResponse instance
+         */
+        public static create(properties?: vtctldata.IGetTabletsResponse): vtctldata.GetTabletsResponse;
+
+        /**
+         * Encodes the specified GetTabletsResponse message. Does not implicitly {@link vtctldata.GetTabletsResponse.verify|verify} messages.
+         * @param message GetTabletsResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IGetTabletsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetTabletsResponse message, length delimited. Does not implicitly {@link vtctldata.GetTabletsResponse.verify|verify} messages.
+         * @param message GetTabletsResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IGetTabletsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetTabletsResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetTabletsResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetTabletsResponse;
+
+        /**
+         * Decodes a GetTabletsResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetTabletsResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetTabletsResponse;
+
+        /**
+         * Verifies a GetTabletsResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetTabletsResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetTabletsResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetTabletsResponse;
+
+        /**
+         * Creates a plain object from a GetTabletsResponse message. Also converts values to other types if specified.
+         * @param message GetTabletsResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.GetTabletsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetTabletsResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a GetVSchemaRequest. */
+    interface IGetVSchemaRequest {
+
+        /** GetVSchemaRequest keyspace */
+        keyspace?: (string|null);
+    }
+
+    /** Represents a GetVSchemaRequest. */
+    class GetVSchemaRequest implements IGetVSchemaRequest {
+
+        /**
+         * Constructs a new GetVSchemaRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IGetVSchemaRequest);
+
+        /** GetVSchemaRequest keyspace. */
+        public keyspace: string;
+
+        /**
+         * Creates a new GetVSchemaRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetVSchemaRequest instance
+         */
+        public static create(properties?: vtctldata.IGetVSchemaRequest): vtctldata.GetVSchemaRequest;
+
+        /**
+         * Encodes the specified GetVSchemaRequest message. Does not implicitly {@link vtctldata.GetVSchemaRequest.verify|verify} messages.
+         * @param message GetVSchemaRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IGetVSchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetVSchemaRequest message, length delimited. Does not implicitly {@link vtctldata.GetVSchemaRequest.verify|verify} messages.
+         * @param message GetVSchemaRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IGetVSchemaRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetVSchemaRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetVSchemaRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetVSchemaRequest;
+
+        /**
+         * Decodes a GetVSchemaRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetVSchemaRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetVSchemaRequest;
+
+        /**
+         * Verifies a GetVSchemaRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetVSchemaRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetVSchemaRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetVSchemaRequest;
+
+        /**
+         * Creates a plain object from a GetVSchemaRequest message. Also converts values to other types if specified.
+         * @param message GetVSchemaRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.GetVSchemaRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetVSchemaRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a GetVSchemaResponse. */
+    interface IGetVSchemaResponse {
+
+        /** GetVSchemaResponse v_schema */
+        v_schema?: (vschema.IKeyspace|null);
+    }
+
+    /** Represents a GetVSchemaResponse. */
+    class GetVSchemaResponse implements IGetVSchemaResponse {
+
+        /**
+         * Constructs a new GetVSchemaResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IGetVSchemaResponse);
+
+        /** GetVSchemaResponse v_schema. */
+        public v_schema?: (vschema.IKeyspace|null);
+
+        /**
+         * Creates a new GetVSchemaResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetVSchemaResponse instance
+         */
+        public static create(properties?: vtctldata.IGetVSchemaResponse): vtctldata.GetVSchemaResponse;
+
+        /**
+         * Encodes the specified GetVSchemaResponse message. Does not implicitly {@link vtctldata.GetVSchemaResponse.verify|verify} messages.
+         * @param message GetVSchemaResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IGetVSchemaResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetVSchemaResponse message, length delimited. Does not implicitly {@link vtctldata.GetVSchemaResponse.verify|verify} messages.
+         * @param message GetVSchemaResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IGetVSchemaResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetVSchemaResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetVSchemaResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetVSchemaResponse;
+
+        /**
+         * Decodes a GetVSchemaResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetVSchemaResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetVSchemaResponse;
+
+        /**
+         * Verifies a GetVSchemaResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetVSchemaResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetVSchemaResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetVSchemaResponse;
+
+        /**
+         * Creates a plain object from a GetVSchemaResponse message. Also converts values to other types if specified.
+         * @param message GetVSchemaResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.GetVSchemaResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetVSchemaResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a GetWorkflowsRequest. */
+    interface IGetWorkflowsRequest {
+
+        /** GetWorkflowsRequest keyspace */
+        keyspace?: (string|null);
+
+        /** GetWorkflowsRequest active_only */
+        active_only?: (boolean|null);
+    }
+
+    /** Represents a GetWorkflowsRequest. */
+    class GetWorkflowsRequest implements IGetWorkflowsRequest {
+
+        /**
+         * Constructs a new GetWorkflowsRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IGetWorkflowsRequest);
+
+        /** GetWorkflowsRequest keyspace. */
+        public keyspace: string;
+
+        /** GetWorkflowsRequest active_only. */
+        public active_only: boolean;
+
+        /**
+         * Creates a new GetWorkflowsRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetWorkflowsRequest instance
+         */
+        public static create(properties?: vtctldata.IGetWorkflowsRequest): vtctldata.GetWorkflowsRequest;
+
+        /**
+         * Encodes the specified GetWorkflowsRequest message. Does not implicitly {@link vtctldata.GetWorkflowsRequest.verify|verify} messages.
+         * @param message GetWorkflowsRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IGetWorkflowsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetWorkflowsRequest message, length delimited. Does not implicitly {@link vtctldata.GetWorkflowsRequest.verify|verify} messages.
+         * @param message GetWorkflowsRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IGetWorkflowsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetWorkflowsRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetWorkflowsRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetWorkflowsRequest;
+
+        /**
+         * Decodes a GetWorkflowsRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetWorkflowsRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetWorkflowsRequest;
+
+        /**
+         * Verifies a GetWorkflowsRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetWorkflowsRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetWorkflowsRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetWorkflowsRequest;
+
+        /**
+         * Creates a plain object from a GetWorkflowsRequest message. Also converts values to other types if specified.
+         * @param message GetWorkflowsRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.GetWorkflowsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetWorkflowsRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a GetWorkflowsResponse. */
+    interface IGetWorkflowsResponse {
+
+        /** GetWorkflowsResponse workflows */
+        workflows?: (vtctldata.IWorkflow[]|null);
+    }
+
+    /** Represents a GetWorkflowsResponse. */
+    class GetWorkflowsResponse implements IGetWorkflowsResponse {
+
+        /**
+         * Constructs a new GetWorkflowsResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IGetWorkflowsResponse);
+
+        /** GetWorkflowsResponse workflows. */
+        public workflows: vtctldata.IWorkflow[];
+
+        /**
+         * Creates a new GetWorkflowsResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetWorkflowsResponse instance
+         */
+        public static create(properties?: vtctldata.IGetWorkflowsResponse): vtctldata.GetWorkflowsResponse;
+
+        /**
+         * Encodes the specified GetWorkflowsResponse message. Does not implicitly {@link vtctldata.GetWorkflowsResponse.verify|verify} messages.
+         * @param message GetWorkflowsResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IGetWorkflowsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetWorkflowsResponse message, length delimited. Does not implicitly {@link vtctldata.GetWorkflowsResponse.verify|verify} messages.
+         * @param message GetWorkflowsResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IGetWorkflowsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetWorkflowsResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetWorkflowsResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.GetWorkflowsResponse;
+
+        /**
+         * Decodes a GetWorkflowsResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetWorkflowsResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.GetWorkflowsResponse;
+
+        /**
+         * Verifies a GetWorkflowsResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetWorkflowsResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetWorkflowsResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.GetWorkflowsResponse;
+
+        /**
+         * Creates a plain object from a GetWorkflowsResponse message. Also converts values to other types if specified.
+         * @param message GetWorkflowsResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.GetWorkflowsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetWorkflowsResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of an InitShardPrimaryRequest. */
+    interface IInitShardPrimaryRequest {
+
+        /** InitShardPrimaryRequest keyspace */
+        keyspace?: (string|null);
+
+        /** InitShardPrimaryRequest shard */
+        shard?: (string|null);
+
+        /** InitShardPrimaryRequest primary_elect_tablet_alias */
+        primary_elect_tablet_alias?: (topodata.ITabletAlias|null);
+
+        /** InitShardPrimaryRequest force */
+        force?: (boolean|null);
+
+        /** InitShardPrimaryRequest wait_replicas_timeout */
+        wait_replicas_timeout?: (vttime.IDuration|null);
+    }
+
+    /** Represents an InitShardPrimaryRequest. */
+    class InitShardPrimaryRequest implements IInitShardPrimaryRequest {
+
+        /**
+         * Constructs a new InitShardPrimaryRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IInitShardPrimaryRequest);
+
+        /** InitShardPrimaryRequest keyspace. */
+        public keyspace: string;
+
+        /** InitShardPrimaryRequest shard. */
+        public shard: string;
+
+        /** InitShardPrimaryRequest primary_elect_tablet_alias. */
+        public primary_elect_tablet_alias?: (topodata.ITabletAlias|null);
+
+        /** InitShardPrimaryRequest force. */
+        public force: boolean;
+
+        /** InitShardPrimaryRequest wait_replicas_timeout. */
+        public wait_replicas_timeout?: (vttime.IDuration|null);
+
+        /**
+         * Creates a new InitShardPrimaryRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns InitShardPrimaryRequest instance
+         */
+        public static create(properties?: vtctldata.IInitShardPrimaryRequest): vtctldata.InitShardPrimaryRequest;
+
+        /**
+         * Encodes the specified InitShardPrimaryRequest message. Does not implicitly {@link vtctldata.InitShardPrimaryRequest.verify|verify} messages.
+         * @param message InitShardPrimaryRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IInitShardPrimaryRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified InitShardPrimaryRequest message, length delimited. Does not implicitly {@link vtctldata.InitShardPrimaryRequest.verify|verify} messages.
+         * @param message InitShardPrimaryRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IInitShardPrimaryRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes an InitShardPrimaryRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns InitShardPrimaryRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.InitShardPrimaryRequest;
+
+        /**
+         * Decodes an InitShardPrimaryRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns InitShardPrimaryRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.InitShardPrimaryRequest;
+
+        /**
+         * Verifies an InitShardPrimaryRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates an InitShardPrimaryRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns InitShardPrimaryRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.InitShardPrimaryRequest;
+
+        /**
+         * Creates a plain object from an InitShardPrimaryRequest message. Also converts values to other types if specified.
+         * @param message InitShardPrimaryRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.InitShardPrimaryRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this InitShardPrimaryRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of an InitShardPrimaryResponse. */
+    interface IInitShardPrimaryResponse {
+
+        /** InitShardPrimaryResponse events */
+        events?: (logutil.IEvent[]|null);
+    }
+
+    /** Represents an InitShardPrimaryResponse. */
+    class InitShardPrimaryResponse implements IInitShardPrimaryResponse {
+
+        /**
+         * Constructs a new InitShardPrimaryResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.IInitShardPrimaryResponse);
+
+        /** InitShardPrimaryResponse events. */
+        public events: logutil.IEvent[];
+
+        /**
+         * Creates a new InitShardPrimaryResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns InitShardPrimaryResponse instance
+         */
+        public static create(properties?: vtctldata.IInitShardPrimaryResponse): vtctldata.InitShardPrimaryResponse;
+
+        /**
+         * Encodes the specified InitShardPrimaryResponse message. Does not implicitly {@link vtctldata.InitShardPrimaryResponse.verify|verify} messages.
+         * @param message InitShardPrimaryResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.IInitShardPrimaryResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified InitShardPrimaryResponse message, length delimited. Does not implicitly {@link vtctldata.InitShardPrimaryResponse.verify|verify} messages.
+         * @param message InitShardPrimaryResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.IInitShardPrimaryResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes an InitShardPrimaryResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns InitShardPrimaryResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.InitShardPrimaryResponse;
+
+        /**
+         * Decodes an InitShardPrimaryResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns InitShardPrimaryResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.InitShardPrimaryResponse;
+
+        /**
+         * Verifies an InitShardPrimaryResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates an InitShardPrimaryResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns InitShardPrimaryResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.InitShardPrimaryResponse;
+
+        /**
+         * Creates a plain object from an InitShardPrimaryResponse message. Also converts values to other types if specified.
+         * @param message InitShardPrimaryResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.InitShardPrimaryResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this InitShardPrimaryResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a LookupVindexCreateRequest. */
+    interface ILookupVindexCreateRequest {
+
+        /** LookupVindexCreateRequest keyspace */
+        keyspace?: (string|null);
+
+        /** LookupVindexCreateRequest vindex */
+        vindex?: (vschema.IKeyspace|null);
+
+        /** LookupVindexCreateRequest cells */
+        cells?: (string[]|null);
+
+        /** LookupVindexCreateRequest tablet_types */
+        tablet_types?: (topodata.TabletType[]|null);
+
+        /** LookupVindexCreateRequest continue_after_copy_with_owner */
+        continue_after_copy_with_owner?: (boolean|null);
+    }
+
+    /** Represents a LookupVindexCreateRequest. */
+    class LookupVindexCreateRequest implements ILookupVindexCreateRequest {
+        /**
+         * Constructs a new LookupVindexCreateRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.ILookupVindexCreateRequest);
+
+        /** LookupVindexCreateRequest keyspace. */
+        public keyspace: string;
+
+        /** LookupVindexCreateRequest vindex. */
+        public vindex?: (vschema.IKeyspace|null);
+
+        /** LookupVindexCreateRequest cells. */
+        public cells: string[];
+
+        /** LookupVindexCreateRequest tablet_types. */
+        public tablet_types: topodata.TabletType[];
+
+        /** LookupVindexCreateRequest continue_after_copy_with_owner. */
+        public continue_after_copy_with_owner: boolean;
+
+        /**
+         * Creates a new LookupVindexCreateRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns LookupVindexCreateRequest instance
+         */
+        public static create(properties?: vtctldata.ILookupVindexCreateRequest): vtctldata.LookupVindexCreateRequest;
+
+        /**
+         * Encodes the specified LookupVindexCreateRequest message. Does not implicitly {@link vtctldata.LookupVindexCreateRequest.verify|verify} messages.
+         * @param message LookupVindexCreateRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.ILookupVindexCreateRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified LookupVindexCreateRequest message, length delimited. Does not implicitly {@link vtctldata.LookupVindexCreateRequest.verify|verify} messages.
+         * @param message LookupVindexCreateRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.ILookupVindexCreateRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a LookupVindexCreateRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns LookupVindexCreateRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.LookupVindexCreateRequest;
+
+        /**
+         * Decodes a LookupVindexCreateRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns LookupVindexCreateRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.LookupVindexCreateRequest;
+
+        /**
+         * Verifies a LookupVindexCreateRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a LookupVindexCreateRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns LookupVindexCreateRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.LookupVindexCreateRequest;
+
+        /**
+         * Creates a plain object from a LookupVindexCreateRequest message. Also converts values to other types if specified.
+         * @param message LookupVindexCreateRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.LookupVindexCreateRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this LookupVindexCreateRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a LookupVindexCreateResponse. */
+    interface ILookupVindexCreateResponse {
+    }
+
+    /** Represents a LookupVindexCreateResponse. */
+    class LookupVindexCreateResponse implements ILookupVindexCreateResponse {
+        /**
+         * Constructs a new LookupVindexCreateResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.ILookupVindexCreateResponse);
+
+        /**
+         * Creates a new LookupVindexCreateResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns LookupVindexCreateResponse instance
+         */
+        public static create(properties?: vtctldata.ILookupVindexCreateResponse): vtctldata.LookupVindexCreateResponse;
+
+        /**
+         * Encodes the specified LookupVindexCreateResponse message. Does not implicitly {@link vtctldata.LookupVindexCreateResponse.verify|verify} messages.
+         * @param message LookupVindexCreateResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.ILookupVindexCreateResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified LookupVindexCreateResponse message, length delimited. Does not implicitly {@link vtctldata.LookupVindexCreateResponse.verify|verify} messages.
+         * @param message LookupVindexCreateResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.ILookupVindexCreateResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a LookupVindexCreateResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns LookupVindexCreateResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.LookupVindexCreateResponse;
+
+        /**
+         * Decodes a LookupVindexCreateResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns LookupVindexCreateResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.LookupVindexCreateResponse;
+
+        /**
+         * Verifies a LookupVindexCreateResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a LookupVindexCreateResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns LookupVindexCreateResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.LookupVindexCreateResponse;
+
+        /**
+         * Creates a plain object from a LookupVindexCreateResponse message. Also converts values to other types if specified.
+         * @param message LookupVindexCreateResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.LookupVindexCreateResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this LookupVindexCreateResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a LookupVindexExternalizeRequest. */
+    interface ILookupVindexExternalizeRequest {
+
+        /** LookupVindexExternalizeRequest keyspace */
+        keyspace?: (string|null);
+
+        /** LookupVindexExternalizeRequest name */
+        name?: (string|null);
+
+        /** LookupVindexExternalizeRequest source_cell */
+        source_cell?: (string|null);
+
+        /** LookupVindexExternalizeRequest target_cell */
+        target_cell?: (string|null);
+
+        /** LookupVindexExternalizeRequest tablet_types */
+        tablet_types?: (topodata.TabletType[]|null);
+
+        /** LookupVindexExternalizeRequest filtered_replication_wait_time */
+        filtered_replication_wait_time?: (vttime.IDuration|null);
+    }
+
+    /** Represents a LookupVindexExternalizeRequest. */
+    class LookupVindexExternalizeRequest implements ILookupVindexExternalizeRequest {
+        /**
+         * Constructs a new LookupVindexExternalizeRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.ILookupVindexExternalizeRequest);
+
+        /** LookupVindexExternalizeRequest keyspace. */
+        public keyspace: string;
+
+        /** LookupVindexExternalizeRequest name. */
+        public name: string;
+
+        /** LookupVindexExternalizeRequest source_cell. */
+        public source_cell: string;
+
+        /** LookupVindexExternalizeRequest target_cell. */
+        public target_cell: string;
+
+        /** LookupVindexExternalizeRequest tablet_types. */
+        public tablet_types: topodata.TabletType[];
+
+        /** LookupVindexExternalizeRequest filtered_replication_wait_time. */
+        public filtered_replication_wait_time?: (vttime.IDuration|null);
+
+        /**
+         * Creates a new LookupVindexExternalizeRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns LookupVindexExternalizeRequest instance
+         */
+        public static create(properties?: vtctldata.ILookupVindexExternalizeRequest): vtctldata.LookupVindexExternalizeRequest;
+
+        /**
+         * Encodes the specified LookupVindexExternalizeRequest message. Does not implicitly {@link vtctldata.LookupVindexExternalizeRequest.verify|verify} messages.
+         * @param message LookupVindexExternalizeRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.ILookupVindexExternalizeRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified LookupVindexExternalizeRequest message, length delimited. Does not implicitly {@link vtctldata.LookupVindexExternalizeRequest.verify|verify} messages.
+         * @param message LookupVindexExternalizeRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.ILookupVindexExternalizeRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a LookupVindexExternalizeRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns LookupVindexExternalizeRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.LookupVindexExternalizeRequest;
+
+        /**
+         * Decodes a LookupVindexExternalizeRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns LookupVindexExternalizeRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.LookupVindexExternalizeRequest;
+
+        /**
+         * Verifies a LookupVindexExternalizeRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a LookupVindexExternalizeRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns LookupVindexExternalizeRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.LookupVindexExternalizeRequest;
+
+        /**
+         * Creates a plain object from a LookupVindexExternalizeRequest message. Also converts values to other types if specified.
+         * @param message LookupVindexExternalizeRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.LookupVindexExternalizeRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this LookupVindexExternalizeRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a LookupVindexExternalizeResponse. */
+    interface ILookupVindexExternalizeResponse {
+    }
+
+    /** Represents a LookupVindexExternalizeResponse. */
+    class LookupVindexExternalizeResponse implements ILookupVindexExternalizeResponse {
+        /**
+         * Constructs a new LookupVindexExternalizeResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.ILookupVindexExternalizeResponse);
+
+        /**
+         * Creates a new LookupVindexExternalizeResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns LookupVindexExternalizeResponse instance
+         */
+        public static create(properties?: vtctldata.ILookupVindexExternalizeResponse): vtctldata.LookupVindexExternalizeResponse;
+
+        /**
+         * Encodes the specified LookupVindexExternalizeResponse message. Does not implicitly {@link vtctldata.LookupVindexExternalizeResponse.verify|verify} messages.
+         * @param message LookupVindexExternalizeResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.ILookupVindexExternalizeResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified LookupVindexExternalizeResponse message, length delimited. Does not implicitly {@link vtctldata.LookupVindexExternalizeResponse.verify|verify} messages.
+         * @param message LookupVindexExternalizeResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.ILookupVindexExternalizeResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a LookupVindexExternalizeResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns LookupVindexExternalizeResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.LookupVindexExternalizeResponse;
+
+        /**
+         * Decodes a LookupVindexExternalizeResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns LookupVindexExternalizeResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.LookupVindexExternalizeResponse;
+
+        /**
+         * Verifies a LookupVindexExternalizeResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a LookupVindexExternalizeResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns LookupVindexExternalizeResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.LookupVindexExternalizeResponse;
+
+        /**
+         * Creates a plain object from a LookupVindexExternalizeResponse message. Also converts values to other types if specified.
+         * @param message LookupVindexExternalizeResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.LookupVindexExternalizeResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this LookupVindexExternalizeResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a LookupVindexShowRequest. */
+    interface ILookupVindexShowRequest {
+
+        /** LookupVindexShowRequest keyspace */
+        keyspace?: (string|null);
+
+        /** LookupVindexShowRequest name */
+        name?: (string|null);
+    }
+
+    /** Represents a LookupVindexShowRequest. */
+    class LookupVindexShowRequest implements ILookupVindexShowRequest {
+        /**
+         * Constructs a new LookupVindexShowRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.ILookupVindexShowRequest);
+
+        /** LookupVindexShowRequest keyspace. */
+        public keyspace: string;
+
+        /** LookupVindexShowRequest name. */
+        public name: string;
+
+        /**
+         * Creates a new LookupVindexShowRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns LookupVindexShowRequest instance
+         */
+        public static create(properties?: vtctldata.ILookupVindexShowRequest): vtctldata.LookupVindexShowRequest;
+
+        /**
+         * Encodes the specified LookupVindexShowRequest message. Does not implicitly {@link vtctldata.LookupVindexShowRequest.verify|verify} messages.
+         * @param message LookupVindexShowRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.ILookupVindexShowRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified LookupVindexShowRequest message, length delimited. Does not implicitly {@link vtctldata.LookupVindexShowRequest.verify|verify} messages.
+         * @param message LookupVindexShowRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.ILookupVindexShowRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a LookupVindexShowRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns LookupVindexShowRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.LookupVindexShowRequest;
+
+        /**
+         * Decodes a LookupVindexShowRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns LookupVindexShowRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.LookupVindexShowRequest;
+
+        /**
+         * Verifies a LookupVindexShowRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a LookupVindexShowRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns LookupVindexShowRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.LookupVindexShowRequest;
+
+        /**
+         * Creates a plain object from a LookupVindexShowRequest message. Also converts values to other types if specified.
+         * @param message LookupVindexShowRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.LookupVindexShowRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this LookupVindexShowRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a LookupVindexShowResponse. */
+    interface ILookupVindexShowResponse {
+
+        /** LookupVindexShowResponse table */
+        table?: (string|null);
+
+        /** LookupVindexShowResponse workflow */
+        workflow?: (string|null);
+
+        /** LookupVindexShowResponse write_only */
+        write_only?: (boolean|null);
+
+        /** LookupVindexShowResponse rows_copied */
+        rows_copied?: (number|Long|null);
+
+        /** LookupVindexShowResponse max_v_replication_lag */
+        max_v_replication_lag?: (number|Long|null);
+
+        /** LookupVindexShowResponse streams */
+        streams?: (vtctldata.LookupVindexShowResponse.IStream[]|null);
+    }
+
+    /** Represents a LookupVindexShowResponse. */
+    class LookupVindexShowResponse implements ILookupVindexShowResponse {
+        /**
+         * Constructs a new LookupVindexShowResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.ILookupVindexShowResponse);
+
+        /** LookupVindexShowResponse table. */
+        public table: string;
+
+        /** LookupVindexShowResponse workflow. */
+        public workflow: string;
+
+        /** LookupVindexShowResponse write_only. */
+        public write_only: boolean;
+
+        /** LookupVindexShowResponse rows_copied. */
+        public rows_copied: (number|Long);
+
+        /** LookupVindexShowResponse max_v_replication_lag. */
+        public max_v_replication_lag: (number|Long);
+
+        /** LookupVindexShowResponse streams. */
+        public streams: vtctldata.LookupVindexShowResponse.IStream[];
+
+        /**
+         * Creates a new LookupVindexShowResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns LookupVindexShowResponse instance
+         */
+        public static create(properties?: vtctldata.ILookupVindexShowResponse): vtctldata.LookupVindexShowResponse;
+
+        /**
+         * Encodes the specified LookupVindexShowResponse message. Does not implicitly {@link vtctldata.LookupVindexShowResponse.verify|verify} messages.
+         * @param message LookupVindexShowResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.ILookupVindexShowResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified LookupVindexShowResponse message, length delimited. Does not implicitly {@link vtctldata.LookupVindexShowResponse.verify|verify} messages.
+         * @param message LookupVindexShowResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.ILookupVindexShowResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a LookupVindexShowResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns LookupVindexShowResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.LookupVindexShowResponse;
+
+        /**
+         * Decodes a LookupVindexShowResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns LookupVindexShowResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.LookupVindexShowResponse;
+
+        /**
+         * Verifies a LookupVindexShowResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a LookupVindexShowResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns LookupVindexShowResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.LookupVindexShowResponse;
+
+        /**
+         * Creates a plain object from a LookupVindexShowResponse message. Also converts values to other types if specified.
+         * @param message LookupVindexShowResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.LookupVindexShowResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this LookupVindexShowResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    namespace LookupVindexShowResponse {
+
+        /** Properties of a Stream. */
+        interface IStream {
+
+            /** Stream shard */
+            shard?: (string|null);
+
+            /** Stream id */
+            id?: (number|Long|null);
+
+            /** Stream state */
+            state?: (string|null);
+
+            /** Stream rows_copied */
+            rows_copied?: (number|Long|null);
+
+            /** Stream message */
+            message?: (string|null);
+        }
+
+        /** Represents a Stream. */
+        class Stream implements IStream {
+            /**
+             * Constructs a new Stream.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: vtctldata.LookupVindexShowResponse.IStream);
+
+            /** Stream shard. */
+            public shard: string;
+
+            /** Stream id. */
+            public id: (number|Long);
+
+            /** Stream state. */
+            public state: string;
+
+            /** Stream rows_copied. */
+            public rows_copied: (number|Long);
+
+            /** Stream message. */
+            public message: string;
+
+            /**
+             * Creates a new Stream instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns Stream instance
+             */
+            public static create(properties?: vtctldata.LookupVindexShowResponse.IStream): vtctldata.LookupVindexShowResponse.Stream;
+
+            /**
+             * Encodes the specified Stream message. Does not implicitly {@link vtctldata.LookupVindexShowResponse.Stream.verify|verify} messages.
+             * @param message Stream message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: vtctldata.LookupVindexShowResponse.IStream, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Encodes the specified Stream message, length delimited. Does not implicitly {@link vtctldata.LookupVindexShowResponse.Stream.verify|verify} messages.
+             * @param message Stream message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encodeDelimited(message: vtctldata.LookupVindexShowResponse.IStream, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a Stream message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns Stream
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.LookupVindexShowResponse.Stream;
+
+            /**
+             * Decodes a Stream message from the specified reader or buffer, length delimited.
+             * @param reader Reader or buffer to decode from
+             * @returns Stream
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.LookupVindexShowResponse.Stream;
+
+            /**
+             * Verifies a Stream message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+
+            /**
+             * Creates a Stream message from a plain object. Also converts values to their respective internal types.
+             * @param object Plain object
+             * @returns Stream
+             */
+            public static fromObject(object: { [k: string]: any }): vtctldata.LookupVindexShowResponse.Stream;
+
+            /**
+             * Creates a plain object from a Stream message. Also converts values to other types if specified.
+             * @param message Stream
+             * @param [options] Conversion options
+             * @returns Plain object
+             */
+            public static toObject(message: vtctldata.LookupVindexShowResponse.Stream, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+            /**
+             * Converts this Stream to JSON.
+             * @returns JSON object
+             */
+            public toJSON(): { [k: string]: any };
+        }
+    }
+
+    /** Properties of a LookupVindexVerifyRequest. */
+    interface ILookupVindexVerifyRequest {
+
+        /** LookupVindexVerifyRequest keyspace */
+        keyspace?: (string|null);
+
+        /** LookupVindexVerifyRequest name */
+        name?: (string|null);
+
+        /** LookupVindexVerifyRequest source_cell */
+        source_cell?: (string|null);
+
+        /** LookupVindexVerifyRequest target_cell */
+        target_cell?: (string|null);
+
+        /** LookupVindexVerifyRequest tablet_types */
+        tablet_types?: (topodata.TabletType[]|null);
+
+        /** LookupVindexVerifyRequest filtered_replication_wait_time */
+        filtered_replication_wait_time?: (vttime.IDuration|null);
+
+        /** LookupVindexVerifyRequest limit */
+        limit?: (number|Long|null);
+
+        /** LookupVindexVerifyRequest debug_query */
+        debug_query?: (boolean|null);
+
+        /** LookupVindexVerifyRequest only_pks */
+        only_pks?: (boolean|null);
+    }
+
+    /** Represents a LookupVindexVerifyRequest. */
+    class LookupVindexVerifyRequest implements ILookupVindexVerifyRequest {
+        /**
+         * Constructs a new LookupVindexVerifyRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.ILookupVindexVerifyRequest);
+
+        /** LookupVindexVerifyRequest keyspace. */
+        public keyspace: string;
+
+        /** LookupVindexVerifyRequest name. */
+        public name: string;
+
+        /** LookupVindexVerifyRequest source_cell. */
+        public source_cell: string;
+
+        /** LookupVindexVerifyRequest target_cell. */
+        public target_cell: string;
+
+        /** LookupVindexVerifyRequest tablet_types. */
+        public tablet_types: topodata.TabletType[];
+
+        /** LookupVindexVerifyRequest filtered_replication_wait_time. */
+        public filtered_replication_wait_time?: (vttime.IDuration|null);
+
+        /** LookupVindexVerifyRequest limit. */
+        public limit: (number|Long);
+
+        /** LookupVindexVerifyRequest debug_query. */
+        public debug_query: boolean;
+
+        /** LookupVindexVerifyRequest only_pks. */
+        public only_pks: boolean;
+
+        /**
+         * Creates a new LookupVindexVerifyRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns LookupVindexVerifyRequest instance
+         */
+        public static create(properties?: vtctldata.ILookupVindexVerifyRequest): vtctldata.LookupVindexVerifyRequest;
+
+        /**
+         * Encodes the specified LookupVindexVerifyRequest message. Does not implicitly {@link vtctldata.LookupVindexVerifyRequest.verify|verify} messages.
+         * @param message LookupVindexVerifyRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.ILookupVindexVerifyRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified LookupVindexVerifyRequest message, length delimited. Does not implicitly {@link vtctldata.LookupVindexVerifyRequest.verify|verify} messages.
+         * @param message LookupVindexVerifyRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.ILookupVindexVerifyRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a LookupVindexVerifyRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns LookupVindexVerifyRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.LookupVindexVerifyRequest;
+
+        /**
+         * Decodes a LookupVindexVerifyRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns LookupVindexVerifyRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.LookupVindexVerifyRequest;
+
+        /**
+         * Verifies a LookupVindexVerifyRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a LookupVindexVerifyRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns LookupVindexVerifyRequest
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.LookupVindexVerifyRequest;
+
+        /**
+         * Creates a plain object from a LookupVindexVerifyRequest message. Also converts values to other types if specified.
+         * @param message LookupVindexVerifyRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.LookupVindexVerifyRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this LookupVindexVerifyRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a LookupVindexVerifyResponse. */
+    interface ILookupVindexVerifyResponse {
+
+        /** LookupVindexVerifyResponse processed_rows */
+        processed_rows?: (number|Long|null);
+
+        /** LookupVindexVerifyResponse matching_rows */
+        matching_rows?: (number|Long|null);
+
+        /** LookupVindexVerifyResponse mismatched_rows */
+        mismatched_rows?: (number|Long|null);
+
+        /** LookupVindexVerifyResponse extra_rows_source */
+        extra_rows_source?: (number|Long|null);
+
+        /** LookupVindexVerifyResponse extra_rows_source_sample */
+        extra_rows_source_sample?: (vtctldata.LookupVindexVerifyResponse.IRowDiff[]|null);
+
+        /** LookupVindexVerifyResponse extra_rows_target */
+        extra_rows_target?: (number|Long|null);
+
+        /** LookupVindexVerifyResponse extra_rows_target_sample */
+        extra_rows_target_sample?: (vtctldata.LookupVindexVerifyResponse.IRowDiff[]|null);
+
+        /** LookupVindexVerifyResponse mismatched_rows_sample */
+        mismatched_rows_sample?: (vtctldata.LookupVindexVerifyResponse.IMismatch[]|null);
+    }
+
+    /** Represents a LookupVindexVerifyResponse. */
+    class LookupVindexVerifyResponse implements ILookupVindexVerifyResponse {
+        /**
+         * Constructs a new LookupVindexVerifyResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: vtctldata.ILookupVindexVerifyResponse);
+
+        /** LookupVindexVerifyResponse processed_rows. */
+        public processed_rows: (number|Long);
+
+        /** LookupVindexVerifyResponse matching_rows. */
+        public matching_rows: (number|Long);
+
+        /** LookupVindexVerifyResponse mismatched_rows. */
+        public mismatched_rows: (number|Long);
+
+        /** LookupVindexVerifyResponse extra_rows_source. */
+        public extra_rows_source: (number|Long);
+
+        /** LookupVindexVerifyResponse extra_rows_source_sample. */
+        public extra_rows_source_sample: vtctldata.LookupVindexVerifyResponse.IRowDiff[];
+
+        /** LookupVindexVerifyResponse extra_rows_target. */
+        public extra_rows_target: (number|Long);
+
+        /** LookupVindexVerifyResponse extra_rows_target_sample. */
+        public extra_rows_target_sample: vtctldata.LookupVindexVerifyResponse.IRowDiff[];
+
+        /** LookupVindexVerifyResponse mismatched_rows_sample. */
+        public mismatched_rows_sample: vtctldata.LookupVindexVerifyResponse.IMismatch[];
+
+        /**
+         * Creates a new LookupVindexVerifyResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns LookupVindexVerifyResponse instance
+         */
+        public static create(properties?: vtctldata.ILookupVindexVerifyResponse): vtctldata.LookupVindexVerifyResponse;
+
+        /**
+         * Encodes the specified LookupVindexVerifyResponse message. Does not implicitly {@link vtctldata.LookupVindexVerifyResponse.verify|verify} messages.
+         * @param message LookupVindexVerifyResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: vtctldata.ILookupVindexVerifyResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified LookupVindexVerifyResponse message, length delimited. Does not implicitly {@link vtctldata.LookupVindexVerifyResponse.verify|verify} messages.
+         * @param message LookupVindexVerifyResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: vtctldata.ILookupVindexVerifyResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a LookupVindexVerifyResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns LookupVindexVerifyResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.LookupVindexVerifyResponse;
+
+        /**
+         * Decodes a LookupVindexVerifyResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns LookupVindexVerifyResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.LookupVindexVerifyResponse;
+
+        /**
+         * Verifies a LookupVindexVerifyResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a LookupVindexVerifyResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns LookupVindexVerifyResponse
+         */
+        public static fromObject(object: { [k: string]: any }): vtctldata.LookupVindexVerifyResponse;
+
+        /**
+         * Creates a plain object from a LookupVindexVerifyResponse message. Also converts values to other types if specified.
+         * @param message LookupVindexVerifyResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: vtctldata.LookupVindexVerifyResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this LookupVindexVerifyResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    namespace LookupVindexVerifyResponse {
+
+        /** Properties of a RowDiff. */
+        interface IRowDiff {
+
+            /** RowDiff row */
+            row?: ({ [k: string]: query.IValue }|null);
+
+            /** RowDiff query */
+            query?: (string|null);
+        }
+
+        /** Represents a RowDiff. */
+        class RowDiff implements IRowDiff {
+            /**
+             * Constructs a new RowDiff.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: vtctldata.LookupVindexVerifyResponse.IRowDiff);
+
+            /** RowDiff row. */
+            public row: { [k: string]: query.IValue };
+
+            /** RowDiff query. */
+            public query: string;
+
+            /**
+             * Creates a new RowDiff instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns RowDiff instance
+             */
+            public static create(properties?: vtctldata.LookupVindexVerifyResponse.IRowDiff): vtctldata.LookupVindexVerifyResponse.RowDiff;
+
+            /**
+             * Encodes the specified RowDiff message. Does not implicitly {@link vtctldata.LookupVindexVerifyResponse.RowDiff.verify|verify} messages.
+             * @param message RowDiff message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: vtctldata.LookupVindexVerifyResponse.IRowDiff, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Encodes the specified RowDiff message, length delimited. Does not implicitly {@link vtctldata.LookupVindexVerifyResponse.RowDiff.verify|verify} messages.
+             * @param message RowDiff message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encodeDelimited(message: vtctldata.LookupVindexVerifyResponse.IRowDiff, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a RowDiff message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns RowDiff
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.LookupVindexVerifyResponse.RowDiff;
+
+            /**
+             * Decodes a RowDiff message from the specified reader or buffer, length delimited.
+             * @param reader Reader or buffer to decode from
+             * @returns RowDiff
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.LookupVindexVerifyResponse.RowDiff;
+
+            /**
+             * Verifies a RowDiff message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+
+            /**
+             * Creates a RowDiff message from a plain object. Also converts values to their respective internal types.
+             * @param object Plain object
+             * @returns RowDiff
+             */
+            public static fromObject(object: { [k: string]: any }): vtctldata.LookupVindexVerifyResponse.RowDiff;
+
+            /**
+             * Creates a plain object from a RowDiff message. Also converts values to other types if specified.
+             * @param message RowDiff
+             * @param [options] Conversion options
+             * @returns Plain object
+             */
+            public static toObject(message: vtctldata.LookupVindexVerifyResponse.RowDiff, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+            /**
+             * Converts this RowDiff to JSON.
+             * @returns JSON object
+             */
+            public toJSON(): { [k: string]: any };
+        }
+
+        /** Properties of a Mismatch. */
+        interface IMismatch {
+
+            /** Mismatch source */
+            source?: (vtctldata.LookupVindexVerifyResponse.IRowDiff|null);
+
+            /** Mismatch target */
+            target?: (vtctldata.LookupVindexVerifyResponse.IRowDiff|null);
+        }
+
+        /** Represents a Mismatch. */
+        class Mismatch implements IMismatch {
+            /**
+             * Constructs a new Mismatch.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: vtctldata.LookupVindexVerifyResponse.IMismatch);
+
+            /** Mismatch source. */
+            public source?: (vtctldata.LookupVindexVerifyResponse.IRowDiff|null);
+
+            /** Mismatch target. */
+            public target?: (vtctldata.LookupVindexVerifyResponse.IRowDiff|null);
+
+            /**
+             * Creates a new Mismatch instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns Mismatch instance
+             */
+            public static create(properties?: vtctldata.LookupVindexVerifyResponse.IMismatch): vtctldata.LookupVindexVerifyResponse.Mismatch;
+
+            /**
+             * Encodes the specified Mismatch message. Does not implicitly {@link vtctldata.LookupVindexVerifyResponse.Mismatch.verify|verify} messages.
+             * @param message Mismatch message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: vtctldata.LookupVindexVerifyResponse.IMismatch, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Encodes the specified Mismatch message, length delimited. Does not implicitly {@link vtctldata.LookupVindexVerifyResponse.Mismatch.verify|verify} messages.
+             * @param message Mismatch message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encodeDelimited(message: vtctldata.LookupVindexVerifyResponse.IMismatch, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a Mismatch message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns Mismatch
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): vtctldata.LookupVindexVerifyResponse.Mismatch;
+
+            /**
+             * Decodes a Mismatch message from the specified reader or buffer, length delimited.
+             * @param reader Reader or buffer to decode from
+             * @returns Mismatch
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): vtctldata.LookupVindexVerifyResponse.Mismatch;
+
+            /**
+             * Verifies a Mismatch message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+
+            /**
+             * Creates a Mismatch message from a plain object. Also converts values to their respective internal types.
+             * @param object Plain object
+             * @returns Mismatch
+             */
+            public static fromObject(object: { [k: string]: any }): vtctldata.LookupVindexVerifyResponse.Mismatch;
+
+            /**
+             * Creates a plain object from a Mismatch message. Also converts values to other types if specified.
+             * @param message Mismatch
+             * @param [options] Conversion options
+             * @returns Plain object
+             */
+            public static toObject(message: vtctldata.LookupVindexVerifyResponse.Mismatch, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+            /**
+             * Converts this Mismatch to JSON.
+             * @returns JSON object
+             */
+            public toJSON(): { [k: string]: any };
+        }
+    }
+
     /** Properties of a PingTabletRequest. */
     interface IPingTabletRequest {
 